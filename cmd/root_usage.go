@@ -70,7 +70,7 @@ var rootUsageGroups = []rootCommandGroup{
 	},
 	{
 		title:    "UTILITY COMMANDS",
-		commands: []string{"diff", "capabilities", "snitch", "version", "completion", "schema"},
+		commands: []string{"diff", "capabilities", "snitch", "version", "completion", "schema", "api", "cache", "mock"},
 	},
 }
 
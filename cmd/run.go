@@ -15,10 +15,13 @@ import (
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/install"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared/errfmt"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/telemetry"
 )
 
 var maybeCheckForSkillUpdates = install.MaybeCheckForSkillUpdates
 
+const telemetryFlushTimeout = 10 * time.Second
+
 // Run executes the CLI using the provided args (not including argv[0]) and version string.
 // It returns the intended process exit code.
 func Run(args []string, versionInfo string) int {
@@ -49,6 +52,12 @@ func Run(args []string, versionInfo string) int {
 		return ExitUsage
 	}
 
+	if err := shared.ApplyRootLogOutput(); err != nil {
+		fmt.Fprint(os.Stderr, errfmt.FormatStderr(err))
+		return ExitUsage
+	}
+	defer shared.CloseRootLogOutput()
+
 	if versionRequested {
 		if err := root.Run(runCtx); err != nil {
 			if errors.Is(err, flag.ErrHelp) {
@@ -69,9 +78,16 @@ func Run(args []string, versionInfo string) int {
 
 	commandName := getCommandName(root, args)
 
+	telemetry.Init(telemetry.OptionsFromEnv(versionInfo))
+	defer flushTelemetry()
+	commandCtx, commandSpan := telemetry.Start(runCtx, commandName, telemetry.SpanKindInternal,
+		telemetry.String("asc.command", commandName),
+	)
+
 	start := time.Now()
-	runErr := root.Run(runCtx)
+	runErr := root.Run(commandCtx)
 	elapsed := time.Since(start)
+	commandSpan.End(runErr)
 
 	if shouldCancelRunContextAfterError(runErr) {
 		stopSignals()
@@ -107,6 +123,16 @@ func Run(args []string, versionInfo string) int {
 	return ExitSuccess
 }
 
+// flushTelemetry exports recorded spans and counters. Export failures are
+// reported as warnings and never change the exit code.
+func flushTelemetry() {
+	ctx, cancel := context.WithTimeout(context.Background(), telemetryFlushTimeout)
+	defer cancel()
+	if err := telemetry.Shutdown(ctx); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+	}
+}
+
 func shouldCancelRunContextAfterError(err error) bool {
 	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
 }
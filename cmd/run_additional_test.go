@@ -523,14 +523,14 @@ func TestRun_InvalidOutputReturnsUsageBeforeAuth(t *testing.T) {
 			"--name", "My Device",
 			"--udid", "UDID",
 			"--platform", "IOS",
-			"--output", "yaml",
+			"--output", "xml",
 		}, "1.0.0")
 		if code != ExitUsage {
 			t.Fatalf("Run() exit code = %d, want %d", code, ExitUsage)
 		}
 	})
 
-	if !strings.Contains(stderr, "unsupported format: yaml") {
+	if !strings.Contains(stderr, "unsupported format: xml") {
 		t.Fatalf("expected output validation error, got %q", stderr)
 	}
 	if strings.Contains(stderr, "missing authentication") {
@@ -590,7 +590,7 @@ func TestRun_InvalidParentOutputReturnsUsageBeforeLeafExec(t *testing.T) {
 	_, stderr := captureCommandOutput(t, func() {
 		code := Run([]string{
 			"reviews",
-			"--output", "yaml",
+			"--output", "xml",
 			"respond",
 			"--review-id", "REVIEW_ID",
 			"--response", "Thanks!",
@@ -600,7 +600,7 @@ func TestRun_InvalidParentOutputReturnsUsageBeforeLeafExec(t *testing.T) {
 		}
 	})
 
-	if !strings.Contains(stderr, "unsupported format: yaml") {
+	if !strings.Contains(stderr, "unsupported format: xml") {
 		t.Fatalf("expected output validation error, got %q", stderr)
 	}
 	if strings.Contains(stderr, "missing authentication") {
@@ -420,7 +420,7 @@ func (c *Client) GetAnalyticsReportRequests(ctx context.Context, appID string, o
 	}
 	if query.nextURL != "" {
 		// Validate nextURL to prevent credential exfiltration
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("analyticsReportRequests: %w", err)
 		}
 		path = query.nextURL
@@ -487,7 +487,7 @@ func (c *Client) GetAnalyticsReports(ctx context.Context, requestID string, opts
 	path := fmt.Sprintf("/v1/analyticsReportRequests/%s/reports", strings.TrimSpace(requestID))
 	if query.nextURL != "" {
 		// Validate nextURL to prevent credential exfiltration
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("analyticsReports: %w", err)
 		}
 		path = query.nextURL
@@ -545,7 +545,7 @@ func (c *Client) GetAnalyticsReportInstances(ctx context.Context, reportID strin
 	path := fmt.Sprintf("/v1/analyticsReports/%s/instances", strings.TrimSpace(reportID))
 	if query.nextURL != "" {
 		// Validate nextURL to prevent credential exfiltration
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("analyticsReportInstances: %w", err)
 		}
 		path = query.nextURL
@@ -604,7 +604,7 @@ func (c *Client) GetAnalyticsReportSegments(ctx context.Context, instanceID stri
 	path := fmt.Sprintf("/v1/analyticsReportInstances/%s/segments", strings.TrimSpace(instanceID))
 	if query.nextURL != "" {
 		// Validate nextURL to prevent credential exfiltration
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("analyticsReportSegments: %w", err)
 		}
 		path = query.nextURL
@@ -15,6 +15,9 @@ import (
 	"net/http"
 	"os"
 	"strings"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/telemetry"
 )
 
 const maxAssetFileSize = int64(1024 * 1024 * 1024) // 1GB safety guardrail
@@ -43,6 +46,7 @@ func UploadAssetFromFile(ctx context.Context, file *os.File, fileSize int64, ope
 
 	client := &http.Client{Timeout: ResolveUploadTimeout()}
 
+	debugEnabled := resolveDebugSettings().enabled
 	for i, op := range operations {
 		method := strings.ToUpper(strings.TrimSpace(op.Method))
 		if method == "" {
@@ -68,16 +72,26 @@ func UploadAssetFromFile(ctx context.Context, file *os.File, fileSize int64, ope
 			req.Header.Set(header.Name, header.Value)
 		}
 
+		_, span := telemetry.Start(ctx, "upload part", telemetry.SpanKindClient, uploadPartAttrs(i, op)...)
+		start := time.Now()
 		resp, err := client.Do(req)
+		if debugEnabled {
+			logUploadPart(i, op, 1, resp, time.Since(start), err)
+		}
 		if err != nil {
+			span.End(err)
 			return fmt.Errorf("upload operation %d failed: %w", i, err)
 		}
 		_, _ = io.Copy(io.Discard, resp.Body)
 		_ = resp.Body.Close()
+		span.SetAttributes(telemetry.Int("http.response.status_code", int64(resp.StatusCode)))
 
 		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-			return fmt.Errorf("upload operation %d failed with status %d", i, resp.StatusCode)
+			err := fmt.Errorf("upload operation %d failed with status %d", i, resp.StatusCode)
+			span.End(err)
+			return err
 		}
+		span.End(nil)
 	}
 
 	return nil
@@ -0,0 +1,158 @@
+package asc
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/config"
+)
+
+const (
+	baseURLEnvVar    = "ASC_BASE_URL"
+	proxyURLEnvVar   = "ASC_PROXY_URL"
+	caBundleEnvVar   = "ASC_CA_BUNDLE"
+	clientCertEnvVar = "ASC_CLIENT_CERT"
+	clientKeyEnvVar  = "ASC_CLIENT_KEY"
+)
+
+// ClientOption configures optional client settings.
+type ClientOption func(*clientOptions)
+
+type clientOptions struct {
+	profile string
+}
+
+// WithProfile selects the config profile whose base URL, proxy, and TLS
+// settings the client uses.
+func WithProfile(name string) ClientOption {
+	return func(o *clientOptions) {
+		o.profile = strings.TrimSpace(name)
+	}
+}
+
+// ResolveNetwork returns the API endpoint and transport settings for a
+// profile, with BaseURL filled in.
+// Precedence: ASC_BASE_URL/ASC_PROXY_URL/ASC_CA_BUNDLE/ASC_CLIENT_CERT/
+// ASC_CLIENT_KEY > config profiles[profile] > config top-level settings.
+func ResolveNetwork(profile string) (config.Network, error) {
+	env := config.Network{}
+	for _, override := range []struct {
+		name  string
+		field *string
+	}{
+		{baseURLEnvVar, &env.BaseURL},
+		{proxyURLEnvVar, &env.ProxyURL},
+		{caBundleEnvVar, &env.CABundle},
+		{clientCertEnvVar, &env.ClientCert},
+		{clientKeyEnvVar, &env.ClientKey},
+	} {
+		if value, ok := envValue(override.name); ok {
+			*override.field = value
+		}
+	}
+
+	network := loadConfig().NetworkForProfile(profile).Merge(env)
+	if err := network.Validate(); err != nil {
+		return config.Network{}, fmt.Errorf("network settings: %w", err)
+	}
+	if network.BaseURL == "" {
+		network.BaseURL = BaseURL
+		return network, nil
+	}
+	normalized, err := NormalizeBaseURL(network.BaseURL)
+	if err != nil {
+		return config.Network{}, err
+	}
+	network.BaseURL = normalized
+	return network, nil
+}
+
+// ResolveBaseURL returns the App Store Connect API base URL for a profile.
+// ASC_BASE_URL or a configured base_url overrides BaseURL, for example to
+// point asc at `asc mock serve` or an egress gateway.
+func ResolveBaseURL(profile string) (string, error) {
+	network, err := ResolveNetwork(profile)
+	if err != nil {
+		return "", err
+	}
+	return network.BaseURL, nil
+}
+
+// NormalizeBaseURL validates an API base URL and strips any trailing slash.
+// Plain HTTP is only accepted for loopback hosts so JWTs never cross the
+// network unencrypted.
+func NormalizeBaseURL(raw string) (string, error) {
+	parsed, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil {
+		return "", fmt.Errorf("invalid base URL: %w", err)
+	}
+	if parsed.Host == "" || (parsed.Scheme != "https" && parsed.Scheme != "http") {
+		return "", fmt.Errorf("base URL must be an absolute http(s) URL (got %q)", raw)
+	}
+	if parsed.Scheme == "http" && !config.IsLoopbackHost(parsed.Hostname()) {
+		return "", fmt.Errorf("base URL must use https unless the host is loopback (got %q)", raw)
+	}
+	if parsed.RawQuery != "" || parsed.Fragment != "" || parsed.User != nil {
+		return "", fmt.Errorf("base URL must not include credentials, a query, or a fragment (got %q)", raw)
+	}
+	parsed.Path = strings.TrimRight(parsed.Path, "/")
+	parsed.RawPath = ""
+	return parsed.String(), nil
+}
+
+// applyNetworkTransport returns httpClient with the configured proxy, CA
+// bundle, and client certificate applied to a copy of its transport. The
+// caller's client is never modified.
+func applyNetworkTransport(httpClient *http.Client, network config.Network) (*http.Client, error) {
+	proxyURL := strings.TrimSpace(network.ProxyURL)
+	if proxyURL == "" && strings.TrimSpace(network.CABundle) == "" && strings.TrimSpace(network.ClientCert) == "" {
+		return httpClient, nil
+	}
+
+	base := httpClient.Transport
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	transport, ok := base.(*http.Transport)
+	if !ok {
+		return nil, fmt.Errorf("proxy and TLS settings require an *http.Transport (got %T)", base)
+	}
+	transport = transport.Clone()
+
+	if proxyURL != "" {
+		parsed, err := url.Parse(proxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy URL: %w", err)
+		}
+		transport.Proxy = http.ProxyURL(parsed)
+	}
+	tlsConfig, err := network.TLSConfig(transport.TLSClientConfig)
+	if err != nil {
+		return nil, err
+	}
+	transport.TLSClientConfig = tlsConfig
+
+	configured := *httpClient
+	configured.Transport = transport
+	return &configured, nil
+}
+
+// SetBaseURL overrides the API base URL for this client.
+func (c *Client) SetBaseURL(rawURL string) error {
+	normalized, err := NormalizeBaseURL(rawURL)
+	if err != nil {
+		return err
+	}
+	c.baseURL = normalized
+	return nil
+}
+
+// apiBaseURL returns the effective API base URL.
+func (c *Client) apiBaseURL() string {
+	if c.baseURL != "" {
+		return c.baseURL
+	}
+	return BaseURL
+}
@@ -79,7 +79,7 @@ func (c *Client) GetBetaLicenseAgreements(ctx context.Context, opts ...BetaLicen
 
 	path := "/v1/betaLicenseAgreements"
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("betaLicenseAgreements: %w", err)
 		}
 		path = query.nextURL
@@ -57,7 +57,7 @@ func (c *Client) GetAppCategories(ctx context.Context, opts ...AppCategoriesOpti
 
 	path := "/v1/appCategories"
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appCategories: %w", err)
 		}
 		path = query.nextURL
@@ -185,7 +185,7 @@ func (c *Client) GetAppCategorySubcategories(ctx context.Context, categoryID str
 
 	path := fmt.Sprintf("/v1/appCategories/%s/subcategories", categoryID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appCategorySubcategories: %w", err)
 		}
 		path = query.nextURL
@@ -220,7 +220,7 @@ func (c *Client) GetAppCategorySubcategoriesRelationships(ctx context.Context, c
 
 	path := fmt.Sprintf("/v1/appCategories/%s/relationships/subcategories", categoryID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appCategorySubcategoriesRelationships: %w", err)
 		}
 		path = query.nextURL
@@ -427,7 +427,7 @@ func (c *Client) GetFeedback(ctx context.Context, appID string, opts ...Feedback
 	path := fmt.Sprintf("/v1/apps/%s/betaFeedbackScreenshotSubmissions", appID)
 	if query.nextURL != "" {
 		// Validate nextURL to prevent credential exfiltration
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("feedback: %w", err)
 		}
 		path = query.nextURL
@@ -458,7 +458,7 @@ func (c *Client) GetCrashes(ctx context.Context, appID string, opts ...CrashOpti
 	path := fmt.Sprintf("/v1/apps/%s/betaFeedbackCrashSubmissions", appID)
 	if query.nextURL != "" {
 		// Validate nextURL to prevent credential exfiltration
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("crashes: %w", err)
 		}
 		path = query.nextURL
@@ -489,7 +489,7 @@ func (c *Client) GetReviews(ctx context.Context, appID string, opts ...ReviewOpt
 	path := fmt.Sprintf("/v1/apps/%s/customerReviews", appID)
 	if query.nextURL != "" {
 		// Validate nextURL to prevent credential exfiltration
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("reviews: %w", err)
 		}
 		path = query.nextURL
@@ -541,7 +541,7 @@ func (c *Client) GetBetaGroups(ctx context.Context, appID string, opts ...BetaGr
 	path := fmt.Sprintf("/v1/apps/%s/betaGroups", appID)
 	if query.nextURL != "" {
 		// Validate nextURL to prevent credential exfiltration
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("betaGroups: %w", err)
 		}
 		path = query.nextURL
@@ -571,7 +571,7 @@ func (c *Client) ListBetaGroups(ctx context.Context, opts ...BetaGroupsOption) (
 
 	path := "/v1/betaGroups"
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("betaGroups: %w", err)
 		}
 		path = query.nextURL
@@ -602,7 +602,7 @@ func (c *Client) GetBetaGroupBuilds(ctx context.Context, groupID string, opts ..
 	path := fmt.Sprintf("/v1/betaGroups/%s/builds", groupID)
 	if query.nextURL != "" {
 		// Validate nextURL to prevent credential exfiltration
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("betaGroupBuilds: %w", err)
 		}
 		path = query.nextURL
@@ -633,7 +633,7 @@ func (c *Client) GetBetaGroupTesters(ctx context.Context, groupID string, opts .
 	path := fmt.Sprintf("/v1/betaGroups/%s/betaTesters", groupID)
 	if query.nextURL != "" {
 		// Validate nextURL to prevent credential exfiltration
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("betaGroupTesters: %w", err)
 		}
 		path = query.nextURL
@@ -810,7 +810,7 @@ func (c *Client) GetBetaTesters(ctx context.Context, appID string, opts ...BetaT
 	path := "/v1/betaTesters"
 	if query.nextURL != "" {
 		// Validate nextURL to prevent credential exfiltration
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("betaTesters: %w", err)
 		}
 		path = query.nextURL
@@ -1150,7 +1150,7 @@ func (c *Client) GetAppStoreVersionLocalizations(ctx context.Context, versionID
 	path := fmt.Sprintf("/v1/appStoreVersions/%s/appStoreVersionLocalizations", versionID)
 	if query.nextURL != "" {
 		// Validate nextURL to prevent credential exfiltration
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appStoreVersionLocalizations: %w", err)
 		}
 		path = query.nextURL
@@ -1271,7 +1271,7 @@ func (c *Client) GetBetaBuildLocalizations(ctx context.Context, buildID string,
 
 	path := fmt.Sprintf("/v1/builds/%s/betaBuildLocalizations", buildID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("betaBuildLocalizations: %w", err)
 		}
 		path = query.nextURL
@@ -1301,7 +1301,7 @@ func (c *Client) ListBetaBuildLocalizations(ctx context.Context, opts ...BetaBui
 
 	path := "/v1/betaBuildLocalizations"
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("betaBuildLocalizations: %w", err)
 		}
 		path = query.nextURL
@@ -1469,7 +1469,7 @@ func (c *Client) GetAppInfoLocalizations(ctx context.Context, appInfoID string,
 	path := fmt.Sprintf("/v1/appInfos/%s/appInfoLocalizations", appInfoID)
 	if query.nextURL != "" {
 		// Validate nextURL to prevent credential exfiltration
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appInfoLocalizations: %w", err)
 		}
 		path = query.nextURL
@@ -17,7 +17,7 @@ func (c *Client) GetAccessibilityDeclarations(ctx context.Context, appID string,
 
 	path := fmt.Sprintf("/v1/apps/%s/accessibilityDeclarations", strings.TrimSpace(appID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("accessibility-declarations: %w", err)
 		}
 		path = query.nextURL
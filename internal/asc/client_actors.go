@@ -32,7 +32,7 @@ func (c *Client) GetActors(ctx context.Context, opts ...ActorsOption) (*ActorsRe
 	path := "/v1/actors"
 	if query.nextURL != "" {
 		// Validate nextURL to prevent credential exfiltration
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("actors: %w", err)
 		}
 		path = query.nextURL
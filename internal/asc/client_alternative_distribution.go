@@ -16,7 +16,7 @@ func (c *Client) GetAlternativeDistributionDomains(ctx context.Context, opts ...
 
 	path := "/v1/alternativeDistributionDomains"
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("alternativeDistributionDomains: %w", err)
 		}
 		path = query.nextURL
@@ -118,7 +118,7 @@ func (c *Client) GetAlternativeDistributionKeys(ctx context.Context, opts ...Alt
 
 	path := "/v1/alternativeDistributionKeys"
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("alternativeDistributionKeys: %w", err)
 		}
 		path = query.nextURL
@@ -311,7 +311,7 @@ func (c *Client) GetAlternativeDistributionPackageVersions(ctx context.Context,
 
 	path := fmt.Sprintf("/v1/alternativeDistributionPackages/%s/versions", packageID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("alternativeDistributionPackageVersions: %w", err)
 		}
 		path = query.nextURL
@@ -346,7 +346,7 @@ func (c *Client) GetAlternativeDistributionPackageVersionsRelationships(ctx cont
 
 	path := fmt.Sprintf("/v1/alternativeDistributionPackages/%s/relationships/versions", packageID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("alternativeDistributionPackageVersionsRelationships: %w", err)
 		}
 		path = query.nextURL
@@ -402,7 +402,7 @@ func (c *Client) GetAlternativeDistributionPackageVersionVariants(ctx context.Co
 
 	path := fmt.Sprintf("/v1/alternativeDistributionPackageVersions/%s/variants", versionID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("alternativeDistributionPackageVariants: %w", err)
 		}
 		path = query.nextURL
@@ -442,7 +442,7 @@ func (c *Client) GetAlternativeDistributionPackageVersionDeltas(ctx context.Cont
 
 	path := fmt.Sprintf("/v1/alternativeDistributionPackageVersions/%s/deltas", versionID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("alternativeDistributionPackageDeltas: %w", err)
 		}
 		path = query.nextURL
@@ -481,7 +481,7 @@ func (c *Client) getAlternativeDistributionPackageVersionLinkages(ctx context.Co
 
 	path := fmt.Sprintf("/v1/alternativeDistributionPackageVersions/%s/relationships/%s", versionID, relationship)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("alternativeDistributionPackageVersionRelationships: %w", err)
 		}
 		path = query.nextURL
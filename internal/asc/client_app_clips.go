@@ -613,7 +613,7 @@ func (c *Client) GetAppClips(ctx context.Context, appID string, opts ...AppClips
 
 	path := fmt.Sprintf("/v1/apps/%s/appClips", appID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appClips: %w", err)
 		}
 		path = query.nextURL
@@ -669,7 +669,7 @@ func (c *Client) GetAppClipDefaultExperiences(ctx context.Context, appClipID str
 
 	path := fmt.Sprintf("/v1/appClips/%s/appClipDefaultExperiences", appClipID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appClipDefaultExperiences: %w", err)
 		}
 		path = query.nextURL
@@ -700,7 +700,7 @@ func (c *Client) GetAppClipDefaultExperiencesRelationships(ctx context.Context,
 	appClipID = strings.TrimSpace(appClipID)
 	path := fmt.Sprintf("/v1/appClips/%s/relationships/appClipDefaultExperiences", appClipID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appClipDefaultExperiencesRelationships: %w", err)
 		}
 		path = query.nextURL
@@ -731,7 +731,7 @@ func (c *Client) GetAppClipAdvancedExperiencesRelationships(ctx context.Context,
 	appClipID = strings.TrimSpace(appClipID)
 	path := fmt.Sprintf("/v1/appClips/%s/relationships/appClipAdvancedExperiences", appClipID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appClipAdvancedExperiencesRelationships: %w", err)
 		}
 		path = query.nextURL
@@ -1017,7 +1017,7 @@ func (c *Client) GetAppClipDefaultExperienceLocalizations(ctx context.Context, e
 
 	path := fmt.Sprintf("/v1/appClipDefaultExperiences/%s/appClipDefaultExperienceLocalizations", experienceID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appClipDefaultExperienceLocalizations: %w", err)
 		}
 		path = query.nextURL
@@ -1052,7 +1052,7 @@ func (c *Client) GetAppClipDefaultExperienceLocalizationsRelationships(ctx conte
 
 	path := fmt.Sprintf("/v1/appClipDefaultExperiences/%s/relationships/appClipDefaultExperienceLocalizations", experienceID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appClipDefaultExperienceLocalizationsRelationships: %w", err)
 		}
 		path = query.nextURL
@@ -1231,7 +1231,7 @@ func (c *Client) GetAppClipAdvancedExperiences(ctx context.Context, appClipID st
 
 	path := fmt.Sprintf("/v1/appClips/%s/appClipAdvancedExperiences", appClipID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appClipAdvancedExperiences: %w", err)
 		}
 		path = query.nextURL
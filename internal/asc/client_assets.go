@@ -248,7 +248,7 @@ func (c *Client) GetAppScreenshotSetAppScreenshotsRelationships(ctx context.Cont
 
 	path := fmt.Sprintf("/v1/appScreenshotSets/%s/relationships/appScreenshots", setID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appScreenshotsRelationships: %w", err)
 		}
 		path = query.nextURL
@@ -503,7 +503,7 @@ func (c *Client) GetAppPreviewSetAppPreviewsRelationships(ctx context.Context, s
 
 	path := fmt.Sprintf("/v1/appPreviewSets/%s/relationships/appPreviews", setID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appPreviewsRelationships: %w", err)
 		}
 		path = query.nextURL
@@ -16,7 +16,7 @@ func (c *Client) GetBetaAppLocalizations(ctx context.Context, opts ...BetaAppLoc
 
 	path := "/v1/betaAppLocalizations"
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("betaAppLocalizations: %w", err)
 		}
 		path = query.nextURL
@@ -142,7 +142,7 @@ func (c *Client) GetBuildBundleFileSizes(ctx context.Context, buildBundleID stri
 
 	path := fmt.Sprintf("/v1/buildBundles/%s/buildBundleFileSizes", buildBundleID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("build bundle file sizes: %w", err)
 		}
 		path = query.nextURL
@@ -204,7 +204,7 @@ func (c *Client) GetBuildBundleBetaAppClipInvocations(ctx context.Context, build
 
 	path := fmt.Sprintf("/v1/buildBundles/%s/betaAppClipInvocations", buildBundleID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("build bundle app clip invocations: %w", err)
 		}
 		path = query.nextURL
@@ -302,7 +302,7 @@ func (c *Client) getBuildBundleLinkages(ctx context.Context, buildBundleID, rela
 
 	path := fmt.Sprintf("/v1/buildBundles/%s/relationships/%s", buildBundleID, relationship)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("buildBundleRelationships: %w", err)
 		}
 		path = query.nextURL
@@ -114,7 +114,7 @@ func (c *Client) GetBuildIcons(ctx context.Context, buildID string, opts ...Buil
 
 	path := fmt.Sprintf("/v1/builds/%s/icons", buildID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("buildIcons: %w", err)
 		}
 		path = query.nextURL
@@ -191,7 +191,7 @@ func (c *Client) GetBuilds(ctx context.Context, appID string, opts ...BuildsOpti
 	path := fmt.Sprintf("/v1/apps/%s/builds", appID)
 	if query.nextURL != "" {
 		// Validate nextURL to prevent credential exfiltration
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("builds: %w", err)
 		}
 		path = query.nextURL
@@ -551,7 +551,7 @@ func (c *Client) GetBuildIndividualTesters(ctx context.Context, buildID string,
 
 	path := fmt.Sprintf("/v1/builds/%s/individualTesters", buildID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("buildIndividualTesters: %w", err)
 		}
 		path = query.nextURL
@@ -652,7 +652,7 @@ func (c *Client) GetBuildUploads(ctx context.Context, appID string, opts ...Buil
 
 	path := fmt.Sprintf("/v1/apps/%s/buildUploads", appID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("buildUploads: %w", err)
 		}
 		path = query.nextURL
@@ -737,7 +737,7 @@ func (c *Client) GetBuildUploadFiles(ctx context.Context, uploadID string, opts
 
 	path := fmt.Sprintf("/v1/buildUploads/%s/buildUploadFiles", uploadID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("buildUploadFiles: %w", err)
 		}
 		path = query.nextURL
@@ -772,7 +772,7 @@ func (c *Client) GetBuildUploadBuildUploadFilesRelationships(ctx context.Context
 
 	path := fmt.Sprintf("/v1/buildUploads/%s/relationships/buildUploadFiles", uploadID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("buildUploadFilesRelationships: %w", err)
 		}
 		path = query.nextURL
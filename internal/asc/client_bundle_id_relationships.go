@@ -34,7 +34,7 @@ func (c *Client) GetBundleIDProfiles(ctx context.Context, bundleID string, opts
 
 	path := fmt.Sprintf("/v1/bundleIds/%s/profiles", strings.TrimSpace(bundleID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("bundleIdProfiles: %w", err)
 		}
 		path = query.nextURL
@@ -74,7 +74,7 @@ func (c *Client) GetBundleIDCapabilitiesRelationships(ctx context.Context, bundl
 	path := fmt.Sprintf("/v1/bundleIds/%s/relationships/bundleIdCapabilities", bundleID)
 	if query.nextURL != "" {
 		// Validate nextURL to prevent credential exfiltration.
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("bundleIDCapabilitiesRelationships: %w", err)
 		}
 		path = query.nextURL
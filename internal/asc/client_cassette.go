@@ -0,0 +1,472 @@
+package asc
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+)
+
+const (
+	recordDirEnvVar      = "ASC_RECORD"
+	replayDirEnvVar      = "ASC_REPLAY"
+	cassetteRedactEnvVar = "ASC_CASSETTE_REDACT"
+	cassetteVersion      = 1
+
+	cassetteRedactedValue = "[REDACTED]"
+)
+
+// defaultCassetteRedactedFields are credential-like JSON member names whose
+// values are never written to a cassette: passwords, secrets, tokens, and key
+// material. ASC_CASSETTE_REDACT adds more names, such as email or url.
+var defaultCassetteRedactedFields = []string{
+	"alternativeDistributionKeyBlob",
+	"awsSecretAccessKey",
+	"awsSessionToken",
+	"certificateContent",
+	"confirmPassword",
+	"demoAccountPassword",
+	"password",
+	"privateKey",
+	"profileContent",
+	"secret",
+	"secretAnswer",
+}
+
+// cassetteInteraction is one recorded request/response pair. Each interaction
+// is stored as its own JSON file so cassettes stay reviewable in diffs.
+type cassetteInteraction struct {
+	Version  int              `json:"version"`
+	Request  cassetteRequest  `json:"request"`
+	Response cassetteResponse `json:"response"`
+}
+
+type cassetteRequest struct {
+	Method     string          `json:"method"`
+	URL        string          `json:"url"`
+	Headers    http.Header     `json:"headers,omitempty"`
+	Body       json.RawMessage `json:"body,omitempty"`
+	BodyBase64 string          `json:"bodyBase64,omitempty"`
+}
+
+type cassetteResponse struct {
+	StatusCode int             `json:"status"`
+	Headers    http.Header     `json:"headers,omitempty"`
+	Body       json.RawMessage `json:"body,omitempty"`
+	BodyBase64 string          `json:"bodyBase64,omitempty"`
+}
+
+// cassetteTransportFromEnv returns a record or replay transport when
+// ASC_RECORD or ASC_REPLAY is set, or nil when neither is.
+func cassetteTransportFromEnv(next http.RoundTripper) http.RoundTripper {
+	recordDir, _ := envValue(recordDirEnvVar)
+	replayDir, _ := envValue(replayDirEnvVar)
+	switch {
+	case recordDir != "" && replayDir != "":
+		return failingTransport{err: fmt.Errorf("%s and %s cannot both be set", recordDirEnvVar, replayDirEnvVar)}
+	case recordDir != "":
+		if next == nil {
+			next = http.DefaultTransport
+		}
+		return &recordingTransport{next: next, dir: filepath.Clean(recordDir), redact: cassetteRedactedFields()}
+	case replayDir != "":
+		return &replayingTransport{dir: filepath.Clean(replayDir), redact: cassetteRedactedFields()}
+	default:
+		return nil
+	}
+}
+
+// ReplayingCassette reports whether requests are served from an ASC_REPLAY
+// cassette. Replayed requests never reach the network, so they are not signed
+// and no credentials are needed.
+func ReplayingCassette() bool {
+	recordDir, _ := envValue(recordDirEnvVar)
+	replayDir, _ := envValue(replayDirEnvVar)
+	return replayDir != "" && recordDir == ""
+}
+
+// withCassette wraps httpClient with the record or replay transport selected
+// by the environment. It reports whether a cassette is active so callers can
+// disable layers, such as the response cache, that would bypass it.
+func withCassette(httpClient *http.Client) (*http.Client, bool) {
+	if httpClient == nil {
+		return nil, false
+	}
+	transport := cassetteTransportFromEnv(httpClient.Transport)
+	if transport == nil {
+		return httpClient, false
+	}
+	wrapped := *httpClient
+	wrapped.Transport = transport
+	return &wrapped, true
+}
+
+type failingTransport struct {
+	err error
+}
+
+func (t failingTransport) RoundTrip(*http.Request) (*http.Response, error) {
+	return nil, t.err
+}
+
+// recordingTransport forwards requests and writes each sanitized exchange to
+// dir as NNNN-METHOD-path.json.
+type recordingTransport struct {
+	next   http.RoundTripper
+	dir    string
+	redact map[string]struct{}
+
+	mu  sync.Mutex
+	seq int
+}
+
+func (t *recordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	reqBody, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	interaction := cassetteInteraction{
+		Version: cassetteVersion,
+		Request: cassetteRequest{
+			Method:  req.Method,
+			URL:     sanitizeURLForLog(req.URL.String()),
+			Headers: sanitizeCassetteRequestHeaders(req.Header),
+		},
+		Response: cassetteResponse{
+			StatusCode: resp.StatusCode,
+			Headers:    sanitizeCassetteResponseHeaders(resp.Header),
+		},
+	}
+	interaction.Request.Body, interaction.Request.BodyBase64 = encodeCassetteBody(redactCassetteBody(reqBody, t.redact))
+	interaction.Response.Body, interaction.Response.BodyBase64 = encodeCassetteBody(redactCassetteBody(respBody, t.redact))
+
+	if err := t.write(req, &interaction); err != nil {
+		return nil, fmt.Errorf("record: %w", err)
+	}
+	return resp, nil
+}
+
+func (t *recordingTransport) write(req *http.Request, interaction *cassetteInteraction) error {
+	data, err := json.MarshalIndent(interaction, "", "  ")
+	if err != nil {
+		return err
+	}
+	data = append(data, '\n')
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if err := os.MkdirAll(t.dir, 0o700); err != nil {
+		return err
+	}
+	if t.seq == 0 {
+		t.seq = countCassetteFiles(t.dir)
+	}
+	// Several asc processes may record into the same directory (for example
+	// workflow steps), so claim file names exclusively.
+	for {
+		t.seq++
+		name := fmt.Sprintf("%04d-%s-%s.json", t.seq, req.Method, cassetteSlug(req.URL.Path))
+		file, err := os.OpenFile(filepath.Join(t.dir, name), os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+		if errors.Is(err, fs.ErrExist) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if _, err := file.Write(data); err != nil {
+			_ = file.Close()
+			return err
+		}
+		return file.Close()
+	}
+}
+
+// replayingTransport serves responses from a cassette directory without
+// touching the network. Identical requests are answered in recorded order;
+// once exhausted, the last recorded response is repeated so polling loops
+// terminate the way they did while recording. Request bodies are redacted
+// before matching, the same way they were when recorded.
+type replayingTransport struct {
+	dir    string
+	redact map[string]struct{}
+
+	loadOnce sync.Once
+	loadErr  error
+
+	mu     sync.Mutex
+	queues map[string][]*cassetteInteraction
+	served map[string]int
+}
+
+func (t *replayingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.loadOnce.Do(t.load)
+	if t.loadErr != nil {
+		return nil, t.loadErr
+	}
+
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	key := cassetteKey(req.Method, sanitizeURLForLog(req.URL.String()), redactCassetteBody(body, t.redact))
+
+	t.mu.Lock()
+	queue := t.queues[key]
+	index := t.served[key]
+	t.served[key]++
+	t.mu.Unlock()
+
+	if len(queue) == 0 {
+		return nil, fmt.Errorf("replay: no recorded response for %s %s in %s", req.Method, sanitizeURLForLog(req.URL.String()), t.dir)
+	}
+	if index >= len(queue) {
+		index = len(queue) - 1
+	}
+	recorded := queue[index].Response
+
+	respBody, err := decodeCassetteBody(recorded.Body, recorded.BodyBase64)
+	if err != nil {
+		return nil, fmt.Errorf("replay: %w", err)
+	}
+	header := recorded.Headers.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", recorded.StatusCode, http.StatusText(recorded.StatusCode)),
+		StatusCode:    recorded.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(respBody)),
+		ContentLength: int64(len(respBody)),
+		Request:       req,
+	}, nil
+}
+
+func (t *replayingTransport) load() {
+	entries, err := os.ReadDir(t.dir)
+	if err != nil {
+		t.loadErr = fmt.Errorf("replay: %w", err)
+		return
+	}
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if !entry.IsDir() && strings.HasSuffix(entry.Name(), ".json") {
+			names = append(names, entry.Name())
+		}
+	}
+	sort.Strings(names)
+
+	t.queues = make(map[string][]*cassetteInteraction, len(names))
+	t.served = make(map[string]int, len(names))
+	for _, name := range names {
+		data, err := os.ReadFile(filepath.Join(t.dir, name))
+		if err != nil {
+			t.loadErr = fmt.Errorf("replay: %w", err)
+			return
+		}
+		var interaction cassetteInteraction
+		if err := json.Unmarshal(data, &interaction); err != nil {
+			t.loadErr = fmt.Errorf("replay: parse %s: %w", name, err)
+			return
+		}
+		if interaction.Version != cassetteVersion {
+			t.loadErr = fmt.Errorf("replay: %s has unsupported cassette version %d", name, interaction.Version)
+			return
+		}
+		reqBody, err := decodeCassetteBody(interaction.Request.Body, interaction.Request.BodyBase64)
+		if err != nil {
+			t.loadErr = fmt.Errorf("replay: %s: %w", name, err)
+			return
+		}
+		key := cassetteKey(interaction.Request.Method, interaction.Request.URL, redactCassetteBody(reqBody, t.redact))
+		t.queues[key] = append(t.queues[key], &interaction)
+	}
+}
+
+// readRequestBody returns the request body and leaves req with an unread copy.
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	body, err := io.ReadAll(req.Body)
+	_ = req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
+
+// cassetteKey identifies a request for replay by method, sanitized URL, and
+// body. JSON bodies are compacted so formatting differences do not matter.
+func cassetteKey(method, rawURL string, body []byte) string {
+	canonical := body
+	if len(body) > 0 && json.Valid(body) {
+		var buf bytes.Buffer
+		if err := json.Compact(&buf, body); err == nil {
+			canonical = buf.Bytes()
+		}
+	}
+	sum := sha256.Sum256(canonical)
+	return strings.ToUpper(method) + " " + rawURL + " " + hex.EncodeToString(sum[:])
+}
+
+// cassetteRedactedFields returns the lowercased default and ASC_CASSETTE_REDACT
+// field names.
+func cassetteRedactedFields() map[string]struct{} {
+	fields := make(map[string]struct{}, len(defaultCassetteRedactedFields))
+	for _, name := range defaultCassetteRedactedFields {
+		fields[strings.ToLower(name)] = struct{}{}
+	}
+	extra, _ := envValue(cassetteRedactEnvVar)
+	for _, name := range strings.Split(extra, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			fields[strings.ToLower(name)] = struct{}{}
+		}
+	}
+	return fields
+}
+
+// redactCassetteBody replaces the values of redacted members, at any depth,
+// in a JSON body. Other bodies are returned unchanged.
+func redactCassetteBody(body []byte, fields map[string]struct{}) []byte {
+	if len(body) == 0 || len(fields) == 0 || !json.Valid(body) {
+		return body
+	}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return body
+	}
+	if !redactJSONValue(value, fields) {
+		return body
+	}
+	redacted, err := json.Marshal(value)
+	if err != nil {
+		return body
+	}
+	return redacted
+}
+
+func redactJSONValue(value any, fields map[string]struct{}) bool {
+	changed := false
+	switch v := value.(type) {
+	case map[string]any:
+		for key, item := range v {
+			if _, ok := fields[strings.ToLower(key)]; ok && item != nil {
+				v[key] = cassetteRedactedValue
+				changed = true
+				continue
+			}
+			if redactJSONValue(item, fields) {
+				changed = true
+			}
+		}
+	case []any:
+		for _, item := range v {
+			if redactJSONValue(item, fields) {
+				changed = true
+			}
+		}
+	}
+	return changed
+}
+
+func encodeCassetteBody(body []byte) (json.RawMessage, string) {
+	if len(body) == 0 {
+		return nil, ""
+	}
+	if json.Valid(body) {
+		return json.RawMessage(body), ""
+	}
+	return nil, base64.StdEncoding.EncodeToString(body)
+}
+
+func decodeCassetteBody(body json.RawMessage, bodyBase64 string) ([]byte, error) {
+	if bodyBase64 != "" {
+		return base64.StdEncoding.DecodeString(bodyBase64)
+	}
+	if len(body) == 0 {
+		return nil, nil
+	}
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, body); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// sanitizeCassetteRequestHeaders keeps only headers that describe the payload.
+// The JWT is replaced via sanitizeAuthHeader so cassettes are safe to commit.
+func sanitizeCassetteRequestHeaders(header http.Header) http.Header {
+	sanitized := http.Header{}
+	if auth := header.Get("Authorization"); auth != "" {
+		sanitized.Set("Authorization", sanitizeAuthHeader(auth))
+	}
+	if contentType := header.Get("Content-Type"); contentType != "" {
+		sanitized.Set("Content-Type", contentType)
+	}
+	return sanitized
+}
+
+func sanitizeCassetteResponseHeaders(header http.Header) http.Header {
+	sanitized := header.Clone()
+	sanitized.Del("Set-Cookie")
+	return sanitized
+}
+
+var cassetteSlugPattern = regexp.MustCompile(`[^A-Za-z0-9]+`)
+
+func cassetteSlug(path string) string {
+	slug := strings.Trim(cassetteSlugPattern.ReplaceAllString(path, "_"), "_")
+	if len(slug) > 80 {
+		slug = slug[:80]
+	}
+	if slug == "" {
+		return "root"
+	}
+	return slug
+}
+
+func countCassetteFiles(dir string) int {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return 0
+	}
+	count := 0
+	for _, entry := range entries {
+		if !entry.IsDir() && strings.HasSuffix(entry.Name(), ".json") {
+			count++
+		}
+	}
+	return count
+}
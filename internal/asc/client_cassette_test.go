@@ -0,0 +1,219 @@
+package asc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func newCassetteTestClient(t *testing.T, transport http.RoundTripper) *Client {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey() error: %v", err)
+	}
+	client, err := newClientWithPrivateKey("KEY123", "ISS456", key, &http.Client{Transport: transport})
+	if err != nil {
+		t.Fatalf("newClientWithPrivateKey() error: %v", err)
+	}
+	return client
+}
+
+func TestCassette_RecordThenReplay(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("ASC_REPLAY", "")
+	t.Setenv("ASC_RECORD", dir)
+
+	calls := 0
+	recorder := newCassetteTestClient(t, roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		calls++
+		body := `{"data":[{"type":"apps","id":"` + string(rune('0'+calls)) + `","attributes":{"name":"Demo"}}]}`
+		return jsonResponse(http.StatusOK, body), nil
+	}))
+	for range 2 {
+		if _, err := recorder.GetApps(context.Background()); err != nil {
+			t.Fatalf("GetApps() error: %v", err)
+		}
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("ReadDir() error: %v", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("expected 2 cassette files, got %d", len(entries))
+	}
+	if !strings.HasPrefix(entries[0].Name(), "0001-GET-v1_apps") {
+		t.Fatalf("unexpected cassette name %q", entries[0].Name())
+	}
+	data, err := os.ReadFile(filepath.Join(dir, entries[0].Name()))
+	if err != nil {
+		t.Fatalf("ReadFile() error: %v", err)
+	}
+	if !strings.Contains(string(data), "Bearer [REDACTED]") || strings.Contains(string(data), "eyJ") {
+		t.Fatalf("expected JWT to be redacted, got %s", data)
+	}
+
+	t.Setenv("ASC_RECORD", "")
+	t.Setenv("ASC_REPLAY", dir)
+	replayer := newCassetteTestClient(t, roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		t.Fatalf("unexpected network request: %s %s", req.Method, req.URL)
+		return nil, nil
+	}))
+
+	// Identical requests replay in recorded order, then repeat the last response.
+	for _, want := range []string{"1", "2", "2"} {
+		apps, err := replayer.GetApps(context.Background())
+		if err != nil {
+			t.Fatalf("GetApps() error: %v", err)
+		}
+		if len(apps.Data) != 1 || apps.Data[0].ID != want {
+			t.Fatalf("expected app %s, got %+v", want, apps.Data)
+		}
+	}
+
+	if _, err := replayer.GetApp(context.Background(), "missing"); err == nil || !strings.Contains(err.Error(), "replay: no recorded response for GET") {
+		t.Fatalf("expected unmatched replay error, got %v", err)
+	}
+}
+
+func TestCassette_ReplayMatchesRequestBody(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("ASC_REPLAY", "")
+	t.Setenv("ASC_RECORD", dir)
+
+	recorder := newCassetteTestClient(t, roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		body, _ := io.ReadAll(req.Body)
+		return jsonResponse(http.StatusCreated, `{"echo":`+string(body)+`}`), nil
+	}))
+	for _, payload := range []string{`{"name":"a"}`, `{"name":"b"}`} {
+		if _, err := recorder.RawRequest(context.Background(), http.MethodPost, "/v1/bundleIds", strings.NewReader(payload)); err != nil {
+			t.Fatalf("RawRequest() error: %v", err)
+		}
+	}
+
+	t.Setenv("ASC_RECORD", "")
+	t.Setenv("ASC_REPLAY", dir)
+	replayer := newCassetteTestClient(t, nil)
+
+	got, err := replayer.RawRequest(context.Background(), http.MethodPost, "/v1/bundleIds", strings.NewReader(`{ "name": "b" }`))
+	if err != nil {
+		t.Fatalf("RawRequest() error: %v", err)
+	}
+	if string(got) != `{"echo":{"name":"b"}}` {
+		t.Fatalf("unexpected replayed body %s", got)
+	}
+}
+
+func TestCassette_RecordAndReplayTogetherFails(t *testing.T) {
+	t.Setenv("ASC_RECORD", t.TempDir())
+	t.Setenv("ASC_REPLAY", t.TempDir())
+
+	client := newCassetteTestClient(t, nil)
+	if _, err := client.GetApps(context.Background()); err == nil || !strings.Contains(err.Error(), "cannot both be set") {
+		t.Fatalf("expected conflicting env error, got %v", err)
+	}
+}
+
+func TestCassette_RedactsSensitiveBodyFields(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("ASC_REPLAY", "")
+	t.Setenv("ASC_RECORD", dir)
+	t.Setenv("ASC_CASSETTE_REDACT", " internalNote, URL ")
+
+	recorder := newCassetteTestClient(t, roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return jsonResponse(http.StatusOK, `{"data":{"type":"appStoreReviewDetails","id":"detail-1","attributes":{
+			"contactEmail":"reviewer@example.com",
+			"internalNote":"private",
+			"uploadOperations":[{"method":"PUT","url":"https://upload.example.com/signed?sig=abc"}]
+		}}}`), nil
+	}))
+	payload := `{"data":{"type":"appStoreReviewDetails","id":"detail-1","attributes":{"demoAccountPassword":"hunter2","notes":"keep"}}}`
+	if _, err := recorder.RawRequest(context.Background(), http.MethodPatch, "/v1/appStoreReviewDetails/detail-1", strings.NewReader(payload)); err != nil {
+		t.Fatalf("RawRequest() error: %v", err)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil || len(entries) != 1 {
+		t.Fatalf("expected 1 cassette file, got %v (err %v)", entries, err)
+	}
+	data, err := os.ReadFile(filepath.Join(dir, entries[0].Name()))
+	if err != nil {
+		t.Fatalf("ReadFile() error: %v", err)
+	}
+	for _, secret := range []string{"hunter2", "private", "upload.example.com"} {
+		if strings.Contains(string(data), secret) {
+			t.Fatalf("expected %q to be redacted, got %s", secret, data)
+		}
+	}
+	// Non-credential fields are kept unless ASC_CASSETTE_REDACT names them.
+	for _, kept := range []string{`"keep"`, "reviewer@example.com", `"[REDACTED]"`} {
+		if !strings.Contains(string(data), kept) {
+			t.Fatalf("expected %s in cassette, got %s", kept, data)
+		}
+	}
+
+	// The live request still carries the real password; it matches the
+	// redacted recording.
+	t.Setenv("ASC_RECORD", "")
+	t.Setenv("ASC_REPLAY", dir)
+	replayer := newCassetteTestClient(t, nil)
+	got, err := replayer.RawRequest(context.Background(), http.MethodPatch, "/v1/appStoreReviewDetails/detail-1", strings.NewReader(payload))
+	if err != nil {
+		t.Fatalf("RawRequest() error: %v", err)
+	}
+	if !strings.Contains(string(got), `"url":"[REDACTED]"`) {
+		t.Fatalf("expected redacted replay body, got %s", got)
+	}
+
+	// Bodies that differ in a non-credential field do not share a recording.
+	changed := strings.Replace(payload, `"keep"`, `"other"`, 1)
+	if _, err := replayer.RawRequest(context.Background(), http.MethodPatch, "/v1/appStoreReviewDetails/detail-1", strings.NewReader(changed)); err == nil {
+		t.Fatal("expected a body with a different notes value not to match")
+	}
+}
+
+func TestNewReplayClient_ReplaysWithoutCredentials(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("ASC_RECORD", "")
+	t.Setenv("ASC_REPLAY", "")
+	if _, err := NewReplayClient(0); err == nil {
+		t.Fatal("expected error when ASC_REPLAY is not set")
+	}
+
+	t.Setenv("ASC_RECORD", dir)
+	recorder := newCassetteTestClient(t, roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return jsonResponse(http.StatusOK, `{"data":[{"type":"apps","id":"app-1","attributes":{"name":"Demo"}}]}`), nil
+	}))
+	if _, err := recorder.GetApps(context.Background()); err != nil {
+		t.Fatalf("GetApps() error: %v", err)
+	}
+
+	t.Setenv("ASC_RECORD", "")
+	t.Setenv("ASC_REPLAY", dir)
+	client, err := NewReplayClient(0)
+	if err != nil {
+		t.Fatalf("NewReplayClient() error: %v", err)
+	}
+	req, err := client.newRequest(context.Background(), http.MethodGet, "/v1/apps", nil)
+	if err != nil {
+		t.Fatalf("newRequest() error: %v", err)
+	}
+	if auth := req.Header.Get("Authorization"); auth != "" {
+		t.Fatalf("expected unsigned replay request, got Authorization %q", auth)
+	}
+	apps, err := client.GetApps(context.Background())
+	if err != nil {
+		t.Fatalf("GetApps() error: %v", err)
+	}
+	if len(apps.Data) != 1 || apps.Data[0].ID != "app-1" {
+		t.Fatalf("unexpected replayed apps %+v", apps.Data)
+	}
+}
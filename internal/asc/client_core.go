@@ -12,14 +12,16 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/auth"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/config"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/telemetry"
 )
 
 const (
-	// BaseURL is the App Store Connect API base URL
+	// BaseURL is the default App Store Connect API base URL (see ResolveBaseURL)
 	BaseURL = "https://api.appstoreconnect.apple.com"
 	// DefaultTimeout is the default request timeout
 	DefaultTimeout = 30 * time.Second
@@ -41,25 +43,17 @@ const (
 	defaultMutatingRequestLimit = 8
 )
 
-var retryLogger = slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
-	Level: slog.LevelInfo,
-	ReplaceAttr: func(_ []string, attr slog.Attr) slog.Attr {
-		if attr.Key == slog.TimeKey {
-			return slog.Attr{}
-		}
-		return attr
-	},
-}))
-
-var debugLogger = slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
-	Level: slog.LevelInfo,
-	ReplaceAttr: func(_ []string, attr slog.Attr) slog.Attr {
-		if attr.Key == slog.TimeKey {
-			return slog.Attr{}
-		}
-		return attr
-	},
-}))
+// retryLogger and debugLogger write text to stderr until ConfigureLogOutput
+// applies ASC_LOG_FORMAT and --log-file. They are swapped atomically because
+// requests may be logging while the output is reconfigured.
+var (
+	retryLogger atomic.Pointer[slog.Logger]
+	debugLogger atomic.Pointer[slog.Logger]
+)
+
+func init() {
+	setLoggers(logStderr, LogFormatText)
+}
 
 var retryLogOverride struct {
 	mu  sync.RWMutex
@@ -233,6 +227,10 @@ func envValue(name string) (string, bool) {
 type RetryableError struct {
 	Err        error
 	RetryAfter time.Duration
+	// StatusCode and RequestID describe the HTTP response that triggered the
+	// retry, when there was one.
+	StatusCode int
+	RequestID  string
 }
 
 func (e *RetryableError) Error() string {
@@ -385,29 +383,52 @@ func WithRetry[T any](ctx context.Context, fn func() (T, error), opts RetryOptio
 		}
 
 		if debugEnabled {
-			debugLogger.Info(
+			debugLogger.Load().Info(
 				"⟳ Retrying request",
-				"attempt", retryCount+1,
-				"max_retries", opts.MaxRetries,
-				"delay", delay.String(),
-				"error", err.Error(),
+				retryLogAttrs(delay, retryCount+1, opts.MaxRetries, err)...,
 			)
 		}
 
 		retryCount++
+		telemetry.Add(telemetry.CounterHTTPRetries, 1)
+		_, waitSpan := telemetry.Start(ctx, "retry backoff", telemetry.SpanKindInternal,
+			telemetry.Int("asc.retry.attempt", int64(retryCount)),
+			telemetry.Int("asc.retry.delay_ms", delay.Milliseconds()),
+		)
 
 		// Wait with context cancellation support
 		select {
 		case <-ctx.Done():
+			waitSpan.End(ctx.Err())
 			return zero, fmt.Errorf("retry cancelled: %w", ctx.Err())
 		case <-time.After(delay):
 			// Continue to next retry
+			waitSpan.End(nil)
 		}
 	}
 }
 
 func logRetry(delay time.Duration, attempt, maxRetries int, err error) {
-	retryLogger.Info("retrying request", "delay", delay.String(), "attempt", attempt, "maxRetries", maxRetries, "error", err)
+	retryLogger.Load().Info("retrying request", retryLogAttrs(delay, attempt, maxRetries, err)...)
+}
+
+func retryLogAttrs(delay time.Duration, attempt, maxRetries int, err error) []any {
+	attrs := []any{
+		"event", "retry",
+		"delay", delay.String(),
+		"delay_ms", delay.Milliseconds(),
+		"attempt", attempt,
+		"max_retries", maxRetries,
+	}
+	if retryable, ok := errors.AsType[*RetryableError](err); ok {
+		if retryable.StatusCode != 0 {
+			attrs = append(attrs, "status", retryable.StatusCode)
+		}
+		if retryable.RequestID != "" {
+			attrs = append(attrs, "request_id", retryable.RequestID)
+		}
+	}
+	return append(attrs, "error", err.Error())
 }
 
 // ResolveTimeout returns the request timeout, optionally overridden by config/env.
@@ -478,6 +499,7 @@ type Client struct {
 	keyID         string
 	issuerID      string
 	privateKey    *ecdsa.PrivateKey
+	baseURL       string // empty uses BaseURL constant
 	notaryBaseURL string // override for testing; empty uses NotaryBaseURL constant
 
 	jwtMu              sync.Mutex
@@ -486,36 +508,56 @@ type Client struct {
 
 	mutatingRequestLimiterOnce sync.Once
 	mutatingRequestLimiter     chan struct{}
+
+	responseCache *responseCache // nil when the on-disk response cache is disabled
+
+	unsignedRequests bool // set while replaying a cassette
+
+	rateLimiterOnce sync.Once
+	rateLimiter     *rateLimiter
 }
 
 // NewClient creates a new ASC client.
-func NewClient(keyID, issuerID, privateKeyPath string) (*Client, error) {
-	return newClientWithHTTPClient(keyID, issuerID, privateKeyPath, newDefaultHTTPClient(ResolveTimeout()))
+func NewClient(keyID, issuerID, privateKeyPath string, opts ...ClientOption) (*Client, error) {
+	return newClientWithHTTPClient(keyID, issuerID, privateKeyPath, newDefaultHTTPClient(ResolveTimeout()), opts...)
 }
 
 // NewClientWithTimeout creates a new ASC client with an explicit HTTP timeout.
-func NewClientWithTimeout(keyID, issuerID, privateKeyPath string, timeout time.Duration) (*Client, error) {
-	return newClientWithHTTPClient(keyID, issuerID, privateKeyPath, newDefaultHTTPClient(timeout))
+func NewClientWithTimeout(keyID, issuerID, privateKeyPath string, timeout time.Duration, opts ...ClientOption) (*Client, error) {
+	return newClientWithHTTPClient(keyID, issuerID, privateKeyPath, newDefaultHTTPClient(timeout), opts...)
 }
 
 // NewClientWithHTTPClient creates a new ASC client using the provided HTTP client.
 // If httpClient is nil, a default client with ASC timeouts is used.
-func NewClientWithHTTPClient(keyID, issuerID, privateKeyPath string, httpClient *http.Client) (*Client, error) {
+func NewClientWithHTTPClient(keyID, issuerID, privateKeyPath string, httpClient *http.Client, opts ...ClientOption) (*Client, error) {
 	if httpClient == nil {
 		httpClient = newDefaultHTTPClient(ResolveTimeout())
 	}
-	return newClientWithHTTPClient(keyID, issuerID, privateKeyPath, httpClient)
+	return newClientWithHTTPClient(keyID, issuerID, privateKeyPath, httpClient, opts...)
 }
 
 // NewClientFromPEM creates a new ASC client from in-memory private key PEM content.
-func NewClientFromPEM(keyID, issuerID, privateKeyPEM string) (*Client, error) {
-	return newClientFromPEMWithHTTPClient(keyID, issuerID, privateKeyPEM, newDefaultHTTPClient(ResolveTimeout()))
+func NewClientFromPEM(keyID, issuerID, privateKeyPEM string, opts ...ClientOption) (*Client, error) {
+	return newClientFromPEMWithHTTPClient(keyID, issuerID, privateKeyPEM, newDefaultHTTPClient(ResolveTimeout()), opts...)
 }
 
 // NewClientFromPEMWithTimeout creates a new ASC client from in-memory PEM
 // content with an explicit HTTP timeout.
-func NewClientFromPEMWithTimeout(keyID, issuerID, privateKeyPEM string, timeout time.Duration) (*Client, error) {
-	return newClientFromPEMWithHTTPClient(keyID, issuerID, privateKeyPEM, newDefaultHTTPClient(timeout))
+func NewClientFromPEMWithTimeout(keyID, issuerID, privateKeyPEM string, timeout time.Duration, opts ...ClientOption) (*Client, error) {
+	return newClientFromPEMWithHTTPClient(keyID, issuerID, privateKeyPEM, newDefaultHTTPClient(timeout), opts...)
+}
+
+// NewReplayClient creates an ASC client that answers every request from the
+// ASC_REPLAY cassette. It needs no credentials because requests are not signed.
+// A zero timeout uses the configured default.
+func NewReplayClient(timeout time.Duration, opts ...ClientOption) (*Client, error) {
+	if !ReplayingCassette() {
+		return nil, fmt.Errorf("%s is not set", replayDirEnvVar)
+	}
+	if timeout <= 0 {
+		timeout = ResolveTimeout()
+	}
+	return newClientWithPrivateKey("", "", nil, newDefaultHTTPClient(timeout), opts...)
 }
 
 func newDefaultHTTPClient(timeout time.Duration) *http.Client {
@@ -539,7 +581,7 @@ func newDefaultHTTPClient(timeout time.Duration) *http.Client {
 	}
 }
 
-func newClientWithHTTPClient(keyID, issuerID, privateKeyPath string, httpClient *http.Client) (*Client, error) {
+func newClientWithHTTPClient(keyID, issuerID, privateKeyPath string, httpClient *http.Client, opts ...ClientOption) (*Client, error) {
 	if err := auth.ValidateKeyFile(privateKeyPath); err != nil {
 		return nil, fmt.Errorf("invalid private key: %w", err)
 	}
@@ -549,25 +591,47 @@ func newClientWithHTTPClient(keyID, issuerID, privateKeyPath string, httpClient
 		return nil, fmt.Errorf("failed to load private key: %w", err)
 	}
 
-	return newClientWithPrivateKey(keyID, issuerID, key, httpClient), nil
+	return newClientWithPrivateKey(keyID, issuerID, key, httpClient, opts...)
 }
 
-func newClientFromPEMWithHTTPClient(keyID, issuerID, privateKeyPEM string, httpClient *http.Client) (*Client, error) {
+func newClientFromPEMWithHTTPClient(keyID, issuerID, privateKeyPEM string, httpClient *http.Client, opts ...ClientOption) (*Client, error) {
 	key, err := auth.LoadPrivateKeyFromPEM([]byte(privateKeyPEM))
 	if err != nil {
 		return nil, fmt.Errorf("invalid private key: %w", err)
 	}
 
-	return newClientWithPrivateKey(keyID, issuerID, key, httpClient), nil
+	return newClientWithPrivateKey(keyID, issuerID, key, httpClient, opts...)
 }
 
-func newClientWithPrivateKey(keyID, issuerID string, privateKey *ecdsa.PrivateKey, httpClient *http.Client) *Client {
-	return &Client{
-		httpClient: httpClient,
-		keyID:      keyID,
-		issuerID:   issuerID,
-		privateKey: privateKey,
+func newClientWithPrivateKey(keyID, issuerID string, privateKey *ecdsa.PrivateKey, httpClient *http.Client, opts ...ClientOption) (*Client, error) {
+	options := clientOptions{}
+	for _, opt := range opts {
+		opt(&options)
 	}
+	network, err := ResolveNetwork(options.profile)
+	if err != nil {
+		return nil, err
+	}
+	httpClient, err = applyNetworkTransport(httpClient, network)
+	if err != nil {
+		return nil, err
+	}
+	httpClient, cassette := withCassette(httpClient)
+	cache := newResponseCache(keyID, issuerID)
+	if cassette {
+		// Cached responses would hide requests from the recorder and make
+		// replay depend on state outside the cassette.
+		cache = nil
+	}
+	return &Client{
+		httpClient:       httpClient,
+		keyID:            keyID,
+		issuerID:         issuerID,
+		privateKey:       privateKey,
+		baseURL:          network.BaseURL,
+		responseCache:    cache,
+		unsignedRequests: cassette && ReplayingCassette(),
+	}, nil
 }
 
 func (c *Client) getMutatingRequestLimiter() chan struct{} {
@@ -17,7 +17,7 @@ func (c *Client) GetDevices(ctx context.Context, opts ...DevicesOption) (*Device
 	path := "/v1/devices"
 	if query.nextURL != "" {
 		// Validate nextURL to prevent credential exfiltration
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("devices: %w", err)
 		}
 		path = query.nextURL
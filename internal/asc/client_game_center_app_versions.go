@@ -17,7 +17,7 @@ func (c *Client) GetGameCenterAppVersions(ctx context.Context, opts ...GCAppVers
 
 	path := "/v1/gameCenterAppVersions"
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-app-versions: %w", err)
 		}
 		path = query.nextURL
@@ -68,7 +68,7 @@ func (c *Client) GetGameCenterDetailGameCenterAppVersions(ctx context.Context, d
 
 	path := fmt.Sprintf("/v1/gameCenterDetails/%s/gameCenterAppVersions", detailID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-detail-app-versions: %w", err)
 		}
 		path = query.nextURL
@@ -119,7 +119,7 @@ func (c *Client) GetGameCenterAppVersionCompatibilityVersions(ctx context.Contex
 
 	path := fmt.Sprintf("/v1/gameCenterAppVersions/%s/compatibilityVersions", appVersionID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-app-version-compatibility: %w", err)
 		}
 		path = query.nextURL
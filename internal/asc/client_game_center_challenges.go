@@ -18,7 +18,7 @@ func (c *Client) GetGameCenterChallenges(ctx context.Context, gcDetailID string,
 
 	path := fmt.Sprintf("/v1/gameCenterDetails/%s/gameCenterChallenges", strings.TrimSpace(gcDetailID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-challenges: %w", err)
 		}
 		path = query.nextURL
@@ -192,7 +192,7 @@ func (c *Client) GetGameCenterChallengeVersions(ctx context.Context, challengeID
 
 	path := fmt.Sprintf("/v1/gameCenterChallenges/%s/versions", strings.TrimSpace(challengeID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-challenge-versions: %w", err)
 		}
 		path = query.nextURL
@@ -272,7 +272,7 @@ func (c *Client) GetGameCenterChallengeLocalizations(ctx context.Context, versio
 
 	path := fmt.Sprintf("/v1/gameCenterChallengeVersions/%s/localizations", strings.TrimSpace(versionID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-challenge-localizations: %w", err)
 		}
 		path = query.nextURL
@@ -576,7 +576,7 @@ func (c *Client) GetGameCenterChallengeVersionReleases(ctx context.Context, gcDe
 
 	path := fmt.Sprintf("/v1/gameCenterDetails/%s/challengeReleases", strings.TrimSpace(gcDetailID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-challenge-releases: %w", err)
 		}
 		path = query.nextURL
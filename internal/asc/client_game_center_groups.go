@@ -17,7 +17,7 @@ func (c *Client) GetGameCenterGroups(ctx context.Context, opts ...GCGroupsOption
 
 	path := "/v1/gameCenterGroups"
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-groups: %w", err)
 		}
 		path = query.nextURL
@@ -142,7 +142,7 @@ func (c *Client) GetGameCenterGroupAchievements(ctx context.Context, groupID str
 
 	path := fmt.Sprintf("/v1/gameCenterGroups/%s/gameCenterAchievements", strings.TrimSpace(groupID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-group-achievements: %w", err)
 		}
 		path = query.nextURL
@@ -172,7 +172,7 @@ func (c *Client) GetGameCenterGroupAchievementsV2(ctx context.Context, groupID s
 
 	path := fmt.Sprintf("/v1/gameCenterGroups/%s/gameCenterAchievementsV2", strings.TrimSpace(groupID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-group-achievements-v2: %w", err)
 		}
 		path = query.nextURL
@@ -202,7 +202,7 @@ func (c *Client) GetGameCenterGroupActivities(ctx context.Context, groupID strin
 
 	path := fmt.Sprintf("/v1/gameCenterGroups/%s/gameCenterActivities", strings.TrimSpace(groupID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-group-activities: %w", err)
 		}
 		path = query.nextURL
@@ -232,7 +232,7 @@ func (c *Client) GetGameCenterGroupChallenges(ctx context.Context, groupID strin
 
 	path := fmt.Sprintf("/v1/gameCenterGroups/%s/gameCenterChallenges", strings.TrimSpace(groupID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-group-challenges: %w", err)
 		}
 		path = query.nextURL
@@ -262,7 +262,7 @@ func (c *Client) GetGameCenterGroupLeaderboards(ctx context.Context, groupID str
 
 	path := fmt.Sprintf("/v1/gameCenterGroups/%s/gameCenterLeaderboards", strings.TrimSpace(groupID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-group-leaderboards: %w", err)
 		}
 		path = query.nextURL
@@ -292,7 +292,7 @@ func (c *Client) GetGameCenterGroupLeaderboardsV2(ctx context.Context, groupID s
 
 	path := fmt.Sprintf("/v1/gameCenterGroups/%s/gameCenterLeaderboardsV2", strings.TrimSpace(groupID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-group-leaderboards-v2: %w", err)
 		}
 		path = query.nextURL
@@ -322,7 +322,7 @@ func (c *Client) GetGameCenterGroupLeaderboardSets(ctx context.Context, groupID
 
 	path := fmt.Sprintf("/v1/gameCenterGroups/%s/gameCenterLeaderboardSets", strings.TrimSpace(groupID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-group-leaderboard-sets: %w", err)
 		}
 		path = query.nextURL
@@ -352,7 +352,7 @@ func (c *Client) GetGameCenterGroupLeaderboardSetsV2(ctx context.Context, groupI
 
 	path := fmt.Sprintf("/v1/gameCenterGroups/%s/gameCenterLeaderboardSetsV2", strings.TrimSpace(groupID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-group-leaderboard-sets-v2: %w", err)
 		}
 		path = query.nextURL
@@ -18,7 +18,7 @@ func (c *Client) GetGameCenterMatchmakingQueues(ctx context.Context, opts ...GCM
 
 	path := "/v1/gameCenterMatchmakingQueues"
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-matchmaking-queues: %w", err)
 		}
 		path = query.nextURL
@@ -167,7 +167,7 @@ func (c *Client) GetGameCenterMatchmakingRuleSets(ctx context.Context, opts ...G
 
 	path := "/v1/gameCenterMatchmakingRuleSets"
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-matchmaking-rule-sets: %w", err)
 		}
 		path = query.nextURL
@@ -213,7 +213,7 @@ func (c *Client) GetGameCenterMatchmakingRuleSetQueues(ctx context.Context, rule
 
 	path := fmt.Sprintf("/v1/gameCenterMatchmakingRuleSets/%s/matchmakingQueues", strings.TrimSpace(ruleSetID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-matchmaking-rule-set-queues: %w", err)
 		}
 		path = query.nextURL
@@ -306,7 +306,7 @@ func (c *Client) GetGameCenterMatchmakingRules(ctx context.Context, ruleSetID st
 
 	path := fmt.Sprintf("/v1/gameCenterMatchmakingRuleSets/%s/rules", strings.TrimSpace(ruleSetID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-matchmaking-rules: %w", err)
 		}
 		path = query.nextURL
@@ -407,7 +407,7 @@ func (c *Client) GetGameCenterMatchmakingTeams(ctx context.Context, ruleSetID st
 
 	path := fmt.Sprintf("/v1/gameCenterMatchmakingRuleSets/%s/teams", strings.TrimSpace(ruleSetID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-matchmaking-teams: %w", err)
 		}
 		path = query.nextURL
@@ -508,7 +508,7 @@ func (c *Client) GetGameCenterMatchmakingQueueSizes(ctx context.Context, queueID
 
 	path := fmt.Sprintf("/v1/gameCenterMatchmakingQueues/%s/metrics/matchmakingQueueSizes", strings.TrimSpace(queueID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-matchmaking-queue-sizes: %w", err)
 		}
 		path = query.nextURL
@@ -538,7 +538,7 @@ func (c *Client) GetGameCenterMatchmakingQueueRequests(ctx context.Context, queu
 
 	path := fmt.Sprintf("/v1/gameCenterMatchmakingQueues/%s/metrics/matchmakingRequests", strings.TrimSpace(queueID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-matchmaking-queue-requests: %w", err)
 		}
 		path = query.nextURL
@@ -568,7 +568,7 @@ func (c *Client) GetGameCenterMatchmakingQueueSessions(ctx context.Context, queu
 
 	path := fmt.Sprintf("/v1/gameCenterMatchmakingQueues/%s/metrics/matchmakingSessions", strings.TrimSpace(queueID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-matchmaking-queue-sessions: %w", err)
 		}
 		path = query.nextURL
@@ -598,7 +598,7 @@ func (c *Client) GetGameCenterMatchmakingQueueExperimentSizes(ctx context.Contex
 
 	path := fmt.Sprintf("/v1/gameCenterMatchmakingQueues/%s/metrics/experimentMatchmakingQueueSizes", strings.TrimSpace(queueID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-matchmaking-experiment-queue-sizes: %w", err)
 		}
 		path = query.nextURL
@@ -628,7 +628,7 @@ func (c *Client) GetGameCenterMatchmakingQueueExperimentRequests(ctx context.Con
 
 	path := fmt.Sprintf("/v1/gameCenterMatchmakingQueues/%s/metrics/experimentMatchmakingRequests", strings.TrimSpace(queueID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-matchmaking-experiment-requests: %w", err)
 		}
 		path = query.nextURL
@@ -658,7 +658,7 @@ func (c *Client) GetGameCenterMatchmakingBooleanRuleResults(ctx context.Context,
 
 	path := fmt.Sprintf("/v1/gameCenterMatchmakingRules/%s/metrics/matchmakingBooleanRuleResults", strings.TrimSpace(ruleID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-matchmaking-boolean-rule-results: %w", err)
 		}
 		path = query.nextURL
@@ -688,7 +688,7 @@ func (c *Client) GetGameCenterMatchmakingNumberRuleResults(ctx context.Context,
 
 	path := fmt.Sprintf("/v1/gameCenterMatchmakingRules/%s/metrics/matchmakingNumberRuleResults", strings.TrimSpace(ruleID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-matchmaking-number-rule-results: %w", err)
 		}
 		path = query.nextURL
@@ -718,7 +718,7 @@ func (c *Client) GetGameCenterMatchmakingRuleErrors(ctx context.Context, ruleID
 
 	path := fmt.Sprintf("/v1/gameCenterMatchmakingRules/%s/metrics/matchmakingRuleErrors", strings.TrimSpace(ruleID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-matchmaking-rule-errors: %w", err)
 		}
 		path = query.nextURL
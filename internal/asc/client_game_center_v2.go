@@ -39,7 +39,7 @@ func (c *Client) GetGameCenterAchievementsV2(ctx context.Context, gcDetailID, gr
 		path = fmt.Sprintf("/v1/gameCenterGroups/%s/gameCenterAchievementsV2", strings.TrimSpace(groupID))
 	}
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-achievements-v2: %w", err)
 		}
 		path = query.nextURL
@@ -69,7 +69,7 @@ func (c *Client) GetGameCenterAchievementVersions(ctx context.Context, achieveme
 
 	path := fmt.Sprintf("/v2/gameCenterAchievements/%s/versions", strings.TrimSpace(achievementID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-achievement-versions: %w", err)
 		}
 		path = query.nextURL
@@ -149,7 +149,7 @@ func (c *Client) GetGameCenterAchievementVersionLocalizations(ctx context.Contex
 
 	path := fmt.Sprintf("/v2/gameCenterAchievementVersions/%s/localizations", strings.TrimSpace(versionID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-achievement-version-localizations: %w", err)
 		}
 		path = query.nextURL
@@ -179,7 +179,7 @@ func (c *Client) GetGameCenterAchievementVersionLocalizationsRelationships(ctx c
 
 	path := fmt.Sprintf("/v2/gameCenterAchievementVersions/%s/relationships/localizations", strings.TrimSpace(versionID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-achievement-version-localizations-relationships: %w", err)
 		}
 		path = query.nextURL
@@ -471,7 +471,7 @@ func (c *Client) GetGameCenterLeaderboardsV2(ctx context.Context, gcDetailID, gr
 		path = fmt.Sprintf("/v1/gameCenterGroups/%s/gameCenterLeaderboardsV2", strings.TrimSpace(groupID))
 	}
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-leaderboards-v2: %w", err)
 		}
 		path = query.nextURL
@@ -501,7 +501,7 @@ func (c *Client) GetGameCenterLeaderboardVersions(ctx context.Context, leaderboa
 
 	path := fmt.Sprintf("/v2/gameCenterLeaderboards/%s/versions", strings.TrimSpace(leaderboardID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-leaderboard-versions: %w", err)
 		}
 		path = query.nextURL
@@ -581,7 +581,7 @@ func (c *Client) GetGameCenterLeaderboardVersionLocalizations(ctx context.Contex
 
 	path := fmt.Sprintf("/v2/gameCenterLeaderboardVersions/%s/localizations", strings.TrimSpace(versionID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-leaderboard-version-localizations: %w", err)
 		}
 		path = query.nextURL
@@ -611,7 +611,7 @@ func (c *Client) GetGameCenterLeaderboardVersionLocalizationsRelationships(ctx c
 
 	path := fmt.Sprintf("/v2/gameCenterLeaderboardVersions/%s/relationships/localizations", strings.TrimSpace(versionID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-leaderboard-version-localizations-relationships: %w", err)
 		}
 		path = query.nextURL
@@ -903,7 +903,7 @@ func (c *Client) GetGameCenterLeaderboardSetsV2(ctx context.Context, gcDetailID,
 		path = fmt.Sprintf("/v1/gameCenterGroups/%s/gameCenterLeaderboardSetsV2", strings.TrimSpace(groupID))
 	}
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-leaderboard-sets-v2: %w", err)
 		}
 		path = query.nextURL
@@ -1038,7 +1038,7 @@ func (c *Client) GetGameCenterLeaderboardSetMembersV2(ctx context.Context, setID
 
 	path := fmt.Sprintf("/v2/gameCenterLeaderboardSets/%s/gameCenterLeaderboards", strings.TrimSpace(setID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-leaderboard-set-members-v2: %w", err)
 		}
 		path = query.nextURL
@@ -1102,7 +1102,7 @@ func (c *Client) GetGameCenterLeaderboardSetVersions(ctx context.Context, setID
 
 	path := fmt.Sprintf("/v2/gameCenterLeaderboardSets/%s/versions", strings.TrimSpace(setID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-leaderboard-set-versions: %w", err)
 		}
 		path = query.nextURL
@@ -1182,7 +1182,7 @@ func (c *Client) GetGameCenterLeaderboardSetVersionLocalizations(ctx context.Con
 
 	path := fmt.Sprintf("/v2/gameCenterLeaderboardSetVersions/%s/localizations", strings.TrimSpace(versionID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-leaderboard-set-version-localizations: %w", err)
 		}
 		path = query.nextURL
@@ -1212,7 +1212,7 @@ func (c *Client) GetGameCenterLeaderboardSetVersionLocalizationsRelationships(ct
 
 	path := fmt.Sprintf("/v2/gameCenterLeaderboardSetVersions/%s/relationships/localizations", strings.TrimSpace(versionID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("game-center-leaderboard-set-version-localizations-relationships: %w", err)
 		}
 		path = query.nextURL
@@ -15,6 +15,7 @@ import (
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/telemetry"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/urlsanitize"
 )
 
@@ -24,28 +25,33 @@ func (c *Client) newRequest(ctx context.Context, method, path string, body io.Re
 		return nil, err
 	}
 
-	// Generate JWT token
-	token, err := c.generateJWT()
-	if err != nil {
-		return nil, fmt.Errorf("failed to generate JWT: %w", err)
-	}
-
-	url := path
-	if !strings.HasPrefix(path, "http://") && !strings.HasPrefix(path, "https://") {
-		url = BaseURL + path
-	}
-	req, err := http.NewRequestWithContext(ctx, method, url, body)
+	req, err := http.NewRequestWithContext(ctx, method, c.resolveRequestURL(path), body)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
-	req.Header.Set("Authorization", "Bearer "+token)
+	// Replayed requests never leave the process, so they are not signed.
+	if !c.unsignedRequests {
+		token, err := c.generateJWT()
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate JWT: %w", err)
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Accept", "application/json")
 
 	return req, nil
 }
 
+// resolveRequestURL expands a relative API path against the client's base URL.
+func (c *Client) resolveRequestURL(path string) string {
+	if strings.HasPrefix(path, "http://") || strings.HasPrefix(path, "https://") {
+		return path
+	}
+	return c.apiBaseURL() + path
+}
+
 // generateJWT generates a JWT for ASC API authentication
 func (c *Client) generateJWT() (string, error) {
 	now := time.Now()
@@ -111,8 +117,10 @@ func (c *Client) do(ctx context.Context, method, path string, body io.Reader) ([
 
 	if shouldRetryMethod(method) {
 		retryOpts := ResolveRetryOptions()
+		attempt := 0
 		return WithRetry(ctx, func() ([]byte, error) {
-			return request(ctx)
+			attempt++
+			return request(withAttempt(ctx, attempt))
 		}, retryOpts)
 	}
 	if shouldLimitMutatingMethod(method) {
@@ -178,59 +186,122 @@ func deriveMutatingRequestContext(ctx context.Context, requestTimeout time.Durat
 	}
 }
 
-func (c *Client) doOnce(ctx context.Context, method, path string, body io.Reader) ([]byte, error) {
+func (c *Client) doOnce(ctx context.Context, method, path string, body io.Reader) (_ []byte, err error) {
 	start := time.Now()
 	debugSettings := resolveDebugSettings()
 
+	ctx, span := telemetry.Start(ctx, "HTTP "+method, telemetry.SpanKindClient,
+		telemetry.String("http.request.method", method),
+		telemetry.String("url.full", sanitizeURLForLog(c.resolveRequestURL(path))),
+	)
+	defer func() { span.End(err) }()
+
+	cache := c.responseCache
+	cacheKey := ""
+	var cached *responseCacheEntry
+	if cache != nil && strings.EqualFold(method, http.MethodGet) {
+		if err := validateAPIPath(path); err != nil {
+			return nil, err
+		}
+		cacheKey = c.resolveRequestURL(path)
+		entry, fresh := cache.load(cacheKey)
+		if fresh && !responseCacheBypassed(ctx) {
+			if debugSettings.verboseHTTP {
+				debugLogger.Load().Info("✓ Cache hit", "event", "cache_hit", "url", sanitizeURLForLog(cacheKey))
+			}
+			cache.recordHit(entry)
+			span.SetAttributes(telemetry.String("asc.cache", "hit"))
+			return entry.Body, nil
+		}
+		if entry.hasValidators() {
+			cached = entry
+		}
+	}
+
+	limiter := c.getRateLimiter()
+	if err := limiter.wait(ctx); err != nil {
+		return nil, err
+	}
+
 	req, err := c.newRequest(ctx, method, path, body)
 	if err != nil {
 		return nil, err
 	}
+	if cached != nil {
+		cached.applyConditionalHeaders(req)
+	}
 
 	if debugSettings.verboseHTTP {
-		debugLogger.Info(
+		debugLogger.Load().Info(
 			"→ HTTP Request",
+			"event", "http_request",
 			"method", method,
 			"url", sanitizeURLForLog(req.URL.String()),
 			"content-type", req.Header.Get("Content-Type"),
 			"authorization", sanitizeAuthHeader(req.Header.Get("Authorization")),
+			"attempt", attemptFromContext(ctx),
 		)
 	}
 
+	telemetry.Add(telemetry.CounterHTTPRequests, 1, telemetry.String("http.request.method", method))
 	resp, err := c.httpClient.Do(req)
 	elapsed := time.Since(start)
 
 	if err != nil {
 		if debugSettings.verboseHTTP {
-			debugLogger.Info(
+			debugLogger.Load().Info(
 				"← HTTP Error",
+				"event", "http_error",
+				"method", method,
+				"url", sanitizeURLForLog(req.URL.String()),
+				"attempt", attemptFromContext(ctx),
 				"error", err.Error(),
 				"elapsed", elapsed.String(),
+				"duration_ms", elapsed.Milliseconds(),
 			)
 		}
 		return nil, fmt.Errorf("request failed: %w", err)
 	}
 	defer resp.Body.Close()
+	limiter.observe(resp.Header, resp.StatusCode)
+	span.SetAttributes(telemetry.Int("http.response.status_code", int64(resp.StatusCode)))
 
 	if debugSettings.verboseHTTP {
-		debugLogger.Info(
+		debugLogger.Load().Info(
 			"← HTTP Response",
+			"event", "http_response",
+			"method", method,
+			"url", sanitizeURLForLog(req.URL.String()),
 			"status", resp.StatusCode,
+			"request_id", responseRequestID(resp.Header),
+			"attempt", attemptFromContext(ctx),
 			"elapsed", elapsed.String(),
+			"duration_ms", elapsed.Milliseconds(),
 			"content-type", resp.Header.Get("Content-Type"),
 			"content-length", resp.Header.Get("Content-Length"),
 		)
 	}
 
+	if resp.StatusCode == http.StatusNotModified && cached != nil {
+		span.SetAttributes(telemetry.String("asc.cache", "revalidated"))
+		cache.revalidated(cached, resp.Header)
+		return cached.Body, nil
+	}
+
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
 		respBody, _ := io.ReadAll(resp.Body)
 
 		// Check for rate limiting (429) or service unavailable (503)
 		if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable {
+			if resp.StatusCode == http.StatusTooManyRequests {
+				telemetry.Add(telemetry.CounterHTTPRateLimited, 1)
+			}
 			retryAfter := parseRetryAfterHeader(resp.Header.Get("Retry-After"))
 			return nil, &RetryableError{
 				Err:        buildRetryableError(resp.StatusCode, retryAfter, respBody),
 				RetryAfter: retryAfter,
+				StatusCode: resp.StatusCode,
+				RequestID:  responseRequestID(resp.Header),
 			}
 		}
 
@@ -240,7 +311,18 @@ func (c *Client) doOnce(ctx context.Context, method, path string, body io.Reader
 		return nil, fmt.Errorf("API request failed with status %d", resp.StatusCode)
 	}
 
-	return io.ReadAll(resp.Body)
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if cache != nil {
+		if cacheKey != "" {
+			cache.store(cacheKey, resp.Header, respBody)
+		} else if shouldLimitMutatingMethod(method) {
+			cache.invalidate()
+		}
+	}
+	return respBody, nil
 }
 
 // sanitizeAuthHeader redacts the JWT token from Authorization header for logging.
@@ -328,8 +410,8 @@ func parseRetryAfterHeader(value string) time.Duration {
 }
 
 // validateNextURL validates that a pagination URL is safe to use.
-// It ensures the URL is on the same host as BaseURL and uses HTTPS.
-func validateNextURL(nextURL string) error {
+// It ensures the URL is on the same host and scheme as the client's base URL.
+func (c *Client) validateNextURL(nextURL string) error {
 	if nextURL == "" {
 		return nil
 	}
@@ -345,19 +427,20 @@ func validateNextURL(nextURL string) error {
 		return fmt.Errorf("invalid pagination URL: %w", err)
 	}
 
-	baseURL, err := url.Parse(BaseURL)
+	baseURL, err := url.Parse(c.apiBaseURL())
 	if err != nil {
 		return fmt.Errorf("invalid base URL: %w", err)
 	}
 
-	// Allow URLs on the same host as BaseURL
+	// Allow URLs on the same host as the base URL
 	if parsedURL.Host != baseURL.Host {
 		return fmt.Errorf("rejected pagination URL from untrusted host %q (expected %q)", parsedURL.Host, baseURL.Host)
 	}
 
-	// Require HTTPS for authentication endpoints
-	if parsedURL.Scheme != "https" {
-		return fmt.Errorf("rejected pagination URL with insecure scheme %q (expected https)", parsedURL.Scheme)
+	// Require HTTPS for authentication endpoints; a loopback base URL (such as
+	// asc mock serve) may use plain HTTP.
+	if parsedURL.Scheme != "https" && parsedURL.Scheme != baseURL.Scheme {
+		return fmt.Errorf("rejected pagination URL with insecure scheme %q (expected %s)", parsedURL.Scheme, baseURL.Scheme)
 	}
 
 	return nil
@@ -454,6 +537,11 @@ func validateAnalyticsDownloadURL(downloadURL string) error {
 }
 
 func (c *Client) doStream(ctx context.Context, path string, accept string) (*http.Response, error) {
+	limiter := c.getRateLimiter()
+	if err := limiter.wait(ctx); err != nil {
+		return nil, err
+	}
+
 	req, err := c.newRequest(ctx, "GET", path, nil)
 	if err != nil {
 		return nil, err
@@ -466,6 +554,7 @@ func (c *Client) doStream(ctx context.Context, path string, accept string) (*htt
 	if err != nil {
 		return nil, fmt.Errorf("request failed: %w", err)
 	}
+	limiter.observe(resp.Header, resp.StatusCode)
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
 		respBody, _ := io.ReadAll(resp.Body)
 		_ = resp.Body.Close()
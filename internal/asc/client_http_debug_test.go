@@ -84,8 +84,8 @@ func TestSanitizeURLForLog_RedactsUserInfo(t *testing.T) {
 
 func TestDebugLoggingRedactsSignedQuery(t *testing.T) {
 	var buf bytes.Buffer
-	originalLogger := debugLogger
-	debugLogger = slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{
+	originalLogger := debugLogger.Load()
+	debugLogger.Store(slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{
 		Level: slog.LevelInfo,
 		ReplaceAttr: func(_ []string, attr slog.Attr) slog.Attr {
 			if attr.Key == slog.TimeKey {
@@ -93,8 +93,8 @@ func TestDebugLoggingRedactsSignedQuery(t *testing.T) {
 			}
 			return attr
 		},
-	}))
-	t.Cleanup(func() { debugLogger = originalLogger })
+	})))
+	t.Cleanup(func() { debugLogger.Store(originalLogger) })
 
 	debugEnabled := true
 	SetDebugOverride(&debugEnabled)
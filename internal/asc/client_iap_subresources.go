@@ -142,7 +142,7 @@ func (c *Client) GetInAppPurchaseImages(ctx context.Context, iapID string, opts
 
 	path := fmt.Sprintf("/v2/inAppPurchases/%s/images", iapID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("in-app-purchase-images: %w", err)
 		}
 		path = query.nextURL
@@ -472,7 +472,7 @@ func (c *Client) GetInAppPurchaseAvailabilityAvailableTerritories(ctx context.Co
 
 	path := fmt.Sprintf("/v1/inAppPurchaseAvailabilities/%s/availableTerritories", availabilityID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("in-app-purchase-availability-territories: %w", err)
 		}
 		path = query.nextURL
@@ -605,7 +605,7 @@ func (c *Client) GetInAppPurchasePricePoints(ctx context.Context, iapID string,
 
 	path := fmt.Sprintf("/v2/inAppPurchases/%s/pricePoints", iapID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("in-app-purchase-price-points: %w", err)
 		}
 		path = query.nextURL
@@ -640,7 +640,7 @@ func (c *Client) GetInAppPurchasePricePointEqualizations(ctx context.Context, pr
 
 	path := fmt.Sprintf("/v1/inAppPurchasePricePoints/%s/equalizations", pricePointID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("in-app-purchase-price-point-equalizations: %w", err)
 		}
 		path = query.nextURL
@@ -775,7 +775,7 @@ func (c *Client) GetInAppPurchasePriceScheduleManualPrices(ctx context.Context,
 
 	path := fmt.Sprintf("/v1/inAppPurchasePriceSchedules/%s/manualPrices", scheduleID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("in-app-purchase-manual-prices: %w", err)
 		}
 		path = query.nextURL
@@ -810,7 +810,7 @@ func (c *Client) GetInAppPurchasePriceScheduleAutomaticPrices(ctx context.Contex
 
 	path := fmt.Sprintf("/v1/inAppPurchasePriceSchedules/%s/automaticPrices", scheduleID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("in-app-purchase-automatic-prices: %w", err)
 		}
 		path = query.nextURL
@@ -935,7 +935,7 @@ func (c *Client) GetInAppPurchaseOfferCodes(ctx context.Context, iapID string, o
 
 	path := fmt.Sprintf("/v2/inAppPurchases/%s/offerCodes", iapID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("in-app-purchase-offer-codes: %w", err)
 		}
 		path = query.nextURL
@@ -970,7 +970,7 @@ func (c *Client) GetInAppPurchaseOfferCodeCustomCodes(ctx context.Context, offer
 
 	path := fmt.Sprintf("/v1/inAppPurchaseOfferCodes/%s/customCodes", offerCodeID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("in-app-purchase-offer-code-custom-codes: %w", err)
 		}
 		path = query.nextURL
@@ -1096,7 +1096,7 @@ func (c *Client) GetInAppPurchaseOfferCodeOneTimeUseCodes(ctx context.Context, o
 
 	path := fmt.Sprintf("/v1/inAppPurchaseOfferCodes/%s/oneTimeUseCodes", offerCodeID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("in-app-purchase-offer-code-one-time-use-codes: %w", err)
 		}
 		path = query.nextURL
@@ -1224,7 +1224,7 @@ func (c *Client) GetInAppPurchaseOfferCodePrices(ctx context.Context, offerCodeI
 
 	path := fmt.Sprintf("/v1/inAppPurchaseOfferCodes/%s/prices", offerCodeID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("in-app-purchase-offer-code-prices: %w", err)
 		}
 		path = query.nextURL
@@ -1483,7 +1483,7 @@ func (c *Client) GetInAppPurchaseAvailabilityAvailableTerritoriesRelationships(c
 
 	path := fmt.Sprintf("/v1/inAppPurchaseAvailabilities/%s/relationships/availableTerritories", availabilityID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("inAppPurchaseAvailabilityAvailableTerritoriesRelationships: %w", err)
 		}
 		path = query.nextURL
@@ -1518,7 +1518,7 @@ func (c *Client) GetInAppPurchaseOfferCodeCustomCodesRelationships(ctx context.C
 
 	path := fmt.Sprintf("/v1/inAppPurchaseOfferCodes/%s/relationships/customCodes", offerCodeID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("inAppPurchaseOfferCodeCustomCodesRelationships: %w", err)
 		}
 		path = query.nextURL
@@ -1553,7 +1553,7 @@ func (c *Client) GetInAppPurchaseOfferCodeOneTimeUseCodesRelationships(ctx conte
 
 	path := fmt.Sprintf("/v1/inAppPurchaseOfferCodes/%s/relationships/oneTimeUseCodes", offerCodeID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("inAppPurchaseOfferCodeOneTimeUseCodesRelationships: %w", err)
 		}
 		path = query.nextURL
@@ -1588,7 +1588,7 @@ func (c *Client) GetInAppPurchaseOfferCodePricesRelationships(ctx context.Contex
 
 	path := fmt.Sprintf("/v1/inAppPurchaseOfferCodes/%s/relationships/prices", offerCodeID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("inAppPurchaseOfferCodePricesRelationships: %w", err)
 		}
 		path = query.nextURL
@@ -1623,7 +1623,7 @@ func (c *Client) GetInAppPurchasePricePointEqualizationsRelationships(ctx contex
 
 	path := fmt.Sprintf("/v1/inAppPurchasePricePoints/%s/relationships/equalizations", pricePointID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("inAppPurchasePricePointEqualizationsRelationships: %w", err)
 		}
 		path = query.nextURL
@@ -1658,7 +1658,7 @@ func (c *Client) GetInAppPurchasePriceScheduleAutomaticPricesRelationships(ctx c
 
 	path := fmt.Sprintf("/v1/inAppPurchasePriceSchedules/%s/relationships/automaticPrices", scheduleID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("inAppPurchasePriceScheduleAutomaticPricesRelationships: %w", err)
 		}
 		path = query.nextURL
@@ -1714,7 +1714,7 @@ func (c *Client) GetInAppPurchasePriceScheduleManualPricesRelationships(ctx cont
 
 	path := fmt.Sprintf("/v1/inAppPurchasePriceSchedules/%s/relationships/manualPrices", scheduleID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("inAppPurchasePriceScheduleManualPricesRelationships: %w", err)
 		}
 		path = query.nextURL
@@ -1812,7 +1812,7 @@ func (c *Client) GetInAppPurchaseImagesRelationships(ctx context.Context, iapID
 
 	path := fmt.Sprintf("/v2/inAppPurchases/%s/relationships/images", iapID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("inAppPurchaseImagesRelationships: %w", err)
 		}
 		path = query.nextURL
@@ -1868,7 +1868,7 @@ func (c *Client) GetInAppPurchaseInAppPurchaseLocalizationsRelationships(ctx con
 
 	path := fmt.Sprintf("/v2/inAppPurchases/%s/relationships/inAppPurchaseLocalizations", iapID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("inAppPurchaseLocalizationsRelationships: %w", err)
 		}
 		path = query.nextURL
@@ -1903,7 +1903,7 @@ func (c *Client) GetInAppPurchaseOfferCodesRelationships(ctx context.Context, ia
 
 	path := fmt.Sprintf("/v2/inAppPurchases/%s/relationships/offerCodes", iapID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("inAppPurchaseOfferCodesRelationships: %w", err)
 		}
 		path = query.nextURL
@@ -1938,7 +1938,7 @@ func (c *Client) GetInAppPurchasePricePointsRelationships(ctx context.Context, i
 
 	path := fmt.Sprintf("/v2/inAppPurchases/%s/relationships/pricePoints", iapID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("inAppPurchasePricePointsRelationships: %w", err)
 		}
 		path = query.nextURL
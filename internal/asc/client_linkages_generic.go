@@ -30,7 +30,7 @@ func getTypedResourceLinkages[T any](
 
 	path := fmt.Sprintf(pathFmt, resourceID, relationship)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("%s: %w", nextURLErrorContext, err)
 		}
 		path = query.nextURL
@@ -17,7 +17,7 @@ func (c *Client) GetMerchantIDs(ctx context.Context, opts ...MerchantIDsOption)
 	path := "/v1/merchantIds"
 	if query.nextURL != "" {
 		// Validate nextURL to prevent credential exfiltration
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("merchantIds: %w", err)
 		}
 		path = query.nextURL
@@ -138,7 +138,7 @@ func (c *Client) GetMerchantIDCertificates(ctx context.Context, merchantID strin
 	path := fmt.Sprintf("/v1/merchantIds/%s/certificates", merchantID)
 	if query.nextURL != "" {
 		// Validate nextURL to prevent credential exfiltration
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("merchantIdCertificates: %w", err)
 		}
 		path = query.nextURL
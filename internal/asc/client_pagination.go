@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/telemetry"
 )
 
 // GetLinks returns the links field for pagination.
@@ -64,7 +66,9 @@ func PaginateAll(ctx context.Context, firstPage PaginatedResponse, fetchNext Pag
 		page++
 
 		// Fetch next page
-		nextPage, err := fetchNext(ctx, links.Next)
+		pageCtx, pageSpan := telemetry.Start(ctx, "pagination page", telemetry.SpanKindInternal, telemetry.Int("asc.page", int64(page)))
+		nextPage, err := fetchNext(pageCtx, links.Next)
+		pageSpan.End(err)
 		if err != nil {
 			return result, fmt.Errorf("page %d: %w", page, err)
 		}
@@ -113,7 +117,9 @@ func PaginateEach(ctx context.Context, firstPage PaginatedResponse, fetchNext Pa
 		}
 		seenNext[links.Next] = struct{}{}
 
-		nextPage, err := fetchNext(ctx, links.Next)
+		pageCtx, pageSpan := telemetry.Start(ctx, "pagination page", telemetry.SpanKindInternal, telemetry.Int("asc.page", int64(page+1)))
+		nextPage, err := fetchNext(pageCtx, links.Next)
+		pageSpan.End(err)
 		if err != nil {
 			return fmt.Errorf("page %d: %w", page+1, err)
 		}
@@ -17,7 +17,7 @@ func (c *Client) GetPassTypeIDs(ctx context.Context, opts ...PassTypeIDsOption)
 	path := "/v1/passTypeIds"
 	if query.nextURL != "" {
 		// Validate nextURL to prevent credential exfiltration
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("passTypeIds: %w", err)
 		}
 		path = query.nextURL
@@ -138,7 +138,7 @@ func (c *Client) GetPassTypeIDCertificates(ctx context.Context, passTypeID strin
 	path := fmt.Sprintf("/v1/passTypeIds/%s/certificates", passTypeID)
 	if query.nextURL != "" {
 		// Validate nextURL to prevent credential exfiltration
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("passTypeIdCertificates: %w", err)
 		}
 		path = query.nextURL
@@ -128,7 +128,7 @@ func (c *Client) GetDiagnosticSignaturesForBuild(ctx context.Context, buildID st
 
 	path := fmt.Sprintf("/v1/builds/%s/diagnosticSignatures", buildID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("diagnosticSignatures: %w", err)
 		}
 		path = query.nextURL
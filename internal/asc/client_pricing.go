@@ -20,7 +20,7 @@ func (c *Client) GetTerritories(ctx context.Context, opts ...TerritoriesOption)
 	path := "/v1/territories"
 	if query.nextURL != "" {
 		// Validate nextURL to prevent credential exfiltration
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("territories: %w", err)
 		}
 		path = query.nextURL
@@ -52,7 +52,7 @@ func (c *Client) GetAppPricePoints(ctx context.Context, appID string, opts ...Pr
 	path := fmt.Sprintf("/v1/apps/%s/appPricePoints", appID)
 	if query.nextURL != "" {
 		// Validate nextURL to prevent credential exfiltration
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appPricePoints: %w", err)
 		}
 		path = query.nextURL
@@ -109,7 +109,7 @@ func (c *Client) GetAppPricePointEqualizations(ctx context.Context, pricePointID
 	}
 	path := fmt.Sprintf("/v3/appPricePoints/%s/equalizations", pricePointID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appPricePointEqualizations: %w", err)
 		}
 		path = query.nextURL
@@ -144,7 +144,7 @@ func (c *Client) GetAppPricePointEqualizationsRelationships(ctx context.Context,
 
 	path := fmt.Sprintf("/v3/appPricePoints/%s/relationships/equalizations", pricePointID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appPricePointEqualizationsRelationships: %w", err)
 		}
 		path = query.nextURL
@@ -343,7 +343,7 @@ func (c *Client) GetAppPriceScheduleManualPrices(ctx context.Context, scheduleID
 
 	path := fmt.Sprintf("/v1/appPriceSchedules/%s/manualPrices", scheduleID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appPriceScheduleManualPrices: %w", err)
 		}
 		path = query.nextURL
@@ -378,7 +378,7 @@ func (c *Client) GetAppPriceScheduleManualPricesRelationships(ctx context.Contex
 
 	path := fmt.Sprintf("/v1/appPriceSchedules/%s/relationships/manualPrices", scheduleID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appPriceScheduleManualPricesRelationships: %w", err)
 		}
 		path = query.nextURL
@@ -413,7 +413,7 @@ func (c *Client) GetAppPriceScheduleAutomaticPrices(ctx context.Context, schedul
 
 	path := fmt.Sprintf("/v1/appPriceSchedules/%s/automaticPrices", scheduleID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appPriceScheduleAutomaticPrices: %w", err)
 		}
 		path = query.nextURL
@@ -448,7 +448,7 @@ func (c *Client) GetAppPriceScheduleAutomaticPricesRelationships(ctx context.Con
 
 	path := fmt.Sprintf("/v1/appPriceSchedules/%s/relationships/automaticPrices", scheduleID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appPriceScheduleAutomaticPricesRelationships: %w", err)
 		}
 		path = query.nextURL
@@ -519,7 +519,7 @@ func (c *Client) GetTerritoryAvailabilities(ctx context.Context, availabilityID
 	path := fmt.Sprintf("/v2/appAvailabilities/%s/territoryAvailabilities", availabilityID)
 	if query.nextURL != "" {
 		// Validate nextURL to prevent credential exfiltration
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("territoryAvailabilities: %w", err)
 		}
 		path = query.nextURL
@@ -554,7 +554,7 @@ func (c *Client) GetAppAvailabilityV2TerritoryAvailabilitiesRelationships(ctx co
 	path := fmt.Sprintf("/v2/appAvailabilities/%s/relationships/territoryAvailabilities", strings.TrimSpace(availabilityID))
 	if query.nextURL != "" {
 		// Validate nextURL to prevent credential exfiltration
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appAvailabilityTerritoryAvailabilitiesRelationships: %w", err)
 		}
 		path = query.nextURL
@@ -195,7 +195,7 @@ func (c *Client) GetAppCustomProductPages(ctx context.Context, appID string, opt
 	}
 	path := fmt.Sprintf("/v1/apps/%s/appCustomProductPages", appID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appCustomProductPages: %w", err)
 		}
 		path = query.nextURL
@@ -337,7 +337,7 @@ func (c *Client) GetAppCustomProductPageVersions(ctx context.Context, pageID str
 	}
 	path := fmt.Sprintf("/v1/appCustomProductPages/%s/appCustomProductPageVersions", pageID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appCustomProductPageVersions: %w", err)
 		}
 		path = query.nextURL
@@ -372,7 +372,7 @@ func (c *Client) GetAppCustomProductPageVersionsRelationships(ctx context.Contex
 
 	path := fmt.Sprintf("/v1/appCustomProductPages/%s/relationships/appCustomProductPageVersions", pageID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appCustomProductPageVersionsRelationships: %w", err)
 		}
 		path = query.nextURL
@@ -501,7 +501,7 @@ func (c *Client) GetAppCustomProductPageLocalizations(ctx context.Context, versi
 	}
 	path := fmt.Sprintf("/v1/appCustomProductPageVersions/%s/appCustomProductPageLocalizations", versionID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appCustomProductPageLocalizations: %w", err)
 		}
 		path = query.nextURL
@@ -536,7 +536,7 @@ func (c *Client) GetAppCustomProductPageLocalizationsRelationships(ctx context.C
 
 	path := fmt.Sprintf("/v1/appCustomProductPageVersions/%s/relationships/appCustomProductPageLocalizations", versionID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appCustomProductPageLocalizationsRelationships: %w", err)
 		}
 		path = query.nextURL
@@ -700,7 +700,7 @@ func (c *Client) GetAppCustomProductPageLocalizationSearchKeywordsRelationships(
 
 	path := fmt.Sprintf("/v1/appCustomProductPageLocalizations/%s/relationships/searchKeywords", localizationID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("searchKeywordsRelationships: %w", err)
 		}
 		path = query.nextURL
@@ -796,7 +796,7 @@ func (c *Client) GetAppCustomProductPageLocalizationPreviewSets(ctx context.Cont
 	}
 	path := fmt.Sprintf("/v1/appCustomProductPageLocalizations/%s/appPreviewSets", localizationID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appPreviewSets: %w", err)
 		}
 		path = query.nextURL
@@ -830,7 +830,7 @@ func (c *Client) GetAppCustomProductPageLocalizationPreviewSetsRelationships(ctx
 
 	path := fmt.Sprintf("/v1/appCustomProductPageLocalizations/%s/relationships/appPreviewSets", localizationID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appPreviewSetsRelationships: %w", err)
 		}
 		path = query.nextURL
@@ -864,7 +864,7 @@ func (c *Client) GetAppCustomProductPageLocalizationScreenshotSets(ctx context.C
 	}
 	path := fmt.Sprintf("/v1/appCustomProductPageLocalizations/%s/appScreenshotSets", localizationID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appScreenshotSets: %w", err)
 		}
 		path = query.nextURL
@@ -898,7 +898,7 @@ func (c *Client) GetAppCustomProductPageLocalizationScreenshotSetsRelationships(
 
 	path := fmt.Sprintf("/v1/appCustomProductPageLocalizations/%s/relationships/appScreenshotSets", localizationID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appScreenshotSetsRelationships: %w", err)
 		}
 		path = query.nextURL
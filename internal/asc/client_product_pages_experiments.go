@@ -243,7 +243,7 @@ func (c *Client) GetAppStoreVersionExperiments(ctx context.Context, versionID st
 	}
 	path := fmt.Sprintf("/v1/appStoreVersions/%s/appStoreVersionExperiments", versionID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appStoreVersionExperiments: %w", err)
 		}
 		path = query.nextURL
@@ -277,7 +277,7 @@ func (c *Client) GetAppStoreVersionExperimentsV2(ctx context.Context, appID stri
 	}
 	path := fmt.Sprintf("/v1/apps/%s/appStoreVersionExperimentsV2", appID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appStoreVersionExperimentsV2: %w", err)
 		}
 		path = query.nextURL
@@ -527,7 +527,7 @@ func (c *Client) GetAppStoreVersionExperimentTreatments(ctx context.Context, exp
 	}
 	path := fmt.Sprintf("/v1/appStoreVersionExperiments/%s/appStoreVersionExperimentTreatments", experimentID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appStoreVersionExperimentTreatments: %w", err)
 		}
 		path = query.nextURL
@@ -561,7 +561,7 @@ func (c *Client) GetAppStoreVersionExperimentTreatmentsV2(ctx context.Context, e
 	}
 	path := fmt.Sprintf("/v2/appStoreVersionExperiments/%s/appStoreVersionExperimentTreatments", experimentID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appStoreVersionExperimentTreatments: %w", err)
 		}
 		path = query.nextURL
@@ -596,7 +596,7 @@ func (c *Client) GetAppStoreVersionExperimentTreatmentsRelationships(ctx context
 
 	path := fmt.Sprintf("/v1/appStoreVersionExperiments/%s/relationships/appStoreVersionExperimentTreatments", experimentID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appStoreVersionExperimentTreatmentsRelationships: %w", err)
 		}
 		path = query.nextURL
@@ -631,7 +631,7 @@ func (c *Client) GetAppStoreVersionExperimentTreatmentsV2Relationships(ctx conte
 
 	path := fmt.Sprintf("/v2/appStoreVersionExperiments/%s/relationships/appStoreVersionExperimentTreatments", experimentID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appStoreVersionExperimentTreatmentsV2Relationships: %w", err)
 		}
 		path = query.nextURL
@@ -774,7 +774,7 @@ func (c *Client) GetAppStoreVersionExperimentTreatmentLocalizations(ctx context.
 	}
 	path := fmt.Sprintf("/v1/appStoreVersionExperimentTreatments/%s/appStoreVersionExperimentTreatmentLocalizations", treatmentID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appStoreVersionExperimentTreatmentLocalizations: %w", err)
 		}
 		path = query.nextURL
@@ -809,7 +809,7 @@ func (c *Client) GetAppStoreVersionExperimentTreatmentLocalizationsRelationships
 
 	path := fmt.Sprintf("/v1/appStoreVersionExperimentTreatments/%s/relationships/appStoreVersionExperimentTreatmentLocalizations", treatmentID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appStoreVersionExperimentTreatmentLocalizationsRelationships: %w", err)
 		}
 		path = query.nextURL
@@ -916,7 +916,7 @@ func (c *Client) GetAppStoreVersionExperimentTreatmentLocalizationPreviewSets(ct
 	}
 	path := fmt.Sprintf("/v1/appStoreVersionExperimentTreatmentLocalizations/%s/appPreviewSets", localizationID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appPreviewSets: %w", err)
 		}
 		path = query.nextURL
@@ -951,7 +951,7 @@ func (c *Client) GetAppStoreVersionExperimentTreatmentLocalizationPreviewSetsRel
 
 	path := fmt.Sprintf("/v1/appStoreVersionExperimentTreatmentLocalizations/%s/relationships/appPreviewSets", localizationID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appPreviewSetsRelationships: %w", err)
 		}
 		path = query.nextURL
@@ -985,7 +985,7 @@ func (c *Client) GetAppStoreVersionExperimentTreatmentLocalizationScreenshotSets
 	}
 	path := fmt.Sprintf("/v1/appStoreVersionExperimentTreatmentLocalizations/%s/appScreenshotSets", localizationID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appScreenshotSets: %w", err)
 		}
 		path = query.nextURL
@@ -1020,7 +1020,7 @@ func (c *Client) GetAppStoreVersionExperimentTreatmentLocalizationScreenshotSets
 
 	path := fmt.Sprintf("/v1/appStoreVersionExperimentTreatmentLocalizations/%s/relationships/appScreenshotSets", localizationID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appScreenshotSetsRelationships: %w", err)
 		}
 		path = query.nextURL
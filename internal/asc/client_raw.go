@@ -0,0 +1,92 @@
+package asc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// RawResponse is an untyped JSON:API document. Data holds either a single
+// resource object or an array of resources, exactly as returned by the API.
+type RawResponse struct {
+	Data     json.RawMessage `json:"data,omitempty"`
+	Included json.RawMessage `json:"included,omitempty"`
+	Links    *Links          `json:"links,omitempty"`
+	Meta     json.RawMessage `json:"meta,omitempty"`
+}
+
+// RawListResponse is an untyped JSON:API collection document that supports
+// pagination via PaginateAll and PaginateEach.
+type RawListResponse struct {
+	Data     []json.RawMessage `json:"data"`
+	Included json.RawMessage   `json:"included,omitempty"`
+	Links    Links             `json:"links"`
+	Meta     json.RawMessage   `json:"meta,omitempty"`
+}
+
+// GetLinks returns the links field for pagination.
+func (r *RawListResponse) GetLinks() *Links {
+	return &r.Links
+}
+
+// GetData returns the data field for aggregation.
+func (r *RawListResponse) GetData() any {
+	return r.Data
+}
+
+// RawRequest sends an arbitrary request to the App Store Connect API and
+// returns the response body. path is either an API path such as
+// "/v1/apps/123" (optionally with a query string) or an absolute URL on the
+// API host, such as a links.next cursor.
+func (c *Client) RawRequest(ctx context.Context, method, path string, body io.Reader) ([]byte, error) {
+	method = strings.ToUpper(strings.TrimSpace(method))
+	if method == "" {
+		return nil, fmt.Errorf("method is required")
+	}
+	if err := c.validateRawRequestPath(path); err != nil {
+		return nil, err
+	}
+	return c.do(ctx, method, path, body)
+}
+
+// GetRawList fetches a collection endpoint as an untyped document.
+func (c *Client) GetRawList(ctx context.Context, path string) (*RawListResponse, error) {
+	data, err := c.RawRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response RawListResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+	return &response, nil
+}
+
+// ParseRawResponse decodes a response body into a RawResponse. An empty body
+// (for example from a 204 No Content DELETE) yields an empty document.
+func ParseRawResponse(body []byte) (*RawResponse, error) {
+	var response RawResponse
+	if len(strings.TrimSpace(string(body))) == 0 {
+		return &response, nil
+	}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+	return &response, nil
+}
+
+func (c *Client) validateRawRequestPath(path string) error {
+	switch {
+	case strings.HasPrefix(path, "http://"), strings.HasPrefix(path, "https://"):
+		// Absolute URLs carry the JWT, so they must point at the API host.
+		return c.validateNextURL(path)
+	case strings.HasPrefix(path, "/"):
+		return validateAPIPath(path)
+	default:
+		return fmt.Errorf("API path must start with /: %q", path)
+	}
+}
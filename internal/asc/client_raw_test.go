@@ -0,0 +1,53 @@
+package asc
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestRawRequest_RejectsUnsafeTargets(t *testing.T) {
+	client := newTestClient(t, func(req *http.Request) {
+		t.Fatalf("unexpected request to %s", req.URL)
+	}, jsonResponse(http.StatusOK, `{}`))
+
+	for _, path := range []string{
+		"v1/apps",
+		"/v1/apps/../builds",
+		"https://example.com/v1/apps",
+		"http://api.appstoreconnect.apple.com/v1/apps",
+	} {
+		if _, err := client.RawRequest(context.Background(), http.MethodGet, path, nil); err == nil {
+			t.Fatalf("expected RawRequest(%q) to fail", path)
+		}
+	}
+}
+
+func TestGetRawList_ParsesCollection(t *testing.T) {
+	client := newTestClient(t, func(req *http.Request) {
+		if req.URL.Path != "/v1/apps" || req.URL.Query().Get("limit") != "2" {
+			t.Fatalf("unexpected request %s", req.URL)
+		}
+	}, jsonResponse(http.StatusOK, `{"data":[{"type":"apps","id":"1"},{"type":"apps","id":"2"}],"links":{"next":"https://api.appstoreconnect.apple.com/v1/apps?cursor=x"}}`))
+
+	resp, err := client.GetRawList(context.Background(), "/v1/apps?limit=2")
+	if err != nil {
+		t.Fatalf("GetRawList() error: %v", err)
+	}
+	if len(resp.Data) != 2 {
+		t.Fatalf("expected 2 resources, got %d", len(resp.Data))
+	}
+	if resp.GetLinks().Next == "" {
+		t.Fatal("expected next link")
+	}
+}
+
+func TestParseRawResponse_EmptyBody(t *testing.T) {
+	resp, err := ParseRawResponse(nil)
+	if err != nil {
+		t.Fatalf("ParseRawResponse() error: %v", err)
+	}
+	if resp.Data != nil || resp.Links != nil {
+		t.Fatalf("expected empty document, got %+v", resp)
+	}
+}
@@ -17,7 +17,7 @@ func (c *Client) GetSubscriptionLocalizations(ctx context.Context, subscriptionI
 
 	path := fmt.Sprintf("/v1/subscriptions/%s/subscriptionLocalizations", strings.TrimSpace(subscriptionID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("subscriptionLocalizations: %w", err)
 		}
 		path = query.nextURL
@@ -136,7 +136,7 @@ func (c *Client) GetSubscriptionImages(ctx context.Context, subscriptionID strin
 
 	path := fmt.Sprintf("/v1/subscriptions/%s/images", strings.TrimSpace(subscriptionID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("subscriptionImages: %w", err)
 		}
 		path = query.nextURL
@@ -261,7 +261,7 @@ func (c *Client) GetSubscriptionIntroductoryOffers(ctx context.Context, subscrip
 
 	path := fmt.Sprintf("/v1/subscriptions/%s/introductoryOffers", strings.TrimSpace(subscriptionID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("subscriptionIntroductoryOffers: %w", err)
 		}
 		path = query.nextURL
@@ -397,7 +397,7 @@ func (c *Client) GetSubscriptionPromotionalOffers(ctx context.Context, subscript
 
 	path := fmt.Sprintf("/v1/subscriptions/%s/promotionalOffers", strings.TrimSpace(subscriptionID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("subscriptionPromotionalOffers: %w", err)
 		}
 		path = query.nextURL
@@ -543,7 +543,7 @@ func (c *Client) GetSubscriptionPromotionalOfferPrices(ctx context.Context, offe
 
 	path := fmt.Sprintf("/v1/subscriptionPromotionalOffers/%s/prices", strings.TrimSpace(offerID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("subscriptionPromotionalOfferPrices: %w", err)
 		}
 		path = query.nextURL
@@ -577,7 +577,7 @@ func (c *Client) GetSubscriptionPromotionalOfferPricesRelationships(ctx context.
 
 	path := fmt.Sprintf("/v1/subscriptionPromotionalOffers/%s/relationships/prices", offerID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("subscriptionPromotionalOfferPricesRelationships: %w", err)
 		}
 		path = query.nextURL
@@ -607,7 +607,7 @@ func (c *Client) GetSubscriptionOfferCodes(ctx context.Context, subscriptionID s
 
 	path := fmt.Sprintf("/v1/subscriptions/%s/offerCodes", strings.TrimSpace(subscriptionID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("subscriptionOfferCodes: %w", err)
 		}
 		path = query.nextURL
@@ -769,7 +769,7 @@ func (c *Client) GetSubscriptionOfferCodeCustomCodes(ctx context.Context, offerC
 
 	path := fmt.Sprintf("/v1/subscriptionOfferCodes/%s/customCodes", strings.TrimSpace(offerCodeID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("subscriptionOfferCodeCustomCodes: %w", err)
 		}
 		path = query.nextURL
@@ -803,7 +803,7 @@ func (c *Client) GetSubscriptionOfferCodeCustomCodesRelationships(ctx context.Co
 
 	path := fmt.Sprintf("/v1/subscriptionOfferCodes/%s/relationships/customCodes", offerCodeID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("subscriptionOfferCodeCustomCodesRelationships: %w", err)
 		}
 		path = query.nextURL
@@ -833,7 +833,7 @@ func (c *Client) GetSubscriptionOfferCodePrices(ctx context.Context, offerCodeID
 
 	path := fmt.Sprintf("/v1/subscriptionOfferCodes/%s/prices", strings.TrimSpace(offerCodeID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("subscriptionOfferCodePrices: %w", err)
 		}
 		path = query.nextURL
@@ -867,7 +867,7 @@ func (c *Client) GetSubscriptionOfferCodePricesRelationships(ctx context.Context
 
 	path := fmt.Sprintf("/v1/subscriptionOfferCodes/%s/relationships/prices", offerCodeID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("subscriptionOfferCodePricesRelationships: %w", err)
 		}
 		path = query.nextURL
@@ -897,7 +897,7 @@ func (c *Client) GetSubscriptionPrices(ctx context.Context, subscriptionID strin
 
 	path := fmt.Sprintf("/v1/subscriptions/%s/prices", strings.TrimSpace(subscriptionID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("subscriptionPrices: %w", err)
 		}
 		path = query.nextURL
@@ -926,7 +926,7 @@ func (c *Client) GetSubscriptionPricePoints(ctx context.Context, subscriptionID
 
 	path := fmt.Sprintf("/v1/subscriptions/%s/pricePoints", strings.TrimSpace(subscriptionID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("subscriptionPricePoints: %w", err)
 		}
 		path = query.nextURL
@@ -970,7 +970,7 @@ func (c *Client) GetSubscriptionPricePointEqualizations(ctx context.Context, pri
 
 	path := fmt.Sprintf("/v1/subscriptionPricePoints/%s/equalizations", strings.TrimSpace(pricePointID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("subscriptionPricePointEqualizations: %w", err)
 		}
 		path = query.nextURL
@@ -1004,7 +1004,7 @@ func (c *Client) GetSubscriptionPricePointEqualizationsRelationships(ctx context
 
 	path := fmt.Sprintf("/v1/subscriptionPricePoints/%s/relationships/equalizations", pricePointID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("subscriptionPricePointEqualizationsRelationships: %w", err)
 		}
 		path = query.nextURL
@@ -1206,7 +1206,7 @@ func (c *Client) GetSubscriptionGroupLocalizations(ctx context.Context, groupID
 
 	path := fmt.Sprintf("/v1/subscriptionGroups/%s/subscriptionGroupLocalizations", strings.TrimSpace(groupID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("subscriptionGroupLocalizations: %w", err)
 		}
 		path = query.nextURL
@@ -18,7 +18,7 @@ func (c *Client) GetSubscriptionGroups(ctx context.Context, appID string, opts .
 
 	path := fmt.Sprintf("/v1/apps/%s/subscriptionGroups", strings.TrimSpace(appID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("subscriptionGroups: %w", err)
 		}
 		path = query.nextURL
@@ -135,7 +135,7 @@ func (c *Client) GetSubscriptions(ctx context.Context, groupID string, opts ...S
 
 	path := fmt.Sprintf("/v1/subscriptionGroups/%s/subscriptions", strings.TrimSpace(groupID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("subscriptions: %w", err)
 		}
 		path = query.nextURL
@@ -532,7 +532,7 @@ func (c *Client) GetSubscriptionAvailabilityAvailableTerritories(ctx context.Con
 
 	path := fmt.Sprintf("/v1/subscriptionAvailabilities/%s/availableTerritories", availabilityID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("subscription-availability-territories: %w", err)
 		}
 		path = query.nextURL
@@ -627,7 +627,7 @@ func (c *Client) GetSubscriptionAvailabilityAvailableTerritoriesRelationships(ct
 
 	path := fmt.Sprintf("/v1/subscriptionAvailabilities/%s/relationships/availableTerritories", availabilityID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("subscriptionAvailabilityAvailableTerritoriesRelationships: %w", err)
 		}
 		path = query.nextURL
@@ -662,7 +662,7 @@ func (c *Client) GetSubscriptionGroupSubscriptionGroupLocalizationsRelationships
 
 	path := fmt.Sprintf("/v1/subscriptionGroups/%s/relationships/subscriptionGroupLocalizations", groupID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("subscriptionGroupLocalizationsRelationships: %w", err)
 		}
 		path = query.nextURL
@@ -697,7 +697,7 @@ func (c *Client) GetSubscriptionGroupSubscriptionsRelationships(ctx context.Cont
 
 	path := fmt.Sprintf("/v1/subscriptionGroups/%s/relationships/subscriptions", groupID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("subscriptionGroupSubscriptionsRelationships: %w", err)
 		}
 		path = query.nextURL
@@ -753,7 +753,7 @@ func (c *Client) GetSubscriptionImagesRelationships(ctx context.Context, subID s
 
 	path := fmt.Sprintf("/v1/subscriptions/%s/relationships/images", subID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("subscriptionImagesRelationships: %w", err)
 		}
 		path = query.nextURL
@@ -788,7 +788,7 @@ func (c *Client) GetSubscriptionIntroductoryOffersRelationships(ctx context.Cont
 
 	path := fmt.Sprintf("/v1/subscriptions/%s/relationships/introductoryOffers", subID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("subscriptionIntroductoryOffersRelationships: %w", err)
 		}
 		path = query.nextURL
@@ -854,7 +854,7 @@ func (c *Client) GetSubscriptionOfferCodesRelationships(ctx context.Context, sub
 
 	path := fmt.Sprintf("/v1/subscriptions/%s/relationships/offerCodes", subID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("subscriptionOfferCodesRelationships: %w", err)
 		}
 		path = query.nextURL
@@ -889,7 +889,7 @@ func (c *Client) GetSubscriptionPricePointsRelationships(ctx context.Context, su
 
 	path := fmt.Sprintf("/v1/subscriptions/%s/relationships/pricePoints", subID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("subscriptionPricePointsRelationships: %w", err)
 		}
 		path = query.nextURL
@@ -924,7 +924,7 @@ func (c *Client) GetSubscriptionPricesRelationships(ctx context.Context, subID s
 
 	path := fmt.Sprintf("/v1/subscriptions/%s/relationships/prices", subID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("subscriptionPricesRelationships: %w", err)
 		}
 		path = query.nextURL
@@ -1011,7 +1011,7 @@ func (c *Client) GetSubscriptionPromotionalOffersRelationships(ctx context.Conte
 
 	path := fmt.Sprintf("/v1/subscriptions/%s/relationships/promotionalOffers", subID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("subscriptionPromotionalOffersRelationships: %w", err)
 		}
 		path = query.nextURL
@@ -1067,7 +1067,7 @@ func (c *Client) GetSubscriptionSubscriptionLocalizationsRelationships(ctx conte
 
 	path := fmt.Sprintf("/v1/subscriptions/%s/relationships/subscriptionLocalizations", subID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("subscriptionSubscriptionLocalizationsRelationships: %w", err)
 		}
 		path = query.nextURL
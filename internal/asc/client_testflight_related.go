@@ -84,7 +84,7 @@ func (c *Client) GetBetaTesterApps(ctx context.Context, testerID string, opts ..
 
 	path := fmt.Sprintf("/v1/betaTesters/%s/apps", testerID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("betaTesterApps: %w", err)
 		}
 		path = query.nextURL
@@ -119,7 +119,7 @@ func (c *Client) GetBetaTesterBetaGroups(ctx context.Context, testerID string, o
 
 	path := fmt.Sprintf("/v1/betaTesters/%s/betaGroups", testerID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("betaTesterBetaGroups: %w", err)
 		}
 		path = query.nextURL
@@ -154,7 +154,7 @@ func (c *Client) GetBetaTesterBuilds(ctx context.Context, testerID string, opts
 
 	path := fmt.Sprintf("/v1/betaTesters/%s/builds", testerID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("betaTesterBuilds: %w", err)
 		}
 		path = query.nextURL
@@ -16,7 +16,7 @@ func (c *Client) GetUserInvitationVisibleApps(ctx context.Context, invitationID
 
 	path := fmt.Sprintf("/v1/userInvitations/%s/visibleApps", strings.TrimSpace(invitationID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("userInvitationVisibleApps: %w", err)
 		}
 		path = query.nextURL
@@ -46,7 +46,7 @@ func (c *Client) GetUserInvitationVisibleAppsRelationships(ctx context.Context,
 
 	path := fmt.Sprintf("/v1/userInvitations/%s/relationships/visibleApps", strings.TrimSpace(invitationID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("userInvitationVisibleAppsRelationships: %w", err)
 		}
 		path = query.nextURL
@@ -17,7 +17,7 @@ func (c *Client) GetUsers(ctx context.Context, opts ...UsersOption) (*UsersRespo
 	path := "/v1/users"
 	if query.nextURL != "" {
 		// Validate nextURL to prevent credential exfiltration
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("users: %w", err)
 		}
 		path = query.nextURL
@@ -112,7 +112,7 @@ func (c *Client) GetUserInvitations(ctx context.Context, opts ...UserInvitations
 	path := "/v1/userInvitations"
 	if query.nextURL != "" {
 		// Validate nextURL to prevent credential exfiltration
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("userInvitations: %w", err)
 		}
 		path = query.nextURL
@@ -217,7 +217,7 @@ func (c *Client) GetUserVisibleApps(ctx context.Context, userID string, opts ...
 	path := fmt.Sprintf("/v1/users/%s/visibleApps", userID)
 	if query.nextURL != "" {
 		// Validate nextURL to prevent credential exfiltration
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("userVisibleApps: %w", err)
 		}
 		path = query.nextURL
@@ -251,7 +251,7 @@ func (c *Client) GetUserVisibleAppsRelationships(ctx context.Context, userID str
 	path := fmt.Sprintf("/v1/users/%s/relationships/visibleApps", userID)
 	if query.nextURL != "" {
 		// Validate nextURL to prevent credential exfiltration
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("userVisibleAppsRelationships: %w", err)
 		}
 		path = query.nextURL
@@ -42,7 +42,7 @@ func (c *Client) GetAppStoreVersionLocalizationSearchKeywordsRelationships(ctx c
 
 	path := fmt.Sprintf("/v1/appStoreVersionLocalizations/%s/relationships/searchKeywords", localizationID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("searchKeywordsRelationships: %w", err)
 		}
 		path = query.nextURL
@@ -139,7 +139,7 @@ func (c *Client) GetAppStoreVersionLocalizationPreviewSets(ctx context.Context,
 
 	path := fmt.Sprintf("/v1/appStoreVersionLocalizations/%s/appPreviewSets", localizationID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appPreviewSets: %w", err)
 		}
 		path = query.nextURL
@@ -174,7 +174,7 @@ func (c *Client) GetAppStoreVersionLocalizationPreviewSetsRelationships(ctx cont
 
 	path := fmt.Sprintf("/v1/appStoreVersionLocalizations/%s/relationships/appPreviewSets", localizationID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appPreviewSetsRelationships: %w", err)
 		}
 		path = query.nextURL
@@ -209,7 +209,7 @@ func (c *Client) GetAppStoreVersionLocalizationScreenshotSets(ctx context.Contex
 
 	path := fmt.Sprintf("/v1/appStoreVersionLocalizations/%s/appScreenshotSets", localizationID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appScreenshotSets: %w", err)
 		}
 		path = query.nextURL
@@ -244,7 +244,7 @@ func (c *Client) GetAppStoreVersionLocalizationScreenshotSetsRelationships(ctx c
 
 	path := fmt.Sprintf("/v1/appStoreVersionLocalizations/%s/relationships/appScreenshotSets", localizationID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appScreenshotSetsRelationships: %w", err)
 		}
 		path = query.nextURL
@@ -156,7 +156,7 @@ func (c *Client) GetAppStoreVersions(ctx context.Context, appID string, opts ...
 	path := fmt.Sprintf("/v1/apps/%s/appStoreVersions", appID)
 	if query.nextURL != "" {
 		// Validate nextURL to prevent credential exfiltration
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appStoreVersions: %w", err)
 		}
 		path = query.nextURL
@@ -188,7 +188,7 @@ func (c *Client) GetPreReleaseVersions(ctx context.Context, appID string, opts .
 	path := "/v1/preReleaseVersions"
 	if query.nextURL != "" {
 		// Validate nextURL to prevent credential exfiltration
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("preReleaseVersions: %w", err)
 		}
 		path = query.nextURL
@@ -18,7 +18,7 @@ func (c *Client) GetAppWebhooks(ctx context.Context, appID string, opts ...Webho
 	appID = strings.TrimSpace(appID)
 	path := fmt.Sprintf("/v1/apps/%s/webhooks", appID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("webhooks: %w", err)
 		}
 		path = query.nextURL
@@ -133,7 +133,7 @@ func (c *Client) GetWebhookDeliveries(ctx context.Context, webhookID string, opt
 
 	path := fmt.Sprintf("/v1/webhooks/%s/deliveries", strings.TrimSpace(webhookID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("webhookDeliveries: %w", err)
 		}
 		path = query.nextURL
@@ -163,7 +163,7 @@ func (c *Client) GetWebhookDeliveriesRelationships(ctx context.Context, webhookI
 
 	path := fmt.Sprintf("/v1/webhooks/%s/relationships/deliveries", strings.TrimSpace(webhookID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("webhookDeliveries relationships: %w", err)
 		}
 		path = query.nextURL
@@ -141,7 +141,7 @@ func (c *Client) GetAppEncryptionDeclarations(ctx context.Context, appID string,
 
 	path := "/v1/appEncryptionDeclarations"
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("app-encryption-declarations: %w", err)
 		}
 		path = query.nextURL
@@ -276,7 +276,7 @@ func (c *Client) GetEndUserLicenseAgreementTerritories(ctx context.Context, id s
 
 	path := fmt.Sprintf("/v1/endUserLicenseAgreements/%s/territories", id)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("endUserLicenseAgreementTerritories: %w", err)
 		}
 		path = query.nextURL
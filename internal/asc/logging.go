@@ -0,0 +1,189 @@
+package asc
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// Log formats accepted by ASC_LOG_FORMAT and the log_format config key.
+const (
+	LogFormatText = "text"
+	LogFormatJSON = "json"
+)
+
+var logOutputOverride struct {
+	mu     sync.RWMutex
+	format *string
+	file   *string
+}
+
+// logStderr is the stderr captured at startup; loggers keep writing there
+// even if os.Stderr is later reassigned.
+var logStderr io.Writer = os.Stderr
+
+var logOutput struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+// SetLogFormatOverride sets an explicit log format override.
+// When set, it takes precedence over env/config. When unset (nil), behavior falls back to env/config.
+func SetLogFormatOverride(value *string) {
+	logOutputOverride.mu.Lock()
+	defer logOutputOverride.mu.Unlock()
+	logOutputOverride.format = value
+}
+
+// SetLogFileOverride sets an explicit log file override.
+// When set, it takes precedence over env/config. When unset (nil), behavior falls back to env/config.
+func SetLogFileOverride(value *string) {
+	logOutputOverride.mu.Lock()
+	defer logOutputOverride.mu.Unlock()
+	logOutputOverride.file = value
+}
+
+// ResolveLogFormat returns the format for retry, debug, and upload logs.
+// Precedence: explicit override > env > config. Defaults to text.
+func ResolveLogFormat() (string, error) {
+	logOutputOverride.mu.RLock()
+	override := logOutputOverride.format
+	logOutputOverride.mu.RUnlock()
+
+	value := ""
+	if override != nil {
+		value = *override
+	} else if env, ok := envValue("ASC_LOG_FORMAT"); ok {
+		value = env
+	} else if cfg := loadConfig(); cfg != nil {
+		value = cfg.LogFormat
+	}
+
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "", LogFormatText:
+		return LogFormatText, nil
+	case LogFormatJSON:
+		return LogFormatJSON, nil
+	default:
+		return "", fmt.Errorf("invalid log format %q (expected text or json)", value)
+	}
+}
+
+// ResolveLogFile returns the file retry, debug, and upload logs are appended
+// to, or "" for stderr.
+// Precedence: explicit override > env > config.
+func ResolveLogFile() string {
+	logOutputOverride.mu.RLock()
+	override := logOutputOverride.file
+	logOutputOverride.mu.RUnlock()
+	if override != nil {
+		return strings.TrimSpace(*override)
+	}
+	if value, ok := envValue("ASC_LOG_FILE"); ok {
+		return value
+	}
+	if cfg := loadConfig(); cfg != nil {
+		return strings.TrimSpace(cfg.LogFile)
+	}
+	return ""
+}
+
+// ConfigureLogOutput points the retry and debug loggers at the resolved format
+// and destination. Call it once before issuing requests and CloseLogOutput
+// when the command finishes.
+func ConfigureLogOutput() error {
+	format, err := ResolveLogFormat()
+	if err != nil {
+		return err
+	}
+
+	writer := logStderr
+	var file *os.File
+	if path := ResolveLogFile(); path != "" {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return fmt.Errorf("open log file: %w", err)
+		}
+		file, err = os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+		if err != nil {
+			return fmt.Errorf("open log file: %w", err)
+		}
+		writer = file
+	}
+
+	logOutput.mu.Lock()
+	previous := logOutput.file
+	logOutput.file = file
+	setLoggers(writer, format)
+	logOutput.mu.Unlock()
+
+	if previous != nil {
+		_ = previous.Close()
+	}
+	return nil
+}
+
+// CloseLogOutput closes the log file opened by ConfigureLogOutput, if any,
+// and restores text logging to stderr.
+func CloseLogOutput() error {
+	logOutput.mu.Lock()
+	file := logOutput.file
+	logOutput.file = nil
+	setLoggers(logStderr, LogFormatText)
+	logOutput.mu.Unlock()
+
+	if file == nil {
+		return nil
+	}
+	return file.Close()
+}
+
+func setLoggers(w io.Writer, format string) {
+	retryLogger.Store(newLogger(w, format))
+	debugLogger.Store(newLogger(w, format))
+}
+
+func newLogger(w io.Writer, format string) *slog.Logger {
+	if format == LogFormatJSON {
+		return slog.New(slog.NewJSONHandler(w, &slog.HandlerOptions{Level: slog.LevelInfo}))
+	}
+	return slog.New(slog.NewTextHandler(w, &slog.HandlerOptions{
+		Level: slog.LevelInfo,
+		ReplaceAttr: func(_ []string, attr slog.Attr) slog.Attr {
+			if attr.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return attr
+		},
+	}))
+}
+
+// responseRequestID returns the server-assigned request ID, if any, so log
+// records can be matched with Apple's side of the exchange.
+func responseRequestID(header http.Header) string {
+	for _, name := range []string{"X-Request-Id", "X-Apple-Request-Uuid"} {
+		if value := strings.TrimSpace(header.Get(name)); value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+type attemptContextKey struct{}
+
+// withAttempt records the 1-based attempt number for a retried request.
+func withAttempt(ctx context.Context, attempt int) context.Context {
+	return context.WithValue(ctx, attemptContextKey{}, attempt)
+}
+
+func attemptFromContext(ctx context.Context) int {
+	if attempt, ok := ctx.Value(attemptContextKey{}).(int); ok && attempt > 0 {
+		return attempt
+	}
+	return 1
+}
@@ -0,0 +1,169 @@
+package asc
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/config"
+)
+
+func TestResolveLogFormat_Precedence(t *testing.T) {
+	setConfigLoaderForTest(func() (*config.Config, error) { return &config.Config{LogFormat: "json"}, nil })
+	t.Cleanup(resetConfigCacheForTest)
+	t.Cleanup(func() { SetLogFormatOverride(nil) })
+
+	t.Setenv("ASC_LOG_FORMAT", "")
+	os.Unsetenv("ASC_LOG_FORMAT")
+	if got, err := ResolveLogFormat(); err != nil || got != LogFormatJSON {
+		t.Fatalf("ResolveLogFormat() = %q, %v; want config value", got, err)
+	}
+
+	t.Setenv("ASC_LOG_FORMAT", "TEXT")
+	if got, err := ResolveLogFormat(); err != nil || got != LogFormatText {
+		t.Fatalf("ResolveLogFormat() = %q, %v; want env value", got, err)
+	}
+
+	override := "json"
+	SetLogFormatOverride(&override)
+	if got, err := ResolveLogFormat(); err != nil || got != LogFormatJSON {
+		t.Fatalf("ResolveLogFormat() = %q, %v; want override", got, err)
+	}
+
+	SetLogFormatOverride(nil)
+	t.Setenv("ASC_LOG_FORMAT", "logfmt")
+	if _, err := ResolveLogFormat(); err == nil || !strings.Contains(err.Error(), "logfmt") {
+		t.Fatalf("expected invalid format error, got %v", err)
+	}
+	if err := ConfigureLogOutput(); err == nil {
+		t.Fatal("expected ConfigureLogOutput to reject an invalid format")
+	}
+}
+
+func TestConfigureLogOutput_WritesJSONRecordsToFile(t *testing.T) {
+	setConfigLoaderForTest(func() (*config.Config, error) { return &config.Config{}, nil })
+	t.Cleanup(resetConfigCacheForTest)
+	t.Setenv("ASC_LOG_FORMAT", "json")
+	t.Setenv("ASC_MAX_RETRIES", "1")
+	t.Setenv("ASC_BASE_DELAY", "1ms")
+	t.Setenv("ASC_MAX_DELAY", "1ms")
+
+	path := filepath.Join(t.TempDir(), "logs", "asc.log")
+	SetLogFileOverride(&path)
+	enabled := true
+	SetRetryLogOverride(&enabled)
+	SetDebugHTTPOverride(&enabled)
+	t.Cleanup(func() {
+		SetLogFileOverride(nil)
+		SetRetryLogOverride(nil)
+		SetDebugHTTPOverride(nil)
+		_ = CloseLogOutput()
+	})
+	if err := ConfigureLogOutput(); err != nil {
+		t.Fatalf("ConfigureLogOutput() error: %v", err)
+	}
+
+	limited := jsonResponse(http.StatusTooManyRequests, `{"errors":[{"title":"Rate limit"}]}`)
+	limited.Header.Set("Retry-After", "0")
+	limited.Header.Set("X-Request-Id", "req-1")
+	ok := jsonResponse(http.StatusOK, `{"data":[]}`)
+	ok.Header.Set("X-Request-Id", "req-2")
+	client := newTestClient(t, nil, limited, ok)
+	if _, err := client.GetApps(context.Background()); err != nil {
+		t.Fatalf("GetApps() error: %v", err)
+	}
+	if err := CloseLogOutput(); err != nil {
+		t.Fatalf("CloseLogOutput() error: %v", err)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("Open() error: %v", err)
+	}
+	defer file.Close()
+
+	var responses, retries []map[string]any
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var record map[string]any
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			t.Fatalf("log line is not JSON: %q: %v", scanner.Text(), err)
+		}
+		switch record["event"] {
+		case "http_response":
+			responses = append(responses, record)
+		case "retry":
+			retries = append(retries, record)
+		}
+	}
+
+	if len(responses) != 2 {
+		t.Fatalf("expected 2 http_response records, got %d", len(responses))
+	}
+	for i, want := range []struct {
+		status    float64
+		requestID string
+		attempt   float64
+	}{{429, "req-1", 1}, {200, "req-2", 2}} {
+		got := responses[i]
+		if got["status"] != want.status || got["request_id"] != want.requestID || got["attempt"] != want.attempt {
+			t.Fatalf("response %d = %v, want %+v", i, got, want)
+		}
+		if _, ok := got["duration_ms"].(float64); !ok {
+			t.Fatalf("response %d missing duration_ms: %v", i, got)
+		}
+	}
+
+	// Retry records come from both the retry logger and the debug logger.
+	if len(retries) != 2 {
+		t.Fatalf("expected 2 retry records, got %d", len(retries))
+	}
+	for _, got := range retries {
+		if got["status"] != float64(429) || got["request_id"] != "req-1" || got["attempt"] != float64(1) {
+			t.Fatalf("unexpected retry record %v", got)
+		}
+	}
+}
+
+func TestConfigureLogOutput_SafeWhileLogging(t *testing.T) {
+	setConfigLoaderForTest(func() (*config.Config, error) { return &config.Config{}, nil })
+	t.Cleanup(resetConfigCacheForTest)
+	path := filepath.Join(t.TempDir(), "asc.log")
+	SetLogFileOverride(&path)
+	t.Cleanup(func() {
+		SetLogFileOverride(nil)
+		_ = CloseLogOutput()
+	})
+
+	var wg sync.WaitGroup
+	done := make(chan struct{})
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			select {
+			case <-done:
+				return
+			default:
+				debugLogger.Load().Info("tick")
+				retryLogger.Load().Info("tick")
+			}
+		}
+	}()
+	for range 20 {
+		if err := ConfigureLogOutput(); err != nil {
+			t.Fatalf("ConfigureLogOutput() error: %v", err)
+		}
+		if err := CloseLogOutput(); err != nil {
+			t.Fatalf("CloseLogOutput() error: %v", err)
+		}
+	}
+	close(done)
+	wg.Wait()
+}
@@ -238,7 +238,7 @@ func (c *Client) GetMarketplaceWebhooks(ctx context.Context, opts ...Marketplace
 
 	path := "/v1/marketplaceWebhooks"
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("marketplaceWebhooks: %w", err)
 		}
 		path = query.nextURL
@@ -133,7 +133,7 @@ func (c *Client) GetNominations(ctx context.Context, opts ...NominationsOption)
 
 	path := "/v1/nominations"
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("nominations: %w", err)
 		}
 		path = query.nextURL
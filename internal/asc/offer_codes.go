@@ -62,7 +62,7 @@ func (c *Client) GetSubscriptionOfferCodeOneTimeUseCodes(ctx context.Context, of
 	path := fmt.Sprintf("/v1/subscriptionOfferCodes/%s/oneTimeUseCodes", offerCodeID)
 	if query.nextURL != "" {
 		// Validate nextURL to prevent credential exfiltration
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("offer codes: %w", err)
 		}
 		path = query.nextURL
@@ -98,7 +98,7 @@ func (c *Client) GetSubscriptionOfferCodeOneTimeUseCodesRelationships(ctx contex
 	path := fmt.Sprintf("/v1/subscriptionOfferCodes/%s/relationships/oneTimeUseCodes", offerCodeID)
 	if query.nextURL != "" {
 		// Validate nextURL to prevent credential exfiltration
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("subscriptionOfferCodeOneTimeUseCodesRelationships: %w", err)
 		}
 		path = query.nextURL
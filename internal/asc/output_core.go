@@ -4,49 +4,179 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
+
+	"gopkg.in/yaml.v3"
 )
 
-func printPrettyRawJSON(data json.RawMessage) error {
+func printPrettyRawJSON(w io.Writer, data json.RawMessage) error {
 	var buf bytes.Buffer
 	if err := json.Indent(&buf, data, "", "  "); err != nil {
 		return fmt.Errorf("pretty-print json: %w", err)
 	}
 	buf.WriteByte('\n')
-	_, err := os.Stdout.Write(buf.Bytes())
+	_, err := w.Write(buf.Bytes())
 	return err
 }
 
 // PrintMarkdown prints data as Markdown table.
 func PrintMarkdown(data any) error {
-	return renderByRegistry(data, RenderMarkdown)
+	return PrintMarkdownTo(os.Stdout, data)
+}
+
+// PrintMarkdownTo writes data to w as Markdown table.
+func PrintMarkdownTo(w io.Writer, data any) error {
+	return renderByRegistryTo(w, data, func(headers []string, rows [][]string) {
+		RenderMarkdownTo(w, headers, rows)
+	})
 }
 
 // PrintTable prints data as a formatted table.
 func PrintTable(data any) error {
-	return renderByRegistry(data, RenderTable)
+	return PrintTableTo(os.Stdout, data)
+}
+
+// PrintTableTo writes data to w as a formatted table.
+func PrintTableTo(w io.Writer, data any) error {
+	return renderByRegistryTo(w, data, func(headers []string, rows [][]string) {
+		RenderTableTo(w, headers, rows)
+	})
+}
+
+// PrintCSV prints data as comma-separated values using its table rows.
+// Multi-table output is separated by blank lines.
+func PrintCSV(data any) error {
+	return PrintCSVTo(os.Stdout, data)
+}
+
+// PrintCSVTo writes data to w as comma-separated values.
+func PrintCSVTo(w io.Writer, data any) error {
+	return printSeparatedTables(w, data, func(headers []string, rows [][]string) error {
+		return RenderCSVTo(w, headers, rows)
+	})
+}
+
+// PrintTSV prints data as tab-separated values using its table rows.
+// Multi-table output is separated by blank lines.
+func PrintTSV(data any) error {
+	return PrintTSVTo(os.Stdout, data)
+}
+
+// PrintTSVTo writes data to w as tab-separated values.
+func PrintTSVTo(w io.Writer, data any) error {
+	return printSeparatedTables(w, data, func(headers []string, rows [][]string) error {
+		return RenderTSVTo(w, headers, rows)
+	})
+}
+
+func printSeparatedTables(w io.Writer, data any, render func([]string, [][]string) error) error {
+	tables := SeparatedTables(w, render)
+	if err := renderByRegistryTo(w, data, tables.Render); err != nil {
+		return err
+	}
+	return tables.Err()
+}
+
+// TableSeparator renders consecutive tables separated by a blank line, keeping
+// multi-table csv and tsv output parseable section by section.
+type TableSeparator struct {
+	w      io.Writer
+	render func([]string, [][]string) error
+	tables int
+	err    error
+}
+
+// SeparatedTables returns a TableSeparator that renders each table with render
+// and writes the separating blank lines to w.
+func SeparatedTables(w io.Writer, render func([]string, [][]string) error) *TableSeparator {
+	return &TableSeparator{w: w, render: render}
+}
+
+// Render writes one table. After the first write error it writes nothing more;
+// the error is reported by Err.
+func (s *TableSeparator) Render(headers []string, rows [][]string) {
+	if s.err != nil {
+		return
+	}
+	if s.tables > 0 {
+		if _, err := fmt.Fprintln(s.w); err != nil {
+			s.err = err
+			return
+		}
+	}
+	s.tables++
+	s.err = s.render(headers, rows)
+}
+
+// Err returns the first error encountered while rendering.
+func (s *TableSeparator) Err() error {
+	return s.err
+}
+
+// PrintYAML prints data as YAML. Keys keep their JSON names and order.
+func PrintYAML(data any) error {
+	return PrintYAMLTo(os.Stdout, data)
+}
+
+// PrintYAMLTo writes data to w as YAML.
+func PrintYAMLTo(w io.Writer, data any) error {
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	var node yaml.Node
+	if err := yaml.Unmarshal(encoded, &node); err != nil {
+		return fmt.Errorf("convert json to yaml: %w", err)
+	}
+	clearYAMLStyle(&node)
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(&node); err != nil {
+		return err
+	}
+	return enc.Close()
+}
+
+// clearYAMLStyle drops the flow and quoting styles inherited from the JSON
+// source so the encoder emits block-style YAML.
+func clearYAMLStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		clearYAMLStyle(child)
+	}
 }
 
 // PrintJSON prints data as minified JSON (best for AI agents).
 func PrintJSON(data any) error {
-	enc := json.NewEncoder(os.Stdout)
+	return PrintJSONTo(os.Stdout, data)
+}
+
+// PrintJSONTo writes data to w as minified JSON.
+func PrintJSONTo(w io.Writer, data any) error {
+	enc := json.NewEncoder(w)
 	return enc.Encode(data)
 }
 
 // PrintPrettyJSON prints data as indented JSON (best for debugging).
 func PrintPrettyJSON(data any) error {
+	return PrintPrettyJSONTo(os.Stdout, data)
+}
+
+// PrintPrettyJSONTo writes data to w as indented JSON.
+func PrintPrettyJSONTo(w io.Writer, data any) error {
 	switch v := data.(type) {
 	case *PerfPowerMetricsResponse:
-		return printPrettyRawJSON(v.Data)
+		return printPrettyRawJSON(w, v.Data)
 	case *DiagnosticLogsResponse:
-		return printPrettyRawJSON(v.Data)
+		return printPrettyRawJSON(w, v.Data)
 	case *BetaBuildUsagesResponse:
-		return printPrettyRawJSON(v.Data)
+		return printPrettyRawJSON(w, v.Data)
 	case *BetaTesterUsagesResponse:
-		return printPrettyRawJSON(v.Data)
+		return printPrettyRawJSON(w, v.Data)
 	}
 
-	enc := json.NewEncoder(os.Stdout)
+	enc := json.NewEncoder(w)
 	enc.SetIndent("", "  ")
 	return enc.Encode(data)
 }
@@ -0,0 +1,75 @@
+package asc
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+type rawResourceSummary struct {
+	Type       string                     `json:"type"`
+	ID         string                     `json:"id"`
+	Attributes map[string]json.RawMessage `json:"attributes"`
+}
+
+func rawResponseRows(resp *RawResponse) ([]string, [][]string) {
+	data := strings.TrimSpace(string(resp.Data))
+	switch {
+	case data == "" || data == "null":
+		return rawResourceRows(nil)
+	case strings.HasPrefix(data, "["):
+		var items []json.RawMessage
+		if err := json.Unmarshal(resp.Data, &items); err != nil {
+			return rawResourceRows(nil)
+		}
+		return rawResourceRows(items)
+	default:
+		return rawResourceRows([]json.RawMessage{resp.Data})
+	}
+}
+
+func rawListResponseRows(resp *RawListResponse) ([]string, [][]string) {
+	return rawResourceRows(resp.Data)
+}
+
+func rawResourceRows(items []json.RawMessage) ([]string, [][]string) {
+	headers := []string{"Type", "ID", "Attributes"}
+	rows := make([][]string, 0, len(items))
+	for _, item := range items {
+		var resource rawResourceSummary
+		if err := json.Unmarshal(item, &resource); err != nil {
+			rows = append(rows, []string{"-", "-", compactWhitespace(string(item))})
+			continue
+		}
+		rows = append(rows, []string{
+			fallbackValue(sanitizeTerminal(resource.Type)),
+			fallbackValue(sanitizeTerminal(resource.ID)),
+			fallbackValue(formatRawAttributes(resource.Attributes)),
+		})
+	}
+	return headers, rows
+}
+
+// formatRawAttributes renders attributes as sorted key=value pairs, skipping nulls.
+func formatRawAttributes(attributes map[string]json.RawMessage) string {
+	keys := make([]string, 0, len(attributes))
+	for key, value := range attributes {
+		if string(value) == "null" {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, 0, len(keys))
+	for _, key := range keys {
+		value := string(attributes[key])
+		var text string
+		if err := json.Unmarshal(attributes[key], &text); err == nil {
+			value = text
+		}
+		parts = append(parts, fmt.Sprintf("%s=%s", key, compactWhitespace(value)))
+	}
+	return strings.Join(parts, ", ")
+}
@@ -2,6 +2,8 @@ package asc
 
 import (
 	"fmt"
+	"io"
+	"os"
 	"reflect"
 )
 
@@ -339,10 +341,21 @@ func registerDirect[T any](fn func(*T, func([]string, [][]string)) error) {
 	}
 }
 
+// HasRowRenderer reports whether data has registered table rows, so callers
+// can choose their own fallback instead of renderByRegistry's JSON output.
+func HasRowRenderer(data any) bool {
+	return isRegistryTypeRegistered(reflect.TypeOf(data))
+}
+
 // renderByRegistry looks up the rows function for the given value and renders
 // using the provided render function (RenderTable or RenderMarkdown).
 // Falls back to JSON output for unregistered types.
 func renderByRegistry(data any, render func([]string, [][]string)) error {
+	return renderByRegistryTo(os.Stdout, data, render)
+}
+
+// renderByRegistryTo is renderByRegistry with the JSON fallback written to w.
+func renderByRegistryTo(w io.Writer, data any, render func([]string, [][]string)) error {
 	t := reflect.TypeOf(data)
 
 	// Check direct render registry first (multi-table types).
@@ -360,5 +373,5 @@ func renderByRegistry(data any, render func([]string, [][]string)) error {
 		return nil
 	}
 
-	return PrintJSON(data)
+	return PrintJSONTo(w, data)
 }
@@ -42,6 +42,8 @@ func init() {
 	registerRowsWithSingleResourceAdapter(backgroundAssetUploadFilesRows)
 	registerRowsWithSingleResourceAdapter(nominationsRows)
 	registerRows(linkagesRows)
+	registerRows(rawResponseRows)
+	registerRows(rawListResponseRows)
 	registerSingleLinkageRows(func(v *AppClipDefaultExperienceReviewDetailLinkageResponse) ResourceData { return v.Data })
 	registerSingleLinkageRows(func(v *AppClipDefaultExperienceReleaseWithAppStoreVersionLinkageResponse) ResourceData {
 		return v.Data
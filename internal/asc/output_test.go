@@ -543,3 +543,86 @@ func TestPrintTableAndMarkdown_BuildUploadResultIncludesOperations(t *testing.T)
 		})
 	}
 }
+
+func TestPrintCSVAndTSV_UseTableRows(t *testing.T) {
+	data := &AppsResponse{
+		Data: []Resource[AppAttributes]{
+			{ID: "1", Attributes: AppAttributes{Name: "Alpha, Inc.", BundleID: "com.example.alpha", SKU: "A"}},
+		},
+	}
+	headers, rows, err := outputRegistry[typeForPtr[AppsResponse]()](data)
+	if err != nil {
+		t.Fatalf("rows error: %v", err)
+	}
+
+	csvOutput := captureStdout(t, func() error { return PrintCSV(data) })
+	lines := strings.Split(strings.TrimSpace(csvOutput), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected header and one row, got %q", csvOutput)
+	}
+	if lines[0] != strings.Join(headers, ",") {
+		t.Fatalf("csv header = %q, want %q", lines[0], strings.Join(headers, ","))
+	}
+	if !strings.Contains(lines[1], `"Alpha, Inc."`) {
+		t.Fatalf("expected quoted csv cell, got %q", lines[1])
+	}
+
+	tsvOutput := captureStdout(t, func() error { return PrintTSV(data) })
+	if got := strings.Split(strings.TrimSpace(tsvOutput), "\n")[1]; got != strings.Join(rows[0], "\t") {
+		t.Fatalf("tsv row = %q, want %q", got, strings.Join(rows[0], "\t"))
+	}
+}
+
+func TestPrintCSVAndTSV_ReturnWriteErrors(t *testing.T) {
+	data := &AppsResponse{
+		Data: []Resource[AppAttributes]{
+			{ID: "1", Attributes: AppAttributes{Name: "Alpha", BundleID: "com.example.alpha", SKU: "A"}},
+		},
+	}
+	readOnly, err := os.Open(os.DevNull)
+	if err != nil {
+		t.Fatalf("open error: %v", err)
+	}
+	t.Cleanup(func() { _ = readOnly.Close() })
+
+	orig := os.Stdout
+	os.Stdout = readOnly
+	t.Cleanup(func() { os.Stdout = orig })
+
+	if err := PrintCSV(data); err == nil {
+		t.Fatal("expected PrintCSV to return the write error")
+	}
+	if err := PrintTSV(data); err == nil {
+		t.Fatal("expected PrintTSV to return the write error")
+	}
+}
+
+func TestSeparatedTables_InsertsBlankLineBetweenTables(t *testing.T) {
+	output := captureStdout(t, func() error {
+		tables := SeparatedTables(os.Stdout, RenderCSV)
+		tables.Render([]string{"A"}, [][]string{{"1"}})
+		tables.Render([]string{"B"}, [][]string{{"2"}})
+		return tables.Err()
+	})
+	if output != "A\n1\n\nB\n2\n" {
+		t.Fatalf("unexpected output %q", output)
+	}
+}
+
+func TestPrintYAML_PreservesJSONFieldOrder(t *testing.T) {
+	data := &AppsResponse{
+		Data: []Resource[AppAttributes]{
+			{Type: ResourceTypeApps, ID: "1", Attributes: AppAttributes{Name: "Alpha", BundleID: "com.example.alpha", SKU: "A"}},
+		},
+	}
+	output := captureStdout(t, func() error { return PrintYAML(data) })
+	if strings.Contains(output, `"data"`) || !strings.Contains(output, `id: "1"`) {
+		t.Fatalf("expected block-style yaml with string ids quoted, got:\n%s", output)
+	}
+	nameIndex := strings.Index(output, "name: Alpha")
+	bundleIndex := strings.Index(output, "bundleId: com.example.alpha")
+	skuIndex := strings.Index(output, "sku: A")
+	if nameIndex < 0 || bundleIndex < nameIndex || skuIndex < bundleIndex {
+		t.Fatalf("expected attributes in struct order, got:\n%s", output)
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 // PollUntil repeatedly executes check until it returns done=true, an error,
 // or the context is canceled. It executes check immediately before waiting.
+// Requests made by check bypass fresh response cache entries.
 func PollUntil[T any](ctx context.Context, interval time.Duration, check func(context.Context) (T, bool, error)) (T, error) {
 	var zero T
 
@@ -17,6 +18,7 @@ func PollUntil[T any](ctx context.Context, interval time.Duration, check func(co
 	if ctx == nil {
 		ctx = context.Background()
 	}
+	ctx = WithoutResponseCache(ctx)
 
 	select {
 	case <-ctx.Done():
@@ -0,0 +1,375 @@
+package asc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"math"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	rateLimitHeader               = "X-Rate-Limit"
+	rateLimitWarnThresholdEnvVar  = "ASC_RATE_LIMIT_WARN_THRESHOLD"
+	rateLimitSharedEnvVar         = "ASC_RATE_LIMIT_SHARED"
+	defaultRateLimitWarnThreshold = "10%"
+	rateLimitWindow               = time.Hour
+	rateLimitLockPollInterval     = 10 * time.Millisecond
+	rateLimitStaleLockAge         = 10 * time.Second
+)
+
+// rateLimitWarningWriter receives low-quota warnings. Tests may replace it.
+var rateLimitWarningWriter io.Writer = os.Stderr
+
+// RateLimitStatus reports the most recent hourly quota observed from the
+// App Store Connect X-Rate-Limit response header.
+type RateLimitStatus struct {
+	Limit      int       `json:"limit"`
+	Remaining  int       `json:"remaining"`
+	ObservedAt time.Time `json:"observedAt"`
+}
+
+// rateLimitState is the token-bucket state. It is kept in memory and, when
+// shared mode is enabled, mirrored to a lock-protected file so that parallel
+// processes using the same API key pace against one budget.
+type rateLimitState struct {
+	Limit      int       `json:"limit"`
+	Remaining  int       `json:"remaining"`
+	ObservedAt time.Time `json:"observedAt"`
+	Tokens     float64   `json:"tokens"`
+	RefilledAt time.Time `json:"refilledAt"`
+}
+
+// rateLimiter paces requests with a token bucket sized from the quota that
+// App Store Connect reports. While the remaining quota is above the warning
+// threshold, requests burst freely; below it, requests are spread out at the
+// sustainable hourly rate so that other jobs sharing the key are not starved.
+type rateLimiter struct {
+	mu        sync.Mutex
+	state     rateLimitState
+	threshold string
+	statePath string // empty when pacing is process-local
+	warned    bool
+
+	now   func() time.Time
+	sleep func(context.Context, time.Duration) error
+}
+
+func newRateLimiter(keyID, issuerID string) *rateLimiter {
+	limiter := &rateLimiter{
+		threshold: resolveRateLimitWarnThreshold(),
+		now:       time.Now,
+		sleep:     sleepContext,
+	}
+	if resolveRateLimitShared() {
+		if dir, err := rateLimitStateDir(); err == nil {
+			limiter.statePath = filepath.Join(dir, credentialScope(keyID, issuerID)+".json")
+		}
+	}
+	return limiter
+}
+
+func (c *Client) getRateLimiter() *rateLimiter {
+	c.rateLimiterOnce.Do(func() {
+		if c.rateLimiter == nil {
+			c.rateLimiter = newRateLimiter(c.keyID, c.issuerID)
+		}
+	})
+	return c.rateLimiter
+}
+
+// RateLimitStatus returns the most recent hourly quota reported by App Store
+// Connect. The boolean is false until a response carrying X-Rate-Limit is seen.
+func (c *Client) RateLimitStatus() (RateLimitStatus, bool) {
+	return c.getRateLimiter().status()
+}
+
+func (l *rateLimiter) status() (RateLimitStatus, bool) {
+	var status RateLimitStatus
+	err := l.withState(context.Background(), func(state *rateLimitState) {
+		status = RateLimitStatus{
+			Limit:      state.Limit,
+			Remaining:  state.Remaining,
+			ObservedAt: state.ObservedAt,
+		}
+	})
+	if err != nil || status.Limit <= 0 {
+		return RateLimitStatus{}, false
+	}
+	return status, true
+}
+
+// wait blocks until a request may be sent under the current quota.
+func (l *rateLimiter) wait(ctx context.Context) error {
+	var delay time.Duration
+	err := l.withState(ctx, func(state *rateLimitState) {
+		if state.Limit <= 0 {
+			return
+		}
+		now := l.now()
+		rate := float64(state.Limit) / rateLimitWindow.Seconds()
+		if !state.RefilledAt.IsZero() {
+			elapsed := now.Sub(state.RefilledAt).Seconds()
+			if elapsed > 0 {
+				state.Tokens = math.Min(state.Tokens+elapsed*rate, float64(state.Limit))
+			}
+		}
+		state.RefilledAt = now
+		// Reserve a token up front; a negative balance is debt that this caller
+		// repays by sleeping, which keeps concurrent waiters evenly spaced.
+		state.Tokens--
+		if state.Tokens < 0 {
+			delay = time.Duration(-state.Tokens / rate * float64(time.Second))
+		}
+	})
+	if err != nil {
+		return fmt.Errorf("wait for rate limit: %w", err)
+	}
+	if delay <= 0 {
+		return nil
+	}
+	if ResolveDebugEnabled() {
+		debugLogger.Load().Info("⏳ Pacing request for rate limit", "event", "rate_limit_pacing", "delay", delay.String(), "delay_ms", delay.Milliseconds())
+	}
+	if err := l.sleep(ctx, delay); err != nil {
+		return fmt.Errorf("wait for rate limit: %w", err)
+	}
+	return nil
+}
+
+// observe updates the bucket from a response's X-Rate-Limit header.
+func (l *rateLimiter) observe(header http.Header, statusCode int) {
+	limit, remaining, ok := parseRateLimitHeader(header.Get(rateLimitHeader))
+	if !ok {
+		return
+	}
+
+	reserve := resolveRateLimitReserve(l.threshold, limit)
+	_ = l.withState(context.Background(), func(state *rateLimitState) {
+		now := l.now()
+		state.Limit = limit
+		state.Remaining = remaining
+		state.ObservedAt = now
+
+		available := float64(remaining - reserve)
+		if available >= 1 && statusCode != http.StatusTooManyRequests {
+			state.Tokens = available
+		} else {
+			// Below the reserve: keep any outstanding debt but allow no burst, so
+			// requests proceed at the sustainable hourly rate.
+			state.Tokens = math.Min(state.Tokens, 0)
+		}
+		state.RefilledAt = now
+	})
+
+	if remaining < reserve || statusCode == http.StatusTooManyRequests {
+		l.warnLowQuota(limit, remaining)
+	}
+}
+
+func (l *rateLimiter) warnLowQuota(limit, remaining int) {
+	l.mu.Lock()
+	if l.warned {
+		l.mu.Unlock()
+		return
+	}
+	l.warned = true
+	l.mu.Unlock()
+
+	fmt.Fprintf(
+		rateLimitWarningWriter,
+		"Warning: App Store Connect API quota is low (%d of %d requests remaining this hour); pacing requests\n",
+		remaining,
+		limit,
+	)
+}
+
+// withState runs fn against the bucket state while holding the in-process
+// lock and, in shared mode, the cross-process lock file.
+func (l *rateLimiter) withState(ctx context.Context, fn func(*rateLimitState)) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.statePath == "" {
+		fn(&l.state)
+		return nil
+	}
+
+	release, err := acquireLockFile(ctx, l.statePath+".lock")
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+		// Fall back to process-local pacing rather than failing the request.
+		fn(&l.state)
+		return nil
+	}
+	defer release()
+
+	state := l.state
+	if data, err := os.ReadFile(l.statePath); err == nil {
+		var shared rateLimitState
+		if json.Unmarshal(data, &shared) == nil {
+			state = shared
+		}
+	}
+	fn(&state)
+	l.state = state
+
+	data, err := json.Marshal(state)
+	if err != nil {
+		return nil
+	}
+	tmpPath := l.statePath + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0o600); err != nil {
+		return nil
+	}
+	_ = os.Rename(tmpPath, l.statePath)
+	return nil
+}
+
+// acquireLockFile creates path exclusively, polling until it is available.
+// Lock files left behind by crashed processes are reclaimed once stale.
+func acquireLockFile(ctx context.Context, path string) (func(), error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return nil, err
+	}
+	for {
+		f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+		if err == nil {
+			_ = f.Close()
+			return func() { _ = os.Remove(path) }, nil
+		}
+		if !errors.Is(err, fs.ErrExist) {
+			return nil, err
+		}
+		if info, statErr := os.Stat(path); statErr == nil && time.Since(info.ModTime()) > rateLimitStaleLockAge {
+			_ = os.Remove(path)
+			continue
+		}
+		if err := sleepContext(ctx, rateLimitLockPollInterval); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// parseRateLimitHeader parses Apple's X-Rate-Limit header, for example
+// "user-hour-lim:3600;user-hour-rem:3598;".
+func parseRateLimitHeader(value string) (limit, remaining int, ok bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, 0, false
+	}
+
+	limit, remaining = -1, -1
+	for _, part := range strings.Split(value, ";") {
+		key, raw, found := strings.Cut(strings.TrimSpace(part), ":")
+		if !found {
+			continue
+		}
+		parsed, err := strconv.Atoi(strings.TrimSpace(raw))
+		if err != nil || parsed < 0 {
+			continue
+		}
+		switch strings.ToLower(strings.TrimSpace(key)) {
+		case "user-hour-lim":
+			limit = parsed
+		case "user-hour-rem":
+			remaining = parsed
+		}
+	}
+	if limit <= 0 || remaining < 0 {
+		return 0, 0, false
+	}
+	return limit, remaining, true
+}
+
+// resolveRateLimitReserve converts a threshold ("200" or "10%") into a request count.
+func resolveRateLimitReserve(threshold string, limit int) int {
+	threshold = strings.TrimSpace(threshold)
+	if percent, ok := strings.CutSuffix(threshold, "%"); ok {
+		parsed, err := strconv.ParseFloat(strings.TrimSpace(percent), 64)
+		if err != nil || parsed < 0 || parsed > 100 {
+			return 0
+		}
+		return int(math.Ceil(float64(limit) * parsed / 100))
+	}
+	parsed, err := strconv.Atoi(threshold)
+	if err != nil || parsed < 0 {
+		return 0
+	}
+	return min(parsed, limit)
+}
+
+func validRateLimitThreshold(value string) bool {
+	value = strings.TrimSpace(value)
+	if percent, ok := strings.CutSuffix(value, "%"); ok {
+		parsed, err := strconv.ParseFloat(strings.TrimSpace(percent), 64)
+		return err == nil && parsed >= 0 && parsed <= 100
+	}
+	parsed, err := strconv.Atoi(value)
+	return err == nil && parsed >= 0
+}
+
+// resolveRateLimitWarnThreshold returns the low-quota threshold.
+// Precedence: env > config > default (10% of the hourly limit).
+func resolveRateLimitWarnThreshold() string {
+	if override, ok := envValue(rateLimitWarnThresholdEnvVar); ok && validRateLimitThreshold(override) {
+		return override
+	}
+	if cfg := loadConfig(); cfg != nil {
+		if value := strings.TrimSpace(cfg.RateLimitWarnThreshold); validRateLimitThreshold(value) {
+			return value
+		}
+	}
+	return defaultRateLimitWarnThreshold
+}
+
+// resolveRateLimitShared reports whether pacing state is shared across processes.
+// Precedence: env > config.
+func resolveRateLimitShared() bool {
+	if override, ok := envValue(rateLimitSharedEnvVar); ok {
+		return isTruthyValue(override)
+	}
+	if cfg := loadConfig(); cfg != nil {
+		return isTruthyValue(cfg.RateLimitShared)
+	}
+	return false
+}
+
+func isTruthyValue(value string) bool {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "1", "t", "true", "yes", "y", "on":
+		return true
+	default:
+		return false
+	}
+}
+
+func rateLimitStateDir() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("resolve home dir: %w", err)
+	}
+	return filepath.Join(home, ".asc", "cache", "ratelimit"), nil
+}
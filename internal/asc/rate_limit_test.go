@@ -0,0 +1,204 @@
+package asc
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newTestRateLimiter(t *testing.T, threshold string) (*rateLimiter, *time.Time, *[]time.Duration) {
+	t.Helper()
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	var sleeps []time.Duration
+	limiter := &rateLimiter{
+		threshold: threshold,
+		now:       func() time.Time { return now },
+		sleep: func(_ context.Context, d time.Duration) error {
+			sleeps = append(sleeps, d)
+			now = now.Add(d)
+			return nil
+		},
+	}
+	return limiter, &now, &sleeps
+}
+
+func captureRateLimitWarnings(t *testing.T) *bytes.Buffer {
+	t.Helper()
+	var buf bytes.Buffer
+	previous := rateLimitWarningWriter
+	rateLimitWarningWriter = &buf
+	t.Cleanup(func() { rateLimitWarningWriter = previous })
+	return &buf
+}
+
+func rateLimitHeaders(value string) http.Header {
+	return http.Header{rateLimitHeader: []string{value}}
+}
+
+func TestParseRateLimitHeader(t *testing.T) {
+	tests := []struct {
+		name          string
+		value         string
+		wantLimit     int
+		wantRemaining int
+		wantOK        bool
+	}{
+		{name: "standard", value: "user-hour-lim:3600;user-hour-rem:3598;", wantLimit: 3600, wantRemaining: 3598, wantOK: true},
+		{name: "whitespace and order", value: " user-hour-rem: 10 ; user-hour-lim: 500 ", wantLimit: 500, wantRemaining: 10, wantOK: true},
+		{name: "exhausted", value: "user-hour-lim:3600;user-hour-rem:0;", wantLimit: 3600, wantRemaining: 0, wantOK: true},
+		{name: "empty", value: ""},
+		{name: "missing remaining", value: "user-hour-lim:3600;"},
+		{name: "garbage", value: "user-hour-lim:many;user-hour-rem:few"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limit, remaining, ok := parseRateLimitHeader(tt.value)
+			if ok != tt.wantOK || limit != tt.wantLimit || remaining != tt.wantRemaining {
+				t.Fatalf("parseRateLimitHeader(%q) = (%d, %d, %t), want (%d, %d, %t)",
+					tt.value, limit, remaining, ok, tt.wantLimit, tt.wantRemaining, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestResolveRateLimitReserve(t *testing.T) {
+	tests := []struct {
+		threshold string
+		limit     int
+		want      int
+	}{
+		{threshold: "10%", limit: 3600, want: 360},
+		{threshold: "0.5%", limit: 3600, want: 18},
+		{threshold: "200", limit: 3600, want: 200},
+		{threshold: "5000", limit: 3600, want: 3600},
+		{threshold: "0", limit: 3600, want: 0},
+		{threshold: "nope", limit: 3600, want: 0},
+		{threshold: "150%", limit: 3600, want: 0},
+	}
+
+	for _, tt := range tests {
+		if got := resolveRateLimitReserve(tt.threshold, tt.limit); got != tt.want {
+			t.Fatalf("resolveRateLimitReserve(%q, %d) = %d, want %d", tt.threshold, tt.limit, got, tt.want)
+		}
+	}
+}
+
+func TestRateLimiter_BurstsWhileQuotaIsHealthy(t *testing.T) {
+	captureRateLimitWarnings(t)
+	limiter, _, sleeps := newTestRateLimiter(t, "10%")
+
+	limiter.observe(rateLimitHeaders("user-hour-lim:3600;user-hour-rem:3000;"), http.StatusOK)
+	for range 50 {
+		if err := limiter.wait(context.Background()); err != nil {
+			t.Fatalf("wait() error: %v", err)
+		}
+	}
+	if len(*sleeps) != 0 {
+		t.Fatalf("expected no pacing with healthy quota, got %v", *sleeps)
+	}
+}
+
+func TestRateLimiter_PacesBelowThresholdAndWarnsOnce(t *testing.T) {
+	warnings := captureRateLimitWarnings(t)
+	limiter, _, sleeps := newTestRateLimiter(t, "10%")
+
+	for range 2 {
+		limiter.observe(rateLimitHeaders("user-hour-lim:3600;user-hour-rem:100;"), http.StatusOK)
+	}
+	for range 3 {
+		if err := limiter.wait(context.Background()); err != nil {
+			t.Fatalf("wait() error: %v", err)
+		}
+	}
+
+	// 3600 requests/hour is one request per second once the burst is exhausted.
+	want := []time.Duration{time.Second, time.Second, time.Second}
+	if len(*sleeps) != len(want) {
+		t.Fatalf("expected %d paced waits, got %v", len(want), *sleeps)
+	}
+	for i, got := range *sleeps {
+		if got != want[i] {
+			t.Fatalf("sleep[%d] = %s, want %s", i, got, want[i])
+		}
+	}
+
+	if count := strings.Count(warnings.String(), "quota is low"); count != 1 {
+		t.Fatalf("expected exactly one low-quota warning, got %d: %q", count, warnings.String())
+	}
+}
+
+func TestRateLimiter_TooManyRequestsDrainsBurst(t *testing.T) {
+	captureRateLimitWarnings(t)
+	limiter, _, sleeps := newTestRateLimiter(t, "0")
+
+	limiter.observe(rateLimitHeaders("user-hour-lim:3600;user-hour-rem:3000;"), http.StatusOK)
+	limiter.observe(rateLimitHeaders("user-hour-lim:3600;user-hour-rem:3000;"), http.StatusTooManyRequests)
+	if err := limiter.wait(context.Background()); err != nil {
+		t.Fatalf("wait() error: %v", err)
+	}
+	if len(*sleeps) != 1 {
+		t.Fatalf("expected 429 to force pacing, got %v", *sleeps)
+	}
+}
+
+func TestRateLimiter_NoHeaderMeansNoPacing(t *testing.T) {
+	limiter, _, sleeps := newTestRateLimiter(t, "10%")
+
+	limiter.observe(http.Header{}, http.StatusOK)
+	if err := limiter.wait(context.Background()); err != nil {
+		t.Fatalf("wait() error: %v", err)
+	}
+	if len(*sleeps) != 0 {
+		t.Fatalf("expected no pacing without quota information, got %v", *sleeps)
+	}
+	if _, ok := limiter.status(); ok {
+		t.Fatal("expected no status before X-Rate-Limit is observed")
+	}
+}
+
+func TestRateLimiter_SharedStateAcrossLimiters(t *testing.T) {
+	captureRateLimitWarnings(t)
+	statePath := filepath.Join(t.TempDir(), "scope.json")
+
+	first, _, _ := newTestRateLimiter(t, "10%")
+	first.statePath = statePath
+	first.observe(rateLimitHeaders("user-hour-lim:3600;user-hour-rem:50;"), http.StatusOK)
+
+	second, _, sleeps := newTestRateLimiter(t, "10%")
+	second.statePath = statePath
+	status, ok := second.status()
+	if !ok || status.Limit != 3600 || status.Remaining != 50 {
+		t.Fatalf("expected shared status from first limiter, got %+v (ok=%t)", status, ok)
+	}
+	if err := second.wait(context.Background()); err != nil {
+		t.Fatalf("wait() error: %v", err)
+	}
+	if len(*sleeps) != 1 {
+		t.Fatalf("expected second limiter to pace from shared state, got %v", *sleeps)
+	}
+}
+
+func TestClientRateLimitStatus(t *testing.T) {
+	resp := jsonResponse(http.StatusOK, `{"data":[]}`)
+	resp.Header.Set(rateLimitHeader, "user-hour-lim:3600;user-hour-rem:3595;")
+	client := newTestClient(t, nil, resp)
+
+	if _, ok := client.RateLimitStatus(); ok {
+		t.Fatal("expected no status before any request")
+	}
+	if _, err := client.do(context.Background(), http.MethodGet, "/v1/apps", nil); err != nil {
+		t.Fatalf("do() error: %v", err)
+	}
+	status, ok := client.RateLimitStatus()
+	if !ok {
+		t.Fatal("expected status after request")
+	}
+	if status.Limit != 3600 || status.Remaining != 3595 {
+		t.Fatalf("unexpected status: %+v", status)
+	}
+}
@@ -0,0 +1,367 @@
+package asc
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/config"
+)
+
+const (
+	responseCacheTTLEnvVar = "ASC_CACHE_TTL"
+	responseCacheDirEnvVar = "ASC_CACHE_DIR"
+	responseCacheVersion   = 1
+)
+
+var responseCacheOverride struct {
+	mu       sync.RWMutex
+	disabled *bool
+}
+
+// SetResponseCacheDisabledOverride sets an explicit response-cache override.
+// When set to true, the on-disk response cache is bypassed for reads and writes.
+// When unset (nil), behavior falls back to env/config.
+func SetResponseCacheDisabledOverride(value *bool) {
+	responseCacheOverride.mu.Lock()
+	defer responseCacheOverride.mu.Unlock()
+	responseCacheOverride.disabled = value
+}
+
+type responseCacheBypassKey struct{}
+
+// WithoutResponseCache returns a context whose GET requests skip fresh cached
+// responses and always reach App Store Connect. Polling loops use it so they
+// see state changes within the cache TTL. Stale-entry revalidation and cache
+// writes still apply.
+func WithoutResponseCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, responseCacheBypassKey{}, true)
+}
+
+func responseCacheBypassed(ctx context.Context) bool {
+	bypassed, _ := ctx.Value(responseCacheBypassKey{}).(bool)
+	return bypassed
+}
+
+// ResolveResponseCacheTTL returns the freshness window for cached GET responses.
+// A zero duration means the response cache is disabled.
+// Precedence: explicit --no-cache override > ASC_CACHE_TTL > config cache_ttl.
+func ResolveResponseCacheTTL() time.Duration {
+	responseCacheOverride.mu.RLock()
+	disabled := responseCacheOverride.disabled
+	responseCacheOverride.mu.RUnlock()
+	if disabled != nil && *disabled {
+		return 0
+	}
+
+	if override, ok := envValue(responseCacheTTLEnvVar); ok {
+		if override == "" {
+			return 0
+		}
+		parsed, err := config.ParseDurationValue(override)
+		if err != nil {
+			return 0
+		}
+		ttl, _ := parsed.Value()
+		return ttl
+	}
+
+	cfg := loadConfig()
+	if cfg == nil {
+		return 0
+	}
+	ttl, _ := cfg.CacheTTL.Value()
+	return ttl
+}
+
+// ResponseCacheDir returns the directory holding cached API responses.
+// ASC_CACHE_DIR overrides the default ~/.asc/cache/http location.
+func ResponseCacheDir() (string, error) {
+	if override, ok := envValue(responseCacheDirEnvVar); ok && override != "" {
+		return filepath.Clean(override), nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("resolve home dir: %w", err)
+	}
+	return filepath.Join(home, ".asc", "cache", "http"), nil
+}
+
+// responseCache stores GET response bodies on disk, scoped per API key.
+type responseCache struct {
+	dir string
+	ttl time.Duration
+	now func() time.Time
+}
+
+type responseCacheEntry struct {
+	Version      int       `json:"version"`
+	URL          string    `json:"url"`
+	ETag         string    `json:"etag,omitempty"`
+	LastModified string    `json:"lastModified,omitempty"`
+	StoredAt     time.Time `json:"storedAt"`
+	Hits         int       `json:"hits"`
+	Body         []byte    `json:"body"`
+}
+
+// newResponseCache returns a cache scoped to the given credentials, or nil when
+// caching is disabled or the cache directory cannot be resolved.
+func newResponseCache(keyID, issuerID string) *responseCache {
+	ttl := ResolveResponseCacheTTL()
+	if ttl <= 0 {
+		return nil
+	}
+	root, err := ResponseCacheDir()
+	if err != nil {
+		return nil
+	}
+	return &responseCache{
+		dir: filepath.Join(root, credentialScope(keyID, issuerID)),
+		ttl: ttl,
+		now: time.Now,
+	}
+}
+
+// credentialScope derives a stable, non-reversible directory name for a credential
+// so that cached data from one API key is never served to another.
+func credentialScope(keyID, issuerID string) string {
+	sum := sha256.Sum256([]byte(strings.TrimSpace(issuerID) + "\x00" + strings.TrimSpace(keyID)))
+	return hex.EncodeToString(sum[:8])
+}
+
+func (rc *responseCache) entryPath(rawURL string) string {
+	sum := sha256.Sum256([]byte(rawURL))
+	return filepath.Join(rc.dir, hex.EncodeToString(sum[:])+".json")
+}
+
+// load returns the cached entry for rawURL and whether it is still fresh.
+func (rc *responseCache) load(rawURL string) (*responseCacheEntry, bool) {
+	data, err := os.ReadFile(rc.entryPath(rawURL))
+	if err != nil {
+		return nil, false
+	}
+	var entry responseCacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, false
+	}
+	if entry.Version != responseCacheVersion || entry.URL != rawURL {
+		return nil, false
+	}
+	return &entry, rc.now().Sub(entry.StoredAt) < rc.ttl
+}
+
+// hasValidators reports whether the entry can be revalidated with a conditional request.
+func (e *responseCacheEntry) hasValidators() bool {
+	return e != nil && (e.ETag != "" || e.LastModified != "")
+}
+
+func (e *responseCacheEntry) applyConditionalHeaders(req *http.Request) {
+	if e.ETag != "" {
+		req.Header.Set("If-None-Match", e.ETag)
+	}
+	if e.LastModified != "" {
+		req.Header.Set("If-Modified-Since", e.LastModified)
+	}
+}
+
+// recordHit bumps the hit counter for a fresh entry served from disk.
+func (rc *responseCache) recordHit(entry *responseCacheEntry) {
+	entry.Hits++
+	_ = rc.write(entry)
+}
+
+// revalidated refreshes the freshness window after a 304 Not Modified response.
+func (rc *responseCache) revalidated(entry *responseCacheEntry, header http.Header) {
+	entry.StoredAt = rc.now()
+	entry.Hits++
+	if etag := strings.TrimSpace(header.Get("ETag")); etag != "" {
+		entry.ETag = etag
+	}
+	if lastModified := strings.TrimSpace(header.Get("Last-Modified")); lastModified != "" {
+		entry.LastModified = lastModified
+	}
+	_ = rc.write(entry)
+}
+
+func (rc *responseCache) store(rawURL string, header http.Header, body []byte) {
+	if strings.Contains(strings.ToLower(header.Get("Cache-Control")), "no-store") {
+		return
+	}
+	_ = rc.write(&responseCacheEntry{
+		Version:      responseCacheVersion,
+		URL:          rawURL,
+		ETag:         strings.TrimSpace(header.Get("ETag")),
+		LastModified: strings.TrimSpace(header.Get("Last-Modified")),
+		StoredAt:     rc.now(),
+		Body:         body,
+	})
+}
+
+// invalidate drops every cached response for the credential scope. Mutations can
+// affect arbitrary related resources, so the whole scope is treated as stale.
+func (rc *responseCache) invalidate() {
+	_ = os.RemoveAll(rc.dir)
+}
+
+func (rc *responseCache) write(entry *responseCacheEntry) error {
+	if err := os.MkdirAll(rc.dir, 0o700); err != nil {
+		return err
+	}
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(rc.dir, ".entry-*.tmp")
+	if err != nil {
+		return err
+	}
+	tmpPath := tmp.Name()
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmpPath)
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmpPath)
+		return err
+	}
+	if err := os.Rename(tmpPath, rc.entryPath(entry.URL)); err != nil {
+		_ = os.Remove(tmpPath)
+		return err
+	}
+	return nil
+}
+
+// ResponseCacheStats summarizes the on-disk response cache.
+type ResponseCacheStats struct {
+	Dir           string     `json:"dir"`
+	Enabled       bool       `json:"enabled"`
+	TTL           string     `json:"ttl,omitempty"`
+	Scopes        int        `json:"scopes"`
+	Entries       int        `json:"entries"`
+	Fresh         int        `json:"fresh"`
+	Stale         int        `json:"stale"`
+	Revalidatable int        `json:"revalidatable"`
+	Hits          int        `json:"hits"`
+	SizeBytes     int64      `json:"sizeBytes"`
+	OldestAt      *time.Time `json:"oldestAt,omitempty"`
+	NewestAt      *time.Time `json:"newestAt,omitempty"`
+}
+
+// ReadResponseCacheStats walks the response cache directory and reports its contents.
+// Freshness is evaluated against the currently resolved TTL.
+func ReadResponseCacheStats() (ResponseCacheStats, error) {
+	dir, err := ResponseCacheDir()
+	if err != nil {
+		return ResponseCacheStats{}, err
+	}
+	ttl := ResolveResponseCacheTTL()
+	stats := ResponseCacheStats{Dir: dir, Enabled: ttl > 0}
+	if ttl > 0 {
+		stats.TTL = ttl.String()
+	}
+
+	scopes, err := os.ReadDir(dir)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return stats, nil
+		}
+		return ResponseCacheStats{}, fmt.Errorf("read cache dir: %w", err)
+	}
+
+	now := time.Now()
+	for _, scope := range scopes {
+		if !scope.IsDir() {
+			continue
+		}
+		stats.Scopes++
+		files, err := os.ReadDir(filepath.Join(dir, scope.Name()))
+		if err != nil {
+			return ResponseCacheStats{}, fmt.Errorf("read cache scope: %w", err)
+		}
+		for _, file := range files {
+			if file.IsDir() || filepath.Ext(file.Name()) != ".json" {
+				continue
+			}
+			path := filepath.Join(dir, scope.Name(), file.Name())
+			info, err := file.Info()
+			if err != nil {
+				continue
+			}
+			data, err := os.ReadFile(path)
+			if err != nil {
+				continue
+			}
+			var entry responseCacheEntry
+			if err := json.Unmarshal(data, &entry); err != nil {
+				continue
+			}
+			stats.Entries++
+			stats.SizeBytes += info.Size()
+			stats.Hits += entry.Hits
+			if ttl > 0 && now.Sub(entry.StoredAt) < ttl {
+				stats.Fresh++
+			} else {
+				stats.Stale++
+			}
+			if entry.hasValidators() {
+				stats.Revalidatable++
+			}
+			storedAt := entry.StoredAt
+			if stats.OldestAt == nil || storedAt.Before(*stats.OldestAt) {
+				stats.OldestAt = &storedAt
+			}
+			if stats.NewestAt == nil || storedAt.After(*stats.NewestAt) {
+				stats.NewestAt = &storedAt
+			}
+		}
+	}
+	return stats, nil
+}
+
+// ClearResponseCache removes every cached response and returns the number of
+// entries that were deleted.
+func ClearResponseCache() (int, error) {
+	dir, err := ResponseCacheDir()
+	if err != nil {
+		return 0, err
+	}
+	scopes, err := os.ReadDir(dir)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("read cache dir: %w", err)
+	}
+
+	removed := 0
+	for _, scope := range scopes {
+		if !scope.IsDir() {
+			continue
+		}
+		scopeDir := filepath.Join(dir, scope.Name())
+		files, err := os.ReadDir(scopeDir)
+		if err != nil {
+			return removed, fmt.Errorf("read cache scope: %w", err)
+		}
+		for _, file := range files {
+			if !file.IsDir() && filepath.Ext(file.Name()) == ".json" {
+				removed++
+			}
+		}
+		if err := os.RemoveAll(scopeDir); err != nil {
+			return removed, fmt.Errorf("remove cache scope: %w", err)
+		}
+	}
+	return removed, nil
+}
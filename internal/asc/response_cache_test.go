@@ -0,0 +1,250 @@
+package asc
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/config"
+)
+
+func newTestResponseCache(t *testing.T, ttl time.Duration) *responseCache {
+	t.Helper()
+	return &responseCache{
+		dir: t.TempDir(),
+		ttl: ttl,
+		now: time.Now,
+	}
+}
+
+func TestResponseCache_ServesFreshGETFromDisk(t *testing.T) {
+	var requests int
+	client := newTestClient(t, func(req *http.Request) {
+		requests++
+	}, jsonResponse(http.StatusOK, `{"data":[{"type":"apps","id":"1"}]}`))
+	client.responseCache = newTestResponseCache(t, time.Hour)
+
+	first, err := client.do(context.Background(), http.MethodGet, "/v1/apps?limit=1", nil)
+	if err != nil {
+		t.Fatalf("first do() error: %v", err)
+	}
+	second, err := client.do(context.Background(), http.MethodGet, "/v1/apps?limit=1", nil)
+	if err != nil {
+		t.Fatalf("second do() error: %v", err)
+	}
+
+	if requests != 1 {
+		t.Fatalf("expected 1 network request, got %d", requests)
+	}
+	if string(first) != string(second) {
+		t.Fatalf("expected cached body %q, got %q", first, second)
+	}
+}
+
+func TestResponseCache_WithoutResponseCacheSkipsFreshEntries(t *testing.T) {
+	var requests int
+	client := newTestClient(t, func(req *http.Request) {
+		requests++
+	},
+		jsonResponse(http.StatusOK, `{"data":{"type":"builds","id":"1","attributes":{"processingState":"PROCESSING"}}}`),
+		jsonResponse(http.StatusOK, `{"data":{"type":"builds","id":"1","attributes":{"processingState":"VALID"}}}`),
+	)
+	client.responseCache = newTestResponseCache(t, time.Hour)
+
+	if _, err := client.do(context.Background(), http.MethodGet, "/v1/builds/1", nil); err != nil {
+		t.Fatalf("first do() error: %v", err)
+	}
+	polled, err := PollUntil(context.Background(), time.Millisecond, func(ctx context.Context) (string, bool, error) {
+		body, err := client.do(ctx, http.MethodGet, "/v1/builds/1", nil)
+		return string(body), true, err
+	})
+	if err != nil {
+		t.Fatalf("polled do() error: %v", err)
+	}
+
+	if requests != 2 {
+		t.Fatalf("expected polling to reach the API, got %d requests", requests)
+	}
+	if !strings.Contains(polled, "VALID") {
+		t.Fatalf("expected fresh body while polling, got %q", polled)
+	}
+	cached, err := client.do(context.Background(), http.MethodGet, "/v1/builds/1", nil)
+	if err != nil {
+		t.Fatalf("cached do() error: %v", err)
+	}
+	if requests != 2 || string(cached) != polled {
+		t.Fatalf("expected the polled response to be cached, got %q after %d requests", cached, requests)
+	}
+}
+
+func TestResponseCache_RevalidatesStaleEntryWithETag(t *testing.T) {
+	stored := jsonResponse(http.StatusOK, `{"data":{"type":"apps","id":"1"}}`)
+	stored.Header.Set("ETag", `"v1"`)
+	notModified := &http.Response{
+		StatusCode: http.StatusNotModified,
+		Header:     http.Header{},
+		Body:       http.NoBody,
+	}
+
+	var conditional string
+	client := newTestClient(t, func(req *http.Request) {
+		conditional = req.Header.Get("If-None-Match")
+	}, stored, notModified)
+	cache := newTestResponseCache(t, time.Minute)
+	client.responseCache = cache
+
+	if _, err := client.do(context.Background(), http.MethodGet, "/v1/apps/1", nil); err != nil {
+		t.Fatalf("first do() error: %v", err)
+	}
+	if conditional != "" {
+		t.Fatalf("expected no conditional header on first request, got %q", conditional)
+	}
+
+	cache.now = func() time.Time { return time.Now().Add(2 * time.Minute) }
+	body, err := client.do(context.Background(), http.MethodGet, "/v1/apps/1", nil)
+	if err != nil {
+		t.Fatalf("revalidated do() error: %v", err)
+	}
+	if conditional != `"v1"` {
+		t.Fatalf("expected If-None-Match %q, got %q", `"v1"`, conditional)
+	}
+	if string(body) != `{"data":{"type":"apps","id":"1"}}` {
+		t.Fatalf("expected cached body after 304, got %q", body)
+	}
+
+	entry, fresh := cache.load(BaseURL + "/v1/apps/1")
+	if entry == nil || !fresh {
+		t.Fatal("expected revalidated entry to be fresh again")
+	}
+	if entry.Hits != 1 {
+		t.Fatalf("expected 1 recorded hit, got %d", entry.Hits)
+	}
+}
+
+func TestResponseCache_MutationInvalidatesScope(t *testing.T) {
+	var requests int
+	client := newTestClient(t, func(req *http.Request) {
+		requests++
+	},
+		jsonResponse(http.StatusOK, `{"data":[]}`),
+		jsonResponse(http.StatusOK, `{"data":{"type":"apps","id":"1"}}`),
+		jsonResponse(http.StatusOK, `{"data":[]}`),
+	)
+	client.responseCache = newTestResponseCache(t, time.Hour)
+
+	if _, err := client.do(context.Background(), http.MethodGet, "/v1/apps", nil); err != nil {
+		t.Fatalf("GET error: %v", err)
+	}
+	if _, err := client.do(context.Background(), http.MethodPatch, "/v1/apps/1", nil); err != nil {
+		t.Fatalf("PATCH error: %v", err)
+	}
+	if _, err := client.do(context.Background(), http.MethodGet, "/v1/apps", nil); err != nil {
+		t.Fatalf("GET after PATCH error: %v", err)
+	}
+	if requests != 3 {
+		t.Fatalf("expected cache to be invalidated by PATCH (3 requests), got %d", requests)
+	}
+}
+
+func TestResponseCache_DoesNotCacheErrors(t *testing.T) {
+	var requests int
+	client := newTestClient(t, func(req *http.Request) {
+		requests++
+	},
+		jsonResponse(http.StatusNotFound, `{"errors":[{"code":"NOT_FOUND","title":"Not found"}]}`),
+		jsonResponse(http.StatusNotFound, `{"errors":[{"code":"NOT_FOUND","title":"Not found"}]}`),
+	)
+	client.responseCache = newTestResponseCache(t, time.Hour)
+
+	for range 2 {
+		if _, err := client.do(context.Background(), http.MethodGet, "/v1/apps/missing", nil); err == nil {
+			t.Fatal("expected error, got nil")
+		}
+	}
+	if requests != 2 {
+		t.Fatalf("expected error responses to bypass the cache, got %d requests", requests)
+	}
+}
+
+func TestResolveResponseCacheTTL(t *testing.T) {
+	t.Cleanup(func() {
+		SetResponseCacheDisabledOverride(nil)
+		resetConfigCacheForTest()
+	})
+	setConfigLoaderForTest(func() (*config.Config, error) {
+		return &config.Config{CacheTTL: config.DurationValue{Raw: "5m"}}, nil
+	})
+
+	tests := []struct {
+		name     string
+		env      *string
+		disabled bool
+		want     time.Duration
+	}{
+		{name: "config fallback", want: 5 * time.Minute},
+		{name: "env duration overrides config", env: new("30s"), want: 30 * time.Second},
+		{name: "env seconds", env: new("90"), want: 90 * time.Second},
+		{name: "empty env disables", env: new(""), want: 0},
+		{name: "invalid env disables", env: new("soon"), want: 0},
+		{name: "no-cache override wins", env: new("30s"), disabled: true, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.env != nil {
+				t.Setenv(responseCacheTTLEnvVar, *tt.env)
+			}
+			if tt.disabled {
+				value := true
+				SetResponseCacheDisabledOverride(&value)
+			} else {
+				SetResponseCacheDisabledOverride(nil)
+			}
+			if got := ResolveResponseCacheTTL(); got != tt.want {
+				t.Fatalf("ResolveResponseCacheTTL() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResponseCacheStatsAndClear(t *testing.T) {
+	root := t.TempDir()
+	t.Setenv(responseCacheDirEnvVar, root)
+	t.Setenv(responseCacheTTLEnvVar, "1h")
+
+	cache := newResponseCache("KEY123", "ISS456")
+	if cache == nil {
+		t.Fatal("expected response cache to be enabled")
+	}
+	header := http.Header{"Etag": []string{`"abc"`}}
+	cache.store(BaseURL+"/v1/apps", header, []byte(`{"data":[]}`))
+	cache.store(BaseURL+"/v1/builds", http.Header{}, []byte(`{"data":[]}`))
+
+	stats, err := ReadResponseCacheStats()
+	if err != nil {
+		t.Fatalf("ReadResponseCacheStats() error: %v", err)
+	}
+	if !stats.Enabled || stats.Scopes != 1 || stats.Entries != 2 || stats.Fresh != 2 || stats.Revalidatable != 1 {
+		t.Fatalf("unexpected stats: %+v", stats)
+	}
+	if stats.SizeBytes <= 0 || stats.OldestAt == nil || stats.NewestAt == nil {
+		t.Fatalf("expected size and timestamps, got %+v", stats)
+	}
+
+	removed, err := ClearResponseCache()
+	if err != nil {
+		t.Fatalf("ClearResponseCache() error: %v", err)
+	}
+	if removed != 2 {
+		t.Fatalf("expected 2 removed entries, got %d", removed)
+	}
+	stats, err = ReadResponseCacheStats()
+	if err != nil {
+		t.Fatalf("ReadResponseCacheStats() after clear error: %v", err)
+	}
+	if stats.Entries != 0 {
+		t.Fatalf("expected empty cache after clear, got %+v", stats)
+	}
+}
@@ -110,7 +110,7 @@ func (c *Client) GetAppStoreReviewDetailAttachmentsRelationships(ctx context.Con
 
 	path := fmt.Sprintf("/v1/appStoreReviewDetails/%s/relationships/appStoreReviewAttachments", detailID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("appStoreReviewAttachmentsRelationships: %w", err)
 		}
 		path = query.nextURL
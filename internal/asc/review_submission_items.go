@@ -262,7 +262,7 @@ func (c *Client) GetReviewSubmissionItems(ctx context.Context, submissionID stri
 
 	var path string
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("reviewSubmissionItems: %w", err)
 		}
 		path = query.nextURL
@@ -1,6 +1,8 @@
 package asc
 
 import (
+	"encoding/csv"
+	"io"
 	"os"
 
 	"github.com/olekukonko/tablewriter"
@@ -12,8 +14,13 @@ import (
 // Headers preserve their original casing and are center-aligned.
 // Data rows are left-aligned for readability.
 func RenderTable(headers []string, rows [][]string) {
+	RenderTableTo(os.Stdout, headers, rows)
+}
+
+// RenderTableTo writes a bordered Unicode table to w.
+func RenderTableTo(w io.Writer, headers []string, rows [][]string) {
 	table := tablewriter.NewTable(
-		os.Stdout,
+		w,
 		tablewriter.WithConfig(tablewriter.Config{
 			Header: tw.CellConfig{
 				Formatting: tw.CellFormatting{
@@ -35,8 +42,13 @@ func RenderTable(headers []string, rows [][]string) {
 // Headers preserve their original casing. Data rows are left-aligned.
 // Pipe characters in cell values are escaped automatically by the renderer.
 func RenderMarkdown(headers []string, rows [][]string) {
+	RenderMarkdownTo(os.Stdout, headers, rows)
+}
+
+// RenderMarkdownTo writes a Markdown-formatted table to w.
+func RenderMarkdownTo(w io.Writer, headers []string, rows [][]string) {
 	table := tablewriter.NewTable(
-		os.Stdout,
+		w,
 		tablewriter.WithRenderer(renderer.NewMarkdown()),
 		tablewriter.WithConfig(tablewriter.Config{
 			Header: tw.CellConfig{
@@ -54,3 +66,38 @@ func RenderMarkdown(headers []string, rows [][]string) {
 	_ = table.Bulk(rows)
 	_ = table.Render()
 }
+
+// RenderCSV writes headers and rows to stdout as RFC 4180 comma-separated values.
+func RenderCSV(headers []string, rows [][]string) error {
+	return RenderCSVTo(os.Stdout, headers, rows)
+}
+
+// RenderCSVTo writes headers and rows to w as comma-separated values.
+func RenderCSVTo(w io.Writer, headers []string, rows [][]string) error {
+	return renderDelimited(w, ',', headers, rows)
+}
+
+// RenderTSV writes headers and rows to stdout as tab-separated values.
+func RenderTSV(headers []string, rows [][]string) error {
+	return RenderTSVTo(os.Stdout, headers, rows)
+}
+
+// RenderTSVTo writes headers and rows to w as tab-separated values.
+func RenderTSVTo(w io.Writer, headers []string, rows [][]string) error {
+	return renderDelimited(w, '\t', headers, rows)
+}
+
+func renderDelimited(w io.Writer, comma rune, headers []string, rows [][]string) error {
+	writer := csv.NewWriter(w)
+	writer.Comma = comma
+	if err := writer.Write(headers); err != nil {
+		return err
+	}
+	for _, row := range rows {
+		if err := writer.Write(row); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
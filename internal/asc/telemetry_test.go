@@ -0,0 +1,111 @@
+package asc
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/telemetry"
+)
+
+func TestGetApps_RecordsTelemetryForRetriesAndRateLimits(t *testing.T) {
+	t.Setenv("ASC_MAX_RETRIES", "1")
+	t.Setenv("ASC_BASE_DELAY", "1ms")
+	t.Setenv("ASC_MAX_DELAY", "1ms")
+
+	path := filepath.Join(t.TempDir(), "otel.jsonl")
+	telemetry.Init(telemetry.Options{File: path})
+	t.Cleanup(func() { telemetry.Init(telemetry.Options{}) })
+
+	limited := jsonResponse(http.StatusTooManyRequests, `{"errors":[{"title":"Rate limit"}]}`)
+	limited.Header.Set("Retry-After", "0")
+	client := newTestClient(t, nil, limited, jsonResponse(http.StatusOK, `{"data":[]}`))
+
+	ctx, command := telemetry.Start(context.Background(), "asc apps list", telemetry.SpanKindInternal)
+	if _, err := client.GetApps(ctx); err != nil {
+		t.Fatalf("GetApps() error: %v", err)
+	}
+	command.End(nil)
+	if err := telemetry.Shutdown(context.Background()); err != nil {
+		t.Fatalf("Shutdown() error: %v", err)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("Open() error: %v", err)
+	}
+	defer file.Close()
+	scanner := bufio.NewScanner(file)
+
+	type span struct {
+		Name         string `json:"name"`
+		SpanID       string `json:"spanId"`
+		ParentSpanID string `json:"parentSpanId"`
+	}
+	var traces struct {
+		ResourceSpans []struct {
+			ScopeSpans []struct {
+				Spans []span `json:"spans"`
+			} `json:"scopeSpans"`
+		} `json:"resourceSpans"`
+	}
+	var metrics struct {
+		ResourceMetrics []struct {
+			ScopeMetrics []struct {
+				Metrics []struct {
+					Name string `json:"name"`
+					Sum  struct {
+						DataPoints []struct {
+							AsInt string `json:"asInt"`
+						} `json:"dataPoints"`
+					} `json:"sum"`
+				} `json:"metrics"`
+			} `json:"scopeMetrics"`
+		} `json:"resourceMetrics"`
+	}
+	for _, target := range []any{&traces, &metrics} {
+		if !scanner.Scan() {
+			t.Fatalf("missing export line: %v", scanner.Err())
+		}
+		if err := json.Unmarshal(scanner.Bytes(), target); err != nil {
+			t.Fatalf("unmarshal export line: %v", err)
+		}
+	}
+
+	counts := map[string]int{}
+	var commandID string
+	spans := traces.ResourceSpans[0].ScopeSpans[0].Spans
+	for _, s := range spans {
+		counts[s.Name]++
+		if s.Name == "asc apps list" {
+			commandID = s.SpanID
+		}
+	}
+	if counts["HTTP GET"] != 2 || counts["retry backoff"] != 1 {
+		t.Fatalf("unexpected spans %v", counts)
+	}
+	for _, s := range spans {
+		if s.Name != "asc apps list" && s.ParentSpanID != commandID {
+			t.Fatalf("expected %q to be a child of the command span", s.Name)
+		}
+	}
+
+	values := map[string]string{}
+	for _, metric := range metrics.ResourceMetrics[0].ScopeMetrics[0].Metrics {
+		values[metric.Name] = metric.Sum.DataPoints[0].AsInt
+	}
+	want := map[string]string{
+		telemetry.CounterHTTPRequests:    "2",
+		telemetry.CounterHTTPRetries:     "1",
+		telemetry.CounterHTTPRateLimited: "1",
+	}
+	for name, value := range want {
+		if values[name] != value {
+			t.Fatalf("counter %s = %q, want %q (all: %v)", name, values[name], value, values)
+		}
+	}
+}
@@ -34,7 +34,7 @@ func (c *Client) GetAppInfoTerritoryAgeRatings(ctx context.Context, appInfoID st
 
 	path := fmt.Sprintf("/v1/appInfos/%s/territoryAgeRatings", appInfoID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("territoryAgeRatings: %w", err)
 		}
 		path = query.nextURL
@@ -10,9 +10,13 @@ import (
 	"hash"
 	"io"
 	"net/http"
+	"net/url"
 	"os"
 	"strings"
 	"sync"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/telemetry"
 )
 
 // UploadOptions configure how upload operations are executed.
@@ -193,7 +197,12 @@ func executeUploadOperation(ctx context.Context, file *os.File, task uploadTask,
 		method = http.MethodPut
 	}
 
+	ctx, span := telemetry.Start(ctx, "upload part", telemetry.SpanKindClient, uploadPartAttrs(task.index, task.op)...)
+	debugEnabled := resolveDebugSettings().enabled
+	attempt := 0
 	_, err := WithRetry(ctx, func() (struct{}, error) {
+		attempt++
+		start := time.Now()
 		reader := io.NewSectionReader(file, task.op.Offset, task.op.Length)
 		req, err := http.NewRequestWithContext(ctx, method, task.op.URL, reader)
 		if err != nil {
@@ -205,6 +214,9 @@ func executeUploadOperation(ctx context.Context, file *os.File, task uploadTask,
 		}
 
 		resp, err := uploadOpts.Client.Do(req)
+		if debugEnabled {
+			logUploadPart(task.index, task.op, attempt, resp, time.Since(start), err)
+		}
 		if err != nil {
 			if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
 				return struct{}{}, err
@@ -214,11 +226,17 @@ func executeUploadOperation(ctx context.Context, file *os.File, task uploadTask,
 		defer resp.Body.Close()
 		_, _ = io.Copy(io.Discard, resp.Body)
 
+		span.SetAttributes(telemetry.Int("http.response.status_code", int64(resp.StatusCode)))
 		if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable {
+			if resp.StatusCode == http.StatusTooManyRequests {
+				telemetry.Add(telemetry.CounterHTTPRateLimited, 1)
+			}
 			retryAfter := parseRetryAfterHeader(resp.Header.Get("Retry-After"))
 			return struct{}{}, &RetryableError{
 				Err:        buildRetryableError(resp.StatusCode, retryAfter, nil),
 				RetryAfter: retryAfter,
+				StatusCode: resp.StatusCode,
+				RequestID:  responseRequestID(resp.Header),
 			}
 		}
 		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
@@ -227,12 +245,49 @@ func executeUploadOperation(ctx context.Context, file *os.File, task uploadTask,
 
 		return struct{}{}, nil
 	}, uploadOpts.RetryOpts)
+	span.End(err)
 	if err != nil {
 		return fmt.Errorf("upload operation %d: %w", task.index, err)
 	}
 	return nil
 }
 
+// logUploadPart records one upload attempt. Only the host of the signed URL
+// is logged.
+func logUploadPart(index int, op UploadOperation, attempt int, resp *http.Response, elapsed time.Duration, err error) {
+	attrs := []any{
+		"event", "upload_part",
+		"part", index,
+		"offset", op.Offset,
+		"length", op.Length,
+		"attempt", attempt,
+		"duration_ms", elapsed.Milliseconds(),
+	}
+	if parsed, parseErr := url.Parse(op.URL); parseErr == nil {
+		attrs = append(attrs, "host", parsed.Host)
+	}
+	if resp != nil {
+		attrs = append(attrs, "status", resp.StatusCode, "request_id", responseRequestID(resp.Header))
+	}
+	if err != nil {
+		attrs = append(attrs, "error", err.Error())
+	}
+	debugLogger.Load().Info("↑ Upload part", attrs...)
+}
+
+// uploadPartAttrs describes an upload operation without its signed URL.
+func uploadPartAttrs(index int, op UploadOperation) []telemetry.Attr {
+	attrs := []telemetry.Attr{
+		telemetry.Int("asc.upload.part", int64(index)),
+		telemetry.Int("asc.upload.offset", op.Offset),
+		telemetry.Int("asc.upload.length", op.Length),
+	}
+	if parsed, err := url.Parse(op.URL); err == nil {
+		attrs = append(attrs, telemetry.String("server.address", parsed.Host))
+	}
+	return attrs
+}
+
 // VerifySourceFileChecksums computes and compares checksums provided by the API.
 func VerifySourceFileChecksums(filePath string, expected *Checksums) (*Checksums, error) {
 	if expected == nil {
@@ -798,7 +798,7 @@ func (c *Client) GetCiProducts(ctx context.Context, opts ...CiProductsOption) (*
 
 	path := "/v1/ciProducts"
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("ciProducts: %w", err)
 		}
 		path = query.nextURL
@@ -860,7 +860,7 @@ func (c *Client) GetCiProductBuildRuns(ctx context.Context, productID string, op
 
 	path := fmt.Sprintf("/v1/ciProducts/%s/buildRuns", productID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("ciProductBuildRuns: %w", err)
 		}
 		path = query.nextURL
@@ -890,7 +890,7 @@ func (c *Client) GetCiProductPrimaryRepositories(ctx context.Context, productID
 
 	path := fmt.Sprintf("/v1/ciProducts/%s/primaryRepositories", productID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("ciProductPrimaryRepositories: %w", err)
 		}
 		path = query.nextURL
@@ -920,7 +920,7 @@ func (c *Client) GetCiProductAdditionalRepositories(ctx context.Context, product
 
 	path := fmt.Sprintf("/v1/ciProducts/%s/additionalRepositories", productID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("ciProductAdditionalRepositories: %w", err)
 		}
 		path = query.nextURL
@@ -958,7 +958,7 @@ func (c *Client) GetCiWorkflows(ctx context.Context, productID string, opts ...C
 
 	path := fmt.Sprintf("/v1/ciProducts/%s/workflows", productID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("ciWorkflows: %w", err)
 		}
 		path = query.nextURL
@@ -1067,7 +1067,7 @@ func (c *Client) GetScmProviders(ctx context.Context, opts ...ScmProvidersOption
 
 	path := "/v1/scmProviders"
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("scmProviders: %w", err)
 		}
 		path = query.nextURL
@@ -1113,7 +1113,7 @@ func (c *Client) GetScmProviderRepositories(ctx context.Context, providerID stri
 
 	path := fmt.Sprintf("/v1/scmProviders/%s/repositories", strings.TrimSpace(providerID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("scmProviderRepositories: %w", err)
 		}
 		path = query.nextURL
@@ -1143,7 +1143,7 @@ func (c *Client) GetScmRepositories(ctx context.Context, opts ...ScmRepositories
 
 	path := "/v1/scmRepositories"
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("scmRepositories: %w", err)
 		}
 		path = query.nextURL
@@ -1207,7 +1207,7 @@ func (c *Client) GetScmGitReferences(ctx context.Context, repositoryID string, o
 
 	path := fmt.Sprintf("/v1/scmRepositories/%s/gitReferences", repositoryID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("scmGitReferences: %w", err)
 		}
 		path = query.nextURL
@@ -1238,7 +1238,7 @@ func (c *Client) GetScmRepositoryGitReferencesRelationships(ctx context.Context,
 
 	path := fmt.Sprintf("/v1/scmRepositories/%s/relationships/gitReferences", repositoryID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("scmRepositoryGitReferencesRelationships: %w", err)
 		}
 		path = query.nextURL
@@ -1268,7 +1268,7 @@ func (c *Client) GetScmRepositoryPullRequests(ctx context.Context, repositoryID
 
 	path := fmt.Sprintf("/v1/scmRepositories/%s/pullRequests", strings.TrimSpace(repositoryID))
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("scmRepositoryPullRequests: %w", err)
 		}
 		path = query.nextURL
@@ -1299,7 +1299,7 @@ func (c *Client) GetScmRepositoryPullRequestsRelationships(ctx context.Context,
 
 	path := fmt.Sprintf("/v1/scmRepositories/%s/relationships/pullRequests", repositoryID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("scmRepositoryPullRequestsRelationships: %w", err)
 		}
 		path = query.nextURL
@@ -1345,7 +1345,7 @@ func (c *Client) GetCiMacOsVersions(ctx context.Context, opts ...CiMacOsVersions
 
 	path := "/v1/ciMacOsVersions"
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("ciMacOsVersions: %w", err)
 		}
 		path = query.nextURL
@@ -1391,7 +1391,7 @@ func (c *Client) GetCiMacOsVersionXcodeVersions(ctx context.Context, macOsVersio
 
 	path := fmt.Sprintf("/v1/ciMacOsVersions/%s/xcodeVersions", macOsVersionID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("ciMacOsVersionXcodeVersions: %w", err)
 		}
 		path = query.nextURL
@@ -1421,7 +1421,7 @@ func (c *Client) GetCiXcodeVersions(ctx context.Context, opts ...CiXcodeVersions
 
 	path := "/v1/ciXcodeVersions"
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("ciXcodeVersions: %w", err)
 		}
 		path = query.nextURL
@@ -1467,7 +1467,7 @@ func (c *Client) GetCiXcodeVersionMacOsVersions(ctx context.Context, xcodeVersio
 
 	path := fmt.Sprintf("/v1/ciXcodeVersions/%s/macOsVersions", xcodeVersionID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("ciXcodeVersionMacOsVersions: %w", err)
 		}
 		path = query.nextURL
@@ -1497,7 +1497,7 @@ func (c *Client) GetCiBuildRuns(ctx context.Context, workflowID string, opts ...
 
 	path := fmt.Sprintf("/v1/ciWorkflows/%s/buildRuns", workflowID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("ciBuildRuns: %w", err)
 		}
 		path = query.nextURL
@@ -1552,7 +1552,7 @@ func (c *Client) GetCiBuildRunBuilds(ctx context.Context, buildRunID string, opt
 
 	path := fmt.Sprintf("/v1/ciBuildRuns/%s/builds", buildRunID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("ciBuildRunBuilds: %w", err)
 		}
 		path = query.nextURL
@@ -2302,7 +2302,7 @@ func (c *Client) GetCiBuildActions(ctx context.Context, buildRunID string, opts
 
 	path := fmt.Sprintf("/v1/ciBuildRuns/%s/actions", buildRunID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("ciBuildActions: %w", err)
 		}
 		path = query.nextURL
@@ -2364,7 +2364,7 @@ func (c *Client) GetCiBuildActionArtifacts(ctx context.Context, buildActionID st
 
 	path := fmt.Sprintf("/v1/ciBuildActions/%s/artifacts", buildActionID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("ciArtifacts: %w", err)
 		}
 		path = query.nextURL
@@ -2410,7 +2410,7 @@ func (c *Client) GetCiBuildActionTestResults(ctx context.Context, buildActionID
 
 	path := fmt.Sprintf("/v1/ciBuildActions/%s/testResults", buildActionID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("ciTestResults: %w", err)
 		}
 		path = query.nextURL
@@ -2456,7 +2456,7 @@ func (c *Client) GetCiBuildActionIssues(ctx context.Context, buildActionID strin
 
 	path := fmt.Sprintf("/v1/ciBuildActions/%s/issues", buildActionID)
 	if query.nextURL != "" {
-		if err := validateNextURL(query.nextURL); err != nil {
+		if err := c.validateNextURL(query.nextURL); err != nil {
 			return nil, fmt.Errorf("ciIssues: %w", err)
 		}
 		path = query.nextURL
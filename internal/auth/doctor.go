@@ -3,6 +3,7 @@ package auth
 import (
 	"errors"
 	"fmt"
+	"net/url"
 	"os"
 	"path/filepath"
 	"sort"
@@ -65,6 +66,7 @@ func doctor(options DoctorOptions, resolver MigrationSuggestionResolver) DoctorR
 		inspectProfiles(),
 		inspectPrivateKeys(options),
 		inspectEnvironment(),
+		inspectNetwork(),
 		inspectTempKeys(options),
 		migrationSection,
 	}
@@ -371,6 +373,11 @@ func inspectEnvironment() DoctorSection {
 		"ASC_PROFILE",
 		"ASC_BYPASS_KEYCHAIN",
 		"ASC_STRICT_AUTH",
+		"ASC_BASE_URL",
+		"ASC_PROXY_URL",
+		"ASC_CA_BUNDLE",
+		"ASC_CLIENT_CERT",
+		"ASC_CLIENT_KEY",
 	}
 	for _, name := range envVars {
 		if value := strings.TrimSpace(os.Getenv(name)); value != "" {
@@ -423,6 +430,115 @@ func inspectEnvironment() DoctorSection {
 	return DoctorSection{Title: "Environment", Checks: checks}
 }
 
+// networkEnv returns network settings from ASC_* environment variables, which
+// take precedence over config.json.
+func networkEnv() config.Network {
+	return config.Network{
+		BaseURL:    strings.TrimSpace(os.Getenv("ASC_BASE_URL")),
+		ProxyURL:   strings.TrimSpace(os.Getenv("ASC_PROXY_URL")),
+		CABundle:   strings.TrimSpace(os.Getenv("ASC_CA_BUNDLE")),
+		ClientCert: strings.TrimSpace(os.Getenv("ASC_CLIENT_CERT")),
+		ClientKey:  strings.TrimSpace(os.Getenv("ASC_CLIENT_KEY")),
+	}
+}
+
+func inspectNetwork() DoctorSection {
+	cfg, err := config.Load()
+	if err != nil && !errors.Is(err, config.ErrNotFound) {
+		return DoctorSection{Title: "Network", Checks: []DoctorCheck{{
+			Status:         DoctorFail,
+			Message:        fmt.Sprintf("Failed to load network settings: %v", err),
+			Recommendation: "Fix base_url, proxy_url, ca_bundle, client_cert, and client_key in config.json",
+		}}}
+	}
+
+	names := []string{""}
+	if cfg != nil {
+		profileNames := make([]string, 0, len(cfg.Profiles))
+		for name := range cfg.Profiles {
+			profileNames = append(profileNames, name)
+		}
+		sort.Strings(profileNames)
+		names = append(names, profileNames...)
+	}
+
+	env := networkEnv()
+	checks := []DoctorCheck{}
+	for _, name := range names {
+		network := cfg.NetworkForProfile(name).Merge(env)
+		if name == "" && network == (config.Network{}) {
+			checks = append(checks, DoctorCheck{
+				Status:  DoctorInfo,
+				Message: "Using the default App Store Connect API endpoint without a proxy",
+			})
+			continue
+		}
+		checks = append(checks, inspectNetworkSettings(name, network)...)
+	}
+
+	return DoctorSection{Title: "Network", Checks: checks}
+}
+
+func inspectNetworkSettings(name string, network config.Network) []DoctorCheck {
+	label := "default"
+	if name != "" {
+		label = "profile " + name
+	}
+
+	if err := network.Validate(); err != nil {
+		return []DoctorCheck{{
+			Status:  DoctorFail,
+			Message: fmt.Sprintf("%s - invalid network settings: %v", label, err),
+		}}
+	}
+	if _, err := network.TLSConfig(nil); err != nil {
+		return []DoctorCheck{{
+			Status:         DoctorFail,
+			Message:        fmt.Sprintf("%s - %v", label, err),
+			Recommendation: "Check that ca_bundle, client_cert, and client_key point at readable PEM files",
+		}}
+	}
+
+	details := []string{}
+	if network.BaseURL != "" {
+		details = append(details, "base URL "+network.BaseURL)
+	}
+	if network.ProxyURL != "" {
+		details = append(details, "proxy "+redactProxyURL(network.ProxyURL))
+	}
+	if network.CABundle != "" {
+		details = append(details, "CA bundle "+network.CABundle)
+	}
+	if network.ClientCert != "" {
+		details = append(details, "client certificate "+network.ClientCert)
+	}
+	checks := []DoctorCheck{{
+		Status:  DoctorOK,
+		Message: fmt.Sprintf("%s - %s", label, strings.Join(details, ", ")),
+	}}
+
+	if keyPath := strings.TrimSpace(network.ClientKey); keyPath != "" {
+		if info, err := os.Stat(keyPath); err == nil && filePermissionsTooPermissive(info.Mode()) {
+			checks = append(checks, DoctorCheck{
+				Status:         DoctorWarn,
+				Message:        fmt.Sprintf("%s - client key permissions %#o (expected 0600)", keyPath, info.Mode().Perm()),
+				Recommendation: fmt.Sprintf("Run: chmod 600 %q", keyPath),
+			})
+		}
+	}
+	return checks
+}
+
+// redactProxyURL hides proxy credentials in doctor output.
+func redactProxyURL(raw string) string {
+	parsed, err := url.Parse(raw)
+	if err != nil || parsed.User == nil {
+		return raw
+	}
+	parsed.User = url.User("REDACTED")
+	return parsed.String()
+}
+
 func inspectTempKeys(options DoctorOptions) DoctorSection {
 	tempDir := os.TempDir()
 	entries, err := os.ReadDir(tempDir)
@@ -0,0 +1,80 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/99designs/keyring"
+)
+
+// ErrSecretNotFound is returned when no secret is stored under a name.
+var ErrSecretNotFound = errors.New("secret not found")
+
+// keyringSecretPrefix namespaces workflow secrets apart from API credentials
+// in the same keyring service.
+const keyringSecretPrefix = "asc:secret:"
+
+// StoreSecret saves a named secret, such as a workflow token, in the system
+// keychain, replacing any previous value.
+func StoreSecret(name, value string) error {
+	kr, err := openSecretKeyring()
+	if err != nil {
+		return err
+	}
+	return kr.Set(keyring.Item{
+		Key:   keyringSecretPrefix + name,
+		Data:  []byte(value),
+		Label: fmt.Sprintf("ASC Secret (%s)", name),
+	})
+}
+
+// GetSecret reads a named secret from the system keychain.
+func GetSecret(name string) (string, error) {
+	kr, err := openSecretKeyring()
+	if err != nil {
+		return "", err
+	}
+	item, err := kr.Get(keyringSecretPrefix + name)
+	if err != nil {
+		return "", secretKeyringError(name, err)
+	}
+	return string(item.Data), nil
+}
+
+// RemoveSecret deletes a named secret from the system keychain.
+func RemoveSecret(name string) error {
+	kr, err := openSecretKeyring()
+	if err != nil {
+		return err
+	}
+	if err := kr.Remove(keyringSecretPrefix + name); err != nil {
+		return secretKeyringError(name, err)
+	}
+	return nil
+}
+
+func openSecretKeyring() (keyring.Keyring, error) {
+	if shouldBypassKeychain() {
+		return nil, fmt.Errorf("keychain is bypassed via %s", bypassKeychainEnv)
+	}
+	kr, err := keyringOpener()
+	if err != nil {
+		if isKeyringUnavailable(err) {
+			return nil, fmt.Errorf("no system keychain is available: %w", err)
+		}
+		return nil, err
+	}
+	return kr, nil
+}
+
+func secretKeyringError(name string, err error) error {
+	switch {
+	case errors.Is(err, keyring.ErrKeyNotFound):
+		return fmt.Errorf("%w: %s", ErrSecretNotFound, strings.TrimSpace(name))
+	case isKeychainAccessDeniedError(err):
+		return fmt.Errorf("%w: %w", ErrKeychainAccessDenied, err)
+	default:
+		return err
+	}
+}
@@ -0,0 +1,56 @@
+package auth
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/99designs/keyring"
+)
+
+func TestSecrets_StoreGetRemove(t *testing.T) {
+	withMetadataKeyring(t, keyring.NewArrayKeyring(nil))
+
+	if err := StoreSecret("SLACK_TOKEN", "xoxb-123"); err != nil {
+		t.Fatalf("StoreSecret: %v", err)
+	}
+	value, err := GetSecret("SLACK_TOKEN")
+	if err != nil {
+		t.Fatalf("GetSecret: %v", err)
+	}
+	if value != "xoxb-123" {
+		t.Fatalf("expected stored value, got %q", value)
+	}
+
+	credentials, err := ListCredentials()
+	if err != nil {
+		t.Fatalf("ListCredentials: %v", err)
+	}
+	if len(credentials) != 0 {
+		t.Fatalf("expected secrets to stay out of credentials, got %+v", credentials)
+	}
+
+	if err := RemoveSecret("SLACK_TOKEN"); err != nil {
+		t.Fatalf("RemoveSecret: %v", err)
+	}
+	if _, err := GetSecret("SLACK_TOKEN"); !errors.Is(err, ErrSecretNotFound) {
+		t.Fatalf("expected ErrSecretNotFound after removal, got %v", err)
+	}
+}
+
+func TestSecrets_BypassKeychain(t *testing.T) {
+	t.Setenv("ASC_BYPASS_KEYCHAIN", "1")
+
+	previous := keyringOpener
+	keyringOpener = func() (keyring.Keyring, error) {
+		t.Fatal("expected keyring opener to be skipped when bypassing keychain")
+		return nil, nil
+	}
+	t.Cleanup(func() {
+		keyringOpener = previous
+	})
+
+	if _, err := GetSecret("SLACK_TOKEN"); err == nil || !strings.Contains(err.Error(), "ASC_BYPASS_KEYCHAIN") {
+		t.Fatalf("expected bypass error, got %v", err)
+	}
+}
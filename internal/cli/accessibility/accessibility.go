@@ -94,13 +94,13 @@ Examples:
 				return fmt.Errorf("accessibility list: %w", err)
 			}
 
-			resolvedAppID := shared.ResolveAppID(*appID)
+			resolvedAppID := shared.ResolveAppID(ctx, *appID)
 			if resolvedAppID == "" && strings.TrimSpace(*next) == "" {
 				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("accessibility list: %w", err)
 			}
@@ -123,14 +123,12 @@ Examples:
 					return fmt.Errorf("accessibility list: failed to fetch: %w", err)
 				}
 
-				pages, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				if err := shared.PrintPaginatedOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetAccessibilityDeclarations(ctx, resolvedAppID, asc.WithAccessibilityDeclarationsNextURL(nextURL))
-				})
-				if err != nil {
+				}, *output.Output, *output.Pretty); err != nil {
 					return fmt.Errorf("accessibility list: %w", err)
 				}
-
-				return shared.PrintOutput(pages, *output.Output, *output.Pretty)
+				return nil
 			}
 
 			resp, err := client.GetAccessibilityDeclarations(requestCtx, resolvedAppID, opts...)
@@ -138,7 +136,7 @@ Examples:
 				return fmt.Errorf("accessibility list: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -174,7 +172,7 @@ Examples:
 				return fmt.Errorf("accessibility get: %w", err)
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("accessibility get: %w", err)
 			}
@@ -187,7 +185,7 @@ Examples:
 				return fmt.Errorf("accessibility get: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -221,7 +219,7 @@ Examples:
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
-			resolvedAppID := shared.ResolveAppID(*appID)
+			resolvedAppID := shared.ResolveAppID(ctx, *appID)
 			if resolvedAppID == "" {
 				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
 				return flag.ErrHelp
@@ -253,7 +251,7 @@ Examples:
 				return fmt.Errorf("accessibility create: %w", err)
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("accessibility create: %w", err)
 			}
@@ -266,7 +264,7 @@ Examples:
 				return fmt.Errorf("accessibility create: failed to create: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -326,7 +324,7 @@ Examples:
 				return fmt.Errorf("accessibility update: at least one update flag is required")
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("accessibility update: %w", err)
 			}
@@ -339,7 +337,7 @@ Examples:
 				return fmt.Errorf("accessibility update: failed to update: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -373,7 +371,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("accessibility delete: %w", err)
 			}
@@ -390,7 +388,7 @@ Examples:
 				Deleted: true,
 			}
 
-			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 		},
 	}
 }
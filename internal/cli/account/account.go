@@ -69,13 +69,13 @@ Examples:
 				return shared.UsageErrorf("unexpected argument(s): %s", strings.Join(args, " "))
 			}
 
-			resolvedAppID := shared.ResolveAppID(*appID)
+			resolvedAppID := shared.ResolveAppID(ctx, *appID)
 			resp, err := collectAccountStatus(ctx, resolvedAppID)
 			if err != nil {
 				return fmt.Errorf("account status: %w", err)
 			}
 
-			return shared.PrintOutputWithRenderers(
+			return shared.PrintOutputWithRenderers(ctx,
 				resp,
 				*output.Output,
 				*output.Pretty,
@@ -150,7 +150,7 @@ func authHealthCheck() accountCheck {
 }
 
 func apiAccessCheck(ctx context.Context, appID string) (accountCheck, error) {
-	client, err := shared.GetASCClient()
+	client, err := shared.GetASCClient(ctx)
 	if err != nil {
 		return accountCheck{
 			Name:    "api_access",
@@ -78,7 +78,7 @@ Examples:
 				return fmt.Errorf("actors list: %w", err)
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("actors list: %w", err)
 			}
@@ -102,14 +102,12 @@ Examples:
 					return fmt.Errorf("actors list: failed to fetch: %w", err)
 				}
 
-				actors, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				if err := shared.PrintPaginatedOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetActors(ctx, asc.WithActorsNextURL(nextURL))
-				})
-				if err != nil {
+				}, *output.Output, *output.Pretty); err != nil {
 					return fmt.Errorf("actors list: %w", err)
 				}
-
-				return shared.PrintOutput(actors, *output.Output, *output.Pretty)
+				return nil
 			}
 
 			actors, err := client.GetActors(requestCtx, opts...)
@@ -117,7 +115,7 @@ Examples:
 				return fmt.Errorf("actors list: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(actors, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, actors, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -153,7 +151,7 @@ Examples:
 				return fmt.Errorf("actors get: %w", err)
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("actors get: %w", err)
 			}
@@ -166,7 +164,7 @@ Examples:
 				return fmt.Errorf("actors get: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(actor, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, actor, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -103,7 +103,7 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			appInfoValue := strings.TrimSpace(*appInfoID)
 			versionValue := strings.TrimSpace(*versionID)
-			appValue := strings.TrimSpace(shared.ResolveAppID(strings.TrimSpace(*appID)))
+			appValue := strings.TrimSpace(shared.ResolveAppID(ctx, strings.TrimSpace(*appID)))
 
 			if appInfoValue != "" && versionValue != "" {
 				return fmt.Errorf("age-rating view: only one of --app-info-id or --version-id is allowed")
@@ -113,7 +113,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("age-rating view: %w", err)
 			}
@@ -126,7 +126,7 @@ Examples:
 				return fmt.Errorf("age-rating view: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -200,7 +200,7 @@ Examples:
 			idValue := strings.TrimSpace(*id)
 			appInfoValue := strings.TrimSpace(*appInfoID)
 			versionValue := strings.TrimSpace(*versionID)
-			appValue := strings.TrimSpace(shared.ResolveAppID(strings.TrimSpace(*appID)))
+			appValue := strings.TrimSpace(shared.ResolveAppID(ctx, strings.TrimSpace(*appID)))
 
 			if idValue == "" {
 				if appInfoValue != "" && versionValue != "" {
@@ -258,7 +258,7 @@ Examples:
 				return fmt.Errorf("age-rating edit: at least one update flag is required")
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("age-rating edit: %w", err)
 			}
@@ -278,7 +278,7 @@ Examples:
 				return fmt.Errorf("age-rating edit: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -101,7 +101,7 @@ Examples:
 				idValue = derivedID
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("agreements territories list: %w", err)
 			}
@@ -125,14 +125,12 @@ Examples:
 					return fmt.Errorf("agreements territories list: failed to fetch: %w", err)
 				}
 
-				paginated, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				if err := shared.PrintPaginatedOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetEndUserLicenseAgreementTerritories(ctx, idValue, asc.WithEndUserLicenseAgreementTerritoriesNextURL(nextURL))
-				})
-				if err != nil {
+				}, *output.Output, *output.Pretty); err != nil {
 					return fmt.Errorf("agreements territories list: %w", err)
 				}
-
-				return shared.PrintOutput(paginated, *output.Output, *output.Pretty)
+				return nil
 			}
 
 			resp, err := client.GetEndUserLicenseAgreementTerritories(requestCtx, idValue, opts...)
@@ -140,7 +138,7 @@ Examples:
 				return fmt.Errorf("agreements territories list: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
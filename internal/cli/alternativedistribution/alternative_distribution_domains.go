@@ -71,7 +71,7 @@ Examples:
 				return fmt.Errorf("alternative-distribution domains list: %w", err)
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("alternative-distribution domains list: %w", err)
 			}
@@ -91,14 +91,12 @@ Examples:
 					return fmt.Errorf("alternative-distribution domains list: failed to fetch: %w", err)
 				}
 
-				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				if err := shared.PrintPaginatedOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetAlternativeDistributionDomains(ctx, asc.WithAlternativeDistributionDomainsNextURL(nextURL))
-				})
-				if err != nil {
+				}, *output.Output, *output.Pretty); err != nil {
 					return fmt.Errorf("alternative-distribution domains list: %w", err)
 				}
-
-				return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+				return nil
 			}
 
 			resp, err := client.GetAlternativeDistributionDomains(requestCtx, opts...)
@@ -106,7 +104,7 @@ Examples:
 				return fmt.Errorf("alternative-distribution domains list: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -135,7 +133,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("alternative-distribution domains get: %w", err)
 			}
@@ -148,7 +146,7 @@ Examples:
 				return fmt.Errorf("alternative-distribution domains get: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -184,7 +182,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("alternative-distribution domains create: %w", err)
 			}
@@ -197,7 +195,7 @@ Examples:
 				return fmt.Errorf("alternative-distribution domains create: failed to create: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -231,7 +229,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("alternative-distribution domains delete: %w", err)
 			}
@@ -248,7 +246,7 @@ Examples:
 				Deleted: true,
 			}
 
-			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -73,7 +73,7 @@ Examples:
 				return fmt.Errorf("alternative-distribution keys list: %w", err)
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("alternative-distribution keys list: %w", err)
 			}
@@ -93,14 +93,12 @@ Examples:
 					return fmt.Errorf("alternative-distribution keys list: failed to fetch: %w", err)
 				}
 
-				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				if err := shared.PrintPaginatedOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetAlternativeDistributionKeys(ctx, asc.WithAlternativeDistributionKeysNextURL(nextURL))
-				})
-				if err != nil {
+				}, *output.Output, *output.Pretty); err != nil {
 					return fmt.Errorf("alternative-distribution keys list: %w", err)
 				}
-
-				return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+				return nil
 			}
 
 			resp, err := client.GetAlternativeDistributionKeys(requestCtx, opts...)
@@ -108,7 +106,7 @@ Examples:
 				return fmt.Errorf("alternative-distribution keys list: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -137,7 +135,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("alternative-distribution keys get: %w", err)
 			}
@@ -150,7 +148,7 @@ Examples:
 				return fmt.Errorf("alternative-distribution keys get: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -176,7 +174,7 @@ Examples:
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
-			resolvedAppID := shared.ResolveAppID(*appID)
+			resolvedAppID := shared.ResolveAppID(ctx, *appID)
 			if resolvedAppID == "" {
 				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
 				return flag.ErrHelp
@@ -199,7 +197,7 @@ Examples:
 				}
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("alternative-distribution keys create: %w", err)
 			}
@@ -212,7 +210,7 @@ Examples:
 				return fmt.Errorf("alternative-distribution keys create: failed to create: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -246,7 +244,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("alternative-distribution keys delete: %w", err)
 			}
@@ -263,7 +261,7 @@ Examples:
 				Deleted: true,
 			}
 
-			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -286,13 +284,13 @@ Examples:
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
-			resolvedAppID := shared.ResolveAppID(*appID)
+			resolvedAppID := shared.ResolveAppID(ctx, *appID)
 			if resolvedAppID == "" {
 				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("alternative-distribution keys app: %w", err)
 			}
@@ -305,7 +303,7 @@ Examples:
 				return fmt.Errorf("alternative-distribution keys app: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -76,7 +76,7 @@ Examples:
 				return fmt.Errorf("alternative-distribution packages versions list: %w", err)
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("alternative-distribution packages versions list: %w", err)
 			}
@@ -96,14 +96,12 @@ Examples:
 					return fmt.Errorf("alternative-distribution packages versions list: failed to fetch: %w", err)
 				}
 
-				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				if err := shared.PrintPaginatedOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetAlternativeDistributionPackageVersions(ctx, trimmedID, asc.WithAlternativeDistributionPackageVersionsNextURL(nextURL))
-				})
-				if err != nil {
+				}, *output.Output, *output.Pretty); err != nil {
 					return fmt.Errorf("alternative-distribution packages versions list: %w", err)
 				}
-
-				return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+				return nil
 			}
 
 			resp, err := client.GetAlternativeDistributionPackageVersions(requestCtx, trimmedID, opts...)
@@ -111,7 +109,7 @@ Examples:
 				return fmt.Errorf("alternative-distribution packages versions list: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -140,7 +138,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("alternative-distribution packages versions get: %w", err)
 			}
@@ -153,7 +151,7 @@ Examples:
 				return fmt.Errorf("alternative-distribution packages versions get: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -192,7 +190,7 @@ Examples:
 				return fmt.Errorf("alternative-distribution packages versions deltas: %w", err)
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("alternative-distribution packages versions deltas: %w", err)
 			}
@@ -212,14 +210,12 @@ Examples:
 					return fmt.Errorf("alternative-distribution packages versions deltas: failed to fetch: %w", err)
 				}
 
-				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				if err := shared.PrintPaginatedOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetAlternativeDistributionPackageVersionDeltas(ctx, trimmedID, asc.WithAlternativeDistributionPackageDeltasNextURL(nextURL))
-				})
-				if err != nil {
+				}, *output.Output, *output.Pretty); err != nil {
 					return fmt.Errorf("alternative-distribution packages versions deltas: %w", err)
 				}
-
-				return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+				return nil
 			}
 
 			resp, err := client.GetAlternativeDistributionPackageVersionDeltas(requestCtx, trimmedID, opts...)
@@ -227,7 +223,7 @@ Examples:
 				return fmt.Errorf("alternative-distribution packages versions deltas: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -266,7 +262,7 @@ Examples:
 				return fmt.Errorf("alternative-distribution packages versions variants: %w", err)
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("alternative-distribution packages versions variants: %w", err)
 			}
@@ -286,14 +282,12 @@ Examples:
 					return fmt.Errorf("alternative-distribution packages versions variants: failed to fetch: %w", err)
 				}
 
-				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				if err := shared.PrintPaginatedOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetAlternativeDistributionPackageVersionVariants(ctx, trimmedID, asc.WithAlternativeDistributionPackageVariantsNextURL(nextURL))
-				})
-				if err != nil {
+				}, *output.Output, *output.Pretty); err != nil {
 					return fmt.Errorf("alternative-distribution packages versions variants: %w", err)
 				}
-
-				return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+				return nil
 			}
 
 			resp, err := client.GetAlternativeDistributionPackageVersionVariants(requestCtx, trimmedID, opts...)
@@ -301,7 +295,7 @@ Examples:
 				return fmt.Errorf("alternative-distribution packages versions variants: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
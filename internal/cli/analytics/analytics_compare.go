@@ -97,7 +97,7 @@ Examples:
 				return shared.UsageError("--source must be sales")
 			}
 
-			resolvedAppID := shared.ResolveAppID(*appID)
+			resolvedAppID := shared.ResolveAppID(ctx, *appID)
 			if resolvedAppID == "" {
 				return shared.UsageError("--app is required (or set ASC_APP_ID)")
 			}
@@ -141,7 +141,7 @@ Examples:
 				return shared.UsageError(err.Error())
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("analytics compare: %w", err)
 			}
@@ -189,7 +189,7 @@ Examples:
 				GeneratedAt: time.Now().UTC().Format(time.RFC3339),
 			}
 
-			return shared.PrintOutputWithRenderers(
+			return shared.PrintOutputWithRenderers(ctx,
 				resp,
 				*output.Output,
 				*output.Pretty,
@@ -62,7 +62,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("analytics instances get: %w", err)
 			}
@@ -75,7 +75,7 @@ Examples:
 				return fmt.Errorf("analytics instances get: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -120,7 +120,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("analytics instances links: %w", err)
 			}
@@ -135,7 +135,7 @@ Examples:
 
 			if *paginate {
 				paginateOpts := append(opts, asc.WithLinkagesLimit(analyticsMaxLimit))
-				resp, err := shared.PaginateWithSpinner(
+				if err := shared.PrintPaginatedOutputWithSpinner(
 					requestCtx,
 					func(ctx context.Context) (asc.PaginatedResponse, error) {
 						return client.GetAnalyticsReportInstanceSegmentsRelationships(ctx, id, paginateOpts...)
@@ -143,12 +143,12 @@ Examples:
 					func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 						return client.GetAnalyticsReportInstanceSegmentsRelationships(ctx, id, asc.WithLinkagesNextURL(nextURL))
 					},
-				)
-				if err != nil {
+					*output.Output,
+					*output.Pretty,
+				); err != nil {
 					return fmt.Errorf("analytics instances links: %w", err)
 				}
-
-				return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+				return nil
 			}
 
 			resp, err := client.GetAnalyticsReportInstanceSegmentsRelationships(requestCtx, id, opts...)
@@ -156,7 +156,7 @@ Examples:
 				return fmt.Errorf("analytics instances links: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
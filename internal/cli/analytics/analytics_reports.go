@@ -62,7 +62,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("analytics reports get: %w", err)
 			}
@@ -75,7 +75,7 @@ Examples:
 				return fmt.Errorf("analytics reports get: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -115,7 +115,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("analytics reports links: %w", err)
 			}
@@ -130,7 +130,7 @@ Examples:
 
 			if *paginate {
 				paginateOpts := append(opts, asc.WithLinkagesLimit(analyticsMaxLimit))
-				resp, err := shared.PaginateWithSpinner(
+				if err := shared.PrintPaginatedOutputWithSpinner(
 					requestCtx,
 					func(ctx context.Context) (asc.PaginatedResponse, error) {
 						return client.GetAnalyticsReportInstancesRelationships(ctx, id, paginateOpts...)
@@ -138,12 +138,12 @@ Examples:
 					func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 						return client.GetAnalyticsReportInstancesRelationships(ctx, id, asc.WithLinkagesNextURL(nextURL))
 					},
-				)
-				if err != nil {
+					*output.Output,
+					*output.Pretty,
+				); err != nil {
 					return fmt.Errorf("analytics reports links: %w", err)
 				}
-
-				return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+				return nil
 			}
 
 			resp, err := client.GetAnalyticsReportInstancesRelationships(requestCtx, id, opts...)
@@ -151,7 +151,7 @@ Examples:
 				return fmt.Errorf("analytics reports links: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -37,7 +37,7 @@ Examples:
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
-			resolvedAppID := shared.ResolveAppID(*appID)
+			resolvedAppID := shared.ResolveAppID(ctx, *appID)
 			if resolvedAppID == "" {
 				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
 				return flag.ErrHelp
@@ -51,7 +51,7 @@ Examples:
 				return shared.UsageError(err.Error())
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("analytics request: %w", err)
 			}
@@ -65,7 +65,7 @@ Examples:
 					return fmt.Errorf("analytics request: %w", err)
 				}
 
-				return shared.PrintOutput(result, *output.Output, *output.Pretty)
+				return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 			}
 
 			response, err := client.CreateAnalyticsReportRequest(requestCtx, resolvedAppID, normalizedAccessType)
@@ -81,7 +81,7 @@ Examples:
 				CreatedDate: response.Data.Attributes.CreatedDate,
 			}
 
-			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -141,13 +141,13 @@ Examples:
 				normalizedState = stateValue
 			}
 
-			resolvedAppID := shared.ResolveAppID(*appID)
+			resolvedAppID := shared.ResolveAppID(ctx, *appID)
 			if resolvedAppID == "" && strings.TrimSpace(*next) == "" && strings.TrimSpace(*requestID) == "" {
 				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("analytics requests: %w", err)
 			}
@@ -176,7 +176,7 @@ Examples:
 
 				if *paginate {
 					paginateOpts := append(opts, asc.WithAnalyticsReportRequestsLimit(200))
-					paginated, err := shared.PaginateWithSpinner(
+					if err := shared.PrintPaginatedOutputWithSpinner(
 						requestCtx,
 						func(ctx context.Context) (asc.PaginatedResponse, error) {
 							return client.GetAnalyticsReportRequests(ctx, resolvedAppID, paginateOpts...)
@@ -184,12 +184,12 @@ Examples:
 						func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 							return client.GetAnalyticsReportRequests(ctx, resolvedAppID, asc.WithAnalyticsReportRequestsNextURL(nextURL))
 						},
-					)
-					if err != nil {
+						*output.Output,
+						*output.Pretty,
+					); err != nil {
 						return fmt.Errorf("analytics requests: %w", err)
 					}
-
-					return shared.PrintOutput(paginated, *output.Output, *output.Pretty)
+					return nil
 				}
 
 				response, err = client.GetAnalyticsReportRequests(requestCtx, resolvedAppID, opts...)
@@ -198,7 +198,7 @@ Examples:
 				}
 			}
 
-			return shared.PrintOutput(response, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, response, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -328,7 +328,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("analytics requests delete: %w", err)
 			}
@@ -345,7 +345,7 @@ Examples:
 				Deleted:   true,
 			}
 
-			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -403,7 +403,7 @@ Examples:
 				return fmt.Errorf("analytics get: %w", err)
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("analytics get: %w", err)
 			}
@@ -497,7 +497,7 @@ Examples:
 				return fmt.Errorf("analytics get: no instances found for date %q", dateFilter)
 			}
 
-			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -549,7 +549,7 @@ Examples:
 			defaultOutput := fmt.Sprintf("analytics_report_%s_%s.csv.gz", strings.TrimSpace(*requestID), strings.TrimSpace(*instanceID))
 			compressedPath, decompressedPath := shared.ResolveReportOutputPaths(*output, defaultOutput, ".csv", *decompress)
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("analytics download: %w", err)
 			}
@@ -642,7 +642,7 @@ Examples:
 				DecompressedSize: decompressedSize,
 			}
 
-			return shared.PrintOutput(result, *outputFlags.OutputFormat, *outputFlags.Pretty)
+			return shared.PrintOutput(ctx, result, *outputFlags.OutputFormat, *outputFlags.Pretty)
 		},
 	}
 }
@@ -0,0 +1,330 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/schema"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+var supportedMethods = []string{http.MethodGet, http.MethodPost, http.MethodPatch, http.MethodDelete}
+
+// apiRequest is a validated raw request ready to send.
+type apiRequest struct {
+	Method string
+	Path   string
+	Query  url.Values
+	Body   json.RawMessage
+}
+
+// target returns the request path with its encoded query string.
+func (r apiRequest) target() string {
+	if len(r.Query) == 0 {
+		return r.Path
+	}
+	return r.Path + "?" + r.Query.Encode()
+}
+
+// APICommand returns the api command.
+func APICommand() *ffcli.Command {
+	fs := flag.NewFlagSet("api", flag.ExitOnError)
+
+	var params shared.MultiStringFlag
+	fs.Var(&apiQueryFlag{params: &params}, "query", "Query parameter as key=value (repeatable); any other value is a JMESPath output expression")
+	fs.Var(&params, "param", "Query parameter as key=value (repeatable; same as --query key=value)")
+	data := fs.String("data", "", "Request body as inline JSON or @path/to/file.json")
+	confirm := fs.Bool("confirm", false, "Confirm POST, PATCH, or DELETE requests")
+	paginate := fs.Bool("paginate", false, "Automatically fetch all pages of a GET collection (aggregate results)")
+	skipSchemaCheck := fs.Bool("skip-schema-check", false, "Send the request even if the endpoint is not in the schema index")
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "api",
+		ShortUsage: "asc api [METHOD] PATH [flags]",
+		ShortHelp:  "Send an authenticated request to any App Store Connect API endpoint.",
+		LongHelp: `Send an authenticated request to any App Store Connect API endpoint.
+
+Use this for endpoints that do not have a dedicated command yet. Requests
+reuse the CLI's authentication, retries, rate limiting, and output formats.
+The method and path are checked against the embedded schema index (see
+` + "`asc schema`" + `), including query parameter names and allowed values.
+
+METHOD defaults to GET. POST, PATCH, and DELETE requests require --confirm.
+
+--query key=value adds an API query parameter. Any other --query value, such
+as 'data[].id', is a JMESPath expression that reshapes the output, as on every
+other command. --param key=value always adds a query parameter.
+
+Examples:
+  asc api /v1/apps --query limit=5
+  asc api GET /v1/apps/APP_ID/appStoreVersions --query filter[platform]=IOS --paginate
+  asc api /v1/apps --query 'data[].attributes.name' --query limit=5
+  asc api GET /v1/builds/BUILD_ID --output table
+  asc api PATCH /v1/apps/APP_ID --data @app.json --confirm
+  asc api DELETE /v1/betaTesters/TESTER_ID --confirm`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			method, rawPath, rest, err := splitAPIArgs(args)
+			if err != nil {
+				return err
+			}
+			// Flags may follow the positional METHOD and PATH arguments.
+			if err := shared.RecoverBoolFlagTailArgs(fs, rest, nil); err != nil {
+				return err
+			}
+
+			req, err := buildAPIRequest(method, rawPath, params, *data)
+			if err != nil {
+				return err
+			}
+			if *paginate && req.Method != http.MethodGet {
+				return shared.UsageError("--paginate is only valid with GET requests")
+			}
+			if !*skipSchemaCheck {
+				if err := validateAgainstSchema(req); err != nil {
+					return err
+				}
+			}
+			if req.Method != http.MethodGet && !*confirm {
+				fmt.Fprintf(os.Stderr, "Error: --confirm is required for %s requests\n", req.Method)
+				return flag.ErrHelp
+			}
+
+			client, err := shared.GetASCClient(ctx)
+			if err != nil {
+				return fmt.Errorf("api: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			if *paginate {
+				firstPage, err := client.GetRawList(requestCtx, req.target())
+				if err != nil {
+					return fmt.Errorf("api: failed to fetch: %w", paginateHint(err))
+				}
+				if err := shared.PrintPaginatedOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+					return client.GetRawList(ctx, nextURL)
+				}, *output.Output, *output.Pretty); err != nil {
+					return fmt.Errorf("api: %w", err)
+				}
+				return nil
+			}
+
+			var body io.Reader
+			if len(req.Body) > 0 {
+				body = bytes.NewReader(req.Body)
+			}
+			respBody, err := client.RawRequest(requestCtx, req.Method, req.target(), body)
+			if err != nil {
+				return fmt.Errorf("api: %w", err)
+			}
+			resp, err := asc.ParseRawResponse(respBody)
+			if err != nil {
+				return fmt.Errorf("api: %w", err)
+			}
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
+		},
+	}
+}
+
+// splitAPIArgs extracts the optional METHOD and required PATH positional
+// arguments, returning any remaining arguments for flag parsing.
+func splitAPIArgs(args []string) (string, string, []string, error) {
+	if len(args) == 0 {
+		return "", "", nil, shared.UsageError("PATH argument is required")
+	}
+
+	method := http.MethodGet
+	if candidate := strings.ToUpper(strings.TrimSpace(args[0])); slices.Contains(supportedMethods, candidate) {
+		method = candidate
+		args = args[1:]
+	} else if !strings.HasPrefix(args[0], "/") && !strings.HasPrefix(args[0], "http") {
+		return "", "", nil, shared.UsageErrorf("invalid method %q (allowed: %s)", args[0], strings.Join(supportedMethods, ", "))
+	}
+
+	if len(args) == 0 || strings.HasPrefix(args[0], "-") {
+		return "", "", nil, shared.UsageError("PATH argument is required")
+	}
+	return method, strings.TrimSpace(args[0]), args[1:], nil
+}
+
+// apiQueryParamPattern matches key=value query parameters such as limit=5 or
+// filter[platform]=IOS. A single "=" is not a JMESPath operator, so these never
+// collide with output expressions like data[?a=='x'].
+var apiQueryParamPattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_.-]*(\[[A-Za-z0-9_.-]+\])?=([^=]|$)`)
+
+// apiQueryFlag routes --query key=value to the request's query parameters and
+// any other value to the shared JMESPath output projection, which
+// shared.BindOutputProjectionFlags connects through ForwardOutputQuery.
+type apiQueryFlag struct {
+	params *shared.MultiStringFlag
+	output flag.Value
+}
+
+func (f *apiQueryFlag) String() string {
+	if f == nil || f.params == nil {
+		return ""
+	}
+	return f.params.String()
+}
+
+func (f *apiQueryFlag) Set(value string) error {
+	if apiQueryParamPattern.MatchString(strings.TrimSpace(value)) {
+		return f.params.Set(value)
+	}
+	if f.output == nil {
+		return fmt.Errorf("JMESPath output expressions are not supported here; use --query key=value")
+	}
+	return f.output.Set(value)
+}
+
+// ForwardOutputQuery sends JMESPath --query values to target.
+func (f *apiQueryFlag) ForwardOutputQuery(target flag.Value) {
+	f.output = target
+}
+
+// buildAPIRequest normalizes the path, merges --param values into any query
+// string already present on the path, and loads the request body.
+func buildAPIRequest(method, rawPath string, params []string, data string) (apiRequest, error) {
+	req := apiRequest{Method: method}
+
+	parsed, err := url.Parse(rawPath)
+	if err != nil {
+		return apiRequest{}, shared.UsageErrorf("invalid PATH: %v", err)
+	}
+	if parsed.IsAbs() {
+		// Absolute URLs (such as copied links.next values) must stay on the API host.
+		baseURL, err := asc.ResolveBaseURL(shared.ResolveProfileName())
+		if err != nil {
+			return apiRequest{}, fmt.Errorf("api: %w", err)
+		}
+		base, err := url.Parse(baseURL)
+		if err != nil {
+			return apiRequest{}, fmt.Errorf("api: invalid base URL: %w", err)
+		}
+		if parsed.Scheme != base.Scheme || parsed.Host != base.Host {
+			return apiRequest{}, shared.UsageErrorf("PATH URL must be on %s (got %q)", base.Host, rawPath)
+		}
+	} else if !strings.HasPrefix(rawPath, "/") {
+		return apiRequest{}, shared.UsageErrorf("PATH must start with / (got %q)", rawPath)
+	}
+	req.Path = parsed.Path
+	req.Query = parsed.Query()
+
+	for _, arg := range params {
+		key, value, ok := strings.Cut(arg, "=")
+		key = strings.TrimSpace(key)
+		if !ok || key == "" {
+			return apiRequest{}, shared.UsageErrorf("--param must be key=value (got %q)", arg)
+		}
+		req.Query.Add(key, strings.TrimSpace(value))
+	}
+
+	data = strings.TrimSpace(data)
+	switch {
+	case data == "":
+		if method == http.MethodPost || method == http.MethodPatch {
+			return apiRequest{}, shared.UsageErrorf("--data is required for %s requests", method)
+		}
+	case method == http.MethodGet:
+		return apiRequest{}, shared.UsageError("--data is not valid with GET requests")
+	case strings.HasPrefix(data, "@"):
+		payload, err := shared.ReadJSONFilePayload(strings.TrimSpace(data[1:]))
+		if err != nil {
+			return apiRequest{}, fmt.Errorf("api: read --data: %w", err)
+		}
+		req.Body = payload
+	default:
+		var payload map[string]any
+		if err := json.Unmarshal([]byte(data), &payload); err != nil {
+			return apiRequest{}, shared.UsageErrorf("--data must be a JSON object or @file: %v", err)
+		}
+		req.Body = json.RawMessage(data)
+	}
+
+	return req, nil
+}
+
+// validateAgainstSchema checks the method, path, and query parameters against
+// the embedded schema index.
+func validateAgainstSchema(req apiRequest) error {
+	matches, err := schema.MatchPath(req.Path)
+	if err != nil {
+		return fmt.Errorf("api: %w", err)
+	}
+	if len(matches) == 0 {
+		return shared.UsageErrorf("%s is not in the schema index (run `asc schema` to search, or pass --skip-schema-check)", req.Path)
+	}
+
+	var endpoint *schema.Endpoint
+	allowed := make([]string, 0, len(matches))
+	for i := range matches {
+		allowed = append(allowed, matches[i].Method)
+		if matches[i].Method == req.Method {
+			endpoint = &matches[i]
+		}
+	}
+	if endpoint == nil {
+		sort.Strings(allowed)
+		return shared.UsageErrorf("%s is not supported for %s (allowed: %s)", req.Method, matches[0].Path, strings.Join(allowed, ", "))
+	}
+
+	params := make(map[string]schema.Parameter)
+	for _, param := range endpoint.Parameters {
+		if param.In == "query" {
+			params[param.Name] = param
+		}
+	}
+	keys := make([]string, 0, len(req.Query))
+	for key := range req.Query {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if key == "cursor" {
+			// Pagination cursors come from links.next and are not part of the schema.
+			continue
+		}
+		param, ok := params[key]
+		if !ok {
+			return shared.UsageErrorf("query parameter %q is not supported by %s %s", key, endpoint.Method, endpoint.Path)
+		}
+		if len(param.Enum) == 0 {
+			continue
+		}
+		for _, value := range req.Query[key] {
+			for _, item := range shared.SplitCSV(value) {
+				if !slices.Contains(param.Enum, item) {
+					return shared.UsageErrorf("invalid value %q for %s (allowed: %s)", item, key, strings.Join(param.Enum, ", "))
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// paginateHint explains the common failure of paginating a single-resource endpoint.
+func paginateHint(err error) error {
+	if _, ok := errors.AsType[*json.UnmarshalTypeError](err); ok {
+		return fmt.Errorf("--paginate requires a collection endpoint: %w", err)
+	}
+	return err
+}
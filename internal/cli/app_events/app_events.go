@@ -81,13 +81,13 @@ Examples:
 				return fmt.Errorf("app-events list: %w", err)
 			}
 
-			resolvedAppID := shared.ResolveAppID(*appID)
+			resolvedAppID := shared.ResolveAppID(ctx, *appID)
 			if resolvedAppID == "" && strings.TrimSpace(*next) == "" {
 				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
 				return flag.ErrHelp
 			}
 
-			client, err := appEventsClientFactory()
+			client, err := appEventsClientFactory(ctx)
 			if err != nil {
 				return fmt.Errorf("app-events list: %w", err)
 			}
@@ -107,13 +107,12 @@ Examples:
 					return fmt.Errorf("app-events list: failed to fetch: %w", err)
 				}
 
-				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				if err := shared.PrintPaginatedOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetAppEvents(ctx, resolvedAppID, asc.WithAppEventsNextURL(nextURL))
-				})
-				if err != nil {
+				}, *output.Output, *output.Pretty); err != nil {
 					return fmt.Errorf("app-events list: %w", err)
 				}
-				return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+				return nil
 			}
 
 			resp, err := client.GetAppEvents(requestCtx, resolvedAppID, opts...)
@@ -121,7 +120,7 @@ Examples:
 				return fmt.Errorf("app-events list: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -150,7 +149,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := appEventsClientFactory()
+			client, err := appEventsClientFactory(ctx)
 			if err != nil {
 				return fmt.Errorf("app-events get: %w", err)
 			}
@@ -163,7 +162,7 @@ Examples:
 				return fmt.Errorf("app-events get: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -199,7 +198,7 @@ Examples:
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
-			resolvedAppID := shared.ResolveAppID(*appID)
+			resolvedAppID := shared.ResolveAppID(ctx, *appID)
 			if resolvedAppID == "" {
 				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
 				return flag.ErrHelp
@@ -255,7 +254,7 @@ Examples:
 				Purpose:             normalizedPurpose,
 			}
 
-			client, err := appEventsClientFactory()
+			client, err := appEventsClientFactory(ctx)
 			if err != nil {
 				return fmt.Errorf("app-events create: %w", err)
 			}
@@ -304,7 +303,7 @@ Examples:
 				}
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -435,7 +434,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := appEventsClientFactory()
+			client, err := appEventsClientFactory(ctx)
 			if err != nil {
 				return fmt.Errorf("app-events update: %w", err)
 			}
@@ -448,7 +447,7 @@ Examples:
 				return fmt.Errorf("app-events update: failed to update: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -482,7 +481,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := appEventsClientFactory()
+			client, err := appEventsClientFactory(ctx)
 			if err != nil {
 				return fmt.Errorf("app-events delete: %w", err)
 			}
@@ -499,7 +498,7 @@ Examples:
 				Deleted: true,
 			}
 
-			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 		},
 	}
 }
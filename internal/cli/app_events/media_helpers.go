@@ -14,6 +14,7 @@ const appEventAssetPollInterval = 2 * time.Second
 func waitForAppEventScreenshotDelivery(ctx context.Context, client *asc.Client, screenshotID string) (*asc.AppEventScreenshotResponse, error) {
 	ticker := time.NewTicker(appEventAssetPollInterval)
 	defer ticker.Stop()
+	ctx = asc.WithoutResponseCache(ctx)
 
 	var lastResp *asc.AppEventScreenshotResponse
 	for {
@@ -44,6 +45,7 @@ func waitForAppEventScreenshotDelivery(ctx context.Context, client *asc.Client,
 func waitForAppEventVideoClipDelivery(ctx context.Context, client *asc.Client, clipID string) (*asc.AppEventVideoClipResponse, error) {
 	ticker := time.NewTicker(appEventAssetPollInterval)
 	defer ticker.Stop()
+	ctx = asc.WithoutResponseCache(ctx)
 
 	var lastResp *asc.AppEventVideoClipResponse
 	for {
@@ -1,6 +1,8 @@
 package app_events
 
 import (
+	"context"
+
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
 )
@@ -12,7 +14,7 @@ func SetClientFactory(fn func() (*asc.Client, error)) func() {
 	if fn == nil {
 		appEventsClientFactory = shared.GetASCClient
 	} else {
-		appEventsClientFactory = fn
+		appEventsClientFactory = func(context.Context) (*asc.Client, error) { return fn() }
 	}
 	return func() {
 		appEventsClientFactory = previous
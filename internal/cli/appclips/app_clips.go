@@ -81,13 +81,13 @@ Examples:
 				return fmt.Errorf("app-clips list: %w", err)
 			}
 
-			appValue := strings.TrimSpace(shared.ResolveAppID(*appID))
+			appValue := strings.TrimSpace(shared.ResolveAppID(ctx, *appID))
 			if appValue == "" {
 				fmt.Fprintln(os.Stderr, "Error: --app is required")
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("app-clips list: %w", err)
 			}
@@ -107,31 +107,29 @@ Examples:
 				if err != nil {
 					if asc.IsNotFound(err) {
 						empty := &asc.AppClipsResponse{Data: []asc.Resource[asc.AppClipAttributes]{}}
-						return shared.PrintOutput(empty, *output.Output, *output.Pretty)
+						return shared.PrintOutput(ctx, empty, *output.Output, *output.Pretty)
 					}
 					return fmt.Errorf("app-clips list: failed to fetch: %w", err)
 				}
 
-				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				if err := shared.PrintPaginatedOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetAppClips(ctx, appValue, asc.WithAppClipsNextURL(nextURL))
-				})
-				if err != nil {
+				}, *output.Output, *output.Pretty); err != nil {
 					return fmt.Errorf("app-clips list: %w", err)
 				}
-
-				return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+				return nil
 			}
 
 			resp, err := client.GetAppClips(requestCtx, appValue, opts...)
 			if err != nil {
 				if asc.IsNotFound(err) {
 					empty := &asc.AppClipsResponse{Data: []asc.Resource[asc.AppClipAttributes]{}}
-					return shared.PrintOutput(empty, *output.Output, *output.Pretty)
+					return shared.PrintOutput(ctx, empty, *output.Output, *output.Pretty)
 				}
 				return fmt.Errorf("app-clips list: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -160,7 +158,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("app-clips get: %w", err)
 			}
@@ -173,7 +171,7 @@ Examples:
 				return fmt.Errorf("app-clips get: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
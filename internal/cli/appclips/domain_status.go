@@ -62,7 +62,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("app-clips domain-status cache: %w", err)
 			}
@@ -74,13 +74,13 @@ Examples:
 			if err != nil {
 				if asc.IsNotFound(err) {
 					result := asc.NewAppClipDomainStatusResult(buildBundleValue, nil)
-					return shared.PrintOutput(result, *output.Output, *output.Pretty)
+					return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 				}
 				return fmt.Errorf("app-clips domain-status cache: failed to fetch: %w", err)
 			}
 
 			result := asc.NewAppClipDomainStatusResult(buildBundleValue, resp)
-			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -109,7 +109,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("app-clips domain-status debug: %w", err)
 			}
@@ -121,13 +121,13 @@ Examples:
 			if err != nil {
 				if asc.IsNotFound(err) {
 					result := asc.NewAppClipDomainStatusResult(buildBundleValue, nil)
-					return shared.PrintOutput(result, *output.Output, *output.Pretty)
+					return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 				}
 				return fmt.Errorf("app-clips domain-status debug: failed to fetch: %w", err)
 			}
 
 			result := asc.NewAppClipDomainStatusResult(buildBundleValue, resp)
-			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 		},
 	}
 }
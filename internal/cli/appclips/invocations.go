@@ -79,7 +79,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := appClipsClientFactory()
+			client, err := appClipsClientFactory(ctx)
 			if err != nil {
 				return fmt.Errorf("app-clips invocations list: %w", err)
 			}
@@ -99,19 +99,17 @@ Examples:
 					if asc.IsNotFound(err) {
 						fmt.Fprintln(os.Stderr, "No invocations found.")
 						empty := &asc.BetaAppClipInvocationsResponse{Data: []asc.Resource[asc.BetaAppClipInvocationAttributes]{}}
-						return shared.PrintOutput(empty, *output.Output, *output.Pretty)
+						return shared.PrintOutput(ctx, empty, *output.Output, *output.Pretty)
 					}
 					return fmt.Errorf("app-clips invocations list: failed to fetch: %w", err)
 				}
 
-				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				if err := shared.PrintPaginatedOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetBuildBundleBetaAppClipInvocations(ctx, buildBundleValue, asc.WithBetaAppClipInvocationsNextURL(nextURL))
-				})
-				if err != nil {
+				}, *output.Output, *output.Pretty); err != nil {
 					return fmt.Errorf("app-clips invocations list: %w", err)
 				}
-
-				return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+				return nil
 			}
 
 			resp, err := client.GetBuildBundleBetaAppClipInvocations(requestCtx, buildBundleValue, opts...)
@@ -119,12 +117,12 @@ Examples:
 				if asc.IsNotFound(err) {
 					fmt.Fprintln(os.Stderr, "No invocations found.")
 					empty := &asc.BetaAppClipInvocationsResponse{Data: []asc.Resource[asc.BetaAppClipInvocationAttributes]{}}
-					return shared.PrintOutput(empty, *output.Output, *output.Pretty)
+					return shared.PrintOutput(ctx, empty, *output.Output, *output.Pretty)
 				}
 				return fmt.Errorf("app-clips invocations list: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -153,7 +151,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := appClipsClientFactory()
+			client, err := appClipsClientFactory(ctx)
 			if err != nil {
 				return fmt.Errorf("app-clips invocations get: %w", err)
 			}
@@ -166,7 +164,7 @@ Examples:
 				return fmt.Errorf("app-clips invocations get: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -232,7 +230,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := appClipsClientFactory()
+			client, err := appClipsClientFactory(ctx)
 			if err != nil {
 				return fmt.Errorf("app-clips invocations create: %w", err)
 			}
@@ -246,7 +244,7 @@ Examples:
 				return fmt.Errorf("app-clips invocations create: failed to create: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -288,7 +286,7 @@ Examples:
 			urlValue := strings.TrimSpace(*url)
 			attrs := &asc.BetaAppClipInvocationUpdateAttributes{URL: &urlValue}
 
-			client, err := appClipsClientFactory()
+			client, err := appClipsClientFactory(ctx)
 			if err != nil {
 				return fmt.Errorf("app-clips invocations update: %w", err)
 			}
@@ -301,7 +299,7 @@ Examples:
 				return fmt.Errorf("app-clips invocations update: failed to update: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -335,7 +333,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := appClipsClientFactory()
+			client, err := appClipsClientFactory(ctx)
 			if err != nil {
 				return fmt.Errorf("app-clips invocations delete: %w", err)
 			}
@@ -352,7 +350,7 @@ Examples:
 				Deleted: true,
 			}
 
-			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 		},
 	}
 }
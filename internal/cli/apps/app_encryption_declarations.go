@@ -85,7 +85,7 @@ Examples:
 				return fmt.Errorf("apps app-encryption-declarations list: %w", err)
 			}
 
-			resolvedAppID := shared.ResolveAppID(*appID)
+			resolvedAppID := shared.ResolveAppID(ctx, *appID)
 			if resolvedAppID == "" && strings.TrimSpace(*next) == "" {
 				fmt.Fprintln(os.Stderr, "Error: --id is required (or set ASC_APP_ID)")
 				return flag.ErrHelp
@@ -93,7 +93,7 @@ Examples:
 
 			buildIDs := shared.SplitCSV(*builds)
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("apps app-encryption-declarations list: %w", err)
 			}
@@ -117,13 +117,12 @@ Examples:
 				if err != nil {
 					return fmt.Errorf("apps app-encryption-declarations list: failed to fetch: %w", err)
 				}
-				pages, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				if err := shared.PrintPaginatedOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetAppEncryptionDeclarations(ctx, resolvedAppID, asc.WithAppEncryptionDeclarationsNextURL(nextURL))
-				})
-				if err != nil {
+				}, *output.Output, *output.Pretty); err != nil {
 					return fmt.Errorf("apps app-encryption-declarations list: %w", err)
 				}
-				return shared.PrintOutput(pages, *output.Output, *output.Pretty)
+				return nil
 			}
 
 			resp, err := client.GetAppEncryptionDeclarations(requestCtx, resolvedAppID, opts...)
@@ -131,7 +130,7 @@ Examples:
 				return fmt.Errorf("apps app-encryption-declarations list: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
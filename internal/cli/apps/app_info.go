@@ -100,7 +100,7 @@ Examples:
 				return shared.UsageError("--version and --version-id are mutually exclusive")
 			}
 
-			resolvedAppID := shared.ResolveAppID(*appID)
+			resolvedAppID := shared.ResolveAppID(ctx, *appID)
 			if strings.TrimSpace(*versionID) == "" && resolvedAppID == "" && infoIDValue == "" {
 				fmt.Fprintln(os.Stderr, "Error: --app or --info-id is required (or set ASC_APP_ID)")
 				return flag.ErrHelp
@@ -142,7 +142,7 @@ Examples:
 				}
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("apps info view: %w", err)
 			}
@@ -161,7 +161,7 @@ Examples:
 					return fmt.Errorf("apps info view: %w", err)
 				}
 
-				return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+				return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 			}
 
 			versionResource, err := resolveAppStoreVersionForAppInfo(
@@ -193,13 +193,12 @@ Examples:
 					return fmt.Errorf("apps info view: failed to fetch: %w", err)
 				}
 
-				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				if err := shared.PrintPaginatedOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetAppStoreVersionLocalizations(ctx, versionResource.ID, asc.WithAppStoreVersionLocalizationsNextURL(nextURL))
-				})
-				if err != nil {
+				}, *output.Output, *output.Pretty); err != nil {
 					return fmt.Errorf("apps info view: %w", err)
 				}
-				return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+				return nil
 			}
 
 			resp, err := client.GetAppStoreVersionLocalizations(requestCtx, versionResource.ID, opts...)
@@ -207,7 +206,7 @@ Examples:
 				return fmt.Errorf("apps info view: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -254,7 +253,7 @@ Examples:
 				return shared.UsageError("--version and --version-id are mutually exclusive")
 			}
 
-			resolvedAppID := shared.ResolveAppID(*appID)
+			resolvedAppID := shared.ResolveAppID(ctx, *appID)
 			if strings.TrimSpace(*versionID) == "" && resolvedAppID == "" {
 				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
 				return flag.ErrHelp
@@ -342,7 +341,7 @@ Examples:
 				}
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("apps info edit: %w", err)
 			}
@@ -420,7 +419,7 @@ Examples:
 			if err != nil {
 				return fmt.Errorf("apps info edit: %w", err)
 			}
-			if err := shared.PrintOutput(batchResult, *output.Output, *output.Pretty); err != nil {
+			if err := shared.PrintOutput(ctx, batchResult, *output.Output, *output.Pretty); err != nil {
 				return err
 			}
 			if err := shared.PrintSubmitReadinessCreateWarnings(os.Stderr, warnings); err != nil {
@@ -526,7 +525,7 @@ func runAppInfoSetSingleLocale(
 		if createErr != nil {
 			return fmt.Errorf("apps info edit: %w", createErr)
 		}
-		if err := shared.PrintOutput(resp, *output.Output, *output.Pretty); err != nil {
+		if err := shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty); err != nil {
 			return err
 		}
 		if warning, ok := shared.SubmitReadinessCreateWarningForLocaleWithOptions(locale, effectiveAttrs, shared.SubmitReadinessCreateModeApplied, submitOpts); ok {
@@ -543,7 +542,7 @@ func runAppInfoSetSingleLocale(
 	if updateErr != nil {
 		return fmt.Errorf("apps info edit: %w", updateErr)
 	}
-	if err := shared.PrintOutput(resp, *output.Output, *output.Pretty); err != nil {
+	if err := shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty); err != nil {
 		return err
 	}
 	warnAppInfoSetSubmitIncompleteLocale(locale, effectiveAttrs)
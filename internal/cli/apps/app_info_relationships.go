@@ -103,13 +103,13 @@ Examples:
 			if err != nil {
 				return shared.UsageError(err.Error())
 			}
-			resolvedAppID := shared.ResolveAppID(*appID)
+			resolvedAppID := shared.ResolveAppID(ctx, *appID)
 			if resolvedAppID == "" && infoIDValue == "" {
 				fmt.Fprintln(os.Stderr, "Error: --app or --info-id is required (or set ASC_APP_ID)")
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("apps info relationships %s: %w", name, err)
 			}
@@ -127,7 +127,7 @@ Examples:
 				return fmt.Errorf("apps info relationships %s: failed to fetch: %w", name, err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -152,7 +152,7 @@ func appsRegistryPull(ctx context.Context, opts appsRegistryPullOptions) error {
 		return fmt.Errorf("apps registry pull: %w", err)
 	}
 
-	client, err := shared.GetASCClient()
+	client, err := shared.GetASCClient(ctx)
 	if err != nil {
 		return fmt.Errorf("apps registry pull: %w", err)
 	}
@@ -194,7 +194,7 @@ func appsRegistryPull(ctx context.Context, opts appsRegistryPullOptions) error {
 		}
 	}
 
-	return printAppRegistryPullResult(&result, opts.Output, opts.Pretty)
+	return printAppRegistryPullResult(ctx, &result, opts.Output, opts.Pretty)
 }
 
 func readAppRegistry(path string) (appRegistryFile, error) {
@@ -530,8 +530,8 @@ func uniqueAppRegistryKey(base string, used map[string]struct{}) string {
 	}
 }
 
-func printAppRegistryPullResult(result *appRegistryPullResult, format string, pretty bool) error {
-	return shared.PrintOutputWithRenderers(
+func printAppRegistryPullResult(ctx context.Context, result *appRegistryPullResult, format string, pretty bool) error {
+	return shared.PrintOutputWithRenderers(ctx,
 		result,
 		format,
 		pretty,
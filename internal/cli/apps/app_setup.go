@@ -160,7 +160,7 @@ Examples:
 				}
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("app-setup info set: %w", err)
 			}
@@ -244,7 +244,7 @@ Examples:
 				AppInfoLocalization: appInfoResp,
 			}
 
-			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -438,7 +438,7 @@ Examples:
 					return flag.ErrHelp
 				}
 
-				client, err := shared.GetASCClient()
+				client, err := shared.GetASCClient(ctx)
 				if err != nil {
 					return fmt.Errorf("app-setup localizations upload: %w", err)
 				}
@@ -463,15 +463,15 @@ Examples:
 					Results:   results,
 				}
 
-				return shared.PrintOutput(&result, *output.Output, *output.Pretty)
+				return shared.PrintOutput(ctx, &result, *output.Output, *output.Pretty)
 			case shared.LocalizationTypeAppInfo:
-				resolvedAppID := shared.ResolveAppID(*appID)
+				resolvedAppID := shared.ResolveAppID(ctx, *appID)
 				if resolvedAppID == "" {
 					fmt.Fprintln(os.Stderr, "Error: --app is required for app-info localizations")
 					return flag.ErrHelp
 				}
 
-				client, err := shared.GetASCClient()
+				client, err := shared.GetASCClient(ctx)
 				if err != nil {
 					return fmt.Errorf("app-setup localizations upload: %w", err)
 				}
@@ -502,7 +502,7 @@ Examples:
 					Results:   results,
 				}
 
-				return shared.PrintOutput(&result, *output.Output, *output.Pretty)
+				return shared.PrintOutput(ctx, &result, *output.Output, *output.Pretty)
 			default:
 				return fmt.Errorf("app-setup localizations upload: unsupported type %q", normalizedType)
 			}
@@ -156,7 +156,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("apps get: %w", err)
 			}
@@ -169,7 +169,7 @@ Examples:
 				return fmt.Errorf("apps get: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(app, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, app, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -309,7 +309,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("apps update: %w", err)
 			}
@@ -322,7 +322,7 @@ Examples:
 				return fmt.Errorf("apps update: failed to update: %w", err)
 			}
 
-			return shared.PrintOutput(app, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, app, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -338,7 +338,7 @@ func appsList(ctx context.Context, output string, pretty bool, bundleID string,
 		return fmt.Errorf("apps: %w", err)
 	}
 
-	client, err := shared.GetASCClient()
+	client, err := shared.GetASCClient(ctx)
 	if err != nil {
 		return fmt.Errorf("apps: %w", err)
 	}
@@ -359,7 +359,7 @@ func appsList(ctx context.Context, output string, pretty bool, bundleID string,
 
 	if paginate {
 		paginateOpts := append(opts, asc.WithAppsLimit(200))
-		apps, err := shared.PaginateWithSpinner(
+		if err := shared.PrintPaginatedOutputWithSpinner(
 			requestCtx,
 			func(ctx context.Context) (asc.PaginatedResponse, error) {
 				return client.GetApps(ctx, paginateOpts...)
@@ -367,12 +367,12 @@ func appsList(ctx context.Context, output string, pretty bool, bundleID string,
 			func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 				return client.GetApps(ctx, asc.WithAppsNextURL(nextURL))
 			},
-		)
-		if err != nil {
+			output,
+			pretty,
+		); err != nil {
 			return fmt.Errorf("apps: %w", err)
 		}
-
-		return shared.PrintOutput(apps, output, pretty)
+		return nil
 	}
 
 	apps, err := client.GetApps(requestCtx, opts...)
@@ -380,5 +380,5 @@ func appsList(ctx context.Context, output string, pretty bool, bundleID string,
 		return fmt.Errorf("apps: failed to fetch: %w", err)
 	}
 
-	return shared.PrintOutput(apps, output, pretty)
+	return shared.PrintOutput(ctx, apps, output, pretty)
 }
@@ -110,7 +110,7 @@ func appsCommunityWall(ctx context.Context, output string, pretty bool, sortBy s
 		entries = entries[:limit]
 	}
 
-	return shared.PrintOutput(&asc.AppsWallResult{Data: entries}, output, pretty)
+	return shared.PrintOutput(ctx, &asc.AppsWallResult{Data: entries}, output, pretty)
 }
 
 func loadCommunityWallEntries(ctx context.Context) ([]communityWallEntry, error) {
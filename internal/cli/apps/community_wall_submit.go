@@ -228,7 +228,7 @@ Examples:
 				fmt.Fprintf(os.Stderr, "Pull request created: #%d %s\n", result.PullRequestNumber, result.PullRequestURL)
 			}
 
-			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -68,13 +68,13 @@ Examples:
 				return shared.UsageErrorf("unexpected argument(s): %s", strings.Join(args, " "))
 			}
 
-			resolvedAppID := shared.ResolveAppID(*appID)
+			resolvedAppID := shared.ResolveAppID(ctx, *appID)
 			if resolvedAppID == "" {
 				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("apps content-rights view: %w", err)
 			}
@@ -88,7 +88,7 @@ Examples:
 			}
 
 			result := buildContentRightsResult(app)
-			return shared.PrintOutputWithRenderers(
+			return shared.PrintOutputWithRenderers(ctx,
 				result,
 				*output.Output,
 				*output.Pretty,
@@ -137,7 +137,7 @@ Examples:
 				return shared.UsageErrorf("unexpected argument(s): %s", strings.Join(args, " "))
 			}
 
-			resolvedAppID := shared.ResolveAppID(*appID)
+			resolvedAppID := shared.ResolveAppID(ctx, *appID)
 			if resolvedAppID == "" {
 				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
 				return flag.ErrHelp
@@ -153,7 +153,7 @@ Examples:
 				return shared.UsageError(err.Error())
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("apps content-rights edit: %w", err)
 			}
@@ -173,7 +173,7 @@ Examples:
 			fmt.Fprintf(os.Stderr, "Content rights declaration set to %s\n", string(declaration))
 
 			result := buildContentRightsResult(app)
-			return shared.PrintOutputWithRenderers(
+			return shared.PrintOutputWithRenderers(ctx,
 				result,
 				*output.Output,
 				*output.Pretty,
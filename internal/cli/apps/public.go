@@ -130,7 +130,7 @@ Examples:
 				return fmt.Errorf("apps public view: %w", err)
 			}
 
-			return shared.PrintOutputWithRenderers(app, *output.Output, *output.Pretty, func() error {
+			return shared.PrintOutputWithRenderers(ctx, app, *output.Output, *output.Pretty, func() error {
 				return renderPublicAppFieldTable(appFields(app))
 			}, func() error {
 				return renderPublicAppFieldMarkdown(appFields(app))
@@ -199,7 +199,7 @@ Examples:
 				Results: results,
 			}
 
-			return shared.PrintOutputWithRenderers(payload, *output.Output, *output.Pretty, func() error {
+			return shared.PrintOutputWithRenderers(ctx, payload, *output.Output, *output.Pretty, func() error {
 				return renderPublicSearchTable(payload)
 			}, func() error {
 				return renderPublicSearchMarkdown(payload)
@@ -271,7 +271,7 @@ Examples:
 				IsFree:         app.Price == 0,
 			}
 
-			return shared.PrintOutputWithRenderers(payload, *output.Output, *output.Pretty, func() error {
+			return shared.PrintOutputWithRenderers(ctx, payload, *output.Output, *output.Pretty, func() error {
 				return renderPublicAppFieldTable(appFieldsFromPrice(payload))
 			}, func() error {
 				return renderPublicAppFieldMarkdown(appFieldsFromPrice(payload))
@@ -341,7 +341,7 @@ Examples:
 				Description: app.Description,
 			}
 
-			return shared.PrintOutputWithRenderers(payload, *output.Output, *output.Pretty, func() error {
+			return shared.PrintOutputWithRenderers(ctx, payload, *output.Output, *output.Pretty, func() error {
 				return renderPublicAppFieldTable(appFieldsFromDescription(payload))
 			}, func() error {
 				return renderPublicAppFieldMarkdown(appFieldsFromDescription(payload))
@@ -401,7 +401,7 @@ Examples:
 			}
 
 			storefronts := itunes.ListStorefronts()
-			return shared.PrintOutputWithRenderers(storefronts, *output.Output, *output.Pretty, func() error {
+			return shared.PrintOutputWithRenderers(ctx, storefronts, *output.Output, *output.Pretty, func() error {
 				return renderPublicStorefrontsTable(storefronts)
 			}, func() error {
 				return renderPublicStorefrontsMarkdown(storefronts)
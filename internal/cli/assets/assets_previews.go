@@ -45,7 +45,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("video-previews list: %w", err)
 			}
@@ -74,7 +74,7 @@ Examples:
 				})
 			}
 
-			return shared.PrintOutput(&result, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, &result, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -141,7 +141,7 @@ Examples:
 				return fmt.Errorf("video-previews upload: %w", err)
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("video-previews upload: %w", err)
 			}
@@ -151,7 +151,7 @@ Examples:
 				return fmt.Errorf("video-previews upload: %w", err)
 			}
 
-			return shared.PrintOutput(&result, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, &result, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -246,7 +246,7 @@ Examples:
 				}
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("video-previews download: %w", err)
 			}
@@ -287,7 +287,7 @@ Examples:
 					result.Total = 1
 					result.Failed = 1
 
-					if err := shared.PrintOutputWithRenderers(
+					if err := shared.PrintOutputWithRenderers(ctx,
 						result,
 						*format.Output,
 						*format.Pretty,
@@ -424,7 +424,7 @@ Examples:
 			result.Total = len(items)
 			result.Failed = len(result.Failures)
 
-			if err := shared.PrintOutputWithRenderers(
+			if err := shared.PrintOutputWithRenderers(ctx,
 				result,
 				*format.Output,
 				*format.Pretty,
@@ -523,7 +523,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("video-previews delete: %w", err)
 			}
@@ -540,7 +540,7 @@ Examples:
 				Deleted: true,
 			}
 
-			return shared.PrintOutput(&result, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, &result, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -588,7 +588,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("video-previews set-poster-frame: %w", err)
 			}
@@ -601,7 +601,7 @@ Examples:
 				return fmt.Errorf("video-previews set-poster-frame: %w", err)
 			}
 
-			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 		},
 	}
 }
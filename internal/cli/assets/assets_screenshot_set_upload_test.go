@@ -65,7 +65,7 @@ func TestExecuteScreenshotSetUploadCompletesUploadFlow(t *testing.T) {
 		Path:           filePath,
 		DeviceType:     "IPHONE_65",
 		Replace:        true,
-		ClientFactory: func() (*asc.Client, error) {
+		ClientFactory: func(context.Context) (*asc.Client, error) {
 			clientFactoryCalled = true
 			return newAssetsUploadTestClient(t), nil
 		},
@@ -144,7 +144,7 @@ func TestExecuteScreenshotSetUploadRequiresDependencies(t *testing.T) {
 		LocalizationID: "LOC_123",
 		Path:           "unused",
 		DeviceType:     "IPHONE_65",
-		ClientFactory: func() (*asc.Client, error) {
+		ClientFactory: func(context.Context) (*asc.Client, error) {
 			t.Fatal("client factory should not be called when build result is missing")
 			return nil, nil
 		},
@@ -163,7 +163,7 @@ func TestExecuteScreenshotSetUploadInvalidDeviceTypeUsageMode(t *testing.T) {
 			Path:                     "unused",
 			DeviceType:               "ANDROID",
 			InvalidDeviceTypeIsUsage: true,
-			ClientFactory: func() (*asc.Client, error) {
+			ClientFactory: func(context.Context) (*asc.Client, error) {
 				clientFactoryCalled = true
 				return nil, nil
 			},
@@ -195,7 +195,7 @@ func TestExecuteScreenshotSetUploadInvalidDeviceTypeNonUsageMode(t *testing.T) {
 			LocalizationID: "LOC_123",
 			Path:           "unused",
 			DeviceType:     "ANDROID",
-			ClientFactory: func() (*asc.Client, error) {
+			ClientFactory: func(context.Context) (*asc.Client, error) {
 				clientFactoryCalled = true
 				return nil, nil
 			},
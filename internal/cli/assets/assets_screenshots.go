@@ -60,7 +60,7 @@ type ScreenshotSetUploadOptions[T any] struct {
 	Replace                  bool
 	InvalidDeviceTypeIsUsage bool
 
-	ClientFactory  func() (*asc.Client, error)
+	ClientFactory  func(context.Context) (*asc.Client, error)
 	RequestContext func(context.Context) (context.Context, context.CancelFunc)
 	UploadContext  func(context.Context) (context.Context, context.CancelFunc)
 
@@ -96,7 +96,7 @@ type screenshotUploadCommandOptions struct {
 }
 
 type screenshotUploadDependencies struct {
-	GetClient        func() (*asc.Client, error)
+	GetClient        func(context.Context) (*asc.Client, error)
 	RequestContext   func(context.Context) (context.Context, context.CancelFunc)
 	UploadScreenshot func(context.Context, *asc.Client, string, string, []string, bool, bool, bool) (asc.AppScreenshotUploadResult, error)
 	ExecuteUpload    func(context.Context, screenshotUploadConfig[asc.AppScreenshotUploadResult], string) (asc.AppScreenshotUploadResult, error)
@@ -256,7 +256,7 @@ func ExecuteScreenshotSetUpload[T any](ctx context.Context, opts ScreenshotSetUp
 		return zero, err
 	}
 
-	client, err := opts.ClientFactory()
+	client, err := opts.ClientFactory(ctx)
 	if err != nil {
 		return zero, err
 	}
@@ -305,7 +305,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("screenshots list: %w", err)
 			}
@@ -334,7 +334,7 @@ Examples:
 				})
 			}
 
-			return shared.PrintOutput(&result, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, &result, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -387,7 +387,7 @@ Examples:
 				result.Sizes = focusedScreenshotSizeCatalog()
 			}
 
-			return shared.PrintOutput(&result, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, &result, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -456,14 +456,14 @@ Examples:
 					return shared.UsageError("--resume cannot be combined with --skip-existing, --replace, or --dry-run")
 				}
 
-				client, err := shared.GetASCClient()
+				client, err := shared.GetASCClient(ctx)
 				if err != nil {
 					return fmt.Errorf("screenshots upload: %w", err)
 				}
 
 				result, err := resumeAppScreenshotUpload(ctx, client, resumePath)
 				if hasAppScreenshotUploadResultOutput(result) {
-					if printErr := shared.PrintOutput(&result, *output.Output, *output.Pretty); printErr != nil {
+					if printErr := shared.PrintOutput(ctx, &result, *output.Output, *output.Pretty); printErr != nil {
 						return printErr
 					}
 				}
@@ -500,7 +500,7 @@ Examples:
 					}
 				}
 				if shouldPrint {
-					if printErr := shared.PrintOutput(result, *output.Output, *output.Pretty); printErr != nil {
+					if printErr := shared.PrintOutput(ctx, result, *output.Output, *output.Pretty); printErr != nil {
 						return printErr
 					}
 				}
@@ -543,7 +543,7 @@ func executeScreenshotUploadCommand(ctx context.Context, opts screenshotUploadCo
 	}
 
 	if locID == "" {
-		resolvedAppValue := shared.ResolveAppID(appFlagValue)
+		resolvedAppValue := shared.ResolveAppID(ctx, appFlagValue)
 		if resolvedAppValue == "" {
 			fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
 			return nil, flag.ErrHelp
@@ -588,7 +588,7 @@ func executeScreenshotUploadCommand(ctx context.Context, opts screenshotUploadCo
 		if err := validateScreenshotDimensions(files, apiDisplayType); err != nil {
 			return nil, err
 		}
-		client, err := deps.GetClient()
+		client, err := deps.GetClient(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -617,7 +617,7 @@ func executeScreenshotUploadCommand(ctx context.Context, opts screenshotUploadCo
 		return nil, err
 	}
 
-	client, err := deps.GetClient()
+	client, err := deps.GetClient(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1073,7 +1073,7 @@ Examples:
 				}
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("screenshots download: %w", err)
 			}
@@ -1114,7 +1114,7 @@ Examples:
 					result.Total = 1
 					result.Failed = 1
 
-					if err := shared.PrintOutputWithRenderers(
+					if err := shared.PrintOutputWithRenderers(ctx,
 						result,
 						*format.Output,
 						*format.Pretty,
@@ -1252,7 +1252,7 @@ Examples:
 			result.Total = len(items)
 			result.Failed = len(result.Failures)
 
-			if err := shared.PrintOutputWithRenderers(
+			if err := shared.PrintOutputWithRenderers(ctx,
 				result,
 				*format.Output,
 				*format.Pretty,
@@ -1351,7 +1351,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("screenshots delete: %w", err)
 			}
@@ -1368,7 +1368,7 @@ Examples:
 				Deleted: true,
 			}
 
-			return shared.PrintOutput(&result, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, &result, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -528,7 +528,7 @@ func TestExecuteScreenshotUploadCommandValidatesFanoutFilesBeforeClientCreation(
 		Path:       rootDir,
 		DeviceType: "IPHONE_65",
 	}, screenshotUploadDependencies{
-		GetClient: func() (*asc.Client, error) {
+		GetClient: func(context.Context) (*asc.Client, error) {
 			clientCalled = true
 			return nil, nil
 		},
@@ -562,7 +562,7 @@ func TestExecuteScreenshotUploadCommandUsesASCAppIDFallbackForExplicitAppMode(t
 		Path:       rootDir,
 		DeviceType: "IPHONE_65",
 	}, screenshotUploadDependencies{
-		GetClient: func() (*asc.Client, error) {
+		GetClient: func(context.Context) (*asc.Client, error) {
 			clientCalled = true
 			return nil, sentinelErr
 		},
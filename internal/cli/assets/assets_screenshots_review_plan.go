@@ -126,7 +126,7 @@ Examples:
 			if err != nil {
 				return err
 			}
-			if err := shared.PrintOutputWithRenderers(
+			if err := shared.PrintOutputWithRenderers(ctx,
 				result,
 				*output.Output,
 				*output.Pretty,
@@ -195,7 +195,7 @@ Examples:
 			if err != nil {
 				return err
 			}
-			if err := shared.PrintOutputWithRenderers(
+			if err := shared.PrintOutputWithRenderers(ctx,
 				result,
 				*output.Output,
 				*output.Pretty,
@@ -213,7 +213,7 @@ Examples:
 }
 
 func executeScreenshotReviewPlan(ctx context.Context, opts screenshotReviewPlanOptions) (*screenshotReviewPlanResult, error) {
-	resolvedAppID := shared.ResolveAppID(opts.AppID)
+	resolvedAppID := shared.ResolveAppID(ctx, opts.AppID)
 	if strings.TrimSpace(resolvedAppID) == "" {
 		fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
 		return nil, flag.ErrHelp
@@ -237,7 +237,7 @@ func executeScreenshotReviewPlan(ctx context.Context, opts screenshotReviewPlanO
 		return nil, shared.UsageError(err.Error())
 	}
 
-	client, err := shared.GetASCClient()
+	client, err := shared.GetASCClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("screenshots %s: %w", reviewPlanVerb(opts.Apply), err)
 	}
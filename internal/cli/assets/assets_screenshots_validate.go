@@ -101,7 +101,7 @@ Examples:
 				return fmt.Errorf("screenshots validate: %w", err)
 			}
 
-			if err := shared.PrintOutputWithRenderers(
+			if err := shared.PrintOutputWithRenderers(ctx,
 				result,
 				*output.Output,
 				*output.Pretty,
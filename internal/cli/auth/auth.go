@@ -142,7 +142,7 @@ Examples:
 				Created:    true,
 				Config:     template,
 			}
-			return shared.PrintOutput(result, "json", false)
+			return shared.PrintOutput(ctx, result, "json", false)
 		},
 	}
 }
@@ -162,7 +162,8 @@ func AuthDoctorCommand() *ffcli.Command {
 		LongHelp: `Diagnose authentication configuration issues.
 
 Runs a comprehensive health check across keychain availability, config files,
-stored profiles, private key files, and environment variables.
+stored profiles, private key files, environment variables, and per-profile
+network settings (base URL, proxy, CA bundle, client certificate).
 
 Examples:
   asc auth doctor
@@ -184,7 +185,7 @@ Examples:
 				doctorMigrationSuggestionResolver(),
 			)
 			if normalizedOutput == "json" {
-				if err := shared.PrintOutput(report, "json", *output.Pretty); err != nil {
+				if err := shared.PrintOutput(ctx, report, "json", *output.Pretty); err != nil {
 					return err
 				}
 			} else {
@@ -257,7 +258,7 @@ func doctorMigrationSuggestionResolver() authsvc.MigrationSuggestionResolver {
 			return result
 		}
 
-		client, err := shared.GetASCClient()
+		client, err := shared.GetASCClient(context.Background())
 		if err != nil {
 			return result
 		}
@@ -341,7 +342,7 @@ func validateStoredCredential(ctx context.Context, cred authsvc.Credential) erro
 		if err != nil {
 			return fmt.Errorf("invalid private key: %w", err)
 		}
-		client, err = asc.NewClientFromPEM(cred.KeyID, cred.IssuerID, pemValue)
+		client, err = asc.NewClientFromPEM(cred.KeyID, cred.IssuerID, pemValue, asc.WithProfile(cred.Name))
 		if err != nil {
 			return err
 		}
@@ -353,7 +354,7 @@ func validateStoredCredential(ctx context.Context, cred authsvc.Credential) erro
 		if err != nil {
 			return fmt.Errorf("failed to load private key: %w", err)
 		}
-		client, err = asc.NewClient(cred.KeyID, cred.IssuerID, cred.PrivateKeyPath)
+		client, err = asc.NewClient(cred.KeyID, cred.IssuerID, cred.PrivateKeyPath, asc.WithProfile(cred.Name))
 		if err != nil {
 			return err
 		}
@@ -817,7 +818,7 @@ Examples:
 						payload.ConfigPath = configPath
 					}
 				}
-				if err := shared.PrintOutput(payload, "json", *output.Pretty); err != nil {
+				if err := shared.PrintOutput(ctx, payload, "json", *output.Pretty); err != nil {
 					return err
 				}
 			}
@@ -948,7 +949,7 @@ Examples:
 			}
 
 			if normalizedOutput == "json" {
-				return shared.PrintOutput(struct {
+				return shared.PrintOutput(ctx, struct {
 					IssuerID string `json:"issuerId"`
 					Profile  string `json:"profile,omitempty"`
 				}{
@@ -1021,7 +1022,7 @@ Examples:
 			}
 
 			if normalizedOutput == "json" {
-				return shared.PrintOutput(struct {
+				return shared.PrintOutput(ctx, struct {
 					Token   string `json:"token"`
 					KeyID   string `json:"keyId"`
 					Profile string `json:"profile,omitempty"`
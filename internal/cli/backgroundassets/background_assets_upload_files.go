@@ -77,7 +77,7 @@ Examples:
 				return fmt.Errorf("background-assets upload-files list: %w", err)
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("background-assets upload-files list: %w", err)
 			}
@@ -97,14 +97,12 @@ Examples:
 					return fmt.Errorf("background-assets upload-files list: failed to fetch: %w", err)
 				}
 
-				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				if err := shared.PrintPaginatedOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetBackgroundAssetUploadFiles(ctx, versionIDValue, asc.WithBackgroundAssetUploadFilesNextURL(nextURL))
-				})
-				if err != nil {
+				}, *output.Output, *output.Pretty); err != nil {
 					return fmt.Errorf("background-assets upload-files list: %w", err)
 				}
-
-				return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+				return nil
 			}
 
 			resp, err := client.GetBackgroundAssetUploadFiles(requestCtx, versionIDValue, opts...)
@@ -112,7 +110,7 @@ Examples:
 				return fmt.Errorf("background-assets upload-files list: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -141,7 +139,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("background-assets upload-files get: %w", err)
 			}
@@ -154,7 +152,7 @@ Examples:
 				return fmt.Errorf("background-assets upload-files get: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -217,7 +215,7 @@ Examples:
 				return fmt.Errorf("background-assets upload-files create: file size must be greater than 0")
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("background-assets upload-files create: %w", err)
 			}
@@ -269,7 +267,7 @@ Examples:
 				return fmt.Errorf("background-assets upload-files create: failed to commit upload: %w", err)
 			}
 
-			return shared.PrintOutput(commitResp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, commitResp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -334,7 +332,7 @@ Examples:
 				}
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("background-assets upload-files update: %w", err)
 			}
@@ -377,7 +375,7 @@ Examples:
 				return fmt.Errorf("background-assets upload-files update: failed to update: %w", err)
 			}
 
-			return shared.PrintOutput(commitResp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, commitResp, *output.Output, *output.Pretty)
 		},
 	}
 }
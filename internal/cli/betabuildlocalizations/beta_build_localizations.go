@@ -123,7 +123,7 @@ Examples:
 				return fmt.Errorf("beta-build-localizations list: %w", err)
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("beta-build-localizations list: %w", err)
 			}
@@ -147,13 +147,12 @@ Examples:
 						return fmt.Errorf("beta-build-localizations list: failed to fetch: %w", err)
 					}
 
-					resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+					if err := shared.PrintPaginatedOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 						return client.ListBetaBuildLocalizations(ctx, asc.WithBetaBuildLocalizationsNextURL(nextURL))
-					})
-					if err != nil {
+					}, *output.Output, *output.Pretty); err != nil {
 						return fmt.Errorf("beta-build-localizations list: %w", err)
 					}
-					return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+					return nil
 				}
 
 				resp, err := client.ListBetaBuildLocalizations(requestCtx, opts...)
@@ -161,7 +160,7 @@ Examples:
 					return fmt.Errorf("beta-build-localizations list: failed to fetch: %w", err)
 				}
 
-				return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+				return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 			}
 
 			if *paginate {
@@ -171,13 +170,12 @@ Examples:
 					return fmt.Errorf("beta-build-localizations list: failed to fetch: %w", err)
 				}
 
-				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				if err := shared.PrintPaginatedOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetBetaBuildLocalizations(ctx, buildValue, asc.WithBetaBuildLocalizationsNextURL(nextURL))
-				})
-				if err != nil {
+				}, *output.Output, *output.Pretty); err != nil {
 					return fmt.Errorf("beta-build-localizations list: %w", err)
 				}
-				return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+				return nil
 			}
 
 			resp, err := client.GetBetaBuildLocalizations(requestCtx, buildValue, opts...)
@@ -185,7 +183,7 @@ Examples:
 				return fmt.Errorf("beta-build-localizations list: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -256,7 +254,7 @@ Examples:
 				}
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("beta-build-localizations get: %w", err)
 			}
@@ -299,7 +297,7 @@ Examples:
 					Data:  localizations.Data[0],
 					Links: localizations.Links,
 				}
-				return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+				return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 			}
 
 			resp, err := client.GetBetaBuildLocalization(requestCtx, idValue)
@@ -307,7 +305,7 @@ Examples:
 				return fmt.Errorf("beta-build-localizations get: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -382,7 +380,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("beta-build-localizations create: %w", err)
 			}
@@ -415,7 +413,7 @@ Examples:
 				}
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -451,7 +449,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("beta-build-localizations update: %w", err)
 			}
@@ -468,7 +466,7 @@ Examples:
 				return fmt.Errorf("beta-build-localizations update: failed to update: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -502,7 +500,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("beta-build-localizations delete: %w", err)
 			}
@@ -519,7 +517,7 @@ Examples:
 				Deleted: true,
 			}
 
-			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -15,7 +15,7 @@ func resolveLatestBuildIDForBetaBuildLocalizations(
 	appInput string,
 	stateValues []string,
 ) (string, error) {
-	resolvedAppID := shared.ResolveAppID(appInput)
+	resolvedAppID := shared.ResolveAppID(ctx, appInput)
 	if resolvedAppID == "" {
 		return "", shared.UsageError("--app is required with --latest")
 	}
@@ -86,7 +86,7 @@ Examples:
 				return fmt.Errorf("build-localizations list: %w", err)
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("build-localizations list: %w", err)
 			}
@@ -114,20 +114,19 @@ Examples:
 					return fmt.Errorf("build-localizations list: failed to fetch: %w", err)
 				}
 
-				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				if err := shared.PrintPaginatedOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetAppStoreVersionLocalizations(ctx, versionID, asc.WithAppStoreVersionLocalizationsNextURL(nextURL))
-				})
-				if err != nil {
+				}, *output.Output, *output.Pretty); err != nil {
 					return fmt.Errorf("build-localizations list: %w", err)
 				}
-				return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+				return nil
 			}
 
 			resp, err := client.GetAppStoreVersionLocalizations(requestCtx, versionID, opts...)
 			if err != nil {
 				return fmt.Errorf("build-localizations list: failed to fetch: %w", err)
 			}
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -156,7 +155,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("build-localizations get: %w", err)
 			}
@@ -169,7 +168,7 @@ Examples:
 				return fmt.Errorf("build-localizations get: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -212,7 +211,7 @@ Examples:
 
 			whatsNewValue := strings.TrimSpace(*whatsNew)
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("build-localizations create: %w", err)
 			}
@@ -246,7 +245,7 @@ Examples:
 				warnings = append(warnings, warning)
 			}
 
-			if err := shared.PrintOutput(resp, *output.Output, *output.Pretty); err != nil {
+			if err := shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty); err != nil {
 				return err
 			}
 			return shared.PrintSubmitReadinessCreateWarnings(os.Stderr, warnings)
@@ -285,7 +284,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("build-localizations update: %w", err)
 			}
@@ -302,7 +301,7 @@ Examples:
 				return fmt.Errorf("build-localizations update: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -336,7 +335,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("build-localizations delete: %w", err)
 			}
@@ -353,7 +352,7 @@ Examples:
 				Deleted: true,
 			}
 
-			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -94,8 +94,8 @@ func (s buildSelectorFlags) resolveOptions() ResolveBuildOptions {
 	}
 }
 
-func (s buildSelectorFlags) validate() error {
-	return validateResolveBuildOptions(s.resolveOptions())
+func (s buildSelectorFlags) validate(ctx context.Context) error {
+	return validateResolveBuildOptions(ctx, s.resolveOptions())
 }
 
 func (s buildSelectorFlags) resolveBuild(ctx context.Context, client *asc.Client) (*asc.BuildResponse, error) {
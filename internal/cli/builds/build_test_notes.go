@@ -101,12 +101,12 @@ Examples:
 				return fmt.Errorf("builds test-notes list: %w", err)
 			}
 			if strings.TrimSpace(*next) == "" {
-				if err := validateResolveBuildOptions(selectors.resolveOptions()); err != nil {
+				if err := validateResolveBuildOptions(ctx, selectors.resolveOptions()); err != nil {
 					return fmt.Errorf("builds test-notes list: %w", err)
 				}
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("builds test-notes list: %w", err)
 			}
@@ -130,7 +130,7 @@ Examples:
 				paginateOpts := append(opts, asc.WithBetaBuildLocalizationsLimit(200))
 				requestCtx, cancel := shared.ContextWithTimeout(ctx)
 				defer cancel()
-				resp, err := shared.PaginateWithSpinner(
+				if err := shared.PrintPaginatedOutputWithSpinner(
 					requestCtx,
 					func(ctx context.Context) (asc.PaginatedResponse, error) {
 						return client.ListBetaBuildLocalizations(ctx, paginateOpts...)
@@ -138,11 +138,12 @@ Examples:
 					func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 						return client.ListBetaBuildLocalizations(ctx, asc.WithBetaBuildLocalizationsNextURL(nextURL))
 					},
-				)
-				if err != nil {
+					*output.Output,
+					*output.Pretty,
+				); err != nil {
 					return fmt.Errorf("builds test-notes list: %w", err)
 				}
-				return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+				return nil
 			}
 
 			requestCtx, cancel := shared.ContextWithTimeout(ctx)
@@ -152,7 +153,7 @@ Examples:
 			if err != nil {
 				return fmt.Errorf("builds test-notes list: failed to fetch: %w", err)
 			}
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -196,11 +197,11 @@ Examples:
 
 			id := strings.TrimSpace(*localizationID)
 			localeValue := strings.TrimSpace(*locale)
-			if err := validateTestNotesLocalizationTarget(id, localeValue, selectors); err != nil {
+			if err := validateTestNotesLocalizationTarget(ctx, id, localeValue, selectors); err != nil {
 				return fmt.Errorf("builds test-notes view: %w", err)
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("builds test-notes view: %w", err)
 			}
@@ -213,14 +214,14 @@ Examples:
 				if err != nil {
 					return fmt.Errorf("builds test-notes view: %w", err)
 				}
-				return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+				return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 			}
 
 			resp, err := resolveTestNotesLocalization(ctx, client, selectors, localeValue)
 			if err != nil {
 				return fmt.Errorf("builds test-notes view: %w", err)
 			}
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -278,7 +279,7 @@ Examples:
 			if err := shared.ValidateBuildLocalizationLocale(localeValue); err != nil {
 				return fmt.Errorf("builds test-notes create: %w", err)
 			}
-			if err := validateResolveBuildOptions(selectors.resolveOptions()); err != nil {
+			if err := validateResolveBuildOptions(ctx, selectors.resolveOptions()); err != nil {
 				return fmt.Errorf("builds test-notes create: %w", err)
 			}
 
@@ -288,7 +289,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("builds test-notes create: %w", err)
 			}
@@ -310,7 +311,7 @@ Examples:
 				return fmt.Errorf("builds test-notes create: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -354,7 +355,7 @@ Examples:
 
 			id := strings.TrimSpace(*localizationID)
 			localeValue := strings.TrimSpace(*locale)
-			if err := validateTestNotesLocalizationTarget(id, localeValue, selectors); err != nil {
+			if err := validateTestNotesLocalizationTarget(ctx, id, localeValue, selectors); err != nil {
 				return fmt.Errorf("builds test-notes update: %w", err)
 			}
 
@@ -364,7 +365,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("builds test-notes update: %w", err)
 			}
@@ -389,7 +390,7 @@ Examples:
 				return fmt.Errorf("builds test-notes update: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -433,7 +434,7 @@ Examples:
 
 			id := strings.TrimSpace(*localizationID)
 			localeValue := strings.TrimSpace(*locale)
-			if err := validateTestNotesLocalizationTarget(id, localeValue, selectors); err != nil {
+			if err := validateTestNotesLocalizationTarget(ctx, id, localeValue, selectors); err != nil {
 				return fmt.Errorf("builds test-notes delete: %w", err)
 			}
 			if !*confirm {
@@ -441,7 +442,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("builds test-notes delete: %w", err)
 			}
@@ -466,7 +467,7 @@ Examples:
 				Deleted: true,
 			}
 
-			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -496,7 +497,7 @@ func (f testNotesBuildSelectorFlags) hasAnyInputs() bool {
 
 func (f testNotesBuildSelectorFlags) resolveBuild(ctx context.Context, client *asc.Client) (*asc.BuildResponse, error) {
 	opts := f.resolveOptions()
-	if err := validateResolveBuildOptions(opts); err != nil {
+	if err := validateResolveBuildOptions(ctx, opts); err != nil {
 		return nil, err
 	}
 
@@ -517,7 +518,7 @@ func applyLegacyLocalizationIDAlias(localizationID *string, legacyLocalizationID
 	return applyLegacyStringAlias(localizationID, legacyLocalizationID, "--id", "--localization-id", legacyLocalizationIDWarning)
 }
 
-func validateTestNotesLocalizationTarget(localizationID, locale string, selectors testNotesBuildSelectorFlags) error {
+func validateTestNotesLocalizationTarget(ctx context.Context, localizationID, locale string, selectors testNotesBuildSelectorFlags) error {
 	localizationIDValue := strings.TrimSpace(localizationID)
 	localeValue := strings.TrimSpace(locale)
 	if localizationIDValue != "" {
@@ -532,7 +533,7 @@ func validateTestNotesLocalizationTarget(localizationID, locale string, selector
 	if err := shared.ValidateBuildLocalizationLocale(localeValue); err != nil {
 		return err
 	}
-	return validateResolveBuildOptions(selectors.resolveOptions())
+	return validateResolveBuildOptions(ctx, selectors.resolveOptions())
 }
 
 func resolveTestNotesLocalization(ctx context.Context, client *asc.Client, selectors testNotesBuildSelectorFlags, locale string) (*asc.BetaBuildLocalizationResponse, error) {
@@ -43,7 +43,7 @@ Examples:
 			if err := selectors.applyLegacyAliases(); err != nil {
 				return err
 			}
-			if err := selectors.validate(); err != nil {
+			if err := selectors.validate(ctx); err != nil {
 				return err
 			}
 
@@ -61,7 +61,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("builds add-groups: %w", err)
 			}
@@ -115,7 +115,7 @@ Examples:
 					GroupIDs: []string{},
 					Action:   "added",
 				}
-				return shared.PrintOutput(result, *output.Output, *output.Pretty)
+				return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 			}
 
 			result := &asc.BuildBetaGroupsUpdateResult{
@@ -124,7 +124,7 @@ Examples:
 				Action:   "added",
 			}
 
-			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -190,7 +190,7 @@ Examples:
 			if err := selectors.applyLegacyAliases(); err != nil {
 				return err
 			}
-			if err := selectors.validate(); err != nil {
+			if err := selectors.validate(ctx); err != nil {
 				return err
 			}
 
@@ -212,7 +212,7 @@ Examples:
 				return shared.UsageError("--uses-non-exempt-encryption must be 'true' or 'false'")
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("builds update: %w", err)
 			}
@@ -231,7 +231,7 @@ Examples:
 			}
 
 			fmt.Fprintf(os.Stderr, "Updated build %s\n", buildID)
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -261,7 +261,7 @@ Examples:
 			if err := selectors.applyLegacyAliases(); err != nil {
 				return err
 			}
-			if err := selectors.validate(); err != nil {
+			if err := selectors.validate(ctx); err != nil {
 				return err
 			}
 
@@ -275,7 +275,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("builds remove-groups: %w", err)
 			}
@@ -299,7 +299,7 @@ Examples:
 				Action:   "removed",
 			}
 
-			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 		},
 	}
 }
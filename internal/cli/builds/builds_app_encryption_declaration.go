@@ -56,11 +56,11 @@ Examples:
 			if err := selectors.applyLegacyAliases(); err != nil {
 				return err
 			}
-			if err := selectors.validate(); err != nil {
+			if err := selectors.validate(ctx); err != nil {
 				return err
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("builds app-encryption-declaration view: %w", err)
 			}
@@ -78,7 +78,7 @@ Examples:
 				return fmt.Errorf("builds app-encryption-declaration view: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
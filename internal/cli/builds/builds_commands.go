@@ -64,7 +64,7 @@ Examples:
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			// Validate required flags
-			resolvedAppID := shared.ResolveAppID(*appID)
+			resolvedAppID := shared.ResolveAppID(ctx, *appID)
 			if resolvedAppID == "" {
 				fmt.Fprintf(os.Stderr, "Error: --app is required (or set ASC_APP_ID)\n\n")
 				return flag.ErrHelp
@@ -213,7 +213,7 @@ Examples:
 				return fmt.Errorf("builds upload: missing Info.plist keys %s; provide %s", strings.Join(missingFields, " and "), strings.Join(missingFlags, " and "))
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("builds upload: %w", err)
 			}
@@ -321,7 +321,7 @@ Examples:
 
 			format := *output.Output
 
-			return shared.PrintOutput(result, format, *output.Pretty)
+			return shared.PrintOutput(ctx, result, format, *output.Pretty)
 		},
 	}
 }
@@ -468,13 +468,13 @@ Examples:
 				return err
 			}
 
-			resolvedAppID := shared.ResolveAppID(*appID)
+			resolvedAppID := shared.ResolveAppID(ctx, *appID)
 			if resolvedAppID == "" && nextValue == "" {
 				fmt.Fprintf(os.Stderr, "Error: --app is required (or set ASC_APP_ID)\n\n")
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("builds: %w", err)
 			}
@@ -496,7 +496,7 @@ Examples:
 					return fmt.Errorf("builds: %w", err)
 				}
 				if len(preReleaseVersionIDs) == 0 {
-					return shared.PrintOutput(&asc.BuildsResponse{Data: []asc.Resource[asc.BuildAttributes]{}}, *output.Output, *output.Pretty)
+					return shared.PrintOutput(ctx, &asc.BuildsResponse{Data: []asc.Resource[asc.BuildAttributes]{}}, *output.Output, *output.Pretty)
 				}
 			}
 
@@ -523,7 +523,7 @@ Examples:
 
 			if *paginate {
 				paginateOpts := append(opts, asc.WithBuildsLimit(200))
-				builds, err := shared.PaginateWithSpinner(
+				if err := shared.PrintPaginatedOutputWithSpinner(
 					requestCtx,
 					func(ctx context.Context) (asc.PaginatedResponse, error) {
 						return client.GetBuilds(ctx, resolvedAppID, paginateOpts...)
@@ -531,13 +531,12 @@ Examples:
 					func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 						return client.GetBuilds(ctx, resolvedAppID, asc.WithBuildsNextURL(nextURL))
 					},
-				)
-				if err != nil {
+					*output.Output,
+					*output.Pretty,
+				); err != nil {
 					return fmt.Errorf("builds: %w", err)
 				}
-
-				format := *output.Output
-				return shared.PrintOutput(builds, format, *output.Pretty)
+				return nil
 			}
 
 			builds, err := client.GetBuilds(requestCtx, resolvedAppID, opts...)
@@ -547,7 +546,7 @@ Examples:
 
 			format := *output.Output
 
-			return shared.PrintOutput(builds, format, *output.Pretty)
+			return shared.PrintOutput(ctx, builds, format, *output.Pretty)
 		},
 	}
 }
@@ -739,11 +738,11 @@ Examples:
 				ProcessingStateValues: processingStateValues,
 				ExcludeExpired:        excludeExpiredValue,
 			}
-			if err := validateResolveBuildOptions(resolveOpts); err != nil {
+			if err := validateResolveBuildOptions(ctx, resolveOpts); err != nil {
 				return err
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("builds info: %w", err)
 			}
@@ -761,7 +760,7 @@ Examples:
 
 			format := *output.Output
 
-			return shared.PrintOutput(build, format, *output.Pretty)
+			return shared.PrintOutput(ctx, build, format, *output.Pretty)
 		},
 	}
 }
@@ -805,7 +804,7 @@ Examples:
 			if err := selectors.applyLegacyAliases(); err != nil {
 				return err
 			}
-			if err := selectors.validate(); err != nil {
+			if err := selectors.validate(ctx); err != nil {
 				return err
 			}
 			if !*confirm {
@@ -813,7 +812,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("builds expire: %w", err)
 			}
@@ -833,7 +832,7 @@ Examples:
 
 			format := *output.Output
 
-			return shared.PrintOutput(build, format, *output.Pretty)
+			return shared.PrintOutput(ctx, build, format, *output.Pretty)
 		},
 	}
 }
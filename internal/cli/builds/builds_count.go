@@ -48,7 +48,7 @@ Examples:
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
-			resolvedAppID := shared.ResolveAppID(*appID)
+			resolvedAppID := shared.ResolveAppID(ctx, *appID)
 			if resolvedAppID == "" {
 				fmt.Fprintf(os.Stderr, "Error: --app is required (or set ASC_APP_ID)\n\n")
 				return flag.ErrHelp
@@ -69,7 +69,7 @@ Examples:
 				return err
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("builds count: %w", err)
 			}
@@ -93,7 +93,7 @@ Examples:
 				}
 				if len(preReleaseVersionIDs) == 0 {
 					result := &asc.BuildsCountResult{AppID: resolvedAppID, Total: 0}
-					return shared.PrintOutput(result, *output.Output, *output.Pretty)
+					return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 				}
 			}
 
@@ -122,7 +122,7 @@ Examples:
 			total, ok := asc.ParsePagingTotalOK(resp.Meta)
 			if ok {
 				result := &asc.BuildsCountResult{AppID: resolvedAppID, Total: total}
-				return shared.PrintOutput(result, *output.Output, *output.Pretty)
+				return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 			}
 
 			// Some ASC responses omit paging.total. Fall back to paginating and
@@ -142,7 +142,7 @@ Examples:
 			}
 
 			result := &asc.BuildsCountResult{AppID: resolvedAppID, Total: total}
-			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 		},
 	}
 }
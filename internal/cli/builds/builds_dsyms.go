@@ -96,7 +96,7 @@ Examples:
 				Platform:    strings.TrimSpace(*platform),
 				Latest:      *latest,
 			}
-			if err := validateResolveBuildOptions(resolveOpts); err != nil {
+			if err := validateResolveBuildOptions(ctx, resolveOpts); err != nil {
 				return fmt.Errorf("builds dsyms: %w", err)
 			}
 
@@ -105,7 +105,7 @@ Examples:
 				dirValue = "."
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("builds dsyms: %w", err)
 			}
@@ -157,7 +157,7 @@ Examples:
 					Dir:         dirValue,
 					Files:       []DSYMDownloadFile{},
 				}
-				return shared.PrintOutputWithRenderers(
+				return shared.PrintOutputWithRenderers(ctx,
 					result,
 					*output.Output,
 					*output.Pretty,
@@ -200,7 +200,7 @@ Examples:
 				Files:       files,
 			}
 
-			return shared.PrintOutputWithRenderers(
+			return shared.PrintOutputWithRenderers(ctx,
 				result,
 				*output.Output,
 				*output.Pretty,
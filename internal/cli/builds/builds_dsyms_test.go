@@ -1,6 +1,7 @@
 package builds
 
 import (
+	"context"
 	"errors"
 	"flag"
 	"testing"
@@ -176,7 +177,7 @@ func TestResolveBuildOptions_RejectsConflictingSelectors(t *testing.T) {
 func TestValidateResolveBuildOptions_BuildIgnoresDefaultAppID(t *testing.T) {
 	t.Setenv("ASC_APP_ID", "default-app")
 
-	err := validateResolveBuildOptions(ResolveBuildOptions{
+	err := validateResolveBuildOptions(context.Background(), ResolveBuildOptions{
 		BuildID: "build-1",
 	})
 	if err != nil {
@@ -51,7 +51,7 @@ Examples:
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
-			resolvedAppID := shared.ResolveAppID(*appID)
+			resolvedAppID := shared.ResolveAppID(ctx, *appID)
 			if resolvedAppID == "" {
 				fmt.Fprintf(os.Stderr, "Error: --app is required (or set ASC_APP_ID)\n\n")
 				return flag.ErrHelp
@@ -80,7 +80,7 @@ Examples:
 				olderThanThreshold = threshold
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("builds expire-all: %w", err)
 			}
@@ -210,7 +210,7 @@ Examples:
 				Failures:            failures,
 			}
 
-			if err := shared.PrintOutput(result, *output.Output, *output.Pretty); err != nil {
+			if err := shared.PrintOutput(ctx, result, *output.Output, *output.Pretty); err != nil {
 				return err
 			}
 
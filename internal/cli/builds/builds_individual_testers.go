@@ -77,12 +77,12 @@ Examples:
 
 			nextValue := strings.TrimSpace(*next)
 			if nextValue == "" {
-				if err := selectors.validate(); err != nil {
+				if err := selectors.validate(ctx); err != nil {
 					return err
 				}
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("builds individual-testers list: %w", err)
 			}
@@ -105,7 +105,7 @@ Examples:
 
 			if *paginate {
 				paginateOpts := append(opts, asc.WithBuildIndividualTestersLimit(200))
-				resp, err := shared.PaginateWithSpinner(
+				if err := shared.PrintPaginatedOutputWithSpinner(
 					requestCtx,
 					func(ctx context.Context) (asc.PaginatedResponse, error) {
 						return client.GetBuildIndividualTesters(ctx, buildID, paginateOpts...)
@@ -113,12 +113,12 @@ Examples:
 					func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 						return client.GetBuildIndividualTesters(ctx, buildID, asc.WithBuildIndividualTestersNextURL(nextURL))
 					},
-				)
-				if err != nil {
+					*output.Output,
+					*output.Pretty,
+				); err != nil {
 					return fmt.Errorf("builds individual-testers list: %w", err)
 				}
-
-				return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+				return nil
 			}
 
 			resp, err := client.GetBuildIndividualTesters(requestCtx, buildID, opts...)
@@ -126,7 +126,7 @@ Examples:
 				return fmt.Errorf("builds individual-testers list: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -155,7 +155,7 @@ Examples:
 			if err := selectors.applyLegacyAliases(); err != nil {
 				return err
 			}
-			if err := selectors.validate(); err != nil {
+			if err := selectors.validate(ctx); err != nil {
 				return err
 			}
 
@@ -165,7 +165,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("builds individual-testers add: %w", err)
 			}
@@ -188,7 +188,7 @@ Examples:
 				Action:    "added",
 			}
 
-			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -218,7 +218,7 @@ Examples:
 			if err := selectors.applyLegacyAliases(); err != nil {
 				return err
 			}
-			if err := selectors.validate(); err != nil {
+			if err := selectors.validate(ctx); err != nil {
 				return err
 			}
 
@@ -232,7 +232,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("builds individual-testers remove: %w", err)
 			}
@@ -255,7 +255,7 @@ Examples:
 				Action:    "removed",
 			}
 
-			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -51,14 +51,14 @@ func BuildsLatestCommand() *ffcli.Command {
 			}
 
 			excludeExpiredValue := *excludeExpired || *notExpired
-			selectionOpts, err := normalizeLatestBuildSelectionOptions(*appID, *version, *platform, *processingState, excludeExpiredValue)
+			selectionOpts, err := normalizeLatestBuildSelectionOptions(ctx, *appID, *version, *platform, *processingState, excludeExpiredValue)
 			if err != nil {
 				return err
 			}
 			if *next && *initialBuildNumber < 1 {
 				return shared.UsageError("--initial-build-number must be >= 1")
 			}
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("builds latest: %w", err)
 			}
@@ -74,14 +74,14 @@ func BuildsLatestCommand() *ffcli.Command {
 				if err != nil {
 					return fmt.Errorf("builds latest: %w", err)
 				}
-				return shared.PrintOutput(result, *output.Output, *output.Pretty)
+				return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 			}
 
 			build, err := resolveLatestBuild(requestCtx, client, selectionOpts)
 			if err != nil {
 				return fmt.Errorf("builds latest: %w", err)
 			}
-			return shared.PrintOutput(build, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, build, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -117,7 +117,7 @@ Examples:
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			excludeExpiredValue := *excludeExpired || *notExpired
-			selectionOpts, err := normalizeLatestBuildSelectionOptions(*appID, *version, *platform, *processingState, excludeExpiredValue)
+			selectionOpts, err := normalizeLatestBuildSelectionOptions(ctx, *appID, *version, *platform, *processingState, excludeExpiredValue)
 			if err != nil {
 				return err
 			}
@@ -125,7 +125,7 @@ Examples:
 				return shared.UsageError("--initial-build-number must be >= 1")
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("builds next-build-number: %w", err)
 			}
@@ -140,13 +140,13 @@ Examples:
 			if err != nil {
 				return fmt.Errorf("builds next-build-number: %w", err)
 			}
-			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 		},
 	}
 }
 
-func normalizeLatestBuildSelectionOptions(appID, version, platform, processingState string, excludeExpired bool) (latestBuildSelectionOptions, error) {
-	opts, err := shared.NormalizeLatestBuildSelectionOptions(appID, version, platform, processingState, excludeExpired)
+func normalizeLatestBuildSelectionOptions(ctx context.Context, appID, version, platform, processingState string, excludeExpired bool) (latestBuildSelectionOptions, error) {
+	opts, err := shared.NormalizeLatestBuildSelectionOptions(ctx, appID, version, platform, processingState, excludeExpired)
 	if err != nil {
 		return latestBuildSelectionOptions{}, err
 	}
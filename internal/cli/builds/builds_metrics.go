@@ -72,12 +72,12 @@ Examples:
 
 			nextValue := strings.TrimSpace(*next)
 			if nextValue == "" {
-				if err := selectors.validate(); err != nil {
+				if err := selectors.validate(ctx); err != nil {
 					return err
 				}
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("builds metrics beta-usages: %w", err)
 			}
@@ -103,7 +103,7 @@ Examples:
 				return fmt.Errorf("builds metrics beta-usages: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -72,11 +72,11 @@ Examples:
 			if err := selectors.applyLegacyAliases(); err != nil {
 				return err
 			}
-			if err := selectors.validate(); err != nil {
+			if err := selectors.validate(ctx); err != nil {
 				return err
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("builds app view: %w", err)
 			}
@@ -94,7 +94,7 @@ Examples:
 				return fmt.Errorf("builds app view: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -146,11 +146,11 @@ Examples:
 			if err := selectors.applyLegacyAliases(); err != nil {
 				return err
 			}
-			if err := selectors.validate(); err != nil {
+			if err := selectors.validate(ctx); err != nil {
 				return err
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("builds pre-release-version view: %w", err)
 			}
@@ -168,7 +168,7 @@ Examples:
 				return fmt.Errorf("builds pre-release-version view: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -232,12 +232,12 @@ Examples:
 
 			nextValue := strings.TrimSpace(*next)
 			if nextValue == "" {
-				if err := selectors.validate(); err != nil {
+				if err := selectors.validate(ctx); err != nil {
 					return err
 				}
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("builds icons list: %w", err)
 			}
@@ -260,7 +260,7 @@ Examples:
 
 			if *paginate {
 				paginateOpts := append(opts, asc.WithBuildIconsLimit(200))
-				resp, err := shared.PaginateWithSpinner(
+				if err := shared.PrintPaginatedOutputWithSpinner(
 					requestCtx,
 					func(ctx context.Context) (asc.PaginatedResponse, error) {
 						return client.GetBuildIcons(ctx, buildID, paginateOpts...)
@@ -268,11 +268,12 @@ Examples:
 					func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 						return client.GetBuildIcons(ctx, buildID, asc.WithBuildIconsNextURL(nextURL))
 					},
-				)
-				if err != nil {
+					*output.Output,
+					*output.Pretty,
+				); err != nil {
 					return fmt.Errorf("builds icons list: %w", err)
 				}
-				return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+				return nil
 			}
 
 			resp, err := client.GetBuildIcons(requestCtx, buildID, opts...)
@@ -280,7 +281,7 @@ Examples:
 				return fmt.Errorf("builds icons list: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -332,11 +333,11 @@ Examples:
 			if err := selectors.applyLegacyAliases(); err != nil {
 				return err
 			}
-			if err := selectors.validate(); err != nil {
+			if err := selectors.validate(ctx); err != nil {
 				return err
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("builds beta-app-review-submission view: %w", err)
 			}
@@ -358,7 +359,7 @@ Examples:
 				return fmt.Errorf("builds beta-app-review-submission view: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -410,11 +411,11 @@ Examples:
 			if err := selectors.applyLegacyAliases(); err != nil {
 				return err
 			}
-			if err := selectors.validate(); err != nil {
+			if err := selectors.validate(ctx); err != nil {
 				return err
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("builds build-beta-detail view: %w", err)
 			}
@@ -432,7 +433,7 @@ Examples:
 				return fmt.Errorf("builds build-beta-detail view: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
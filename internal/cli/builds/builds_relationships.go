@@ -105,7 +105,7 @@ Examples:
 
 			nextValue := strings.TrimSpace(*next)
 			if nextValue == "" {
-				if err := selectors.validate(); err != nil {
+				if err := selectors.validate(ctx); err != nil {
 					return err
 				}
 			}
@@ -115,7 +115,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("builds links view: %w", err)
 			}
@@ -137,7 +137,7 @@ Examples:
 				if err != nil {
 					return fmt.Errorf("builds links view: %w", err)
 				}
-				return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+				return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 			case relationshipList:
 				opts := []asc.LinkagesOption{
 					asc.WithLinkagesLimit(*limit),
@@ -146,7 +146,7 @@ Examples:
 
 				if *paginate {
 					paginateOpts := append(opts, asc.WithLinkagesLimit(200))
-					resp, err := shared.PaginateWithSpinner(
+					if err := shared.PrintPaginatedOutputWithSpinner(
 						requestCtx,
 						func(ctx context.Context) (asc.PaginatedResponse, error) {
 							return getBuildRelationshipList(ctx, client, relationshipType, buildID, paginateOpts...)
@@ -154,18 +154,19 @@ Examples:
 						func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 							return getBuildRelationshipList(ctx, client, relationshipType, buildID, asc.WithLinkagesNextURL(nextURL))
 						},
-					)
-					if err != nil {
+						*output.Output,
+						*output.Pretty,
+					); err != nil {
 						return fmt.Errorf("builds links view: %w", err)
 					}
-					return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+					return nil
 				}
 
 				resp, err := getBuildRelationshipList(requestCtx, client, relationshipType, buildID, opts...)
 				if err != nil {
 					return fmt.Errorf("builds links view: %w", err)
 				}
-				return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+				return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 			default:
 				return fmt.Errorf("builds links view: unsupported relationship type %q", relationshipType)
 			}
@@ -75,7 +75,7 @@ Examples:
 
 			started := time.Now()
 			buildValue := strings.TrimSpace(*buildID)
-			resolvedAppID := shared.ResolveAppID(*appID)
+			resolvedAppID := shared.ResolveAppID(ctx, *appID)
 			versionValue := strings.TrimSpace(*version)
 			buildNumberValue := strings.TrimSpace(*buildNumber)
 			sinceValue := strings.TrimSpace(*since)
@@ -122,7 +122,7 @@ Examples:
 				sinceTime = &parsedSince
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("builds wait: %w", err)
 			}
@@ -195,9 +195,9 @@ Examples:
 			}
 
 			if format == "json" {
-				return shared.PrintOutput(result, format, *output.Pretty)
+				return shared.PrintOutput(ctx, result, format, *output.Pretty)
 			}
-			return shared.PrintOutput(buildResp, format, *output.Pretty)
+			return shared.PrintOutput(ctx, buildResp, format, *output.Pretty)
 		},
 	}
 }
@@ -324,6 +324,7 @@ func waitForBuildProcessingState(
 	failOnInvalid bool,
 ) (*asc.BuildResponse, error) {
 	started := time.Now()
+	ctx = asc.WithoutResponseCache(ctx)
 
 	for {
 		buildResp, err := client.GetBuild(ctx, buildID)
@@ -35,7 +35,7 @@ type buildNumberSelectionOptions struct {
 // Returns the build response or an error. Callers use this to avoid duplicating
 // build lookup logic across commands (dsyms, wait, find, etc.).
 func ResolveBuild(ctx context.Context, client *asc.Client, opts ResolveBuildOptions) (*asc.BuildResponse, error) {
-	if err := validateResolveBuildOptions(opts); err != nil {
+	if err := validateResolveBuildOptions(ctx, opts); err != nil {
 		return nil, err
 	}
 	if client == nil {
@@ -85,7 +85,7 @@ func ResolveBuild(ctx context.Context, client *asc.Client, opts ResolveBuildOpti
 	}, false)
 }
 
-func validateResolveBuildOptions(opts ResolveBuildOptions) error {
+func validateResolveBuildOptions(ctx context.Context, opts ResolveBuildOptions) error {
 	buildID := strings.TrimSpace(opts.BuildID)
 	buildNumber := strings.TrimSpace(opts.BuildNumber)
 	version := strings.TrimSpace(opts.Version)
@@ -103,7 +103,7 @@ func validateResolveBuildOptions(opts ResolveBuildOptions) error {
 		return nil
 	}
 
-	if shared.ResolveAppID(appInput) == "" {
+	if shared.ResolveAppID(ctx, appInput) == "" {
 		return shared.UsageError("--build-id or --app is required (or set ASC_APP_ID)")
 	}
 	if len(opts.ProcessingStateValues) > 0 && !opts.Latest {
@@ -133,7 +133,7 @@ func resolveBuildByNumberSelection(
 		return nil, fmt.Errorf("build client is required")
 	}
 
-	appID := shared.ResolveAppID(strings.TrimSpace(opts.AppID))
+	appID := shared.ResolveAppID(ctx, strings.TrimSpace(opts.AppID))
 	if appID == "" {
 		return nil, shared.UsageError("--app is required (or set ASC_APP_ID)")
 	}
@@ -0,0 +1,200 @@
+package cache
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+type clearResult struct {
+	Dir     string `json:"dir"`
+	Removed int    `json:"removed"`
+}
+
+// CacheCommand returns the cache command group.
+func CacheCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("cache", flag.ExitOnError)
+
+	return &ffcli.Command{
+		Name:       "cache",
+		ShortUsage: "asc cache <subcommand> [flags]",
+		ShortHelp:  "Inspect and clear the on-disk API response cache.",
+		LongHelp: `Inspect and clear the on-disk API response cache.
+
+The response cache is opt-in. Set ASC_CACHE_TTL (for example "10m") or
+"cache_ttl" in config.json to serve repeated GET requests from disk.
+Entries older than the TTL are revalidated with conditional requests
+(If-None-Match / If-Modified-Since) when App Store Connect returned validators.
+Any successful POST, PATCH, or DELETE clears cached responses for that API key.
+
+Entries are stored per API key under ~/.asc/cache/http (override with ASC_CACHE_DIR).
+Use the root --no-cache flag to bypass the cache for a single invocation.
+
+Examples:
+  ASC_CACHE_TTL=10m asc apps list
+  asc --no-cache builds list --app "APP_ID"
+  asc cache stats
+  asc cache clear --confirm`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			CacheStatsCommand(),
+			CacheClearCommand(),
+		},
+		Exec: func(ctx context.Context, args []string) error {
+			return flag.ErrHelp
+		},
+	}
+}
+
+// CacheStatsCommand returns the cache stats subcommand.
+func CacheStatsCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("stats", flag.ExitOnError)
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "stats",
+		ShortUsage: "asc cache stats [flags]",
+		ShortHelp:  "Show response cache size, freshness, and hit counts.",
+		LongHelp: `Show response cache size, freshness, and hit counts.
+
+Freshness is evaluated against the currently configured TTL.
+
+Examples:
+  asc cache stats
+  asc cache stats --output table`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if len(args) > 0 {
+				return shared.UsageErrorf("unexpected argument(s): %s", strings.Join(args, " "))
+			}
+			shared.ApplyRootCacheOverrides()
+
+			stats, err := asc.ReadResponseCacheStats()
+			if err != nil {
+				return fmt.Errorf("cache stats: %w", err)
+			}
+
+			return shared.PrintOutputWithRenderers(ctx,
+				stats,
+				*output.Output,
+				*output.Pretty,
+				func() error { return renderStats(stats, false) },
+				func() error { return renderStats(stats, true) },
+			)
+		},
+	}
+}
+
+// CacheClearCommand returns the cache clear subcommand.
+func CacheClearCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("clear", flag.ExitOnError)
+	confirm := fs.Bool("confirm", false, "Confirm deletion of all cached responses")
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "clear",
+		ShortUsage: "asc cache clear --confirm [flags]",
+		ShortHelp:  "Delete all cached API responses.",
+		LongHelp: `Delete all cached API responses for every API key.
+
+Examples:
+  asc cache clear --confirm`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if len(args) > 0 {
+				return shared.UsageErrorf("unexpected argument(s): %s", strings.Join(args, " "))
+			}
+			if !*confirm {
+				fmt.Fprintln(os.Stderr, "Error: --confirm is required")
+				return flag.ErrHelp
+			}
+
+			dir, err := asc.ResponseCacheDir()
+			if err != nil {
+				return fmt.Errorf("cache clear: %w", err)
+			}
+			removed, err := asc.ClearResponseCache()
+			if err != nil {
+				return fmt.Errorf("cache clear: %w", err)
+			}
+
+			result := clearResult{Dir: dir, Removed: removed}
+			return shared.PrintOutputWithRenderers(ctx,
+				result,
+				*output.Output,
+				*output.Pretty,
+				func() error { return renderClear(result, false) },
+				func() error { return renderClear(result, true) },
+			)
+		},
+	}
+}
+
+func renderStats(stats asc.ResponseCacheStats, markdown bool) error {
+	render := asc.RenderTable
+	if markdown {
+		render = asc.RenderMarkdown
+	}
+
+	render(
+		[]string{"Dir", "Enabled", "TTL", "Entries", "Fresh", "Stale", "Revalidatable", "Hits", "Size", "Oldest", "Newest"},
+		[][]string{{
+			stats.Dir,
+			fmt.Sprintf("%t", stats.Enabled),
+			shared.OrNA(stats.TTL),
+			fmt.Sprintf("%d", stats.Entries),
+			fmt.Sprintf("%d", stats.Fresh),
+			fmt.Sprintf("%d", stats.Stale),
+			fmt.Sprintf("%d", stats.Revalidatable),
+			fmt.Sprintf("%d", stats.Hits),
+			formatBytes(stats.SizeBytes),
+			formatTime(stats.OldestAt),
+			formatTime(stats.NewestAt),
+		}},
+	)
+	return nil
+}
+
+func renderClear(result clearResult, markdown bool) error {
+	render := asc.RenderTable
+	if markdown {
+		render = asc.RenderMarkdown
+	}
+
+	render(
+		[]string{"Dir", "Removed"},
+		[][]string{{result.Dir, fmt.Sprintf("%d", result.Removed)}},
+	)
+	return nil
+}
+
+func formatTime(value *time.Time) string {
+	if value == nil {
+		return "n/a"
+	}
+	return value.UTC().Format(time.RFC3339)
+}
+
+func formatBytes(size int64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%d B", size)
+	}
+	div, exp := int64(unit), 0
+	for n := size / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(size)/float64(div), "KMGTPE"[exp])
+}
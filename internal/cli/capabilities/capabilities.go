@@ -101,7 +101,7 @@ Examples:
 				return fmt.Errorf("capabilities: %w", err)
 			}
 
-			return shared.PrintOutputWithRenderers(
+			return shared.PrintOutputWithRenderers(ctx,
 				report,
 				*output.Output,
 				*output.Pretty,
@@ -68,7 +68,7 @@ Examples:
 				return fmt.Errorf("categories list: --limit must be between 1 and 200")
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("categories list: %w", err)
 			}
@@ -81,7 +81,7 @@ Examples:
 				return fmt.Errorf("categories list: %w", err)
 			}
 
-			return shared.PrintOutput(categories, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, categories, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -200,7 +200,7 @@ Examples:
 				Subject: subject,
 			}
 
-			return shared.PrintOutputWithRenderers(
+			return shared.PrintOutputWithRenderers(ctx,
 				result,
 				*output.Output,
 				*output.Pretty,
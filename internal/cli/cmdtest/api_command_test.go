@@ -0,0 +1,255 @@
+package cmdtest
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/cmd"
+)
+
+func installAPITestTransport(t *testing.T, fn roundTripFunc) {
+	t.Helper()
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = fn
+}
+
+func apiJSONResponse(body string) *http.Response {
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+	}
+}
+
+func TestRun_APIGetPaginatesWithQuery(t *testing.T) {
+	requests := 0
+	installAPITestTransport(t, func(req *http.Request) (*http.Response, error) {
+		requests++
+		if req.Method != http.MethodGet {
+			t.Fatalf("expected GET, got %s", req.Method)
+		}
+		if req.URL.Path != "/v1/apps/app-1/appStoreVersions" {
+			t.Fatalf("unexpected path %s", req.URL.Path)
+		}
+		switch requests {
+		case 1:
+			if got := req.URL.Query().Get("filter[platform]"); got != "IOS" {
+				t.Fatalf("expected filter[platform]=IOS, got %q", got)
+			}
+			return apiJSONResponse(`{
+				"data":[{"type":"appStoreVersions","id":"v1","attributes":{"versionString":"1.0"}}],
+				"links":{"next":"https://api.appstoreconnect.apple.com/v1/apps/app-1/appStoreVersions?cursor=abc"}
+			}`), nil
+		case 2:
+			if got := req.URL.Query().Get("cursor"); got != "abc" {
+				t.Fatalf("expected cursor=abc, got %q", got)
+			}
+			return apiJSONResponse(`{"data":[{"type":"appStoreVersions","id":"v2","attributes":{"versionString":"2.0"}}],"links":{}}`), nil
+		default:
+			t.Fatalf("unexpected request %d", requests)
+			return nil, nil
+		}
+	})
+
+	stdout, stderr := captureOutput(t, func() {
+		code := cmd.Run([]string{
+			"api", "GET", "/v1/apps/app-1/appStoreVersions",
+			"--query", "filter[platform]=IOS",
+			"--paginate",
+		}, "1.0.0")
+		if code != cmd.ExitSuccess {
+			t.Fatalf("expected exit code %d, got %d", cmd.ExitSuccess, code)
+		}
+	})
+	if strings.TrimSpace(stderr) != "" {
+		t.Fatalf("expected empty stderr, got %q", stderr)
+	}
+
+	var out struct {
+		Data []struct {
+			ID string `json:"id"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &out); err != nil {
+		t.Fatalf("failed to parse stdout: %v\nstdout=%s", err, stdout)
+	}
+	if len(out.Data) != 2 || out.Data[0].ID != "v1" || out.Data[1].ID != "v2" {
+		t.Fatalf("expected aggregated pages, got %+v", out.Data)
+	}
+}
+
+func TestRun_APIQuerySeparatesParamsFromExpressions(t *testing.T) {
+	installAPITestTransport(t, func(req *http.Request) (*http.Response, error) {
+		query := req.URL.Query()
+		if got := query.Get("limit"); got != "5" {
+			t.Fatalf("expected limit=5, got %q", got)
+		}
+		if got := query.Get("fields[apps]"); got != "name" {
+			t.Fatalf("expected fields[apps]=name, got %q", got)
+		}
+		if len(query) != 2 {
+			t.Fatalf("expected only key=value params in the URL, got %v", query)
+		}
+		return apiJSONResponse(`{"data":[
+			{"type":"apps","id":"app-1","attributes":{"name":"Alpha"}},
+			{"type":"apps","id":"app-2","attributes":{"name":"Beta"}}
+		],"links":{}}`), nil
+	})
+
+	stdout, stderr := captureOutput(t, func() {
+		code := cmd.Run([]string{
+			"api", "/v1/apps",
+			"--query", "limit=5",
+			"--query", "data[?attributes.name=='Beta'].id",
+			"--param", "fields[apps]=name",
+		}, "1.0.0")
+		if code != cmd.ExitSuccess {
+			t.Fatalf("expected exit code %d, got %d", cmd.ExitSuccess, code)
+		}
+	})
+	if strings.TrimSpace(stderr) != "" {
+		t.Fatalf("expected empty stderr, got %q", stderr)
+	}
+	if got := strings.TrimSpace(stdout); got != `["app-2"]` {
+		t.Fatalf("stdout = %q, want %q", got, `["app-2"]`)
+	}
+}
+
+func TestRun_APIPatchSendsDataFile(t *testing.T) {
+	payloadPath := filepath.Join(t.TempDir(), "app.json")
+	payload := `{"data":{"type":"apps","id":"app-1","attributes":{"primaryLocale":"en-US"}}}`
+	if err := os.WriteFile(payloadPath, []byte(payload), 0o600); err != nil {
+		t.Fatalf("write payload: %v", err)
+	}
+
+	installAPITestTransport(t, func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodPatch || req.URL.Path != "/v1/apps/app-1" {
+			t.Fatalf("unexpected request %s %s", req.Method, req.URL.Path)
+		}
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			t.Fatalf("read body: %v", err)
+		}
+		if string(body) != payload {
+			t.Fatalf("expected body %s, got %s", payload, body)
+		}
+		return apiJSONResponse(`{"data":{"type":"apps","id":"app-1","attributes":{"primaryLocale":"en-US"}}}`), nil
+	})
+
+	stdout, _ := captureOutput(t, func() {
+		code := cmd.Run([]string{"api", "PATCH", "/v1/apps/app-1", "--data", "@" + payloadPath, "--confirm"}, "1.0.0")
+		if code != cmd.ExitSuccess {
+			t.Fatalf("expected exit code %d, got %d", cmd.ExitSuccess, code)
+		}
+	})
+
+	var out struct {
+		Data struct {
+			ID string `json:"id"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &out); err != nil {
+		t.Fatalf("failed to parse stdout: %v\nstdout=%s", err, stdout)
+	}
+	if out.Data.ID != "app-1" {
+		t.Fatalf("expected app-1 in output, got %+v", out)
+	}
+}
+
+func TestRun_APIRejectsInvalidRequestsBeforeSending(t *testing.T) {
+	installAPITestTransport(t, func(req *http.Request) (*http.Response, error) {
+		t.Fatalf("unexpected request %s %s", req.Method, req.URL)
+		return nil, nil
+	})
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "unknown path",
+			args:    []string{"api", "/v1/notAnEndpoint"},
+			wantErr: "not in the schema index",
+		},
+		{
+			name:    "method not allowed",
+			args:    []string{"api", "POST", "/v1/apps/app-1", "--data", `{"data":{}}`, "--confirm"},
+			wantErr: "POST is not supported for /v1/apps/{id}",
+		},
+		{
+			name:    "unknown query parameter",
+			args:    []string{"api", "/v1/apps", "--param", "filter[color]=blue"},
+			wantErr: `query parameter "filter[color]" is not supported`,
+		},
+		{
+			name:    "invalid enum value",
+			args:    []string{"api", "/v1/apps/app-1/appStoreVersions", "--param", "filter[platform]=ANDROID"},
+			wantErr: `invalid value "ANDROID" for filter[platform]`,
+		},
+		{
+			name:    "mutation without confirm",
+			args:    []string{"api", "DELETE", "/v1/betaTesters/tester-1"},
+			wantErr: "--confirm is required for DELETE requests",
+		},
+		{
+			name:    "patch without data",
+			args:    []string{"api", "PATCH", "/v1/apps/app-1", "--confirm"},
+			wantErr: "--data is required for PATCH requests",
+		},
+		{
+			name:    "paginate mutation",
+			args:    []string{"api", "DELETE", "/v1/betaTesters/tester-1", "--paginate", "--confirm"},
+			wantErr: "--paginate is only valid with GET requests",
+		},
+		{
+			name:    "foreign host",
+			args:    []string{"api", "https://example.com/v1/apps"},
+			wantErr: "PATH URL must be on api.appstoreconnect.apple.com",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, stderr := captureOutput(t, func() {
+				code := cmd.Run(tt.args, "1.0.0")
+				if code != cmd.ExitUsage {
+					t.Fatalf("expected exit code %d, got %d", cmd.ExitUsage, code)
+				}
+			})
+			if !strings.Contains(stderr, tt.wantErr) {
+				t.Fatalf("expected stderr to contain %q, got %q", tt.wantErr, stderr)
+			}
+		})
+	}
+}
+
+func TestRun_APISkipSchemaCheckSendsUnknownEndpoint(t *testing.T) {
+	installAPITestTransport(t, func(req *http.Request) (*http.Response, error) {
+		if req.URL.Path != "/v1/brandNewResources" {
+			t.Fatalf("unexpected path %s", req.URL.Path)
+		}
+		return apiJSONResponse(`{"data":[{"type":"brandNewResources","id":"1","attributes":{"name":"New"}}]}`), nil
+	})
+
+	stdout, _ := captureOutput(t, func() {
+		code := cmd.Run([]string{"api", "/v1/brandNewResources", "--skip-schema-check", "--output", "table"}, "1.0.0")
+		if code != cmd.ExitSuccess {
+			t.Fatalf("expected exit code %d, got %d", cmd.ExitSuccess, code)
+		}
+	})
+	if !strings.Contains(stdout, "brandNewResources") || !strings.Contains(stdout, "name=New") {
+		t.Fatalf("expected table output with resource row, got %q", stdout)
+	}
+}
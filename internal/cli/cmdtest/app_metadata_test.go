@@ -183,8 +183,8 @@ func TestLocalizationsMediaSetsOutputErrors(t *testing.T) {
 	}{
 		{
 			name:    "preview sets get unsupported output",
-			args:    []string{"localizations", "preview-sets", "view", "--id", "SET_ID", "--output", "yaml"},
-			wantErr: "unsupported format: yaml",
+			args:    []string{"localizations", "preview-sets", "view", "--id", "SET_ID", "--output", "xml"},
+			wantErr: "unsupported format: xml",
 		},
 		{
 			name:    "preview sets get pretty with table",
@@ -193,8 +193,8 @@ func TestLocalizationsMediaSetsOutputErrors(t *testing.T) {
 		},
 		{
 			name:    "screenshot sets get unsupported output",
-			args:    []string{"localizations", "screenshot-sets", "view", "--id", "SET_ID", "--output", "yaml"},
-			wantErr: "unsupported format: yaml",
+			args:    []string{"localizations", "screenshot-sets", "view", "--id", "SET_ID", "--output", "xml"},
+			wantErr: "unsupported format: xml",
 		},
 		{
 			name:    "screenshot sets get pretty with markdown",
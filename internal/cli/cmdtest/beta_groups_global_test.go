@@ -389,6 +389,50 @@ func TestBetaGroupsListScopedWithExternalFilter(t *testing.T) {
 	}
 }
 
+func TestBetaGroupsListScopedFilterStreamsNDJSONPages(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_APP_ID", "")
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Query().Get("cursor") == "2" {
+			return nil, errors.New("page 2 failed")
+		}
+		body := `{"data":[` +
+			`{"type":"betaGroups","id":"bg-int","attributes":{"name":"Internal","isInternalGroup":true}},` +
+			`{"type":"betaGroups","id":"bg-ext","attributes":{"name":"External","isInternalGroup":false}}` +
+			`],"links":{"next":"https://api.appstoreconnect.apple.com/v1/apps/app-1/betaGroups?cursor=2"}}`
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"testflight", "groups", "list", "--app", "app-1", "--external", "--output", "ndjson"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err == nil || !strings.Contains(err.Error(), "page 2 failed") {
+			t.Fatalf("expected page 2 error, got %v", err)
+		}
+	})
+
+	// The filtered first page is written before the second page is requested.
+	lines := strings.Split(strings.TrimSpace(stdout), "\n")
+	if len(lines) != 1 || !strings.Contains(lines[0], `"id":"bg-ext"`) {
+		t.Fatalf("expected only the filtered first page, got %q", stdout)
+	}
+}
+
 func TestBetaGroupsListInternalAndExternalMutuallyExclusive(t *testing.T) {
 	t.Setenv("ASC_APP_ID", "")
 
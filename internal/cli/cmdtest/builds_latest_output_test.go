@@ -402,8 +402,8 @@ func TestBuildsLatestOutputErrors(t *testing.T) {
 	}{
 		{
 			name:    "unsupported output",
-			args:    []string{"builds", "latest", "--app", "100000001", "--output", "yaml"},
-			wantErr: "unsupported format: yaml",
+			args:    []string{"builds", "latest", "--app", "100000001", "--output", "xml"},
+			wantErr: "unsupported format: xml",
 		},
 		{
 			name:    "pretty with table",
@@ -0,0 +1,108 @@
+package cmdtest
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/cmd"
+)
+
+func TestRun_CacheStatsReportsEmptyCache(t *testing.T) {
+	cacheDir := filepath.Join(t.TempDir(), "http")
+	t.Setenv("ASC_CACHE_DIR", cacheDir)
+	t.Setenv("ASC_CACHE_TTL", "15m")
+
+	stdout, stderr := captureOutput(t, func() {
+		code := cmd.Run([]string{"cache", "stats", "--output", "json"}, "1.0.0")
+		if code != cmd.ExitSuccess {
+			t.Fatalf("expected exit code %d, got %d", cmd.ExitSuccess, code)
+		}
+	})
+	if strings.TrimSpace(stderr) != "" {
+		t.Fatalf("expected empty stderr, got %q", stderr)
+	}
+
+	var payload struct {
+		Dir     string `json:"dir"`
+		Enabled bool   `json:"enabled"`
+		TTL     string `json:"ttl"`
+		Entries int    `json:"entries"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &payload); err != nil {
+		t.Fatalf("failed to parse stdout: %v\nstdout=%s", err, stdout)
+	}
+	if payload.Dir != cacheDir || !payload.Enabled || payload.TTL != "15m0s" || payload.Entries != 0 {
+		t.Fatalf("unexpected stats payload: %+v", payload)
+	}
+}
+
+func TestRun_CacheStatsHonorsNoCache(t *testing.T) {
+	t.Setenv("ASC_CACHE_DIR", t.TempDir())
+	t.Setenv("ASC_CACHE_TTL", "15m")
+
+	stdout, _ := captureOutput(t, func() {
+		code := cmd.Run([]string{"--no-cache", "cache", "stats", "--output", "json"}, "1.0.0")
+		if code != cmd.ExitSuccess {
+			t.Fatalf("expected exit code %d, got %d", cmd.ExitSuccess, code)
+		}
+	})
+
+	var payload struct {
+		Enabled bool `json:"enabled"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &payload); err != nil {
+		t.Fatalf("failed to parse stdout: %v\nstdout=%s", err, stdout)
+	}
+	if payload.Enabled {
+		t.Fatal("expected --no-cache to report the cache as disabled")
+	}
+}
+
+func TestRun_CacheClearRequiresConfirm(t *testing.T) {
+	t.Setenv("ASC_CACHE_DIR", t.TempDir())
+
+	_, stderr := captureOutput(t, func() {
+		code := cmd.Run([]string{"cache", "clear"}, "1.0.0")
+		if code != cmd.ExitUsage {
+			t.Fatalf("expected exit code %d, got %d", cmd.ExitUsage, code)
+		}
+	})
+	if !strings.Contains(stderr, "--confirm is required") {
+		t.Fatalf("expected confirm error, got %q", stderr)
+	}
+}
+
+func TestRun_CacheClearRemovesEntries(t *testing.T) {
+	cacheDir := t.TempDir()
+	t.Setenv("ASC_CACHE_DIR", cacheDir)
+	scopeDir := filepath.Join(cacheDir, "scope")
+	if err := os.MkdirAll(scopeDir, 0o700); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(scopeDir, "entry.json"), []byte(`{"version":1}`), 0o600); err != nil {
+		t.Fatalf("write entry: %v", err)
+	}
+
+	stdout, _ := captureOutput(t, func() {
+		code := cmd.Run([]string{"cache", "clear", "--confirm", "--output", "json"}, "1.0.0")
+		if code != cmd.ExitSuccess {
+			t.Fatalf("expected exit code %d, got %d", cmd.ExitSuccess, code)
+		}
+	})
+
+	var payload struct {
+		Removed int `json:"removed"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &payload); err != nil {
+		t.Fatalf("failed to parse stdout: %v\nstdout=%s", err, stdout)
+	}
+	if payload.Removed != 1 {
+		t.Fatalf("expected 1 removed entry, got %d", payload.Removed)
+	}
+	if _, err := os.Stat(scopeDir); !os.IsNotExist(err) {
+		t.Fatalf("expected scope dir to be removed, stat err=%v", err)
+	}
+}
@@ -0,0 +1,48 @@
+package cmdtest
+
+import (
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/cmd"
+)
+
+func TestRun_ReplayWithoutCredentials(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("ASC_REPLAY", "")
+	t.Setenv("ASC_RECORD", dir)
+	installAPITestTransport(t, func(req *http.Request) (*http.Response, error) {
+		return apiJSONResponse(`{"data":[{"type":"apps","id":"app-1","attributes":{"name":"Alpha"}}],"links":{}}`), nil
+	})
+	captureOutput(t, func() {
+		if code := cmd.Run([]string{"apps", "list"}, "1.0.0"); code != cmd.ExitSuccess {
+			t.Fatalf("record: expected exit code %d, got %d", cmd.ExitSuccess, code)
+		}
+	})
+
+	// Replay with no credentials anywhere and no network.
+	t.Setenv("ASC_RECORD", "")
+	t.Setenv("ASC_REPLAY", dir)
+	t.Setenv("ASC_KEY_ID", "")
+	t.Setenv("ASC_ISSUER_ID", "")
+	t.Setenv("ASC_PRIVATE_KEY_PATH", "")
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		t.Fatalf("unexpected network request: %s %s", req.Method, req.URL)
+		return nil, nil
+	})
+
+	stdout, stderr := captureOutput(t, func() {
+		if code := cmd.Run([]string{"--query", "data[].id", "apps", "list"}, "1.0.0"); code != cmd.ExitSuccess {
+			t.Fatalf("replay: expected exit code %d, got %d", cmd.ExitSuccess, code)
+		}
+	})
+	if strings.TrimSpace(stderr) != "" {
+		t.Fatalf("expected empty stderr, got %q", stderr)
+	}
+	if got := strings.TrimSpace(stdout); got != `["app-1"]` {
+		t.Fatalf("stdout = %q, want %q", got, `["app-1"]`)
+	}
+}
@@ -0,0 +1,215 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func diffVersionTransport(t *testing.T) roundTripFunc {
+	t.Helper()
+	return roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet {
+			t.Fatalf("expected diff to use GET only, got %s %s", req.Method, req.URL.Path)
+		}
+		if req.URL.Path == "/v1/apps/app-1/appStoreVersions" {
+			switch req.URL.Query().Get("filter[versionString]") {
+			case "1.2.0":
+				return jsonResponse(http.StatusOK, `{"data":[{"type":"appStoreVersions","id":"version-1","attributes":{"versionString":"1.2.0","platform":"IOS","appStoreState":"READY_FOR_SALE"}}],"links":{"next":""}}`)
+			case "1.3.0":
+				return jsonResponse(http.StatusOK, `{"data":[{"type":"appStoreVersions","id":"version-2","attributes":{"versionString":"1.3.0","platform":"IOS","appStoreState":"PREPARE_FOR_SUBMISSION"}}],"links":{"next":""}}`)
+			}
+		}
+		if resp, ok := metadataScopesBaseResponse(t, req); ok {
+			return resp, nil
+		}
+		switch req.URL.Path {
+		case "/v1/appInfos/appinfo-1/appInfoLocalizations":
+			return jsonResponse(http.StatusOK, `{"data":[{"type":"appInfoLocalizations","id":"info-en","attributes":{"locale":"en-US","name":"App"}}],"links":{"next":""}}`)
+		case "/v1/appStoreVersions/version-1/appStoreVersionLocalizations":
+			return jsonResponse(http.StatusOK, `{"data":[
+				{"type":"appStoreVersionLocalizations","id":"loc-1","attributes":{"locale":"en-US","description":"Hello","keywords":"one"}}
+			],"links":{"next":""}}`)
+		case "/v1/appStoreVersions/version-2/appStoreVersionLocalizations":
+			return jsonResponse(http.StatusOK, `{"data":[
+				{"type":"appStoreVersionLocalizations","id":"loc-2","attributes":{"locale":"en-US","description":"Hello\nWorld","whatsNew":"Fixes"}}
+			],"links":{"next":""}}`)
+		case "/v1/appStoreVersions/version-1/build":
+			return jsonResponse(http.StatusOK, `{"data":{"type":"builds","id":"build-1","attributes":{"version":"100","minOsVersion":"17.0"}}}`)
+		case "/v1/appStoreVersions/version-2/build":
+			return jsonResponse(http.StatusOK, `{"data":{"type":"builds","id":"build-2","attributes":{"version":"120","minOsVersion":"17.0"}}}`)
+		}
+		t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		return nil, nil
+	})
+}
+
+func runDiffCommand(t *testing.T, args []string) string {
+	t.Helper()
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse(args); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+	return stdout
+}
+
+func TestDiffVersionComparesLocalizationsAndBuild(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	t.Setenv("ASC_APP_ID", "")
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = diffVersionTransport(t)
+
+	stdout := runDiffCommand(t, []string{
+		"diff", "version",
+		"--app", "app-1",
+		"--from", "1.2.0",
+		"--to", "1.3.0",
+		"--include", "localizations,build,categories",
+	})
+
+	var plan struct {
+		Scope    string   `json:"scope"`
+		Includes []string `json:"includes"`
+		Source   struct {
+			VersionID string `json:"versionId"`
+		} `json:"source"`
+		Target struct {
+			VersionID string `json:"versionId"`
+		} `json:"target"`
+		Adds    []struct{ Key string } `json:"adds"`
+		Updates []struct{ Key string } `json:"updates"`
+		Deletes []struct{ Key string } `json:"deletes"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &plan); err != nil {
+		t.Fatalf("stdout should be valid json: %v\nstdout=%q", err, stdout)
+	}
+	if plan.Scope != "version" || plan.Source.VersionID != "version-1" || plan.Target.VersionID != "version-2" {
+		t.Fatalf("unexpected plan header: %+v", plan)
+	}
+	if len(plan.Adds) != 1 || plan.Adds[0].Key != "version:en-US:whatsNew" {
+		t.Fatalf("unexpected adds: %+v", plan.Adds)
+	}
+	if len(plan.Updates) != 2 || plan.Updates[0].Key != "build:buildNumber" || plan.Updates[1].Key != "version:en-US:description" {
+		t.Fatalf("unexpected updates: %+v", plan.Updates)
+	}
+	if len(plan.Deletes) != 1 || plan.Deletes[0].Key != "version:en-US:keywords" {
+		t.Fatalf("unexpected deletes: %+v", plan.Deletes)
+	}
+}
+
+func TestDiffVersionUnifiedAndJSONPatchOutput(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	t.Setenv("ASC_APP_ID", "")
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = diffVersionTransport(t)
+
+	args := []string{"diff", "version", "--app", "app-1", "--from", "1.2.0", "--to", "1.3.0", "--include", "localizations,build"}
+
+	unified := runDiffCommand(t, append(args, "--output", "diff"))
+	for _, want := range []string{
+		"--- a/build/buildNumber\n+++ b/build/buildNumber\n@@ -1,1 +1,1 @@\n-100\n+120\n",
+		"--- a/version/en-US/description\n+++ b/version/en-US/description\n@@ -1,1 +1,2 @@\n Hello\n+World\n",
+		"--- /dev/null\n+++ b/version/en-US/whatsNew\n@@ -0,0 +1,1 @@\n+Fixes\n",
+		"--- a/version/en-US/keywords\n+++ /dev/null\n",
+	} {
+		if !strings.Contains(unified, want) {
+			t.Fatalf("expected %q in unified diff:\n%s", want, unified)
+		}
+	}
+
+	patch := runDiffCommand(t, append(args, "--output", "json-patch"))
+	var operations []map[string]any
+	if err := json.Unmarshal([]byte(patch), &operations); err != nil {
+		t.Fatalf("stdout should be valid json: %v\nstdout=%q", err, patch)
+	}
+	got := make([]string, 0, len(operations))
+	for _, operation := range operations {
+		got = append(got, operation["op"].(string)+" "+operation["path"].(string))
+	}
+	want := "replace /build/buildNumber,replace /version/en-US/description,remove /version/en-US/keywords,add /version/en-US/whatsNew"
+	if strings.Join(got, ",") != want {
+		t.Fatalf("expected operations %s, got %s", want, strings.Join(got, ","))
+	}
+}
+
+func TestDiffMetadataComparesLocalScopesOnly(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	t.Setenv("ASC_APP_ID", "")
+
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "app"), 0o755); err != nil {
+		t.Fatalf("mkdir app: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "app", "categories.json"), []byte(`{"primaryCategory":"GAMES"}`), 0o644); err != nil {
+		t.Fatalf("write categories: %v", err)
+	}
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if resp, ok := metadataScopesBaseResponse(t, req); ok {
+			return resp, nil
+		}
+		switch req.URL.Path {
+		case "/v1/apps/app-1":
+			return jsonResponse(http.StatusOK, `{"data":{"type":"apps","id":"app-1","attributes":{"contentRightsDeclaration":"USES_THIRD_PARTY_CONTENT"}}}`)
+		}
+		t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		return nil, nil
+	})
+
+	stdout := runDiffCommand(t, []string{
+		"diff", "metadata",
+		"--app", "app-1",
+		"--version", "1.2.3",
+		"--dir", dir,
+		"--include", "categories,content-rights",
+	})
+
+	var plan struct {
+		Source struct {
+			Kind string `json:"kind"`
+		} `json:"source"`
+		Adds    []struct{ Key string } `json:"adds"`
+		Updates []struct {
+			Key  string `json:"key"`
+			From string `json:"from"`
+			To   string `json:"to"`
+		} `json:"updates"`
+		Deletes []struct{ Key string } `json:"deletes"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &plan); err != nil {
+		t.Fatalf("stdout should be valid json: %v\nstdout=%q", err, stdout)
+	}
+	if plan.Source.Kind != "local" || len(plan.Adds) != 0 || len(plan.Deletes) != 0 {
+		t.Fatalf("unexpected plan: %+v", plan)
+	}
+	if len(plan.Updates) != 1 || plan.Updates[0].Key != "categories:primaryCategory" || plan.Updates[0].From != "GAMES" || plan.Updates[0].To != "UTILITIES" {
+		t.Fatalf("unexpected updates: %+v", plan.Updates)
+	}
+}
@@ -125,8 +125,8 @@ func TestGameCenterEnabledVersionsOutputErrors(t *testing.T) {
 	}{
 		{
 			name:    "enabled-versions list unsupported output",
-			args:    []string{"game-center", "enabled-versions", "list", "--app", "APP_ID", "--output", "yaml"},
-			wantErr: "unsupported format: yaml",
+			args:    []string{"game-center", "enabled-versions", "list", "--app", "APP_ID", "--output", "xml"},
+			wantErr: "unsupported format: xml",
 		},
 		{
 			name:    "enabled-versions list pretty with table",
@@ -140,8 +140,8 @@ func TestGameCenterEnabledVersionsOutputErrors(t *testing.T) {
 		},
 		{
 			name:    "enabled-versions compatible unsupported output",
-			args:    []string{"game-center", "enabled-versions", "compatible-versions", "--id", "ENABLED_VERSION_ID", "--output", "yaml"},
-			wantErr: "unsupported format: yaml",
+			args:    []string{"game-center", "enabled-versions", "compatible-versions", "--id", "ENABLED_VERSION_ID", "--output", "xml"},
+			wantErr: "unsupported format: xml",
 		},
 		{
 			name:    "enabled-versions compatible pretty with table",
@@ -419,8 +419,8 @@ func TestIAPOfferCodesListOutputErrors(t *testing.T) {
 	}{
 		{
 			name:    "unsupported output",
-			args:    []string{"iap", "offer-codes", "list", "--iap-id", "9000000001", "--output", "yaml"},
-			wantErr: "unsupported format: yaml",
+			args:    []string{"iap", "offer-codes", "list", "--iap-id", "9000000001", "--output", "xml"},
+			wantErr: "unsupported format: xml",
 		},
 		{
 			name:    "pretty with table",
@@ -0,0 +1,149 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLocalizationsDownloadWritesXLIFFPerLocale(t *testing.T) {
+	setupAuth(t)
+
+	dir := filepath.Join(t.TempDir(), "xliff")
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method == http.MethodGet && req.URL.Path == "/v1/appStoreVersions/version-1/appStoreVersionLocalizations" {
+			return jsonResponse(http.StatusOK, `{"data":[
+				{"type":"appStoreVersionLocalizations","id":"loc-en","attributes":{"locale":"en-US","description":"Hello","keywords":"one,two"}},
+				{"type":"appStoreVersionLocalizations","id":"loc-fr","attributes":{"locale":"fr-FR","description":"Bonjour"}}
+			],"links":{"next":""}}`)
+		}
+		t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		return nil, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{
+			"localizations", "download",
+			"--version", "version-1",
+			"--format", "xliff",
+			"--path", dir,
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var out struct {
+		Files []struct {
+			Locale string `json:"locale"`
+			Path   string `json:"path"`
+		} `json:"files"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &out); err != nil {
+		t.Fatalf("stdout should be valid json: %v\nstdout=%q", err, stdout)
+	}
+	if len(out.Files) != 2 || out.Files[1].Path != filepath.Join(dir, "fr-FR.xliff") {
+		t.Fatalf("unexpected files: %+v", out.Files)
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, "fr-FR.xliff"))
+	if err != nil {
+		t.Fatalf("read xliff: %v", err)
+	}
+	content := string(data)
+	for _, want := range []string{`source-language="en-US"`, `target-language="fr-FR"`, `<trans-unit id="description" maxwidth="4000" size-unit="char">`, `<source>Hello</source>`, `<target state="translated">Bonjour</target>`} {
+		if !strings.Contains(content, want) {
+			t.Fatalf("expected %s in xliff:\n%s", want, content)
+		}
+	}
+}
+
+func TestLocalizationsUploadFromXCStringsSkipsNeedsReview(t *testing.T) {
+	setupAuth(t)
+
+	path := filepath.Join(t.TempDir(), "Metadata.xcstrings")
+	catalog := `{"sourceLanguage":"en-US","version":"1.0","strings":{
+		"description":{"localizations":{
+			"en-US":{"stringUnit":{"state":"translated","value":"Hello"}},
+			"fr-FR":{"stringUnit":{"state":"needs_review","value":"Bonjour"}}
+		}},
+		"whatsNew":{"localizations":{
+			"fr-FR":{"stringUnit":{"state":"translated","value":"Corrections"}}
+		}}
+	}}`
+	if err := os.WriteFile(path, []byte(catalog), 0o644); err != nil {
+		t.Fatalf("write catalog: %v", err)
+	}
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method == http.MethodGet && req.URL.Path == "/v1/appStoreVersions/version-1/appStoreVersionLocalizations" {
+			return jsonResponse(http.StatusOK, `{"data":[
+				{"type":"appStoreVersionLocalizations","id":"loc-en","attributes":{"locale":"en-US"}},
+				{"type":"appStoreVersionLocalizations","id":"loc-fr","attributes":{"locale":"fr-FR"}}
+			],"links":{"next":""}}`)
+		}
+		if req.Method == http.MethodGet && req.URL.Path == "/v1/appStoreVersions/version-1" {
+			return jsonResponse(http.StatusOK, `{"data":{"type":"appStoreVersions","id":"version-1","attributes":{"versionString":"1.2.3","platform":"IOS"},"relationships":{"app":{"data":{"type":"apps","id":"app-1"}}}}}`)
+		}
+		if req.Method == http.MethodGet && req.URL.Path == "/v1/apps/app-1/appStoreVersions" {
+			return jsonResponse(http.StatusOK, `{"data":[],"links":{"next":""}}`)
+		}
+		t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		return nil, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{
+			"localizations", "upload",
+			"--version", "version-1",
+			"--path", path,
+			"--dry-run",
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if !strings.Contains(stderr, "skipped 1 localization value(s) not marked translated: fr-FR:description (needs_review)") {
+		t.Fatalf("expected needs_review warning, got %q", stderr)
+	}
+
+	var out struct {
+		Results []struct {
+			Locale string `json:"locale"`
+			Action string `json:"action"`
+		} `json:"results"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &out); err != nil {
+		t.Fatalf("stdout should be valid json: %v\nstdout=%q", err, stdout)
+	}
+	if len(out.Results) != 2 || out.Results[0].Locale != "en-US" || out.Results[1].Locale != "fr-FR" || out.Results[1].Action != "update" {
+		t.Fatalf("unexpected results: %+v", out.Results)
+	}
+}
@@ -0,0 +1,274 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// writeMetadataMergeFixture writes local categories plus a lock file whose
+// base primary category is GAMES. The remote fixture reports UTILITIES.
+func writeMetadataMergeFixture(t *testing.T, categories string) string {
+	t.Helper()
+	dir := t.TempDir()
+	if categories != "" {
+		if err := os.MkdirAll(filepath.Join(dir, "app"), 0o755); err != nil {
+			t.Fatalf("mkdir app: %v", err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, "app", "categories.json"), []byte(categories), 0o644); err != nil {
+			t.Fatalf("write categories: %v", err)
+		}
+	}
+	lock := `{"appId":"app-1","scopes":{"categories":{"primaryCategory":"GAMES"}}}`
+	if err := os.WriteFile(filepath.Join(dir, ".asc-metadata-lock.json"), []byte(lock), 0o644); err != nil {
+		t.Fatalf("write lock: %v", err)
+	}
+	return dir
+}
+
+func TestMetadataPushReportsConflictsAgainstLock(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	t.Setenv("ASC_APP_ID", "")
+
+	dir := writeMetadataMergeFixture(t, `{"primaryCategory":"ENTERTAINMENT","secondaryCategory":"GAMES"}`)
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet {
+			t.Fatalf("expected no mutation, got %s %s", req.Method, req.URL.Path)
+		}
+		if resp, ok := metadataScopesBaseResponse(t, req); ok {
+			return resp, nil
+		}
+		t.Fatalf("unexpected path: %s", req.URL.Path)
+		return nil, nil
+	})
+
+	run := func(args ...string) (string, error) {
+		root := RootCommand("1.2.3")
+		root.FlagSet.SetOutput(io.Discard)
+		var runErr error
+		stdout, _ := captureOutput(t, func() {
+			if err := root.Parse(append([]string{
+				"metadata", "push",
+				"--app", "app-1",
+				"--version", "1.2.3",
+				"--dir", dir,
+				"--include", "categories",
+			}, args...)); err != nil {
+				t.Fatalf("parse error: %v", err)
+			}
+			runErr = root.Run(context.Background())
+		})
+		return stdout, runErr
+	}
+
+	stdout, err := run("--dry-run")
+	if err != nil {
+		t.Fatalf("dry-run error: %v", err)
+	}
+	var payload struct {
+		Adds      []struct{ Key string } `json:"adds"`
+		Conflicts []struct {
+			Key        string `json:"key"`
+			Base       string `json:"base"`
+			Local      string `json:"local"`
+			Remote     string `json:"remote"`
+			Resolution string `json:"resolution"`
+		} `json:"conflicts"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &payload); err != nil {
+		t.Fatalf("unmarshal output: %v\nstdout=%q", err, stdout)
+	}
+	if len(payload.Conflicts) != 1 {
+		t.Fatalf("expected 1 conflict, got %+v", payload.Conflicts)
+	}
+	conflict := payload.Conflicts[0]
+	if conflict.Key != "categories:primaryCategory" || conflict.Base != "GAMES" || conflict.Local != "ENTERTAINMENT" || conflict.Remote != "UTILITIES" || conflict.Resolution != "" {
+		t.Fatalf("unexpected conflict: %+v", conflict)
+	}
+	if len(payload.Adds) != 1 || payload.Adds[0].Key != "categories:secondaryCategory" {
+		t.Fatalf("expected untouched remote field to keep the local add, got %+v", payload.Adds)
+	}
+
+	_, err = run()
+	if err == nil || !strings.Contains(err.Error(), "changed both locally and remotely since last pull: categories:primaryCategory") {
+		t.Fatalf("expected conflict error, got %v", err)
+	}
+}
+
+func TestMetadataApplyStrategyTheirsKeepsRemoteValueAndUpdatesLock(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	t.Setenv("ASC_APP_ID", "")
+
+	dir := writeMetadataMergeFixture(t, `{"primaryCategory":"ENTERTAINMENT","secondaryCategory":"GAMES"}`)
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	patched := false
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method == http.MethodGet {
+			if resp, ok := metadataScopesBaseResponse(t, req); ok {
+				return resp, nil
+			}
+		}
+		if req.Method == http.MethodPatch && req.URL.Path == "/v1/appInfos/appinfo-1" {
+			body, err := io.ReadAll(req.Body)
+			if err != nil {
+				t.Fatalf("read body: %v", err)
+			}
+			if !strings.Contains(string(body), `"id":"UTILITIES"`) || !strings.Contains(string(body), `"id":"GAMES"`) || strings.Contains(string(body), "ENTERTAINMENT") {
+				t.Fatalf("unexpected patch body: %s", body)
+			}
+			patched = true
+			return jsonResponse(http.StatusOK, `{"data":{"type":"appInfos","id":"appinfo-1"}}`)
+		}
+		t.Fatalf("unexpected request: %s %s", req.Method, req.URL.Path)
+		return nil, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{
+			"metadata", "apply",
+			"--app", "app-1",
+			"--version", "1.2.3",
+			"--dir", dir,
+			"--include", "categories",
+			"--strategy", "theirs",
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if !patched {
+		t.Fatal("expected categories update request")
+	}
+	var payload struct {
+		Updates   []struct{ Key string } `json:"updates"`
+		Conflicts []struct {
+			Resolution string `json:"resolution"`
+		} `json:"conflicts"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &payload); err != nil {
+		t.Fatalf("unmarshal output: %v\nstdout=%q", err, stdout)
+	}
+	if len(payload.Updates) != 0 || len(payload.Conflicts) != 1 || payload.Conflicts[0].Resolution != "theirs" {
+		t.Fatalf("unexpected apply output: %s", stdout)
+	}
+
+	lockData, err := os.ReadFile(filepath.Join(dir, ".asc-metadata-lock.json"))
+	if err != nil {
+		t.Fatalf("read lock file: %v", err)
+	}
+	var lock struct {
+		Scopes map[string]map[string]string `json:"scopes"`
+	}
+	if err := json.Unmarshal(lockData, &lock); err != nil {
+		t.Fatalf("unmarshal lock file: %v", err)
+	}
+	want := map[string]string{"primaryCategory": "UTILITIES", "secondaryCategory": "GAMES"}
+	got := lock.Scopes["categories"]
+	if len(got) != len(want) || got["primaryCategory"] != want["primaryCategory"] || got["secondaryCategory"] != want["secondaryCategory"] {
+		t.Fatalf("expected lock categories %v, got %v", want, got)
+	}
+}
+
+func TestMetadataDriftReportsRemoteChanges(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	t.Setenv("ASC_APP_ID", "")
+
+	dir := writeMetadataMergeFixture(t, "")
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet {
+			t.Fatalf("expected drift to use GET only, got %s %s", req.Method, req.URL.Path)
+		}
+		if resp, ok := metadataScopesBaseResponse(t, req); ok {
+			return resp, nil
+		}
+		t.Fatalf("unexpected path: %s", req.URL.Path)
+		return nil, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"metadata", "drift", "--version", "1.2.3", "--dir", dir}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+
+	if _, ok := errors.AsType[ReportedError](runErr); !ok {
+		t.Fatalf("expected ReportedError, got %T: %v", runErr, runErr)
+	}
+	var payload struct {
+		AppID    string   `json:"appId"`
+		Includes []string `json:"includes"`
+		Drifted  bool     `json:"drifted"`
+		Changes  []struct {
+			Key    string `json:"key"`
+			Base   string `json:"base"`
+			Remote string `json:"remote"`
+		} `json:"changes"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &payload); err != nil {
+		t.Fatalf("unmarshal output: %v\nstdout=%q", err, stdout)
+	}
+	if payload.AppID != "app-1" || !payload.Drifted || len(payload.Includes) != 1 || payload.Includes[0] != "categories" {
+		t.Fatalf("unexpected drift output: %s", stdout)
+	}
+	if len(payload.Changes) != 1 || payload.Changes[0].Key != "categories:primaryCategory" || payload.Changes[0].Base != "GAMES" || payload.Changes[0].Remote != "UTILITIES" {
+		t.Fatalf("unexpected changes: %+v", payload.Changes)
+	}
+}
+
+func TestMetadataDriftRequiresLockFile(t *testing.T) {
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	_, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"metadata", "drift", "--version", "1.2.3", "--dir", t.TempDir()}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+
+	if !errors.Is(runErr, flag.ErrHelp) {
+		t.Fatalf("expected ErrHelp, got %v", runErr)
+	}
+	if !strings.Contains(stderr, "run metadata pull first") {
+		t.Fatalf("expected missing lock guidance, got %q", stderr)
+	}
+}
@@ -0,0 +1,377 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func metadataScopesBaseResponse(t *testing.T, req *http.Request) (*http.Response, bool) {
+	t.Helper()
+	switch req.URL.Path {
+	case "/v1/apps/app-1/appInfos":
+		resp, _ := jsonResponse(http.StatusOK, `{"data":[{"type":"appInfos","id":"appinfo-1","attributes":{"state":"PREPARE_FOR_SUBMISSION"}}]}`)
+		return resp, true
+	case "/v1/apps/app-1/appStoreVersions":
+		resp, _ := jsonResponse(http.StatusOK, `{"data":[{"type":"appStoreVersions","id":"version-1","attributes":{"versionString":"1.2.3","platform":"IOS"}}],"links":{"next":""}}`)
+		return resp, true
+	case "/v1/appInfos/appinfo-1/primaryCategory":
+		resp, _ := jsonResponse(http.StatusOK, `{"data":{"type":"appCategories","id":"UTILITIES"}}`)
+		return resp, true
+	case "/v1/appInfos/appinfo-1/primarySubcategoryOne",
+		"/v1/appInfos/appinfo-1/primarySubcategoryTwo",
+		"/v1/appInfos/appinfo-1/secondaryCategory",
+		"/v1/appInfos/appinfo-1/secondarySubcategoryOne",
+		"/v1/appInfos/appinfo-1/secondarySubcategoryTwo":
+		resp, _ := jsonResponse(http.StatusOK, `{"data":null}`)
+		return resp, true
+	}
+	return nil, false
+}
+
+func TestMetadataPushDryRunPlansAppScopes(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	t.Setenv("ASC_APP_ID", "")
+
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "app"), 0o755); err != nil {
+		t.Fatalf("mkdir app: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "app", "categories.json"), []byte(`{"primaryCategory":"games","secondaryCategory":"ENTERTAINMENT"}`), 0o644); err != nil {
+		t.Fatalf("write categories: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "app", "content-rights.json"), []byte(`{"contentRightsDeclaration":"DOES_NOT_USE_THIRD_PARTY_CONTENT"}`), 0o644); err != nil {
+		t.Fatalf("write content rights: %v", err)
+	}
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet {
+			t.Fatalf("expected dry-run to use GET only, got %s %s", req.Method, req.URL.Path)
+		}
+		if resp, ok := metadataScopesBaseResponse(t, req); ok {
+			return resp, nil
+		}
+		switch req.URL.Path {
+		case "/v1/apps/app-1":
+			return jsonResponse(http.StatusOK, `{"data":{"type":"apps","id":"app-1","attributes":{"contentRightsDeclaration":"USES_THIRD_PARTY_CONTENT"}}}`)
+		default:
+			t.Fatalf("unexpected path: %s", req.URL.Path)
+			return nil, nil
+		}
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{
+			"metadata", "push",
+			"--app", "app-1",
+			"--version", "1.2.3",
+			"--dir", dir,
+			"--include", "categories,content-rights",
+			"--dry-run",
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var payload struct {
+		Includes []string `json:"includes"`
+		Adds     []struct {
+			Key string `json:"key"`
+			To  string `json:"to"`
+		} `json:"adds"`
+		Updates []struct {
+			Key  string `json:"key"`
+			From string `json:"from"`
+			To   string `json:"to"`
+		} `json:"updates"`
+		APICalls []struct {
+			Operation string `json:"operation"`
+			Scope     string `json:"scope"`
+			Count     int    `json:"count"`
+		} `json:"apiCalls"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &payload); err != nil {
+		t.Fatalf("unmarshal output: %v\nstdout=%q", err, stdout)
+	}
+
+	if len(payload.Adds) != 1 || payload.Adds[0].Key != "categories:secondaryCategory" || payload.Adds[0].To != "ENTERTAINMENT" {
+		t.Fatalf("unexpected adds: %+v", payload.Adds)
+	}
+	if len(payload.Updates) != 2 {
+		t.Fatalf("expected 2 updates, got %+v", payload.Updates)
+	}
+	if payload.Updates[0].Key != "categories:primaryCategory" || payload.Updates[0].From != "UTILITIES" || payload.Updates[0].To != "GAMES" {
+		t.Fatalf("unexpected category update: %+v", payload.Updates[0])
+	}
+	if payload.Updates[1].Key != "content-rights:contentRightsDeclaration" {
+		t.Fatalf("unexpected content rights update: %+v", payload.Updates[1])
+	}
+	if len(payload.APICalls) != 2 ||
+		payload.APICalls[0].Operation != "update_app_info_categories" ||
+		payload.APICalls[1].Operation != "update_app" {
+		t.Fatalf("unexpected api calls: %+v", payload.APICalls)
+	}
+}
+
+func TestMetadataApplyUpdatesContentRights(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	t.Setenv("ASC_APP_ID", "")
+
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "app"), 0o755); err != nil {
+		t.Fatalf("mkdir app: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "app", "content-rights.json"), []byte(`{"contentRightsDeclaration":"DOES_NOT_USE_THIRD_PARTY_CONTENT"}`), 0o644); err != nil {
+		t.Fatalf("write content rights: %v", err)
+	}
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	patched := false
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if resp, ok := metadataScopesBaseResponse(t, req); ok {
+			return resp, nil
+		}
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/apps/app-1":
+			return jsonResponse(http.StatusOK, `{"data":{"type":"apps","id":"app-1","attributes":{"contentRightsDeclaration":"USES_THIRD_PARTY_CONTENT"}}}`)
+		case req.Method == http.MethodPatch && req.URL.Path == "/v1/apps/app-1":
+			body, err := io.ReadAll(req.Body)
+			if err != nil {
+				t.Fatalf("read body: %v", err)
+			}
+			if !strings.Contains(string(body), `"contentRightsDeclaration":"DOES_NOT_USE_THIRD_PARTY_CONTENT"`) {
+				t.Fatalf("unexpected patch body: %s", body)
+			}
+			patched = true
+			return jsonResponse(http.StatusOK, `{"data":{"type":"apps","id":"app-1","attributes":{"contentRightsDeclaration":"DOES_NOT_USE_THIRD_PARTY_CONTENT"}}}`)
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.Path)
+			return nil, nil
+		}
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{
+			"metadata", "apply",
+			"--app", "app-1",
+			"--version", "1.2.3",
+			"--dir", dir,
+			"--include", "content-rights",
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if !patched {
+		t.Fatal("expected app update request")
+	}
+	var payload struct {
+		Applied bool `json:"applied"`
+		Actions []struct {
+			Scope      string `json:"scope"`
+			Action     string `json:"action"`
+			ResourceID string `json:"resourceId"`
+		} `json:"actions"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &payload); err != nil {
+		t.Fatalf("unmarshal output: %v\nstdout=%q", err, stdout)
+	}
+	if !payload.Applied || len(payload.Actions) != 1 || payload.Actions[0].Scope != "content-rights" || payload.Actions[0].ResourceID != "app-1" {
+		t.Fatalf("unexpected apply output: %+v", payload)
+	}
+}
+
+func TestMetadataPullWritesSelectedScopes(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	t.Setenv("ASC_APP_ID", "")
+
+	outputDir := filepath.Join(t.TempDir(), "metadata")
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if resp, ok := metadataScopesBaseResponse(t, req); ok {
+			return resp, nil
+		}
+		switch req.URL.Path {
+		case "/v1/appStoreVersions/version-1/appStoreReviewDetail":
+			return jsonResponse(http.StatusOK, `{"data":{"type":"appStoreReviewDetails","id":"review-1","attributes":{"contactEmail":"dev@example.com","demoAccountName":"demo","demoAccountPassword":"secret","demoAccountRequired":true}}}`)
+		default:
+			t.Fatalf("unexpected path: %s", req.URL.Path)
+			return nil, nil
+		}
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{
+			"metadata", "pull",
+			"--app", "app-1",
+			"--version", "1.2.3",
+			"--dir", outputDir,
+			"--include", "categories,review-info",
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	categories, err := os.ReadFile(filepath.Join(outputDir, "app", "categories.json"))
+	if err != nil {
+		t.Fatalf("read categories: %v", err)
+	}
+	if string(categories) != `{"primaryCategory":"UTILITIES"}` {
+		t.Fatalf("unexpected categories file: %s", categories)
+	}
+
+	review, err := os.ReadFile(filepath.Join(outputDir, "review", "1.2.3.json"))
+	if err != nil {
+		t.Fatalf("read review info: %v", err)
+	}
+	if strings.Contains(string(review), "secret") || strings.Contains(string(review), "demoAccountPassword") {
+		t.Fatalf("expected demo account password to be omitted, got %s", review)
+	}
+	if !strings.Contains(string(review), `"demoAccountRequired":true`) {
+		t.Fatalf("unexpected review file: %s", review)
+	}
+
+	if _, err := os.Stat(filepath.Join(outputDir, "app-info")); !os.IsNotExist(err) {
+		t.Fatalf("expected localizations to be skipped, stat err=%v", err)
+	}
+
+	var payload struct {
+		FileCount int      `json:"fileCount"`
+		Includes  []string `json:"includes"`
+		LockFile  string   `json:"lockFile"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &payload); err != nil {
+		t.Fatalf("unmarshal output: %v\nstdout=%q", err, stdout)
+	}
+	if payload.FileCount != 2 {
+		t.Fatalf("expected 2 files, got %d", payload.FileCount)
+	}
+
+	lockPath := filepath.Join(outputDir, ".asc-metadata-lock.json")
+	if payload.LockFile != lockPath {
+		t.Fatalf("expected lock file %q, got %q", lockPath, payload.LockFile)
+	}
+	lockData, err := os.ReadFile(lockPath)
+	if err != nil {
+		t.Fatalf("read lock file: %v", err)
+	}
+	var lock struct {
+		AppID  string                       `json:"appId"`
+		Scopes map[string]map[string]string `json:"scopes"`
+	}
+	if err := json.Unmarshal(lockData, &lock); err != nil {
+		t.Fatalf("unmarshal lock file: %v", err)
+	}
+	if lock.AppID != "app-1" || lock.Scopes["categories"]["primaryCategory"] != "UTILITIES" {
+		t.Fatalf("unexpected lock file: %s", lockData)
+	}
+	if lock.Scopes["review-info:1.2.3"]["contactEmail"] != "dev@example.com" || strings.Contains(string(lockData), "secret") {
+		t.Fatalf("unexpected review info snapshot: %s", lockData)
+	}
+	if _, ok := lock.Scopes["app-info"]; ok {
+		t.Fatalf("expected localizations to be missing from lock, got %s", lockData)
+	}
+}
+
+func TestMetadataApplyRefusesToRemoveTerritoriesWithoutAllowDeletes(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	t.Setenv("ASC_APP_ID", "")
+
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "app"), 0o755); err != nil {
+		t.Fatalf("mkdir app: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "app", "availability.json"), []byte(`{"territories":["USA"]}`), 0o644); err != nil {
+		t.Fatalf("write availability: %v", err)
+	}
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if resp, ok := metadataScopesBaseResponse(t, req); ok {
+			return resp, nil
+		}
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/apps/app-1/appAvailabilityV2":
+			return jsonResponse(http.StatusOK, `{"data":{"type":"appAvailabilities","id":"avail-1","attributes":{"availableInNewTerritories":false}}}`)
+		case req.Method == http.MethodGet && req.URL.Path == "/v2/appAvailabilities/avail-1/territoryAvailabilities":
+			return jsonResponse(http.StatusOK, `{"data":[`+
+				`{"type":"territoryAvailabilities","id":"ta-usa","attributes":{"available":true},"relationships":{"territory":{"data":{"type":"territories","id":"USA"}}}},`+
+				`{"type":"territoryAvailabilities","id":"ta-can","attributes":{"available":true},"relationships":{"territory":{"data":{"type":"territories","id":"CAN"}}}}`+
+				`],"links":{"next":""}}`)
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.Path)
+			return nil, nil
+		}
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	_, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{
+			"metadata", "apply",
+			"--app", "app-1",
+			"--version", "1.2.3",
+			"--dir", dir,
+			"--include", "availability",
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+
+	if !errors.Is(runErr, flag.ErrHelp) {
+		t.Fatalf("expected usage error, got %v", runErr)
+	}
+	if !strings.Contains(stderr, "--allow-deletes is required") {
+		t.Fatalf("expected --allow-deletes message, got %q", stderr)
+	}
+}
@@ -0,0 +1,71 @@
+package cmdtest
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	cmd "github.com/rudrankriyam/App-Store-Connect-CLI/cmd"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/mock"
+)
+
+func setupMockServer(t *testing.T) {
+	t.Helper()
+	server := httptest.NewServer(mock.NewServer(mock.DefaultSeed()))
+	t.Cleanup(server.Close)
+
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	t.Setenv("ASC_BASE_URL", server.URL)
+	t.Setenv("ASC_CACHE_TTL", "")
+}
+
+func TestRun_MockServerVersionLifecycle(t *testing.T) {
+	setupMockServer(t)
+
+	_, stderr := captureOutput(t, func() {
+		code := cmd.Run([]string{"versions", "create", "--app", "6400000000", "--version", "1.1", "--platform", "IOS"}, "1.0.0")
+		if code != cmd.ExitSuccess {
+			t.Fatalf("versions create exit code %d", code)
+		}
+	})
+	if strings.TrimSpace(stderr) != "" {
+		t.Fatalf("expected empty stderr, got %q", stderr)
+	}
+
+	stdout, _ := captureOutput(t, func() {
+		code := cmd.Run([]string{"versions", "list", "--app", "6400000000", "--limit", "1", "--paginate"}, "1.0.0")
+		if code != cmd.ExitSuccess {
+			t.Fatalf("versions list exit code %d", code)
+		}
+	})
+	var out struct {
+		Data []struct {
+			Attributes struct {
+				VersionString string `json:"versionString"`
+			} `json:"attributes"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &out); err != nil {
+		t.Fatalf("parse stdout: %v\n%s", err, stdout)
+	}
+	if len(out.Data) != 2 || out.Data[0].Attributes.VersionString != "1.0" || out.Data[1].Attributes.VersionString != "1.1" {
+		t.Fatalf("expected seeded and created versions across pages, got %+v", out.Data)
+	}
+}
+
+func TestRun_MockServerRejectsUnmodeledEndpoints(t *testing.T) {
+	setupMockServer(t)
+
+	_, stderr := captureOutput(t, func() {
+		code := cmd.Run([]string{"subscriptions", "groups", "list", "--app", "6400000000"}, "1.0.0")
+		if code == cmd.ExitSuccess {
+			t.Fatal("expected failure for unmodeled endpoint")
+		}
+	})
+	if !strings.Contains(stderr, "asc mock serve does not keep state") {
+		t.Fatalf("expected mock 501 detail, got %q", stderr)
+	}
+}
@@ -0,0 +1,78 @@
+package cmdtest
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/cmd"
+)
+
+func TestRun_DevicesListPaginateNDJSON(t *testing.T) {
+	requests := 0
+	installAPITestTransport(t, func(req *http.Request) (*http.Response, error) {
+		requests++
+		switch requests {
+		case 1:
+			return apiJSONResponse(`{
+				"data":[{"type":"devices","id":"d1","attributes":{"name":"iPhone","udid":"U1"}}],
+				"links":{"next":"https://api.appstoreconnect.apple.com/v1/devices?cursor=abc"}
+			}`), nil
+		case 2:
+			return apiJSONResponse(`{"data":[{"type":"devices","id":"d2","attributes":{"name":"iPad","udid":"U2"}}],"links":{}}`), nil
+		default:
+			t.Fatalf("unexpected request %d", requests)
+			return nil, nil
+		}
+	})
+
+	stdout, stderr := captureOutput(t, func() {
+		code := cmd.Run([]string{"devices", "list", "--paginate", "--output", "ndjson"}, "1.0.0")
+		if code != cmd.ExitSuccess {
+			t.Fatalf("expected exit code %d, got %d", cmd.ExitSuccess, code)
+		}
+	})
+	if strings.TrimSpace(stderr) != "" {
+		t.Fatalf("expected empty stderr, got %q", stderr)
+	}
+
+	lines := strings.Split(strings.TrimSpace(stdout), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected one line per device, got %q", stdout)
+	}
+	if !strings.Contains(lines[0], `"id":"d1"`) || !strings.Contains(lines[1], `"id":"d2"`) {
+		t.Fatalf("unexpected ndjson lines %q", lines)
+	}
+}
+
+func TestRun_DevicesListCSVUsesTableColumns(t *testing.T) {
+	installAPITestTransport(t, func(req *http.Request) (*http.Response, error) {
+		return apiJSONResponse(`{"data":[
+			{"type":"devices","id":"d1","attributes":{"name":"Ada's iPhone, work","udid":"U1","platform":"IOS"}}
+		],"links":{}}`), nil
+	})
+
+	tableOut, _ := captureOutput(t, func() {
+		if code := cmd.Run([]string{"devices", "list", "--output", "table"}, "1.0.0"); code != cmd.ExitSuccess {
+			t.Fatalf("expected exit code %d, got %d", cmd.ExitSuccess, code)
+		}
+	})
+	csvOut, _ := captureOutput(t, func() {
+		if code := cmd.Run([]string{"devices", "list", "--output", "csv"}, "1.0.0"); code != cmd.ExitSuccess {
+			t.Fatalf("expected exit code %d, got %d", cmd.ExitSuccess, code)
+		}
+	})
+
+	lines := strings.Split(strings.TrimSpace(csvOut), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected header and one row, got %q", csvOut)
+	}
+	for _, header := range strings.Split(lines[0], ",") {
+		if !strings.Contains(tableOut, header) {
+			t.Fatalf("csv header %q missing from table output %q", header, tableOut)
+		}
+	}
+	if !strings.Contains(lines[1], `"Ada's iPhone, work"`) {
+		t.Fatalf("expected quoted name cell, got %q", lines[1])
+	}
+}
@@ -0,0 +1,110 @@
+package cmdtest
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/cmd"
+)
+
+func TestRun_RootQueryAppliesAfterPagination(t *testing.T) {
+	requests := 0
+	installAPITestTransport(t, func(req *http.Request) (*http.Response, error) {
+		requests++
+		switch requests {
+		case 1:
+			return apiJSONResponse(`{
+				"data":[{"type":"apps","id":"app-1","attributes":{"name":"Alpha","bundleId":"com.example.alpha"}}],
+				"links":{"next":"https://api.appstoreconnect.apple.com/v1/apps?cursor=abc"}
+			}`), nil
+		case 2:
+			return apiJSONResponse(`{"data":[{"type":"apps","id":"app-2","attributes":{"name":"Beta","bundleId":"org.example.beta"}}],"links":{}}`), nil
+		default:
+			t.Fatalf("unexpected request %d", requests)
+			return nil, nil
+		}
+	})
+
+	stdout, stderr := captureOutput(t, func() {
+		code := cmd.Run([]string{
+			"--query", "data[].attributes.name",
+			"api", "/v1/apps", "--paginate",
+		}, "1.0.0")
+		if code != cmd.ExitSuccess {
+			t.Fatalf("expected exit code %d, got %d", cmd.ExitSuccess, code)
+		}
+	})
+	if strings.TrimSpace(stderr) != "" {
+		t.Fatalf("expected empty stderr, got %q", stderr)
+	}
+	if got := strings.TrimSpace(stdout); got != `["Alpha","Beta"]` {
+		t.Fatalf("stdout = %q, want %q", got, `["Alpha","Beta"]`)
+	}
+}
+
+func TestRun_RootFieldsTableOutput(t *testing.T) {
+	installAPITestTransport(t, func(req *http.Request) (*http.Response, error) {
+		return apiJSONResponse(`{"data":[
+			{"type":"apps","id":"app-1","attributes":{"name":"Alpha","bundleId":"com.example.alpha","sku":"SKU-A"}}
+		],"links":{}}`), nil
+	})
+
+	stdout, _ := captureOutput(t, func() {
+		code := cmd.Run([]string{
+			"--fields", "id,bundleId",
+			"api", "/v1/apps", "--output", "table",
+		}, "1.0.0")
+		if code != cmd.ExitSuccess {
+			t.Fatalf("expected exit code %d, got %d", cmd.ExitSuccess, code)
+		}
+	})
+	if !strings.Contains(stdout, "com.example.alpha") || !strings.Contains(stdout, "app-1") {
+		t.Fatalf("expected projected columns, got %q", stdout)
+	}
+	if strings.Contains(stdout, "SKU-A") {
+		t.Fatalf("expected sku to be dropped, got %q", stdout)
+	}
+}
+
+func TestRun_QueryAndFieldsAfterSubcommand(t *testing.T) {
+	installAPITestTransport(t, func(req *http.Request) (*http.Response, error) {
+		return apiJSONResponse(`{"data":[
+			{"type":"apps","id":"app-1","attributes":{"name":"Alpha","bundleId":"com.example.alpha","sku":"SKU-A"}},
+			{"type":"apps","id":"app-2","attributes":{"name":"Beta","bundleId":"org.example.beta","sku":"SKU-B"}}
+		],"links":{}}`), nil
+	})
+
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{
+			name: "query",
+			args: []string{"apps", "list", "--query", "data[?attributes.sku=='SKU-B'].id"},
+			want: `["app-2"]`,
+		},
+		{
+			name: "fields",
+			args: []string{"apps", "list", "--fields", "id,name"},
+			want: `[{"id":"app-1","name":"Alpha"},{"id":"app-2","name":"Beta"}]`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			stdout, stderr := captureOutput(t, func() {
+				if code := cmd.Run(test.args, "1.0.0"); code != cmd.ExitSuccess {
+					t.Fatalf("expected exit code %d, got %d", cmd.ExitSuccess, code)
+				}
+			})
+			if strings.TrimSpace(stderr) != "" {
+				t.Fatalf("expected empty stderr, got %q", stderr)
+			}
+			if got := strings.TrimSpace(stdout); got != test.want {
+				t.Fatalf("stdout = %q, want %q", got, test.want)
+			}
+		})
+	}
+}
@@ -479,8 +479,8 @@ func TestSubscriptionsOfferCodesListOutputErrors(t *testing.T) {
 	}{
 		{
 			name:    "unsupported output",
-			args:    []string{"subscriptions", "offers", "offer-codes", "list", "--subscription-id", "8000000001", "--output", "yaml"},
-			wantErr: "unsupported format: yaml",
+			args:    []string{"subscriptions", "offers", "offer-codes", "list", "--subscription-id", "8000000001", "--output", "xml"},
+			wantErr: "unsupported format: xml",
 		},
 		{
 			name:    "pretty with markdown",
@@ -154,12 +154,12 @@ func TestWebAuthCapabilitiesRunHonorsRootProfileFlag(t *testing.T) {
 
 func TestWebAuthCapabilitiesRunRejectsInvalidOutput(t *testing.T) {
 	_, stderr := captureOutput(t, func() {
-		code := cmd.Run([]string{"web", "auth", "capabilities", "--output", "yaml"}, "1.0.0")
+		code := cmd.Run([]string{"web", "auth", "capabilities", "--output", "xml"}, "1.0.0")
 		if code != cmd.ExitUsage {
 			t.Fatalf("exit code = %d, want %d", code, cmd.ExitUsage)
 		}
 	})
-	if !strings.Contains(stderr, "unsupported format: yaml") {
+	if !strings.Contains(stderr, "unsupported format: xml") {
 		t.Fatalf("expected unsupported format error, got %q", stderr)
 	}
 }
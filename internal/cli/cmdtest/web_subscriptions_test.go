@@ -220,9 +220,9 @@ func TestWebSubscriptionsAvailabilityRemoveFromSaleRunUsageErrors(t *testing.T)
 				"web", "subscriptions", "availability", "remove-from-sale",
 				"--subscription-id", "sub-1",
 				"--confirm",
-				"--output", "yaml",
+				"--output", "xml",
 			},
-			wantErr: "unsupported format: yaml",
+			wantErr: "unsupported format: xml",
 		},
 	}
 
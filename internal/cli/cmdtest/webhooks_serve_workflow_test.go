@@ -0,0 +1,111 @@
+package cmdtest
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWebhooksServe_RouteRunsWorkflow(t *testing.T) {
+	dir := t.TempDir()
+	outPath := filepath.Join(dir, "distributed.txt")
+	path := writeWorkflowJSON(t, dir, fmt.Sprintf(`{
+		"workflows": {
+			"distribute-qa": {
+				"steps": [
+					{"name": "record", "run": "echo \"$EVENT_TYPE $EVENT_DATA_ATTRIBUTES_NEW_STATE\" > %s"}
+				]
+			}
+		}
+	}`, outPath))
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen for free port: %v", err)
+	}
+	port := listener.Addr().(*net.TCPAddr).Port
+	_ = listener.Close()
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	if err := root.Parse([]string{
+		"webhooks", "serve",
+		"--port", fmt.Sprintf("%d", port),
+		"--workflow-file", path,
+		"--route", "BUILD_UPLOAD_STATE_UPDATED=distribute-qa",
+	}); err != nil {
+		t.Fatalf("parse error: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- root.Run(ctx)
+	}()
+	defer func() {
+		cancel()
+		select {
+		case err := <-errCh:
+			if err != nil {
+				t.Errorf("webhooks serve: %v", err)
+			}
+		case <-time.After(10 * time.Second):
+			t.Error("timed out waiting for webhooks serve to stop")
+		}
+	}()
+
+	payload := `{"id":"evt-1","eventType":"BUILD_UPLOAD_STATE_UPDATED","data":{"attributes":{"newState":"COMPLETE"}}}`
+	client := &http.Client{Timeout: time.Second}
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		resp, err := client.Post(fmt.Sprintf("http://127.0.0.1:%d", port), "application/json", strings.NewReader(payload))
+		if err == nil {
+			_ = resp.Body.Close()
+			if resp.StatusCode != http.StatusAccepted {
+				t.Fatalf("expected status %d, got %d", http.StatusAccepted, resp.StatusCode)
+			}
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("post webhook event: %v", err)
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+
+	for {
+		data, readErr := os.ReadFile(outPath)
+		runs, _ := filepath.Glob(filepath.Join(filepath.Dir(path), "runs", "distribute-qa-*.json"))
+		if readErr == nil && len(runs) == 1 {
+			if got := strings.TrimSpace(string(data)); got != "BUILD_UPLOAD_STATE_UPDATED COMPLETE" {
+				t.Fatalf("unexpected workflow output %q", got)
+			}
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for workflow run, got output %q (%v) and runs %v", data, readErr, runs)
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+}
+
+func TestWebhooksServe_RouteUnknownWorkflow(t *testing.T) {
+	dir := t.TempDir()
+	path := writeWorkflowJSON(t, dir, `{"workflows": {"beta": {"steps": ["echo hi"]}}}`)
+
+	_, stderr, err := runWorkflowCommand(t, "webhooks", "serve", "--port", "0", "--workflow-file", path, "--route", "BUILD_UPLOAD_STATE_UPDATED=missing")
+	if !errors.Is(err, flag.ErrHelp) {
+		t.Fatalf("expected ErrHelp, got %v", err)
+	}
+	if !strings.Contains(stderr, `workflow "missing" not found`) {
+		t.Fatalf("expected unknown workflow error, got %q", stderr)
+	}
+}
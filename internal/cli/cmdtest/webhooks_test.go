@@ -136,6 +136,11 @@ func TestWebhooksValidationErrors(t *testing.T) {
 			args:    []string{"webhooks", "serve", "--max-body-bytes", "0"},
 			wantErr: "--max-body-bytes must be greater than 0",
 		},
+		{
+			name:    "serve route without workflow file",
+			args:    []string{"webhooks", "serve", "--route", "BUILD_UPLOAD_STATE_UPDATED=qa"},
+			wantErr: "--route and --queue-dir require --workflow-file",
+		},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,187 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestWorkflowRun_ASCStepRunsInProcess(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	requests := &lockedCounter{}
+	installDefaultTransport(t, roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		requests.Inc()
+		if req.Method != http.MethodGet || req.URL.Path != "/v1/apps/app-1" {
+			t.Errorf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		return insightsJSONResponse(`{
+			"data": {"type":"apps","id":"app-1","attributes":{"name":"My App","bundleId":"com.example.myapp","sku":"sku"}}
+		}`), nil
+	}))
+
+	dir := t.TempDir()
+	path := writeWorkflowJSON(t, dir, `{
+		"workflows": {
+			"inspect": {
+				"steps": [
+					{
+						"name": "lookup",
+						"asc": ["apps", "view", "--id", "$APP", "--output", "table"],
+						"outputs": {"BUNDLE_ID": "$.data.attributes.bundleId"}
+					},
+					"echo bundle=${steps.lookup.BUNDLE_ID}"
+				]
+			}
+		}
+	}`)
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"workflow", "run", "--file", path, "inspect", "APP:app-1"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if got := requests.Load(); got != 1 {
+		t.Fatalf("expected 1 API request, got %d", got)
+	}
+	if !strings.Contains(stderr, "bundle=com.example.myapp") {
+		t.Fatalf("expected run step to receive asc step output, got stderr %q", stderr)
+	}
+	if !strings.Contains(stderr, "My App") {
+		t.Fatalf("expected the step's table output on the step stream, got stderr %q", stderr)
+	}
+
+	var result map[string]any
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("expected workflow JSON on stdout only, got %q: %v", stdout, err)
+	}
+	if result["status"] != "ok" {
+		t.Fatalf("expected status ok, got %v", result["status"])
+	}
+	steps, _ := result["steps"].([]any)
+	if len(steps) != 2 {
+		t.Fatalf("expected 2 step results, got %v", result["steps"])
+	}
+	first, _ := steps[0].(map[string]any)
+	if first["command"] != "asc apps view --id $APP --output table" {
+		t.Fatalf("expected asc step command, got %v", first["command"])
+	}
+}
+
+func TestWorkflowRun_ASCStepRejectsWorkflowCommands(t *testing.T) {
+	dir := t.TempDir()
+	path := writeWorkflowJSON(t, dir, `{
+		"workflows": {
+			"nested": {
+				"steps": [{"asc": ["workflow", "list"]}]
+			}
+		}
+	}`)
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"workflow", "run", "--file", path, "nested"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err == nil {
+			t.Fatal("expected error for asc workflow step")
+		}
+	})
+
+	if !strings.Contains(stdout, "use a workflow step instead") {
+		t.Fatalf("expected workflow step guidance in result, got %q", stdout)
+	}
+}
+
+func TestWorkflowRun_ParallelASCStepsUseTheirOwnEnvAndProjection(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	t.Setenv("ASC_APP_ID", "")
+
+	var mu sync.Mutex
+	var apps []string
+	installDefaultTransport(t, roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		mu.Lock()
+		apps = append(apps, req.URL.Query().Get("filter[app]"))
+		mu.Unlock()
+		return insightsJSONResponse(`{"data":[],"meta":{"paging":{"total":3,"limit":1}}}`), nil
+	}))
+
+	dir := t.TempDir()
+	path := writeWorkflowJSON(t, dir, `{
+		"workflows": {
+			"team-a": {
+				"private": true,
+				"env": {"ASC_APP_ID": "111"},
+				"steps": [
+					{"name": "count_a", "asc": ["builds", "count", "--fields", "total"], "outputs": {"TOTAL": "$.total"}},
+					"echo a=${steps.count_a.TOTAL}"
+				]
+			},
+			"team-b": {
+				"private": true,
+				"env": {"ASC_APP_ID": "222"},
+				"steps": [
+					{"name": "count_b", "asc": ["builds", "count"], "outputs": {"APP": "$.appId"}},
+					"echo b=${steps.count_b.APP}"
+				]
+			},
+			"inspect": {
+				"steps": [
+					{"parallel": [{"workflow": "team-a"}, {"workflow": "team-b"}]},
+					"echo process=[$ASC_APP_ID]"
+				]
+			}
+		}
+	}`)
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"--fields", "status", "workflow", "run", "--file", path, "inspect"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	mu.Lock()
+	slices.Sort(apps)
+	mu.Unlock()
+	if want := []string{"111", "222"}; !slices.Equal(apps, want) {
+		t.Fatalf("expected each step to use its own ASC_APP_ID, got %v", apps)
+	}
+	// Neither the root --fields nor count_a's --fields reach count_b, whose
+	// result still has appId.
+	if !strings.Contains(stderr, "a=3") || !strings.Contains(stderr, "b=222") {
+		t.Fatalf("expected --fields to apply to its own step only, got stderr %q", stderr)
+	}
+	if !strings.Contains(stderr, "process=[]") {
+		t.Fatalf("expected step env to stay out of the process, got stderr %q", stderr)
+	}
+	if got := os.Getenv("ASC_APP_ID"); got != "" {
+		t.Fatalf("expected process ASC_APP_ID to stay empty, got %q", got)
+	}
+	if strings.Contains(stdout, "appId") {
+		t.Fatalf("expected step output to stay off process stdout, got %q", stdout)
+	}
+}
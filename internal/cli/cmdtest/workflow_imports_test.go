@@ -0,0 +1,62 @@
+package cmdtest
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestWorkflowRun_ImportedWorkflow(t *testing.T) {
+	dir := t.TempDir()
+	libDir := filepath.Join(dir, "shared-workflows", "v1")
+	if err := os.MkdirAll(libDir, 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(libDir, "workflow.json"), []byte(`{
+		"env": {"TRACK": "beta"},
+		"workflows": {
+			"release": {"steps": [{"workflow": "announce"}]},
+			"announce": {"private": true, "steps": ["echo released to $TRACK"]}
+		}
+	}`), 0o600); err != nil {
+		t.Fatalf("write library: %v", err)
+	}
+	path := writeWorkflowJSON(t, dir, `{
+		"imports": {"lib": "../shared-workflows/v1"},
+		"workflows": {
+			"ship": {"steps": [{"workflow": "lib.release"}]}
+		}
+	}`)
+
+	listOut, _, err := runWorkflowCommand(t, "workflow", "list", "--file", path)
+	if err != nil {
+		t.Fatalf("workflow list: %v", err)
+	}
+	var listed []struct {
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal([]byte(listOut), &listed); err != nil {
+		t.Fatalf("parse list output %q: %v", listOut, err)
+	}
+	if len(listed) != 2 || listed[0].Name != "lib.release" || listed[1].Name != "ship" {
+		t.Fatalf("expected public root and imported workflows, got %+v", listed)
+	}
+
+	validateOut, _, err := runWorkflowCommand(t, "workflow", "validate", "--file", path)
+	if err != nil || !strings.Contains(validateOut, `"valid":true`) {
+		t.Fatalf("expected valid workflow file, got %q (%v)", validateOut, err)
+	}
+
+	runOut, runErr, err := runWorkflowCommand(t, "workflow", "run", "--file", path, "ship")
+	if err != nil {
+		t.Fatalf("workflow run: %v (stderr %q)", err, runErr)
+	}
+	if !strings.Contains(runErr, "released to beta") {
+		t.Fatalf("expected imported workflow output on stderr, got %q", runErr)
+	}
+	if !strings.Contains(runOut, `"status":"ok"`) {
+		t.Fatalf("expected ok run, got %q", runOut)
+	}
+}
@@ -0,0 +1,184 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func runWorkflowCommand(t *testing.T, args ...string) (string, string, error) {
+	t.Helper()
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse(args); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	return stdout, stderr, runErr
+}
+
+func TestWorkflowRuns_ListShowAndLogs(t *testing.T) {
+	dir := t.TempDir()
+	path := writeWorkflowJSON(t, dir, `{
+		"workflows": {
+			"release": {
+				"steps": [
+					{"name": "build", "run": "echo compiled"},
+					{"name": "upload", "run": "echo sending >&2; exit 4"}
+				]
+			}
+		}
+	}`)
+
+	runOut, _, err := runWorkflowCommand(t, "workflow", "run", "--file", path, "release")
+	if err == nil {
+		t.Fatal("expected workflow run to fail")
+	}
+	var runResult struct {
+		RunID string `json:"run_id"`
+	}
+	if err := json.Unmarshal([]byte(runOut), &runResult); err != nil || runResult.RunID == "" {
+		t.Fatalf("expected run ID in run output, got %q: %v", runOut, err)
+	}
+
+	listOut, _, err := runWorkflowCommand(t, "workflow", "runs", "list", "--file", path)
+	if err != nil {
+		t.Fatalf("runs list: %v", err)
+	}
+	var runs []map[string]any
+	if err := json.Unmarshal([]byte(listOut), &runs); err != nil {
+		t.Fatalf("expected JSON list, got %q: %v", listOut, err)
+	}
+	if len(runs) != 1 || runs[0]["run_id"] != runResult.RunID || runs[0]["status"] != "error" || runs[0]["failed_step"] != "upload" {
+		t.Fatalf("unexpected runs list: %v", runs)
+	}
+
+	filteredOut, _, err := runWorkflowCommand(t, "workflow", "runs", "list", "--file", path, "--status", "ok")
+	if err != nil {
+		t.Fatalf("runs list --status: %v", err)
+	}
+	if strings.TrimSpace(filteredOut) != "[]" {
+		t.Fatalf("expected no ok runs, got %q", filteredOut)
+	}
+
+	showOut, _, err := runWorkflowCommand(t, "workflow", "runs", "show", "--file", path, runResult.RunID)
+	if err != nil {
+		t.Fatalf("runs show: %v", err)
+	}
+	var shown struct {
+		Steps []struct {
+			Name   string `json:"name"`
+			Status string `json:"status"`
+			Log    string `json:"log"`
+		} `json:"steps"`
+	}
+	if err := json.Unmarshal([]byte(showOut), &shown); err != nil {
+		t.Fatalf("expected JSON run, got %q: %v", showOut, err)
+	}
+	if len(shown.Steps) != 2 || shown.Steps[0].Status != "ok" || shown.Steps[1].Status != "error" || shown.Steps[1].Log == "" {
+		t.Fatalf("unexpected shown steps: %+v", shown.Steps)
+	}
+
+	logsOut, _, err := runWorkflowCommand(t, "workflow", "runs", "logs", "--file", path, runResult.RunID)
+	if err != nil {
+		t.Fatalf("runs logs: %v", err)
+	}
+	want := "==> step 1 build (ok) <==\ncompiled\n==> step 2 upload (error) <==\nsending\n"
+	if logsOut != want {
+		t.Fatalf("unexpected logs:\n%s\nwant:\n%s", logsOut, want)
+	}
+
+	stepOut, _, err := runWorkflowCommand(t, "workflow", "runs", "logs", "--file", path, "--step", "upload", runResult.RunID)
+	if err != nil {
+		t.Fatalf("runs logs --step: %v", err)
+	}
+	if stepOut != "==> step 2 upload (error) <==\nsending\n" {
+		t.Fatalf("unexpected step logs: %q", stepOut)
+	}
+}
+
+func TestWorkflowRuns_ShowUnknownRun(t *testing.T) {
+	dir := t.TempDir()
+	path := writeWorkflowJSON(t, dir, `{"workflows": {"beta": {"steps": ["echo hi"]}}}`)
+
+	_, _, err := runWorkflowCommand(t, "workflow", "runs", "show", "--file", path, "missing-run")
+	if err == nil || !strings.Contains(err.Error(), `workflow run "missing-run" not found`) {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+}
+
+func TestWorkflowRuns_ValidationErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{"show missing run id", []string{"workflow", "runs", "show"}, "run ID is required"},
+		{"logs extra args", []string{"workflow", "runs", "logs", "a", "b"}, "unexpected argument(s): b"},
+		{"list negative limit", []string{"workflow", "runs", "list", "--limit", "-1"}, "--limit must be greater than or equal to 0"},
+		{"prune missing older-than", []string{"workflow", "runs", "prune", "--confirm"}, "--older-than is required"},
+		{"prune missing confirm", []string{"workflow", "runs", "prune", "--older-than", "30d"}, "--confirm is required to delete runs"},
+		{"prune invalid older-than", []string{"workflow", "runs", "prune", "--older-than", "soon", "--dry-run"}, "--older-than must be a duration"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, stderr, err := runWorkflowCommand(t, tt.args...)
+			if !errors.Is(err, flag.ErrHelp) {
+				t.Fatalf("expected ErrHelp, got %v", err)
+			}
+			if !strings.Contains(stderr, tt.wantErr) {
+				t.Fatalf("expected %q in stderr, got %q", tt.wantErr, stderr)
+			}
+		})
+	}
+}
+
+func TestWorkflowRuns_Prune(t *testing.T) {
+	dir := t.TempDir()
+	path := writeWorkflowJSON(t, dir, `{"workflows": {"beta": {"steps": ["echo hi"]}}}`)
+
+	runOut, _, err := runWorkflowCommand(t, "workflow", "run", "--file", path, "beta")
+	if err != nil {
+		t.Fatalf("workflow run: %v", err)
+	}
+	var runResult struct {
+		RunID   string `json:"run_id"`
+		RunFile string `json:"run_file"`
+	}
+	if err := json.Unmarshal([]byte(runOut), &runResult); err != nil {
+		t.Fatalf("parse run output: %v", err)
+	}
+
+	recentOut, _, err := runWorkflowCommand(t, "workflow", "runs", "prune", "--file", path, "--older-than", "1d", "--confirm")
+	if err != nil {
+		t.Fatalf("runs prune: %v", err)
+	}
+	if !strings.Contains(recentOut, `"pruned":[]`) {
+		t.Fatalf("expected recent run to be kept, got %q", recentOut)
+	}
+
+	futureOut, _, err := runWorkflowCommand(t, "workflow", "runs", "prune", "--file", path, "--older-than", "2999-01-01", "--confirm")
+	if err != nil {
+		t.Fatalf("runs prune: %v", err)
+	}
+	if !strings.Contains(futureOut, runResult.RunID) {
+		t.Fatalf("expected run to be pruned, got %q", futureOut)
+	}
+	if _, err := os.Stat(runResult.RunFile); !os.IsNotExist(err) {
+		t.Fatalf("expected run file to be deleted, got %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(filepath.Dir(runResult.RunFile), "logs", runResult.RunID)); !os.IsNotExist(err) {
+		t.Fatalf("expected run logs to be deleted, got %v", err)
+	}
+}
@@ -0,0 +1,80 @@
+package cmdtest
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"strings"
+	"testing"
+)
+
+func TestWorkflowSchema_PrintsJSONSchema(t *testing.T) {
+	stdout, _, err := runWorkflowCommand(t, "workflow", "schema")
+	if err != nil {
+		t.Fatalf("workflow schema: %v", err)
+	}
+	var schema struct {
+		Schema     string                     `json:"$schema"`
+		Properties map[string]json.RawMessage `json:"properties"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &schema); err != nil {
+		t.Fatalf("expected JSON output, got %q (%v)", stdout, err)
+	}
+	if schema.Schema == "" || schema.Properties["workflows"] == nil || schema.Properties["$schema"] == nil {
+		t.Fatalf("expected a workflow file schema, got %s", stdout)
+	}
+}
+
+func TestWorkflowGraph(t *testing.T) {
+	dir := t.TempDir()
+	path := writeWorkflowJSON(t, dir, `{
+		"workflows": {
+			"release": {"steps": [{"name": "build", "run": "echo build"}, {"workflow": "notify"}]},
+			"notify": {"private": true, "steps": ["echo sent"]},
+			"other": {"steps": ["echo other"]}
+		}
+	}`)
+
+	stdout, _, err := runWorkflowCommand(t, "workflow", "graph", "--file", path, "release")
+	if err != nil {
+		t.Fatalf("workflow graph: %v", err)
+	}
+	for _, want := range []string{"flowchart TD", `subgraph w2["notify (private)"]`, "w1s2 -. calls .-> w2"} {
+		if !strings.Contains(stdout, want) {
+			t.Fatalf("expected %q in mermaid output, got %q", want, stdout)
+		}
+	}
+	if strings.Contains(stdout, "other") {
+		t.Fatalf("expected only workflows reachable from release, got %q", stdout)
+	}
+
+	stdout, _, err = runWorkflowCommand(t, "workflow", "graph", "--file", path, "--format", "dot")
+	if err != nil {
+		t.Fatalf("workflow graph --format dot: %v", err)
+	}
+	if !strings.HasPrefix(stdout, "digraph workflows {") || !strings.Contains(stdout, "lhead=cluster_w1") {
+		t.Fatalf("expected DOT output, got %q", stdout)
+	}
+}
+
+func TestWorkflowGraph_ValidationErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{"bad format", []string{"workflow", "graph", "--format", "svg"}, "--format must be mermaid or dot"},
+		{"extra args", []string{"workflow", "graph", "release", "beta"}, "unexpected argument(s): beta"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, stderr, err := runWorkflowCommand(t, tt.args...)
+			if !errors.Is(err, flag.ErrHelp) {
+				t.Fatalf("expected ErrHelp, got %v", err)
+			}
+			if !strings.Contains(stderr, tt.wantErr) {
+				t.Fatalf("expected %q in stderr, got %q", tt.wantErr, stderr)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package cmdtest
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestWorkflowSecrets_ValidationErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{"set missing name", []string{"workflow", "secrets", "set"}, "secret name is required"},
+		{"set invalid name", []string{"workflow", "secrets", "set", "slack token"}, `secret name "slack token" may only contain`},
+		{"set empty value", []string{"workflow", "secrets", "set", "SLACK_TOKEN"}, "secret value is required on stdin"},
+		{"delete missing confirm", []string{"workflow", "secrets", "delete", "SLACK_TOKEN"}, "--confirm is required to delete a secret"},
+		{"delete extra args", []string{"workflow", "secrets", "delete", "A", "B"}, "unexpected argument(s): B"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stderr string
+			var err error
+			withNonTTYStdin(t, func() {
+				_, stderr, err = runWorkflowCommand(t, tt.args...)
+			})
+			if !errors.Is(err, flag.ErrHelp) {
+				t.Fatalf("expected ErrHelp, got %v", err)
+			}
+			if !strings.Contains(stderr, tt.wantErr) {
+				t.Fatalf("expected %q in stderr, got %q", tt.wantErr, stderr)
+			}
+		})
+	}
+}
+
+func TestWorkflowRun_MasksFileSecret(t *testing.T) {
+	dir := t.TempDir()
+	path := writeWorkflowJSON(t, dir, `{
+		"secrets": {"MATCH_PASSWORD": {"file": "match-password.txt"}},
+		"workflows": {
+			"sync": {"steps": [{"name": "sync", "run": "echo password=$MATCH_PASSWORD"}]}
+		}
+	}`)
+	if err := os.WriteFile(filepath.Join(filepath.Dir(path), "match-password.txt"), []byte("correct-horse\n"), 0o600); err != nil {
+		t.Fatalf("write secret file: %v", err)
+	}
+
+	stdout, stderr, err := runWorkflowCommand(t, "workflow", "run", "--file", path, "sync")
+	if err != nil {
+		t.Fatalf("workflow run: %v", err)
+	}
+	if !strings.Contains(stderr, "password=***") || strings.Contains(stderr, "correct-horse") {
+		t.Fatalf("expected masked step output, got %q", stderr)
+	}
+	var result struct {
+		Status string `json:"status"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil || result.Status != "ok" {
+		t.Fatalf("expected ok result, got %q (%v)", stdout, err)
+	}
+}
@@ -1772,3 +1772,49 @@ func TestWorkflowValidate_RejectsDuplicateOutputProducerNamesInSameRunGraph(t *t
 		t.Fatalf("expected duplicate_output_producer_name error, got %v", errs)
 	}
 }
+
+func TestWorkflowRun_MatrixNamespacesOutputs(t *testing.T) {
+	dir := t.TempDir()
+	path := writeWorkflowJSON(t, dir, `{
+		"workflows": {
+			"release": {
+				"steps": [
+					{
+						"name": "build",
+						"run": "printf '{\"id\":\"%s\"}' ${{ matrix.app }}",
+						"outputs": {"ID": "$.id"},
+						"matrix": {"app": ["one", "two"]}
+					}
+				]
+			}
+		}
+	}`)
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"workflow", "run", "--file", path, "release"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var result struct {
+		Outputs map[string]map[string]string `json:"outputs"`
+		Steps   []struct {
+			Matrix map[string]string `json:"matrix"`
+		} `json:"steps"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("expected valid JSON, got %q: %v", stdout, err)
+	}
+	if result.Outputs["build[app=one]"]["ID"] != "one" || result.Outputs["build[app=two]"]["ID"] != "two" {
+		t.Fatalf("expected namespaced outputs, got %#v", result.Outputs)
+	}
+	if len(result.Steps) != 2 || result.Steps[1].Matrix["app"] != "two" {
+		t.Fatalf("expected one step result per combination, got %+v", result.Steps)
+	}
+}
@@ -93,13 +93,13 @@ func runListCommand(ctx context.Context, config shared.ListCommandConfig, flags
 		return fmt.Errorf("%s: %w", prefix, err)
 	}
 
-	resolvedAppID := shared.ResolveAppID(*flags.appID)
+	resolvedAppID := shared.ResolveAppID(ctx, *flags.appID)
 	if resolvedAppID == "" && strings.TrimSpace(*flags.next) == "" {
 		fmt.Fprintf(os.Stderr, "Error: --app is required (or set ASC_APP_ID)\n\n")
 		return flag.ErrHelp
 	}
 
-	client, err := shared.GetASCClient()
+	client, err := shared.GetASCClient(ctx)
 	if err != nil {
 		return fmt.Errorf("%s: %w", prefix, err)
 	}
@@ -136,14 +136,12 @@ func runListCommand(ctx context.Context, config shared.ListCommandConfig, flags
 			return fmt.Errorf("%s: failed to fetch: %w", prefix, err)
 		}
 
-		crashes, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		if err := shared.PrintPaginatedOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 			return client.GetCrashes(ctx, resolvedAppID, asc.WithCrashNextURL(nextURL))
-		})
-		if err != nil {
+		}, *flags.output.Output, *flags.output.Pretty); err != nil {
 			return fmt.Errorf("%s: %w", prefix, err)
 		}
-
-		return shared.PrintOutput(crashes, *flags.output.Output, *flags.output.Pretty)
+		return nil
 	}
 
 	crashes, err := client.GetCrashes(requestCtx, resolvedAppID, opts...)
@@ -151,7 +149,7 @@ func runListCommand(ctx context.Context, config shared.ListCommandConfig, flags
 		return fmt.Errorf("%s: failed to fetch: %w", prefix, err)
 	}
 
-	return shared.PrintOutput(crashes, *flags.output.Output, *flags.output.Pretty)
+	return shared.PrintOutput(ctx, crashes, *flags.output.Output, *flags.output.Pretty)
 }
 
 // Crashes command factory
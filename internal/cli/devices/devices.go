@@ -116,7 +116,7 @@ Examples:
 				return fmt.Errorf("devices list: %w", err)
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("devices list: %w", err)
 			}
@@ -151,14 +151,12 @@ Examples:
 					return fmt.Errorf("devices list: failed to fetch: %w", err)
 				}
 
-				devices, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				if err := shared.PrintPaginatedOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetDevices(ctx, asc.WithDevicesNextURL(nextURL))
-				})
-				if err != nil {
+				}, *output.Output, *output.Pretty); err != nil {
 					return fmt.Errorf("devices list: %w", err)
 				}
-
-				return shared.PrintOutput(devices, *output.Output, *output.Pretty)
+				return nil
 			}
 
 			devices, err := client.GetDevices(requestCtx, opts...)
@@ -166,7 +164,7 @@ Examples:
 				return fmt.Errorf("devices list: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(devices, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, devices, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -202,7 +200,7 @@ Examples:
 				return fmt.Errorf("devices get: %w", err)
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("devices get: %w", err)
 			}
@@ -215,7 +213,7 @@ Examples:
 				return fmt.Errorf("devices get: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(device, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, device, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -248,7 +246,7 @@ Examples:
 				Platform: "MAC_OS",
 			}
 
-			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -316,7 +314,7 @@ Examples:
 				return fmt.Errorf("devices register: %w", err)
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("devices register: %w", err)
 			}
@@ -335,7 +333,7 @@ Examples:
 				return fmt.Errorf("devices register: failed to register: %w", err)
 			}
 
-			return shared.PrintOutput(device, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, device, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -379,7 +377,7 @@ Examples:
 				return fmt.Errorf("devices update: %w", err)
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("devices update: %w", err)
 			}
@@ -401,7 +399,7 @@ Examples:
 				return fmt.Errorf("devices update: failed to update: %w", err)
 			}
 
-			return shared.PrintOutput(device, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, device, *output.Output, *output.Pretty)
 		},
 	}
 }
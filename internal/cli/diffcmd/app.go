@@ -0,0 +1,139 @@
+package diffcmd
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/metadata"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+var appDiffDefaultIncludes = []string{"localizations", "categories", "age-rating", "review-info"}
+
+// DiffAppCommand compares the metadata of two apps, such as white-label
+// variants of one product.
+func DiffAppCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("app", flag.ExitOnError)
+
+	left := fs.String("left", "", "Source app ID (required)")
+	right := fs.String("right", "", "Target app ID (required)")
+	version := fs.String("version", "", "App version string used for both apps (for example 1.2.3)")
+	leftVersion := fs.String("left-version", "", "Source app version string (overrides --version)")
+	rightVersion := fs.String("right-version", "", "Target app version string (overrides --version)")
+	leftAppInfo := fs.String("left-app-info", "", "Source App Info ID (optional override)")
+	rightAppInfo := fs.String("right-app-info", "", "Target App Info ID (optional override)")
+	platform := fs.String("platform", "", "Optional platform: IOS, MAC_OS, TV_OS, or VISION_OS")
+	include := fs.String("include", "", "Compared scopes (comma-separated; default: "+strings.Join(appDiffDefaultIncludes, ",")+"; all selects every scope)")
+	output := bindResourceDiffOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "app",
+		ShortUsage: `asc diff app --left "APP_A" --right "APP_B" --version "1.2.3" [flags]`,
+		ShortHelp:  "Diff metadata between two apps.",
+		LongHelp: `Diff metadata between two apps.
+
+Compares the metadata of one version of each app, which keeps white-label
+apps built from the same product in sync. Adds are fields only the --right
+app has; deletes are fields only the --left app has.
+
+Scopes: localizations, categories, age-rating, review-info, content-rights,
+eula, availability, pricing, media, build, or all.
+
+Output formats: json (default), table, markdown, diff (unified diff per field),
+and json-patch (RFC 6902 operations that turn --left into --right).
+
+Examples:
+  asc diff app --left "APP_A" --right "APP_B" --version "1.2.3"
+  asc diff app --left "APP_A" --right "APP_B" --left-version "2.0" --right-version "1.4" --output table
+  asc diff app --left "APP_A" --right "APP_B" --version "1.2.3" --include all --output diff`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if len(args) > 0 {
+				return shared.UsageError("diff app does not accept positional arguments")
+			}
+
+			leftAppID := strings.TrimSpace(*left)
+			if leftAppID == "" {
+				return shared.UsageError("--left is required")
+			}
+			rightAppID := strings.TrimSpace(*right)
+			if rightAppID == "" {
+				return shared.UsageError("--right is required")
+			}
+			leftVersionValue := firstNonEmpty(*leftVersion, *version)
+			if leftVersionValue == "" {
+				return shared.UsageError("--version or --left-version is required")
+			}
+			rightVersionValue := firstNonEmpty(*rightVersion, *version)
+			if rightVersionValue == "" {
+				return shared.UsageError("--version or --right-version is required")
+			}
+			platformValue, err := normalizeDiffPlatform(*platform)
+			if err != nil {
+				return shared.UsageError(err.Error())
+			}
+			includes, err := parseDiffIncludes(*include, appDiffDefaultIncludes)
+			if err != nil {
+				return shared.UsageError(err.Error())
+			}
+
+			client, err := shared.GetASCClient(ctx)
+			if err != nil {
+				return fmt.Errorf("diff app: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			command := fmt.Sprintf(`asc diff app --left %q --right %q --left-version %q --right-version %q`, leftAppID, rightAppID, leftVersionValue, rightVersionValue)
+			leftSnapshot, leftValues, err := collectRemoteValues(requestCtx, client, metadata.SnapshotOptions{
+				AppID:     leftAppID,
+				AppInfoID: strings.TrimSpace(*leftAppInfo),
+				Version:   leftVersionValue,
+				Platform:  platformValue,
+				AppInfoExample: func(infoID string) string {
+					return buildDiffAppInfoExample(command, platformValue, "--left-app-info", infoID)
+				},
+			}, includes)
+			if err != nil {
+				return resourceDiffError("diff app", err)
+			}
+			rightSnapshot, rightValues, err := collectRemoteValues(requestCtx, client, metadata.SnapshotOptions{
+				AppID:     rightAppID,
+				AppInfoID: strings.TrimSpace(*rightAppInfo),
+				Version:   rightVersionValue,
+				Platform:  platformValue,
+				AppInfoExample: func(infoID string) string {
+					return buildDiffAppInfoExample(command, platformValue, "--right-app-info", infoID)
+				},
+			}, includes)
+			if err != nil {
+				return resourceDiffError("diff app", err)
+			}
+
+			plan := buildResourceDiffPlan(
+				"app",
+				resourceDiffEndpoint{Kind: "remote", AppID: leftAppID, Version: leftVersionValue, VersionID: leftSnapshot.VersionID},
+				resourceDiffEndpoint{Kind: "remote", AppID: rightAppID, Version: rightVersionValue, VersionID: rightSnapshot.VersionID},
+				includes,
+				leftValues,
+				rightValues,
+			)
+			return printResourceDiffPlan(ctx, plan, leftValues, rightValues, output)
+		},
+	}
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if trimmed := strings.TrimSpace(value); trimmed != "" {
+			return trimmed
+		}
+	}
+	return ""
+}
@@ -21,11 +21,17 @@ func DiffCommand() *ffcli.Command {
 
 Examples:
   asc diff localizations --app "APP_ID" --path "./metadata/localizations" --version "VERSION_ID"
-  asc diff localizations --app "APP_ID" --from-version "VERSION_ID_A" --to-version "VERSION_ID_B"`,
+  asc diff localizations --app "APP_ID" --from-version "VERSION_ID_A" --to-version "VERSION_ID_B"
+  asc diff version --app "APP_ID" --from "1.2.0" --to "1.3.0"
+  asc diff app --left "APP_A" --right "APP_B" --version "1.2.3"
+  asc diff metadata --app "APP_ID" --version "1.2.3" --dir "./metadata" --output diff`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
 			DiffLocalizationsCommand(),
+			DiffVersionCommand(),
+			DiffAppCommand(),
+			DiffMetadataCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			return flag.ErrHelp
@@ -0,0 +1,530 @@
+package diffcmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/metadata"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// diffIncludeBuild compares the build attached to each version. It is the
+// only include that is not a metadata scope.
+const diffIncludeBuild = "build"
+
+// Output formats accepted by the resource diff commands.
+var resourceDiffOutputFormats = []string{"json", "table", "markdown", "diff", "json-patch"}
+
+type resourceDiffEndpoint struct {
+	Kind      string `json:"kind"`
+	AppID     string `json:"appId,omitempty"`
+	Version   string `json:"version,omitempty"`
+	VersionID string `json:"versionId,omitempty"`
+	Path      string `json:"path,omitempty"`
+}
+
+const (
+	resourceDiffReasonAdd    = "field exists in target but not in source"
+	resourceDiffReasonDelete = "field exists in source but not in target"
+	resourceDiffReasonUpdate = "field value differs"
+)
+
+type resourceDiffItem struct {
+	Key    string `json:"key"`
+	Scope  string `json:"scope"`
+	Locale string `json:"locale,omitempty"`
+	Field  string `json:"field"`
+	Reason string `json:"reason"`
+	From   string `json:"from,omitempty"`
+	To     string `json:"to,omitempty"`
+}
+
+type resourceDiffPlan struct {
+	Scope     string               `json:"scope"`
+	Direction string               `json:"direction"`
+	Source    resourceDiffEndpoint `json:"source"`
+	Target    resourceDiffEndpoint `json:"target"`
+	Includes  []string             `json:"includes"`
+	Adds      []resourceDiffItem   `json:"adds"`
+	Updates   []resourceDiffItem   `json:"updates"`
+	Deletes   []resourceDiffItem   `json:"deletes"`
+}
+
+// resourceValues holds flattened values by scope and "<locale>:<field>" (or
+// field alone for scopes without locales), as returned by metadata snapshots.
+type resourceValues map[string]map[string]string
+
+// jsonPatchOperation is one RFC 6902 operation.
+type jsonPatchOperation struct {
+	Op    string `json:"op"`
+	Path  string `json:"path"`
+	Value any    `json:"value,omitempty"`
+}
+
+func bindResourceDiffOutputFlags(fs *flag.FlagSet) shared.OutputFlags {
+	return shared.BindOutputFlagsWithAllowed(
+		fs,
+		"output",
+		shared.DefaultOutputFormat(),
+		"Output format: json, table, markdown, diff (unified), json-patch (RFC 6902)",
+		resourceDiffOutputFormats...,
+	)
+}
+
+// parseDiffIncludes parses --include for resource diffs. An empty value
+// returns defaults; "all" selects every metadata scope plus build.
+func parseDiffIncludes(value string, defaults []string) ([]string, error) {
+	supported := append(metadata.SupportedIncludes(), diffIncludeBuild)
+	items := shared.SplitCSV(value)
+	if len(items) == 0 {
+		items = defaults
+	}
+
+	unique := make(map[string]struct{}, len(items))
+	for _, item := range items {
+		normalized := strings.ToLower(strings.TrimSpace(item))
+		if normalized == "all" {
+			for _, include := range supported {
+				unique[include] = struct{}{}
+			}
+			continue
+		}
+		if !containsString(supported, normalized) {
+			return nil, fmt.Errorf("--include supports: %s, all", strings.Join(supported, ", "))
+		}
+		unique[normalized] = struct{}{}
+	}
+
+	includes := make([]string, 0, len(unique))
+	for include := range unique {
+		includes = append(includes, include)
+	}
+	sort.Strings(includes)
+	return includes, nil
+}
+
+func containsString(values []string, value string) bool {
+	for _, candidate := range values {
+		if candidate == value {
+			return true
+		}
+	}
+	return false
+}
+
+// metadataIncludes drops the diff-only build include.
+func metadataIncludes(includes []string) []string {
+	result := make([]string, 0, len(includes))
+	for _, include := range includes {
+		if include != diffIncludeBuild {
+			result = append(result, include)
+		}
+	}
+	return result
+}
+
+// collectRemoteValues snapshots one remote app version for the selected
+// includes.
+func collectRemoteValues(ctx context.Context, client *asc.Client, opts metadata.SnapshotOptions, includes []string) (metadata.Snapshot, resourceValues, error) {
+	opts.Includes = metadataIncludes(includes)
+	snapshot, err := metadata.CollectSnapshot(ctx, client, opts)
+	if err != nil {
+		return metadata.Snapshot{}, nil, err
+	}
+	values := resourceValues(snapshot.Remote)
+	if containsString(includes, diffIncludeBuild) {
+		fields, err := fetchVersionBuildFields(ctx, client, snapshot.VersionID)
+		if err != nil {
+			return metadata.Snapshot{}, nil, fmt.Errorf("build: %w", err)
+		}
+		values[diffIncludeBuild] = fields
+	}
+	return snapshot, values, nil
+}
+
+// fetchVersionBuildFields returns the comparable attributes of the build
+// attached to a version, or no fields when none is attached.
+func fetchVersionBuildFields(ctx context.Context, client *asc.Client, versionID string) (map[string]string, error) {
+	resp, err := client.GetAppStoreVersionBuild(ctx, versionID)
+	if err != nil {
+		if asc.IsNotFound(err) {
+			return map[string]string{}, nil
+		}
+		return nil, err
+	}
+	fields := make(map[string]string)
+	if resp == nil || strings.TrimSpace(resp.Data.ID) == "" {
+		return fields, nil
+	}
+	attrs := resp.Data.Attributes
+	setDiffField(fields, "buildNumber", attrs.Version)
+	setDiffField(fields, "minOsVersion", attrs.MinOSVersion)
+	setDiffField(fields, "processingState", attrs.ProcessingState)
+	if attrs.UsesNonExemptEncryption != nil {
+		fields["usesNonExemptEncryption"] = fmt.Sprintf("%t", *attrs.UsesNonExemptEncryption)
+	}
+	return fields, nil
+}
+
+func setDiffField(fields map[string]string, key, value string) {
+	if trimmed := strings.TrimSpace(value); trimmed != "" {
+		fields[key] = trimmed
+	}
+}
+
+// resourceDiffError wraps a snapshot error, passing usage errors through.
+func resourceDiffError(command string, err error) error {
+	if errors.Is(err, flag.ErrHelp) {
+		return err
+	}
+	return fmt.Errorf("%s: %w", command, err)
+}
+
+func splitResourceKey(key string) (string, string) {
+	if locale, field, ok := strings.Cut(key, ":"); ok {
+		return locale, field
+	}
+	return "", key
+}
+
+func resourceItemKey(scope, locale, field string) string {
+	if locale == "" {
+		return scope + ":" + field
+	}
+	return scope + ":" + locale + ":" + field
+}
+
+// buildResourceDiffPlan compares source with target. Adds exist only in
+// target, deletes only in source, matching diff localizations.
+func buildResourceDiffPlan(scope string, source, target resourceDiffEndpoint, includes []string, sourceValues, targetValues resourceValues) resourceDiffPlan {
+	plan := resourceDiffPlan{
+		Scope:     scope,
+		Direction: "source-to-target",
+		Source:    source,
+		Target:    target,
+		Includes:  includes,
+		Adds:      make([]resourceDiffItem, 0),
+		Updates:   make([]resourceDiffItem, 0),
+		Deletes:   make([]resourceDiffItem, 0),
+	}
+
+	for _, scopeName := range unionKeys(sourceValues, targetValues) {
+		sourceFields := sourceValues[scopeName]
+		targetFields := targetValues[scopeName]
+		for _, key := range unionKeys(sourceFields, targetFields) {
+			sourceValue, sourceOK := sourceFields[key]
+			targetValue, targetOK := targetFields[key]
+			locale, field := splitResourceKey(key)
+			item := resourceDiffItem{
+				Key:    resourceItemKey(scopeName, locale, field),
+				Scope:  scopeName,
+				Locale: locale,
+				Field:  field,
+			}
+			switch {
+			case !sourceOK && targetOK:
+				item.Reason = resourceDiffReasonAdd
+				item.To = targetValue
+				plan.Adds = append(plan.Adds, item)
+			case sourceOK && !targetOK:
+				item.Reason = resourceDiffReasonDelete
+				item.From = sourceValue
+				plan.Deletes = append(plan.Deletes, item)
+			case sourceOK && targetOK && sourceValue != targetValue:
+				item.Reason = resourceDiffReasonUpdate
+				item.From = sourceValue
+				item.To = targetValue
+				plan.Updates = append(plan.Updates, item)
+			}
+		}
+	}
+	return plan
+}
+
+func unionKeys[V any](left, right map[string]V) []string {
+	seen := make(map[string]struct{}, len(left)+len(right))
+	for key := range left {
+		seen[key] = struct{}{}
+	}
+	for key := range right {
+		seen[key] = struct{}{}
+	}
+	keys := make([]string, 0, len(seen))
+	for key := range seen {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// sortedResourceItems returns every change ordered by key.
+func sortedResourceItems(plan resourceDiffPlan) []resourceDiffItem {
+	items := make([]resourceDiffItem, 0, len(plan.Adds)+len(plan.Updates)+len(plan.Deletes))
+	items = append(items, plan.Adds...)
+	items = append(items, plan.Updates...)
+	items = append(items, plan.Deletes...)
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].Key < items[j].Key
+	})
+	return items
+}
+
+func printResourceDiffPlan(ctx context.Context, plan resourceDiffPlan, sourceValues, targetValues resourceValues, output shared.OutputFlags) error {
+	format, err := shared.ValidateOutputFormatAllowed(*output.Output, *output.Pretty, resourceDiffOutputFormats...)
+	if err != nil {
+		return shared.UsageError(err.Error())
+	}
+	switch format {
+	case "diff":
+		_, err := fmt.Fprint(shared.Stdout(ctx), renderUnifiedDiff(plan))
+		return err
+	case "json-patch":
+		operations := buildJSONPatch(sourceValues, targetValues)
+		if *output.Pretty {
+			return asc.PrintPrettyJSONTo(shared.Stdout(ctx), operations)
+		}
+		return asc.PrintJSONTo(shared.Stdout(ctx), operations)
+	default:
+		return shared.PrintOutputWithRenderers(ctx,
+			plan,
+			format,
+			*output.Pretty,
+			func() error {
+				printResourceDiffHeader(plan, false)
+				asc.RenderTable(resourceDiffHeaders, buildResourceDiffRows(plan))
+				return nil
+			},
+			func() error {
+				printResourceDiffHeader(plan, true)
+				asc.RenderMarkdown(resourceDiffHeaders, buildResourceDiffRows(plan))
+				return nil
+			},
+		)
+	}
+}
+
+var resourceDiffHeaders = []string{"change", "key", "scope", "locale", "field", "from", "to"}
+
+func describeResourceEndpoint(endpoint resourceDiffEndpoint) string {
+	if endpoint.Kind == "local" {
+		return "local " + endpoint.Path
+	}
+	parts := []string{"app " + endpoint.AppID}
+	if endpoint.Version != "" {
+		parts = append(parts, "version "+endpoint.Version)
+	}
+	return strings.Join(parts, " ")
+}
+
+func printResourceDiffHeader(plan resourceDiffPlan, markdown bool) {
+	summary := fmt.Sprintf("%d add(s), %d update(s), %d delete(s)", len(plan.Adds), len(plan.Updates), len(plan.Deletes))
+	if markdown {
+		fmt.Printf("**Source:** %s\n\n", describeResourceEndpoint(plan.Source))
+		fmt.Printf("**Target:** %s\n\n", describeResourceEndpoint(plan.Target))
+		fmt.Printf("**Includes:** %s\n\n", strings.Join(plan.Includes, ", "))
+		fmt.Printf("**Summary:** %s\n\n", summary)
+		return
+	}
+	fmt.Printf("Source: %s\n", describeResourceEndpoint(plan.Source))
+	fmt.Printf("Target: %s\n", describeResourceEndpoint(plan.Target))
+	fmt.Printf("Includes: %s\n", strings.Join(plan.Includes, ","))
+	fmt.Printf("Summary: %s\n\n", summary)
+}
+
+func buildResourceDiffRows(plan resourceDiffPlan) [][]string {
+	changes := make(map[string]string, len(plan.Adds)+len(plan.Updates)+len(plan.Deletes))
+	for change, items := range map[string][]resourceDiffItem{"add": plan.Adds, "update": plan.Updates, "delete": plan.Deletes} {
+		for _, item := range items {
+			changes[item.Key] = change
+		}
+	}
+
+	items := sortedResourceItems(plan)
+	rows := make([][]string, 0, len(items))
+	for _, item := range items {
+		rows = append(rows, []string{
+			changes[item.Key],
+			item.Key,
+			item.Scope,
+			item.Locale,
+			item.Field,
+			sanitizeDiffCell(item.From),
+			sanitizeDiffCell(item.To),
+		})
+	}
+	if len(rows) == 0 {
+		rows = append(rows, []string{"none", "", "", "", "", "no changes", ""})
+	}
+	return rows
+}
+
+// renderUnifiedDiff renders each changed field as a file in a git-style
+// unified diff, with the field path standing in for the file name.
+func renderUnifiedDiff(plan resourceDiffPlan) string {
+	var b strings.Builder
+	for _, item := range sortedResourceItems(plan) {
+		path := resourceItemPath(item)
+		fromName, toName := "a/"+path, "b/"+path
+		var fromLines, toLines []string
+		switch item.Reason {
+		case resourceDiffReasonAdd:
+			fromName = "/dev/null"
+			toLines = strings.Split(item.To, "\n")
+		case resourceDiffReasonDelete:
+			toName = "/dev/null"
+			fromLines = strings.Split(item.From, "\n")
+		default:
+			fromLines = strings.Split(item.From, "\n")
+			toLines = strings.Split(item.To, "\n")
+		}
+		fmt.Fprintf(&b, "--- %s\n+++ %s\n", fromName, toName)
+		fmt.Fprintf(&b, "@@ -%s +%s @@\n", hunkRange(len(fromLines)), hunkRange(len(toLines)))
+		for _, line := range diffLines(fromLines, toLines) {
+			b.WriteString(line)
+			b.WriteByte('\n')
+		}
+	}
+	return b.String()
+}
+
+func resourceItemPath(item resourceDiffItem) string {
+	if item.Locale == "" {
+		return item.Scope + "/" + item.Field
+	}
+	return item.Scope + "/" + item.Locale + "/" + item.Field
+}
+
+func hunkRange(count int) string {
+	if count == 0 {
+		return "0,0"
+	}
+	return fmt.Sprintf("1,%d", count)
+}
+
+// diffLines returns a full-context line diff of two short values using the
+// longest common subsequence.
+func diffLines(from, to []string) []string {
+	lengths := make([][]int, len(from)+1)
+	for i := range lengths {
+		lengths[i] = make([]int, len(to)+1)
+	}
+	for i := len(from) - 1; i >= 0; i-- {
+		for j := len(to) - 1; j >= 0; j-- {
+			if from[i] == to[j] {
+				lengths[i][j] = lengths[i+1][j+1] + 1
+			} else {
+				lengths[i][j] = max(lengths[i+1][j], lengths[i][j+1])
+			}
+		}
+	}
+
+	lines := make([]string, 0, len(from)+len(to))
+	i, j := 0, 0
+	for i < len(from) && j < len(to) {
+		switch {
+		case from[i] == to[j]:
+			lines = append(lines, " "+from[i])
+			i++
+			j++
+		case lengths[i+1][j] >= lengths[i][j+1]:
+			lines = append(lines, "-"+from[i])
+			i++
+		default:
+			lines = append(lines, "+"+to[j])
+			j++
+		}
+	}
+	for ; i < len(from); i++ {
+		lines = append(lines, "-"+from[i])
+	}
+	for ; j < len(to); j++ {
+		lines = append(lines, "+"+to[j])
+	}
+	return lines
+}
+
+// buildJSONPatch returns RFC 6902 operations that turn the source document
+// into the target document. Documents nest scope, then locale, then field;
+// a missing scope or locale is added or removed as a whole.
+func buildJSONPatch(sourceValues, targetValues resourceValues) []jsonPatchOperation {
+	operations := make([]jsonPatchOperation, 0)
+	for _, scope := range unionKeys(sourceValues, targetValues) {
+		sourceDoc := nestResourceFields(sourceValues[scope])
+		targetDoc := nestResourceFields(targetValues[scope])
+		_, inSource := sourceValues[scope]
+		_, inTarget := targetValues[scope]
+		scopePath := "/" + escapeJSONPointer(scope)
+		switch {
+		case !inSource:
+			operations = append(operations, jsonPatchOperation{Op: "add", Path: scopePath, Value: targetDoc})
+			continue
+		case !inTarget:
+			operations = append(operations, jsonPatchOperation{Op: "remove", Path: scopePath})
+			continue
+		}
+		operations = append(operations, diffJSONObjects(scopePath, sourceDoc, targetDoc)...)
+	}
+	return operations
+}
+
+// nestResourceFields turns "<locale>:<field>" keys into locale objects.
+func nestResourceFields(fields map[string]string) map[string]any {
+	doc := make(map[string]any, len(fields))
+	for key, value := range fields {
+		locale, field := splitResourceKey(key)
+		if locale == "" {
+			doc[field] = value
+			continue
+		}
+		localeDoc, ok := doc[locale].(map[string]any)
+		if !ok {
+			localeDoc = make(map[string]any)
+			doc[locale] = localeDoc
+		}
+		localeDoc[field] = value
+	}
+	return doc
+}
+
+func diffJSONObjects(path string, source, target map[string]any) []jsonPatchOperation {
+	operations := make([]jsonPatchOperation, 0)
+	for _, key := range unionKeys(source, target) {
+		sourceValue, inSource := source[key]
+		targetValue, inTarget := target[key]
+		childPath := path + "/" + escapeJSONPointer(key)
+		switch {
+		case !inSource:
+			operations = append(operations, jsonPatchOperation{Op: "add", Path: childPath, Value: targetValue})
+		case !inTarget:
+			operations = append(operations, jsonPatchOperation{Op: "remove", Path: childPath})
+		default:
+			sourceObject, sourceIsObject := sourceValue.(map[string]any)
+			targetObject, targetIsObject := targetValue.(map[string]any)
+			if sourceIsObject && targetIsObject {
+				operations = append(operations, diffJSONObjects(childPath, sourceObject, targetObject)...)
+				continue
+			}
+			if !jsonValuesEqual(sourceValue, targetValue) {
+				operations = append(operations, jsonPatchOperation{Op: "replace", Path: childPath, Value: targetValue})
+			}
+		}
+	}
+	return operations
+}
+
+func jsonValuesEqual(left, right any) bool {
+	leftData, leftErr := json.Marshal(left)
+	rightData, rightErr := json.Marshal(right)
+	return leftErr == nil && rightErr == nil && string(leftData) == string(rightData)
+}
+
+// escapeJSONPointer escapes one RFC 6901 reference token.
+func escapeJSONPointer(token string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(token)
+}
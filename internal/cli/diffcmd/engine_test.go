@@ -0,0 +1,68 @@
+package diffcmd
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseDiffIncludes(t *testing.T) {
+	includes, err := parseDiffIncludes("", []string{"localizations", "build"})
+	if err != nil || !reflect.DeepEqual(includes, []string{"build", "localizations"}) {
+		t.Fatalf("defaults: got %v, %v", includes, err)
+	}
+
+	includes, err = parseDiffIncludes("all", nil)
+	if err != nil || !containsString(includes, "build") || !containsString(includes, "media") {
+		t.Fatalf("all: got %v, %v", includes, err)
+	}
+
+	if _, err := parseDiffIncludes("localizations,unknown", nil); err == nil {
+		t.Fatal("expected unsupported include error")
+	}
+}
+
+func TestBuildJSONPatchAddsAndRemovesWholeParents(t *testing.T) {
+	source := resourceValues{
+		"version":    {"en-US:description": "Hello", "de-DE:description": "Hallo"},
+		"categories": {"primaryCategory": "GAMES"},
+	}
+	target := resourceValues{
+		"version": {"en-US:description": "Hello", "fr/CA~x:description": "Bonjour"},
+		"build":   {"buildNumber": "12"},
+	}
+
+	got := buildJSONPatch(source, target)
+	want := []jsonPatchOperation{
+		{Op: "add", Path: "/build", Value: map[string]any{"buildNumber": "12"}},
+		{Op: "remove", Path: "/categories"},
+		{Op: "remove", Path: "/version/de-DE"},
+		{Op: "add", Path: "/version/fr~1CA~0x", Value: map[string]any{"description": "Bonjour"}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected %#v, got %#v", want, got)
+	}
+}
+
+func TestRenderUnifiedDiffUsesLineDiff(t *testing.T) {
+	plan := buildResourceDiffPlan(
+		"version",
+		resourceDiffEndpoint{Kind: "remote"},
+		resourceDiffEndpoint{Kind: "remote"},
+		nil,
+		resourceValues{"version": {"en-US:whatsNew": "one\ntwo\nthree"}},
+		resourceValues{"version": {"en-US:whatsNew": "one\n2\nthree\nfour"}},
+	)
+
+	want := "--- a/version/en-US/whatsNew\n+++ b/version/en-US/whatsNew\n@@ -1,3 +1,4 @@\n one\n-two\n+2\n three\n+four\n"
+	if got := renderUnifiedDiff(plan); got != want {
+		t.Fatalf("expected:\n%s\ngot:\n%s", want, got)
+	}
+}
+
+func TestBuildResourceDiffRowsEmptyPlan(t *testing.T) {
+	plan := buildResourceDiffPlan("app", resourceDiffEndpoint{}, resourceDiffEndpoint{}, nil, resourceValues{}, resourceValues{})
+	rows := buildResourceDiffRows(plan)
+	if len(rows) != 1 || rows[0][0] != "none" {
+		t.Fatalf("expected single none row, got %v", rows)
+	}
+}
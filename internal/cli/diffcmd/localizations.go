@@ -45,7 +45,7 @@ func DiffLocalizationsCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("localizations", flag.ExitOnError)
 
 	appID := fs.String("app", "", "App Store Connect app ID (required, or ASC_APP_ID env)")
-	path := fs.String("path", "", "Local .strings directory or file (source)")
+	path := fs.String("path", "", "Local .strings, .xcstrings, or XLIFF directory or file (source)")
 	fromVersion := fs.String("from-version", "", "Remote source app store version ID")
 	version := fs.String("version", "", "Remote target app store version ID (when using --path)")
 	toVersion := fs.String("to-version", "", "Remote target app store version ID (when using --from-version)")
@@ -60,9 +60,13 @@ func DiffLocalizationsCommand() *ffcli.Command {
 Modes:
   Local vs remote:
     asc diff localizations --app "APP_ID" --path "./metadata/localizations" --version "VERSION_ID"
+    asc diff localizations --app "APP_ID" --path "./Metadata.xcstrings" --version "VERSION_ID"
 
   Remote vs remote:
-    asc diff localizations --app "APP_ID" --from-version "VERSION_ID_A" --to-version "VERSION_ID_B"`,
+    asc diff localizations --app "APP_ID" --from-version "VERSION_ID_A" --to-version "VERSION_ID_B"
+
+Local files may be .strings, .xcstrings, or XLIFF 1.2/2.0. Catalog values whose
+state is not translated are skipped with a warning.`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -70,7 +74,7 @@ Modes:
 				return shared.UsageError("diff localizations does not accept positional arguments")
 			}
 
-			resolvedAppID := shared.ResolveAppID(*appID)
+			resolvedAppID := shared.ResolveAppID(ctx, *appID)
 			if resolvedAppID == "" {
 				return shared.UsageError("--app is required (or set ASC_APP_ID)")
 			}
@@ -109,7 +113,7 @@ Modes:
 					return shared.UsageError(err.Error())
 				}
 
-				client, err := shared.GetASCClient()
+				client, err := shared.GetASCClient(ctx)
 				if err != nil {
 					return fmt.Errorf("diff localizations: %w", err)
 				}
@@ -140,7 +144,7 @@ Modes:
 					return shared.UsageError("--version cannot be used with --from-version; use --to-version")
 				}
 
-				client, err := shared.GetASCClient()
+				client, err := shared.GetASCClient(ctx)
 				if err != nil {
 					return fmt.Errorf("diff localizations: %w", err)
 				}
@@ -174,7 +178,7 @@ Modes:
 				)
 			}
 
-			return shared.PrintOutputWithRenderers(
+			return shared.PrintOutputWithRenderers(ctx,
 				plan,
 				*output.Output,
 				*output.Pretty,
@@ -0,0 +1,127 @@
+package diffcmd
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/metadata"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+var metadataDiffDefaultIncludes = []string{"localizations"}
+
+// DiffMetadataCommand compares a local metadata directory with App Store
+// Connect.
+func DiffMetadataCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("metadata", flag.ExitOnError)
+
+	appID := fs.String("app", "", "App Store Connect app ID (required, or ASC_APP_ID env)")
+	appInfoID := fs.String("app-info", "", "App Info ID (optional override)")
+	version := fs.String("version", "", "App version string (for example 1.2.3)")
+	platform := fs.String("platform", "", "Optional platform: IOS, MAC_OS, TV_OS, or VISION_OS")
+	dir := fs.String("dir", "", "Metadata root directory written by metadata pull (required)")
+	include := fs.String("include", "", "Compared scopes (comma-separated; default: localizations; all selects every scope)")
+	output := bindResourceDiffOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "metadata",
+		ShortUsage: `asc diff metadata --app "APP_ID" --version "1.2.3" --dir "./metadata" [flags]`,
+		ShortHelp:  "Diff a local metadata directory with App Store Connect.",
+		LongHelp: `Diff a local metadata directory with App Store Connect.
+
+Reads the files metadata pull writes under --dir and compares them with the
+remote version. Only scopes with local files are compared. Adds are fields
+only App Store Connect has; deletes are fields only the local files have, so
+the plan previews what metadata pull would change locally.
+
+Scopes: localizations, categories, age-rating, review-info, content-rights,
+eula, availability, pricing, media, or all.
+
+Output formats: json (default), table, markdown, diff (unified diff per field),
+and json-patch (RFC 6902 operations that turn the local files into remote).
+
+Examples:
+  asc diff metadata --app "APP_ID" --version "1.2.3" --dir "./metadata"
+  asc diff metadata --app "APP_ID" --version "1.2.3" --dir "./metadata" --include all --output diff
+  asc diff metadata --app "APP_ID" --version "1.2.3" --dir "./metadata" --output json-patch`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if len(args) > 0 {
+				return shared.UsageError("diff metadata does not accept positional arguments")
+			}
+
+			resolvedAppID := shared.ResolveAppID(ctx, *appID)
+			if resolvedAppID == "" {
+				return shared.UsageError("--app is required (or set ASC_APP_ID)")
+			}
+			versionValue := strings.TrimSpace(*version)
+			if versionValue == "" {
+				return shared.UsageError("--version is required")
+			}
+			dirValue := strings.TrimSpace(*dir)
+			if dirValue == "" {
+				return shared.UsageError("--dir is required")
+			}
+			platformValue, err := normalizeDiffPlatform(*platform)
+			if err != nil {
+				return shared.UsageError(err.Error())
+			}
+			includes, err := parseDiffIncludes(*include, metadataDiffDefaultIncludes)
+			if err != nil {
+				return shared.UsageError(err.Error())
+			}
+			if containsString(includes, diffIncludeBuild) {
+				return shared.UsageError("--include build is not supported by diff metadata; builds have no local files")
+			}
+
+			client, err := shared.GetASCClient(ctx)
+			if err != nil {
+				return fmt.Errorf("diff metadata: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			snapshot, err := metadata.CollectSnapshot(requestCtx, client, metadata.SnapshotOptions{
+				AppID:     resolvedAppID,
+				AppInfoID: strings.TrimSpace(*appInfoID),
+				Version:   versionValue,
+				Platform:  platformValue,
+				Dir:       dirValue,
+				Includes:  includes,
+				AppInfoExample: func(infoID string) string {
+					command := fmt.Sprintf(`asc diff metadata --app %q --version %q --dir %q`, resolvedAppID, versionValue, dirValue)
+					return buildDiffAppInfoExample(command, platformValue, "--app-info", infoID)
+				},
+			})
+			if err != nil {
+				return resourceDiffError("diff metadata", err)
+			}
+
+			localValues := resourceValues(snapshot.Local)
+			remoteValues := make(resourceValues, len(localValues))
+			for scope := range localValues {
+				fields, ok := snapshot.Remote[scope]
+				if !ok {
+					fields = map[string]string{}
+				}
+				remoteValues[scope] = fields
+			}
+
+			plan := buildResourceDiffPlan(
+				"metadata",
+				resourceDiffEndpoint{Kind: "local", Path: dirValue},
+				resourceDiffEndpoint{Kind: "remote", AppID: resolvedAppID, Version: versionValue, VersionID: snapshot.VersionID},
+				includes,
+				localValues,
+				remoteValues,
+			)
+			return printResourceDiffPlan(ctx, plan, localValues, remoteValues, output)
+		},
+	}
+}
@@ -0,0 +1,135 @@
+package diffcmd
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/metadata"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+var versionDiffDefaultIncludes = []string{"localizations", "media", "review-info", "categories", "age-rating", diffIncludeBuild}
+
+// DiffVersionCommand compares two app store versions of one app.
+func DiffVersionCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("version", flag.ExitOnError)
+
+	appID := fs.String("app", "", "App Store Connect app ID (required, or ASC_APP_ID env)")
+	appInfoID := fs.String("app-info", "", "App Info ID (optional override)")
+	from := fs.String("from", "", "Source app version string (for example 1.2.0)")
+	to := fs.String("to", "", "Target app version string (for example 1.3.0)")
+	platform := fs.String("platform", "", "Optional platform: IOS, MAC_OS, TV_OS, or VISION_OS")
+	include := fs.String("include", "", "Compared scopes (comma-separated; default: "+strings.Join(versionDiffDefaultIncludes, ",")+"; all selects every scope)")
+	output := bindResourceDiffOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "version",
+		ShortUsage: `asc diff version --app "APP_ID" --from "1.2.0" --to "1.3.0" [flags]`,
+		ShortHelp:  "Diff metadata and build between two app versions.",
+		LongHelp: `Diff metadata and build between two app versions.
+
+Compares localizations, screenshots and previews, review info, categories,
+age rating, and the attached build of two versions of the same app. Adds are
+fields only the --to version has; deletes are fields only the --from version
+has.
+
+Scopes: localizations, categories, age-rating, review-info, content-rights,
+eula, availability, pricing, media, build, or all.
+
+Output formats: json (default), table, markdown, diff (unified diff per field),
+and json-patch (RFC 6902 operations that turn --from into --to).
+
+Examples:
+  asc diff version --app "APP_ID" --from "1.2.0" --to "1.3.0"
+  asc diff version --app "APP_ID" --from "1.2.0" --to "1.3.0" --include localizations,build --output diff
+  asc diff version --app "APP_ID" --from "1.2.0" --to "1.3.0" --platform IOS --output json-patch`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if len(args) > 0 {
+				return shared.UsageError("diff version does not accept positional arguments")
+			}
+
+			resolvedAppID := shared.ResolveAppID(ctx, *appID)
+			if resolvedAppID == "" {
+				return shared.UsageError("--app is required (or set ASC_APP_ID)")
+			}
+			fromValue := strings.TrimSpace(*from)
+			if fromValue == "" {
+				return shared.UsageError("--from is required")
+			}
+			toValue := strings.TrimSpace(*to)
+			if toValue == "" {
+				return shared.UsageError("--to is required")
+			}
+			platformValue, err := normalizeDiffPlatform(*platform)
+			if err != nil {
+				return shared.UsageError(err.Error())
+			}
+			includes, err := parseDiffIncludes(*include, versionDiffDefaultIncludes)
+			if err != nil {
+				return shared.UsageError(err.Error())
+			}
+
+			client, err := shared.GetASCClient(ctx)
+			if err != nil {
+				return fmt.Errorf("diff version: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			appInfoExample := func(infoID string) string {
+				return buildDiffAppInfoExample(fmt.Sprintf(`asc diff version --app %q --from %q --to %q`, resolvedAppID, fromValue, toValue), platformValue, "--app-info", infoID)
+			}
+			snapshotOptions := metadata.SnapshotOptions{
+				AppID:          resolvedAppID,
+				AppInfoID:      strings.TrimSpace(*appInfoID),
+				Platform:       platformValue,
+				AppInfoExample: appInfoExample,
+			}
+
+			snapshotOptions.Version = fromValue
+			fromSnapshot, fromValues, err := collectRemoteValues(requestCtx, client, snapshotOptions, includes)
+			if err != nil {
+				return resourceDiffError("diff version", err)
+			}
+			snapshotOptions.Version = toValue
+			toSnapshot, toValues, err := collectRemoteValues(requestCtx, client, snapshotOptions, includes)
+			if err != nil {
+				return resourceDiffError("diff version", err)
+			}
+
+			plan := buildResourceDiffPlan(
+				"version",
+				resourceDiffEndpoint{Kind: "remote", AppID: resolvedAppID, Version: fromValue, VersionID: fromSnapshot.VersionID},
+				resourceDiffEndpoint{Kind: "remote", AppID: resolvedAppID, Version: toValue, VersionID: toSnapshot.VersionID},
+				includes,
+				fromValues,
+				toValues,
+			)
+			return printResourceDiffPlan(ctx, plan, fromValues, toValues, output)
+		},
+	}
+}
+
+func normalizeDiffPlatform(value string) (string, error) {
+	trimmed := strings.TrimSpace(value)
+	if trimmed == "" {
+		return "", nil
+	}
+	return shared.NormalizeAppStoreVersionPlatform(trimmed)
+}
+
+// buildDiffAppInfoExample renders the command suggested when several app
+// infos match and an app info flag is required.
+func buildDiffAppInfoExample(command, platform, appInfoFlag, appInfoID string) string {
+	if platform != "" {
+		command += " --platform " + platform
+	}
+	return fmt.Sprintf("%s %s %q", command, appInfoFlag, appInfoID)
+}
@@ -62,7 +62,7 @@ func NewInitReferenceCommand(flagSetName, commandName, shortUsage, shortHelp, lo
 			if err != nil {
 				return fmt.Errorf("%s: %w", errorPrefix, err)
 			}
-			return shared.PrintOutput(result, "json", false)
+			return shared.PrintOutput(ctx, result, "json", false)
 		},
 	}
 }
@@ -34,7 +34,7 @@ Examples:
 				fmt.Fprintln(os.Stderr, "Error: docs list does not accept positional arguments")
 				return flag.ErrHelp
 			}
-			if err := shared.PrintOutputWithRenderers(
+			if err := shared.PrintOutputWithRenderers(ctx,
 				listGuideSummaries(),
 				*output.Output,
 				*output.Pretty,
@@ -126,7 +126,7 @@ Examples:
 				return fmt.Errorf("encryption declarations list: %w", err)
 			}
 
-			resolvedAppID := shared.ResolveAppID(*appID)
+			resolvedAppID := shared.ResolveAppID(ctx, *appID)
 			if resolvedAppID == "" && strings.TrimSpace(*next) == "" {
 				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
 				return flag.ErrHelp
@@ -134,7 +134,7 @@ Examples:
 
 			buildIDs := shared.SplitCSV(*builds)
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("encryption declarations list: %w", err)
 			}
@@ -159,14 +159,12 @@ Examples:
 					return fmt.Errorf("encryption declarations list: failed to fetch: %w", err)
 				}
 
-				pages, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				if err := shared.PrintPaginatedOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetAppEncryptionDeclarations(ctx, resolvedAppID, asc.WithAppEncryptionDeclarationsNextURL(nextURL))
-				})
-				if err != nil {
+				}, *output.Output, *output.Pretty); err != nil {
 					return fmt.Errorf("encryption declarations list: %w", err)
 				}
-
-				return shared.PrintOutput(pages, *output.Output, *output.Pretty)
+				return nil
 			}
 
 			resp, err := client.GetAppEncryptionDeclarations(requestCtx, resolvedAppID, opts...)
@@ -174,7 +172,7 @@ Examples:
 				return fmt.Errorf("encryption declarations list: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -224,7 +222,7 @@ Examples:
 				return fmt.Errorf("encryption declarations get: %w", err)
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("encryption declarations get: %w", err)
 			}
@@ -243,7 +241,7 @@ Examples:
 				return fmt.Errorf("encryption declarations get: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -270,7 +268,7 @@ Examples:
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
-			resolvedAppID := shared.ResolveAppID(*appID)
+			resolvedAppID := shared.ResolveAppID(ctx, *appID)
 			if resolvedAppID == "" {
 				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
 				return flag.ErrHelp
@@ -299,7 +297,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("encryption declarations create: %w", err)
 			}
@@ -319,7 +317,7 @@ Examples:
 				return fmt.Errorf("encryption declarations create: failed to create: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -568,7 +566,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("encryption declarations assign-builds: %w", err)
 			}
@@ -587,7 +585,7 @@ Examples:
 				Action:        "assigned",
 			}
 
-			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -644,7 +642,7 @@ Examples:
 				return fmt.Errorf("encryption documents get: %w", err)
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("encryption documents get: %w", err)
 			}
@@ -657,7 +655,7 @@ Examples:
 				return fmt.Errorf("encryption documents get: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -707,7 +705,7 @@ Examples:
 				return fmt.Errorf("encryption documents upload: file size must be greater than 0")
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("encryption documents upload: %w", err)
 			}
@@ -748,7 +746,7 @@ Examples:
 				return fmt.Errorf("encryption documents upload: failed to commit upload: %w", err)
 			}
 
-			return shared.PrintOutput(commitResp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, commitResp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -95,13 +95,13 @@ func runListCommand(ctx context.Context, config shared.ListCommandConfig, flags
 		return fmt.Errorf("%s: %w", prefix, err)
 	}
 
-	resolvedAppID := shared.ResolveAppID(*flags.appID)
+	resolvedAppID := shared.ResolveAppID(ctx, *flags.appID)
 	if resolvedAppID == "" && strings.TrimSpace(*flags.next) == "" {
 		fmt.Fprintf(os.Stderr, "Error: --app is required (or set ASC_APP_ID)\n\n")
 		return flag.ErrHelp
 	}
 
-	client, err := shared.GetASCClient()
+	client, err := shared.GetASCClient(ctx)
 	if err != nil {
 		return fmt.Errorf("%s: %w", prefix, err)
 	}
@@ -134,14 +134,12 @@ func runListCommand(ctx context.Context, config shared.ListCommandConfig, flags
 			return fmt.Errorf("%s: failed to fetch: %w", prefix, err)
 		}
 
-		feedback, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		if err := shared.PrintPaginatedOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 			return client.GetFeedback(ctx, resolvedAppID, asc.WithFeedbackNextURL(nextURL))
-		})
-		if err != nil {
+		}, *flags.output.Output, *flags.output.Pretty); err != nil {
 			return fmt.Errorf("%s: %w", prefix, err)
 		}
-
-		return shared.PrintOutput(feedback, *flags.output.Output, *flags.output.Pretty)
+		return nil
 	}
 
 	feedback, err := client.GetFeedback(requestCtx, resolvedAppID, opts...)
@@ -149,7 +147,7 @@ func runListCommand(ctx context.Context, config shared.ListCommandConfig, flags
 		return fmt.Errorf("%s: failed to fetch: %w", prefix, err)
 	}
 
-	return shared.PrintOutput(feedback, *flags.output.Output, *flags.output.Pretty)
+	return shared.PrintOutput(ctx, feedback, *flags.output.Output, *flags.output.Pretty)
 }
 
 // Feedback command factory
@@ -106,7 +106,7 @@ Examples:
 				return fmt.Errorf("game-center matchmaking queues list: %w", err)
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("game-center matchmaking queues list: %w", err)
 			}
@@ -126,14 +126,12 @@ Examples:
 					return fmt.Errorf("game-center matchmaking queues list: failed to fetch: %w", err)
 				}
 
-				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				if err := shared.PrintPaginatedOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetGameCenterMatchmakingQueues(ctx, asc.WithGCMatchmakingQueuesNextURL(nextURL))
-				})
-				if err != nil {
+				}, *output.Output, *output.Pretty); err != nil {
 					return fmt.Errorf("game-center matchmaking queues list: %w", err)
 				}
-
-				return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+				return nil
 			}
 
 			resp, err := client.GetGameCenterMatchmakingQueues(requestCtx, opts...)
@@ -141,7 +139,7 @@ Examples:
 				return fmt.Errorf("game-center matchmaking queues list: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -170,7 +168,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("game-center matchmaking queues get: %w", err)
 			}
@@ -183,7 +181,7 @@ Examples:
 				return fmt.Errorf("game-center matchmaking queues get: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -226,7 +224,7 @@ Examples:
 				ClassicMatchmakingBundleIDs: shared.SplitCSV(*classicBundleIDs),
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("game-center matchmaking queues create: %w", err)
 			}
@@ -239,7 +237,7 @@ Examples:
 				return fmt.Errorf("game-center matchmaking queues create: failed to create: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -284,7 +282,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("game-center matchmaking queues update: %w", err)
 			}
@@ -297,7 +295,7 @@ Examples:
 				return fmt.Errorf("game-center matchmaking queues update: failed to update: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -331,7 +329,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("game-center matchmaking queues delete: %w", err)
 			}
@@ -348,7 +346,7 @@ Examples:
 				Deleted: true,
 			}
 
-			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -415,7 +413,7 @@ Examples:
 				return fmt.Errorf("game-center matchmaking rule-sets list: %w", err)
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("game-center matchmaking rule-sets list: %w", err)
 			}
@@ -435,14 +433,12 @@ Examples:
 					return fmt.Errorf("game-center matchmaking rule-sets list: failed to fetch: %w", err)
 				}
 
-				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				if err := shared.PrintPaginatedOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetGameCenterMatchmakingRuleSets(ctx, asc.WithGCMatchmakingRuleSetsNextURL(nextURL))
-				})
-				if err != nil {
+				}, *output.Output, *output.Pretty); err != nil {
 					return fmt.Errorf("game-center matchmaking rule-sets list: %w", err)
 				}
-
-				return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+				return nil
 			}
 
 			resp, err := client.GetGameCenterMatchmakingRuleSets(requestCtx, opts...)
@@ -450,7 +446,7 @@ Examples:
 				return fmt.Errorf("game-center matchmaking rule-sets list: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -479,7 +475,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("game-center matchmaking rule-sets get: %w", err)
 			}
@@ -492,7 +488,7 @@ Examples:
 				return fmt.Errorf("game-center matchmaking rule-sets get: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -539,7 +535,7 @@ Examples:
 				MaxPlayers:          *maxPlayers,
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("game-center matchmaking rule-sets create: %w", err)
 			}
@@ -552,7 +548,7 @@ Examples:
 				return fmt.Errorf("game-center matchmaking rule-sets create: failed to create: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -603,7 +599,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("game-center matchmaking rule-sets update: %w", err)
 			}
@@ -616,7 +612,7 @@ Examples:
 				return fmt.Errorf("game-center matchmaking rule-sets update: failed to update: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -650,7 +646,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("game-center matchmaking rule-sets delete: %w", err)
 			}
@@ -667,7 +663,7 @@ Examples:
 				Deleted: true,
 			}
 
-			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -731,7 +727,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("game-center matchmaking rule-sets queues list: %w", err)
 			}
@@ -751,14 +747,12 @@ Examples:
 					return fmt.Errorf("game-center matchmaking rule-sets queues list: failed to fetch: %w", err)
 				}
 
-				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				if err := shared.PrintPaginatedOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetGameCenterMatchmakingRuleSetQueues(ctx, id, asc.WithGCMatchmakingQueuesNextURL(nextURL))
-				})
-				if err != nil {
+				}, *output.Output, *output.Pretty); err != nil {
 					return fmt.Errorf("game-center matchmaking rule-sets queues list: %w", err)
 				}
-
-				return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+				return nil
 			}
 
 			resp, err := client.GetGameCenterMatchmakingRuleSetQueues(requestCtx, id, opts...)
@@ -766,7 +760,7 @@ Examples:
 				return fmt.Errorf("game-center matchmaking rule-sets queues list: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -836,7 +830,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("game-center matchmaking rules list: %w", err)
 			}
@@ -856,14 +850,12 @@ Examples:
 					return fmt.Errorf("game-center matchmaking rules list: failed to fetch: %w", err)
 				}
 
-				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				if err := shared.PrintPaginatedOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetGameCenterMatchmakingRules(ctx, id, asc.WithGCMatchmakingRulesNextURL(nextURL))
-				})
-				if err != nil {
+				}, *output.Output, *output.Pretty); err != nil {
 					return fmt.Errorf("game-center matchmaking rules list: %w", err)
 				}
-
-				return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+				return nil
 			}
 
 			resp, err := client.GetGameCenterMatchmakingRules(requestCtx, id, opts...)
@@ -871,7 +863,7 @@ Examples:
 				return fmt.Errorf("game-center matchmaking rules list: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -941,7 +933,7 @@ Examples:
 				attrs.Weight = &val
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("game-center matchmaking rules create: %w", err)
 			}
@@ -954,7 +946,7 @@ Examples:
 				return fmt.Errorf("game-center matchmaking rules create: failed to create: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -1015,7 +1007,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("game-center matchmaking rules update: %w", err)
 			}
@@ -1028,7 +1020,7 @@ Examples:
 				return fmt.Errorf("game-center matchmaking rules update: failed to update: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -1062,7 +1054,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("game-center matchmaking rules delete: %w", err)
 			}
@@ -1079,7 +1071,7 @@ Examples:
 				Deleted: true,
 			}
 
-			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -1149,7 +1141,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("game-center matchmaking teams list: %w", err)
 			}
@@ -1169,14 +1161,12 @@ Examples:
 					return fmt.Errorf("game-center matchmaking teams list: failed to fetch: %w", err)
 				}
 
-				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				if err := shared.PrintPaginatedOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetGameCenterMatchmakingTeams(ctx, id, asc.WithGCMatchmakingTeamsNextURL(nextURL))
-				})
-				if err != nil {
+				}, *output.Output, *output.Pretty); err != nil {
 					return fmt.Errorf("game-center matchmaking teams list: %w", err)
 				}
-
-				return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+				return nil
 			}
 
 			resp, err := client.GetGameCenterMatchmakingTeams(requestCtx, id, opts...)
@@ -1184,7 +1174,7 @@ Examples:
 				return fmt.Errorf("game-center matchmaking teams list: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -1231,7 +1221,7 @@ Examples:
 				MaxPlayers:    *maxPlayers,
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("game-center matchmaking teams create: %w", err)
 			}
@@ -1244,7 +1234,7 @@ Examples:
 				return fmt.Errorf("game-center matchmaking teams create: failed to create: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -1293,7 +1283,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("game-center matchmaking teams update: %w", err)
 			}
@@ -1306,7 +1296,7 @@ Examples:
 				return fmt.Errorf("game-center matchmaking teams update: failed to update: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -1340,7 +1330,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("game-center matchmaking teams delete: %w", err)
 			}
@@ -1357,7 +1347,7 @@ Examples:
 				Deleted: true,
 			}
 
-			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -1407,7 +1397,7 @@ func GameCenterMatchmakingQueueSizesCommand() *ffcli.Command {
 	output := shared.BindOutputFlags(fs)
 
 	return metricsQueueCommand("queue-sizes", fs, queueID, granularity, sort, limit, next, paginate, output.Output, output.Pretty, func(ctx context.Context, id string, opts ...asc.GCMatchmakingMetricsOption) (*asc.GameCenterMatchmakingQueueSizesResponse, error) {
-		return ascClient().GetGameCenterMatchmakingQueueSizes(ctx, id, opts...)
+		return ascClient(ctx).GetGameCenterMatchmakingQueueSizes(ctx, id, opts...)
 	})
 }
 
@@ -1427,7 +1417,7 @@ func GameCenterMatchmakingQueueRequestsCommand() *ffcli.Command {
 	output := shared.BindOutputFlags(fs)
 
 	return metricsQueueCommandWithFilters("queue-requests", fs, queueID, granularity, groupBy, filterResult, filterDetail, sort, limit, next, paginate, output.Output, output.Pretty, func(ctx context.Context, id string, opts ...asc.GCMatchmakingMetricsOption) (*asc.GameCenterMatchmakingQueueRequestsResponse, error) {
-		return ascClient().GetGameCenterMatchmakingQueueRequests(ctx, id, opts...)
+		return ascClient(ctx).GetGameCenterMatchmakingQueueRequests(ctx, id, opts...)
 	})
 }
 
@@ -1444,7 +1434,7 @@ func GameCenterMatchmakingQueueSessionsCommand() *ffcli.Command {
 	output := shared.BindOutputFlags(fs)
 
 	return metricsQueueCommand("queue-sessions", fs, queueID, granularity, sort, limit, next, paginate, output.Output, output.Pretty, func(ctx context.Context, id string, opts ...asc.GCMatchmakingMetricsOption) (*asc.GameCenterMatchmakingQueueSessionsResponse, error) {
-		return ascClient().GetGameCenterMatchmakingQueueSessions(ctx, id, opts...)
+		return ascClient(ctx).GetGameCenterMatchmakingQueueSessions(ctx, id, opts...)
 	})
 }
 
@@ -1461,7 +1451,7 @@ func GameCenterMatchmakingQueueExperimentSizesCommand() *ffcli.Command {
 	output := shared.BindOutputFlags(fs)
 
 	return metricsQueueCommand("experiment-queue-sizes", fs, queueID, granularity, sort, limit, next, paginate, output.Output, output.Pretty, func(ctx context.Context, id string, opts ...asc.GCMatchmakingMetricsOption) (*asc.GameCenterMatchmakingQueueExperimentSizesResponse, error) {
-		return ascClient().GetGameCenterMatchmakingQueueExperimentSizes(ctx, id, opts...)
+		return ascClient(ctx).GetGameCenterMatchmakingQueueExperimentSizes(ctx, id, opts...)
 	})
 }
 
@@ -1481,7 +1471,7 @@ func GameCenterMatchmakingQueueExperimentRequestsCommand() *ffcli.Command {
 	output := shared.BindOutputFlags(fs)
 
 	return metricsQueueCommandWithFilters("experiment-queue-requests", fs, queueID, granularity, groupBy, filterResult, filterDetail, sort, limit, next, paginate, output.Output, output.Pretty, func(ctx context.Context, id string, opts ...asc.GCMatchmakingMetricsOption) (*asc.GameCenterMatchmakingQueueExperimentRequestsResponse, error) {
-		return ascClient().GetGameCenterMatchmakingQueueExperimentRequests(ctx, id, opts...)
+		return ascClient(ctx).GetGameCenterMatchmakingQueueExperimentRequests(ctx, id, opts...)
 	})
 }
 
@@ -1501,7 +1491,7 @@ func GameCenterMatchmakingBooleanRuleResultsCommand() *ffcli.Command {
 	output := shared.BindOutputFlags(fs)
 
 	return metricsRuleCommand("rule-boolean-results", fs, ruleID, granularity, groupBy, filterResult, filterQueue, sort, limit, next, paginate, output.Output, output.Pretty, func(ctx context.Context, id string, opts ...asc.GCMatchmakingMetricsOption) (*asc.GameCenterMatchmakingBooleanRuleResultsResponse, error) {
-		return ascClient().GetGameCenterMatchmakingBooleanRuleResults(ctx, id, opts...)
+		return ascClient(ctx).GetGameCenterMatchmakingBooleanRuleResults(ctx, id, opts...)
 	})
 }
 
@@ -1521,7 +1511,7 @@ func GameCenterMatchmakingNumberRuleResultsCommand() *ffcli.Command {
 	output := shared.BindOutputFlags(fs)
 
 	return metricsRuleCommand("rule-number-results", fs, ruleID, granularity, groupBy, filterResult, filterQueue, sort, limit, next, paginate, output.Output, output.Pretty, func(ctx context.Context, id string, opts ...asc.GCMatchmakingMetricsOption) (*asc.GameCenterMatchmakingNumberRuleResultsResponse, error) {
-		return ascClient().GetGameCenterMatchmakingNumberRuleResults(ctx, id, opts...)
+		return ascClient(ctx).GetGameCenterMatchmakingNumberRuleResults(ctx, id, opts...)
 	})
 }
 
@@ -1541,7 +1531,7 @@ func GameCenterMatchmakingRuleErrorsCommand() *ffcli.Command {
 	output := shared.BindOutputFlags(fs)
 
 	return metricsRuleCommand("rule-errors", fs, ruleID, granularity, groupBy, filterResult, filterQueue, sort, limit, next, paginate, output.Output, output.Pretty, func(ctx context.Context, id string, opts ...asc.GCMatchmakingMetricsOption) (*asc.GameCenterMatchmakingRuleErrorsResponse, error) {
-		return ascClient().GetGameCenterMatchmakingRuleErrors(ctx, id, opts...)
+		return ascClient(ctx).GetGameCenterMatchmakingRuleErrors(ctx, id, opts...)
 	})
 }
 
@@ -1648,17 +1638,15 @@ func runMetricsQueue(ctx context.Context, name string, queueID *string, granular
 			return fmt.Errorf("game-center matchmaking metrics %s: failed to fetch: %w", name, err)
 		}
 
-		resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		if err := shared.PrintPaginatedOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 			if fetchRequests != nil {
 				return fetchRequests(ctx, id, asc.WithGCMatchmakingMetricsNextURL(nextURL))
 			}
 			return fetchSizes(ctx, id, asc.WithGCMatchmakingMetricsNextURL(nextURL))
-		})
-		if err != nil {
+		}, *output, *pretty); err != nil {
 			return fmt.Errorf("game-center matchmaking metrics %s: %w", name, err)
 		}
-
-		return shared.PrintOutput(resp, *output, *pretty)
+		return nil
 	}
 
 	var resp any
@@ -1671,7 +1659,7 @@ func runMetricsQueue(ctx context.Context, name string, queueID *string, granular
 		return fmt.Errorf("game-center matchmaking metrics %s: failed to fetch: %w", name, err)
 	}
 
-	return shared.PrintOutput(resp, *output, *pretty)
+	return shared.PrintOutput(ctx, resp, *output, *pretty)
 }
 
 func runMetricsRule(ctx context.Context, name string, ruleID *string, granularity *string, groupBy *string, filterResult *string, filterQueue *string, sort *string, limit *int, next *string, paginate *bool, output *string, pretty *bool, fetch func(ctx context.Context, id string, opts ...asc.GCMatchmakingMetricsOption) (*asc.GameCenterMatchmakingBooleanRuleResultsResponse, error)) error {
@@ -1713,14 +1701,12 @@ func runMetricsRule(ctx context.Context, name string, ruleID *string, granularit
 			return fmt.Errorf("game-center matchmaking metrics %s: failed to fetch: %w", name, err)
 		}
 
-		resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		if err := shared.PrintPaginatedOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 			return fetch(ctx, id, asc.WithGCMatchmakingMetricsNextURL(nextURL))
-		})
-		if err != nil {
+		}, *output, *pretty); err != nil {
 			return fmt.Errorf("game-center matchmaking metrics %s: %w", name, err)
 		}
-
-		return shared.PrintOutput(resp, *output, *pretty)
+		return nil
 	}
 
 	resp, err := fetch(requestCtx, id, opts...)
@@ -1728,7 +1714,7 @@ func runMetricsRule(ctx context.Context, name string, ruleID *string, granularit
 		return fmt.Errorf("game-center matchmaking metrics %s: failed to fetch: %w", name, err)
 	}
 
-	return shared.PrintOutput(resp, *output, *pretty)
+	return shared.PrintOutput(ctx, resp, *output, *pretty)
 }
 
 // GameCenterMatchmakingRuleSetTestsCommand returns the rule set tests command group.
@@ -1783,7 +1769,7 @@ Examples:
 				return fmt.Errorf("game-center matchmaking rule-set-tests create: %w", err)
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("game-center matchmaking rule-set-tests create: %w", err)
 			}
@@ -1796,12 +1782,12 @@ Examples:
 				return fmt.Errorf("game-center matchmaking rule-set-tests create: failed to create: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
 
-func ascClient() *asc.Client {
-	client, _ := shared.GetASCClient()
+func ascClient(ctx context.Context) *asc.Client {
+	client, _ := shared.GetASCClient(ctx)
 	return client
 }
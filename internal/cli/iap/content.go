@@ -65,7 +65,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("iap content get: %w", err)
 			}
@@ -79,7 +79,7 @@ Examples:
 					return fmt.Errorf("iap content get: failed to fetch: %w", err)
 				}
 
-				return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+				return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 			}
 
 			iapValue, err = resolveIAPLookupIDWithTimeout(ctx, client, *appID, iapValue)
@@ -95,7 +95,7 @@ Examples:
 				return fmt.Errorf("iap content get: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
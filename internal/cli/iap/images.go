@@ -80,7 +80,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("iap images list: %w", err)
 			}
@@ -107,14 +107,12 @@ Examples:
 					return fmt.Errorf("iap images list: failed to fetch: %w", err)
 				}
 
-				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				if err := shared.PrintPaginatedOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetInAppPurchaseImages(ctx, iapValue, asc.WithIAPImagesNextURL(nextURL))
-				})
-				if err != nil {
+				}, *output.Output, *output.Pretty); err != nil {
 					return fmt.Errorf("iap images list: %w", err)
 				}
-
-				return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+				return nil
 			}
 
 			resp, err := client.GetInAppPurchaseImages(requestCtx, iapValue, opts...)
@@ -122,7 +120,7 @@ Examples:
 				return fmt.Errorf("iap images list: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -151,7 +149,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("iap images get: %w", err)
 			}
@@ -164,7 +162,7 @@ Examples:
 				return fmt.Errorf("iap images get: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -211,7 +209,7 @@ Examples:
 				return fmt.Errorf("iap images create: %w", err)
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("iap images create: %w", err)
 			}
@@ -249,7 +247,7 @@ Examples:
 				return fmt.Errorf("iap images create: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(finalResp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, finalResp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -295,7 +293,7 @@ Examples:
 				return fmt.Errorf("iap images update: %w", err)
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("iap images update: %w", err)
 			}
@@ -352,7 +350,7 @@ Examples:
 				}
 			}
 
-			return shared.PrintOutput(updated, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, updated, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -386,7 +384,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("iap images delete: %w", err)
 			}
@@ -403,7 +401,7 @@ Examples:
 				Deleted: true,
 			}
 
-			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -15,7 +15,7 @@ func addIAPLookupAppFlag(fs *flag.FlagSet) *string {
 }
 
 func resolveIAPLookupID(ctx context.Context, client *asc.Client, appValue, selector string) (string, error) {
-	resolvedAppID := shared.ResolveAppID(appValue)
+	resolvedAppID := shared.ResolveAppID(ctx, appValue)
 	if err := shared.RequireAppForStableSelector(resolvedAppID, selector, "--iap-id"); err != nil {
 		return "", err
 	}
@@ -93,7 +93,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("iap price-points list: %w", err)
 			}
@@ -128,21 +128,29 @@ Examples:
 					return fmt.Errorf("iap price-points list: failed to fetch: %w", err)
 				}
 
-				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
-					return client.GetInAppPurchasePricePoints(ctx, iapValue, asc.WithIAPPricePointsNextURL(nextURL))
-				})
-				if err != nil {
-					return fmt.Errorf("iap price-points list: %w", err)
-				}
-
 				if priceFilter.HasFilter() {
-					if typed, ok := resp.(*asc.InAppPurchasePricePointsResponse); ok {
-						filterIAPPricePoints(typed, priceFilter)
-						return shared.PrintOutput(typed, *output.Output, *output.Pretty)
-					}
+					filterIAPPricePoints(firstPage, priceFilter)
 				}
 
-				return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+				if err := shared.PrintPaginatedOutput(
+					requestCtx,
+					firstPage,
+					func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+						page, err := client.GetInAppPurchasePricePoints(ctx, iapValue, asc.WithIAPPricePointsNextURL(nextURL))
+						if err != nil {
+							return nil, err
+						}
+						if priceFilter.HasFilter() {
+							filterIAPPricePoints(page, priceFilter)
+						}
+						return page, nil
+					},
+					*output.Output,
+					*output.Pretty,
+				); err != nil {
+					return fmt.Errorf("iap price-points list: %w", err)
+				}
+				return nil
 			}
 
 			resp, err := client.GetInAppPurchasePricePoints(requestCtx, iapValue, opts...)
@@ -154,7 +162,7 @@ Examples:
 				filterIAPPricePoints(resp, priceFilter)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -96,7 +96,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("iap pricing schedules get: %w", err)
 			}
@@ -130,7 +130,7 @@ Examples:
 					return fmt.Errorf("iap pricing schedules get: failed to fetch: %w", err)
 				}
 
-				return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+				return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 			}
 
 			iapValue, err = resolveIAPLookupIDWithTimeout(ctx, client, *appID, iapValue)
@@ -146,7 +146,7 @@ Examples:
 				return fmt.Errorf("iap pricing schedules get: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -202,7 +202,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("iap pricing schedules base-territory: %w", err)
 			}
@@ -215,7 +215,7 @@ Examples:
 				return fmt.Errorf("iap pricing schedules base-territory: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -301,7 +301,7 @@ Examples:
 				resolvedStartDate = normalizedStartDate
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("iap pricing schedules create: %w", err)
 			}
@@ -354,7 +354,7 @@ Examples:
 				return fmt.Errorf("iap pricing schedules create: failed to create: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -400,7 +400,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("iap pricing schedules manual-prices: %w", err)
 			}
@@ -413,7 +413,7 @@ Examples:
 				if err != nil {
 					return fmt.Errorf("iap pricing schedules manual-prices: failed to resolve: %w", err)
 				}
-				return shared.PrintResolvedPrices(resp, *output.Output, *output.Pretty)
+				return shared.PrintResolvedPrices(ctx, resp, *output.Output, *output.Pretty)
 			}
 
 			opts := []asc.IAPPriceSchedulePricesOption{
@@ -428,14 +428,12 @@ Examples:
 					return fmt.Errorf("iap pricing schedules manual-prices: failed to fetch: %w", err)
 				}
 
-				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				if err := shared.PrintPaginatedOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetInAppPurchasePriceScheduleManualPrices(ctx, id, asc.WithIAPPriceSchedulePricesNextURL(nextURL))
-				})
-				if err != nil {
+				}, *output.Output, *output.Pretty); err != nil {
 					return fmt.Errorf("iap pricing schedules manual-prices: %w", err)
 				}
-
-				return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+				return nil
 			}
 
 			resp, err := client.GetInAppPurchasePriceScheduleManualPrices(requestCtx, id, opts...)
@@ -443,7 +441,7 @@ Examples:
 				return fmt.Errorf("iap pricing schedules manual-prices: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -489,7 +487,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("iap pricing schedules automatic-prices: %w", err)
 			}
@@ -502,7 +500,7 @@ Examples:
 				if err != nil {
 					return fmt.Errorf("iap pricing schedules automatic-prices: failed to resolve: %w", err)
 				}
-				return shared.PrintResolvedPrices(resp, *output.Output, *output.Pretty)
+				return shared.PrintResolvedPrices(ctx, resp, *output.Output, *output.Pretty)
 			}
 
 			opts := []asc.IAPPriceSchedulePricesOption{
@@ -517,14 +515,12 @@ Examples:
 					return fmt.Errorf("iap pricing schedules automatic-prices: failed to fetch: %w", err)
 				}
 
-				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				if err := shared.PrintPaginatedOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetInAppPurchasePriceScheduleAutomaticPrices(ctx, id, asc.WithIAPPriceSchedulePricesNextURL(nextURL))
-				})
-				if err != nil {
+				}, *output.Output, *output.Pretty); err != nil {
 					return fmt.Errorf("iap pricing schedules automatic-prices: %w", err)
 				}
-
-				return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+				return nil
 			}
 
 			resp, err := client.GetInAppPurchasePriceScheduleAutomaticPrices(requestCtx, id, opts...)
@@ -532,7 +528,7 @@ Examples:
 				return fmt.Errorf("iap pricing schedules automatic-prices: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
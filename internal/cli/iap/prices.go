@@ -92,13 +92,13 @@ Examples:
 		Exec: func(ctx context.Context, args []string) error {
 			requestedIAPID := strings.TrimSpace(*iapID)
 			requestedAppID := strings.TrimSpace(*appID)
-			resolvedAppID := shared.ResolveAppID(requestedAppID)
+			resolvedAppID := shared.ResolveAppID(ctx, requestedAppID)
 			if requestedIAPID == "" && resolvedAppID == "" {
 				fmt.Fprintln(os.Stderr, "Error: --app or --iap-id is required")
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("iap prices: %w", err)
 			}
@@ -158,7 +158,7 @@ Examples:
 				return fmt.Errorf("iap prices: %w", err)
 			}
 
-			return printIAPPricesResult(&iapPricesResult{IAPs: summaries}, *output.Output, *output.Pretty)
+			return printIAPPricesResult(ctx, &iapPricesResult{IAPs: summaries}, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -972,8 +972,8 @@ func dedupePriceEntries(entries []iapPriceEntry) []iapPriceEntry {
 	return unique
 }
 
-func printIAPPricesResult(result *iapPricesResult, format string, pretty bool) error {
-	return shared.PrintOutputWithRenderers(
+func printIAPPricesResult(ctx context.Context, result *iapPricesResult, format string, pretty bool) error {
+	return shared.PrintOutputWithRenderers(ctx,
 		result,
 		format,
 		pretty,
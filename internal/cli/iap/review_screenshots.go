@@ -73,7 +73,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("iap review-screenshots get: %w", err)
 			}
@@ -86,7 +86,7 @@ Examples:
 				if err != nil {
 					return fmt.Errorf("iap review-screenshots get: failed to fetch: %w", err)
 				}
-				return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+				return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 			}
 
 			iapValue, err = resolveIAPLookupIDWithTimeout(ctx, client, *appID, iapValue)
@@ -102,7 +102,7 @@ Examples:
 				return fmt.Errorf("iap review-screenshots get: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -149,7 +149,7 @@ Examples:
 				return fmt.Errorf("iap review-screenshots create: %w", err)
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("iap review-screenshots create: %w", err)
 			}
@@ -191,7 +191,7 @@ Examples:
 				return fmt.Errorf("iap review-screenshots create: %w", verifyErr)
 			}
 
-			return shared.PrintOutput(finalResp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, finalResp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -237,7 +237,7 @@ Examples:
 				return fmt.Errorf("iap review-screenshots update: %w", err)
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("iap review-screenshots update: %w", err)
 			}
@@ -294,7 +294,7 @@ Examples:
 				}
 			}
 
-			return shared.PrintOutput(updated, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, updated, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -328,7 +328,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("iap review-screenshots delete: %w", err)
 			}
@@ -345,7 +345,7 @@ Examples:
 				Deleted: true,
 			}
 
-			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -158,7 +158,7 @@ Examples:
 			}
 
 			opts := iapSetupOptions{
-				AppID:            shared.ResolveAppID(*appID),
+				AppID:            shared.ResolveAppID(ctx, *appID),
 				ReferenceName:    referenceNameValue,
 				ProductID:        strings.TrimSpace(*productID),
 				FamilySharable:   *familySharable,
@@ -239,7 +239,7 @@ Examples:
 			}
 
 			result, runErr := executeIAPSetup(ctx, opts)
-			if printErr := printIAPSetupResult(&result, *output.Output, *output.Pretty); printErr != nil {
+			if printErr := printIAPSetupResult(ctx, &result, *output.Output, *output.Pretty); printErr != nil {
 				return printErr
 			}
 			if runErr != nil {
@@ -262,7 +262,7 @@ func executeIAPSetup(ctx context.Context, opts iapSetupOptions) (iapSetupResult,
 		Steps:         make([]iapSetupStepResult, 0, 4),
 	}
 
-	client, err := shared.GetASCClient()
+	client, err := shared.GetASCClient(ctx)
 	if err != nil {
 		result.Status = "error"
 		result.Error = err.Error()
@@ -728,8 +728,8 @@ func resolveExpectedIAPSetupVerificationPrice(ctx context.Context, client *asc.C
 	return "", fmt.Errorf("price point %q not found in %s during verification", opts.PricePointID, opts.BaseTerritory)
 }
 
-func printIAPSetupResult(result *iapSetupResult, format string, pretty bool) error {
-	return shared.PrintOutputWithRenderers(
+func printIAPSetupResult(ctx context.Context, result *iapSetupResult, format string, pretty bool) error {
+	return shared.PrintOutputWithRenderers(ctx,
 		result,
 		format,
 		pretty,
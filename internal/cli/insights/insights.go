@@ -82,7 +82,7 @@ Examples:
 				return shared.UsageErrorf("unexpected argument(s): %s", strings.Join(args, " "))
 			}
 
-			resolvedAppID := shared.ResolveAppID(*appID)
+			resolvedAppID := shared.ResolveAppID(ctx, *appID)
 			if resolvedAppID == "" {
 				return shared.UsageError("--app is required (or set ASC_APP_ID)")
 			}
@@ -108,7 +108,7 @@ Examples:
 				}
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("insights weekly: %w", err)
 			}
@@ -121,7 +121,7 @@ Examples:
 				return fmt.Errorf("insights weekly: %w", err)
 			}
 
-			return shared.PrintOutputWithRenderers(
+			return shared.PrintOutputWithRenderers(ctx,
 				resp,
 				*output.Output,
 				*output.Pretty,
@@ -159,7 +159,7 @@ Examples:
 				return shared.UsageErrorf("unexpected argument(s): %s", strings.Join(args, " "))
 			}
 
-			resolvedAppID := shared.ResolveAppID(*appID)
+			resolvedAppID := shared.ResolveAppID(ctx, *appID)
 			if resolvedAppID == "" {
 				return shared.UsageError("--app is required (or set ASC_APP_ID)")
 			}
@@ -174,7 +174,7 @@ Examples:
 				return shared.UsageError(err.Error())
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("insights daily: %w", err)
 			}
@@ -187,7 +187,7 @@ Examples:
 				return fmt.Errorf("insights daily: %w", err)
 			}
 
-			return shared.PrintOutputWithRenderers(
+			return shared.PrintOutputWithRenderers(ctx,
 				resp,
 				*output.Output,
 				*output.Pretty,
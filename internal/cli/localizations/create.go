@@ -86,7 +86,7 @@ Examples:
 				return shared.UsageError(err.Error())
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("localizations create: %w", err)
 			}
@@ -112,7 +112,7 @@ Examples:
 				warnings = append(warnings, warning)
 			}
 
-			if err := shared.PrintOutput(resp, *output.Output, *output.Pretty); err != nil {
+			if err := shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty); err != nil {
 				return err
 			}
 			if err := shared.PrintSubmitReadinessCreateWarnings(os.Stderr, warnings); err != nil {
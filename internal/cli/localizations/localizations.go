@@ -101,7 +101,7 @@ Examples:
 					return flag.ErrHelp
 				}
 
-				client, err := shared.GetASCClient()
+				client, err := shared.GetASCClient(ctx)
 				if err != nil {
 					return fmt.Errorf("localizations list: %w", err)
 				}
@@ -126,28 +126,27 @@ Examples:
 					}
 
 					// Fetch all remaining pages
-					resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+					if err := shared.PrintPaginatedOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 						return client.GetAppStoreVersionLocalizations(ctx, strings.TrimSpace(*versionID), asc.WithAppStoreVersionLocalizationsNextURL(nextURL))
-					})
-					if err != nil {
+					}, *output.Output, *output.Pretty); err != nil {
 						return fmt.Errorf("localizations list: %w", err)
 					}
-					return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+					return nil
 				}
 
 				resp, err := client.GetAppStoreVersionLocalizations(requestCtx, strings.TrimSpace(*versionID), opts...)
 				if err != nil {
 					return fmt.Errorf("localizations list: failed to fetch: %w", err)
 				}
-				return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+				return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 			case shared.LocalizationTypeAppInfo:
-				resolvedAppID := shared.ResolveAppID(*appID)
+				resolvedAppID := shared.ResolveAppID(ctx, *appID)
 				if resolvedAppID == "" {
 					fmt.Fprintln(os.Stderr, "Error: --app is required for app-info localizations")
 					return flag.ErrHelp
 				}
 
-				client, err := shared.GetASCClient()
+				client, err := shared.GetASCClient(ctx)
 				if err != nil {
 					return fmt.Errorf("localizations list: %w", err)
 				}
@@ -177,20 +176,19 @@ Examples:
 					}
 
 					// Fetch all remaining pages
-					resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+					if err := shared.PrintPaginatedOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 						return client.GetAppInfoLocalizations(ctx, appInfo, asc.WithAppInfoLocalizationsNextURL(nextURL))
-					})
-					if err != nil {
+					}, *output.Output, *output.Pretty); err != nil {
 						return fmt.Errorf("localizations list: %w", err)
 					}
-					return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+					return nil
 				}
 
 				resp, err := client.GetAppInfoLocalizations(requestCtx, appInfo, opts...)
 				if err != nil {
 					return fmt.Errorf("localizations list: failed to fetch: %w", err)
 				}
-				return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+				return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 			default:
 				return fmt.Errorf("localizations list: unsupported type %q", normalizedType)
 			}
@@ -207,7 +205,9 @@ func LocalizationsDownloadCommand() *ffcli.Command {
 	appInfoID := fs.String("app-info", "", "App Info ID (optional override)")
 	locType := fs.String("type", shared.LocalizationTypeVersion, "Localization type: version (default) or app-info")
 	locale := fs.String("locale", "", "Filter by locale(s), comma-separated")
-	path := fs.String("path", "localizations", "Output path (directory or .strings file)")
+	path := fs.String("path", "localizations", "Output path (directory, or .strings, .xcstrings, or .xliff file)")
+	format := fs.String("format", "", "Output format: strings, xcstrings, xliff (1.2), or xliff2 (2.0); default inferred from --path, else strings")
+	sourceLocale := fs.String("source-locale", "", "Source locale for xcstrings and XLIFF output (default: en-US when present)")
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
@@ -216,14 +216,26 @@ func LocalizationsDownloadCommand() *ffcli.Command {
 	return &ffcli.Command{
 		Name:       "download",
 		ShortUsage: "asc localizations download [flags]",
-		ShortHelp:  "Download localizations to .strings files.",
-		LongHelp: `Download localizations to .strings files.
+		ShortHelp:  "Download localizations to .strings, .xcstrings, or XLIFF files.",
+		LongHelp: `Download localizations to .strings, .xcstrings, or XLIFF files.
+
+Formats:
+  strings    One <locale>.strings file per locale (default)
+  xcstrings  One Xcode String Catalog with every locale (<type>.xcstrings in a directory)
+  xliff      One <locale>.xliff file per locale (XLIFF 1.2)
+  xliff2     One <locale>.xliff file per locale (XLIFF 2.0)
+
+String Catalogs and XLIFF files mark every value as translated and annotate
+length-limited fields with their App Store character limit. XLIFF source text
+comes from --source-locale.
 
 Examples:
   asc localizations download --version "VERSION_ID" --path "./localizations"
   asc localizations download --app "APP_ID" --type app-info --path "./localizations"
   asc localizations download --version "VERSION_ID" --locale "en-US" --path "en-US.strings"
-  asc localizations download --version "VERSION_ID" --paginate --path "./localizations"`,
+  asc localizations download --version "VERSION_ID" --paginate --path "./localizations"
+  asc localizations download --version "VERSION_ID" --path "./Metadata.xcstrings"
+  asc localizations download --version "VERSION_ID" --format xliff --source-locale "en-US" --path "./xliff"`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -238,6 +250,14 @@ Examples:
 			if err != nil {
 				return fmt.Errorf("localizations download: %w", err)
 			}
+			normalizedFormat, err := shared.ResolveLocalizationFormat(*format, *path)
+			if err != nil {
+				return fmt.Errorf("localizations download: %w", err)
+			}
+			writeOpts := shared.LocalizationWriteOptions{
+				Format:       normalizedFormat,
+				SourceLocale: strings.TrimSpace(*sourceLocale),
+			}
 
 			locales := shared.SplitCSV(*locale)
 
@@ -248,7 +268,7 @@ Examples:
 					return flag.ErrHelp
 				}
 
-				client, err := shared.GetASCClient()
+				client, err := shared.GetASCClient(ctx)
 				if err != nil {
 					return fmt.Errorf("localizations download: %w", err)
 				}
@@ -271,19 +291,22 @@ Examples:
 						return fmt.Errorf("localizations download: failed to fetch: %w", err)
 					}
 
-					resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+					var items []asc.Resource[asc.AppStoreVersionLocalizationAttributes]
+					err = asc.PaginateEach(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 						return client.GetAppStoreVersionLocalizations(ctx, strings.TrimSpace(*versionID), asc.WithAppStoreVersionLocalizationsNextURL(nextURL))
+					}, func(page asc.PaginatedResponse) error {
+						resp, ok := page.(*asc.AppStoreVersionLocalizationsResponse)
+						if !ok {
+							return fmt.Errorf("unexpected pagination response type")
+						}
+						items = append(items, resp.Data...)
+						return nil
 					})
 					if err != nil {
 						return fmt.Errorf("localizations download: %w", err)
 					}
 
-					aggregated, ok := resp.(*asc.AppStoreVersionLocalizationsResponse)
-					if !ok {
-						return fmt.Errorf("localizations download: unexpected pagination response type")
-					}
-
-					files, err := shared.WriteVersionLocalizationStrings(*path, aggregated.Data)
+					files, err := shared.WriteVersionLocalizationFiles(*path, items, writeOpts)
 					if err != nil {
 						return fmt.Errorf("localizations download: %w", err)
 					}
@@ -295,7 +318,7 @@ Examples:
 						Files:      files,
 					}
 
-					return shared.PrintOutput(&result, *output.Output, *output.Pretty)
+					return shared.PrintOutput(ctx, &result, *output.Output, *output.Pretty)
 				}
 
 				resp, err := client.GetAppStoreVersionLocalizations(requestCtx, strings.TrimSpace(*versionID), opts...)
@@ -303,7 +326,7 @@ Examples:
 					return fmt.Errorf("localizations download: failed to fetch: %w", err)
 				}
 
-				files, err := shared.WriteVersionLocalizationStrings(*path, resp.Data)
+				files, err := shared.WriteVersionLocalizationFiles(*path, resp.Data, writeOpts)
 				if err != nil {
 					return fmt.Errorf("localizations download: %w", err)
 				}
@@ -315,15 +338,15 @@ Examples:
 					Files:      files,
 				}
 
-				return shared.PrintOutput(&result, *output.Output, *output.Pretty)
+				return shared.PrintOutput(ctx, &result, *output.Output, *output.Pretty)
 			case shared.LocalizationTypeAppInfo:
-				resolvedAppID := shared.ResolveAppID(*appID)
+				resolvedAppID := shared.ResolveAppID(ctx, *appID)
 				if resolvedAppID == "" {
 					fmt.Fprintln(os.Stderr, "Error: --app is required for app-info localizations")
 					return flag.ErrHelp
 				}
 
-				client, err := shared.GetASCClient()
+				client, err := shared.GetASCClient(ctx)
 				if err != nil {
 					return fmt.Errorf("localizations download: %w", err)
 				}
@@ -351,19 +374,22 @@ Examples:
 						return fmt.Errorf("localizations download: failed to fetch: %w", err)
 					}
 
-					resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+					var items []asc.Resource[asc.AppInfoLocalizationAttributes]
+					err = asc.PaginateEach(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 						return client.GetAppInfoLocalizations(ctx, appInfo, asc.WithAppInfoLocalizationsNextURL(nextURL))
+					}, func(page asc.PaginatedResponse) error {
+						resp, ok := page.(*asc.AppInfoLocalizationsResponse)
+						if !ok {
+							return fmt.Errorf("unexpected pagination response type")
+						}
+						items = append(items, resp.Data...)
+						return nil
 					})
 					if err != nil {
 						return fmt.Errorf("localizations download: %w", err)
 					}
 
-					aggregated, ok := resp.(*asc.AppInfoLocalizationsResponse)
-					if !ok {
-						return fmt.Errorf("localizations download: unexpected pagination response type")
-					}
-
-					files, err := shared.WriteAppInfoLocalizationStrings(*path, aggregated.Data)
+					files, err := shared.WriteAppInfoLocalizationFiles(*path, items, writeOpts)
 					if err != nil {
 						return fmt.Errorf("localizations download: %w", err)
 					}
@@ -376,7 +402,7 @@ Examples:
 						Files:      files,
 					}
 
-					return shared.PrintOutput(&result, *output.Output, *output.Pretty)
+					return shared.PrintOutput(ctx, &result, *output.Output, *output.Pretty)
 				}
 
 				resp, err := client.GetAppInfoLocalizations(requestCtx, appInfo, opts...)
@@ -384,7 +410,7 @@ Examples:
 					return fmt.Errorf("localizations download: failed to fetch: %w", err)
 				}
 
-				files, err := shared.WriteAppInfoLocalizationStrings(*path, resp.Data)
+				files, err := shared.WriteAppInfoLocalizationFiles(*path, resp.Data, writeOpts)
 				if err != nil {
 					return fmt.Errorf("localizations download: %w", err)
 				}
@@ -397,7 +423,7 @@ Examples:
 					Files:      files,
 				}
 
-				return shared.PrintOutput(&result, *output.Output, *output.Pretty)
+				return shared.PrintOutput(ctx, &result, *output.Output, *output.Pretty)
 			default:
 				return fmt.Errorf("localizations download: unsupported type %q", normalizedType)
 			}
@@ -414,21 +440,27 @@ func LocalizationsUploadCommand() *ffcli.Command {
 	appInfoID := fs.String("app-info", "", "App Info ID (optional override)")
 	locType := fs.String("type", shared.LocalizationTypeVersion, "Localization type: version (default) or app-info")
 	locale := fs.String("locale", "", "Filter by locale(s), comma-separated")
-	path := fs.String("path", "", "Input path (directory or .strings file)")
+	path := fs.String("path", "", "Input path (directory, or .strings, .xcstrings, or .xliff file)")
 	dryRun := fs.Bool("dry-run", false, "Validate file without uploading")
 	output := shared.BindOutputFlags(fs)
 
 	return &ffcli.Command{
 		Name:       "upload",
 		ShortUsage: "asc localizations upload [flags]",
-		ShortHelp:  "Upload localizations from .strings files.",
-		LongHelp: `Upload localizations from .strings files.
+		ShortHelp:  "Upload localizations from .strings, .xcstrings, or XLIFF files.",
+		LongHelp: `Upload localizations from .strings, .xcstrings, or XLIFF files.
+
+The format is detected from each file extension (.strings, .xcstrings, .xliff,
+or .xlf); XLIFF 1.2 and 2.0 are both accepted. Catalog values whose state is
+not translated (for example needs_review) are skipped with a warning.
 
 Examples:
   asc localizations upload --version "VERSION_ID" --path "./localizations"
   asc localizations upload --app "APP_ID" --type app-info --path "./localizations"
   asc localizations upload --version "VERSION_ID" --locale "en-US" --path "en-US.strings"
-  asc localizations upload --version "VERSION_ID" --path "./localizations" --dry-run`,
+  asc localizations upload --version "VERSION_ID" --path "./localizations" --dry-run
+  asc localizations upload --version "VERSION_ID" --path "./Metadata.xcstrings"
+  asc localizations upload --version "VERSION_ID" --locale "fr-FR" --path "./xliff/fr-FR.xliff"`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -459,7 +491,7 @@ Examples:
 					return shared.UsageError(err.Error())
 				}
 
-				client, err := shared.GetASCClient()
+				client, err := shared.GetASCClient(ctx)
 				if err != nil {
 					return fmt.Errorf("localizations upload: %w", err)
 				}
@@ -483,18 +515,18 @@ Examples:
 					Results:   results,
 				}
 
-				if err := shared.PrintOutput(&result, *output.Output, *output.Pretty); err != nil {
+				if err := shared.PrintOutput(ctx, &result, *output.Output, *output.Pretty); err != nil {
 					return err
 				}
 				return shared.PrintSubmitReadinessCreateWarnings(os.Stderr, warnings)
 			case shared.LocalizationTypeAppInfo:
-				resolvedAppID := shared.ResolveAppID(*appID)
+				resolvedAppID := shared.ResolveAppID(ctx, *appID)
 				if resolvedAppID == "" {
 					fmt.Fprintln(os.Stderr, "Error: --app is required for app-info localizations")
 					return flag.ErrHelp
 				}
 
-				client, err := shared.GetASCClient()
+				client, err := shared.GetASCClient(ctx)
 				if err != nil {
 					return fmt.Errorf("localizations upload: %w", err)
 				}
@@ -525,7 +557,7 @@ Examples:
 					Results:   results,
 				}
 
-				return shared.PrintOutput(&result, *output.Output, *output.Pretty)
+				return shared.PrintOutput(ctx, &result, *output.Output, *output.Pretty)
 			default:
 				return fmt.Errorf("localizations upload: unsupported type %q", normalizedType)
 			}
@@ -196,7 +196,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("localizations screenshot-sets delete: %w", err)
 			}
@@ -213,7 +213,7 @@ Examples:
 				Deleted: true,
 			}
 
-			return shared.PrintOutput(&result, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, &result, *output.Output, *output.Pretty)
 		},
 	}
 }
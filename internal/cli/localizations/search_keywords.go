@@ -69,7 +69,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("localizations search-keywords list: %w", err)
 			}
@@ -82,7 +82,7 @@ Examples:
 				return fmt.Errorf("localizations search-keywords list: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -118,7 +118,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("localizations search-keywords add: %w", err)
 			}
@@ -130,7 +130,7 @@ Examples:
 				return fmt.Errorf("localizations search-keywords add: failed to add: %w", err)
 			}
 
-			return shared.PrintOutput(shared.BuildAppKeywordsResponse(keywordValues), *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, shared.BuildAppKeywordsResponse(keywordValues), *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -171,7 +171,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("localizations search-keywords delete: %w", err)
 			}
@@ -183,7 +183,7 @@ Examples:
 				return fmt.Errorf("localizations search-keywords delete: failed to delete: %w", err)
 			}
 
-			return shared.PrintOutput(shared.BuildAppKeywordsResponse(keywordValues), *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, shared.BuildAppKeywordsResponse(keywordValues), *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -58,7 +58,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("localizations supported-locales: %w", err)
 			}
@@ -71,20 +71,23 @@ Examples:
 				return fmt.Errorf("localizations supported-locales: failed to fetch configured localizations: %w", err)
 			}
 
-			paginated, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+			configured := make(map[string]string)
+			err = asc.PaginateEach(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 				return client.GetAppStoreVersionLocalizations(ctx, vid, asc.WithAppStoreVersionLocalizationsNextURL(nextURL))
+			}, func(page asc.PaginatedResponse) error {
+				resp, ok := page.(*asc.AppStoreVersionLocalizationsResponse)
+				if !ok {
+					return fmt.Errorf("unexpected localization response type %T", page)
+				}
+				addConfiguredLocales(configured, resp)
+				return nil
 			})
 			if err != nil {
 				return fmt.Errorf("localizations supported-locales: paginate configured localizations: %w", err)
 			}
 
-			resp, ok := paginated.(*asc.AppStoreVersionLocalizationsResponse)
-			if !ok {
-				return fmt.Errorf("localizations supported-locales: unexpected localization response type %T", paginated)
-			}
-
-			result := buildSupportedLocalesResult(vid, resp)
-			return shared.PrintOutputWithRenderers(
+			result := buildSupportedLocalesResult(vid, configured)
+			return shared.PrintOutputWithRenderers(ctx,
 				result,
 				*output.Output,
 				*output.Pretty,
@@ -101,9 +104,9 @@ Examples:
 	}
 }
 
-func buildSupportedLocalesResult(versionID string, resp *asc.AppStoreVersionLocalizationsResponse) *supportedLocalesResult {
-	catalog := shared.AppStoreLocalizationCatalog()
-	configured := make(map[string]string, len(resp.Data))
+// addConfiguredLocales records the normalized locale and localization ID of
+// each configured localization in resp.
+func addConfiguredLocales(configured map[string]string, resp *asc.AppStoreVersionLocalizationsResponse) {
 	for _, item := range resp.Data {
 		locale := strings.TrimSpace(item.Attributes.Locale)
 		if locale == "" {
@@ -115,7 +118,10 @@ func buildSupportedLocalesResult(versionID string, resp *asc.AppStoreVersionLoca
 		}
 		configured[locale] = item.ID
 	}
+}
 
+func buildSupportedLocalesResult(versionID string, configured map[string]string) *supportedLocalesResult {
+	catalog := shared.AppStoreLocalizationCatalog()
 	result := &supportedLocalesResult{
 		VersionID: versionID,
 		Locales:   make([]supportedLocaleEntry, 0, len(catalog)+len(configured)),
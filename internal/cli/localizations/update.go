@@ -131,13 +131,13 @@ func updateAppInfoLocalization(ctx context.Context, p updateAppInfoParams) error
 		return flag.ErrHelp
 	}
 
-	resolvedAppID := shared.ResolveAppID(p.appID)
+	resolvedAppID := shared.ResolveAppID(ctx, p.appID)
 	if resolvedAppID == "" {
 		fmt.Fprintln(os.Stderr, "Error: --app is required for app-info localizations (or set ASC_APP_ID)")
 		return flag.ErrHelp
 	}
 
-	client, err := shared.GetASCClient()
+	client, err := shared.GetASCClient(ctx)
 	if err != nil {
 		return fmt.Errorf("localizations update: %w", err)
 	}
@@ -185,7 +185,7 @@ func updateAppInfoLocalization(ctx context.Context, p updateAppInfoParams) error
 		)
 	}
 
-	return shared.PrintOutput(resp, *p.output.Output, *p.output.Pretty)
+	return shared.PrintOutput(ctx, resp, *p.output.Output, *p.output.Pretty)
 }
 
 func hasAnyAppInfoField(p updateAppInfoParams) bool {
@@ -213,7 +213,7 @@ func updateVersionLocalization(ctx context.Context, p updateVersionParams) error
 		return shared.UsageError(err.Error())
 	}
 
-	client, err := shared.GetASCClient()
+	client, err := shared.GetASCClient(ctx)
 	if err != nil {
 		return fmt.Errorf("localizations update: %w", err)
 	}
@@ -257,7 +257,7 @@ func updateVersionLocalization(ctx context.Context, p updateVersionParams) error
 		)
 	}
 
-	return shared.PrintOutput(resp, *p.output.Output, *p.output.Pretty)
+	return shared.PrintOutput(ctx, resp, *p.output.Output, *p.output.Pretty)
 }
 
 func hasAnyVersionField(p updateVersionParams) bool {
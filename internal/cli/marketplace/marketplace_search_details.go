@@ -61,7 +61,7 @@ Examples:
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
-			resolvedAppID := shared.ResolveAppID(*appID)
+			resolvedAppID := shared.ResolveAppID(ctx, *appID)
 			if resolvedAppID == "" {
 				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
 				return flag.ErrHelp
@@ -72,7 +72,7 @@ Examples:
 				return fmt.Errorf("marketplace search-details get: %w", err)
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("marketplace search-details get: %w", err)
 			}
@@ -85,7 +85,7 @@ Examples:
 				return fmt.Errorf("marketplace search-details get: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(detail, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, detail, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -109,7 +109,7 @@ Examples:
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
-			resolvedAppID := shared.ResolveAppID(*appID)
+			resolvedAppID := shared.ResolveAppID(ctx, *appID)
 			if resolvedAppID == "" {
 				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
 				return flag.ErrHelp
@@ -121,7 +121,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("marketplace search-details create: %w", err)
 			}
@@ -134,7 +134,7 @@ Examples:
 				return fmt.Errorf("marketplace search-details create: failed to create: %w", err)
 			}
 
-			return shared.PrintOutput(detail, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, detail, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -180,7 +180,7 @@ Examples:
 				attrs.CatalogURL = &value
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("marketplace search-details update: %w", err)
 			}
@@ -193,7 +193,7 @@ Examples:
 				return fmt.Errorf("marketplace search-details update: failed to update: %w", err)
 			}
 
-			return shared.PrintOutput(detail, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, detail, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -227,7 +227,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("marketplace search-details delete: %w", err)
 			}
@@ -244,7 +244,7 @@ Examples:
 				Deleted: true,
 			}
 
-			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 		},
 	}
 }
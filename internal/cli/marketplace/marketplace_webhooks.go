@@ -78,7 +78,7 @@ Examples:
 				return fmt.Errorf("marketplace webhooks list: %w", err)
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("marketplace webhooks list: %w", err)
 			}
@@ -101,14 +101,12 @@ Examples:
 					return fmt.Errorf("marketplace webhooks list: failed to fetch: %w", err)
 				}
 
-				webhooks, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				if err := shared.PrintPaginatedOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetMarketplaceWebhooks(ctx, asc.WithMarketplaceWebhooksNextURL(nextURL))
-				})
-				if err != nil {
+				}, *output.Output, *output.Pretty); err != nil {
 					return fmt.Errorf("marketplace webhooks list: %w", err)
 				}
-
-				return shared.PrintOutput(webhooks, *output.Output, *output.Pretty)
+				return nil
 			}
 
 			webhooks, err := client.GetMarketplaceWebhooks(requestCtx, opts...)
@@ -116,7 +114,7 @@ Examples:
 				return fmt.Errorf("marketplace webhooks list: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(webhooks, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, webhooks, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -147,7 +145,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("marketplace webhooks get: %w", err)
 			}
@@ -160,7 +158,7 @@ Examples:
 				return fmt.Errorf("marketplace webhooks get: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(webhook, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, webhook, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -197,7 +195,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("marketplace webhooks create: %w", err)
 			}
@@ -210,7 +208,7 @@ Examples:
 				return fmt.Errorf("marketplace webhooks create: failed to create: %w", err)
 			}
 
-			return shared.PrintOutput(webhook, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, webhook, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -264,7 +262,7 @@ Examples:
 				attrs.Secret = &value
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("marketplace webhooks update: %w", err)
 			}
@@ -277,7 +275,7 @@ Examples:
 				return fmt.Errorf("marketplace webhooks update: failed to update: %w", err)
 			}
 
-			return shared.PrintOutput(webhook, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, webhook, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -313,7 +311,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("marketplace webhooks delete: %w", err)
 			}
@@ -330,7 +328,7 @@ Examples:
 				Deleted: true,
 			}
 
-			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 		},
 	}
 }
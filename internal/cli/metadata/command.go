@@ -19,24 +19,37 @@ func MetadataCommand() *ffcli.Command {
 		ShortHelp:  "Manage app metadata with deterministic workflows and keyword tooling.",
 		LongHelp: `Manage app metadata with deterministic workflows and keyword tooling.
 
-Phase 1 scope:
+Localization scope (default):
   - app-info localizations: name, subtitle, privacyPolicyUrl, privacyChoicesUrl, privacyPolicyText
   - version localizations: description, keywords, marketingUrl, promotionalText, supportUrl, whatsNew
 
+Additional scopes (select with --include on pull, push, and apply):
+  - categories, age-rating, review-info, content-rights, eula, availability,
+    pricing, and media (screenshot and preview manifests)
+
+Three-way merge:
+  - pull records the remote values it wrote in <dir>/.asc-metadata-lock.json
+  - push and apply use it to keep remote edits and report conflicts (--strategy)
+  - ` + "`asc metadata drift`" + ` exits non-zero when App Store Connect changed since pull
+
+Machine translation:
+  - ` + "`asc metadata translate`" + ` translates version fields through a local command or an
+    OpenAI-compatible API and records the results for review
+
 Keyword workflow:
   - ` + "`asc metadata keywords ...`" + ` manages the canonical version-localization ` + "`keywords`" + ` field
   - raw App Store Connect ` + "`searchKeywords`" + ` relationship APIs remain under
     ` + "`asc apps search-keywords ...`" + ` and ` + "`asc localizations search-keywords ...`" + `
 
-Not yet included in this group:
-  - categories, review information, age ratings, screenshots
-
 Note: copyright is managed via "asc versions create --copyright" or "asc versions update --copyright".
 
 Examples:
   asc metadata init --dir "./metadata" --version "1.2.3" --locale "en-US"
   asc metadata pull --app "APP_ID" --version "1.2.3" --dir "./metadata"
   asc metadata pull --app "APP_ID" --version "1.2.3" --platform IOS --dir "./metadata"
+  asc metadata pull --app "APP_ID" --version "1.2.3" --dir "./metadata" --include all
+  asc metadata drift --version "1.2.3" --dir "./metadata"
+  asc metadata translate --dir "./metadata" --version "1.2.3" --from "en-US" --to "de-DE,ja" --model "gpt-4o-mini"
   asc metadata keywords import --dir "./metadata" --version "1.2.3" --locale "en-US" --input "./keywords.csv"`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
@@ -44,8 +57,10 @@ Examples:
 			MetadataInitCommand(),
 			MetadataPullCommand(),
 			MetadataApplyCommand(),
+			MetadataDriftCommand(),
 			MetadataKeywordsCommand(),
 			MetadataPushCommand(),
+			MetadataTranslateCommand(),
 			MetadataValidateCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
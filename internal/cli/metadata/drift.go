@@ -0,0 +1,245 @@
+package metadata
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// DriftResult is the structured result for metadata drift.
+type DriftResult struct {
+	AppID    string      `json:"appId"`
+	Version  string      `json:"version"`
+	Dir      string      `json:"dir"`
+	LockFile string      `json:"lockFile"`
+	Includes []string    `json:"includes"`
+	Drifted  bool        `json:"drifted"`
+	Changes  []MergeItem `json:"changes"`
+}
+
+// MetadataDriftCommand returns the metadata drift subcommand.
+func MetadataDriftCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("metadata drift", flag.ExitOnError)
+
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env; defaults to the lock file app)")
+	appInfoID := fs.String("app-info", "", "App Info ID (optional override)")
+	version := fs.String("version", "", "App version string (for example 1.2.3)")
+	platform := fs.String("platform", "", "Optional platform: IOS, MAC_OS, TV_OS, or VISION_OS")
+	dir := fs.String("dir", "", "Metadata root directory containing .asc-metadata-lock.json (required)")
+	include := fs.String("include", "", "Included metadata scopes (comma-separated; default: every scope in the lock file)")
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "drift",
+		ShortUsage: `asc metadata drift --version "1.2.3" --dir "./metadata" [--app "APP_ID"] [flags]`,
+		ShortHelp:  "Detect remote metadata changes since the last pull.",
+		LongHelp: `Detect remote metadata changes since the last pull.
+
+Compares App Store Connect with the base snapshot that metadata pull records
+in <dir>/.asc-metadata-lock.json and lists every field whose remote value
+changed. Local files are not read. Exits non-zero when drift is found, so it
+can gate CI before metadata push.
+
+Examples:
+  asc metadata drift --version "1.2.3" --dir "./metadata"
+  asc metadata drift --app "APP_ID" --version "1.2.3" --dir "./metadata" --include localizations,categories
+  asc metadata drift --version "1.2.3" --dir "./metadata" --output table`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if len(args) > 0 {
+				return shared.UsageError("metadata drift does not accept positional arguments")
+			}
+
+			versionValue := strings.TrimSpace(*version)
+			if versionValue == "" {
+				return shared.UsageError("--version is required")
+			}
+			dirValue := strings.TrimSpace(*dir)
+			if dirValue == "" {
+				return shared.UsageError("--dir is required")
+			}
+			platformValue := strings.TrimSpace(*platform)
+			if platformValue != "" {
+				normalizedPlatform, err := shared.NormalizeAppStoreVersionPlatform(platformValue)
+				if err != nil {
+					return shared.UsageError(err.Error())
+				}
+				platformValue = normalizedPlatform
+			}
+
+			lock, err := readMetadataLock(dirValue)
+			if err != nil {
+				return fmt.Errorf("metadata drift: %w", err)
+			}
+			if lock == nil {
+				return shared.UsageErrorf("%s not found; run metadata pull first", metadataLockPath(dirValue))
+			}
+
+			resolvedAppID := shared.ResolveAppID(ctx, *appID)
+			if resolvedAppID == "" {
+				resolvedAppID = lock.AppID
+			}
+			if resolvedAppID != lock.AppID {
+				return fmt.Errorf("metadata drift: %s was pulled for app %q, not %q", metadataLockPath(dirValue), lock.AppID, resolvedAppID)
+			}
+
+			includes, prefixes, err := resolveDriftScopes(lock, *include, versionValue)
+			if err != nil {
+				return shared.UsageError(err.Error())
+			}
+
+			client, err := shared.GetASCClient(ctx)
+			if err != nil {
+				return fmt.Errorf("metadata drift: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			collected, err := collectMetadataSnapshots(
+				requestCtx,
+				client,
+				SnapshotOptions{
+					AppID:     resolvedAppID,
+					AppInfoID: strings.TrimSpace(*appInfoID),
+					Version:   versionValue,
+					Platform:  platformValue,
+					Includes:  includes,
+				},
+				func(aid, v, p, _, infoID string) string {
+					return buildMetadataAppInfoExample("drift", aid, v, p, dirValue, infoID)
+				},
+			)
+			if err != nil {
+				if errors.Is(err, flag.ErrHelp) {
+					return err
+				}
+				return fmt.Errorf("metadata drift: %w", err)
+			}
+
+			changes := diffSnapshot(lock.Scopes, collected.remote, prefixes)
+			result := DriftResult{
+				AppID:    resolvedAppID,
+				Version:  versionValue,
+				Dir:      dirValue,
+				LockFile: metadataLockPath(dirValue),
+				Includes: includes,
+				Drifted:  len(changes) > 0,
+				Changes:  changes,
+			}
+
+			if err := shared.PrintOutputWithRenderers(ctx,
+				result,
+				*output.Output,
+				*output.Pretty,
+				func() error { return printDriftResultTable(result) },
+				func() error { return printDriftResultMarkdown(result) },
+			); err != nil {
+				return err
+			}
+
+			if result.Drifted {
+				return shared.NewReportedError(fmt.Errorf("metadata drift: %d field(s) changed remotely since last pull", len(changes)))
+			}
+			return nil
+		},
+	}
+}
+
+// driftIncludePrefixes returns the lock prefixes an include scope owns for
+// version.
+func driftIncludePrefixes(include, version string) []string {
+	switch include {
+	case includeLocalizations:
+		return []string{appInfoDirName, snapshotPrefix(versionDirName, version)}
+	case includeReviewInfo, includeMedia:
+		return []string{snapshotPrefix(include, version)}
+	default:
+		return []string{include}
+	}
+}
+
+// resolveDriftScopes selects the include scopes and lock prefixes to compare.
+// Without --include, every scope recorded in the lock for version is used.
+func resolveDriftScopes(lock *MetadataLock, includeValue, version string) ([]string, []string, error) {
+	explicit := strings.TrimSpace(includeValue) != ""
+	candidates := supportedIncludes
+	if explicit {
+		parsed, err := parseIncludes(includeValue)
+		if err != nil {
+			return nil, nil, err
+		}
+		candidates = parsed
+	}
+
+	includes := make([]string, 0, len(candidates))
+	prefixes := make([]string, 0, len(candidates))
+	for _, include := range candidates {
+		recorded := make([]string, 0, 2)
+		for _, prefix := range driftIncludePrefixes(include, version) {
+			if _, ok := lock.Scopes[prefix]; ok {
+				recorded = append(recorded, prefix)
+			}
+		}
+		if len(recorded) == 0 {
+			if explicit {
+				return nil, nil, fmt.Errorf("lock file has no %s snapshot for version %s; run metadata pull --include %s first", include, version, include)
+			}
+			continue
+		}
+		includes = append(includes, include)
+		prefixes = append(prefixes, recorded...)
+	}
+	if len(includes) == 0 {
+		return nil, nil, fmt.Errorf("lock file has no snapshot for version %s; run metadata pull first", version)
+	}
+	sort.Strings(includes)
+	sort.Strings(prefixes)
+	return includes, prefixes, nil
+}
+
+func printDriftResultTable(result DriftResult) error {
+	fmt.Printf("App ID: %s\n", result.AppID)
+	fmt.Printf("Version: %s\n", result.Version)
+	fmt.Printf("Lock File: %s\n", result.LockFile)
+	fmt.Printf("Drifted: %t\n\n", result.Drifted)
+	asc.RenderTable([]string{"key", "scope", "locale", "version", "field", "base", "remote"}, buildDriftRows(result))
+	return nil
+}
+
+func printDriftResultMarkdown(result DriftResult) error {
+	fmt.Printf("**App ID:** %s\n\n", result.AppID)
+	fmt.Printf("**Version:** %s\n\n", result.Version)
+	fmt.Printf("**Lock File:** %s\n\n", result.LockFile)
+	fmt.Printf("**Drifted:** %t\n\n", result.Drifted)
+	asc.RenderMarkdown([]string{"key", "scope", "locale", "version", "field", "base", "remote"}, buildDriftRows(result))
+	return nil
+}
+
+func buildDriftRows(result DriftResult) [][]string {
+	rows := make([][]string, 0, len(result.Changes))
+	for _, item := range result.Changes {
+		rows = append(rows, []string{
+			item.Key,
+			item.Scope,
+			item.Locale,
+			item.Version,
+			item.Field,
+			sanitizePlanCell(item.Base),
+			sanitizePlanCell(item.Remote),
+		})
+	}
+	if len(rows) == 0 {
+		rows = append(rows, []string{"none", "", "", "", "", "", ""})
+	}
+	return rows
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
 )
 
@@ -22,6 +23,9 @@ type PushExecutionOptions struct {
 	DryRun       bool
 	AllowDeletes bool
 	Confirm      bool
+	// Strategy resolves fields changed both locally and remotely since the
+	// last pull: fail (default), ours, or theirs.
+	Strategy string
 }
 
 // ExecutePush computes and optionally applies a metadata push plan.
@@ -37,7 +41,7 @@ func ExecutePush(ctx context.Context, opts PushExecutionOptions) (PushPlanResult
 // submission warnings for callers that need to emit them after output succeeds.
 func ExecutePushWithWarnings(ctx context.Context, opts PushExecutionOptions) (PushPlanResult, []shared.SubmitReadinessCreateWarning, error) {
 	errorPrefix := metadataMutationErrorPrefix(opts.CommandName)
-	resolvedAppID := shared.ResolveAppID(opts.AppID)
+	resolvedAppID := shared.ResolveAppID(ctx, opts.AppID)
 	if resolvedAppID == "" {
 		return PushPlanResult{}, nil, shared.UsageError("--app is required (or set ASC_APP_ID)")
 	}
@@ -70,12 +74,43 @@ func ExecutePushWithWarnings(ctx context.Context, opts PushExecutionOptions) (Pu
 		return PushPlanResult{}, nil, shared.UsageError(err.Error())
 	}
 
-	localBundle, err := loadLocalMetadata(dirValue, versionValue)
+	strategy, err := parseMergeStrategy(opts.Strategy)
+	if err != nil {
+		return PushPlanResult{}, nil, shared.UsageError(err.Error())
+	}
+
+	includesLocalizations := hasInclude(includes, includeLocalizations)
+	scopes := newMetadataScopes(includes)
+
+	var localBundle localMetadataBundle
+	if includesLocalizations {
+		localBundle, err = loadLocalMetadata(dirValue, versionValue)
+		if err != nil {
+			return PushPlanResult{}, nil, fmt.Errorf("%s: %w", errorPrefix, err)
+		}
+	}
+	scopeFiles, err := loadScopes(scopes, dirValue, versionValue)
 	if err != nil {
 		return PushPlanResult{}, nil, fmt.Errorf("%s: %w", errorPrefix, err)
 	}
+	if localBundle.files+scopeFiles == 0 {
+		return PushPlanResult{}, nil, fmt.Errorf("%s: %w", errorPrefix, shared.UsageError("no metadata .json files found"))
+	}
+	lock, err := readMetadataLock(dirValue)
+	if err != nil {
+		return PushPlanResult{}, nil, fmt.Errorf("%s: %w", errorPrefix, err)
+	}
+	if lock != nil && lock.AppID != resolvedAppID {
+		return PushPlanResult{}, nil, fmt.Errorf(
+			"%s: %s was pulled for app %q, not %q; run metadata pull again",
+			errorPrefix,
+			metadataLockPath(dirValue),
+			lock.AppID,
+			resolvedAppID,
+		)
+	}
 
-	client, err := shared.GetASCClient()
+	client, err := shared.GetASCClient(ctx)
 	if err != nil {
 		return PushPlanResult{}, nil, fmt.Errorf("%s: %w", errorPrefix, err)
 	}
@@ -108,86 +143,133 @@ func ExecutePushWithWarnings(ctx context.Context, opts PushExecutionOptions) (Pu
 		return PushPlanResult{}, nil, fmt.Errorf("%s: %w", errorPrefix, err)
 	}
 
-	remoteAppInfoItems, err := fetchAppInfoLocalizations(requestCtx, client, appInfoIDValue)
-	if err != nil {
-		return PushPlanResult{}, nil, fmt.Errorf("%s: %w", errorPrefix, err)
+	var remoteAppInfoItems []asc.Resource[asc.AppInfoLocalizationAttributes]
+	if includesLocalizations {
+		remoteAppInfoItems, err = fetchAppInfoLocalizations(requestCtx, client, appInfoIDValue)
+		if err != nil {
+			return PushPlanResult{}, nil, fmt.Errorf("%s: %w", errorPrefix, err)
+		}
 	}
-	remoteVersionItems, err := fetchVersionLocalizations(requestCtx, client, versionIDValue)
-	if err != nil {
-		return PushPlanResult{}, nil, fmt.Errorf("%s: %w", errorPrefix, err)
+	var remoteVersionItems []asc.Resource[asc.AppStoreVersionLocalizationAttributes]
+	if includesLocalizations || hasInclude(includes, includeMedia) {
+		remoteVersionItems, err = fetchVersionLocalizations(requestCtx, client, versionIDValue)
+		if err != nil {
+			return PushPlanResult{}, nil, fmt.Errorf("%s: %w", errorPrefix, err)
+		}
 	}
 
-	remoteAppInfo := make(map[string]AppInfoLocalization, len(remoteAppInfoItems))
-	for _, item := range remoteAppInfoItems {
-		locale := strings.TrimSpace(item.Attributes.Locale)
-		if locale == "" {
-			continue
-		}
-		remoteAppInfo[locale] = NormalizeAppInfoLocalization(AppInfoLocalization{
-			Name:              item.Attributes.Name,
-			Subtitle:          item.Attributes.Subtitle,
-			PrivacyPolicyURL:  item.Attributes.PrivacyPolicyURL,
-			PrivacyChoicesURL: item.Attributes.PrivacyChoicesURL,
-			PrivacyPolicyText: item.Attributes.PrivacyPolicyText,
-		})
+	target := scopeTarget{
+		appID:                resolvedAppID,
+		appInfoID:            appInfoIDValue,
+		versionID:            versionIDValue,
+		version:              versionValue,
+		dir:                  dirValue,
+		versionLocalizations: versionLocalizationIDs(remoteVersionItems),
+	}
+	if err := fetchScopes(requestCtx, client, scopes, target); err != nil {
+		return PushPlanResult{}, nil, fmt.Errorf("%s: %w", errorPrefix, err)
 	}
 
+	remoteAppInfo := remoteAppInfoItemsToMap(remoteAppInfoItems)
 	remoteVersion := remoteVersionItemsToVersionMap(remoteVersionItems)
 
-	localAppInfo := applyDefaultAppInfoFallback(localBundle.appInfo, localBundle.defaultAppInfo, remoteAppInfo, opts.AllowDeletes)
-	localVersion := applyDefaultVersionFallback(localBundle.version, localBundle.defaultVersion, remoteVersion, opts.AllowDeletes)
-	warningMode := shared.SubmitReadinessCreateModePlanned
-	if !opts.DryRun {
-		warningMode = shared.SubmitReadinessCreateModeApplied
+	var localAppInfo map[string]appInfoLocalPatch
+	var localVersion map[string]versionLocalPatch
+	if includesLocalizations {
+		localAppInfo = applyDefaultAppInfoFallback(localBundle.appInfo, localBundle.defaultAppInfo, remoteAppInfo, opts.AllowDeletes)
+		localVersion = applyDefaultVersionFallback(localBundle.version, localBundle.defaultVersion, remoteVersion, opts.AllowDeletes)
 	}
-	submitOpts := shared.SubmitReadinessOptions{}
-	if versionCreateWarningsNeedUpdateContext(localVersion, remoteVersion) {
-		submitOpts = shared.ResolveSubmitReadinessOptionsForVersionBestEffort(requestCtx, client, versionIDValue, resolvedAppID, platformValue)
-	}
-	warnings := versionCreateWarningsForPatches(localVersion, remoteVersion, warningMode, submitOpts)
 
-	adds, updates, deletes, appInfoCalls := buildScopePlan(
-		appInfoDirName,
-		"",
-		appInfoPlanFields,
-		appInfoToPlanFields(localAppInfo),
-		appInfoToFieldMap(remoteAppInfo),
-	)
-	versionAdds, versionUpdates, versionDeletes, versionCalls := buildScopePlan(
-		versionDirName,
-		versionValue,
-		versionPlanFields,
-		versionToPlanFields(localVersion),
-		versionToFieldMap(remoteVersion),
-	)
-	adds = append(adds, versionAdds...)
-	updates = append(updates, versionUpdates...)
-	deletes = append(deletes, versionDeletes...)
+	buildPlan := func() scopePlan {
+		plan := scopePlan{
+			adds:    make([]PlanItem, 0),
+			updates: make([]PlanItem, 0),
+			deletes: make([]PlanItem, 0),
+			calls:   make([]PlanAPICall, 0),
+		}
+		if includesLocalizations {
+			plan.append(buildLocalizationPlan(versionValue, localAppInfo, localVersion, remoteAppInfo, remoteVersion))
+		}
+		for _, scope := range scopes {
+			plan.append(scope.plan(target))
+		}
+		sortPlanItems(plan.adds)
+		sortPlanItems(plan.updates)
+		sortPlanItems(plan.deletes)
+		sortAPICalls(plan.calls)
+		return plan
+	}
+	snapshots := func() (metadataSnapshot, metadataSnapshot) {
+		local, remote := scopeSnapshots(scopes, target)
+		if includesLocalizations {
+			localizationLocal, localizationRemote := localizationSnapshots(versionValue, localAppInfo, localVersion, remoteAppInfo, remoteVersion)
+			local.merge(localizationLocal)
+			remote.merge(localizationRemote)
+		}
+		return local, remote
+	}
 
-	sortPlanItems(adds)
-	sortPlanItems(updates)
-	sortPlanItems(deletes)
+	plan := buildPlan()
+	var conflicts, remoteChanges []MergeItem
+	if lock != nil {
+		localSnapshot, remoteSnapshot := snapshots()
+		conflicts, remoteChanges = mergePlan(lock, plan.items(), localSnapshot, remoteSnapshot, strategy)
+		for _, item := range append(append([]MergeItem(nil), conflicts...), remoteChanges...) {
+			if item.Resolution != mergeStrategyTheirs {
+				continue
+			}
+			switch item.Scope {
+			case appInfoDirName:
+				keepRemoteAppInfoField(localAppInfo, item.Locale, item.Field)
+			case versionDirName:
+				keepRemoteVersionField(localVersion, item.Locale, item.Field)
+			default:
+				for _, scope := range scopes {
+					if scope.name() == item.Scope {
+						scope.keepRemote(item.Locale, item.Field)
+					}
+				}
+			}
+		}
+		plan = buildPlan()
+	}
 
-	apiCalls := buildAPICallSummary(appInfoCalls, versionCalls)
+	var warnings []shared.SubmitReadinessCreateWarning
+	if includesLocalizations {
+		warningMode := shared.SubmitReadinessCreateModePlanned
+		if !opts.DryRun {
+			warningMode = shared.SubmitReadinessCreateModeApplied
+		}
+		submitOpts := shared.SubmitReadinessOptions{}
+		if versionCreateWarningsNeedUpdateContext(localVersion, remoteVersion) {
+			submitOpts = shared.ResolveSubmitReadinessOptionsForVersionBestEffort(requestCtx, client, versionIDValue, resolvedAppID, platformValue)
+		}
+		warnings = versionCreateWarningsForPatches(localVersion, remoteVersion, warningMode, submitOpts)
+	}
 
 	result := PushPlanResult{
-		AppID:     resolvedAppID,
-		AppInfoID: appInfoIDValue,
-		Version:   versionValue,
-		VersionID: versionIDValue,
-		Dir:       dirValue,
-		DryRun:    opts.DryRun,
-		Includes:  includes,
-		Adds:      adds,
-		Updates:   updates,
-		Deletes:   deletes,
-		APICalls:  apiCalls,
+		AppID:         resolvedAppID,
+		AppInfoID:     appInfoIDValue,
+		Version:       versionValue,
+		VersionID:     versionIDValue,
+		Dir:           dirValue,
+		DryRun:        opts.DryRun,
+		Includes:      includes,
+		Adds:          plan.adds,
+		Updates:       plan.updates,
+		Deletes:       plan.deletes,
+		APICalls:      plan.calls,
+		Conflicts:     conflicts,
+		RemoteChanges: remoteChanges,
 	}
 
 	if opts.DryRun {
 		return result, warnings, nil
 	}
 
+	if err := unresolvedConflictError(conflicts); err != nil {
+		return PushPlanResult{}, nil, fmt.Errorf("%s: %w", errorPrefix, err)
+	}
 	if len(result.Deletes) > 0 {
 		if !opts.AllowDeletes {
 			return PushPlanResult{}, nil, shared.UsageError("--allow-deletes is required to apply delete operations")
@@ -197,20 +279,48 @@ func ExecutePushWithWarnings(ctx context.Context, opts PushExecutionOptions) (Pu
 		}
 	}
 
-	actions, applyErr := applyMetadataPlan(
-		requestCtx,
-		client,
-		appInfoIDValue,
-		versionIDValue,
-		versionValue,
-		localAppInfo,
-		localVersion,
-		remoteAppInfoItems,
-		remoteVersionItems,
-		opts.AllowDeletes,
-	)
-	if applyErr != nil {
-		return PushPlanResult{}, nil, fmt.Errorf("%s: %w", errorPrefix, applyErr)
+	actions := make([]ApplyAction, 0)
+	if includesLocalizations {
+		localizationActions, applyErr := applyMetadataPlan(
+			requestCtx,
+			client,
+			appInfoIDValue,
+			versionIDValue,
+			versionValue,
+			localAppInfo,
+			localVersion,
+			remoteAppInfoItems,
+			remoteVersionItems,
+			opts.AllowDeletes,
+		)
+		if applyErr != nil {
+			return PushPlanResult{}, nil, fmt.Errorf("%s: %w", errorPrefix, applyErr)
+		}
+		actions = append(actions, localizationActions...)
+	}
+	if len(scopes) > 0 {
+		if includesLocalizations && hasInclude(includes, includeMedia) {
+			// Media sets attach to version localizations created above.
+			refreshed, err := fetchVersionLocalizations(requestCtx, client, versionIDValue)
+			if err != nil {
+				return PushPlanResult{}, nil, fmt.Errorf("%s: %w", errorPrefix, err)
+			}
+			target.versionLocalizations = versionLocalizationIDs(refreshed)
+		}
+		for _, scope := range scopes {
+			scopeActions, applyErr := scope.apply(requestCtx, client, target)
+			if applyErr != nil {
+				return PushPlanResult{}, nil, fmt.Errorf("%s: %s: %w", errorPrefix, scope.name(), applyErr)
+			}
+			actions = append(actions, scopeActions...)
+		}
+	}
+	if lock != nil {
+		localSnapshot, remoteSnapshot := snapshots()
+		advanceMetadataLock(lock, localSnapshot, remoteSnapshot, plan.items())
+		if _, err := writeMetadataLock(dirValue, *lock); err != nil {
+			return PushPlanResult{}, nil, fmt.Errorf("%s: update lock file: %w", errorPrefix, err)
+		}
 	}
 	result.Applied = true
 	result.Actions = actions
@@ -218,6 +328,36 @@ func ExecutePushWithWarnings(ctx context.Context, opts PushExecutionOptions) (Pu
 	return result, warnings, nil
 }
 
+// buildLocalizationPlan plans the app-info and version localization scopes.
+func buildLocalizationPlan(
+	version string,
+	localAppInfo map[string]appInfoLocalPatch,
+	localVersion map[string]versionLocalPatch,
+	remoteAppInfo map[string]AppInfoLocalization,
+	remoteVersion map[string]VersionLocalization,
+) scopePlan {
+	appInfoAdds, appInfoUpdates, appInfoDeletes, appInfoCalls := buildScopePlan(
+		appInfoDirName,
+		"",
+		appInfoPlanFields,
+		appInfoToPlanFields(localAppInfo),
+		appInfoToFieldMap(remoteAppInfo),
+	)
+	versionAdds, versionUpdates, versionDeletes, versionCalls := buildScopePlan(
+		versionDirName,
+		version,
+		versionPlanFields,
+		versionToPlanFields(localVersion),
+		versionToFieldMap(remoteVersion),
+	)
+	return scopePlan{
+		adds:    append(appInfoAdds, versionAdds...),
+		updates: append(appInfoUpdates, versionUpdates...),
+		deletes: append(appInfoDeletes, versionDeletes...),
+		calls:   buildAPICallSummary(appInfoCalls, versionCalls),
+	}
+}
+
 func metadataMutationErrorPrefix(commandName string) string {
 	name := strings.TrimSpace(commandName)
 	if name == "" {
@@ -331,7 +331,7 @@ Examples:
 				}
 				return fmt.Errorf("metadata keywords import: %w", err)
 			}
-			if err := shared.PrintOutputWithRenderers(
+			if err := shared.PrintOutputWithRenderers(ctx,
 				result,
 				*output.Output,
 				*output.Pretty,
@@ -441,7 +441,7 @@ func runMetadataKeywordsPlanLikeCommand(
 		}
 		return fmt.Errorf("%s: %w", errorPrefix, err)
 	}
-	return shared.PrintOutputWithRenderers(
+	return shared.PrintOutputWithRenderers(ctx,
 		result,
 		*output.Output,
 		*output.Pretty,
@@ -470,7 +470,8 @@ func MetadataKeywordsLocalizeCommand() *ffcli.Command {
 
 This command copies the canonical keyword field from one locale into one or
 more target locale files. It does not translate terms; it seeds target locale
-files so they can be reviewed and refined before apply.
+files so they can be reviewed and refined before apply. Use
+` + "`asc metadata translate --fields keywords`" + ` to machine-translate them instead.
 
 Examples:
   asc metadata keywords localize --dir "./metadata" --version "1.2.3" --from-locale "en-US" --to-locales "fr-FR,de-DE"
@@ -496,7 +497,7 @@ Examples:
 				}
 				return fmt.Errorf("metadata keywords localize: %w", err)
 			}
-			if err := shared.PrintOutputWithRenderers(
+			if err := shared.PrintOutputWithRenderers(ctx,
 				result,
 				*output.Output,
 				*output.Pretty,
@@ -562,7 +563,7 @@ Examples:
 			if !shouldPrintMetadataKeywordsPlanResult(result, err) {
 				return fmt.Errorf("metadata keywords apply: %w", err)
 			}
-			if printErr := shared.PrintOutputWithRenderers(
+			if printErr := shared.PrintOutputWithRenderers(ctx,
 				result,
 				*output.Output,
 				*output.Pretty,
@@ -622,7 +623,7 @@ Examples:
 			if len(args) > 0 {
 				return shared.UsageError("metadata keywords sync does not accept positional arguments")
 			}
-			resolvedAppID, versionValue, dirValue, platformValue, err := validateMetadataKeywordsRemoteInputs(*appID, *version, *dir, *platform)
+			resolvedAppID, versionValue, dirValue, platformValue, err := validateMetadataKeywordsRemoteInputs(ctx, *appID, *version, *dir, *platform)
 			if err != nil {
 				return fmt.Errorf("metadata keywords sync: %w", err)
 			}
@@ -644,7 +645,7 @@ Examples:
 			}
 			if !importPayload.result.Valid {
 				result := MetadataKeywordsSyncResult{Import: importPayload.result}
-				if err := shared.PrintOutputWithRenderers(
+				if err := shared.PrintOutputWithRenderers(ctx,
 					result,
 					*output.Output,
 					*output.Pretty,
@@ -678,7 +679,7 @@ Examples:
 				Import: importPayload.result,
 				Plan:   &planResult,
 			}
-			if printErr := shared.PrintOutputWithRenderers(
+			if printErr := shared.PrintOutputWithRenderers(ctx,
 				result,
 				*output.Output,
 				*output.Pretty,
@@ -698,8 +699,8 @@ Examples:
 	}
 }
 
-func validateMetadataKeywordsRemoteInputs(appID string, version string, dir string, platform string) (string, string, string, string, error) {
-	resolvedAppID := shared.ResolveAppID(appID)
+func validateMetadataKeywordsRemoteInputs(ctx context.Context, appID string, version string, dir string, platform string) (string, string, string, string, error) {
+	resolvedAppID := shared.ResolveAppID(ctx, appID)
 	if resolvedAppID == "" {
 		return "", "", "", "", shared.UsageError("--app is required (or set ASC_APP_ID)")
 	}
@@ -911,7 +912,7 @@ func executeMetadataKeywordsLocalize(opts metadataKeywordsLocalizeOptions) (Meta
 }
 
 func executeMetadataKeywordsPlan(ctx context.Context, opts metadataKeywordsPlanOptions) (MetadataKeywordsPlanResult, error) {
-	resolvedAppID, versionValue, dirValue, platformValue, err := validateMetadataKeywordsRemoteInputs(opts.AppID, opts.Version, opts.Dir, opts.Platform)
+	resolvedAppID, versionValue, dirValue, platformValue, err := validateMetadataKeywordsRemoteInputs(ctx, opts.AppID, opts.Version, opts.Dir, opts.Platform)
 	if err != nil {
 		return MetadataKeywordsPlanResult{}, err
 	}
@@ -927,7 +928,7 @@ func executeMetadataKeywordsPlan(ctx context.Context, opts metadataKeywordsPlanO
 		}
 	}
 
-	client, err := shared.GetASCClient()
+	client, err := shared.GetASCClient(ctx)
 	if err != nil {
 		return MetadataKeywordsPlanResult{}, fmt.Errorf("auth: %w", err)
 	}
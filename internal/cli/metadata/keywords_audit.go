@@ -69,7 +69,7 @@ Examples:
 				return shared.UsageError("metadata keywords audit does not accept positional arguments")
 			}
 
-			resolvedAppID := shared.ResolveAppID(*appID)
+			resolvedAppID := shared.ResolveAppID(ctx, *appID)
 			if resolvedAppID == "" {
 				return shared.UsageError("--app is required (or set ASC_APP_ID)")
 			}
@@ -97,7 +97,7 @@ Examples:
 				return shared.UsageError(err.Error())
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("metadata keywords audit: %w", err)
 			}
@@ -161,7 +161,7 @@ Examples:
 				AppInfoLocalizations: mapAppInfoLocalizationsForAudit(appInfoItems),
 			}, *strict)
 
-			if err := shared.PrintOutputWithRenderers(
+			if err := shared.PrintOutputWithRenderers(ctx,
 				report,
 				*output.Output,
 				*output.Pretty,
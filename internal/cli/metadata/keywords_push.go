@@ -110,7 +110,7 @@ Examples:
 				return shared.UsageError(err.Error())
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("metadata keywords push: %w", err)
 			}
@@ -204,7 +204,7 @@ Examples:
 				summary.FailureArtifactPath = artifactPath
 			}
 
-			if err := shared.PrintOutputWithRenderers(
+			if err := shared.PrintOutputWithRenderers(ctx,
 				summary,
 				*output.Output,
 				*output.Pretty,
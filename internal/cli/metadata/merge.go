@@ -0,0 +1,379 @@
+package metadata
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+)
+
+// metadataLockFileName is the base snapshot written by metadata pull.
+const metadataLockFileName = ".asc-metadata-lock.json"
+
+// Merge strategies accepted by --strategy.
+const (
+	mergeStrategyFail   = "fail"
+	mergeStrategyOurs   = "ours"
+	mergeStrategyTheirs = "theirs"
+)
+
+// MetadataLock records the remote values seen by the last metadata pull so
+// push can tell local edits from remote edits. Scopes maps a snapshot prefix
+// such as "app-info", "version:1.2.3", or "categories" to field values keyed
+// by "<locale>:<field>", or by field alone for scopes without locales.
+type MetadataLock struct {
+	AppID  string                       `json:"appId"`
+	Scopes map[string]map[string]string `json:"scopes"`
+}
+
+// MergeItem is one field that changed remotely since the base snapshot.
+type MergeItem struct {
+	Key        string `json:"key"`
+	Scope      string `json:"scope"`
+	Locale     string `json:"locale,omitempty"`
+	Version    string `json:"version,omitempty"`
+	Field      string `json:"field"`
+	Base       string `json:"base,omitempty"`
+	Local      string `json:"local,omitempty"`
+	Remote     string `json:"remote,omitempty"`
+	Resolution string `json:"resolution,omitempty"`
+}
+
+// metadataSnapshot holds field values by snapshot prefix and field key.
+type metadataSnapshot map[string]map[string]string
+
+func snapshotPrefix(scope, version string) string {
+	if version == "" {
+		return scope
+	}
+	return scope + ":" + version
+}
+
+func snapshotField(locale, field string) string {
+	if locale == "" {
+		return field
+	}
+	return locale + ":" + field
+}
+
+// splitSnapshotPrefix reverses snapshotPrefix.
+func splitSnapshotPrefix(prefix string) (string, string) {
+	scope, version, _ := strings.Cut(prefix, ":")
+	return scope, version
+}
+
+// splitSnapshotField reverses snapshotField. Field names never contain a
+// colon, so a colon always separates the locale.
+func splitSnapshotField(key string) (string, string) {
+	if locale, field, ok := strings.Cut(key, ":"); ok {
+		return locale, field
+	}
+	return "", key
+}
+
+func (s metadataSnapshot) lookup(prefix, key string) (string, bool) {
+	value, ok := s[prefix][key]
+	return value, ok
+}
+
+// ensure returns the fields for prefix, creating an empty map if needed.
+func (s metadataSnapshot) ensure(prefix string) map[string]string {
+	fields, ok := s[prefix]
+	if !ok {
+		fields = make(map[string]string)
+		s[prefix] = fields
+	}
+	return fields
+}
+
+func (s metadataSnapshot) merge(other metadataSnapshot) {
+	for prefix, fields := range other {
+		target := s.ensure(prefix)
+		for key, value := range fields {
+			target[key] = value
+		}
+	}
+}
+
+// addLocaleFields records per-locale fields under prefix. The prefix is
+// recorded even when there are no locales.
+func (s metadataSnapshot) addLocaleFields(prefix string, values map[string]map[string]string) {
+	fields := s.ensure(prefix)
+	for locale, localeFields := range values {
+		for field, value := range localeFields {
+			fields[snapshotField(locale, field)] = value
+		}
+	}
+}
+
+// documentSnapshots builds the local and remote snapshots of a single-document
+// scope. A scope without a local file has no local snapshot.
+func documentSnapshots(prefix string, local, remote map[string]string) (metadataSnapshot, metadataSnapshot) {
+	localSnapshot := metadataSnapshot{}
+	if local != nil {
+		localSnapshot[prefix] = local
+	}
+	return localSnapshot, metadataSnapshot{prefix: remote}
+}
+
+// localizationSnapshots builds snapshots for the app-info and version
+// localization scopes.
+func localizationSnapshots(
+	version string,
+	localAppInfo map[string]appInfoLocalPatch,
+	localVersion map[string]versionLocalPatch,
+	remoteAppInfo map[string]AppInfoLocalization,
+	remoteVersion map[string]VersionLocalization,
+) (metadataSnapshot, metadataSnapshot) {
+	local := metadataSnapshot{}
+	appInfoFields := make(map[string]map[string]string, len(localAppInfo))
+	for locale, patch := range localAppInfo {
+		appInfoFields[locale] = patch.setFields
+	}
+	local.addLocaleFields(appInfoDirName, appInfoFields)
+	versionFields := make(map[string]map[string]string, len(localVersion))
+	for locale, patch := range localVersion {
+		versionFields[locale] = patch.setFields
+	}
+	local.addLocaleFields(snapshotPrefix(versionDirName, version), versionFields)
+
+	remote := metadataSnapshot{}
+	remote.addLocaleFields(appInfoDirName, appInfoToFieldMap(remoteAppInfo))
+	remote.addLocaleFields(snapshotPrefix(versionDirName, version), versionToFieldMap(remoteVersion))
+	return local, remote
+}
+
+// adoptRemoteField replaces one JSON field of the document pointed to by local
+// with the value from remote, or drops it when remote omits the field.
+func adoptRemoteField(local any, remote any, field string) {
+	if reflect.ValueOf(local).IsNil() {
+		return
+	}
+	localRaw := rawDocument(local)
+	remoteRaw := rawDocument(remote)
+	if value, ok := remoteRaw[field]; ok {
+		localRaw[field] = value
+	} else {
+		delete(localRaw, field)
+	}
+	data, err := json.Marshal(localRaw)
+	if err != nil {
+		return
+	}
+	reflect.ValueOf(local).Elem().SetZero()
+	_ = json.Unmarshal(data, local)
+}
+
+func rawDocument(doc any) map[string]json.RawMessage {
+	raw := make(map[string]json.RawMessage)
+	data, err := json.Marshal(doc)
+	if err != nil {
+		return raw
+	}
+	_ = json.Unmarshal(data, &raw)
+	return raw
+}
+
+func metadataLockPath(dir string) string {
+	return filepath.Join(dir, metadataLockFileName)
+}
+
+// readMetadataLock reads the lock file in dir. A missing lock returns nil
+// without an error.
+func readMetadataLock(dir string) (*MetadataLock, error) {
+	path := metadataLockPath(dir)
+	if _, err := os.Lstat(path); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	data, err := readFileNoFollow(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	var lock MetadataLock
+	if err := decodeStrictJSON(data, &lock); err != nil {
+		return nil, fmt.Errorf("invalid lock file %s: %w", path, err)
+	}
+	if lock.Scopes == nil {
+		lock.Scopes = make(map[string]map[string]string)
+	}
+	return &lock, nil
+}
+
+func writeMetadataLock(dir string, lock MetadataLock) (string, error) {
+	data, err := json.MarshalIndent(lock, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	path := metadataLockPath(dir)
+	if err := writeFileNoFollow(path, append(data, '\n')); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+// recordPulledSnapshot replaces the pulled prefixes of an existing lock for
+// the same app, or starts a new lock.
+func recordPulledSnapshot(existing *MetadataLock, appID string, remote metadataSnapshot) MetadataLock {
+	lock := MetadataLock{AppID: appID, Scopes: make(map[string]map[string]string)}
+	if existing != nil && existing.AppID == appID {
+		for prefix, fields := range existing.Scopes {
+			lock.Scopes[prefix] = fields
+		}
+	}
+	for prefix, fields := range remote {
+		lock.Scopes[prefix] = cloneStringMap(fields)
+	}
+	return lock
+}
+
+// advanceMetadataLock records the state after an apply as the new base: the
+// remote snapshot taken before apply, overlaid with the local values of every
+// field the plan changed.
+func advanceMetadataLock(lock *MetadataLock, local, remote metadataSnapshot, items []PlanItem) {
+	for prefix, fields := range remote {
+		if _, covered := lock.Scopes[prefix]; !covered {
+			continue
+		}
+		lock.Scopes[prefix] = cloneStringMap(fields)
+	}
+	for _, item := range items {
+		prefix := snapshotPrefix(item.Scope, item.Version)
+		base, covered := lock.Scopes[prefix]
+		if !covered {
+			continue
+		}
+		key := snapshotField(item.Locale, item.Field)
+		if value, ok := local.lookup(prefix, key); ok {
+			base[key] = value
+		} else {
+			delete(base, key)
+		}
+	}
+}
+
+func parseMergeStrategy(value string) (string, error) {
+	strategy := strings.ToLower(strings.TrimSpace(value))
+	switch strategy {
+	case "":
+		return mergeStrategyFail, nil
+	case mergeStrategyFail, mergeStrategyOurs, mergeStrategyTheirs:
+		return strategy, nil
+	default:
+		return "", fmt.Errorf("--strategy must be one of: %s, %s, %s", mergeStrategyOurs, mergeStrategyTheirs, mergeStrategyFail)
+	}
+}
+
+// mergePlan compares every field touched by the two-way plan with the lock.
+// Fields unchanged remotely keep the local change. Fields changed only
+// remotely are reported in remoteChanges and resolved to the remote value.
+// Fields changed on both sides are conflicts, resolved by strategy; the fail
+// strategy leaves them unresolved. Prefixes missing from the lock stay
+// two-way.
+func mergePlan(lock *MetadataLock, items []PlanItem, local, remote metadataSnapshot, strategy string) ([]MergeItem, []MergeItem) {
+	conflicts := make([]MergeItem, 0)
+	remoteChanges := make([]MergeItem, 0)
+	if lock == nil {
+		return conflicts, remoteChanges
+	}
+
+	seen := make(map[string]struct{}, len(items))
+	for _, item := range items {
+		prefix := snapshotPrefix(item.Scope, item.Version)
+		base, covered := lock.Scopes[prefix]
+		if !covered {
+			continue
+		}
+		key := snapshotField(item.Locale, item.Field)
+		if _, ok := seen[prefix+"\x00"+key]; ok {
+			continue
+		}
+		seen[prefix+"\x00"+key] = struct{}{}
+
+		baseValue, hasBase := base[key]
+		remoteValue, hasRemote := remote.lookup(prefix, key)
+		if hasBase == hasRemote && baseValue == remoteValue {
+			continue
+		}
+		localValue, hasLocal := local.lookup(prefix, key)
+		merged := MergeItem{
+			Key:     buildPlanKey(item.Scope, item.Version, item.Locale, item.Field),
+			Scope:   item.Scope,
+			Locale:  item.Locale,
+			Version: item.Version,
+			Field:   item.Field,
+			Base:    baseValue,
+			Local:   localValue,
+			Remote:  remoteValue,
+		}
+		if hasLocal == hasBase && localValue == baseValue {
+			merged.Resolution = mergeStrategyTheirs
+			remoteChanges = append(remoteChanges, merged)
+			continue
+		}
+		if strategy != mergeStrategyFail {
+			merged.Resolution = strategy
+		}
+		conflicts = append(conflicts, merged)
+	}
+	return conflicts, remoteChanges
+}
+
+// unresolvedConflictError reports conflicts left unresolved by the fail
+// strategy.
+func unresolvedConflictError(conflicts []MergeItem) error {
+	keys := make([]string, 0, len(conflicts))
+	for _, item := range conflicts {
+		if item.Resolution == "" {
+			keys = append(keys, item.Key)
+		}
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	return fmt.Errorf(
+		"%d field(s) changed both locally and remotely since last pull: %s (use --strategy ours or --strategy theirs, or pull again)",
+		len(keys),
+		strings.Join(keys, ", "),
+	)
+}
+
+// diffSnapshot lists fields whose remote value differs from the base.
+func diffSnapshot(base map[string]map[string]string, remote metadataSnapshot, prefixes []string) []MergeItem {
+	changes := make([]MergeItem, 0)
+	for _, prefix := range prefixes {
+		scope, version := splitSnapshotPrefix(prefix)
+		baseFields := base[prefix]
+		remoteFields := remote[prefix]
+		keys := make(map[string]struct{}, len(baseFields)+len(remoteFields))
+		for key := range baseFields {
+			keys[key] = struct{}{}
+		}
+		for key := range remoteFields {
+			keys[key] = struct{}{}
+		}
+		for _, key := range sortedKeys(keys) {
+			baseValue, hasBase := baseFields[key]
+			remoteValue, hasRemote := remoteFields[key]
+			if hasBase == hasRemote && baseValue == remoteValue {
+				continue
+			}
+			locale, field := splitSnapshotField(key)
+			changes = append(changes, MergeItem{
+				Key:     buildPlanKey(scope, version, locale, field),
+				Scope:   scope,
+				Locale:  locale,
+				Version: version,
+				Field:   field,
+				Base:    baseValue,
+				Remote:  remoteValue,
+			})
+		}
+	}
+	return changes
+}
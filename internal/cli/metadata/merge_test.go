@@ -0,0 +1,179 @@
+package metadata
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestMergePlanClassifiesFields(t *testing.T) {
+	lock := &MetadataLock{
+		AppID: "app-1",
+		Scopes: map[string]map[string]string{
+			"version:1.2.3": {
+				"en-US:description": "Base",
+				"en-US:keywords":    "one,two",
+				"en-US:whatsNew":    "Fixes",
+			},
+		},
+	}
+	local := metadataSnapshot{"version:1.2.3": {
+		"en-US:description": "Local",
+		"en-US:keywords":    "one,two",
+		"en-US:whatsNew":    "Local notes",
+	}}
+	remote := metadataSnapshot{"version:1.2.3": {
+		"en-US:description": "Base",
+		"en-US:keywords":    "one,two,three",
+		"en-US:whatsNew":    "Remote notes",
+	}}
+	items := []PlanItem{
+		{Scope: versionDirName, Version: "1.2.3", Locale: "en-US", Field: "description"},
+		{Scope: versionDirName, Version: "1.2.3", Locale: "en-US", Field: "keywords"},
+		{Scope: versionDirName, Version: "1.2.3", Locale: "en-US", Field: "whatsNew"},
+		{Scope: appInfoDirName, Locale: "en-US", Field: "name"},
+	}
+
+	conflicts, remoteChanges := mergePlan(lock, items, local, remote, mergeStrategyFail)
+	if len(remoteChanges) != 1 || remoteChanges[0].Field != "keywords" || remoteChanges[0].Resolution != mergeStrategyTheirs {
+		t.Fatalf("unexpected remote changes: %+v", remoteChanges)
+	}
+	if len(conflicts) != 1 || conflicts[0].Key != "version:1.2.3:en-US:whatsNew" || conflicts[0].Resolution != "" {
+		t.Fatalf("unexpected conflicts: %+v", conflicts)
+	}
+	if conflicts[0].Base != "Fixes" || conflicts[0].Local != "Local notes" || conflicts[0].Remote != "Remote notes" {
+		t.Fatalf("unexpected conflict values: %+v", conflicts[0])
+	}
+	if err := unresolvedConflictError(conflicts); err == nil || !strings.Contains(err.Error(), "version:1.2.3:en-US:whatsNew") {
+		t.Fatalf("expected unresolved conflict error, got %v", err)
+	}
+
+	conflicts, _ = mergePlan(lock, items, local, remote, mergeStrategyOurs)
+	if len(conflicts) != 1 || conflicts[0].Resolution != mergeStrategyOurs {
+		t.Fatalf("unexpected conflicts for ours: %+v", conflicts)
+	}
+	if err := unresolvedConflictError(conflicts); err != nil {
+		t.Fatalf("expected resolved conflicts, got %v", err)
+	}
+}
+
+func TestMergePlanWithoutLockIsTwoWay(t *testing.T) {
+	items := []PlanItem{{Scope: includeCategories, Field: "primaryCategory"}}
+	remote := metadataSnapshot{includeCategories: {"primaryCategory": "GAMES"}}
+
+	conflicts, remoteChanges := mergePlan(nil, items, metadataSnapshot{}, remote, mergeStrategyFail)
+	if len(conflicts)+len(remoteChanges) != 0 {
+		t.Fatalf("expected no merge items without lock, got %+v %+v", conflicts, remoteChanges)
+	}
+
+	lock := &MetadataLock{Scopes: map[string]map[string]string{"app-info": {}}}
+	conflicts, remoteChanges = mergePlan(lock, items, metadataSnapshot{}, remote, mergeStrategyFail)
+	if len(conflicts)+len(remoteChanges) != 0 {
+		t.Fatalf("expected scopes missing from lock to stay two-way, got %+v %+v", conflicts, remoteChanges)
+	}
+}
+
+func TestAdvanceMetadataLockOverlaysAppliedFields(t *testing.T) {
+	lock := &MetadataLock{Scopes: map[string]map[string]string{
+		"app-info":   {"en-US:name": "Old", "fr-FR:name": "Ancien"},
+		"categories": {"primaryCategory": "GAMES"},
+	}}
+	local := metadataSnapshot{"app-info": {"en-US:name": "New"}}
+	remote := metadataSnapshot{
+		"app-info":      {"en-US:name": "Old", "fr-FR:name": "Ancien", "de-DE:name": "Neu"},
+		"version:1.2.3": {"en-US:description": "Not locked"},
+	}
+	items := []PlanItem{
+		{Scope: appInfoDirName, Locale: "en-US", Field: "name"},
+		{Scope: appInfoDirName, Locale: "fr-FR", Field: "name"},
+	}
+
+	advanceMetadataLock(lock, local, remote, items)
+
+	want := map[string]map[string]string{
+		"app-info":   {"en-US:name": "New", "de-DE:name": "Neu"},
+		"categories": {"primaryCategory": "GAMES"},
+	}
+	if !reflect.DeepEqual(lock.Scopes, want) {
+		t.Fatalf("expected %v, got %v", want, lock.Scopes)
+	}
+}
+
+func TestKeepRemoteRevertsLocalValues(t *testing.T) {
+	categories := &categoriesScope{
+		local:  &Categories{PrimaryCategory: "GAMES", SecondaryCategory: "ENTERTAINMENT"},
+		remote: Categories{PrimaryCategory: "UTILITIES"},
+	}
+	categories.keepRemote("", "primaryCategory")
+	categories.keepRemote("", "secondaryCategory")
+	if *categories.local != (Categories{PrimaryCategory: "UTILITIES"}) {
+		t.Fatalf("unexpected categories: %+v", *categories.local)
+	}
+
+	availability := &availabilityScope{
+		local: &Availability{Territories: []string{"CAN", "USA"}},
+		remote: map[string]territoryAvailability{
+			"CAN": {id: "ta-can", available: false},
+			"GBR": {id: "ta-gbr", available: true},
+			"USA": {id: "ta-usa", available: true},
+		},
+	}
+	availability.keepRemote("", "territories.CAN")
+	availability.keepRemote("", "territories.GBR")
+	if !reflect.DeepEqual(availability.local.Territories, []string{"GBR", "USA"}) {
+		t.Fatalf("unexpected territories: %v", availability.local.Territories)
+	}
+
+	version := map[string]versionLocalPatch{
+		"en-US": {
+			localization: VersionLocalization{Description: "Local", WhatsNew: "Notes"},
+			setFields:    map[string]string{"description": "Local", "whatsNew": "Notes"},
+		},
+	}
+	keepRemoteVersionField(version, "en-US", "description")
+	keepRemoteVersionField(version, "fr-FR", "description")
+	if version["en-US"].localization.Description != "" || !reflect.DeepEqual(version["en-US"].setFields, map[string]string{"whatsNew": "Notes"}) {
+		t.Fatalf("unexpected en-US patch: %+v", version["en-US"])
+	}
+	if patch, ok := version["fr-FR"]; !ok || len(patch.setFields) != 0 {
+		t.Fatalf("expected empty fr-FR patch to keep the remote locale, got %+v", version)
+	}
+}
+
+func TestReviewInfoSnapshotsOmitDemoAccountPassword(t *testing.T) {
+	scope := &reviewInfoScope{
+		local:  &ReviewInformation{ContactEmail: "dev@example.com", DemoAccountPassword: "local"},
+		remote: ReviewInformation{ContactEmail: "dev@example.com", DemoAccountPassword: "remote"},
+	}
+	local, remote := scope.snapshots(scopeTarget{version: "1.2.3"})
+	for _, snapshot := range []metadataSnapshot{local, remote} {
+		fields := snapshot["review-info:1.2.3"]
+		if _, ok := fields["demoAccountPassword"]; ok || fields["contactEmail"] != "dev@example.com" {
+			t.Fatalf("unexpected review snapshot: %v", fields)
+		}
+	}
+}
+
+func TestResolveDriftScopesDefaultsToLockedScopes(t *testing.T) {
+	lock := &MetadataLock{Scopes: map[string]map[string]string{
+		"app-info":          {},
+		"version:1.2.3":     {},
+		"review-info:1.0.0": {},
+		"categories":        {},
+	}}
+
+	includes, prefixes, err := resolveDriftScopes(lock, "", "1.2.3")
+	if err != nil {
+		t.Fatalf("resolveDriftScopes() error: %v", err)
+	}
+	if !reflect.DeepEqual(includes, []string{includeCategories, includeLocalizations}) {
+		t.Fatalf("unexpected includes: %v", includes)
+	}
+	if !reflect.DeepEqual(prefixes, []string{"app-info", "categories", "version:1.2.3"}) {
+		t.Fatalf("unexpected prefixes: %v", prefixes)
+	}
+
+	if _, _, err := resolveDriftScopes(lock, "review-info", "1.2.3"); err == nil {
+		t.Fatal("expected error for scope missing from lock")
+	}
+}
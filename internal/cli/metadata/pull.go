@@ -28,6 +28,7 @@ type PullResult struct {
 	Locales   []string `json:"locales,omitempty"`
 	FileCount int      `json:"fileCount"`
 	Files     []string `json:"files"`
+	LockFile  string   `json:"lockFile,omitempty"`
 }
 
 // MetadataPullCommand returns the metadata pull subcommand.
@@ -49,13 +50,28 @@ func MetadataPullCommand() *ffcli.Command {
 		ShortHelp:  "Pull metadata from App Store Connect into canonical files.",
 		LongHelp: `Pull metadata from App Store Connect into canonical files.
 
-Phase 1 supports localization metadata for app-info and app-store versions.
+By default only localization metadata for app-info and app-store versions is
+pulled. Use --include to select additional scopes, or --include all:
+  localizations   app-info/<locale>.json, version/<version>/<locale>.json
+  categories      app/categories.json
+  age-rating      app/age-rating.json
+  review-info     review/<version>.json (demo account password is never written)
+  content-rights  app/content-rights.json
+  eula            app/eula.json
+  availability    app/availability.json
+  pricing         app/pricing.json
+  media           media/<version>/<locale>.json (manifests only; assets are not downloaded)
 
 Examples:
   asc metadata pull --app "APP_ID" --version "1.2.3" --dir "./metadata"
   asc metadata pull --app "APP_ID" --version "1.2.3" --platform IOS --dir "./metadata"
   asc metadata pull --app "APP_ID" --app-info "APP_INFO_ID" --version "1.2.3" --dir "./metadata"
-  asc metadata pull --app "APP_ID" --version "1.2.3" --dir "./metadata" --force`,
+  asc metadata pull --app "APP_ID" --version "1.2.3" --dir "./metadata" --include all
+  asc metadata pull --app "APP_ID" --version "1.2.3" --dir "./metadata" --force
+
+Pull also records the remote values it wrote in <dir>/.asc-metadata-lock.json.
+Push and apply use it as the base of a three-way merge, and "asc metadata
+drift" compares it with App Store Connect. Commit it with the metadata files.`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -63,7 +79,7 @@ Examples:
 				return shared.UsageError("metadata pull does not accept positional arguments")
 			}
 
-			resolvedAppID := shared.ResolveAppID(*appID)
+			resolvedAppID := shared.ResolveAppID(ctx, *appID)
 			if resolvedAppID == "" {
 				return shared.UsageError("--app is required (or set ASC_APP_ID)")
 			}
@@ -92,7 +108,7 @@ Examples:
 				return shared.UsageError(err.Error())
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("metadata pull: %w", err)
 			}
@@ -122,13 +138,20 @@ Examples:
 				return fmt.Errorf("metadata pull: %w", err)
 			}
 
-			appInfoItems, err := fetchAppInfoLocalizations(requestCtx, client, appInfoIDValue)
-			if err != nil {
-				return fmt.Errorf("metadata pull: %w", err)
+			includesLocalizations := hasInclude(includes, includeLocalizations)
+			var appInfoItems []asc.Resource[asc.AppInfoLocalizationAttributes]
+			if includesLocalizations {
+				appInfoItems, err = fetchAppInfoLocalizations(requestCtx, client, appInfoIDValue)
+				if err != nil {
+					return fmt.Errorf("metadata pull: %w", err)
+				}
 			}
-			versionItems, err := fetchVersionLocalizations(requestCtx, client, versionIDValue)
-			if err != nil {
-				return fmt.Errorf("metadata pull: %w", err)
+			var versionItems []asc.Resource[asc.AppStoreVersionLocalizationAttributes]
+			if includesLocalizations || hasInclude(includes, includeMedia) {
+				versionItems, err = fetchVersionLocalizations(requestCtx, client, versionIDValue)
+				if err != nil {
+					return fmt.Errorf("metadata pull: %w", err)
+				}
 			}
 
 			appInfoByLocale := make(map[string]AppInfoLocalization, len(appInfoItems))
@@ -149,20 +172,22 @@ Examples:
 			}
 
 			versionByLocale := make(map[string]VersionLocalization, len(versionItems))
-			for _, item := range versionItems {
-				locale := strings.TrimSpace(item.Attributes.Locale)
-				if locale == "" {
-					continue
+			if includesLocalizations {
+				for _, item := range versionItems {
+					locale := strings.TrimSpace(item.Attributes.Locale)
+					if locale == "" {
+						continue
+					}
+					versionByLocale[locale] = NormalizeVersionLocalization(VersionLocalization{
+						Description:     item.Attributes.Description,
+						Keywords:        item.Attributes.Keywords,
+						MarketingURL:    item.Attributes.MarketingURL,
+						PromotionalText: item.Attributes.PromotionalText,
+						SupportURL:      item.Attributes.SupportURL,
+						WhatsNew:        item.Attributes.WhatsNew,
+					})
+					localeSet[locale] = struct{}{}
 				}
-				versionByLocale[locale] = NormalizeVersionLocalization(VersionLocalization{
-					Description:     item.Attributes.Description,
-					Keywords:        item.Attributes.Keywords,
-					MarketingURL:    item.Attributes.MarketingURL,
-					PromotionalText: item.Attributes.PromotionalText,
-					SupportURL:      item.Attributes.SupportURL,
-					WhatsNew:        item.Attributes.WhatsNew,
-				})
-				localeSet[locale] = struct{}{}
 			}
 
 			plans, err := BuildWritePlans(
@@ -175,6 +200,26 @@ Examples:
 			if err != nil {
 				return fmt.Errorf("metadata pull: %w", err)
 			}
+
+			target := scopeTarget{
+				appID:                resolvedAppID,
+				appInfoID:            appInfoIDValue,
+				versionID:            versionIDValue,
+				version:              versionValue,
+				dir:                  dirValue,
+				versionLocalizations: versionLocalizationIDs(versionItems),
+			}
+			scopes := newMetadataScopes(includes)
+			if err := fetchScopes(requestCtx, client, scopes, target); err != nil {
+				return fmt.Errorf("metadata pull: %w", err)
+			}
+			for _, scope := range scopes {
+				scopePlans, err := scope.pullPlans(target)
+				if err != nil {
+					return fmt.Errorf("metadata pull: %w", err)
+				}
+				plans = append(plans, scopePlans...)
+			}
 			if !*force {
 				if err := ensureNoExistingPullTargets(plans); err != nil {
 					return err
@@ -184,10 +229,25 @@ Examples:
 				return fmt.Errorf("metadata pull: %w", err)
 			}
 
+			_, remoteSnapshot := scopeSnapshots(scopes, target)
+			if includesLocalizations {
+				_, localizationRemote := localizationSnapshots(versionValue, nil, nil, appInfoByLocale, versionByLocale)
+				remoteSnapshot.merge(localizationRemote)
+			}
+			existingLock, err := readMetadataLock(dirValue)
+			if err != nil {
+				return fmt.Errorf("metadata pull: %w", err)
+			}
+			lockPath, err := writeMetadataLock(dirValue, recordPulledSnapshot(existingLock, resolvedAppID, remoteSnapshot))
+			if err != nil {
+				return fmt.Errorf("metadata pull: %w", err)
+			}
+
 			files := make([]string, 0, len(plans))
 			for _, plan := range plans {
 				files = append(files, plan.Path)
 			}
+			sort.Strings(files)
 
 			locales := make([]string, 0, len(localeSet))
 			for locale := range localeSet {
@@ -205,9 +265,10 @@ Examples:
 				Locales:   locales,
 				FileCount: len(files),
 				Files:     files,
+				LockFile:  lockPath,
 			}
 
-			return shared.PrintOutputWithRenderers(
+			return shared.PrintOutputWithRenderers(ctx,
 				result,
 				*output.Output,
 				*output.Pretty,
@@ -238,8 +299,14 @@ func parseIncludes(value string) ([]string, error) {
 	unique := make(map[string]struct{})
 	for _, item := range includes {
 		normalized := strings.ToLower(strings.TrimSpace(item))
-		if normalized != includeLocalizations {
-			return nil, fmt.Errorf("--include supports only %q", includeLocalizations)
+		if normalized == includeAll {
+			for _, include := range supportedIncludes {
+				unique[include] = struct{}{}
+			}
+			continue
+		}
+		if !hasInclude(supportedIncludes, normalized) {
+			return nil, fmt.Errorf("--include supports: %s, %s", strings.Join(supportedIncludes, ", "), includeAll)
 		}
 		unique[normalized] = struct{}{}
 	}
@@ -345,7 +412,8 @@ func printPullResultTable(result PullResult) error {
 	fmt.Printf("Version: %s\n", result.Version)
 	fmt.Printf("Dir: %s\n", result.Dir)
 	fmt.Printf("Includes: %s\n", strings.Join(result.Includes, ","))
-	fmt.Printf("File Count: %d\n\n", result.FileCount)
+	fmt.Printf("File Count: %d\n", result.FileCount)
+	fmt.Printf("Lock File: %s\n\n", result.LockFile)
 
 	rows := make([][]string, 0, len(result.Files))
 	for _, file := range result.Files {
@@ -364,6 +432,7 @@ func printPullResultMarkdown(result PullResult) error {
 	fmt.Printf("**Dir:** %s\n\n", result.Dir)
 	fmt.Printf("**Includes:** %s\n\n", strings.Join(result.Includes, ","))
 	fmt.Printf("**File Count:** %d\n\n", result.FileCount)
+	fmt.Printf("**Lock File:** %s\n\n", result.LockFile)
 
 	rows := make([][]string, 0, len(result.Files))
 	for _, file := range result.Files {
@@ -39,7 +39,7 @@ var (
 type PlanItem struct {
 	Key     string `json:"key"`
 	Scope   string `json:"scope"`
-	Locale  string `json:"locale"`
+	Locale  string `json:"locale,omitempty"`
 	Version string `json:"version,omitempty"`
 	Field   string `json:"field"`
 	Reason  string `json:"reason"`
@@ -57,10 +57,11 @@ type PlanAPICall struct {
 // ApplyAction represents one executed mutation action.
 type ApplyAction struct {
 	Scope          string `json:"scope"`
-	Locale         string `json:"locale"`
+	Locale         string `json:"locale,omitempty"`
 	Version        string `json:"version,omitempty"`
 	Action         string `json:"action"`
 	LocalizationID string `json:"localizationId,omitempty"`
+	ResourceID     string `json:"resourceId,omitempty"`
 }
 
 // PushPlanResult is the push dry-run output artifact.
@@ -78,6 +79,10 @@ type PushPlanResult struct {
 	Deletes   []PlanItem    `json:"deletes"`
 	APICalls  []PlanAPICall `json:"apiCalls,omitempty"`
 	Actions   []ApplyAction `json:"actions,omitempty"`
+	// Conflicts and RemoteChanges are reported when a lock file from
+	// metadata pull is present.
+	Conflicts     []MergeItem `json:"conflicts,omitempty"`
+	RemoteChanges []MergeItem `json:"remoteChanges,omitempty"`
 }
 
 type scopeCallCounts struct {
@@ -91,6 +96,7 @@ type localMetadataBundle struct {
 	version        map[string]versionLocalPatch
 	defaultAppInfo *appInfoLocalPatch
 	defaultVersion *versionLocalPatch
+	files          int
 }
 
 type localPlanFields struct {
@@ -125,6 +131,7 @@ func newMetadataMutationCommand(cfg metadataMutationCommandConfig) *ffcli.Comman
 	dryRun := fs.Bool("dry-run", false, "Preview changes without mutating App Store Connect")
 	allowDeletes := fs.Bool("allow-deletes", false, "Allow destructive delete operations when applying changes (disables default locale fallback for missing locales)")
 	confirm := fs.Bool("confirm", false, "Confirm destructive operations (required with --allow-deletes)")
+	strategy := fs.String("strategy", mergeStrategyFail, "Conflict resolution when a field changed locally and remotely since pull: ours, theirs, or fail")
 	output := shared.BindOutputFlags(fs)
 
 	return &ffcli.Command{
@@ -140,17 +147,43 @@ Examples:
   asc metadata %s --app "APP_ID" --app-info "APP_INFO_ID" --version "1.2.3" --platform IOS --dir "./metadata" --dry-run
   asc metadata %s --app "APP_ID" --version "1.2.3" --dir "./metadata"
   asc metadata %s --app "APP_ID" --version "1.2.3" --dir "./metadata" --allow-deletes --confirm
+  asc metadata %s --app "APP_ID" --version "1.2.3" --dir "./metadata" --include all --dry-run
+  asc metadata %s --app "APP_ID" --version "1.2.3" --dir "./metadata" --strategy theirs
+
+Scopes (--include, default localizations; "all" selects every scope):
+  localizations, categories, age-rating, review-info, content-rights, eula,
+  availability, pricing, media. See "asc metadata pull --help" for file paths.
 
 Notes:
   - default.json fallback is applied only when --allow-deletes is not set.
   - with --allow-deletes, remote locales missing locally are planned as deletes.
-  - omitted fields are treated as no-op; they do not imply deletion.`,
+  - omitted fields are treated as no-op; they do not imply deletion.
+  - availability territories not listed locally are made unavailable; these
+    are deletes and require --allow-deletes --confirm.
+  - a pricing change creates a new price schedule starting today; replacing
+    an existing schedule drops other manual and future-dated prices, so it is
+    a delete and requires --allow-deletes --confirm.
+  - media assets are matched by file name; remote assets missing from a listed
+    set are deletes and require --allow-deletes --confirm.
+
+Three-way merge:
+  metadata pull records the remote values it wrote in .asc-metadata-lock.json.
+  When that file exists, each planned field is compared with it:
+  - changed only locally: pushed.
+  - changed only remotely: the remote value is kept (listed in remoteChanges).
+  - changed on both sides: a conflict, resolved by --strategy. "fail" (default)
+    refuses to apply, "ours" pushes the local value, "theirs" keeps the remote
+    value.
+  Apply updates the lock file. Scopes not recorded in the lock file are
+  compared two-way. Use "asc metadata drift" to check for remote changes.`,
 			cfg.verbTitle,
 			cfg.name,
 			cfg.name,
 			cfg.name,
 			cfg.name,
 			cfg.name,
+			cfg.name,
+			cfg.name,
 		),
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
@@ -169,11 +202,12 @@ Notes:
 				DryRun:       *dryRun,
 				AllowDeletes: *allowDeletes,
 				Confirm:      *confirm,
+				Strategy:     *strategy,
 			})
 			if err != nil {
 				return err
 			}
-			if err := shared.PrintOutputWithRenderers(
+			if err := shared.PrintOutputWithRenderers(ctx,
 				result,
 				*output.Output,
 				*output.Pretty,
@@ -276,14 +310,12 @@ func loadLocalMetadata(dir, version string) (localMetadataBundle, error) {
 		}
 	}
 
-	if filesSeen == 0 {
-		return localMetadataBundle{}, shared.UsageError("no metadata .json files found")
-	}
 	return localMetadataBundle{
 		appInfo:        localAppInfo,
 		version:        localVersion,
 		defaultAppInfo: defaultAppInfo,
 		defaultVersion: defaultVersion,
+		files:          filesSeen,
 	}, nil
 }
 
@@ -402,18 +434,7 @@ func readAppInfoLocalizationPatchFromFile(path string) (appInfoLocalPatch, error
 			return appInfoLocalPatch{}, err
 		}
 		setFields[canonicalKey] = value
-		switch canonicalKey {
-		case "name":
-			loc.Name = value
-		case "subtitle":
-			loc.Subtitle = value
-		case "privacyPolicyUrl":
-			loc.PrivacyPolicyURL = value
-		case "privacyChoicesUrl":
-			loc.PrivacyChoicesURL = value
-		case "privacyPolicyText":
-			loc.PrivacyPolicyText = value
-		}
+		setAppInfoLocalizationField(&loc, canonicalKey, value)
 	}
 
 	if len(setFields) == 0 {
@@ -452,20 +473,7 @@ func readVersionLocalizationPatchFromFile(path string) (versionLocalPatch, error
 			return versionLocalPatch{}, err
 		}
 		setFields[canonicalKey] = value
-		switch canonicalKey {
-		case "description":
-			loc.Description = value
-		case "keywords":
-			loc.Keywords = value
-		case "marketingUrl":
-			loc.MarketingURL = value
-		case "promotionalText":
-			loc.PromotionalText = value
-		case "supportUrl":
-			loc.SupportURL = value
-		case "whatsNew":
-			loc.WhatsNew = value
-		}
+		setVersionLocalizationField(&loc, canonicalKey, value)
 	}
 
 	if len(setFields) == 0 {
@@ -483,6 +491,65 @@ func readVersionLocalizationPatchFromFile(path string) (versionLocalPatch, error
 	}, nil
 }
 
+func setAppInfoLocalizationField(loc *AppInfoLocalization, field, value string) {
+	switch field {
+	case "name":
+		loc.Name = value
+	case "subtitle":
+		loc.Subtitle = value
+	case "privacyPolicyUrl":
+		loc.PrivacyPolicyURL = value
+	case "privacyChoicesUrl":
+		loc.PrivacyChoicesURL = value
+	case "privacyPolicyText":
+		loc.PrivacyPolicyText = value
+	}
+}
+
+func setVersionLocalizationField(loc *VersionLocalization, field, value string) {
+	switch field {
+	case "description":
+		loc.Description = value
+	case "keywords":
+		loc.Keywords = value
+	case "marketingUrl":
+		loc.MarketingURL = value
+	case "promotionalText":
+		loc.PromotionalText = value
+	case "supportUrl":
+		loc.SupportURL = value
+	case "whatsNew":
+		loc.WhatsNew = value
+	}
+}
+
+// keepRemoteAppInfoField drops a field from the local patch so the remote
+// value is left in place. A locale missing locally gets an empty patch so it
+// is not deleted.
+func keepRemoteAppInfoField(local map[string]appInfoLocalPatch, locale, field string) {
+	patch, ok := local[locale]
+	if !ok {
+		local[locale] = appInfoLocalPatch{setFields: map[string]string{}}
+		return
+	}
+	delete(patch.setFields, field)
+	setAppInfoLocalizationField(&patch.localization, field, "")
+	local[locale] = patch
+}
+
+// keepRemoteVersionField is keepRemoteAppInfoField for version localizations.
+func keepRemoteVersionField(local map[string]versionLocalPatch, locale, field string) {
+	patch, ok := local[locale]
+	if !ok {
+		local[locale] = versionLocalPatch{setFields: map[string]string{}}
+		return
+	}
+	delete(patch.setFields, field)
+	setVersionLocalizationField(&patch.localization, field, "")
+	setVersionLocalizationField(&patch.createLocalization, field, "")
+	local[locale] = patch
+}
+
 func canonicalStringFieldPatchKey(field string, allowed []string) (string, error) {
 	for _, key := range allowed {
 		if field == key {
@@ -911,6 +978,24 @@ func sortedLocaleUnion[T any](local map[string]T, remote map[string]remoteLocali
 	return locales
 }
 
+func remoteAppInfoItemsToMap(items []asc.Resource[asc.AppInfoLocalizationAttributes]) map[string]AppInfoLocalization {
+	result := make(map[string]AppInfoLocalization, len(items))
+	for _, item := range items {
+		locale := strings.TrimSpace(item.Attributes.Locale)
+		if locale == "" {
+			continue
+		}
+		result[locale] = NormalizeAppInfoLocalization(AppInfoLocalization{
+			Name:              item.Attributes.Name,
+			Subtitle:          item.Attributes.Subtitle,
+			PrivacyPolicyURL:  item.Attributes.PrivacyPolicyURL,
+			PrivacyChoicesURL: item.Attributes.PrivacyChoicesURL,
+			PrivacyPolicyText: item.Attributes.PrivacyPolicyText,
+		})
+	}
+	return result
+}
+
 func appInfoAttributes(locale string, loc AppInfoLocalization, includeLocale bool) asc.AppInfoLocalizationAttributes {
 	normalized := NormalizeAppInfoLocalization(loc)
 	attrs := asc.AppInfoLocalizationAttributes{
@@ -1112,6 +1197,15 @@ func buildPlanKey(scope, version, locale, field string) string {
 	if scope == appInfoDirName {
 		return fmt.Sprintf("%s:%s:%s", scope, locale, field)
 	}
+	if scope != versionDirName {
+		parts := []string{scope}
+		for _, part := range []string{version, locale} {
+			if part != "" {
+				parts = append(parts, part)
+			}
+		}
+		return strings.Join(append(parts, field), ":")
+	}
 	return fmt.Sprintf("%s:%s:%s:%s", scope, version, locale, field)
 }
 
@@ -1177,7 +1271,11 @@ func printPushPlanTable(result PushPlanResult) error {
 	}
 	if len(result.Actions) > 0 {
 		fmt.Println()
-		asc.RenderTable([]string{"scope", "locale", "version", "action", "localizationId"}, buildApplyActionRows(result.Actions))
+		asc.RenderTable([]string{"scope", "locale", "version", "action", "localizationId", "resourceId"}, buildApplyActionRows(result.Actions))
+	}
+	if len(result.Conflicts) > 0 || len(result.RemoteChanges) > 0 {
+		fmt.Println()
+		asc.RenderTable([]string{"merge", "key", "base", "local", "remote", "resolution"}, buildMergeRows(result))
 	}
 	return nil
 }
@@ -1201,7 +1299,11 @@ func printPushPlanMarkdown(result PushPlanResult) error {
 	}
 	if len(result.Actions) > 0 {
 		fmt.Println()
-		asc.RenderMarkdown([]string{"scope", "locale", "version", "action", "localizationId"}, buildApplyActionRows(result.Actions))
+		asc.RenderMarkdown([]string{"scope", "locale", "version", "action", "localizationId", "resourceId"}, buildApplyActionRows(result.Actions))
+	}
+	if len(result.Conflicts) > 0 || len(result.RemoteChanges) > 0 {
+		fmt.Println()
+		asc.RenderMarkdown([]string{"merge", "key", "base", "local", "remote", "resolution"}, buildMergeRows(result))
 	}
 	return nil
 }
@@ -1253,6 +1355,25 @@ func buildPlanRows(result PushPlanResult) [][]string {
 	return rows
 }
 
+func buildMergeRows(result PushPlanResult) [][]string {
+	rows := make([][]string, 0, len(result.Conflicts)+len(result.RemoteChanges))
+	appendRows := func(kind string, items []MergeItem) {
+		for _, item := range items {
+			rows = append(rows, []string{
+				kind,
+				item.Key,
+				sanitizePlanCell(item.Base),
+				sanitizePlanCell(item.Local),
+				sanitizePlanCell(item.Remote),
+				item.Resolution,
+			})
+		}
+	}
+	appendRows("conflict", result.Conflicts)
+	appendRows("remote change", result.RemoteChanges)
+	return rows
+}
+
 func buildAPICallRows(calls []PlanAPICall) [][]string {
 	rows := make([][]string, 0, len(calls))
 	for _, call := range calls {
@@ -1270,6 +1391,7 @@ func buildApplyActionRows(actions []ApplyAction) [][]string {
 			action.Version,
 			action.Action,
 			action.LocalizationID,
+			action.ResourceID,
 		})
 	}
 	return rows
@@ -0,0 +1,317 @@
+package metadata
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// Include scopes reconciled alongside localizations.
+const (
+	includeCategories    = "categories"
+	includeAgeRating     = "age-rating"
+	includeReviewInfo    = "review-info"
+	includeContentRights = "content-rights"
+	includeEULA          = "eula"
+	includeAvailability  = "availability"
+	includePricing       = "pricing"
+	includeMedia         = "media"
+	includeAll           = "all"
+)
+
+const (
+	appDirName    = "app"
+	reviewDirName = "review"
+	mediaDirName  = "media"
+)
+
+// supportedIncludes lists every include scope accepted by --include.
+var supportedIncludes = []string{
+	includeLocalizations,
+	includeCategories,
+	includeAgeRating,
+	includeReviewInfo,
+	includeContentRights,
+	includeEULA,
+	includeAvailability,
+	includePricing,
+	includeMedia,
+}
+
+func hasInclude(includes []string, name string) bool {
+	for _, include := range includes {
+		if include == name {
+			return true
+		}
+	}
+	return false
+}
+
+// scopeTarget identifies the remote resources a scope reads and writes.
+type scopeTarget struct {
+	appID     string
+	appInfoID string
+	versionID string
+	version   string
+	dir       string
+	// versionLocalizations maps locale to version localization ID.
+	versionLocalizations map[string]string
+}
+
+// scopePlan is one scope's contribution to a push plan.
+type scopePlan struct {
+	adds    []PlanItem
+	updates []PlanItem
+	deletes []PlanItem
+	calls   []PlanAPICall
+}
+
+func (p *scopePlan) append(other scopePlan) {
+	p.adds = append(p.adds, other.adds...)
+	p.updates = append(p.updates, other.updates...)
+	p.deletes = append(p.deletes, other.deletes...)
+	p.calls = append(p.calls, other.calls...)
+}
+
+// items returns every planned change.
+func (p scopePlan) items() []PlanItem {
+	items := make([]PlanItem, 0, len(p.adds)+len(p.updates)+len(p.deletes))
+	items = append(items, p.adds...)
+	items = append(items, p.updates...)
+	return append(items, p.deletes...)
+}
+
+// metadataScope reconciles one non-localization include scope. A scope loads
+// its local files, fetches the matching remote state, and then plans, applies,
+// or renders pull files from the two.
+type metadataScope interface {
+	name() string
+	// loadLocal reads the scope's files and returns how many were found.
+	loadLocal(dir, version string) (int, error)
+	fetchRemote(ctx context.Context, client *asc.Client, target scopeTarget) error
+	plan(target scopeTarget) scopePlan
+	apply(ctx context.Context, client *asc.Client, target scopeTarget) ([]ApplyAction, error)
+	pullPlans(target scopeTarget) ([]WritePlan, error)
+	// snapshots return the local and remote field values compared with the
+	// lock file during a three-way merge.
+	snapshots(target scopeTarget) (metadataSnapshot, metadataSnapshot)
+	// keepRemote reverts one local field so apply leaves the remote value.
+	keepRemote(locale, field string)
+}
+
+// newMetadataScopes returns the selected scopes in include order, skipping
+// localizations which have their own pipeline.
+func newMetadataScopes(includes []string) []metadataScope {
+	scopes := make([]metadataScope, 0, len(includes))
+	for _, include := range supportedIncludes {
+		if !hasInclude(includes, include) {
+			continue
+		}
+		switch include {
+		case includeCategories:
+			scopes = append(scopes, &categoriesScope{})
+		case includeAgeRating:
+			scopes = append(scopes, &ageRatingScope{})
+		case includeReviewInfo:
+			scopes = append(scopes, &reviewInfoScope{})
+		case includeContentRights:
+			scopes = append(scopes, &contentRightsScope{})
+		case includeEULA:
+			scopes = append(scopes, &eulaScope{})
+		case includeAvailability:
+			scopes = append(scopes, &availabilityScope{})
+		case includePricing:
+			scopes = append(scopes, &pricingScope{})
+		case includeMedia:
+			scopes = append(scopes, &mediaScope{})
+		}
+	}
+	return scopes
+}
+
+// appDocumentPath returns the path of an app-level scope file.
+func appDocumentPath(dir, fileName string) string {
+	return filepath.Join(dir, appDirName, fileName)
+}
+
+// reviewDocumentPath returns the path of the review information file for a version.
+func reviewDocumentPath(dir, version string) (string, error) {
+	resolvedVersion, err := validatePathSegment("version", version)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, reviewDirName, resolvedVersion+".json"), nil
+}
+
+// readScopeDocument strictly decodes a scope file into target. A missing file
+// reports false without an error.
+func readScopeDocument(path string, target any) (bool, error) {
+	if _, err := os.Lstat(path); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	data, err := readFileNoFollow(path)
+	if err != nil {
+		return false, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	if err := decodeStrictJSON(data, target); err != nil {
+		return false, shared.UsageErrorf("invalid metadata schema in %s: %v", path, err)
+	}
+	return true, nil
+}
+
+func scopeDocumentWritePlan(path string, doc any) (WritePlan, error) {
+	contents, err := encodeCanonicalJSON(doc)
+	if err != nil {
+		return WritePlan{}, err
+	}
+	return WritePlan{Path: path, Contents: contents}, nil
+}
+
+// documentFields flattens a scope document into plan fields. Strings keep
+// their value, string lists are joined with commas, and other values use
+// their compact JSON form. Omitted fields are absent from the result.
+func documentFields(doc any) map[string]string {
+	data, _ := json.Marshal(doc)
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return map[string]string{}
+	}
+
+	fields := make(map[string]string, len(raw))
+	for key, value := range raw {
+		var text string
+		if err := json.Unmarshal(value, &text); err == nil {
+			fields[key] = text
+			continue
+		}
+		var list []string
+		if err := json.Unmarshal(value, &list); err == nil {
+			fields[key] = strings.Join(list, ",")
+			continue
+		}
+		fields[key] = string(value)
+	}
+	return fields
+}
+
+// diffScopeFields plans every locally set field that is missing or different
+// remotely. Fields omitted locally are left untouched.
+func diffScopeFields(scope, version, locale string, local, remote map[string]string) ([]PlanItem, []PlanItem) {
+	adds := make([]PlanItem, 0)
+	updates := make([]PlanItem, 0)
+	for _, field := range sortedKeys(local) {
+		value := local[field]
+		remoteValue, exists := remote[field]
+		switch {
+		case !exists:
+			adds = append(adds, PlanItem{
+				Key:     buildPlanKey(scope, version, locale, field),
+				Scope:   scope,
+				Locale:  locale,
+				Version: version,
+				Field:   field,
+				Reason:  "field exists locally but not remotely",
+				To:      value,
+			})
+		case remoteValue != value:
+			updates = append(updates, PlanItem{
+				Key:     buildPlanKey(scope, version, locale, field),
+				Scope:   scope,
+				Locale:  locale,
+				Version: version,
+				Field:   field,
+				Reason:  "field value differs",
+				From:    remoteValue,
+				To:      value,
+			})
+		}
+	}
+	return adds, updates
+}
+
+// documentScopePlan plans a single-document scope that is reconciled with one
+// API call.
+func documentScopePlan(scope, version, operation string, local, remote map[string]string) scopePlan {
+	if local == nil {
+		return scopePlan{}
+	}
+	adds, updates := diffScopeFields(scope, version, "", local, remote)
+	plan := scopePlan{adds: adds, updates: updates}
+	if len(adds) > 0 || len(updates) > 0 {
+		plan.calls = []PlanAPICall{{Operation: operation, Scope: scope, Count: 1}}
+	}
+	return plan
+}
+
+func documentChanged(local, remote map[string]string) bool {
+	for field, value := range local {
+		if remoteValue, exists := remote[field]; !exists || remoteValue != value {
+			return true
+		}
+	}
+	return false
+}
+
+func sortAPICalls(calls []PlanAPICall) {
+	sort.Slice(calls, func(i, j int) bool {
+		if calls[i].Scope == calls[j].Scope {
+			return calls[i].Operation < calls[j].Operation
+		}
+		return calls[i].Scope < calls[j].Scope
+	})
+}
+
+func loadScopes(scopes []metadataScope, dir, version string) (int, error) {
+	filesSeen := 0
+	for _, scope := range scopes {
+		count, err := scope.loadLocal(dir, version)
+		if err != nil {
+			return 0, err
+		}
+		filesSeen += count
+	}
+	return filesSeen, nil
+}
+
+func fetchScopes(ctx context.Context, client *asc.Client, scopes []metadataScope, target scopeTarget) error {
+	for _, scope := range scopes {
+		if err := scope.fetchRemote(ctx, client, target); err != nil {
+			return fmt.Errorf("%s: %w", scope.name(), err)
+		}
+	}
+	return nil
+}
+
+func scopeSnapshots(scopes []metadataScope, target scopeTarget) (metadataSnapshot, metadataSnapshot) {
+	local := metadataSnapshot{}
+	remote := metadataSnapshot{}
+	for _, scope := range scopes {
+		scopeLocal, scopeRemote := scope.snapshots(target)
+		local.merge(scopeLocal)
+		remote.merge(scopeRemote)
+	}
+	return local, remote
+}
+
+func versionLocalizationIDs(items []asc.Resource[asc.AppStoreVersionLocalizationAttributes]) map[string]string {
+	ids := make(map[string]string, len(items))
+	for _, item := range items {
+		locale := strings.TrimSpace(item.Attributes.Locale)
+		if locale == "" {
+			continue
+		}
+		ids[locale] = item.ID
+	}
+	return ids
+}
@@ -0,0 +1,621 @@
+package metadata
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/ascterritory"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const (
+	categoriesFileName    = "categories.json"
+	ageRatingFileName     = "age-rating.json"
+	contentRightsFileName = "content-rights.json"
+	eulaFileName          = "eula.json"
+)
+
+// Categories is the canonical app/categories.json schema. Values are App Store
+// category IDs such as GAMES or GAMES_ACTION.
+type Categories struct {
+	PrimaryCategory         string `json:"primaryCategory,omitempty"`
+	PrimarySubcategoryOne   string `json:"primarySubcategoryOne,omitempty"`
+	PrimarySubcategoryTwo   string `json:"primarySubcategoryTwo,omitempty"`
+	SecondaryCategory       string `json:"secondaryCategory,omitempty"`
+	SecondarySubcategoryOne string `json:"secondarySubcategoryOne,omitempty"`
+	SecondarySubcategoryTwo string `json:"secondarySubcategoryTwo,omitempty"`
+}
+
+// NormalizeCategories trims and upper-cases category IDs.
+func NormalizeCategories(doc Categories) Categories {
+	normalize := func(value string) string {
+		return strings.ToUpper(strings.TrimSpace(value))
+	}
+	return Categories{
+		PrimaryCategory:         normalize(doc.PrimaryCategory),
+		PrimarySubcategoryOne:   normalize(doc.PrimarySubcategoryOne),
+		PrimarySubcategoryTwo:   normalize(doc.PrimarySubcategoryTwo),
+		SecondaryCategory:       normalize(doc.SecondaryCategory),
+		SecondarySubcategoryOne: normalize(doc.SecondarySubcategoryOne),
+		SecondarySubcategoryTwo: normalize(doc.SecondarySubcategoryTwo),
+	}
+}
+
+type categoriesScope struct {
+	local  *Categories
+	remote Categories
+}
+
+func (s *categoriesScope) name() string { return includeCategories }
+
+func (s *categoriesScope) loadLocal(dir, _ string) (int, error) {
+	var doc Categories
+	found, err := readScopeDocument(appDocumentPath(dir, categoriesFileName), &doc)
+	if err != nil || !found {
+		return 0, err
+	}
+	doc = NormalizeCategories(doc)
+	s.local = &doc
+	return 1, nil
+}
+
+func (s *categoriesScope) fetchRemote(ctx context.Context, client *asc.Client, target scopeTarget) error {
+	getters := []struct {
+		value *string
+		get   func(context.Context, string) (*asc.AppCategoryResponse, error)
+	}{
+		{&s.remote.PrimaryCategory, client.GetAppInfoPrimaryCategory},
+		{&s.remote.PrimarySubcategoryOne, client.GetAppInfoPrimarySubcategoryOne},
+		{&s.remote.PrimarySubcategoryTwo, client.GetAppInfoPrimarySubcategoryTwo},
+		{&s.remote.SecondaryCategory, client.GetAppInfoSecondaryCategory},
+		{&s.remote.SecondarySubcategoryOne, client.GetAppInfoSecondarySubcategoryOne},
+		{&s.remote.SecondarySubcategoryTwo, client.GetAppInfoSecondarySubcategoryTwo},
+	}
+	for _, getter := range getters {
+		resp, err := getter.get(ctx, target.appInfoID)
+		if err != nil {
+			if asc.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+		*getter.value = strings.ToUpper(strings.TrimSpace(resp.Data.ID))
+	}
+	return nil
+}
+
+func (s *categoriesScope) localFields() map[string]string {
+	if s.local == nil {
+		return nil
+	}
+	return documentFields(s.local)
+}
+
+func (s *categoriesScope) plan(_ scopeTarget) scopePlan {
+	return documentScopePlan(includeCategories, "", "update_app_info_categories", s.localFields(), documentFields(s.remote))
+}
+
+func (s *categoriesScope) snapshots(_ scopeTarget) (metadataSnapshot, metadataSnapshot) {
+	return documentSnapshots(includeCategories, s.localFields(), documentFields(s.remote))
+}
+
+func (s *categoriesScope) keepRemote(_, field string) {
+	adoptRemoteField(s.local, s.remote, field)
+}
+
+func (s *categoriesScope) apply(ctx context.Context, client *asc.Client, target scopeTarget) ([]ApplyAction, error) {
+	if !documentChanged(s.localFields(), documentFields(s.remote)) {
+		return nil, nil
+	}
+	if _, err := client.UpdateAppInfoCategories(
+		ctx,
+		target.appInfoID,
+		s.local.PrimaryCategory,
+		s.local.SecondaryCategory,
+		s.local.PrimarySubcategoryOne,
+		s.local.PrimarySubcategoryTwo,
+		s.local.SecondarySubcategoryOne,
+		s.local.SecondarySubcategoryTwo,
+	); err != nil {
+		return nil, fmt.Errorf("update app info categories: %w", err)
+	}
+	return []ApplyAction{{Scope: includeCategories, Action: "update", ResourceID: target.appInfoID}}, nil
+}
+
+func (s *categoriesScope) pullPlans(target scopeTarget) ([]WritePlan, error) {
+	if s.remote == (Categories{}) {
+		return nil, nil
+	}
+	plan, err := scopeDocumentWritePlan(appDocumentPath(target.dir, categoriesFileName), s.remote)
+	if err != nil {
+		return nil, err
+	}
+	return []WritePlan{plan}, nil
+}
+
+// The app/age-rating.json schema reuses the age rating declaration attributes
+// from the App Store Connect API. The deprecated seventeenPlus field is not
+// accepted; use ageRatingOverride instead.
+type ageRatingScope struct {
+	local    *asc.AgeRatingDeclarationAttributes
+	remoteID string
+	remote   asc.AgeRatingDeclarationAttributes
+}
+
+func (s *ageRatingScope) name() string { return includeAgeRating }
+
+func (s *ageRatingScope) loadLocal(dir, _ string) (int, error) {
+	path := appDocumentPath(dir, ageRatingFileName)
+	var doc asc.AgeRatingDeclarationAttributes
+	found, err := readScopeDocument(path, &doc)
+	if err != nil || !found {
+		return 0, err
+	}
+	if doc.SeventeenPlus != nil {
+		return 0, shared.UsageErrorf("invalid metadata schema in %s: seventeenPlus is deprecated; use ageRatingOverride", path)
+	}
+	s.local = &doc
+	return 1, nil
+}
+
+func (s *ageRatingScope) fetchRemote(ctx context.Context, client *asc.Client, target scopeTarget) error {
+	resp, err := client.GetAgeRatingDeclarationForAppInfo(ctx, target.appInfoID)
+	if err != nil {
+		if asc.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	s.remoteID = strings.TrimSpace(resp.Data.ID)
+	s.remote = resp.Data.Attributes
+	s.remote.SeventeenPlus = nil
+	return nil
+}
+
+func (s *ageRatingScope) localFields() map[string]string {
+	if s.local == nil {
+		return nil
+	}
+	return documentFields(s.local)
+}
+
+func (s *ageRatingScope) plan(_ scopeTarget) scopePlan {
+	return documentScopePlan(includeAgeRating, "", "update_age_rating_declaration", s.localFields(), documentFields(s.remote))
+}
+
+func (s *ageRatingScope) snapshots(_ scopeTarget) (metadataSnapshot, metadataSnapshot) {
+	return documentSnapshots(includeAgeRating, s.localFields(), documentFields(s.remote))
+}
+
+func (s *ageRatingScope) keepRemote(_, field string) {
+	adoptRemoteField(s.local, s.remote, field)
+}
+
+func (s *ageRatingScope) apply(ctx context.Context, client *asc.Client, _ scopeTarget) ([]ApplyAction, error) {
+	if !documentChanged(s.localFields(), documentFields(s.remote)) {
+		return nil, nil
+	}
+	if s.remoteID == "" {
+		return nil, fmt.Errorf("age rating declaration not found")
+	}
+	if _, err := client.UpdateAgeRatingDeclaration(ctx, s.remoteID, *s.local); err != nil {
+		return nil, fmt.Errorf("update age rating declaration: %w", err)
+	}
+	return []ApplyAction{{Scope: includeAgeRating, Action: "update", ResourceID: s.remoteID}}, nil
+}
+
+func (s *ageRatingScope) pullPlans(target scopeTarget) ([]WritePlan, error) {
+	if s.remoteID == "" {
+		return nil, nil
+	}
+	plan, err := scopeDocumentWritePlan(appDocumentPath(target.dir, ageRatingFileName), s.remote)
+	if err != nil {
+		return nil, err
+	}
+	return []WritePlan{plan}, nil
+}
+
+// ContentRights is the canonical app/content-rights.json schema.
+type ContentRights struct {
+	ContentRightsDeclaration string `json:"contentRightsDeclaration,omitempty"`
+}
+
+type contentRightsScope struct {
+	local  *ContentRights
+	remote ContentRights
+}
+
+func (s *contentRightsScope) name() string { return includeContentRights }
+
+func (s *contentRightsScope) loadLocal(dir, _ string) (int, error) {
+	path := appDocumentPath(dir, contentRightsFileName)
+	var doc ContentRights
+	found, err := readScopeDocument(path, &doc)
+	if err != nil || !found {
+		return 0, err
+	}
+	doc.ContentRightsDeclaration = strings.ToUpper(strings.TrimSpace(doc.ContentRightsDeclaration))
+	switch asc.ContentRightsDeclaration(doc.ContentRightsDeclaration) {
+	case "", asc.ContentRightsDeclarationDoesNotUseThirdPartyContent, asc.ContentRightsDeclarationUsesThirdPartyContent:
+	default:
+		return 0, shared.UsageErrorf(
+			"invalid metadata schema in %s: contentRightsDeclaration must be %s or %s",
+			path,
+			asc.ContentRightsDeclarationDoesNotUseThirdPartyContent,
+			asc.ContentRightsDeclarationUsesThirdPartyContent,
+		)
+	}
+	s.local = &doc
+	return 1, nil
+}
+
+func (s *contentRightsScope) fetchRemote(ctx context.Context, client *asc.Client, target scopeTarget) error {
+	resp, err := client.GetApp(ctx, target.appID)
+	if err != nil {
+		return err
+	}
+	if value := resp.Data.Attributes.ContentRightsDeclaration; value != nil {
+		s.remote.ContentRightsDeclaration = string(*value)
+	}
+	return nil
+}
+
+func (s *contentRightsScope) localFields() map[string]string {
+	if s.local == nil {
+		return nil
+	}
+	return documentFields(s.local)
+}
+
+func (s *contentRightsScope) plan(_ scopeTarget) scopePlan {
+	return documentScopePlan(includeContentRights, "", "update_app", s.localFields(), documentFields(s.remote))
+}
+
+func (s *contentRightsScope) snapshots(_ scopeTarget) (metadataSnapshot, metadataSnapshot) {
+	return documentSnapshots(includeContentRights, s.localFields(), documentFields(s.remote))
+}
+
+func (s *contentRightsScope) keepRemote(_, field string) {
+	adoptRemoteField(s.local, s.remote, field)
+}
+
+func (s *contentRightsScope) apply(ctx context.Context, client *asc.Client, target scopeTarget) ([]ApplyAction, error) {
+	if !documentChanged(s.localFields(), documentFields(s.remote)) {
+		return nil, nil
+	}
+	declaration := asc.ContentRightsDeclaration(s.local.ContentRightsDeclaration)
+	if _, err := client.UpdateApp(ctx, target.appID, asc.AppUpdateAttributes{ContentRightsDeclaration: &declaration}); err != nil {
+		return nil, fmt.Errorf("update content rights declaration: %w", err)
+	}
+	return []ApplyAction{{Scope: includeContentRights, Action: "update", ResourceID: target.appID}}, nil
+}
+
+func (s *contentRightsScope) pullPlans(target scopeTarget) ([]WritePlan, error) {
+	if s.remote.ContentRightsDeclaration == "" {
+		return nil, nil
+	}
+	plan, err := scopeDocumentWritePlan(appDocumentPath(target.dir, contentRightsFileName), s.remote)
+	if err != nil {
+		return nil, err
+	}
+	return []WritePlan{plan}, nil
+}
+
+// EULA is the canonical app/eula.json schema for a custom end user license
+// agreement. Territories are territory IDs such as USA or GBR.
+type EULA struct {
+	AgreementText string   `json:"agreementText,omitempty"`
+	Territories   []string `json:"territories,omitempty"`
+}
+
+type eulaScope struct {
+	local    *EULA
+	remoteID string
+	remote   EULA
+}
+
+func (s *eulaScope) name() string { return includeEULA }
+
+func (s *eulaScope) loadLocal(dir, _ string) (int, error) {
+	path := appDocumentPath(dir, eulaFileName)
+	var doc EULA
+	found, err := readScopeDocument(path, &doc)
+	if err != nil || !found {
+		return 0, err
+	}
+	territories, err := normalizeTerritoryList(doc.Territories)
+	if err != nil {
+		return 0, shared.UsageErrorf("invalid metadata schema in %s: %v", path, err)
+	}
+	doc.Territories = territories
+	s.local = &doc
+	return 1, nil
+}
+
+func (s *eulaScope) fetchRemote(ctx context.Context, client *asc.Client, target scopeTarget) error {
+	resp, err := client.GetEndUserLicenseAgreementForApp(ctx, target.appID)
+	if err != nil {
+		if asc.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	s.remoteID = strings.TrimSpace(resp.Data.ID)
+	if s.remoteID == "" {
+		return nil
+	}
+	s.remote.AgreementText = resp.Data.Attributes.AgreementText
+
+	firstPage, err := client.GetEndUserLicenseAgreementTerritories(ctx, s.remoteID, asc.WithEndUserLicenseAgreementTerritoriesLimit(200))
+	if err != nil {
+		return err
+	}
+	paginated, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetEndUserLicenseAgreementTerritories(ctx, s.remoteID, asc.WithEndUserLicenseAgreementTerritoriesNextURL(nextURL))
+	})
+	if err != nil {
+		return err
+	}
+	territories, ok := paginated.(*asc.TerritoriesResponse)
+	if !ok {
+		return fmt.Errorf("unexpected EULA territories response")
+	}
+	ids := make([]string, 0, len(territories.Data))
+	for _, item := range territories.Data {
+		ids = append(ids, strings.ToUpper(strings.TrimSpace(item.ID)))
+	}
+	sort.Strings(ids)
+	s.remote.Territories = ids
+	return nil
+}
+
+func (s *eulaScope) localFields() map[string]string {
+	if s.local == nil {
+		return nil
+	}
+	return documentFields(s.local)
+}
+
+func (s *eulaScope) plan(_ scopeTarget) scopePlan {
+	operation := "update_eula"
+	if s.remoteID == "" {
+		operation = "create_eula"
+	}
+	return documentScopePlan(includeEULA, "", operation, s.localFields(), documentFields(s.remote))
+}
+
+func (s *eulaScope) snapshots(_ scopeTarget) (metadataSnapshot, metadataSnapshot) {
+	return documentSnapshots(includeEULA, s.localFields(), documentFields(s.remote))
+}
+
+func (s *eulaScope) keepRemote(_, field string) {
+	adoptRemoteField(s.local, s.remote, field)
+}
+
+func (s *eulaScope) apply(ctx context.Context, client *asc.Client, target scopeTarget) ([]ApplyAction, error) {
+	if !documentChanged(s.localFields(), documentFields(s.remote)) {
+		return nil, nil
+	}
+	if s.remoteID == "" {
+		if strings.TrimSpace(s.local.AgreementText) == "" || len(s.local.Territories) == 0 {
+			return nil, fmt.Errorf("agreementText and territories are required to create an EULA")
+		}
+		resp, err := client.CreateEndUserLicenseAgreement(ctx, target.appID, s.local.AgreementText, s.local.Territories)
+		if err != nil {
+			return nil, fmt.Errorf("create EULA: %w", err)
+		}
+		return []ApplyAction{{Scope: includeEULA, Action: "create", ResourceID: resp.Data.ID}}, nil
+	}
+
+	var text *string
+	if s.local.AgreementText != "" {
+		text = &s.local.AgreementText
+	}
+	if _, err := client.UpdateEndUserLicenseAgreement(ctx, s.remoteID, text, s.local.Territories); err != nil {
+		return nil, fmt.Errorf("update EULA: %w", err)
+	}
+	return []ApplyAction{{Scope: includeEULA, Action: "update", ResourceID: s.remoteID}}, nil
+}
+
+func (s *eulaScope) pullPlans(target scopeTarget) ([]WritePlan, error) {
+	if s.remoteID == "" {
+		return nil, nil
+	}
+	plan, err := scopeDocumentWritePlan(appDocumentPath(target.dir, eulaFileName), s.remote)
+	if err != nil {
+		return nil, err
+	}
+	return []WritePlan{plan}, nil
+}
+
+// ReviewInformation is the canonical review/<version>.json schema for App
+// Review contact and demo account details. Pull never writes the demo account
+// password.
+type ReviewInformation struct {
+	ContactFirstName    string `json:"contactFirstName,omitempty"`
+	ContactLastName     string `json:"contactLastName,omitempty"`
+	ContactPhone        string `json:"contactPhone,omitempty"`
+	ContactEmail        string `json:"contactEmail,omitempty"`
+	DemoAccountName     string `json:"demoAccountName,omitempty"`
+	DemoAccountPassword string `json:"demoAccountPassword,omitempty"`
+	DemoAccountRequired *bool  `json:"demoAccountRequired,omitempty"`
+	Notes               string `json:"notes,omitempty"`
+}
+
+type reviewInfoScope struct {
+	local    *ReviewInformation
+	remoteID string
+	remote   ReviewInformation
+}
+
+func (s *reviewInfoScope) name() string { return includeReviewInfo }
+
+func (s *reviewInfoScope) loadLocal(dir, version string) (int, error) {
+	path, err := reviewDocumentPath(dir, version)
+	if err != nil {
+		return 0, shared.UsageError(err.Error())
+	}
+	var doc ReviewInformation
+	found, err := readScopeDocument(path, &doc)
+	if err != nil || !found {
+		return 0, err
+	}
+	s.local = &doc
+	return 1, nil
+}
+
+func (s *reviewInfoScope) fetchRemote(ctx context.Context, client *asc.Client, target scopeTarget) error {
+	resp, err := client.GetAppStoreReviewDetailForVersion(ctx, target.versionID)
+	if err != nil {
+		if asc.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	s.remoteID = strings.TrimSpace(resp.Data.ID)
+	attrs := resp.Data.Attributes
+	demoAccountRequired := attrs.DemoAccountRequired
+	s.remote = ReviewInformation{
+		ContactFirstName:    attrs.ContactFirstName,
+		ContactLastName:     attrs.ContactLastName,
+		ContactPhone:        attrs.ContactPhone,
+		ContactEmail:        attrs.ContactEmail,
+		DemoAccountName:     attrs.DemoAccountName,
+		DemoAccountPassword: attrs.DemoAccountPassword,
+		DemoAccountRequired: &demoAccountRequired,
+		Notes:               attrs.Notes,
+	}
+	return nil
+}
+
+func (s *reviewInfoScope) localFields() map[string]string {
+	if s.local == nil {
+		return nil
+	}
+	return documentFields(s.local)
+}
+
+func (s *reviewInfoScope) plan(target scopeTarget) scopePlan {
+	operation := "update_review_detail"
+	if s.remoteID == "" {
+		operation = "create_review_detail"
+	}
+	plan := documentScopePlan(includeReviewInfo, target.version, operation, s.localFields(), documentFields(s.remote))
+	// Still plan password changes, but keep the values out of plan output.
+	for _, items := range [][]PlanItem{plan.adds, plan.updates} {
+		for i := range items {
+			if items[i].Field == "demoAccountPassword" {
+				items[i].From = redactedPlanValue(items[i].From)
+				items[i].To = redactedPlanValue(items[i].To)
+			}
+		}
+	}
+	return plan
+}
+
+func redactedPlanValue(value string) string {
+	if value == "" {
+		return ""
+	}
+	return "***"
+}
+
+// snapshots leave out the demo account password so it never reaches the lock
+// file.
+func (s *reviewInfoScope) snapshots(target scopeTarget) (metadataSnapshot, metadataSnapshot) {
+	local := s.localFields()
+	delete(local, "demoAccountPassword")
+	remote := documentFields(s.remote)
+	delete(remote, "demoAccountPassword")
+	return documentSnapshots(snapshotPrefix(includeReviewInfo, target.version), local, remote)
+}
+
+func (s *reviewInfoScope) keepRemote(_, field string) {
+	adoptRemoteField(s.local, s.remote, field)
+}
+
+func (s *reviewInfoScope) apply(ctx context.Context, client *asc.Client, target scopeTarget) ([]ApplyAction, error) {
+	if !documentChanged(s.localFields(), documentFields(s.remote)) {
+		return nil, nil
+	}
+	local := s.local
+	if s.remoteID == "" {
+		resp, err := client.CreateAppStoreReviewDetail(ctx, target.versionID, &asc.AppStoreReviewDetailCreateAttributes{
+			ContactFirstName:    optionalString(local.ContactFirstName),
+			ContactLastName:     optionalString(local.ContactLastName),
+			ContactPhone:        optionalString(local.ContactPhone),
+			ContactEmail:        optionalString(local.ContactEmail),
+			DemoAccountName:     optionalString(local.DemoAccountName),
+			DemoAccountPassword: optionalString(local.DemoAccountPassword),
+			DemoAccountRequired: local.DemoAccountRequired,
+			Notes:               optionalString(local.Notes),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("create review detail: %w", err)
+		}
+		return []ApplyAction{{Scope: includeReviewInfo, Version: target.version, Action: "create", ResourceID: resp.Data.ID}}, nil
+	}
+
+	if _, err := client.UpdateAppStoreReviewDetail(ctx, s.remoteID, asc.AppStoreReviewDetailUpdateAttributes{
+		ContactFirstName:    optionalString(local.ContactFirstName),
+		ContactLastName:     optionalString(local.ContactLastName),
+		ContactPhone:        optionalString(local.ContactPhone),
+		ContactEmail:        optionalString(local.ContactEmail),
+		DemoAccountName:     optionalString(local.DemoAccountName),
+		DemoAccountPassword: optionalString(local.DemoAccountPassword),
+		DemoAccountRequired: local.DemoAccountRequired,
+		Notes:               optionalString(local.Notes),
+	}); err != nil {
+		return nil, fmt.Errorf("update review detail: %w", err)
+	}
+	return []ApplyAction{{Scope: includeReviewInfo, Version: target.version, Action: "update", ResourceID: s.remoteID}}, nil
+}
+
+func (s *reviewInfoScope) pullPlans(target scopeTarget) ([]WritePlan, error) {
+	if s.remoteID == "" {
+		return nil, nil
+	}
+	path, err := reviewDocumentPath(target.dir, target.version)
+	if err != nil {
+		return nil, err
+	}
+	doc := s.remote
+	doc.DemoAccountPassword = ""
+	plan, err := scopeDocumentWritePlan(path, doc)
+	if err != nil {
+		return nil, err
+	}
+	return []WritePlan{plan}, nil
+}
+
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+// normalizeTerritoryList returns sorted, deduplicated territory IDs.
+func normalizeTerritoryList(values []string) ([]string, error) {
+	normalized, err := ascterritory.NormalizeMany(values)
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[string]struct{}, len(normalized))
+	result := make([]string, 0, len(normalized))
+	for _, value := range normalized {
+		if _, exists := seen[value]; exists {
+			continue
+		}
+		seen[value] = struct{}{}
+		result = append(result, value)
+	}
+	sort.Strings(result)
+	if len(result) == 0 {
+		return nil, nil
+	}
+	return result, nil
+}
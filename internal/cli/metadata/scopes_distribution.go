@@ -0,0 +1,434 @@
+package metadata
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/ascterritory"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/pricing"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const (
+	availabilityFileName = "availability.json"
+	pricingFileName      = "pricing.json"
+
+	availableInNewTerritoriesField = "availableInNewTerritories"
+	territoryFieldPrefix           = "territories."
+)
+
+// Availability is the canonical app/availability.json schema. Territories
+// lists every territory the app is available in; territories missing from the
+// list are made unavailable, which push treats as a delete.
+type Availability struct {
+	AvailableInNewTerritories *bool    `json:"availableInNewTerritories,omitempty"`
+	Territories               []string `json:"territories,omitempty"`
+}
+
+type territoryAvailability struct {
+	id        string
+	available bool
+}
+
+type availabilityScope struct {
+	local                           *Availability
+	remoteFound                     bool
+	remoteAvailableInNewTerritories bool
+	remote                          map[string]territoryAvailability
+}
+
+func (s *availabilityScope) name() string { return includeAvailability }
+
+func (s *availabilityScope) loadLocal(dir, _ string) (int, error) {
+	path := appDocumentPath(dir, availabilityFileName)
+	var doc Availability
+	found, err := readScopeDocument(path, &doc)
+	if err != nil || !found {
+		return 0, err
+	}
+	territories, err := normalizeTerritoryList(doc.Territories)
+	if err != nil {
+		return 0, shared.UsageErrorf("invalid metadata schema in %s: %v", path, err)
+	}
+	doc.Territories = territories
+	s.local = &doc
+	return 1, nil
+}
+
+func (s *availabilityScope) fetchRemote(ctx context.Context, client *asc.Client, target scopeTarget) error {
+	resp, err := client.GetAppAvailabilityV2(ctx, target.appID)
+	if err != nil {
+		if shared.IsAppAvailabilityMissing(err) {
+			return nil
+		}
+		return err
+	}
+	availabilityID := strings.TrimSpace(resp.Data.ID)
+	if availabilityID == "" {
+		return fmt.Errorf("app availability ID missing from response")
+	}
+	s.remoteFound = true
+	s.remoteAvailableInNewTerritories = resp.Data.Attributes.AvailableInNewTerritories
+
+	firstPage, err := client.GetTerritoryAvailabilities(ctx, availabilityID, asc.WithTerritoryAvailabilitiesLimit(200))
+	if err != nil {
+		return err
+	}
+	paginated, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetTerritoryAvailabilities(ctx, availabilityID, asc.WithTerritoryAvailabilitiesNextURL(nextURL))
+	})
+	if err != nil {
+		return err
+	}
+	territoryResp, ok := paginated.(*asc.TerritoryAvailabilitiesResponse)
+	if !ok {
+		return fmt.Errorf("unexpected territory availabilities response")
+	}
+	ids, err := shared.MapTerritoryAvailabilityIDs(territoryResp)
+	if err != nil {
+		return err
+	}
+	availableByID := make(map[string]bool, len(territoryResp.Data))
+	for _, item := range territoryResp.Data {
+		availableByID[item.ID] = item.Attributes.Available
+	}
+	s.remote = make(map[string]territoryAvailability, len(ids))
+	for territory, id := range ids {
+		s.remote[territory] = territoryAvailability{id: id, available: availableByID[id]}
+	}
+	return nil
+}
+
+// localFields expands the territory list into one field per known territory,
+// so the plan shows exactly which territories change.
+func (s *availabilityScope) localFields() map[string]string {
+	if s.local == nil {
+		return nil
+	}
+	fields := make(map[string]string)
+	if s.local.AvailableInNewTerritories != nil {
+		fields[availableInNewTerritoriesField] = strconv.FormatBool(*s.local.AvailableInNewTerritories)
+	}
+	if len(s.local.Territories) == 0 {
+		return fields
+	}
+	listed := make(map[string]struct{}, len(s.local.Territories))
+	for _, territory := range s.local.Territories {
+		listed[territory] = struct{}{}
+		fields[territoryFieldPrefix+territory] = "true"
+	}
+	for territory := range s.remote {
+		if _, ok := listed[territory]; !ok {
+			fields[territoryFieldPrefix+territory] = "false"
+		}
+	}
+	return fields
+}
+
+func (s *availabilityScope) remoteFields() map[string]string {
+	fields := make(map[string]string, len(s.remote)+1)
+	if !s.remoteFound {
+		return fields
+	}
+	fields[availableInNewTerritoriesField] = strconv.FormatBool(s.remoteAvailableInNewTerritories)
+	for territory, item := range s.remote {
+		fields[territoryFieldPrefix+territory] = strconv.FormatBool(item.available)
+	}
+	return fields
+}
+
+func (s *availabilityScope) plan(_ scopeTarget) scopePlan {
+	adds, changes := diffScopeFields(includeAvailability, "", "", s.localFields(), s.remoteFields())
+	plan := scopePlan{adds: adds, updates: make([]PlanItem, 0), deletes: make([]PlanItem, 0)}
+	count := 0
+	for _, item := range adds {
+		if strings.HasPrefix(item.Field, territoryFieldPrefix) {
+			count++
+		}
+	}
+	// Removing a territory the app is available in is destructive, so it is
+	// planned as a delete and gated by --allow-deletes --confirm.
+	for _, item := range changes {
+		if !strings.HasPrefix(item.Field, territoryFieldPrefix) {
+			plan.updates = append(plan.updates, item)
+			continue
+		}
+		count++
+		if item.To == "false" {
+			item.Reason = "territory missing locally"
+			plan.deletes = append(plan.deletes, item)
+			continue
+		}
+		plan.updates = append(plan.updates, item)
+	}
+	if count > 0 {
+		plan.calls = []PlanAPICall{{Operation: "update_territory_availability", Scope: includeAvailability, Count: count}}
+	}
+	return plan
+}
+
+func (s *availabilityScope) snapshots(_ scopeTarget) (metadataSnapshot, metadataSnapshot) {
+	return documentSnapshots(includeAvailability, s.localFields(), s.remoteFields())
+}
+
+// keepRemote lists or unlists a territory to match its remote availability.
+func (s *availabilityScope) keepRemote(_, field string) {
+	if s.local == nil {
+		return
+	}
+	if field == availableInNewTerritoriesField {
+		s.local.AvailableInNewTerritories = nil
+		return
+	}
+	territory, ok := strings.CutPrefix(field, territoryFieldPrefix)
+	if !ok {
+		return
+	}
+	territories := make([]string, 0, len(s.local.Territories)+1)
+	for _, value := range s.local.Territories {
+		if value != territory {
+			territories = append(territories, value)
+		}
+	}
+	if s.remote[territory].available {
+		territories = append(territories, territory)
+		sort.Strings(territories)
+	}
+	s.local.Territories = territories
+}
+
+func (s *availabilityScope) apply(ctx context.Context, client *asc.Client, target scopeTarget) ([]ApplyAction, error) {
+	local := s.localFields()
+	remote := s.remoteFields()
+	if !documentChanged(local, remote) {
+		return nil, nil
+	}
+	if !s.remoteFound {
+		return nil, fmt.Errorf("app availability not found for app %q; set up availability before applying territories", target.appID)
+	}
+	if s.local.AvailableInNewTerritories != nil && *s.local.AvailableInNewTerritories != s.remoteAvailableInNewTerritories {
+		return nil, fmt.Errorf(
+			"cannot change availableInNewTerritories for an existing app availability (current value: %t)",
+			s.remoteAvailableInNewTerritories,
+		)
+	}
+
+	unknown := make([]string, 0)
+	for _, field := range sortedKeys(local) {
+		territory, ok := strings.CutPrefix(field, territoryFieldPrefix)
+		if ok {
+			if _, exists := s.remote[territory]; !exists {
+				unknown = append(unknown, territory)
+			}
+		}
+	}
+	if len(unknown) > 0 {
+		return nil, fmt.Errorf("territory availability not found for territories: %s", strings.Join(unknown, ", "))
+	}
+
+	actions := make([]ApplyAction, 0)
+	for _, field := range sortedKeys(local) {
+		territory, ok := strings.CutPrefix(field, territoryFieldPrefix)
+		if !ok || local[field] == remote[field] {
+			continue
+		}
+		available := local[field] == "true"
+		id := s.remote[territory].id
+		if _, err := client.UpdateTerritoryAvailability(ctx, id, asc.TerritoryAvailabilityUpdateAttributes{Available: &available}); err != nil {
+			return nil, fmt.Errorf("update territory availability %s: %w", territory, err)
+		}
+		actions = append(actions, ApplyAction{Scope: includeAvailability, Action: "update", ResourceID: id})
+	}
+	return actions, nil
+}
+
+func (s *availabilityScope) pullPlans(target scopeTarget) ([]WritePlan, error) {
+	if !s.remoteFound {
+		return nil, nil
+	}
+	territories := make([]string, 0, len(s.remote))
+	for territory, item := range s.remote {
+		if item.available {
+			territories = append(territories, territory)
+		}
+	}
+	sort.Strings(territories)
+	availableInNewTerritories := s.remoteAvailableInNewTerritories
+	plan, err := scopeDocumentWritePlan(appDocumentPath(target.dir, availabilityFileName), Availability{
+		AvailableInNewTerritories: &availableInNewTerritories,
+		Territories:               territories,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return []WritePlan{plan}, nil
+}
+
+// Pricing is the canonical app/pricing.json schema. Price is the customer
+// price in the base territory currency; 0 makes the app free. Applying a
+// change replaces the manual price schedule starting today, and other
+// territories follow Apple's automatic equalization. Push treats replacing an
+// existing schedule as a delete.
+type Pricing struct {
+	BaseTerritory string `json:"baseTerritory,omitempty"`
+	Price         string `json:"price,omitempty"`
+}
+
+type pricingScope struct {
+	local  *Pricing
+	remote Pricing
+}
+
+func (s *pricingScope) name() string { return includePricing }
+
+func (s *pricingScope) loadLocal(dir, _ string) (int, error) {
+	path := appDocumentPath(dir, pricingFileName)
+	var doc Pricing
+	found, err := readScopeDocument(path, &doc)
+	if err != nil || !found {
+		return 0, err
+	}
+	if strings.TrimSpace(doc.BaseTerritory) != "" {
+		territory, err := ascterritory.Normalize(doc.BaseTerritory)
+		if err != nil {
+			return 0, shared.UsageErrorf("invalid metadata schema in %s: %v", path, err)
+		}
+		doc.BaseTerritory = territory
+	}
+	if strings.TrimSpace(doc.Price) != "" {
+		price, err := normalizePrice(doc.Price)
+		if err != nil {
+			return 0, shared.UsageErrorf("invalid metadata schema in %s: %v", path, err)
+		}
+		doc.Price = price
+	}
+	s.local = &doc
+	return 1, nil
+}
+
+func (s *pricingScope) fetchRemote(ctx context.Context, client *asc.Client, target scopeTarget) error {
+	current, err := pricing.ResolveCurrentAppPrice(ctx, client, target.appID)
+	if err != nil {
+		if asc.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	s.remote.BaseTerritory = current.BaseTerritory
+	if price, err := normalizePrice(current.CustomerPrice); err == nil {
+		s.remote.Price = price
+	} else {
+		s.remote.Price = current.CustomerPrice
+	}
+	return nil
+}
+
+func (s *pricingScope) localFields() map[string]string {
+	if s.local == nil {
+		return nil
+	}
+	return documentFields(s.local)
+}
+
+// plan reports a change to an existing price as a delete: the new schedule
+// replaces every manual price, including other territories and future-dated
+// changes, so it is gated by --allow-deletes --confirm.
+func (s *pricingScope) plan(_ scopeTarget) scopePlan {
+	plan := documentScopePlan(includePricing, "", "create_price_schedule", s.localFields(), documentFields(s.remote))
+	if s.remote == (Pricing{}) {
+		return plan
+	}
+	deletes := make([]PlanItem, 0, len(plan.adds)+len(plan.updates))
+	for _, item := range append(plan.adds, plan.updates...) {
+		item.Reason = "replaces the manual price schedule"
+		deletes = append(deletes, item)
+	}
+	plan.adds, plan.updates, plan.deletes = nil, nil, deletes
+	return plan
+}
+
+func (s *pricingScope) snapshots(_ scopeTarget) (metadataSnapshot, metadataSnapshot) {
+	return documentSnapshots(includePricing, s.localFields(), documentFields(s.remote))
+}
+
+func (s *pricingScope) keepRemote(_, field string) {
+	adoptRemoteField(s.local, s.remote, field)
+}
+
+func (s *pricingScope) apply(ctx context.Context, client *asc.Client, target scopeTarget) ([]ApplyAction, error) {
+	if !documentChanged(s.localFields(), documentFields(s.remote)) {
+		return nil, nil
+	}
+	baseTerritory := s.local.BaseTerritory
+	if baseTerritory == "" {
+		baseTerritory = s.remote.BaseTerritory
+	}
+	if baseTerritory == "" {
+		return nil, fmt.Errorf("baseTerritory is required to create a price schedule")
+	}
+	price := s.local.Price
+	if price == "" {
+		price = s.remote.Price
+	}
+	if price == "" {
+		return nil, fmt.Errorf("price is required to create a price schedule")
+	}
+
+	var pricePointID string
+	if value, _ := strconv.ParseFloat(price, 64); value == 0 {
+		id, err := shared.ResolveFreeAppPricePoint(ctx, client, target.appID, baseTerritory)
+		if err != nil {
+			return nil, fmt.Errorf("resolve free price point: %w", err)
+		}
+		pricePointID = id
+	} else {
+		tiers, err := shared.ResolveTiers(ctx, client, target.appID, baseTerritory, false)
+		if err != nil {
+			return nil, fmt.Errorf("resolve price points: %w", err)
+		}
+		id, err := shared.ResolvePricePointByPrice(tiers, price)
+		if err != nil {
+			return nil, err
+		}
+		pricePointID = id
+	}
+
+	resp, err := client.CreateAppPriceSchedule(ctx, target.appID, asc.AppPriceScheduleCreateAttributes{
+		PricePointID:    pricePointID,
+		StartDate:       time.Now().UTC().Format("2006-01-02"),
+		BaseTerritoryID: baseTerritory,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create price schedule: %w", err)
+	}
+	return []ApplyAction{{Scope: includePricing, Action: "create", ResourceID: resp.Data.ID}}, nil
+}
+
+func (s *pricingScope) pullPlans(target scopeTarget) ([]WritePlan, error) {
+	if s.remote == (Pricing{}) {
+		return nil, nil
+	}
+	plan, err := scopeDocumentWritePlan(appDocumentPath(target.dir, pricingFileName), s.remote)
+	if err != nil {
+		return nil, err
+	}
+	return []WritePlan{plan}, nil
+}
+
+// normalizePrice returns the shortest decimal form of a non-negative price.
+func normalizePrice(value string) (string, error) {
+	parsed, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	if err != nil || math.IsNaN(parsed) || math.IsInf(parsed, 0) {
+		return "", fmt.Errorf("invalid price %q", value)
+	}
+	if parsed < 0 {
+		return "", fmt.Errorf("price must be non-negative")
+	}
+	return strconv.FormatFloat(parsed, 'f', -1, 64), nil
+}
@@ -0,0 +1,549 @@
+package metadata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/assets"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const (
+	mediaScreenshots = "screenshots"
+	mediaPreviews    = "previews"
+)
+
+// MediaManifest is the canonical media/<version>/<locale>.json schema. Keys
+// are screenshot display types and preview types; values list asset files in
+// display order, relative to media/<version>/. Assets are matched to App Store
+// Connect by file name.
+type MediaManifest struct {
+	Screenshots map[string][]string `json:"screenshots,omitempty"`
+	Previews    map[string][]string `json:"previews,omitempty"`
+}
+
+type mediaFile struct {
+	path string
+	name string
+}
+
+type mediaAsset struct {
+	id   string
+	name string
+}
+
+type mediaSet struct {
+	id     string
+	assets []mediaAsset
+}
+
+// mediaSetChange describes how one local set differs from its remote set.
+type mediaSetChange struct {
+	locale  string
+	kind    string
+	setType string
+	setID   string
+	files   []mediaFile
+	remote  []mediaAsset
+	uploads []mediaFile
+	deletes []mediaAsset
+	reorder bool
+}
+
+type mediaScope struct {
+	// local and remote are keyed by locale, media kind, and set type.
+	local  map[string]map[string]map[string][]mediaFile
+	remote map[string]map[string]map[string]mediaSet
+}
+
+func (s *mediaScope) name() string { return includeMedia }
+
+func mediaDir(dir, version string) (string, error) {
+	resolvedVersion, err := validatePathSegment("version", version)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, mediaDirName, resolvedVersion), nil
+}
+
+func (s *mediaScope) loadLocal(dir, version string) (int, error) {
+	s.local = make(map[string]map[string]map[string][]mediaFile)
+	root, err := mediaDir(dir, version)
+	if err != nil {
+		return 0, shared.UsageError(err.Error())
+	}
+	entries, err := os.ReadDir(root)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("failed to read %s: %w", root, err)
+	}
+
+	filesSeen := 0
+	seenLocales := make(map[string]string)
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".json" {
+			continue
+		}
+		locale, localeErr := validateLocale(strings.TrimSuffix(entry.Name(), ".json"))
+		if localeErr != nil {
+			return 0, shared.UsageErrorf("invalid media manifest file %q: %v", entry.Name(), localeErr)
+		}
+		if locale == DefaultLocale {
+			return 0, shared.UsageErrorf("invalid media manifest file %q: %s is not supported for media", entry.Name(), DefaultLocale)
+		}
+		if err := recordCanonicalLocaleFile(seenLocales, locale, entry.Name()); err != nil {
+			return 0, shared.UsageError(err.Error())
+		}
+
+		path := filepath.Join(root, entry.Name())
+		var manifest MediaManifest
+		if _, err := readScopeDocument(path, &manifest); err != nil {
+			return 0, err
+		}
+		sets, err := resolveMediaManifest(root, manifest)
+		if err != nil {
+			return 0, shared.UsageErrorf("invalid metadata schema in %s: %v", path, err)
+		}
+		s.local[locale] = sets
+		filesSeen++
+	}
+	return filesSeen, nil
+}
+
+// resolveMediaManifest validates a manifest and resolves its files against root.
+func resolveMediaManifest(root string, manifest MediaManifest) (map[string]map[string][]mediaFile, error) {
+	sets := map[string]map[string][]mediaFile{
+		mediaScreenshots: {},
+		mediaPreviews:    {},
+	}
+	kinds := []struct {
+		kind      string
+		entries   map[string][]string
+		normalize func(string) (string, error)
+	}{
+		{mediaScreenshots, manifest.Screenshots, assets.NormalizeScreenshotDisplayType},
+		{mediaPreviews, manifest.Previews, assets.NormalizePreviewType},
+	}
+	for _, kind := range kinds {
+		for _, rawType := range sortedKeys(kind.entries) {
+			setType, err := kind.normalize(rawType)
+			if err != nil {
+				return nil, err
+			}
+			if _, exists := sets[kind.kind][setType]; exists {
+				return nil, fmt.Errorf("duplicate %s set %q", kind.kind, setType)
+			}
+			files := make([]mediaFile, 0, len(kind.entries[rawType]))
+			names := make(map[string]struct{}, len(kind.entries[rawType]))
+			for _, entry := range kind.entries[rawType] {
+				file, err := resolveMediaFile(root, entry)
+				if err != nil {
+					return nil, err
+				}
+				if _, exists := names[file.name]; exists {
+					return nil, fmt.Errorf("duplicate file name %q in %s set %q", file.name, kind.kind, setType)
+				}
+				names[file.name] = struct{}{}
+				files = append(files, file)
+			}
+			sets[kind.kind][setType] = files
+		}
+	}
+	return sets, nil
+}
+
+func resolveMediaFile(root, entry string) (mediaFile, error) {
+	trimmed := strings.TrimSpace(entry)
+	if trimmed == "" {
+		return mediaFile{}, fmt.Errorf("media file path is required")
+	}
+	cleaned := filepath.Clean(filepath.FromSlash(trimmed))
+	if filepath.IsAbs(cleaned) || cleaned == ".." || strings.HasPrefix(cleaned, ".."+string(filepath.Separator)) {
+		return mediaFile{}, fmt.Errorf("media file path %q must stay inside the media directory", entry)
+	}
+	return mediaFile{path: filepath.Join(root, cleaned), name: filepath.Base(cleaned)}, nil
+}
+
+func (s *mediaScope) fetchRemote(ctx context.Context, client *asc.Client, target scopeTarget) error {
+	s.remote = make(map[string]map[string]map[string]mediaSet)
+	for _, locale := range sortedKeys(target.versionLocalizations) {
+		localizationID := target.versionLocalizations[locale]
+		if localizationID == "" {
+			continue
+		}
+		sets, err := fetchMediaSets(ctx, client, localizationID)
+		if err != nil {
+			return fmt.Errorf("%s: %w", locale, err)
+		}
+		s.remote[locale] = sets
+	}
+	return nil
+}
+
+func fetchMediaSets(ctx context.Context, client *asc.Client, localizationID string) (map[string]map[string]mediaSet, error) {
+	sets := map[string]map[string]mediaSet{
+		mediaScreenshots: {},
+		mediaPreviews:    {},
+	}
+
+	screenshotSets, err := client.GetAppScreenshotSets(ctx, localizationID)
+	if err != nil {
+		return nil, err
+	}
+	for _, set := range screenshotSets.Data {
+		resp, err := client.GetAppScreenshots(ctx, set.ID)
+		if err != nil {
+			return nil, err
+		}
+		items := make([]mediaAsset, 0, len(resp.Data))
+		for _, item := range resp.Data {
+			items = append(items, mediaAsset{id: item.ID, name: item.Attributes.FileName})
+		}
+		sets[mediaScreenshots][set.Attributes.ScreenshotDisplayType] = mediaSet{id: set.ID, assets: items}
+	}
+
+	previewSets, err := client.GetAppPreviewSets(ctx, localizationID)
+	if err != nil {
+		return nil, err
+	}
+	for _, set := range previewSets.Data {
+		resp, err := client.GetAppPreviews(ctx, set.ID)
+		if err != nil {
+			return nil, err
+		}
+		items := make([]mediaAsset, 0, len(resp.Data))
+		for _, item := range resp.Data {
+			items = append(items, mediaAsset{id: item.ID, name: item.Attributes.FileName})
+		}
+		sets[mediaPreviews][set.Attributes.PreviewType] = mediaSet{id: set.ID, assets: items}
+	}
+	return sets, nil
+}
+
+// changes compares every locally listed set with its remote counterpart.
+// Sets not listed locally are left untouched.
+func (s *mediaScope) changes() []mediaSetChange {
+	changes := make([]mediaSetChange, 0)
+	for _, locale := range sortedKeys(s.local) {
+		for _, kind := range []string{mediaScreenshots, mediaPreviews} {
+			for _, setType := range sortedKeys(s.local[locale][kind]) {
+				files := s.local[locale][kind][setType]
+				remote := s.remote[locale][kind][setType]
+				change := mediaSetChange{
+					locale:  locale,
+					kind:    kind,
+					setType: setType,
+					setID:   remote.id,
+					files:   files,
+					remote:  remote.assets,
+				}
+
+				localNames := make(map[string]struct{}, len(files))
+				for _, file := range files {
+					localNames[file.name] = struct{}{}
+				}
+				remoteNames := make(map[string]struct{}, len(remote.assets))
+				kept := make([]string, 0, len(remote.assets))
+				for _, item := range remote.assets {
+					remoteNames[item.name] = struct{}{}
+					if _, ok := localNames[item.name]; ok {
+						kept = append(kept, item.name)
+					} else {
+						change.deletes = append(change.deletes, item)
+					}
+				}
+				for _, file := range files {
+					if _, ok := remoteNames[file.name]; !ok {
+						change.uploads = append(change.uploads, file)
+						kept = append(kept, file.name)
+					}
+				}
+				for i, file := range files {
+					if kept[i] != file.name {
+						change.reorder = true
+						break
+					}
+				}
+
+				if len(change.uploads) > 0 || len(change.deletes) > 0 || change.reorder {
+					changes = append(changes, change)
+				}
+			}
+		}
+	}
+	return changes
+}
+
+func mediaNoun(kind string) string {
+	return strings.TrimSuffix(kind, "s")
+}
+
+func mediaFileNames(files []mediaFile) string {
+	names := make([]string, 0, len(files))
+	for _, file := range files {
+		names = append(names, file.name)
+	}
+	return strings.Join(names, ",")
+}
+
+func mediaAssetNames(items []mediaAsset) string {
+	names := make([]string, 0, len(items))
+	for _, item := range items {
+		names = append(names, item.name)
+	}
+	return strings.Join(names, ",")
+}
+
+func (s *mediaScope) plan(target scopeTarget) scopePlan {
+	plan := scopePlan{
+		adds:    make([]PlanItem, 0),
+		updates: make([]PlanItem, 0),
+		deletes: make([]PlanItem, 0),
+	}
+	counts := make(map[string]int)
+	for _, change := range s.changes() {
+		field := change.kind + "." + change.setType
+		noun := mediaNoun(change.kind)
+		if change.setID == "" && len(change.files) > 0 {
+			counts["create_"+noun+"_set"]++
+		}
+		for _, file := range change.uploads {
+			plan.adds = append(plan.adds, PlanItem{
+				Key:     buildPlanKey(includeMedia, target.version, change.locale, field+"/"+file.name),
+				Scope:   includeMedia,
+				Locale:  change.locale,
+				Version: target.version,
+				Field:   field,
+				Reason:  "asset exists locally but not remotely",
+				To:      file.name,
+			})
+			counts["upload_"+noun]++
+		}
+		for _, item := range change.deletes {
+			plan.deletes = append(plan.deletes, PlanItem{
+				Key:     buildPlanKey(includeMedia, target.version, change.locale, field+"/"+item.name),
+				Scope:   includeMedia,
+				Locale:  change.locale,
+				Version: target.version,
+				Field:   field,
+				Reason:  "asset missing locally",
+				From:    item.name,
+			})
+			counts["delete_"+noun]++
+		}
+		if change.reorder {
+			if len(change.uploads) == 0 && len(change.deletes) == 0 {
+				plan.updates = append(plan.updates, PlanItem{
+					Key:     buildPlanKey(includeMedia, target.version, change.locale, field),
+					Scope:   includeMedia,
+					Locale:  change.locale,
+					Version: target.version,
+					Field:   field,
+					Reason:  "asset order differs",
+					From:    mediaAssetNames(change.remote),
+					To:      mediaFileNames(change.files),
+				})
+			}
+			counts["reorder_"+change.kind]++
+		}
+	}
+	for _, operation := range sortedKeys(counts) {
+		plan.calls = append(plan.calls, PlanAPICall{Operation: operation, Scope: includeMedia, Count: counts[operation]})
+	}
+	return plan
+}
+
+// snapshots record each non-empty set as its comma-joined file names, keyed by
+// locale and "<kind>.<type>".
+func (s *mediaScope) snapshots(target scopeTarget) (metadataSnapshot, metadataSnapshot) {
+	prefix := snapshotPrefix(includeMedia, target.version)
+	local := metadataSnapshot{}
+	localFields := local.ensure(prefix)
+	for locale, kinds := range s.local {
+		for kind, sets := range kinds {
+			for setType, files := range sets {
+				if len(files) > 0 {
+					localFields[snapshotField(locale, kind+"."+setType)] = mediaFileNames(files)
+				}
+			}
+		}
+	}
+	remote := metadataSnapshot{}
+	remoteFields := remote.ensure(prefix)
+	for locale, kinds := range s.remote {
+		for kind, sets := range kinds {
+			for setType, set := range sets {
+				if len(set.assets) > 0 {
+					remoteFields[snapshotField(locale, kind+"."+setType)] = mediaAssetNames(set.assets)
+				}
+			}
+		}
+	}
+	return local, remote
+}
+
+// keepRemote drops a local set so the remote set is left as is.
+func (s *mediaScope) keepRemote(locale, field string) {
+	kind, setType, ok := strings.Cut(field, ".")
+	if !ok {
+		return
+	}
+	delete(s.local[locale][kind], setType)
+}
+
+func (s *mediaScope) apply(ctx context.Context, client *asc.Client, target scopeTarget) ([]ApplyAction, error) {
+	changes := s.changes()
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	uploadCtx, cancel := assets.ContextWithAssetUploadTimeout(shared.ContextWithoutTimeout(ctx))
+	defer cancel()
+
+	actions := make([]ApplyAction, 0)
+	for _, change := range changes {
+		for _, file := range change.uploads {
+			if _, err := os.Stat(file.path); err != nil {
+				return nil, fmt.Errorf("%s %s: %w", change.locale, change.setType, err)
+			}
+		}
+
+		setID := change.setID
+		if setID == "" {
+			localizationID := target.versionLocalizations[change.locale]
+			if localizationID == "" {
+				return nil, fmt.Errorf("version localization %s does not exist for version %s", change.locale, target.version)
+			}
+			id, err := createMediaSet(uploadCtx, client, change.kind, localizationID, change.setType)
+			if err != nil {
+				return nil, fmt.Errorf("create %s set %s for %s: %w", mediaNoun(change.kind), change.setType, change.locale, err)
+			}
+			setID = id
+			actions = append(actions, ApplyAction{Scope: includeMedia, Locale: change.locale, Version: target.version, Action: "create_set", ResourceID: setID})
+		}
+
+		for _, item := range change.deletes {
+			if err := deleteMediaAsset(uploadCtx, client, change.kind, item.id); err != nil {
+				return nil, fmt.Errorf("delete %s %s: %w", mediaNoun(change.kind), item.name, err)
+			}
+			actions = append(actions, ApplyAction{Scope: includeMedia, Locale: change.locale, Version: target.version, Action: "delete", ResourceID: item.id})
+		}
+
+		idsByName := make(map[string]string, len(change.remote)+len(change.uploads))
+		for _, item := range change.remote {
+			idsByName[item.name] = item.id
+		}
+		for _, file := range change.uploads {
+			id, err := uploadMediaAsset(uploadCtx, client, change.kind, setID, file.path)
+			if err != nil {
+				return nil, fmt.Errorf("upload %s %s: %w", mediaNoun(change.kind), file.name, err)
+			}
+			idsByName[file.name] = id
+			actions = append(actions, ApplyAction{Scope: includeMedia, Locale: change.locale, Version: target.version, Action: "upload", ResourceID: id})
+		}
+
+		if change.reorder {
+			ordered := make([]string, 0, len(change.files))
+			for _, file := range change.files {
+				ordered = append(ordered, idsByName[file.name])
+			}
+			if err := reorderMediaSet(uploadCtx, client, change.kind, setID, ordered); err != nil {
+				return nil, fmt.Errorf("reorder %s set %s: %w", mediaNoun(change.kind), change.setType, err)
+			}
+			actions = append(actions, ApplyAction{Scope: includeMedia, Locale: change.locale, Version: target.version, Action: "reorder", ResourceID: setID})
+		}
+	}
+	return actions, nil
+}
+
+func createMediaSet(ctx context.Context, client *asc.Client, kind, localizationID, setType string) (string, error) {
+	if kind == mediaPreviews {
+		resp, err := client.CreateAppPreviewSet(ctx, localizationID, setType)
+		if err != nil {
+			return "", err
+		}
+		return resp.Data.ID, nil
+	}
+	resp, err := client.CreateAppScreenshotSet(ctx, localizationID, setType)
+	if err != nil {
+		return "", err
+	}
+	return resp.Data.ID, nil
+}
+
+func deleteMediaAsset(ctx context.Context, client *asc.Client, kind, id string) error {
+	if kind == mediaPreviews {
+		return client.DeleteAppPreview(ctx, id)
+	}
+	return client.DeleteAppScreenshot(ctx, id)
+}
+
+func uploadMediaAsset(ctx context.Context, client *asc.Client, kind, setID, path string) (string, error) {
+	upload := assets.UploadScreenshotAsset
+	if kind == mediaPreviews {
+		upload = assets.UploadPreviewAsset
+	}
+	result, err := upload(ctx, client, setID, path)
+	if err != nil {
+		return "", err
+	}
+	return result.AssetID, nil
+}
+
+func reorderMediaSet(ctx context.Context, client *asc.Client, kind, setID string, ids []string) error {
+	if kind == mediaPreviews {
+		return client.UpdateAppPreviewSetAppPreviewsRelationship(ctx, setID, ids)
+	}
+	return assets.SetOrderedAppScreenshots(ctx, client, setID, ids)
+}
+
+func (s *mediaScope) pullPlans(target scopeTarget) ([]WritePlan, error) {
+	root, err := mediaDir(target.dir, target.version)
+	if err != nil {
+		return nil, err
+	}
+	plans := make([]WritePlan, 0, len(s.remote))
+	for _, locale := range sortedKeys(s.remote) {
+		manifest := MediaManifest{
+			Screenshots: mediaManifestEntries(locale, s.remote[locale][mediaScreenshots]),
+			Previews:    mediaManifestEntries(locale, s.remote[locale][mediaPreviews]),
+		}
+		if len(manifest.Screenshots) == 0 && len(manifest.Previews) == 0 {
+			continue
+		}
+		resolvedLocale, err := validateLocale(locale)
+		if err != nil {
+			return nil, err
+		}
+		plan, err := scopeDocumentWritePlan(filepath.Join(root, resolvedLocale+".json"), manifest)
+		if err != nil {
+			return nil, err
+		}
+		plans = append(plans, plan)
+	}
+	return plans, nil
+}
+
+func mediaManifestEntries(locale string, sets map[string]mediaSet) map[string][]string {
+	entries := make(map[string][]string)
+	for setType, set := range sets {
+		if len(set.assets) == 0 {
+			continue
+		}
+		files := make([]string, 0, len(set.assets))
+		for _, item := range set.assets {
+			files = append(files, locale+"/"+item.name)
+		}
+		entries[setType] = files
+	}
+	if len(entries) == 0 {
+		return nil
+	}
+	return entries
+}
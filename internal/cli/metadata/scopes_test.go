@@ -0,0 +1,262 @@
+package metadata
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseIncludesExpandsAll(t *testing.T) {
+	includes, err := parseIncludes("all")
+	if err != nil {
+		t.Fatalf("parseIncludes() error: %v", err)
+	}
+	if len(includes) != len(supportedIncludes) {
+		t.Fatalf("expected %d includes, got %v", len(supportedIncludes), includes)
+	}
+	for _, include := range supportedIncludes {
+		if !hasInclude(includes, include) {
+			t.Fatalf("expected %q in %v", include, includes)
+		}
+	}
+}
+
+func TestParseIncludesRejectsUnknownScope(t *testing.T) {
+	_, err := parseIncludes("localizations,screenshots")
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if !strings.Contains(err.Error(), "--include supports: localizations, categories") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestDocumentFieldsFlattensValues(t *testing.T) {
+	required := true
+	fields := documentFields(ReviewInformation{
+		ContactEmail:        "dev@example.com",
+		DemoAccountRequired: &required,
+	})
+	want := map[string]string{
+		"contactEmail":        "dev@example.com",
+		"demoAccountRequired": "true",
+	}
+	if !reflect.DeepEqual(fields, want) {
+		t.Fatalf("expected %v, got %v", want, fields)
+	}
+
+	fields = documentFields(EULA{AgreementText: "Terms", Territories: []string{"CAN", "USA"}})
+	if fields["territories"] != "CAN,USA" {
+		t.Fatalf("expected joined territories, got %q", fields["territories"])
+	}
+}
+
+func TestDocumentScopePlanTreatsOmittedFieldsAsNoOp(t *testing.T) {
+	local := documentFields(Categories{PrimaryCategory: "GAMES", SecondaryCategory: "ENTERTAINMENT"})
+	remote := documentFields(Categories{PrimaryCategory: "UTILITIES", PrimarySubcategoryOne: "GAMES_ACTION"})
+
+	plan := documentScopePlan(includeCategories, "", "update_app_info_categories", local, remote)
+	if len(plan.adds) != 1 || plan.adds[0].Key != "categories:secondaryCategory" {
+		t.Fatalf("unexpected adds: %+v", plan.adds)
+	}
+	if len(plan.updates) != 1 || plan.updates[0].From != "UTILITIES" || plan.updates[0].To != "GAMES" {
+		t.Fatalf("unexpected updates: %+v", plan.updates)
+	}
+	if len(plan.calls) != 1 || plan.calls[0].Count != 1 {
+		t.Fatalf("unexpected calls: %+v", plan.calls)
+	}
+
+	plan = documentScopePlan(includeCategories, "", "update_app_info_categories", nil, remote)
+	if len(plan.adds)+len(plan.updates)+len(plan.calls) != 0 {
+		t.Fatalf("expected empty plan without local file, got %+v", plan)
+	}
+}
+
+func TestAgeRatingScopeRejectsSeventeenPlus(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, appDirName), 0o755); err != nil {
+		t.Fatalf("mkdir app: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, appDirName, ageRatingFileName), []byte(`{"seventeenPlus":true}`), 0o644); err != nil {
+		t.Fatalf("write age rating: %v", err)
+	}
+
+	if _, err := (&ageRatingScope{}).loadLocal(dir, ""); err == nil {
+		t.Fatal("expected seventeenPlus to be rejected")
+	}
+}
+
+func TestAvailabilityScopePlansUnlistedTerritoriesAsDeletes(t *testing.T) {
+	scope := &availabilityScope{
+		local:       &Availability{Territories: []string{"USA"}},
+		remoteFound: true,
+		remote: map[string]territoryAvailability{
+			"USA": {id: "ta-usa", available: false},
+			"CAN": {id: "ta-can", available: true},
+			"GBR": {id: "ta-gbr", available: false},
+		},
+	}
+
+	plan := scope.plan(scopeTarget{})
+	if len(plan.adds) != 0 {
+		t.Fatalf("expected no adds, got %+v", plan.adds)
+	}
+	if len(plan.updates) != 1 || plan.updates[0].Field != "territories.USA" || plan.updates[0].To != "true" {
+		t.Fatalf("unexpected updates: %+v", plan.updates)
+	}
+	if len(plan.deletes) != 1 || plan.deletes[0].Field != "territories.CAN" || plan.deletes[0].From != "true" {
+		t.Fatalf("unexpected deletes: %+v", plan.deletes)
+	}
+	if len(plan.calls) != 1 || plan.calls[0].Count != 2 {
+		t.Fatalf("unexpected calls: %+v", plan.calls)
+	}
+}
+
+func TestReviewInfoScopeRedactsDemoAccountPasswordInPlan(t *testing.T) {
+	scope := &reviewInfoScope{
+		local:    &ReviewInformation{ContactEmail: "dev@example.com", DemoAccountPassword: "local-secret"},
+		remoteID: "detail-1",
+		remote:   ReviewInformation{ContactEmail: "dev@example.com", DemoAccountPassword: "remote-secret"},
+	}
+
+	plan := scope.plan(scopeTarget{version: "1.0"})
+	if len(plan.updates) != 1 || plan.updates[0].Field != "demoAccountPassword" {
+		t.Fatalf("expected the password update to be planned, got %+v", plan.updates)
+	}
+	if plan.updates[0].From != "***" || plan.updates[0].To != "***" {
+		t.Fatalf("expected redacted password values, got %+v", plan.updates[0])
+	}
+	if len(plan.calls) != 1 || plan.calls[0].Operation != "update_review_detail" {
+		t.Fatalf("unexpected calls: %+v", plan.calls)
+	}
+}
+
+func TestPricingScopePlansScheduleReplacementAsDelete(t *testing.T) {
+	scope := &pricingScope{
+		local:  &Pricing{BaseTerritory: "USA", Price: "1.99"},
+		remote: Pricing{BaseTerritory: "USA", Price: "0.99"},
+	}
+
+	plan := scope.plan(scopeTarget{})
+	if len(plan.adds)+len(plan.updates) != 0 {
+		t.Fatalf("expected no adds or updates, got %+v %+v", plan.adds, plan.updates)
+	}
+	if len(plan.deletes) != 1 || plan.deletes[0].Field != "price" || plan.deletes[0].From != "0.99" || plan.deletes[0].To != "1.99" {
+		t.Fatalf("unexpected deletes: %+v", plan.deletes)
+	}
+	if len(plan.calls) != 1 || plan.calls[0].Operation != "create_price_schedule" {
+		t.Fatalf("unexpected calls: %+v", plan.calls)
+	}
+
+	scope.remote = Pricing{}
+	plan = scope.plan(scopeTarget{})
+	if len(plan.adds) != 2 || len(plan.deletes) != 0 {
+		t.Fatalf("expected a first price schedule to be planned as adds, got %+v", plan)
+	}
+}
+
+func TestNormalizePrice(t *testing.T) {
+	for input, want := range map[string]string{"0.990": "0.99", "1": "1", "0": "0"} {
+		got, err := normalizePrice(input)
+		if err != nil {
+			t.Fatalf("normalizePrice(%q) error: %v", input, err)
+		}
+		if got != want {
+			t.Fatalf("normalizePrice(%q) = %q, want %q", input, got, want)
+		}
+	}
+	if _, err := normalizePrice("-1"); err == nil {
+		t.Fatal("expected negative price to be rejected")
+	}
+}
+
+func TestMediaScopePlansUploadsDeletesAndReorders(t *testing.T) {
+	dir := t.TempDir()
+	mediaRoot := filepath.Join(dir, mediaDirName, "1.2.3")
+	if err := os.MkdirAll(mediaRoot, 0o755); err != nil {
+		t.Fatalf("mkdir media: %v", err)
+	}
+	manifest := `{"screenshots":{"APP_IPHONE_65":["en-US/b.png","en-US/a.png","en-US/new.png"]},"previews":{"IPHONE_65":["en-US/intro.mov"]}}`
+	if err := os.WriteFile(filepath.Join(mediaRoot, "en-US.json"), []byte(manifest), 0o644); err != nil {
+		t.Fatalf("write manifest: %v", err)
+	}
+
+	scope := &mediaScope{}
+	count, err := scope.loadLocal(dir, "1.2.3")
+	if err != nil {
+		t.Fatalf("loadLocal() error: %v", err)
+	}
+	if count != 1 {
+		t.Fatalf("expected 1 manifest, got %d", count)
+	}
+	scope.remote = map[string]map[string]map[string]mediaSet{
+		"en-US": {
+			mediaScreenshots: {
+				"APP_IPHONE_65": {id: "set-1", assets: []mediaAsset{
+					{id: "shot-a", name: "a.png"},
+					{id: "shot-b", name: "b.png"},
+					{id: "shot-old", name: "old.png"},
+				}},
+			},
+			mediaPreviews: {},
+		},
+	}
+
+	plan := scope.plan(scopeTarget{version: "1.2.3"})
+	addKeys := make([]string, 0, len(plan.adds))
+	for _, item := range plan.adds {
+		addKeys = append(addKeys, item.Key)
+	}
+	wantAdds := []string{
+		"media:1.2.3:en-US:screenshots.APP_IPHONE_65/new.png",
+		"media:1.2.3:en-US:previews.IPHONE_65/intro.mov",
+	}
+	if !sameStrings(addKeys, wantAdds) {
+		t.Fatalf("expected adds %v, got %v", wantAdds, addKeys)
+	}
+	if len(plan.deletes) != 1 || plan.deletes[0].From != "old.png" {
+		t.Fatalf("unexpected deletes: %+v", plan.deletes)
+	}
+
+	operations := make(map[string]int)
+	for _, call := range plan.calls {
+		operations[call.Operation] = call.Count
+	}
+	want := map[string]int{
+		"create_preview_set":  1,
+		"delete_screenshot":   1,
+		"reorder_screenshots": 1,
+		"upload_preview":      1,
+		"upload_screenshot":   1,
+	}
+	if !reflect.DeepEqual(operations, want) {
+		t.Fatalf("expected calls %v, got %v", want, operations)
+	}
+}
+
+func TestResolveMediaFileRejectsPathsOutsideMediaDir(t *testing.T) {
+	for _, entry := range []string{"../secret.png", "/tmp/a.png", ""} {
+		if _, err := resolveMediaFile("/root/media/1.2.3", entry); err == nil {
+			t.Fatalf("expected %q to be rejected", entry)
+		}
+	}
+}
+
+func sameStrings(got, want []string) bool {
+	if len(got) != len(want) {
+		return false
+	}
+	seen := make(map[string]int, len(got))
+	for _, value := range got {
+		seen[value]++
+	}
+	for _, value := range want {
+		if seen[value] == 0 {
+			return false
+		}
+		seen[value]--
+	}
+	return true
+}
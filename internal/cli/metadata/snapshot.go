@@ -0,0 +1,192 @@
+package metadata
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+// SnapshotOptions selects the app version and scopes CollectSnapshot reads.
+type SnapshotOptions struct {
+	AppID     string
+	AppInfoID string
+	Version   string
+	Platform  string
+	// Dir, when set, also loads the local metadata files under Dir.
+	Dir      string
+	Includes []string
+	// AppInfoExample renders the command suggested when several app infos
+	// match and --app-info is required.
+	AppInfoExample func(appInfoID string) string
+}
+
+// Snapshot is the flattened metadata of one app version. Remote and Local map
+// a scope such as "app-info", "version", "categories", or "media" to field
+// values keyed by "<locale>:<field>", or by field alone for scopes without
+// locales. Scope keys carry no version so snapshots of different versions and
+// apps line up.
+type Snapshot struct {
+	AppID     string
+	AppInfoID string
+	Version   string
+	VersionID string
+	Remote    map[string]map[string]string
+	// Local holds only the scopes with files under SnapshotOptions.Dir.
+	Local map[string]map[string]string
+}
+
+// SupportedIncludes returns every include scope accepted by --include.
+func SupportedIncludes() []string {
+	return slices.Clone(supportedIncludes)
+}
+
+// CollectSnapshot fetches the selected scopes for one app version and, when
+// opts.Dir is set, loads the matching local files.
+func CollectSnapshot(ctx context.Context, client *asc.Client, opts SnapshotOptions) (Snapshot, error) {
+	exampleBuilder := func(_, _, _, _, infoID string) string {
+		if opts.AppInfoExample != nil {
+			return opts.AppInfoExample(infoID)
+		}
+		return buildMetadataAppInfoExample("pull", opts.AppID, opts.Version, opts.Platform, opts.Dir, infoID)
+	}
+	collected, err := collectMetadataSnapshots(ctx, client, opts, exampleBuilder)
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	snapshot := Snapshot{
+		AppID:     opts.AppID,
+		AppInfoID: collected.target.appInfoID,
+		Version:   opts.Version,
+		VersionID: collected.target.versionID,
+		Remote:    unversionedSnapshot(collected.remote),
+	}
+	if opts.Dir != "" {
+		snapshot.Local = unversionedSnapshot(collected.local)
+	}
+	return snapshot, nil
+}
+
+// collectedSnapshots is the result of collectMetadataSnapshots.
+type collectedSnapshots struct {
+	target scopeTarget
+	local  metadataSnapshot
+	remote metadataSnapshot
+}
+
+// collectMetadataSnapshots resolves the version and app info, fetches every
+// selected scope, and returns its local and remote snapshots. Local files are
+// read only when opts.Dir is set; scopes without local files are left out of
+// the local snapshot.
+func collectMetadataSnapshots(ctx context.Context, client *asc.Client, opts SnapshotOptions, buildExample exampleBuilderFunc) (collectedSnapshots, error) {
+	includesLocalizations := hasInclude(opts.Includes, includeLocalizations)
+	scopes := newMetadataScopes(opts.Includes)
+
+	var localBundle localMetadataBundle
+	localScopes := make(map[string]bool, len(scopes))
+	if opts.Dir != "" {
+		if includesLocalizations {
+			bundle, err := loadLocalMetadata(opts.Dir, opts.Version)
+			if err != nil {
+				return collectedSnapshots{}, err
+			}
+			localBundle = bundle
+		}
+		for _, scope := range scopes {
+			count, err := scope.loadLocal(opts.Dir, opts.Version)
+			if err != nil {
+				return collectedSnapshots{}, fmt.Errorf("%s: %w", scope.name(), err)
+			}
+			localScopes[scope.name()] = count > 0
+		}
+	}
+
+	versionID, versionState, err := resolveVersionID(ctx, client, opts.AppID, opts.Version, opts.Platform)
+	if err != nil {
+		return collectedSnapshots{}, err
+	}
+	appInfoID, err := resolveMetadataAppInfoID(
+		ctx,
+		client,
+		opts.AppID,
+		strings.TrimSpace(opts.AppInfoID),
+		opts.Version,
+		opts.Platform,
+		opts.Dir,
+		versionState,
+		buildExample,
+	)
+	if err != nil {
+		return collectedSnapshots{}, err
+	}
+
+	var appInfoItems []asc.Resource[asc.AppInfoLocalizationAttributes]
+	if includesLocalizations {
+		appInfoItems, err = fetchAppInfoLocalizations(ctx, client, appInfoID)
+		if err != nil {
+			return collectedSnapshots{}, err
+		}
+	}
+	var versionItems []asc.Resource[asc.AppStoreVersionLocalizationAttributes]
+	if includesLocalizations || hasInclude(opts.Includes, includeMedia) {
+		versionItems, err = fetchVersionLocalizations(ctx, client, versionID)
+		if err != nil {
+			return collectedSnapshots{}, err
+		}
+	}
+
+	target := scopeTarget{
+		appID:                opts.AppID,
+		appInfoID:            appInfoID,
+		versionID:            versionID,
+		version:              opts.Version,
+		dir:                  opts.Dir,
+		versionLocalizations: versionLocalizationIDs(versionItems),
+	}
+	if err := fetchScopes(ctx, client, scopes, target); err != nil {
+		return collectedSnapshots{}, err
+	}
+
+	local, remote := scopeSnapshots(scopes, target)
+	for _, scope := range scopes {
+		if localScopes[scope.name()] {
+			continue
+		}
+		for prefix := range local {
+			if name, _ := splitSnapshotPrefix(prefix); name == scope.name() {
+				delete(local, prefix)
+			}
+		}
+	}
+	if includesLocalizations {
+		remoteAppInfo := remoteAppInfoItemsToMap(appInfoItems)
+		remoteVersion := remoteVersionItemsToVersionMap(versionItems)
+		var localAppInfo map[string]appInfoLocalPatch
+		var localVersion map[string]versionLocalPatch
+		if localBundle.files > 0 {
+			localAppInfo = applyDefaultAppInfoFallback(localBundle.appInfo, localBundle.defaultAppInfo, remoteAppInfo, false)
+			localVersion = applyDefaultVersionFallback(localBundle.version, localBundle.defaultVersion, remoteVersion, false)
+		}
+		localizationLocal, localizationRemote := localizationSnapshots(opts.Version, localAppInfo, localVersion, remoteAppInfo, remoteVersion)
+		if localBundle.files > 0 {
+			local.merge(localizationLocal)
+		}
+		remote.merge(localizationRemote)
+	}
+
+	return collectedSnapshots{target: target, local: local, remote: remote}, nil
+}
+
+// unversionedSnapshot copies snapshot with the version dropped from every
+// prefix.
+func unversionedSnapshot(snapshot metadataSnapshot) map[string]map[string]string {
+	result := make(map[string]map[string]string, len(snapshot))
+	for prefix, fields := range snapshot {
+		scope, _ := splitSnapshotPrefix(prefix)
+		result[scope] = cloneStringMap(fields)
+	}
+	return result
+}
@@ -0,0 +1,618 @@
+package metadata
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/translate"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/validation"
+)
+
+const (
+	machineTranslationsFileName = ".asc-machine-translations.json"
+
+	translateBaseURLEnvVar = "ASC_TRANSLATE_BASE_URL"
+	translateModelEnvVar   = "ASC_TRANSLATE_MODEL"
+	translateAPIKeyEnvVar  = "ASC_TRANSLATE_API_KEY"
+	openAIAPIKeyEnvVar     = "OPENAI_API_KEY"
+)
+
+// translatableVersionFields lists the version localization fields metadata
+// translate supports, in output order.
+var translatableVersionFields = []string{"description", "promotionalText", "whatsNew", "keywords"}
+
+var translateHTTPClient = func() *http.Client {
+	return &http.Client{Timeout: asc.ResolveTimeout()}
+}
+
+// MachineTranslation records one field written by metadata translate. The
+// entry stays pending review until the field value is edited or the entry is
+// removed.
+type MachineTranslation struct {
+	Version      string `json:"version"`
+	Locale       string `json:"locale"`
+	Field        string `json:"field"`
+	SourceLocale string `json:"sourceLocale"`
+	Provider     string `json:"provider"`
+	Model        string `json:"model,omitempty"`
+	ValueSHA256  string `json:"valueSha256"`
+	TranslatedAt string `json:"translatedAt"`
+}
+
+// MachineTranslationManifest is stored in <dir>/.asc-machine-translations.json.
+type MachineTranslationManifest struct {
+	Fields []MachineTranslation `json:"fields"`
+}
+
+// MetadataTranslateFieldResult describes the outcome for one locale field.
+type MetadataTranslateFieldResult struct {
+	Locale string `json:"locale"`
+	Field  string `json:"field"`
+	File   string `json:"file"`
+	Action string `json:"action"`
+	Reason string `json:"reason,omitempty"`
+	Value  string `json:"value,omitempty"`
+	Length int    `json:"length,omitempty"`
+	Limit  int    `json:"limit,omitempty"`
+}
+
+// MetadataTranslateResult is the structured result for metadata translate.
+type MetadataTranslateResult struct {
+	Dir           string                         `json:"dir"`
+	Version       string                         `json:"version"`
+	FromLocale    string                         `json:"fromLocale"`
+	TargetLocales []string                       `json:"targetLocales"`
+	Fields        []string                       `json:"fields"`
+	Provider      string                         `json:"provider"`
+	Model         string                         `json:"model,omitempty"`
+	DryRun        bool                           `json:"dryRun"`
+	Valid         bool                           `json:"valid"`
+	Results       []MetadataTranslateFieldResult `json:"results"`
+	ReviewFile    string                         `json:"reviewFile,omitempty"`
+}
+
+type metadataTranslateOptions struct {
+	Dir           string
+	Version       string
+	FromLocale    string
+	TargetLocales []string
+	Fields        []string
+	Provider      string
+	Model         string
+	Translator    translate.Translator
+	Overwrite     bool
+	DryRun        bool
+}
+
+// MetadataTranslateCommand returns the metadata translate subcommand.
+func MetadataTranslateCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("metadata translate", flag.ExitOnError)
+
+	dir := fs.String("dir", "", "Metadata root directory (required)")
+	version := fs.String("version", "", "App version string (for example 1.2.3)")
+	from := fs.String("from", "", "Source locale (required)")
+	to := fs.String("to", "", "Target locales (comma-separated, required)")
+	fields := fs.String("fields", strings.Join(translatableVersionFields, ","), "Fields to translate (comma-separated): "+strings.Join(translatableVersionFields, ", "))
+	provider := fs.String("provider", "", "Translation provider: command or openai (default: command when --command is set, otherwise openai)")
+	command := fs.String("command", "", "Local translation command for --provider command (request JSON is piped on stdin)")
+	baseURL := fs.String("base-url", "", "OpenAI-compatible API base URL (or "+translateBaseURLEnvVar+" env; default: "+translate.DefaultOpenAIBaseURL+")")
+	model := fs.String("model", "", "Model for --provider openai (or "+translateModelEnvVar+" env)")
+	overwrite := fs.Bool("overwrite", false, "Replace target fields that already have a value")
+	dryRun := fs.Bool("dry-run", false, "Preview translations without writing files")
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "translate",
+		ShortUsage: `asc metadata translate --dir "./metadata" --version "1.2.3" --from "en-US" --to "de-DE,ja" [flags]`,
+		ShortHelp:  "Machine-translate version metadata into other locales.",
+		LongHelp: `Machine-translate version metadata into other locales.
+
+Translates description, promotional text, what's new, and keywords from the
+--from locale file into each --to locale file under <dir>/version/<version>.
+Target fields that already have a value are kept unless --overwrite is set.
+Translations that exceed the App Store Connect character limit are reported
+and not written, and the command exits non-zero.
+
+Every written field is recorded in <dir>/` + machineTranslationsFileName + `
+as machine-translated. metadata validate warns about these fields until the
+value is edited or its entry is removed after review.
+
+Providers:
+  command  Runs --command with sh. The request is piped as JSON on stdin
+           ({"sourceLocale","targetLocale","field","text","maxLength"}) and
+           the translation is read from stdout.
+  openai   Calls an OpenAI-compatible /chat/completions endpoint. Point
+           --base-url at a local server (Ollama, llama.cpp, vLLM) to keep
+           metadata on your machine. The API key is read from
+           ` + translateAPIKeyEnvVar + ` or ` + openAIAPIKeyEnvVar + `.
+
+Examples:
+  asc metadata translate --dir "./metadata" --version "1.2.3" --from "en-US" --to "de-DE,ja" --model "gpt-4o-mini"
+  asc metadata translate --dir "./metadata" --version "1.2.3" --from "en-US" --to "fr-FR" --base-url "http://localhost:11434/v1" --model "llama3.1"
+  asc metadata translate --dir "./metadata" --version "1.2.3" --from "en-US" --to "it,es-MX" --command "./scripts/translate.sh" --fields whatsNew --dry-run`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if len(args) > 0 {
+				return shared.UsageError("metadata translate does not accept positional arguments")
+			}
+
+			providerValue, modelValue, translator, err := newMetadataTranslator(*provider, *command, *baseURL, *model)
+			if err != nil {
+				return shared.UsageError(err.Error())
+			}
+			result, err := executeMetadataTranslate(ctx, metadataTranslateOptions{
+				Dir:           *dir,
+				Version:       *version,
+				FromLocale:    *from,
+				TargetLocales: shared.SplitUniqueCSV(*to),
+				Fields:        shared.SplitUniqueCSV(*fields),
+				Provider:      providerValue,
+				Model:         modelValue,
+				Translator:    translator,
+				Overwrite:     *overwrite,
+				DryRun:        *dryRun,
+			})
+			if err != nil {
+				if errors.Is(err, flag.ErrHelp) {
+					return err
+				}
+				return fmt.Errorf("metadata translate: %w", err)
+			}
+			if err := shared.PrintOutputWithRenderers(ctx,
+				result,
+				*output.Output,
+				*output.Pretty,
+				func() error { return printMetadataTranslateTable(result) },
+				func() error { return printMetadataTranslateMarkdown(result) },
+			); err != nil {
+				return err
+			}
+			if !result.Valid {
+				return shared.NewReportedError(fmt.Errorf("metadata translate: %d translation(s) exceed field limits", countTranslateResults(result.Results, "invalid")))
+			}
+			return nil
+		},
+	}
+}
+
+// newMetadataTranslator builds the provider selected by the translate flags.
+func newMetadataTranslator(provider, command, baseURL, model string) (string, string, translate.Translator, error) {
+	providerValue := strings.ToLower(strings.TrimSpace(provider))
+	commandValue := strings.TrimSpace(command)
+	if providerValue == "" {
+		providerValue = translate.ProviderOpenAI
+		if commandValue != "" {
+			providerValue = translate.ProviderCommand
+		}
+	}
+
+	switch providerValue {
+	case translate.ProviderCommand:
+		if commandValue == "" {
+			return "", "", nil, fmt.Errorf("--command is required for --provider command")
+		}
+		return providerValue, "", &translate.CommandTranslator{Command: commandValue}, nil
+	case translate.ProviderOpenAI:
+		if commandValue != "" {
+			return "", "", nil, fmt.Errorf("--command can only be used with --provider command")
+		}
+		modelValue := strings.TrimSpace(model)
+		if modelValue == "" {
+			modelValue = strings.TrimSpace(os.Getenv(translateModelEnvVar))
+		}
+		if modelValue == "" {
+			return "", "", nil, fmt.Errorf("--model is required for --provider openai (or set %s)", translateModelEnvVar)
+		}
+		baseURLValue := strings.TrimSpace(baseURL)
+		if baseURLValue == "" {
+			baseURLValue = strings.TrimSpace(os.Getenv(translateBaseURLEnvVar))
+		}
+		apiKey := strings.TrimSpace(os.Getenv(translateAPIKeyEnvVar))
+		if apiKey == "" {
+			apiKey = strings.TrimSpace(os.Getenv(openAIAPIKeyEnvVar))
+		}
+		return providerValue, modelValue, &translate.OpenAITranslator{
+			BaseURL:    baseURLValue,
+			APIKey:     apiKey,
+			Model:      modelValue,
+			HTTPClient: translateHTTPClient(),
+		}, nil
+	default:
+		return "", "", nil, fmt.Errorf("--provider must be %s or %s", translate.ProviderCommand, translate.ProviderOpenAI)
+	}
+}
+
+func executeMetadataTranslate(ctx context.Context, opts metadataTranslateOptions) (MetadataTranslateResult, error) {
+	dirValue, versionValue, err := validateMetadataKeywordDirVersion(opts.Dir, opts.Version)
+	if err != nil {
+		return MetadataTranslateResult{}, err
+	}
+	sourceLocale, err := validateTranslateLocale("--from", opts.FromLocale)
+	if err != nil {
+		return MetadataTranslateResult{}, shared.UsageError(err.Error())
+	}
+	if len(opts.TargetLocales) == 0 {
+		return MetadataTranslateResult{}, shared.UsageError("--to is required")
+	}
+	targets := make([]string, 0, len(opts.TargetLocales))
+	for _, rawLocale := range opts.TargetLocales {
+		locale, err := validateTranslateLocale("--to", rawLocale)
+		if err != nil {
+			return MetadataTranslateResult{}, shared.UsageError(err.Error())
+		}
+		if strings.EqualFold(locale, sourceLocale) {
+			return MetadataTranslateResult{}, shared.UsageError("--from must be different from every --to locale")
+		}
+		targets = append(targets, locale)
+	}
+	sort.Strings(targets)
+	fields, err := resolveTranslateFields(opts.Fields)
+	if err != nil {
+		return MetadataTranslateResult{}, shared.UsageError(err.Error())
+	}
+
+	sourcePath, err := resolveExistingVersionLocalizationPath(dirValue, versionValue, sourceLocale)
+	if err != nil {
+		return MetadataTranslateResult{}, err
+	}
+	source, err := ReadVersionLocalizationFile(sourcePath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return MetadataTranslateResult{}, shared.UsageErrorf("source locale file %s does not exist", sourcePath)
+		}
+		return MetadataTranslateResult{}, err
+	}
+	sourceValues := versionLocalizationFieldValues(NormalizeVersionLocalization(source))
+
+	manifest, err := readMachineTranslations(dirValue)
+	if err != nil {
+		return MetadataTranslateResult{}, err
+	}
+
+	result := MetadataTranslateResult{
+		Dir:           dirValue,
+		Version:       versionValue,
+		FromLocale:    sourceLocale,
+		TargetLocales: targets,
+		Fields:        fields,
+		Provider:      opts.Provider,
+		Model:         opts.Model,
+		DryRun:        opts.DryRun,
+		Results:       make([]MetadataTranslateFieldResult, 0, len(targets)*len(fields)),
+	}
+	plans := make([]WritePlan, 0, len(targets))
+	translatedAt := time.Now().UTC().Format(time.RFC3339)
+
+	for _, locale := range targets {
+		path, err := resolveExistingVersionLocalizationPath(dirValue, versionValue, locale)
+		if err != nil {
+			return MetadataTranslateResult{}, err
+		}
+		existing, exists, err := readExistingVersionLocalization(path)
+		if err != nil {
+			return MetadataTranslateResult{}, err
+		}
+		next := NormalizeVersionLocalization(existing)
+		targetValues := versionLocalizationFieldValues(next)
+		changed := false
+
+		for _, field := range fields {
+			fieldResult := MetadataTranslateFieldResult{Locale: locale, Field: field, File: path}
+			switch {
+			case sourceValues[field] == "":
+				fieldResult.Action = "skip"
+				fieldResult.Reason = "source field is empty"
+				result.Results = append(result.Results, fieldResult)
+				continue
+			case targetValues[field] != "" && !opts.Overwrite:
+				fieldResult.Action = "skip"
+				fieldResult.Reason = "existing value preserved (use --overwrite to replace)"
+				result.Results = append(result.Results, fieldResult)
+				continue
+			}
+
+			request := translate.Request{
+				SourceLocale: sourceLocale,
+				TargetLocale: locale,
+				Field:        field,
+				Text:         sourceValues[field],
+				MaxLength:    translateFieldLimit(field),
+			}
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			translated, err := opts.Translator.Translate(requestCtx, request)
+			cancel()
+			if err != nil {
+				return MetadataTranslateResult{}, fmt.Errorf("translate %s %s: %w", locale, field, err)
+			}
+			if field == "keywords" {
+				normalized, _, err := normalizeMetadataKeywordListDetailed(splitMetadataKeywordTokens(translated))
+				if err != nil {
+					return MetadataTranslateResult{}, fmt.Errorf("translate %s keywords: %w", locale, err)
+				}
+				translated = strings.Join(normalized, ",")
+			}
+
+			fieldResult.Value = translated
+			if issue := translateFieldLengthIssue(field, translated); issue != nil {
+				fieldResult.Action = "invalid"
+				fieldResult.Reason = fmt.Sprintf("translation exceeds %d %s", issue.Limit, issue.Unit)
+				fieldResult.Length = issue.Length
+				fieldResult.Limit = issue.Limit
+				result.Results = append(result.Results, fieldResult)
+				continue
+			}
+			if translated == targetValues[field] {
+				fieldResult.Action = "noop"
+				fieldResult.Reason = "translation matches existing value"
+				result.Results = append(result.Results, fieldResult)
+				continue
+			}
+
+			fieldResult.Action = "update"
+			if !exists {
+				fieldResult.Action = "create"
+			}
+			fieldResult.Reason = "machine-translated; review before push"
+			setVersionLocalizationField(&next, field, translated)
+			manifest.record(MachineTranslation{
+				Version:      versionValue,
+				Locale:       locale,
+				Field:        field,
+				SourceLocale: sourceLocale,
+				Provider:     opts.Provider,
+				Model:        opts.Model,
+				ValueSHA256:  machineTranslationHash(translated),
+				TranslatedAt: translatedAt,
+			})
+			changed = true
+			result.Results = append(result.Results, fieldResult)
+		}
+
+		if changed {
+			data, err := EncodeVersionLocalization(next)
+			if err != nil {
+				return MetadataTranslateResult{}, err
+			}
+			plans = append(plans, WritePlan{Path: path, Contents: data})
+		}
+	}
+
+	result.Valid = countTranslateResults(result.Results, "invalid") == 0
+	if len(plans) > 0 {
+		result.ReviewFile = machineTranslationsPath(dirValue)
+		if !opts.DryRun {
+			if err := ApplyWritePlans(plans); err != nil {
+				return MetadataTranslateResult{}, err
+			}
+			if err := writeMachineTranslations(dirValue, manifest); err != nil {
+				return MetadataTranslateResult{}, err
+			}
+		}
+	}
+	return result, nil
+}
+
+func validateTranslateLocale(flagName, locale string) (string, error) {
+	resolved, err := validateLocale(locale)
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", flagName, err)
+	}
+	if resolved == DefaultLocale {
+		return "", fmt.Errorf("%s: default locale is not supported for metadata translate", flagName)
+	}
+	return resolved, nil
+}
+
+func resolveTranslateFields(values []string) ([]string, error) {
+	if len(values) == 0 {
+		return append([]string(nil), translatableVersionFields...), nil
+	}
+	selected := make(map[string]bool, len(values))
+	for _, value := range values {
+		matched := false
+		for _, field := range translatableVersionFields {
+			if strings.EqualFold(strings.TrimSpace(value), field) {
+				selected[field] = true
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return nil, fmt.Errorf("--fields supports: %s", strings.Join(translatableVersionFields, ", "))
+		}
+	}
+	fields := make([]string, 0, len(selected))
+	for _, field := range translatableVersionFields {
+		if selected[field] {
+			fields = append(fields, field)
+		}
+	}
+	return fields, nil
+}
+
+func versionLocalizationFieldValues(loc VersionLocalization) map[string]string {
+	return map[string]string{
+		"description":     loc.Description,
+		"promotionalText": loc.PromotionalText,
+		"whatsNew":        loc.WhatsNew,
+		"keywords":        loc.Keywords,
+	}
+}
+
+func translateFieldLimit(field string) int {
+	switch field {
+	case "description":
+		return validation.LimitDescription
+	case "promotionalText":
+		return validation.LimitPromotionalText
+	case "whatsNew":
+		return validation.LimitWhatsNew
+	case "keywords":
+		return validation.LimitKeywords
+	}
+	return 0
+}
+
+func translateFieldLengthIssue(field, value string) *validation.MetadataLengthIssue {
+	var loc VersionLocalization
+	setVersionLocalizationField(&loc, field, value)
+	issues := validation.VersionLocalizationLengthIssues(validation.VersionLocalization{
+		Description:     loc.Description,
+		Keywords:        loc.Keywords,
+		WhatsNew:        loc.WhatsNew,
+		PromotionalText: loc.PromotionalText,
+	})
+	if len(issues) == 0 {
+		return nil
+	}
+	return &issues[0]
+}
+
+func countTranslateResults(results []MetadataTranslateFieldResult, action string) int {
+	count := 0
+	for _, result := range results {
+		if result.Action == action {
+			count++
+		}
+	}
+	return count
+}
+
+func machineTranslationHash(value string) string {
+	sum := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(sum[:])
+}
+
+func machineTranslationsPath(dir string) string {
+	return filepath.Join(dir, machineTranslationsFileName)
+}
+
+// record adds entry, replacing any earlier entry for the same field.
+func (m *MachineTranslationManifest) record(entry MachineTranslation) {
+	for i, existing := range m.Fields {
+		if existing.Version == entry.Version && existing.Locale == entry.Locale && existing.Field == entry.Field {
+			m.Fields[i] = entry
+			return
+		}
+	}
+	m.Fields = append(m.Fields, entry)
+}
+
+// pending returns the entry for a field whose current value is still the
+// machine translation.
+func (m *MachineTranslationManifest) pending(version, locale, field, value string) (MachineTranslation, bool) {
+	for _, entry := range m.Fields {
+		if entry.Version == version && entry.Locale == locale && entry.Field == field {
+			return entry, value != "" && entry.ValueSHA256 == machineTranslationHash(value)
+		}
+	}
+	return MachineTranslation{}, false
+}
+
+// readMachineTranslations reads the review manifest in dir. A missing file
+// returns an empty manifest.
+func readMachineTranslations(dir string) (*MachineTranslationManifest, error) {
+	path := machineTranslationsPath(dir)
+	if _, err := os.Lstat(path); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return &MachineTranslationManifest{}, nil
+		}
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	data, err := readFileNoFollow(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	var manifest MachineTranslationManifest
+	if err := decodeStrictJSON(data, &manifest); err != nil {
+		return nil, fmt.Errorf("invalid machine translation file %s: %w", path, err)
+	}
+	return &manifest, nil
+}
+
+func writeMachineTranslations(dir string, manifest *MachineTranslationManifest) error {
+	sort.Slice(manifest.Fields, func(i, j int) bool {
+		left, right := manifest.Fields[i], manifest.Fields[j]
+		if left.Version != right.Version {
+			return left.Version < right.Version
+		}
+		if left.Locale != right.Locale {
+			return left.Locale < right.Locale
+		}
+		return left.Field < right.Field
+	})
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	return writeFileNoFollow(machineTranslationsPath(dir), append(data, '\n'))
+}
+
+func buildMetadataTranslateRows(results []MetadataTranslateFieldResult) [][]string {
+	rows := make([][]string, 0, len(results))
+	for _, result := range results {
+		length := ""
+		if result.Limit > 0 {
+			length = fmt.Sprintf("%d/%d", result.Length, result.Limit)
+		}
+		rows = append(rows, []string{
+			result.Locale,
+			result.Field,
+			result.Action,
+			result.Reason,
+			length,
+			sanitizePlanCell(result.Value),
+		})
+	}
+	if len(rows) == 0 {
+		rows = append(rows, []string{"", "", "none", "no changes", "", ""})
+	}
+	return rows
+}
+
+var metadataTranslateHeaders = []string{"locale", "field", "action", "reason", "length", "value"}
+
+func printMetadataTranslateTable(result MetadataTranslateResult) error {
+	fmt.Println("Metadata Translate")
+	fmt.Printf("Dir: %s\n", result.Dir)
+	fmt.Printf("Version: %s\n", result.Version)
+	fmt.Printf("From: %s\n", result.FromLocale)
+	fmt.Printf("Provider: %s\n", result.Provider)
+	fmt.Printf("Dry Run: %t\n\n", result.DryRun)
+	asc.RenderTable(metadataTranslateHeaders, buildMetadataTranslateRows(result.Results))
+	if result.ReviewFile != "" {
+		fmt.Printf("\nMachine-translated fields are recorded in %s for review.\n", result.ReviewFile)
+	}
+	return nil
+}
+
+func printMetadataTranslateMarkdown(result MetadataTranslateResult) error {
+	fmt.Println("## Metadata Translate")
+	fmt.Println()
+	fmt.Printf("**Dir:** %s\n\n", result.Dir)
+	fmt.Printf("**Version:** %s\n\n", result.Version)
+	fmt.Printf("**From:** %s\n\n", result.FromLocale)
+	fmt.Printf("**Provider:** %s\n\n", result.Provider)
+	fmt.Printf("**Dry Run:** %t\n\n", result.DryRun)
+	asc.RenderMarkdown(metadataTranslateHeaders, buildMetadataTranslateRows(result.Results))
+	if result.ReviewFile != "" {
+		fmt.Printf("\nMachine-translated fields are recorded in `%s` for review.\n", result.ReviewFile)
+	}
+	return nil
+}
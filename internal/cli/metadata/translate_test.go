@@ -0,0 +1,209 @@
+package metadata
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/translate"
+)
+
+type fakeTranslator struct {
+	translate func(req translate.Request) string
+	requests  []translate.Request
+}
+
+func (f *fakeTranslator) Translate(_ context.Context, req translate.Request) (string, error) {
+	f.requests = append(f.requests, req)
+	return f.translate(req), nil
+}
+
+func writeTranslateFixture(t *testing.T, dir, locale string, loc VersionLocalization) string {
+	t.Helper()
+	path, err := VersionLocalizationFilePath(dir, "1.2.3", locale)
+	if err != nil {
+		t.Fatalf("VersionLocalizationFilePath() error: %v", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	data, err := EncodeVersionLocalization(loc)
+	if err != nil {
+		t.Fatalf("EncodeVersionLocalization() error: %v", err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		t.Fatalf("write fixture: %v", err)
+	}
+	return path
+}
+
+func TestExecuteMetadataTranslateWritesFieldsAndReviewManifest(t *testing.T) {
+	dir := t.TempDir()
+	writeTranslateFixture(t, dir, "en-US", VersionLocalization{
+		Description: "A habit tracker",
+		Keywords:    "habit,tracker",
+		WhatsNew:    "Fixes",
+	})
+	dePath := writeTranslateFixture(t, dir, "de-DE", VersionLocalization{WhatsNew: "Reviewed text", SupportURL: "https://example.com"})
+
+	translator := &fakeTranslator{translate: func(req translate.Request) string {
+		if req.Field == "keywords" {
+			return "Gewohnheit, Tracker, gewohnheit"
+		}
+		return req.TargetLocale + " " + req.Text
+	}}
+	result, err := executeMetadataTranslate(context.Background(), metadataTranslateOptions{
+		Dir:           dir,
+		Version:       "1.2.3",
+		FromLocale:    "en-US",
+		TargetLocales: []string{"ja", "de-DE"},
+		Provider:      translate.ProviderCommand,
+		Translator:    translator,
+	})
+	if err != nil {
+		t.Fatalf("executeMetadataTranslate() error: %v", err)
+	}
+	if !result.Valid || result.ReviewFile != filepath.Join(dir, machineTranslationsFileName) {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	if got := countTranslateResults(result.Results, "skip"); got != 3 {
+		t.Fatalf("expected 3 skips (empty promotionalText twice, reviewed whatsNew), got %d: %+v", got, result.Results)
+	}
+
+	de, err := ReadVersionLocalizationFile(dePath)
+	if err != nil {
+		t.Fatalf("read de-DE: %v", err)
+	}
+	if de.Description != "de-DE A habit tracker" || de.Keywords != "Gewohnheit,Tracker" || de.WhatsNew != "Reviewed text" || de.SupportURL != "https://example.com" {
+		t.Fatalf("unexpected de-DE file: %+v", de)
+	}
+	for _, req := range translator.requests {
+		if req.Field == "keywords" && req.MaxLength != 100 {
+			t.Fatalf("expected keyword limit in request, got %+v", req)
+		}
+	}
+
+	manifest, err := readMachineTranslations(dir)
+	if err != nil {
+		t.Fatalf("readMachineTranslations() error: %v", err)
+	}
+	if len(manifest.Fields) != 5 || manifest.Fields[0].Locale != "de-DE" || manifest.Fields[0].Field != "description" {
+		t.Fatalf("unexpected manifest: %+v", manifest.Fields)
+	}
+
+	validated, err := validateDir(dir, false)
+	if err != nil {
+		t.Fatalf("validateDir() error: %v", err)
+	}
+	if validated.WarningCount != 5 || !validated.Valid {
+		t.Fatalf("expected 5 review warnings, got %+v", validated)
+	}
+
+	de.Description = "Ein Gewohnheitstracker"
+	data, _ := EncodeVersionLocalization(de)
+	if err := os.WriteFile(dePath, data, 0o644); err != nil {
+		t.Fatalf("edit de-DE: %v", err)
+	}
+	validated, err = validateDir(dir, false)
+	if err != nil {
+		t.Fatalf("validateDir() error: %v", err)
+	}
+	if validated.WarningCount != 4 {
+		t.Fatalf("expected edited field to count as reviewed, got %d warnings", validated.WarningCount)
+	}
+}
+
+func TestExecuteMetadataTranslateRejectsOverLimitTranslations(t *testing.T) {
+	dir := t.TempDir()
+	writeTranslateFixture(t, dir, "en-US", VersionLocalization{PromotionalText: "Try it", WhatsNew: "Fixes"})
+
+	translator := &fakeTranslator{translate: func(req translate.Request) string {
+		if req.Field == "promotionalText" {
+			return strings.Repeat("ü", 171)
+		}
+		return "Corrections"
+	}}
+	result, err := executeMetadataTranslate(context.Background(), metadataTranslateOptions{
+		Dir:           dir,
+		Version:       "1.2.3",
+		FromLocale:    "en-US",
+		TargetLocales: []string{"fr-FR"},
+		Fields:        []string{"promotionalText", "whatsnew"},
+		Provider:      translate.ProviderCommand,
+		Translator:    translator,
+	})
+	if err != nil {
+		t.Fatalf("executeMetadataTranslate() error: %v", err)
+	}
+	if result.Valid || len(result.Results) != 2 {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	invalid := result.Results[0]
+	if invalid.Action != "invalid" || invalid.Length != 171 || invalid.Limit != 170 {
+		t.Fatalf("unexpected invalid result: %+v", invalid)
+	}
+
+	path, _ := VersionLocalizationFilePath(dir, "1.2.3", "fr-FR")
+	fr, err := ReadVersionLocalizationFile(path)
+	if err != nil {
+		t.Fatalf("read fr-FR: %v", err)
+	}
+	if fr.PromotionalText != "" || fr.WhatsNew != "Corrections" {
+		t.Fatalf("expected only the valid field written, got %+v", fr)
+	}
+}
+
+func TestExecuteMetadataTranslateDryRunWritesNothing(t *testing.T) {
+	dir := t.TempDir()
+	writeTranslateFixture(t, dir, "en-US", VersionLocalization{WhatsNew: "Fixes"})
+
+	result, err := executeMetadataTranslate(context.Background(), metadataTranslateOptions{
+		Dir:           dir,
+		Version:       "1.2.3",
+		FromLocale:    "en-US",
+		TargetLocales: []string{"it"},
+		Fields:        []string{"whatsNew"},
+		DryRun:        true,
+		Translator:    &fakeTranslator{translate: func(translate.Request) string { return "Correzioni" }},
+	})
+	if err != nil {
+		t.Fatalf("executeMetadataTranslate() error: %v", err)
+	}
+	if len(result.Results) != 1 || result.Results[0].Action != "create" || result.Results[0].Value != "Correzioni" {
+		t.Fatalf("unexpected results: %+v", result.Results)
+	}
+	if _, err := os.Stat(filepath.Join(dir, machineTranslationsFileName)); !os.IsNotExist(err) {
+		t.Fatalf("expected no review manifest on dry run, got %v", err)
+	}
+	path, _ := VersionLocalizationFilePath(dir, "1.2.3", "it")
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Fatalf("expected no locale file on dry run, got %v", err)
+	}
+}
+
+func TestNewMetadataTranslatorSelectsProvider(t *testing.T) {
+	t.Setenv(translateModelEnvVar, "")
+	provider, _, translator, err := newMetadataTranslator("", "./translate.sh", "", "")
+	if err != nil || provider != translate.ProviderCommand {
+		t.Fatalf("expected command provider, got %q, %v", provider, err)
+	}
+	if _, ok := translator.(*translate.CommandTranslator); !ok {
+		t.Fatalf("unexpected translator %T", translator)
+	}
+
+	if _, _, _, err := newMetadataTranslator("openai", "", "", ""); err == nil || !strings.Contains(err.Error(), "--model is required") {
+		t.Fatalf("expected model error, got %v", err)
+	}
+
+	t.Setenv(translateModelEnvVar, "llama3.1")
+	provider, model, _, err := newMetadataTranslator("", "", "http://localhost:11434/v1", "")
+	if err != nil || provider != translate.ProviderOpenAI || model != "llama3.1" {
+		t.Fatalf("expected openai provider with env model, got %q %q %v", provider, model, err)
+	}
+
+	if _, _, _, err := newMetadataTranslator("deepl", "", "", ""); err == nil {
+		t.Fatal("expected unknown provider error")
+	}
+}
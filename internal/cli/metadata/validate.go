@@ -65,6 +65,7 @@ Checks:
   - required fields
   - metadata character limits
   - optional subscription-app Terms of Use / EULA description link heuristic
+  - strict decode of app/, review/, and media/ scope files
 
 Examples:
   asc metadata validate --dir "./metadata"
@@ -87,7 +88,7 @@ Examples:
 				return err
 			}
 
-			if err := shared.PrintOutputWithRenderers(
+			if err := shared.PrintOutputWithRenderers(ctx,
 				result,
 				*output.Output,
 				*output.Pretty,
@@ -111,6 +112,11 @@ func validateDir(dir string, subscriptionApp bool) (ValidateResult, error) {
 		Issues: make([]ValidateIssue, 0),
 	}
 
+	machineTranslations, err := readMachineTranslations(dir)
+	if err != nil {
+		return ValidateResult{}, fmt.Errorf("metadata validate: %w", err)
+	}
+
 	appInfoDir := filepath.Join(dir, appInfoDirName)
 	appInfoEntries, err := os.ReadDir(appInfoDir)
 	if err != nil && !errors.Is(err, os.ErrNotExist) {
@@ -223,6 +229,7 @@ func validateDir(dir string, subscriptionApp bool) (ValidateResult, error) {
 					})
 				}
 				result.Issues = append(result.Issues, versionLengthIssues(filePath, version, resolvedLocale, loc)...)
+				result.Issues = append(result.Issues, machineTranslationIssues(machineTranslations, filePath, version, resolvedLocale, loc)...)
 				if subscriptionApp {
 					result.Issues = append(result.Issues, versionTermsIssues(filePath, version, resolvedLocale, loc)...)
 				}
@@ -230,6 +237,15 @@ func validateDir(dir string, subscriptionApp bool) (ValidateResult, error) {
 		}
 	}
 
+	scopeFiles, err := validateScopeFiles(dir)
+	if err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return ValidateResult{}, err
+		}
+		return ValidateResult{}, fmt.Errorf("metadata validate: %w", err)
+	}
+	result.FilesScanned += scopeFiles
+
 	if result.FilesScanned == 0 {
 		result.Issues = append(result.Issues, ValidateIssue{
 			Scope:    "metadata",
@@ -262,6 +278,56 @@ func validateDir(dir string, subscriptionApp bool) (ValidateResult, error) {
 	return result, nil
 }
 
+// validateScopeFiles strictly decodes the non-localization scope files under
+// app/, review/, and media/ and returns how many were found.
+func validateScopeFiles(dir string) (int, error) {
+	appScopes := newMetadataScopes([]string{
+		includeCategories,
+		includeAgeRating,
+		includeContentRights,
+		includeEULA,
+		includeAvailability,
+		includePricing,
+	})
+	filesScanned, err := loadScopes(appScopes, dir, "")
+	if err != nil {
+		return 0, err
+	}
+
+	reviewDir := filepath.Join(dir, reviewDirName)
+	reviewEntries, err := os.ReadDir(reviewDir)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return 0, fmt.Errorf("failed to read %s: %w", reviewDir, err)
+	}
+	for _, entry := range reviewEntries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".json" {
+			continue
+		}
+		count, err := (&reviewInfoScope{}).loadLocal(dir, strings.TrimSuffix(entry.Name(), ".json"))
+		if err != nil {
+			return 0, err
+		}
+		filesScanned += count
+	}
+
+	mediaRoot := filepath.Join(dir, mediaDirName)
+	mediaEntries, err := os.ReadDir(mediaRoot)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return 0, fmt.Errorf("failed to read %s: %w", mediaRoot, err)
+	}
+	for _, entry := range mediaEntries {
+		if !entry.IsDir() {
+			continue
+		}
+		count, err := (&mediaScope{}).loadLocal(dir, entry.Name())
+		if err != nil {
+			return 0, err
+		}
+		filesScanned += count
+	}
+	return filesScanned, nil
+}
+
 type metadataFieldIntentIssue struct {
 	Field   string
 	Message string
@@ -372,6 +438,29 @@ func appInfoLengthIssues(filePath, locale string, loc AppInfoLocalization) []Val
 	return issues
 }
 
+// machineTranslationIssues warns about fields that still hold the value
+// metadata translate wrote.
+func machineTranslationIssues(manifest *MachineTranslationManifest, filePath, version, locale string, loc VersionLocalization) []ValidateIssue {
+	values := versionLocalizationFieldValues(NormalizeVersionLocalization(loc))
+	issues := make([]ValidateIssue, 0)
+	for _, field := range translatableVersionFields {
+		entry, pending := manifest.pending(version, locale, field, values[field])
+		if !pending {
+			continue
+		}
+		issues = append(issues, ValidateIssue{
+			Scope:    versionDirName,
+			File:     filePath,
+			Locale:   locale,
+			Version:  version,
+			Field:    field,
+			Severity: issueSeverityWarning,
+			Message:  fmt.Sprintf("%s is machine-translated from %s and has not been reviewed", field, entry.SourceLocale),
+		})
+	}
+	return issues
+}
+
 func versionTermsIssues(filePath, version, locale string, loc VersionLocalization) []ValidateIssue {
 	description := strings.TrimSpace(loc.Description)
 	if description == "" || validation.HasTermsOfUseLink(description) {
@@ -174,7 +174,7 @@ Examples:
 				fmt.Fprintln(os.Stderr, "Error: --version-id is required (or set Deliverfile app_version and platform)")
 				return flag.ErrHelp
 			}
-			if strings.TrimSpace(*appID) == "" && strings.TrimSpace(inputs.DeliverfileConfig.AppIdentifier) == "" && shared.ResolveAppID("") == "" {
+			if strings.TrimSpace(*appID) == "" && strings.TrimSpace(inputs.DeliverfileConfig.AppIdentifier) == "" && shared.ResolveAppID(ctx, "") == "" {
 				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID or Deliverfile app_identifier)")
 				return flag.ErrHelp
 			}
@@ -186,7 +186,7 @@ Examples:
 				(strings.TrimSpace(*appID) == "" && strings.TrimSpace(inputs.DeliverfileConfig.AppIdentifier) != "") ||
 				(strings.TrimSpace(*versionID) == "" && strings.TrimSpace(inputs.DeliverfileConfig.AppVersion) != "" && strings.TrimSpace(inputs.DeliverfileConfig.Platform) != "")
 			if needsClient {
-				client, err = shared.GetASCClient()
+				client, err = shared.GetASCClient(ctx)
 				if err != nil {
 					return fmt.Errorf("migrate import: %w", err)
 				}
@@ -223,11 +223,11 @@ Examples:
 			}
 
 			if *dryRun {
-				return printMigrateOutput(result, *output.Output, *output.Pretty)
+				return printMigrateOutput(ctx, result, *output.Output, *output.Pretty)
 			}
 
 			if client == nil {
-				client, err = shared.GetASCClient()
+				client, err = shared.GetASCClient(ctx)
 				if err != nil {
 					return fmt.Errorf("migrate import: %w", err)
 				}
@@ -274,7 +274,7 @@ Examples:
 			result.ReviewInfoResult = reviewResult
 			result.ScreenshotResults = screenshotResults
 
-			if err := printMigrateOutput(result, *output.Output, *output.Pretty); err != nil {
+			if err := printMigrateOutput(ctx, result, *output.Output, *output.Pretty); err != nil {
 				return err
 			}
 			return shared.PrintSubmitReadinessCreateWarnings(os.Stderr, warnings)
@@ -325,13 +325,13 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			resolvedAppID := shared.ResolveAppID(*appID)
+			resolvedAppID := shared.ResolveAppID(ctx, *appID)
 			if resolvedAppID == "" {
 				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("migrate export: %w", err)
 			}
@@ -400,7 +400,7 @@ Examples:
 				TotalFiles: totalFiles,
 			}
 
-			return printMigrateOutput(result, *output.Output, *output.Pretty)
+			return printMigrateOutput(ctx, result, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -516,9 +516,9 @@ func writeAndCount(path, content string) int {
 }
 
 // printMigrateOutput handles output for migrate-specific result types.
-func printMigrateOutput(data any, format string, pretty bool) error {
+func printMigrateOutput(ctx context.Context, data any, format string, pretty bool) error {
 	normalizedFormat := shared.NormalizeOutputFormat(format)
-	return shared.PrintOutputWithRenderers(
+	return shared.PrintOutputWithRenderers(ctx,
 		data,
 		normalizedFormat,
 		pretty,
@@ -809,7 +809,7 @@ Examples:
 				Valid:       errorCount == 0,
 			}
 
-			return printMigrateOutput(result, *output.Output, *output.Pretty)
+			return printMigrateOutput(ctx, result, *output.Output, *output.Pretty)
 		},
 	}
 }
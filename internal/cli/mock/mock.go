@@ -0,0 +1,227 @@
+package mock
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"flag"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const (
+	serveDefaultHost = "127.0.0.1"
+	serveDefaultPort = 8788
+
+	mockKeyID    = "MOCKKEY123"
+	mockIssuerID = "00000000-0000-0000-0000-000000000000"
+)
+
+type serveStartup struct {
+	URL  string            `json:"url"`
+	Host string            `json:"host"`
+	Port int               `json:"port"`
+	Env  map[string]string `json:"env"`
+}
+
+// MockCommand returns the mock command group.
+func MockCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("mock", flag.ExitOnError)
+
+	return &ffcli.Command{
+		Name:       "mock",
+		ShortUsage: "asc mock <subcommand> [flags]",
+		ShortHelp:  "Run a local, stateful mock of the App Store Connect API.",
+		LongHelp: `Run a local, stateful mock of the App Store Connect API.
+
+The mock keeps apps, App Store versions, builds, beta groups, app infos, and
+their localizations in memory, so creates and updates are visible to later
+requests. Every request is checked against the embedded schema index (see
+` + "`asc schema`" + `); endpoints outside the modeled resources answer 501.
+
+Point asc at the mock with ASC_BASE_URL. No App Store Connect credentials
+are needed: serve prints a throwaway API key to use instead.
+
+Examples:
+  asc mock serve
+  asc mock serve --port 9000 --seed ./fixtures/account.json`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			MockServeCommand(),
+		},
+		Exec: func(ctx context.Context, args []string) error {
+			return flag.ErrHelp
+		},
+	}
+}
+
+// MockServeCommand returns the mock serve subcommand.
+func MockServeCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("serve", flag.ExitOnError)
+
+	host := fs.String("host", serveDefaultHost, "Loopback host to bind the mock server")
+	port := fs.Int("port", serveDefaultPort, "Port to bind the mock server (0 picks a free port)")
+	seed := fs.String("seed", "", "JSON:API document ({\"data\": [...]}) to load instead of the built-in sample account")
+	output := fs.String("output", "text", "Output format: text (default), json")
+
+	return &ffcli.Command{
+		Name:       "serve",
+		ShortUsage: "asc mock serve [flags]",
+		ShortHelp:  "Serve the mock App Store Connect API until interrupted.",
+		LongHelp: `Serve the mock App Store Connect API until interrupted.
+
+On startup, serve prints export lines that point asc at the mock, including
+a generated API key that is valid only for this mock. Run them in another
+shell, then use asc as usual:
+
+  asc apps list
+  asc versions create --app "6400000000" --version "1.1" --platform IOS
+
+The built-in sample account has one app (6400000000) with version 1.0,
+build 1, an internal beta group, and en-US localizations. Pass --seed to
+start from your own resources instead; GET /_mock/state returns the current
+state in the same format, so a run can be saved and reused as a seed.
+
+Examples:
+  asc mock serve
+  asc mock serve --port 0 --output json
+  asc mock serve --seed ./fixtures/account.json`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if len(args) > 0 {
+				return shared.UsageErrorf("unexpected argument(s): %s", strings.Join(args, " "))
+			}
+
+			bindHost := strings.TrimSpace(*host)
+			if !isLoopbackHost(bindHost) {
+				return shared.UsageErrorf("--host must be a loopback host (got %q)", bindHost)
+			}
+			if *port < 0 || *port > 65535 {
+				return shared.UsageError("--port must be between 0 and 65535")
+			}
+			outputFormat := strings.ToLower(strings.TrimSpace(*output))
+			if outputFormat != "text" && outputFormat != "json" {
+				return shared.UsageError("--output must be one of: text, json")
+			}
+
+			resources := DefaultSeed()
+			if path := strings.TrimSpace(*seed); path != "" {
+				loaded, err := LoadSeed(path)
+				if err != nil {
+					return fmt.Errorf("mock serve: %w", err)
+				}
+				resources = loaded
+			}
+
+			keyB64, err := generateMockKey()
+			if err != nil {
+				return fmt.Errorf("mock serve: %w", err)
+			}
+
+			address := net.JoinHostPort(bindHost, strconv.Itoa(*port))
+			listener, err := net.Listen("tcp", address)
+			if err != nil {
+				return fmt.Errorf("mock serve: failed to listen on %s: %w", address, err)
+			}
+			defer listener.Close()
+
+			tcpAddr, ok := listener.Addr().(*net.TCPAddr)
+			if !ok {
+				return fmt.Errorf("mock serve: unexpected listener address type %T", listener.Addr())
+			}
+			baseURL := "http://" + net.JoinHostPort(bindHost, strconv.Itoa(tcpAddr.Port))
+			startup := serveStartup{
+				URL:  baseURL,
+				Host: bindHost,
+				Port: tcpAddr.Port,
+				Env: map[string]string{
+					"ASC_BASE_URL":        baseURL,
+					"ASC_KEY_ID":          mockKeyID,
+					"ASC_ISSUER_ID":       mockIssuerID,
+					"ASC_PRIVATE_KEY_B64": keyB64,
+				},
+			}
+
+			server := &http.Server{
+				Handler:           NewServer(resources),
+				ReadHeaderTimeout: 5 * time.Second,
+				IdleTimeout:       60 * time.Second,
+			}
+			serveErrCh := make(chan error, 1)
+			go func() {
+				err := server.Serve(listener)
+				if errors.Is(err, http.ErrServerClosed) {
+					err = nil
+				}
+				serveErrCh <- err
+			}()
+
+			if outputFormat == "json" {
+				if err := asc.PrintJSON(startup); err != nil {
+					return fmt.Errorf("mock serve: %w", err)
+				}
+			} else {
+				fmt.Fprintf(os.Stderr, "Mock App Store Connect API listening on %s (Ctrl+C to stop)\n", baseURL)
+				for _, name := range []string{"ASC_BASE_URL", "ASC_KEY_ID", "ASC_ISSUER_ID", "ASC_PRIVATE_KEY_B64"} {
+					fmt.Fprintf(os.Stdout, "export %s=%s\n", name, startup.Env[name])
+				}
+			}
+
+			select {
+			case err := <-serveErrCh:
+				if err != nil {
+					return fmt.Errorf("mock serve: %w", err)
+				}
+				return nil
+			case <-ctx.Done():
+				shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+				defer cancel()
+				_ = server.Shutdown(shutdownCtx)
+				if err := <-serveErrCh; err != nil {
+					return fmt.Errorf("mock serve: %w", err)
+				}
+				return nil
+			}
+		},
+	}
+}
+
+// generateMockKey returns a fresh base64-encoded PKCS#8 P-256 key, the same
+// shape as an App Store Connect .p8 file, for ASC_PRIVATE_KEY_B64.
+func generateMockKey() (string, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return "", fmt.Errorf("generate key: %w", err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return "", fmt.Errorf("encode key: %w", err)
+	}
+	pemBytes := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+	return base64.StdEncoding.EncodeToString(pemBytes), nil
+}
+
+func isLoopbackHost(host string) bool {
+	if strings.EqualFold(host, "localhost") {
+		return true
+	}
+	ip := net.ParseIP(strings.Trim(host, "[]"))
+	return ip != nil && ip.IsLoopback()
+}
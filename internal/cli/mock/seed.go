@@ -0,0 +1,125 @@
+package mock
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// DefaultSeed returns a small, internally consistent account: one app with an
+// editable App Store version, a processed build, an internal beta group, and
+// en-US localizations.
+func DefaultSeed() []Resource {
+	app := Linkage{Type: "apps", ID: "6400000000"}
+	version := Linkage{Type: "appStoreVersions", ID: "00000000-0000-4000-8000-000000000101"}
+	build := Linkage{Type: "builds", ID: "00000000-0000-4000-8000-000000000201"}
+	appInfo := Linkage{Type: "appInfos", ID: "00000000-0000-4000-8000-000000000401"}
+
+	return []Resource{
+		{
+			Type: app.Type,
+			ID:   app.ID,
+			Attributes: map[string]any{
+				"name":          "Mock App",
+				"bundleId":      "com.example.mock",
+				"sku":           "MOCKAPP",
+				"primaryLocale": "en-US",
+			},
+		},
+		{
+			Type: appInfo.Type,
+			ID:   appInfo.ID,
+			Attributes: map[string]any{
+				"appStoreState": "PREPARE_FOR_SUBMISSION",
+				"state":         "PREPARE_FOR_SUBMISSION",
+			},
+			Relationships: map[string]Relationship{"app": {Items: []Linkage{app}}},
+		},
+		{
+			Type: "appInfoLocalizations",
+			ID:   "00000000-0000-4000-8000-000000000402",
+			Attributes: map[string]any{
+				"locale":   "en-US",
+				"name":     "Mock App",
+				"subtitle": "A pretend app for dry runs",
+			},
+			Relationships: map[string]Relationship{"appInfo": {Items: []Linkage{appInfo}}},
+		},
+		{
+			Type: build.Type,
+			ID:   build.ID,
+			Attributes: map[string]any{
+				"version":                 "1",
+				"processingState":         "VALID",
+				"uploadedDate":            "2026-01-01T00:00:00Z",
+				"expirationDate":          "2026-04-01T00:00:00Z",
+				"expired":                 false,
+				"minOsVersion":            "17.0",
+				"usesNonExemptEncryption": false,
+			},
+			Relationships: map[string]Relationship{"app": {Items: []Linkage{app}}},
+		},
+		{
+			Type: version.Type,
+			ID:   version.ID,
+			Attributes: map[string]any{
+				"platform":        "IOS",
+				"versionString":   "1.0",
+				"appStoreState":   "PREPARE_FOR_SUBMISSION",
+				"appVersionState": "PREPARE_FOR_SUBMISSION",
+				"releaseType":     "AFTER_APPROVAL",
+				"createdDate":     "2026-01-01T00:00:00Z",
+			},
+			Relationships: map[string]Relationship{
+				"app":   {Items: []Linkage{app}},
+				"build": {Items: []Linkage{build}},
+			},
+		},
+		{
+			Type: "appStoreVersionLocalizations",
+			ID:   "00000000-0000-4000-8000-000000000102",
+			Attributes: map[string]any{
+				"locale":      "en-US",
+				"description": "Mock App helps you rehearse releases.",
+				"keywords":    "mock,test",
+				"whatsNew":    "First release.",
+			},
+			Relationships: map[string]Relationship{"appStoreVersion": {Items: []Linkage{version}}},
+		},
+		{
+			Type: "betaGroups",
+			ID:   "00000000-0000-4000-8000-000000000301",
+			Attributes: map[string]any{
+				"name":              "Internal Testers",
+				"isInternalGroup":   true,
+				"publicLinkEnabled": false,
+				"createdDate":       "2026-01-01T00:00:00Z",
+			},
+			Relationships: map[string]Relationship{
+				"app":    {Items: []Linkage{app}},
+				"builds": {Many: true, Items: []Linkage{build}},
+			},
+		},
+	}
+}
+
+// LoadSeed reads seed resources from a JSON:API document ({"data": [...]}),
+// such as one saved from GET /_mock/state.
+func LoadSeed(path string) ([]Resource, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var document struct {
+		Data []Resource `json:"data"`
+	}
+	if err := json.Unmarshal(data, &document); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", path, err)
+	}
+	for i, resource := range document.Data {
+		if resource.Type == "" {
+			return nil, fmt.Errorf("parse %s: data[%d] is missing type", path, i)
+		}
+	}
+	return document.Data, nil
+}
@@ -0,0 +1,704 @@
+package mock
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/schema"
+)
+
+const (
+	defaultPageLimit = 50
+	maxPageLimit     = 200
+	maxBodyBytes     = 4 << 20
+
+	// stateEndpoint returns every stored resource, for asserting on the
+	// outcome of a pipeline run.
+	stateEndpoint = "/_mock/state"
+)
+
+// typeSpec describes a resource type the mock stores.
+type typeSpec struct {
+	// singular is the relationship name other resources use to refer to
+	// this type (for example "app" for apps).
+	singular string
+	// defaults are attributes set on create when the request omits them.
+	defaults func(now time.Time) map[string]any
+}
+
+// modeledTypes lists the resource types the mock keeps state for. Other
+// endpoints in the schema index answer 501 so callers can tell a gap in the
+// mock from a bad request.
+var modeledTypes = map[string]typeSpec{
+	"apps": {singular: "app"},
+	"appStoreVersions": {singular: "appStoreVersion", defaults: func(now time.Time) map[string]any {
+		return map[string]any{
+			"appStoreState":   "PREPARE_FOR_SUBMISSION",
+			"appVersionState": "PREPARE_FOR_SUBMISSION",
+			"releaseType":     "AFTER_APPROVAL",
+			"createdDate":     now.Format(time.RFC3339),
+		}
+	}},
+	"builds": {singular: "build"},
+	"betaGroups": {singular: "betaGroup", defaults: func(now time.Time) map[string]any {
+		return map[string]any{
+			"isInternalGroup":   false,
+			"publicLinkEnabled": false,
+			"createdDate":       now.Format(time.RFC3339),
+		}
+	}},
+	"appStoreVersionLocalizations": {singular: "appStoreVersionLocalization"},
+	"appInfos":                     {singular: "appInfo"},
+	"appInfoLocalizations":         {singular: "appInfoLocalization"},
+}
+
+// Resource is a stored JSON:API resource.
+type Resource struct {
+	Type          string                  `json:"type"`
+	ID            string                  `json:"id"`
+	Attributes    map[string]any          `json:"attributes,omitempty"`
+	Relationships map[string]Relationship `json:"relationships,omitempty"`
+}
+
+// Linkage identifies a related resource.
+type Linkage struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+}
+
+// Relationship holds to-one or to-many linkage data.
+type Relationship struct {
+	Many  bool
+	Items []Linkage
+}
+
+func (r Relationship) MarshalJSON() ([]byte, error) {
+	if r.Many {
+		items := r.Items
+		if items == nil {
+			items = []Linkage{}
+		}
+		return json.Marshal(map[string]any{"data": items})
+	}
+	if len(r.Items) == 0 {
+		return []byte(`{"data":null}`), nil
+	}
+	return json.Marshal(map[string]any{"data": r.Items[0]})
+}
+
+func (r *Relationship) UnmarshalJSON(data []byte) error {
+	var wrapper struct {
+		Data json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(data, &wrapper); err != nil {
+		return err
+	}
+	return r.setData(wrapper.Data)
+}
+
+func (r *Relationship) setData(data json.RawMessage) error {
+	trimmed := bytes.TrimSpace(data)
+	switch {
+	case len(trimmed) == 0 || bytes.Equal(trimmed, []byte("null")):
+		*r = Relationship{}
+	case trimmed[0] == '[':
+		var items []Linkage
+		if err := json.Unmarshal(trimmed, &items); err != nil {
+			return err
+		}
+		*r = Relationship{Many: true, Items: items}
+	default:
+		var item Linkage
+		if err := json.Unmarshal(trimmed, &item); err != nil {
+			return err
+		}
+		*r = Relationship{Items: []Linkage{item}}
+	}
+	return nil
+}
+
+func (r Relationship) references(resourceType, id string) bool {
+	return slices.Contains(r.Items, Linkage{Type: resourceType, ID: id})
+}
+
+// Server is an in-memory, stateful App Store Connect API.
+type Server struct {
+	mu        sync.Mutex
+	resources map[string][]*Resource
+	nextID    int
+	now       func() time.Time
+}
+
+// NewServer returns a server seeded with resources. Resources with an empty
+// ID are assigned one.
+func NewServer(seed []Resource) *Server {
+	s := &Server{
+		resources: make(map[string][]*Resource),
+		now:       func() time.Time { return time.Now().UTC() },
+	}
+	for i := range seed {
+		resource := seed[i]
+		if resource.ID == "" {
+			resource.ID = s.newID(resource.Type)
+		}
+		s.resources[resource.Type] = append(s.resources[resource.Type], &resource)
+	}
+	return s
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == stateEndpoint {
+		s.serveState(w, r)
+		return
+	}
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "Bearer ") {
+		writeError(w, http.StatusUnauthorized, "NOT_AUTHORIZED", "Authentication credentials are missing or invalid.", "Provide a properly configured and signed bearer token.")
+		return
+	}
+
+	endpoint, status := matchEndpoint(r.Method, r.URL.Path)
+	switch status {
+	case http.StatusNotFound:
+		writeError(w, http.StatusNotFound, "PATH_ERROR", "The URL path is not valid.", fmt.Sprintf("The path %s is not in the schema index.", r.URL.Path))
+		return
+	case http.StatusMethodNotAllowed:
+		writeError(w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", "The request method is not valid for the resource path.", fmt.Sprintf("%s is not allowed for %s.", r.Method, r.URL.Path))
+		return
+	}
+
+	segments := strings.Split(strings.Trim(r.URL.Path, "/"), "/")[1:]
+	if _, ok := modeledTypes[segments[0]]; !ok {
+		s.notImplemented(w, endpoint)
+		return
+	}
+
+	var body []byte
+	if r.Body != nil {
+		var err error
+		body, err = io.ReadAll(io.LimitReader(r.Body, maxBodyBytes))
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "PARAMETER_ERROR.INVALID", "The request body could not be read.", err.Error())
+			return
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch {
+	case len(segments) == 1 && r.Method == http.MethodGet:
+		s.list(w, r, s.resources[segments[0]])
+	case len(segments) == 1 && r.Method == http.MethodPost:
+		s.create(w, segments[0], body)
+	case len(segments) == 2:
+		s.serveResource(w, r, segments[0], segments[1], body)
+	case len(segments) == 3:
+		s.serveRelated(w, r, endpoint, segments[0], segments[1], segments[2])
+	case len(segments) == 4 && segments[2] == "relationships":
+		s.serveLinkage(w, r, segments[0], segments[1], segments[3], body)
+	default:
+		s.notImplemented(w, endpoint)
+	}
+}
+
+// matchEndpoint checks method and path against the schema index.
+func matchEndpoint(method, path string) (schema.Endpoint, int) {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(segments) < 2 || !strings.HasPrefix(segments[0], "v") {
+		return schema.Endpoint{}, http.StatusNotFound
+	}
+	matches, err := schema.MatchPath(path)
+	if err != nil || len(matches) == 0 {
+		return schema.Endpoint{}, http.StatusNotFound
+	}
+	for _, match := range matches {
+		if match.Method == method {
+			return match, http.StatusOK
+		}
+	}
+	return schema.Endpoint{}, http.StatusMethodNotAllowed
+}
+
+func (s *Server) notImplemented(w http.ResponseWriter, endpoint schema.Endpoint) {
+	writeError(w, http.StatusNotImplemented, "NOT_IMPLEMENTED", "The mock server does not model this endpoint.", fmt.Sprintf("asc mock serve does not keep state for %s %s.", endpoint.Method, endpoint.Path))
+}
+
+func (s *Server) serveResource(w http.ResponseWriter, r *http.Request, resourceType, id string, body []byte) {
+	resource := s.find(resourceType, id)
+	if resource == nil {
+		writeNotFound(w, resourceType, id)
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, map[string]any{"data": resource})
+	case http.MethodPatch:
+		s.update(w, resource, body)
+	case http.MethodDelete:
+		s.delete(resourceType, id)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// serveRelated answers GET /v1/{type}/{id}/{relationship}. Stored linkage on
+// the parent wins; otherwise resources of the related type that refer back to
+// the parent are returned.
+func (s *Server) serveRelated(w http.ResponseWriter, r *http.Request, endpoint schema.Endpoint, resourceType, id, name string) {
+	parent := s.find(resourceType, id)
+	if parent == nil {
+		writeNotFound(w, resourceType, id)
+		return
+	}
+
+	if rel, ok := parent.Relationships[name]; ok {
+		related := s.resolve(rel.Items)
+		if rel.Many {
+			s.list(w, r, related)
+			return
+		}
+		var data any
+		if len(related) > 0 {
+			data = related[0]
+		}
+		writeJSON(w, http.StatusOK, map[string]any{"data": data})
+		return
+	}
+
+	relatedType, toOne := relatedTypeFor(name)
+	if relatedType == "" {
+		s.notImplemented(w, endpoint)
+		return
+	}
+	var related []*Resource
+	for _, candidate := range s.resources[relatedType] {
+		for _, rel := range candidate.Relationships {
+			if rel.references(resourceType, id) {
+				related = append(related, candidate)
+				break
+			}
+		}
+	}
+	if toOne {
+		var data any
+		if len(related) > 0 {
+			data = related[0]
+		}
+		writeJSON(w, http.StatusOK, map[string]any{"data": data})
+		return
+	}
+	s.list(w, r, related)
+}
+
+// relatedTypeFor maps a relationship name to a modeled type and reports
+// whether the name is the singular (to-one) form.
+func relatedTypeFor(name string) (string, bool) {
+	if _, ok := modeledTypes[name]; ok {
+		return name, false
+	}
+	for resourceType, spec := range modeledTypes {
+		if spec.singular == name {
+			return resourceType, true
+		}
+	}
+	return "", false
+}
+
+// serveLinkage handles /v1/{type}/{id}/relationships/{name}.
+func (s *Server) serveLinkage(w http.ResponseWriter, r *http.Request, resourceType, id, name string, body []byte) {
+	parent := s.find(resourceType, id)
+	if parent == nil {
+		writeNotFound(w, resourceType, id)
+		return
+	}
+	if r.Method == http.MethodGet {
+		writeJSON(w, http.StatusOK, parent.Relationships[name])
+		return
+	}
+
+	var change Relationship
+	if err := json.Unmarshal(body, &change); err != nil {
+		writeError(w, http.StatusUnprocessableEntity, "ENTITY_UNPROCESSABLE", "The request entity is not valid.", err.Error())
+		return
+	}
+	if err := s.checkLinkage(change.Items); err != nil {
+		writeError(w, http.StatusConflict, "ENTITY_ERROR.RELATIONSHIP.INVALID", "The provided entity includes a relationship with an invalid value.", err.Error())
+		return
+	}
+
+	if parent.Relationships == nil {
+		parent.Relationships = make(map[string]Relationship)
+	}
+	current := parent.Relationships[name]
+	switch r.Method {
+	case http.MethodPatch:
+		current = change
+	case http.MethodPost:
+		current.Many = true
+		for _, item := range change.Items {
+			if !slices.Contains(current.Items, item) {
+				current.Items = append(current.Items, item)
+			}
+		}
+	case http.MethodDelete:
+		current.Items = slices.DeleteFunc(current.Items, func(item Linkage) bool {
+			return slices.Contains(change.Items, item)
+		})
+	}
+	parent.Relationships[name] = current
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) create(w http.ResponseWriter, resourceType string, body []byte) {
+	document, err := decodeDocument(body)
+	if err != nil {
+		writeError(w, http.StatusUnprocessableEntity, "ENTITY_UNPROCESSABLE", "The request entity is not valid.", err.Error())
+		return
+	}
+	if document.Type != resourceType {
+		writeError(w, http.StatusConflict, "ENTITY_ERROR.TYPE_MISMATCH", "The provided entity type is not valid.", fmt.Sprintf("Expected type %q, got %q.", resourceType, document.Type))
+		return
+	}
+	for _, rel := range document.Relationships {
+		if err := s.checkLinkage(rel.Items); err != nil {
+			writeError(w, http.StatusConflict, "ENTITY_ERROR.RELATIONSHIP.INVALID", "The provided entity includes a relationship with an invalid value.", err.Error())
+			return
+		}
+	}
+
+	attributes := map[string]any{}
+	if spec := modeledTypes[resourceType]; spec.defaults != nil {
+		attributes = spec.defaults(s.now())
+	}
+	for key, value := range document.Attributes {
+		attributes[key] = value
+	}
+	resource := &Resource{
+		Type:          resourceType,
+		ID:            s.newID(resourceType),
+		Attributes:    attributes,
+		Relationships: document.Relationships,
+	}
+	s.resources[resourceType] = append(s.resources[resourceType], resource)
+	writeJSON(w, http.StatusCreated, map[string]any{"data": resource})
+}
+
+func (s *Server) update(w http.ResponseWriter, resource *Resource, body []byte) {
+	document, err := decodeDocument(body)
+	if err != nil {
+		writeError(w, http.StatusUnprocessableEntity, "ENTITY_UNPROCESSABLE", "The request entity is not valid.", err.Error())
+		return
+	}
+	if document.Type != resource.Type || document.ID != resource.ID {
+		writeError(w, http.StatusConflict, "ENTITY_ERROR.ID_MISMATCH", "The provided entity does not match the resource path.", fmt.Sprintf("Expected %s %s.", resource.Type, resource.ID))
+		return
+	}
+	for _, rel := range document.Relationships {
+		if err := s.checkLinkage(rel.Items); err != nil {
+			writeError(w, http.StatusConflict, "ENTITY_ERROR.RELATIONSHIP.INVALID", "The provided entity includes a relationship with an invalid value.", err.Error())
+			return
+		}
+	}
+
+	if resource.Attributes == nil {
+		resource.Attributes = make(map[string]any)
+	}
+	for key, value := range document.Attributes {
+		resource.Attributes[key] = value
+	}
+	if len(document.Relationships) > 0 && resource.Relationships == nil {
+		resource.Relationships = make(map[string]Relationship)
+	}
+	for name, rel := range document.Relationships {
+		resource.Relationships[name] = rel
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"data": resource})
+}
+
+func (s *Server) delete(resourceType, id string) {
+	s.resources[resourceType] = slices.DeleteFunc(s.resources[resourceType], func(resource *Resource) bool {
+		return resource.ID == id
+	})
+}
+
+// list filters, sorts, and paginates resources like the real API. The cursor
+// is the offset of the next page.
+func (s *Server) list(w http.ResponseWriter, r *http.Request, resources []*Resource) {
+	query := r.URL.Query()
+	filtered := make([]*Resource, 0, len(resources))
+	for _, resource := range resources {
+		if matchesFilters(resource, query) {
+			filtered = append(filtered, resource)
+		}
+	}
+	if order := strings.TrimSpace(query.Get("sort")); order != "" {
+		sortResources(filtered, strings.Split(order, ","))
+	}
+
+	limit := defaultPageLimit
+	if raw := query.Get("limit"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed < 1 || parsed > maxPageLimit {
+			writeError(w, http.StatusBadRequest, "PARAMETER_ERROR.INVALID", "A parameter has an invalid value", fmt.Sprintf("'%s' is not a valid value for limit; it must be between 1 and %d.", raw, maxPageLimit))
+			return
+		}
+		limit = parsed
+	}
+	offset, _ := strconv.Atoi(query.Get("cursor"))
+	if offset < 0 || offset > len(filtered) {
+		offset = len(filtered)
+	}
+	end := min(offset+limit, len(filtered))
+
+	links := map[string]string{"self": requestURL(r, query)}
+	if end < len(filtered) {
+		next := cloneValues(query)
+		next.Set("cursor", strconv.Itoa(end))
+		links["next"] = requestURL(r, next)
+	}
+	page := filtered[offset:end]
+	if page == nil {
+		page = []*Resource{}
+	}
+	writeJSON(w, http.StatusOK, map[string]any{
+		"data":  page,
+		"links": links,
+		"meta":  map[string]any{"paging": map[string]int{"total": len(filtered), "limit": limit}},
+	})
+}
+
+// matchesFilters applies filter[key]=a,b parameters. Keys match the resource
+// id, an attribute, or a relationship name.
+func matchesFilters(resource *Resource, query url.Values) bool {
+	for key, values := range query {
+		if !strings.HasPrefix(key, "filter[") || !strings.HasSuffix(key, "]") {
+			continue
+		}
+		field := key[len("filter[") : len(key)-1]
+		var allowed []string
+		for _, value := range values {
+			for _, item := range strings.Split(value, ",") {
+				if item = strings.TrimSpace(item); item != "" {
+					allowed = append(allowed, item)
+				}
+			}
+		}
+		if len(allowed) == 0 {
+			continue
+		}
+
+		switch {
+		case field == "id":
+			if !slices.Contains(allowed, resource.ID) {
+				return false
+			}
+		case resource.Relationships[field].Items != nil:
+			matched := false
+			for _, item := range resource.Relationships[field].Items {
+				if slices.Contains(allowed, item.ID) {
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				return false
+			}
+		default:
+			value, ok := resource.Attributes[field]
+			if !ok || !slices.Contains(allowed, formatValue(value)) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func sortResources(resources []*Resource, keys []string) {
+	sort.SliceStable(resources, func(i, j int) bool {
+		for _, key := range keys {
+			key = strings.TrimSpace(key)
+			descending := strings.HasPrefix(key, "-")
+			key = strings.TrimPrefix(key, "-")
+			cmp := compareValues(resources[i].Attributes[key], resources[j].Attributes[key])
+			if cmp == 0 {
+				continue
+			}
+			if descending {
+				return cmp > 0
+			}
+			return cmp < 0
+		}
+		return false
+	})
+}
+
+func compareValues(a, b any) int {
+	af, aNumber := a.(float64)
+	bf, bNumber := b.(float64)
+	if aNumber && bNumber {
+		switch {
+		case af < bf:
+			return -1
+		case af > bf:
+			return 1
+		default:
+			return 0
+		}
+	}
+	return strings.Compare(formatValue(a), formatValue(b))
+}
+
+func formatValue(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func (s *Server) serveState(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", "The request method is not valid for the resource path.", "Only GET is supported.")
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	types := make([]string, 0, len(s.resources))
+	for resourceType := range s.resources {
+		types = append(types, resourceType)
+	}
+	sort.Strings(types)
+	data := []*Resource{}
+	for _, resourceType := range types {
+		data = append(data, s.resources[resourceType]...)
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"data": data})
+}
+
+func (s *Server) find(resourceType, id string) *Resource {
+	for _, resource := range s.resources[resourceType] {
+		if resource.ID == id {
+			return resource
+		}
+	}
+	return nil
+}
+
+func (s *Server) resolve(items []Linkage) []*Resource {
+	resolved := make([]*Resource, 0, len(items))
+	for _, item := range items {
+		if resource := s.find(item.Type, item.ID); resource != nil {
+			resolved = append(resolved, resource)
+		}
+	}
+	return resolved
+}
+
+// checkLinkage rejects references to modeled resources that do not exist.
+// Unmodeled types are accepted as-is.
+func (s *Server) checkLinkage(items []Linkage) error {
+	for _, item := range items {
+		if _, ok := modeledTypes[item.Type]; ok && s.find(item.Type, item.ID) == nil {
+			return fmt.Errorf("%s with id %q does not exist", item.Type, item.ID)
+		}
+	}
+	return nil
+}
+
+// newID returns numeric IDs for apps, like App Store Connect, and UUID-shaped
+// IDs for everything else.
+func (s *Server) newID(resourceType string) string {
+	for {
+		s.nextID++
+		id := fmt.Sprintf("00000000-0000-4000-8000-%012d", s.nextID)
+		if resourceType == "apps" {
+			id = strconv.Itoa(6400000000 + s.nextID)
+		}
+		if s.find(resourceType, id) == nil {
+			return id
+		}
+	}
+}
+
+type requestDocument struct {
+	Type          string
+	ID            string
+	Attributes    map[string]any
+	Relationships map[string]Relationship
+}
+
+func decodeDocument(body []byte) (requestDocument, error) {
+	var envelope struct {
+		Data *struct {
+			Type          string                  `json:"type"`
+			ID            string                  `json:"id"`
+			Attributes    map[string]any          `json:"attributes"`
+			Relationships map[string]Relationship `json:"relationships"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return requestDocument{}, err
+	}
+	if envelope.Data == nil {
+		return requestDocument{}, fmt.Errorf("the request body must contain a data object")
+	}
+	return requestDocument{
+		Type:          envelope.Data.Type,
+		ID:            envelope.Data.ID,
+		Attributes:    envelope.Data.Attributes,
+		Relationships: envelope.Data.Relationships,
+	}, nil
+}
+
+func requestURL(r *http.Request, query url.Values) string {
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	target := url.URL{Scheme: scheme, Host: r.Host, Path: r.URL.Path, RawQuery: query.Encode()}
+	return target.String()
+}
+
+func cloneValues(values url.Values) url.Values {
+	cloned := make(url.Values, len(values))
+	for key, items := range values {
+		cloned[key] = append([]string(nil), items...)
+	}
+	return cloned
+}
+
+func writeNotFound(w http.ResponseWriter, resourceType, id string) {
+	writeError(w, http.StatusNotFound, "NOT_FOUND", "The specified resource does not exist", fmt.Sprintf("There is no resource of type '%s' with id '%s'", resourceType, id))
+}
+
+func writeError(w http.ResponseWriter, status int, code, title, detail string) {
+	writeJSON(w, status, map[string]any{
+		"errors": []map[string]string{{
+			"status": strconv.Itoa(status),
+			"code":   code,
+			"title":  title,
+			"detail": detail,
+		}},
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, payload any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(payload)
+}
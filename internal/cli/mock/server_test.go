@@ -0,0 +1,207 @@
+package mock
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type testDocument struct {
+	Data   json.RawMessage   `json:"data"`
+	Links  map[string]string `json:"links"`
+	Errors []struct {
+		Status string `json:"status"`
+		Code   string `json:"code"`
+	} `json:"errors"`
+}
+
+func doRequest(t *testing.T, server *httptest.Server, method, path, body string) (int, testDocument) {
+	t.Helper()
+	var reader io.Reader
+	if body != "" {
+		reader = strings.NewReader(body)
+	}
+	req, err := http.NewRequest(method, server.URL+path, reader)
+	if err != nil {
+		t.Fatalf("NewRequest() error: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer test")
+	resp, err := server.Client().Do(req)
+	if err != nil {
+		t.Fatalf("Do() error: %v", err)
+	}
+	defer resp.Body.Close()
+
+	var document testDocument
+	if resp.StatusCode != http.StatusNoContent {
+		if err := json.NewDecoder(resp.Body).Decode(&document); err != nil {
+			t.Fatalf("decode %s %s: %v", method, path, err)
+		}
+	}
+	return resp.StatusCode, document
+}
+
+func resourceIDs(t *testing.T, data json.RawMessage) []string {
+	t.Helper()
+	var resources []Resource
+	if err := json.Unmarshal(data, &resources); err != nil {
+		t.Fatalf("decode resources: %v (%s)", err, data)
+	}
+	ids := make([]string, 0, len(resources))
+	for _, resource := range resources {
+		ids = append(ids, resource.ID)
+	}
+	return ids
+}
+
+func TestServer_CreateVersionIsListedUnderApp(t *testing.T) {
+	server := httptest.NewServer(NewServer(DefaultSeed()))
+	defer server.Close()
+
+	status, created := doRequest(t, server, http.MethodPost, "/v1/appStoreVersions", `{"data":{"type":"appStoreVersions","attributes":{"platform":"IOS","versionString":"1.1"},"relationships":{"app":{"data":{"type":"apps","id":"6400000000"}}}}}`)
+	if status != http.StatusCreated {
+		t.Fatalf("expected 201, got %d", status)
+	}
+	var version Resource
+	if err := json.Unmarshal(created.Data, &version); err != nil {
+		t.Fatalf("decode created version: %v", err)
+	}
+	if version.Attributes["appStoreState"] != "PREPARE_FOR_SUBMISSION" {
+		t.Fatalf("expected default appStoreState, got %v", version.Attributes)
+	}
+
+	status, listed := doRequest(t, server, http.MethodGet, "/v1/apps/6400000000/appStoreVersions?filter[versionString]=1.1", "")
+	if status != http.StatusOK {
+		t.Fatalf("expected 200, got %d", status)
+	}
+	if ids := resourceIDs(t, listed.Data); len(ids) != 1 || ids[0] != version.ID {
+		t.Fatalf("expected created version %s, got %v", version.ID, ids)
+	}
+}
+
+func TestServer_PaginatesWithNextLinks(t *testing.T) {
+	server := httptest.NewServer(NewServer(DefaultSeed()))
+	defer server.Close()
+
+	for _, name := range []string{"Beta", "Alpha"} {
+		status, _ := doRequest(t, server, http.MethodPost, "/v1/betaGroups", `{"data":{"type":"betaGroups","attributes":{"name":"`+name+`"},"relationships":{"app":{"data":{"type":"apps","id":"6400000000"}}}}}`)
+		if status != http.StatusCreated {
+			t.Fatalf("expected 201, got %d", status)
+		}
+	}
+
+	status, first := doRequest(t, server, http.MethodGet, "/v1/betaGroups?limit=2&sort=name", "")
+	if status != http.StatusOK {
+		t.Fatalf("expected 200, got %d", status)
+	}
+	var names []string
+	var page []Resource
+	if err := json.Unmarshal(first.Data, &page); err != nil {
+		t.Fatalf("decode page: %v", err)
+	}
+	for _, resource := range page {
+		names = append(names, resource.Attributes["name"].(string))
+	}
+	if strings.Join(names, ",") != "Alpha,Beta" {
+		t.Fatalf("expected sorted first page, got %v", names)
+	}
+	next := first.Links["next"]
+	if !strings.HasPrefix(next, server.URL+"/v1/betaGroups?") {
+		t.Fatalf("expected absolute next link, got %q", next)
+	}
+
+	status, second := doRequest(t, server, http.MethodGet, strings.TrimPrefix(next, server.URL), "")
+	if status != http.StatusOK {
+		t.Fatalf("expected 200, got %d", status)
+	}
+	if ids := resourceIDs(t, second.Data); len(ids) != 1 {
+		t.Fatalf("expected one remaining group, got %v", ids)
+	}
+	if second.Links["next"] != "" {
+		t.Fatalf("expected no next link on last page, got %q", second.Links["next"])
+	}
+}
+
+func TestServer_LinkageUpdatesRelatedLists(t *testing.T) {
+	server := httptest.NewServer(NewServer(DefaultSeed()))
+	defer server.Close()
+
+	_, created := doRequest(t, server, http.MethodPost, "/v1/betaGroups", `{"data":{"type":"betaGroups","attributes":{"name":"External"},"relationships":{"app":{"data":{"type":"apps","id":"6400000000"}}}}}`)
+	var group Resource
+	if err := json.Unmarshal(created.Data, &group); err != nil {
+		t.Fatalf("decode group: %v", err)
+	}
+
+	build := `{"data":[{"type":"builds","id":"00000000-0000-4000-8000-000000000201"}]}`
+	if status, _ := doRequest(t, server, http.MethodPost, "/v1/betaGroups/"+group.ID+"/relationships/builds", build); status != http.StatusNoContent {
+		t.Fatalf("expected 204, got %d", status)
+	}
+
+	_, groups := doRequest(t, server, http.MethodGet, "/v1/betaGroups?filter[builds]=00000000-0000-4000-8000-000000000201", "")
+	if ids := resourceIDs(t, groups.Data); len(ids) != 2 {
+		t.Fatalf("expected build in both beta groups, got %v", ids)
+	}
+
+	if status, _ := doRequest(t, server, http.MethodDelete, "/v1/betaGroups/"+group.ID+"/relationships/builds", build); status != http.StatusNoContent {
+		t.Fatalf("expected 204, got %d", status)
+	}
+	_, builds := doRequest(t, server, http.MethodGet, "/v1/betaGroups/"+group.ID+"/builds", "")
+	if ids := resourceIDs(t, builds.Data); len(ids) != 0 {
+		t.Fatalf("expected no builds after removal, got %v", ids)
+	}
+}
+
+func TestServer_Errors(t *testing.T) {
+	server := httptest.NewServer(NewServer(DefaultSeed()))
+	defer server.Close()
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		body   string
+		status int
+		code   string
+	}{
+		{name: "unknown path", method: http.MethodGet, path: "/v1/notARealThing", status: http.StatusNotFound, code: "PATH_ERROR"},
+		{name: "method not in schema", method: http.MethodDelete, path: "/v1/apps/6400000000", status: http.StatusMethodNotAllowed, code: "METHOD_NOT_ALLOWED"},
+		{name: "unmodeled endpoint", method: http.MethodGet, path: "/v1/apps/6400000000/subscriptionGroups", status: http.StatusNotImplemented, code: "NOT_IMPLEMENTED"},
+		{name: "missing resource", method: http.MethodGet, path: "/v1/builds/missing", status: http.StatusNotFound, code: "NOT_FOUND"},
+		{
+			name:   "dangling relationship",
+			method: http.MethodPost,
+			path:   "/v1/appStoreVersions",
+			body:   `{"data":{"type":"appStoreVersions","attributes":{"versionString":"2.0"},"relationships":{"app":{"data":{"type":"apps","id":"missing"}}}}}`,
+			status: http.StatusConflict,
+			code:   "ENTITY_ERROR.RELATIONSHIP.INVALID",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			status, document := doRequest(t, server, test.method, test.path, test.body)
+			if status != test.status {
+				t.Fatalf("expected %d, got %d", test.status, status)
+			}
+			if len(document.Errors) != 1 || document.Errors[0].Code != test.code {
+				t.Fatalf("expected %s error, got %+v", test.code, document.Errors)
+			}
+		})
+	}
+}
+
+func TestServer_RequiresBearerToken(t *testing.T) {
+	server := httptest.NewServer(NewServer(DefaultSeed()))
+	defer server.Close()
+
+	resp, err := server.Client().Get(server.URL + "/v1/apps")
+	if err != nil {
+		t.Fatalf("Get() error: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("expected 401, got %d", resp.StatusCode)
+	}
+}
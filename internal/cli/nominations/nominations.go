@@ -133,7 +133,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("nominations list: %w", err)
 			}
@@ -174,14 +174,12 @@ Examples:
 					return fmt.Errorf("nominations list: failed to fetch: %w", err)
 				}
 
-				nominations, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				if err := shared.PrintPaginatedOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetNominations(ctx, asc.WithNominationsNextURL(nextURL))
-				})
-				if err != nil {
+				}, *output.Output, *output.Pretty); err != nil {
 					return fmt.Errorf("nominations list: %w", err)
 				}
-
-				return shared.PrintOutput(nominations, *output.Output, *output.Pretty)
+				return nil
 			}
 
 			resp, err := client.GetNominations(requestCtx, opts...)
@@ -189,7 +187,7 @@ Examples:
 				return fmt.Errorf("nominations list: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -256,7 +254,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("nominations get: %w", err)
 			}
@@ -286,7 +284,7 @@ Examples:
 				return fmt.Errorf("nominations get: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -330,7 +328,7 @@ Examples:
 				visited[f.Name] = true
 			})
 
-			relatedApps := shared.SplitCSV(shared.ResolveAppID(*appID))
+			relatedApps := shared.SplitCSV(shared.ResolveAppID(ctx, *appID))
 			if len(relatedApps) == 0 {
 				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
 				return flag.ErrHelp
@@ -382,7 +380,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("nominations create: %w", err)
 			}
@@ -441,7 +439,7 @@ Examples:
 				return fmt.Errorf("nominations create: failed to create: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -640,7 +638,7 @@ Examples:
 				relationships = &relationshipValue
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("nominations update: %w", err)
 			}
@@ -653,7 +651,7 @@ Examples:
 				return fmt.Errorf("nominations update: failed to update: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -687,7 +685,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("nominations delete: %w", err)
 			}
@@ -704,7 +702,7 @@ Examples:
 				Deleted: true,
 			}
 
-			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 		},
 	}
 }
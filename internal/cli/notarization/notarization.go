@@ -120,7 +120,7 @@ Examples:
 				return fmt.Errorf("notarization submit: failed to compute SHA-256: %w", err)
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("notarization submit: %w", err)
 			}
@@ -191,7 +191,7 @@ Examples:
 						},
 					},
 				}
-				return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+				return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 			}
 
 			// Wait for notarization to complete
@@ -207,7 +207,7 @@ Examples:
 				return fmt.Errorf("notarization submit: %w", err)
 			}
 
-			if err := shared.PrintOutput(statusResp, *output.Output, *output.Pretty); err != nil {
+			if err := shared.PrintOutput(ctx, statusResp, *output.Output, *output.Pretty); err != nil {
 				return err
 			}
 
@@ -257,7 +257,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("notarization status: %w", err)
 			}
@@ -270,7 +270,7 @@ Examples:
 				return fmt.Errorf("notarization status: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -303,7 +303,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("notarization log: %w", err)
 			}
@@ -316,7 +316,7 @@ Examples:
 				return fmt.Errorf("notarization log: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -345,7 +345,7 @@ Examples:
 				return fmt.Errorf("notarization list: --limit must not be negative")
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("notarization list: %w", err)
 			}
@@ -362,7 +362,7 @@ Examples:
 				resp.Data = resp.Data[:*limit]
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
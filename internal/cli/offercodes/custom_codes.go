@@ -142,7 +142,7 @@ Examples:
 				normalizedExpiration = &normalized
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("offer-codes custom-codes create: %w", err)
 			}
@@ -174,7 +174,7 @@ Examples:
 				return fmt.Errorf("offer-codes custom-codes create: failed to create: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -54,7 +54,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("offer-codes generate: %w", err)
 			}
@@ -102,7 +102,7 @@ Examples:
 				}
 			}
 
-			if err := shared.PrintOutput(resp, *output.OutputFormat, *output.Pretty); err != nil {
+			if err := shared.PrintOutput(ctx, resp, *output.OutputFormat, *output.Pretty); err != nil {
 				return err
 			}
 			if writeErr != nil {
@@ -138,7 +138,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("offer-codes values: %w", err)
 			}
@@ -218,12 +218,12 @@ Examples:
 				return fmt.Errorf("offer-codes create: %w", err)
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("offer-codes create: %w", err)
 			}
 
-			resolvedAppID := shared.ResolveAppID(strings.TrimSpace(*appID))
+			resolvedAppID := shared.ResolveAppID(ctx, strings.TrimSpace(*appID))
 			if err := shared.RequireAppForStableSelector(resolvedAppID, subscription, "--subscription-id"); err != nil {
 				return err
 			}
@@ -252,7 +252,7 @@ Examples:
 				return fmt.Errorf("offer-codes create: failed to create: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -55,7 +55,7 @@ func newActiveUpdateCommand(config activeUpdateCommandConfig) *ffcli.Command {
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("%s: %w", config.ErrorPrefix, err)
 			}
@@ -68,7 +68,7 @@ func newActiveUpdateCommand(config activeUpdateCommandConfig) *ffcli.Command {
 				return fmt.Errorf("%s: failed to update: %w", config.ErrorPrefix, err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
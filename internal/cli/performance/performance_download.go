@@ -59,7 +59,7 @@ Examples:
 				selectionCount++
 			}
 			if selectionCount == 0 {
-				appFlag = shared.ResolveAppID(*appID)
+				appFlag = shared.ResolveAppID(ctx, *appID)
 				if appFlag == "" {
 					fmt.Fprintln(os.Stderr, "Error: --app, --build, or --diagnostic-id is required")
 					return flag.ErrHelp
@@ -88,7 +88,7 @@ Examples:
 				return shared.UsageError(err.Error())
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("performance download: %w", err)
 			}
@@ -136,7 +136,7 @@ Examples:
 					DecompressedSize:      decompressedSize,
 				}
 
-				return shared.PrintOutput(result, *outputFlags.OutputFormat, *outputFlags.Pretty)
+				return shared.PrintOutput(ctx, result, *outputFlags.OutputFormat, *outputFlags.Pretty)
 			case trimmedBuildID != "":
 				defaultOutput := fmt.Sprintf("perf_power_metrics_%s.json", trimmedBuildID)
 
@@ -181,7 +181,7 @@ Examples:
 					DecompressedSize: decompressedSize,
 				}
 
-				return shared.PrintOutput(result, *outputFlags.OutputFormat, *outputFlags.Pretty)
+				return shared.PrintOutput(ctx, result, *outputFlags.OutputFormat, *outputFlags.Pretty)
 			default:
 				defaultOutput := fmt.Sprintf("perf_power_metrics_%s.json", appFlag)
 
@@ -226,7 +226,7 @@ Examples:
 					DecompressedSize: decompressedSize,
 				}
 
-				return shared.PrintOutput(result, *outputFlags.OutputFormat, *outputFlags.Pretty)
+				return shared.PrintOutput(ctx, result, *outputFlags.OutputFormat, *outputFlags.Pretty)
 			}
 		},
 	}
@@ -62,13 +62,13 @@ Examples:
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
-			resolvedAppID := shared.ResolveAppID(*appID)
+			resolvedAppID := shared.ResolveAppID(ctx, *appID)
 			if resolvedAppID == "" {
 				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("pre-orders get: %w", err)
 			}
@@ -84,7 +84,7 @@ Examples:
 				return fmt.Errorf("pre-orders get: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -166,7 +166,7 @@ Examples:
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
-			resolvedAppID := shared.ResolveAppID(*appID)
+			resolvedAppID := shared.ResolveAppID(ctx, *appID)
 			if resolvedAppID == "" {
 				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
 				return flag.ErrHelp
@@ -198,7 +198,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("pre-orders enable: %w", err)
 			}
@@ -217,21 +217,7 @@ Examples:
 			if availabilityID == "" {
 				return fmt.Errorf("pre-orders enable: app availability ID missing from response")
 			}
-			firstPage, err := client.GetTerritoryAvailabilities(requestCtx, availabilityID, asc.WithTerritoryAvailabilitiesLimit(200))
-			if err != nil {
-				return fmt.Errorf("pre-orders enable: %w", err)
-			}
-			paginated, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
-				return client.GetTerritoryAvailabilities(ctx, availabilityID, asc.WithTerritoryAvailabilitiesNextURL(nextURL))
-			})
-			if err != nil {
-				return fmt.Errorf("pre-orders enable: %w", err)
-			}
-			territoryResp, ok := paginated.(*asc.TerritoryAvailabilitiesResponse)
-			if !ok {
-				return fmt.Errorf("pre-orders enable: unexpected territory availabilities response")
-			}
-			territoryMap, err := shared.MapTerritoryAvailabilityIDs(territoryResp)
+			territoryMap, err := shared.FetchTerritoryAvailabilityIDs(requestCtx, client, availabilityID)
 			if err != nil {
 				return fmt.Errorf("pre-orders enable: %w", err)
 			}
@@ -265,7 +251,7 @@ Examples:
 				updated = append(updated, updateResp.Data)
 			}
 
-			return shared.PrintOutput(&asc.TerritoryAvailabilitiesResponse{Data: updated}, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, &asc.TerritoryAvailabilitiesResponse{Data: updated}, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -335,7 +321,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("pre-orders update: %w", err)
 			}
@@ -348,7 +334,7 @@ Examples:
 				return fmt.Errorf("pre-orders update: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -378,7 +364,7 @@ Examples:
 			}
 
 			preOrderEnabled := false
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("pre-orders disable: %w", err)
 			}
@@ -394,7 +380,7 @@ Examples:
 				return fmt.Errorf("pre-orders disable: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -423,7 +409,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("pre-orders end: %w", err)
 			}
@@ -436,7 +422,7 @@ Examples:
 				return fmt.Errorf("pre-orders end: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
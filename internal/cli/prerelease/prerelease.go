@@ -76,14 +76,14 @@ Examples:
 				return fmt.Errorf("pre-release-versions list: %w", err)
 			}
 
-			resolvedAppID := strings.TrimSpace(shared.ResolveAppID(strings.TrimSpace(*appID)))
+			resolvedAppID := strings.TrimSpace(shared.ResolveAppID(ctx, strings.TrimSpace(*appID)))
 			nextValue := strings.TrimSpace(*next)
 			if resolvedAppID == "" && nextValue == "" {
 				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("pre-release-versions list: %w", err)
 			}
@@ -112,14 +112,12 @@ Examples:
 				}
 
 				// Fetch all remaining pages
-				versions, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				if err := shared.PrintPaginatedOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetPreReleaseVersions(ctx, resolvedAppID, asc.WithPreReleaseVersionsNextURL(nextURL))
-				})
-				if err != nil {
+				}, *output.Output, *output.Pretty); err != nil {
 					return fmt.Errorf("pre-release-versions list: %w", err)
 				}
-
-				return shared.PrintOutput(versions, *output.Output, *output.Pretty)
+				return nil
 			}
 
 			versions, err := client.GetPreReleaseVersions(requestCtx, resolvedAppID, opts...)
@@ -127,7 +125,7 @@ Examples:
 				return fmt.Errorf("pre-release-versions list: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(versions, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, versions, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -156,7 +154,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("pre-release-versions get: %w", err)
 			}
@@ -169,7 +167,7 @@ Examples:
 				return fmt.Errorf("pre-release-versions get: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(version, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, version, *output.Output, *output.Pretty)
 		},
 	}
 }
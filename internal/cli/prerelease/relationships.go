@@ -104,7 +104,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("pre-release-versions relationships get: %w", err)
 			}
@@ -118,7 +118,7 @@ Examples:
 				if err != nil {
 					return fmt.Errorf("pre-release-versions relationships get: %w", err)
 				}
-				return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+				return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 			case relationshipList:
 				opts := []asc.LinkagesOption{
 					asc.WithLinkagesLimit(*limit),
@@ -131,20 +131,19 @@ Examples:
 					if err != nil {
 						return fmt.Errorf("pre-release-versions relationships get: failed to fetch: %w", err)
 					}
-					resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+					if err := shared.PrintPaginatedOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 						return getPreReleaseRelationshipList(ctx, client, relationshipType, versionValue, asc.WithLinkagesNextURL(nextURL))
-					})
-					if err != nil {
+					}, *output.Output, *output.Pretty); err != nil {
 						return fmt.Errorf("pre-release-versions relationships get: %w", err)
 					}
-					return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+					return nil
 				}
 
 				resp, err := getPreReleaseRelationshipList(requestCtx, client, relationshipType, versionValue, opts...)
 				if err != nil {
 					return fmt.Errorf("pre-release-versions relationships get: %w", err)
 				}
-				return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+				return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 			default:
 				return fmt.Errorf("pre-release-versions relationships get: unsupported relationship type %q", relationshipType)
 			}
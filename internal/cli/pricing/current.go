@@ -71,7 +71,7 @@ Examples:
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
-			resolvedAppID := shared.ResolveAppID(*appID)
+			resolvedAppID := shared.ResolveAppID(ctx, *appID)
 			if resolvedAppID == "" {
 				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
 				return flag.ErrHelp
@@ -91,7 +91,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("pricing current: %w", err)
 			}
@@ -166,11 +166,59 @@ Examples:
 				return fmt.Errorf("pricing current: %w", err)
 			}
 
-			return printAppCurrentPricingResult(result, *output.Output, *output.Pretty)
+			return printAppCurrentPricingResult(ctx, result, *output.Output, *output.Pretty)
 		},
 	}
 }
 
+// CurrentAppPrice is the price an app charges today in its base territory.
+type CurrentAppPrice struct {
+	BaseTerritory string
+	CustomerPrice string
+}
+
+// ResolveCurrentAppPrice returns an app's base territory and the manual price
+// active there today. A missing price schedule is returned as the wrapped API
+// error, so callers can test it with asc.IsNotFound.
+func ResolveCurrentAppPrice(ctx context.Context, client *asc.Client, appID string) (CurrentAppPrice, error) {
+	scheduleResp, err := getAppPriceScheduleWithTimeout(ctx, client, appID)
+	if err != nil {
+		return CurrentAppPrice{}, fmt.Errorf("get app price schedule: %w", err)
+	}
+	scheduleID := strings.TrimSpace(scheduleResp.Data.ID)
+	if scheduleID == "" {
+		return CurrentAppPrice{}, fmt.Errorf("app price schedule ID missing")
+	}
+
+	baseTerritoryResp, err := getAppPriceScheduleBaseTerritoryWithTimeout(ctx, client, scheduleID)
+	if err != nil {
+		return CurrentAppPrice{}, fmt.Errorf("get base territory: %w", err)
+	}
+	baseTerritory := strings.ToUpper(strings.TrimSpace(baseTerritoryResp.Data.ID))
+	if baseTerritory == "" {
+		return CurrentAppPrice{}, fmt.Errorf("base territory missing from response")
+	}
+
+	entries, values, currencies, err := fetchAppSchedulePriceEntries(ctx, func(callCtx context.Context, opts ...asc.AppPriceSchedulePricesOption) (*asc.AppPricesResponse, error) {
+		return client.GetAppPriceScheduleManualPrices(callCtx, scheduleID, opts...)
+	})
+	if err != nil {
+		return CurrentAppPrice{}, fmt.Errorf("fetch manual prices: %w", err)
+	}
+
+	current, found, err := resolveCurrentTerritoryPrice(dedupeAppPriceEntries(entries), values, currencies, baseTerritory, time.Now().UTC())
+	if err != nil {
+		return CurrentAppPrice{}, err
+	}
+	if !found {
+		return CurrentAppPrice{}, fmt.Errorf("no current price found for base territory %s", baseTerritory)
+	}
+	return CurrentAppPrice{
+		BaseTerritory: baseTerritory,
+		CustomerPrice: current.CustomerPrice,
+	}, nil
+}
+
 type appSchedulePricePageFetcher func(context.Context, ...asc.AppPriceSchedulePricesOption) (*asc.AppPricesResponse, error)
 
 func fetchAppSchedulePriceEntries(
@@ -623,8 +671,8 @@ func getAppPriceScheduleBaseTerritoryWithTimeout(ctx context.Context, client *as
 	return client.GetAppPriceScheduleBaseTerritory(callCtx, scheduleID)
 }
 
-func printAppCurrentPricingResult(result *appCurrentPricingResult, format string, pretty bool) error {
-	return shared.PrintOutputWithRenderers(
+func printAppCurrentPricingResult(ctx context.Context, result *appCurrentPricingResult, format string, pretty bool) error {
+	return shared.PrintOutputWithRenderers(ctx,
 		result,
 		format,
 		pretty,
@@ -106,7 +106,7 @@ Examples:
 				return fmt.Errorf("pricing territories list: %w", err)
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("pricing territories list: %w", err)
 			}
@@ -126,14 +126,12 @@ Examples:
 					return fmt.Errorf("pricing territories list: failed to fetch: %w", err)
 				}
 
-				territories, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				if err := shared.PrintPaginatedOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetTerritories(ctx, asc.WithTerritoriesNextURL(nextURL))
-				})
-				if err != nil {
+				}, *output.Output, *output.Pretty); err != nil {
 					return fmt.Errorf("pricing territories list: %w", err)
 				}
-
-				return shared.PrintOutput(territories, *output.Output, *output.Pretty)
+				return nil
 			}
 
 			resp, err := client.GetTerritories(requestCtx, opts...)
@@ -141,7 +139,7 @@ Examples:
 				return fmt.Errorf("pricing territories list: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -183,13 +181,13 @@ Examples:
 				return fmt.Errorf("pricing price-points: %w", err)
 			}
 
-			resolvedAppID := shared.ResolveAppID(*appID)
+			resolvedAppID := shared.ResolveAppID(ctx, *appID)
 			if resolvedAppID == "" && strings.TrimSpace(*next) == "" {
 				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("pricing price-points: %w", err)
 			}
@@ -218,14 +216,12 @@ Examples:
 					return fmt.Errorf("pricing price-points: failed to fetch: %w", err)
 				}
 
-				points, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				if err := shared.PrintPaginatedOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetAppPricePoints(ctx, resolvedAppID, asc.WithPricePointsNextURL(nextURL))
-				})
-				if err != nil {
+				}, *output.Output, *output.Pretty); err != nil {
 					return fmt.Errorf("pricing price-points: %w", err)
 				}
-
-				return shared.PrintOutput(points, *output.Output, *output.Pretty)
+				return nil
 			}
 
 			points, err := client.GetAppPricePoints(requestCtx, resolvedAppID, opts...)
@@ -233,7 +229,7 @@ Examples:
 				return fmt.Errorf("pricing price-points: %w", err)
 			}
 
-			return shared.PrintOutput(points, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, points, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -262,7 +258,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("pricing price-points get: %w", err)
 			}
@@ -275,7 +271,7 @@ Examples:
 				return fmt.Errorf("pricing price-points get: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -353,7 +349,7 @@ Examples:
 			idValue := strings.TrimSpace(*id)
 			appValue := ""
 			if idValue == "" {
-				appValue = shared.ResolveAppID(*appID)
+				appValue = shared.ResolveAppID(ctx, *appID)
 			}
 			if idValue == "" && appValue == "" {
 				fmt.Fprintln(os.Stderr, "Error: --app or --id is required (or set ASC_APP_ID)")
@@ -364,7 +360,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("pricing schedule get: %w", err)
 			}
@@ -382,7 +378,7 @@ Examples:
 				return fmt.Errorf("pricing schedule get: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -447,7 +443,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("pricing schedule manual-prices: %w", err)
 			}
@@ -460,7 +456,7 @@ Examples:
 				if err != nil {
 					return fmt.Errorf("pricing schedule manual-prices: failed to resolve: %w", err)
 				}
-				return shared.PrintResolvedPrices(resp, *output.Output, *output.Pretty)
+				return shared.PrintResolvedPrices(ctx, resp, *output.Output, *output.Pretty)
 			}
 
 			opts := []asc.AppPriceSchedulePricesOption{
@@ -475,14 +471,12 @@ Examples:
 					return fmt.Errorf("pricing schedule manual-prices: %w", err)
 				}
 
-				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				if err := shared.PrintPaginatedOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetAppPriceScheduleManualPrices(ctx, trimmedScheduleID, asc.WithAppPriceSchedulePricesNextURL(nextURL))
-				})
-				if err != nil {
+				}, *output.Output, *output.Pretty); err != nil {
 					return fmt.Errorf("pricing schedule manual-prices: %w", err)
 				}
-
-				return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+				return nil
 			}
 
 			resp, err := client.GetAppPriceScheduleManualPrices(requestCtx, trimmedScheduleID, opts...)
@@ -490,7 +484,7 @@ Examples:
 				return fmt.Errorf("pricing schedule manual-prices: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -537,7 +531,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("pricing schedule automatic-prices: %w", err)
 			}
@@ -550,7 +544,7 @@ Examples:
 				if err != nil {
 					return fmt.Errorf("pricing schedule automatic-prices: failed to resolve: %w", err)
 				}
-				return shared.PrintResolvedPrices(resp, *output.Output, *output.Pretty)
+				return shared.PrintResolvedPrices(ctx, resp, *output.Output, *output.Pretty)
 			}
 
 			opts := []asc.AppPriceSchedulePricesOption{
@@ -565,14 +559,12 @@ Examples:
 					return fmt.Errorf("pricing schedule automatic-prices: %w", err)
 				}
 
-				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				if err := shared.PrintPaginatedOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetAppPriceScheduleAutomaticPrices(ctx, trimmedScheduleID, asc.WithAppPriceSchedulePricesNextURL(nextURL))
-				})
-				if err != nil {
+				}, *output.Output, *output.Pretty); err != nil {
 					return fmt.Errorf("pricing schedule automatic-prices: %w", err)
 				}
-
-				return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+				return nil
 			}
 
 			resp, err := client.GetAppPriceScheduleAutomaticPrices(requestCtx, trimmedScheduleID, opts...)
@@ -580,7 +572,7 @@ Examples:
 				return fmt.Errorf("pricing schedule automatic-prices: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -639,7 +631,7 @@ Examples:
 			idValue := strings.TrimSpace(*id)
 			appValue := ""
 			if idValue == "" {
-				appValue = shared.ResolveAppID(*appID)
+				appValue = shared.ResolveAppID(ctx, *appID)
 			}
 			if idValue == "" && appValue == "" {
 				fmt.Fprintln(os.Stderr, "Error: --app or --id is required (or set ASC_APP_ID)")
@@ -650,7 +642,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("pricing availability get: %w", err)
 			}
@@ -671,7 +663,7 @@ Examples:
 				return fmt.Errorf("pricing availability get: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
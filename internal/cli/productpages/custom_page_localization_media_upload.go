@@ -41,7 +41,7 @@ Examples:
 			if err != nil {
 				return fmt.Errorf("custom-pages localizations screenshot-sets upload: %w", err)
 			}
-			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -78,7 +78,7 @@ Examples:
 			if err != nil {
 				return fmt.Errorf("custom-pages localizations screenshot-sets sync: %w", err)
 			}
-			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -108,7 +108,7 @@ Examples:
 			if err != nil {
 				return fmt.Errorf("custom-pages localizations preview-sets upload: %w", err)
 			}
-			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -145,7 +145,7 @@ Examples:
 			if err != nil {
 				return fmt.Errorf("custom-pages localizations preview-sets sync: %w", err)
 			}
-			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -212,7 +212,7 @@ func executeCustomPagePreviewUpload(
 		return nil, err
 	}
 
-	client, err := customPageMediaClientFactory()
+	client, err := customPageMediaClientFactory(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -99,7 +99,7 @@ func TestExecuteCustomPageScreenshotUpload_SyncDeletesExistingScreenshotsAndReor
 
 	client := newCustomPageTestClient(t)
 	origFactory := customPageMediaClientFactory
-	customPageMediaClientFactory = func() (*asc.Client, error) { return client, nil }
+	customPageMediaClientFactory = func(context.Context) (*asc.Client, error) { return client, nil }
 	t.Cleanup(func() {
 		customPageMediaClientFactory = origFactory
 	})
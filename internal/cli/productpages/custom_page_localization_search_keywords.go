@@ -63,7 +63,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("custom-pages localizations search-keywords list: %w", err)
 			}
@@ -76,7 +76,7 @@ Examples:
 				return fmt.Errorf("custom-pages localizations search-keywords list: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -112,7 +112,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("custom-pages localizations search-keywords add: %w", err)
 			}
@@ -124,7 +124,7 @@ Examples:
 				return fmt.Errorf("custom-pages localizations search-keywords add: failed to add: %w", err)
 			}
 
-			return shared.PrintOutput(shared.BuildAppKeywordsResponse(keywordValues), *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, shared.BuildAppKeywordsResponse(keywordValues), *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -165,7 +165,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("custom-pages localizations search-keywords delete: %w", err)
 			}
@@ -177,7 +177,7 @@ Examples:
 				return fmt.Errorf("custom-pages localizations search-keywords delete: failed to delete: %w", err)
 			}
 
-			return shared.PrintOutput(shared.BuildAppKeywordsResponse(keywordValues), *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, shared.BuildAppKeywordsResponse(keywordValues), *output.Output, *output.Pretty)
 		},
 	}
 }
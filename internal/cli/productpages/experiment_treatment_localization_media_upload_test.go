@@ -90,7 +90,7 @@ func TestExecuteExperimentTreatmentLocalizationScreenshotUpload_UploadCreatesSet
 
 	client := newCustomPageTestClientWithTimeout(t, 0)
 	origFactory := experimentTreatmentLocalizationMediaClientFactory
-	experimentTreatmentLocalizationMediaClientFactory = func() (*asc.Client, error) { return client, nil }
+	experimentTreatmentLocalizationMediaClientFactory = func(context.Context) (*asc.Client, error) { return client, nil }
 	t.Cleanup(func() {
 		experimentTreatmentLocalizationMediaClientFactory = origFactory
 	})
@@ -158,7 +158,7 @@ func TestExecuteExperimentTreatmentLocalizationScreenshotUpload_CanonicalizesAli
 
 	client := newCustomPageTestClient(t)
 	origFactory := experimentTreatmentLocalizationMediaClientFactory
-	experimentTreatmentLocalizationMediaClientFactory = func() (*asc.Client, error) { return client, nil }
+	experimentTreatmentLocalizationMediaClientFactory = func(context.Context) (*asc.Client, error) { return client, nil }
 	t.Cleanup(func() {
 		experimentTreatmentLocalizationMediaClientFactory = origFactory
 	})
@@ -240,7 +240,7 @@ func TestExecuteExperimentTreatmentLocalizationScreenshotUpload_UploadPreservesE
 
 	client := newCustomPageTestClient(t)
 	origFactory := experimentTreatmentLocalizationMediaClientFactory
-	experimentTreatmentLocalizationMediaClientFactory = func() (*asc.Client, error) { return client, nil }
+	experimentTreatmentLocalizationMediaClientFactory = func(context.Context) (*asc.Client, error) { return client, nil }
 	t.Cleanup(func() {
 		experimentTreatmentLocalizationMediaClientFactory = origFactory
 	})
@@ -329,7 +329,7 @@ func TestExecuteExperimentTreatmentLocalizationScreenshotUpload_SyncDeletesExist
 
 	client := newCustomPageTestClient(t)
 	origFactory := experimentTreatmentLocalizationMediaClientFactory
-	experimentTreatmentLocalizationMediaClientFactory = func() (*asc.Client, error) { return client, nil }
+	experimentTreatmentLocalizationMediaClientFactory = func(context.Context) (*asc.Client, error) { return client, nil }
 	t.Cleanup(func() {
 		experimentTreatmentLocalizationMediaClientFactory = origFactory
 	})
@@ -396,7 +396,7 @@ func TestExecuteExperimentTreatmentLocalizationScreenshotUpload_UsesRequestTimeo
 
 	client := newCustomPageTestClient(t)
 	origFactory := experimentTreatmentLocalizationMediaClientFactory
-	experimentTreatmentLocalizationMediaClientFactory = func() (*asc.Client, error) { return client, nil }
+	experimentTreatmentLocalizationMediaClientFactory = func(context.Context) (*asc.Client, error) { return client, nil }
 	t.Cleanup(func() {
 		experimentTreatmentLocalizationMediaClientFactory = origFactory
 	})
@@ -86,13 +86,13 @@ Examples:
 			}
 
 			if *v2 {
-				resolvedAppID := shared.ResolveAppID(*appID)
+				resolvedAppID := shared.ResolveAppID(ctx, *appID)
 				if resolvedAppID == "" {
 					fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
 					return flag.ErrHelp
 				}
 
-				client, err := shared.GetASCClient()
+				client, err := shared.GetASCClient(ctx)
 				if err != nil {
 					return fmt.Errorf("experiments list: %w", err)
 				}
@@ -113,14 +113,12 @@ Examples:
 						return fmt.Errorf("experiments list: failed to fetch: %w", err)
 					}
 
-					paginated, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+					if err := shared.PrintPaginatedOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 						return client.GetAppStoreVersionExperimentsV2(ctx, resolvedAppID, asc.WithAppStoreVersionExperimentsV2NextURL(nextURL))
-					})
-					if err != nil {
+					}, *output.Output, *output.Pretty); err != nil {
 						return fmt.Errorf("experiments list: %w", err)
 					}
-
-					return shared.PrintOutput(paginated, *output.Output, *output.Pretty)
+					return nil
 				}
 
 				resp, err := client.GetAppStoreVersionExperimentsV2(requestCtx, resolvedAppID, opts...)
@@ -128,7 +126,7 @@ Examples:
 					return fmt.Errorf("experiments list: failed to fetch: %w", err)
 				}
 
-				return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+				return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 			}
 
 			trimmedVersionID := strings.TrimSpace(*versionID)
@@ -137,7 +135,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("experiments list: %w", err)
 			}
@@ -158,14 +156,12 @@ Examples:
 					return fmt.Errorf("experiments list: failed to fetch: %w", err)
 				}
 
-				paginated, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				if err := shared.PrintPaginatedOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetAppStoreVersionExperiments(ctx, trimmedVersionID, asc.WithAppStoreVersionExperimentsNextURL(nextURL))
-				})
-				if err != nil {
+				}, *output.Output, *output.Pretty); err != nil {
 					return fmt.Errorf("experiments list: %w", err)
 				}
-
-				return shared.PrintOutput(paginated, *output.Output, *output.Pretty)
+				return nil
 			}
 
 			resp, err := client.GetAppStoreVersionExperiments(requestCtx, trimmedVersionID, opts...)
@@ -173,7 +169,7 @@ Examples:
 				return fmt.Errorf("experiments list: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -204,7 +200,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("experiments get: %w", err)
 			}
@@ -217,7 +213,7 @@ Examples:
 				if err != nil {
 					return fmt.Errorf("experiments get: failed to fetch: %w", err)
 				}
-				return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+				return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 			}
 
 			resp, err := client.GetAppStoreVersionExperiment(requestCtx, trimmedID)
@@ -225,7 +221,7 @@ Examples:
 				return fmt.Errorf("experiments get: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -267,7 +263,7 @@ Examples:
 			}
 
 			if *v2 {
-				resolvedAppID := shared.ResolveAppID(*appID)
+				resolvedAppID := shared.ResolveAppID(ctx, *appID)
 				if resolvedAppID == "" {
 					fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
 					return flag.ErrHelp
@@ -279,7 +275,7 @@ Examples:
 					return flag.ErrHelp
 				}
 
-				client, err := shared.GetASCClient()
+				client, err := shared.GetASCClient(ctx)
 				if err != nil {
 					return fmt.Errorf("experiments create: %w", err)
 				}
@@ -292,7 +288,7 @@ Examples:
 					return fmt.Errorf("experiments create: failed to create: %w", err)
 				}
 
-				return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+				return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 			}
 
 			trimmedVersionID := strings.TrimSpace(*versionID)
@@ -301,7 +297,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("experiments create: %w", err)
 			}
@@ -314,7 +310,7 @@ Examples:
 				return fmt.Errorf("experiments create: failed to create: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -367,7 +363,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("experiments update: %w", err)
 			}
@@ -393,7 +389,7 @@ Examples:
 					return fmt.Errorf("experiments update: failed to update: %w", err)
 				}
 
-				return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+				return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 			}
 
 			attrs := asc.AppStoreVersionExperimentUpdateAttributes{}
@@ -413,7 +409,7 @@ Examples:
 				return fmt.Errorf("experiments update: failed to update: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -449,7 +445,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("experiments delete: %w", err)
 			}
@@ -468,7 +464,7 @@ Examples:
 			}
 
 			result := &asc.AppStoreVersionExperimentDeleteResult{ID: trimmedID, Deleted: true}
-			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -173,7 +173,7 @@ Examples:
 				content = data
 				source = pathValue
 			} else {
-				client, err := shared.GetASCClient()
+				client, err := shared.GetASCClient(ctx)
 				if err != nil {
 					return fmt.Errorf("profiles local install: %w", err)
 				}
@@ -246,7 +246,7 @@ Examples:
 				Profile:       item,
 			}
 
-			return shared.PrintOutputWithRenderers(
+			return shared.PrintOutputWithRenderers(ctx,
 				result,
 				*output.Output,
 				*output.Pretty,
@@ -325,7 +325,7 @@ Examples:
 				SkippedItems: skipped,
 			}
 
-			return shared.PrintOutputWithRenderers(
+			return shared.PrintOutputWithRenderers(ctx,
 				result,
 				*output.Output,
 				*output.Pretty,
@@ -412,7 +412,7 @@ Examples:
 			}
 
 			if *dryRun {
-				return shared.PrintOutputWithRenderers(
+				return shared.PrintOutputWithRenderers(ctx,
 					result,
 					*output.Output,
 					*output.Pretty,
@@ -431,7 +431,7 @@ Examples:
 				result.DeletedItems = append(result.DeletedItems, localCleanItem{UUID: item.UUID, Name: item.Name, Path: item.Path})
 			}
 
-			return shared.PrintOutputWithRenderers(
+			return shared.PrintOutputWithRenderers(ctx,
 				result,
 				*output.Output,
 				*output.Pretty,
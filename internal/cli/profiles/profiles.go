@@ -84,7 +84,7 @@ Examples:
 
 			profileTypes := shared.SplitCSVUpper(*profileType)
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("profiles list: %w", err)
 			}
@@ -102,7 +102,7 @@ Examples:
 
 			if *paginate {
 				paginateOpts := append(opts, asc.WithProfilesLimit(200))
-				paginated, err := shared.PaginateWithSpinner(
+				if err := shared.PrintPaginatedOutputWithSpinner(
 					requestCtx,
 					func(ctx context.Context) (asc.PaginatedResponse, error) {
 						return client.GetProfiles(ctx, paginateOpts...)
@@ -110,12 +110,12 @@ Examples:
 					func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 						return client.GetProfiles(ctx, asc.WithProfilesNextURL(nextURL))
 					},
-				)
-				if err != nil {
+					*output.Output,
+					*output.Pretty,
+				); err != nil {
 					return fmt.Errorf("profiles list: %w", err)
 				}
-
-				return shared.PrintOutput(paginated, *output.Output, *output.Pretty)
+				return nil
 			}
 
 			resp, err := client.GetProfiles(requestCtx, opts...)
@@ -123,7 +123,7 @@ Examples:
 				return fmt.Errorf("profiles list: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -159,7 +159,7 @@ Examples:
 				return fmt.Errorf("profiles get: %w", err)
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("profiles get: %w", err)
 			}
@@ -177,7 +177,7 @@ Examples:
 				return fmt.Errorf("profiles get: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -227,7 +227,7 @@ Examples:
 			}
 			deviceIDs := shared.SplitCSV(*devices)
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("profiles create: %w", err)
 			}
@@ -244,7 +244,7 @@ Examples:
 				return fmt.Errorf("profiles create: failed to create: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -278,7 +278,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("profiles delete: %w", err)
 			}
@@ -295,7 +295,7 @@ Examples:
 				Deleted: true,
 			}
 
-			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -330,7 +330,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("profiles download: %w", err)
 			}
@@ -363,7 +363,7 @@ Examples:
 				OutputPath: pathValue,
 			}
 
-			return shared.PrintOutput(result, *output.OutputFormat, *output.Pretty)
+			return shared.PrintOutput(ctx, result, *output.OutputFormat, *output.Pretty)
 		},
 	}
 }
@@ -85,7 +85,7 @@ Examples:
 		paginate := boolFlagValue(cmd.FlagSet, "paginate")
 		output := stringFlagValue(cmd.FlagSet, "output")
 		pretty := boolFlagValue(cmd.FlagSet, "pretty")
-		appID := shared.ResolveAppID(stringFlagValue(cmd.FlagSet, "app"))
+		appID := shared.ResolveAppID(ctx, stringFlagValue(cmd.FlagSet, "app"))
 		errorPrefix := promotedPurchasesCommandErrorPrefix(cfg, "list")
 
 		if limit != 0 && (limit < 1 || limit > 200) {
@@ -100,7 +100,7 @@ Examples:
 			return flag.ErrHelp
 		}
 
-		client, err := shared.GetASCClient()
+		client, err := shared.GetASCClient(ctx)
 		if err != nil {
 			return fmt.Errorf("%s: %w", errorPrefix, err)
 		}
@@ -115,26 +115,38 @@ Examples:
 
 		if paginate {
 			paginateOpts := append(opts, asc.WithPromotedPurchasesLimit(200))
-			firstPage, err := client.GetAppPromotedPurchases(requestCtx, appID, paginateOpts...)
-			if err != nil {
-				return fmt.Errorf("%s: failed to fetch: %w", errorPrefix, err)
-			}
-
-			paginated, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
-				return client.GetAppPromotedPurchases(ctx, appID, asc.WithPromotedPurchasesNextURL(nextURL))
-			})
-			if err != nil {
-				return fmt.Errorf("%s: %w", errorPrefix, err)
-			}
-
-			resp, ok := paginated.(*asc.PromotedPurchasesResponse)
-			if !ok {
-				return fmt.Errorf("%s: unexpected response type %T", errorPrefix, paginated)
+			filterPage := func(ctx context.Context, page asc.PaginatedResponse) (asc.PaginatedResponse, error) {
+				resp, ok := page.(*asc.PromotedPurchasesResponse)
+				if !ok {
+					return nil, fmt.Errorf("unexpected response type %T", page)
+				}
+				if err := filterPromotedPurchasesByProductType(ctx, client, resp, cfg.ProductType); err != nil {
+					return nil, err
+				}
+				return resp, nil
 			}
-			if err := filterPromotedPurchasesByProductType(requestCtx, client, resp, cfg.ProductType); err != nil {
+			if err := shared.PrintPaginatedOutputWithSpinner(
+				requestCtx,
+				func(ctx context.Context) (asc.PaginatedResponse, error) {
+					firstPage, err := client.GetAppPromotedPurchases(ctx, appID, paginateOpts...)
+					if err != nil {
+						return nil, fmt.Errorf("failed to fetch: %w", err)
+					}
+					return filterPage(ctx, firstPage)
+				},
+				func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+					page, err := client.GetAppPromotedPurchases(ctx, appID, asc.WithPromotedPurchasesNextURL(nextURL))
+					if err != nil {
+						return nil, err
+					}
+					return filterPage(ctx, page)
+				},
+				output,
+				pretty,
+			); err != nil {
 				return fmt.Errorf("%s: %w", errorPrefix, err)
 			}
-			return shared.PrintOutput(resp, output, pretty)
+			return nil
 		}
 
 		resp, err := client.GetAppPromotedPurchases(requestCtx, appID, opts...)
@@ -145,7 +157,7 @@ Examples:
 			return fmt.Errorf("%s: %w", errorPrefix, err)
 		}
 
-		return shared.PrintOutput(resp, output, pretty)
+		return shared.PrintOutput(ctx, resp, output, pretty)
 	}
 }
 
@@ -158,7 +170,7 @@ func wrapScopedPromotedPurchaseDetailCommand(cmd *ffcli.Command, cfg ScopedPromo
 	cmd.Exec = func(ctx context.Context, args []string) error {
 		promotedPurchaseID := strings.TrimSpace(stringFlagValue(cmd.FlagSet, "promoted-purchase-id"))
 		if promotedPurchaseID != "" {
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("%s: %w", promotedPurchasesCommandErrorPrefix(cfg, cmd.Name), err)
 			}
@@ -192,7 +204,7 @@ Examples:
   %s link --app "APP_ID" --clear --confirm`, cfg.ProductPlural, cfg.ProductPlural, otherProductPlural(cfg.ProductType), cfg.PathPrefix, cfg.PathPrefix, cfg.PathPrefix)
 
 	cmd.Exec = func(ctx context.Context, args []string) error {
-		appID := shared.ResolveAppID(stringFlagValue(cmd.FlagSet, "app"))
+		appID := shared.ResolveAppID(ctx, stringFlagValue(cmd.FlagSet, "app"))
 		promotedIDs := stringFlagValue(cmd.FlagSet, "promoted-purchase-id")
 		clear := boolFlagValue(cmd.FlagSet, "clear")
 		confirm := boolFlagValue(cmd.FlagSet, "confirm")
@@ -223,7 +235,7 @@ Examples:
 			}
 		}
 
-		client, err := shared.GetASCClient()
+		client, err := shared.GetASCClient(ctx)
 		if err != nil {
 			return fmt.Errorf("%s: %w", errorPrefix, err)
 		}
@@ -261,7 +273,7 @@ Examples:
 			Action:              action,
 		}
 
-		return shared.PrintOutput(result, output, pretty)
+		return shared.PrintOutput(ctx, result, output, pretty)
 	}
 }
 
@@ -313,7 +313,7 @@ func TestResolvePublishBetaGroupIDs_SinglePage(t *testing.T) {
 		return jsonResponse(http.StatusOK, body), nil
 	}))
 
-	client, err := shared.GetASCClient()
+	client, err := shared.GetASCClient(context.Background())
 	if err != nil {
 		t.Fatalf("shared.GetASCClient: %v", err)
 	}
@@ -343,7 +343,7 @@ func TestResolvePublishBetaGroupIDs_APIError(t *testing.T) {
 		return jsonResponse(http.StatusForbidden, body), nil
 	}))
 
-	client, err := shared.GetASCClient()
+	client, err := shared.GetASCClient(context.Background())
 	if err != nil {
 		t.Fatalf("shared.GetASCClient: %v", err)
 	}
@@ -369,7 +369,7 @@ func TestResolvePublishBetaGroupIDs_NameNotFound(t *testing.T) {
 		return jsonResponse(http.StatusOK, body), nil
 	}))
 
-	client, err := shared.GetASCClient()
+	client, err := shared.GetASCClient(context.Background())
 	if err != nil {
 		t.Fatalf("shared.GetASCClient: %v", err)
 	}
@@ -398,7 +398,7 @@ func TestListAllPublishBetaGroups_SinglePage(t *testing.T) {
 		return jsonResponse(http.StatusOK, body), nil
 	}))
 
-	client, err := shared.GetASCClient()
+	client, err := shared.GetASCClient(context.Background())
 	if err != nil {
 		t.Fatalf("shared.GetASCClient: %v", err)
 	}
@@ -449,7 +449,7 @@ func TestListAllPublishBetaGroups_Paginated(t *testing.T) {
 		}
 	}))
 
-	client, err := shared.GetASCClient()
+	client, err := shared.GetASCClient(context.Background())
 	if err != nil {
 		t.Fatalf("shared.GetASCClient: %v", err)
 	}
@@ -496,7 +496,7 @@ func TestListAllPublishBetaGroups_PaginationAPIError(t *testing.T) {
 		}
 	}))
 
-	client, err := shared.GetASCClient()
+	client, err := shared.GetASCClient(context.Background())
 	if err != nil {
 		t.Fatalf("shared.GetASCClient: %v", err)
 	}
@@ -537,7 +537,7 @@ func TestResolvePublishBetaGroupIDs_PaginatedNameResolution(t *testing.T) {
 		}
 	}))
 
-	client, err := shared.GetASCClient()
+	client, err := shared.GetASCClient(context.Background())
 	if err != nil {
 		t.Fatalf("shared.GetASCClient: %v", err)
 	}
@@ -20,11 +20,11 @@ const defaultPublishExportOptionsPath = ".asc/export-options-app-store.plist"
 var (
 	runPublishArchiveFn   = localxcode.Archive
 	runPublishExportFn    = localxcode.Export
-	getPublishASCClientFn = func(timeout time.Duration) (*asc.Client, error) {
+	getPublishASCClientFn = func(ctx context.Context, timeout time.Duration) (*asc.Client, error) {
 		if timeout > 0 {
-			return shared.GetASCClientWithTimeout(timeout)
+			return shared.GetASCClientWithTimeout(ctx, timeout)
 		}
-		return shared.GetASCClient()
+		return shared.GetASCClient(ctx)
 	}
 	validatePublishIPAPathFn        = shared.ValidateIPAPath
 	resolvePublishNextBuildNumberFn = func(ctx context.Context, client *asc.Client, opts shared.NextBuildNumberOptions) (*asc.BuildsNextBuildNumberResult, error) {
@@ -104,7 +104,7 @@ Examples:
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
-			resolvedAppInput := shared.ResolveAppID(*appID)
+			resolvedAppInput := shared.ResolveAppID(ctx, *appID)
 			if resolvedAppInput == "" {
 				fmt.Fprintf(os.Stderr, "Error: --app is required (or set ASC_APP_ID)\n\n")
 				return flag.ErrHelp
@@ -209,7 +209,7 @@ Examples:
 			}
 
 			timeoutValue := resolvePublishTimeout(*timeout)
-			client, err := getPublishASCClientFn(timeoutValue)
+			client, err := getPublishASCClientFn(ctx, timeoutValue)
 			if err != nil {
 				return fmt.Errorf("publish testflight: %w", err)
 			}
@@ -387,7 +387,7 @@ Examples:
 				}
 			}
 
-			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -444,7 +444,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			resolvedAppInput := shared.ResolveAppID(*appID)
+			resolvedAppInput := shared.ResolveAppID(ctx, *appID)
 			if resolvedAppInput == "" {
 				fmt.Fprintf(os.Stderr, "Error: --app is required (or set ASC_APP_ID)\n\n")
 				return flag.ErrHelp
@@ -536,7 +536,7 @@ Examples:
 					mode = asc.PublishModeLocalBuild
 				}
 			} else {
-				client, err = getPublishASCClientFn(timeoutValue)
+				client, err = getPublishASCClientFn(ctx, timeoutValue)
 				if err != nil {
 					return fmt.Errorf("publish appstore: %w", err)
 				}
@@ -572,7 +572,7 @@ Examples:
 
 			if *dryRun {
 				result := plannedAppStorePublishResult(mode, versionValue, buildNumberValue, *wait, *submit, metadataDirValue != "", localBuildMode, localBuildConfig)
-				return shared.PrintOutput(result, *output.Output, *output.Pretty)
+				return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 			}
 
 			var buildResp *asc.BuildResponse
@@ -675,7 +675,7 @@ Examples:
 					result.SubmissionID = existingSubmissionID
 					result.Submitted = true
 					attachLocalPublishResult()
-					return shared.PrintOutput(result, *output.Output, *output.Pretty)
+					return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 				}
 			}
 
@@ -745,7 +745,7 @@ Examples:
 			}
 			attachLocalPublishResult()
 
-			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -30,7 +30,7 @@ func TestPublishTestFlightLocalBuildJSONIncludesNestedStages(t *testing.T) {
 	restore := overridePublishCommandTestHooks(t)
 	defer restore()
 
-	getPublishASCClientFn = func(time.Duration) (*asc.Client, error) { return newPublishCommandTestClient(t), nil }
+	getPublishASCClientFn = func(context.Context, time.Duration) (*asc.Client, error) { return newPublishCommandTestClient(t), nil }
 	resolvePublishAppIDWithLookupFn = func(_ context.Context, _ *asc.Client, appID string) (string, error) {
 		if appID != "friendly-app" {
 			t.Fatalf("expected unresolved app input to be passed through lookup, got %q", appID)
@@ -252,7 +252,7 @@ func TestPublishTestFlightLocalBuildRejectsDirectUploadExportOptions(t *testing.
 		t.Fatalf("WriteFile() error: %v", err)
 	}
 
-	getPublishASCClientFn = func(time.Duration) (*asc.Client, error) { return newPublishCommandTestClient(t), nil }
+	getPublishASCClientFn = func(context.Context, time.Duration) (*asc.Client, error) { return newPublishCommandTestClient(t), nil }
 	resolvePublishAppIDWithLookupFn = func(_ context.Context, _ *asc.Client, _ string) (string, error) {
 		return "app-123", nil
 	}
@@ -325,7 +325,7 @@ func TestPublishTestFlightLocalBuildTableIncludesArchiveAndExportSections(t *tes
 	restore := overridePublishCommandTestHooks(t)
 	defer restore()
 
-	getPublishASCClientFn = func(time.Duration) (*asc.Client, error) { return newPublishCommandTestClient(t), nil }
+	getPublishASCClientFn = func(context.Context, time.Duration) (*asc.Client, error) { return newPublishCommandTestClient(t), nil }
 	resolvePublishAppIDWithLookupFn = func(_ context.Context, _ *asc.Client, _ string) (string, error) {
 		return "app-123", nil
 	}
@@ -437,7 +437,7 @@ func TestPublishTestFlightLocalBuildUsesDefaultExportOptionsPath(t *testing.T) {
 		_ = os.Chdir(originalWD)
 	})
 
-	getPublishASCClientFn = func(time.Duration) (*asc.Client, error) { return newPublishCommandTestClient(t), nil }
+	getPublishASCClientFn = func(context.Context, time.Duration) (*asc.Client, error) { return newPublishCommandTestClient(t), nil }
 	resolvePublishAppIDWithLookupFn = func(_ context.Context, _ *asc.Client, _ string) (string, error) {
 		return "app-123", nil
 	}
@@ -528,7 +528,7 @@ func TestPublishTestFlightLocalBuildUsesFreshUploadTimeoutAfterArchive(t *testin
 	restore := overridePublishCommandTestHooks(t)
 	defer restore()
 
-	getPublishASCClientFn = func(time.Duration) (*asc.Client, error) { return newPublishCommandTestClient(t), nil }
+	getPublishASCClientFn = func(context.Context, time.Duration) (*asc.Client, error) { return newPublishCommandTestClient(t), nil }
 	resolvePublishAppIDWithLookupFn = func(_ context.Context, _ *asc.Client, _ string) (string, error) {
 		return "app-123", nil
 	}
@@ -707,7 +707,7 @@ func TestPublishTestFlightIPAUploadResolvesAppIDBeforeGroupLookupAndUpload(t *te
 	restore := overridePublishCommandTestHooks(t)
 	defer restore()
 
-	getPublishASCClientFn = func(time.Duration) (*asc.Client, error) { return newPublishCommandTestClient(t), nil }
+	getPublishASCClientFn = func(context.Context, time.Duration) (*asc.Client, error) { return newPublishCommandTestClient(t), nil }
 	validatePublishIPAPathFn = func(string) (os.FileInfo, error) {
 		return newPublishTestFileInfo(t)
 	}
@@ -821,7 +821,7 @@ func TestPublishAppStoreLocalBuildRequiresExportOptionsWhenDefaultMissing(t *tes
 	restore := overridePublishCommandTestHooks(t)
 	defer restore()
 
-	getPublishASCClientFn = func(time.Duration) (*asc.Client, error) { return newPublishCommandTestClient(t), nil }
+	getPublishASCClientFn = func(context.Context, time.Duration) (*asc.Client, error) { return newPublishCommandTestClient(t), nil }
 	resolvePublishAppIDWithLookupFn = func(_ context.Context, _ *asc.Client, _ string) (string, error) {
 		return "app-123", nil
 	}
@@ -859,7 +859,7 @@ func TestPublishAppStoreMetadataDirAppliesAfterEnsureVersionBeforeAttach(t *test
 	writePublishVersionMetadataFixture(t, metadataDir, "1.2.3")
 	sequence := make([]string, 0, 4)
 
-	getPublishASCClientFn = func(time.Duration) (*asc.Client, error) { return newPublishCommandTestClient(t), nil }
+	getPublishASCClientFn = func(context.Context, time.Duration) (*asc.Client, error) { return newPublishCommandTestClient(t), nil }
 	resolvePublishAppIDWithLookupFn = func(_ context.Context, _ *asc.Client, appID string) (string, error) {
 		return appID, nil
 	}
@@ -1030,7 +1030,7 @@ func TestPublishAppStoreLocalBuildRejectsDirectUploadExportOptions(t *testing.T)
 		t.Fatalf("WriteFile() error: %v", err)
 	}
 
-	getPublishASCClientFn = func(time.Duration) (*asc.Client, error) { return newPublishCommandTestClient(t), nil }
+	getPublishASCClientFn = func(context.Context, time.Duration) (*asc.Client, error) { return newPublishCommandTestClient(t), nil }
 	resolvePublishAppIDWithLookupFn = func(_ context.Context, _ *asc.Client, _ string) (string, error) {
 		return "app-123", nil
 	}
@@ -1078,7 +1078,7 @@ func TestPublishAppStoreLocalBuildUsesFreshUploadTimeoutAfterArchive(t *testing.
 	restore := overridePublishCommandTestHooks(t)
 	defer restore()
 
-	getPublishASCClientFn = func(time.Duration) (*asc.Client, error) { return newPublishCommandTestClient(t), nil }
+	getPublishASCClientFn = func(context.Context, time.Duration) (*asc.Client, error) { return newPublishCommandTestClient(t), nil }
 	resolvePublishAppIDWithLookupFn = func(_ context.Context, _ *asc.Client, _ string) (string, error) {
 		return "app-123", nil
 	}
@@ -1199,7 +1199,7 @@ func TestPublishAppStoreIPAUploadResolvesAppIDBeforeUploadAndAttach(t *testing.T
 	restore := overridePublishCommandTestHooks(t)
 	defer restore()
 
-	getPublishASCClientFn = func(time.Duration) (*asc.Client, error) { return newPublishCommandTestClient(t), nil }
+	getPublishASCClientFn = func(context.Context, time.Duration) (*asc.Client, error) { return newPublishCommandTestClient(t), nil }
 	validatePublishIPAPathFn = func(string) (os.FileInfo, error) {
 		return newPublishTestFileInfo(t)
 	}
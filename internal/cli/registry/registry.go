@@ -14,6 +14,7 @@ import (
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/alternativedistribution"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/analytics"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/androidiosmapping"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/api"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/app_events"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/appclips"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/apps"
@@ -24,6 +25,7 @@ import (
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/buildlocalizations"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/builds"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/bundleids"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/cache"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/capabilities"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/categories"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/certificates"
@@ -44,6 +46,7 @@ import (
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/merchantids"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/metadata"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/migrate"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/mock"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/nominations"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/notarization"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/notify"
@@ -125,7 +128,7 @@ func Subcommands(version string) []*ffcli.Command {
 		apps.AppTagsCommand(),
 		marketplace.MarketplaceCommand(),
 		alternativedistribution.Command(),
-		webhooks.WebhooksCommand(),
+		webhooks.WebhooksCommand(workflow.FileRunner(func() []*ffcli.Command { return Subcommands(version) })),
 		nominations.NominationsCommand(),
 		bundleids.BundleIDsCommand(),
 		merchantids.MerchantIDsCommand(),
@@ -140,7 +143,7 @@ func Subcommands(version string) []*ffcli.Command {
 		buildbundles.BuildBundlesCommand(),
 		publish.PublishCommand(),
 		release.ReleaseCommand(),
-		workflow.WorkflowCommand(),
+		workflow.WorkflowCommand(func() []*ffcli.Command { return Subcommands(version) }),
 		xcode.XcodeCommand(),
 		versions.VersionsCommand(),
 		productpages.ProductPagesCommand(),
@@ -174,6 +177,9 @@ func Subcommands(version string) []*ffcli.Command {
 		gamecenter.GameCenterCommand(),
 		capabilities.Command(),
 		schema.SchemaCommand(),
+		api.APICommand(),
+		cache.CacheCommand(),
+		mock.MockCommand(),
 		snitch.SnitchCommand(version),
 		VersionCommand(version),
 	}
@@ -176,7 +176,7 @@ func TestExecuteRun_ResumesCompletedCheckpoint(t *testing.T) {
 		readinessReportBuilder = origReadinessBuilder
 	})
 
-	releaseClientFactory = func() (*asc.Client, error) { return nil, nil }
+	releaseClientFactory = func(context.Context) (*asc.Client, error) { return nil, nil }
 	metadataPushExecutor = func(context.Context, metadata.PushExecutionOptions) (metadata.PushPlanResult, error) {
 		t.Fatal("metadata executor should not be called for completed checkpoint")
 		return metadata.PushPlanResult{}, nil
@@ -310,7 +310,7 @@ func TestExecuteRun_SuccessPath(t *testing.T) {
 		}
 	})
 	testClient := newReleaseTestClient(t)
-	releaseClientFactory = func() (*asc.Client, error) { return testClient, nil }
+	releaseClientFactory = func(context.Context) (*asc.Client, error) { return testClient, nil }
 
 	result, err := executeRun(context.Background(), runOptions{
 		AppID:          "APP_123",
@@ -436,7 +436,7 @@ func TestExecuteStage_CopyMetadataSuccessPath(t *testing.T) {
 	})
 
 	testClient := newReleaseTestClient(t)
-	releaseClientFactory = func() (*asc.Client, error) { return testClient, nil }
+	releaseClientFactory = func(context.Context) (*asc.Client, error) { return testClient, nil }
 
 	result, err := executeStage(context.Background(), runOptions{
 		AppID:              "APP_123",
@@ -519,7 +519,7 @@ func TestExecuteRun_IdempotentWhenSubmissionExists(t *testing.T) {
 	})
 
 	testClient := newReleaseTestClient(t)
-	releaseClientFactory = func() (*asc.Client, error) { return testClient, nil }
+	releaseClientFactory = func(context.Context) (*asc.Client, error) { return testClient, nil }
 	metadataPushExecutor = func(_ context.Context, opts metadata.PushExecutionOptions) (metadata.PushPlanResult, error) {
 		return metadata.PushPlanResult{
 			AppID:     opts.AppID,
@@ -633,7 +633,7 @@ func TestExecuteRun_EmitsSubmitProgressMessagesToStderr(t *testing.T) {
 	})
 
 	testClient := newReleaseTestClient(t)
-	releaseClientFactory = func() (*asc.Client, error) { return testClient, nil }
+	releaseClientFactory = func(context.Context) (*asc.Client, error) { return testClient, nil }
 
 	stderr := captureReleaseStderr(t, func() {
 		result, err := executeRun(context.Background(), runOptions{
@@ -705,7 +705,7 @@ func TestExecuteRun_DryRunReadinessStepMarkedDryRun(t *testing.T) {
 	})
 
 	testClient := newReleaseTestClient(t)
-	releaseClientFactory = func() (*asc.Client, error) { return testClient, nil }
+	releaseClientFactory = func(context.Context) (*asc.Client, error) { return testClient, nil }
 
 	result, err := executeRun(context.Background(), runOptions{
 		AppID:          "APP_123",
@@ -762,7 +762,7 @@ func TestExecuteRun_DryRunDefersAttachAndSubmitWhenVersionWouldBeCreated(t *test
 	})
 
 	testClient := newReleaseTestClient(t)
-	releaseClientFactory = func() (*asc.Client, error) { return testClient, nil }
+	releaseClientFactory = func(context.Context) (*asc.Client, error) { return testClient, nil }
 
 	result, err := executeRun(context.Background(), runOptions{
 		AppID:          "APP_123",
@@ -822,7 +822,7 @@ func TestExecuteRun_TimeoutCancelsPipeline(t *testing.T) {
 	})
 
 	testClient := newReleaseTestClient(t)
-	releaseClientFactory = func() (*asc.Client, error) { return testClient, nil }
+	releaseClientFactory = func(context.Context) (*asc.Client, error) { return testClient, nil }
 
 	_, err := executeRun(context.Background(), runOptions{
 		AppID:          "APP_123",
@@ -161,7 +161,7 @@ Examples:
 				return shared.UsageError("--confirm is required unless --dry-run is set")
 			}
 
-			resolvedAppID := shared.ResolveAppID(*appID)
+			resolvedAppID := shared.ResolveAppID(ctx, *appID)
 			if strings.TrimSpace(resolvedAppID) == "" {
 				return shared.UsageError("--app is required (or set ASC_APP_ID)")
 			}
@@ -208,7 +208,7 @@ Examples:
 				CheckpointFile: absCheckpointPath,
 			})
 
-			if printErr := shared.PrintOutput(result, *output.Output, *output.Pretty); printErr != nil {
+			if printErr := shared.PrintOutput(ctx, result, *output.Output, *output.Pretty); printErr != nil {
 				return printErr
 			}
 			if runErr != nil {
@@ -308,7 +308,7 @@ func executePipeline(ctx context.Context, opts runOptions) (runResult, error) {
 		}
 	}
 
-	client, err := releaseClientFactory()
+	client, err := releaseClientFactory(ctx)
 	if err != nil {
 		result.Status = "error"
 		result.Error = err.Error()
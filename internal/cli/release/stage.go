@@ -58,7 +58,7 @@ Examples:
 				return shared.UsageError("--confirm is required unless --dry-run is set")
 			}
 
-			resolvedAppID := shared.ResolveAppID(*appID)
+			resolvedAppID := shared.ResolveAppID(ctx, *appID)
 			if strings.TrimSpace(resolvedAppID) == "" {
 				return shared.UsageError("--app is required (or set ASC_APP_ID)")
 			}
@@ -129,7 +129,7 @@ Examples:
 				StrictValidate:     *strictValidate,
 				CheckpointFile:     absCheckpointPath,
 			})
-			if printErr := shared.PrintOutput(result, *output.Output, *output.Pretty); printErr != nil {
+			if printErr := shared.PrintOutput(ctx, result, *output.Output, *output.Pretty); printErr != nil {
 				return printErr
 			}
 			if runErr != nil {
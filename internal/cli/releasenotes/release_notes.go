@@ -158,7 +158,7 @@ Examples:
 
 			switch normalizedOutput {
 			case "json":
-				return shared.PrintOutput(&result, "json", *output.Pretty)
+				return shared.PrintOutput(ctx, &result, "json", *output.Pretty)
 			case "text", "markdown":
 				// Notes body output (markdown is a bullet list).
 				body := shared.SanitizeTerminal(truncatedNotes)
@@ -45,7 +45,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("review details-get: %w", err)
 			}
@@ -58,7 +58,7 @@ Examples:
 				return fmt.Errorf("review details-get: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -87,7 +87,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("review details-for-version: %w", err)
 			}
@@ -108,7 +108,7 @@ Examples:
 						Configured: false,
 						Message:    message,
 					}
-					return shared.PrintOutputWithRenderers(
+					return shared.PrintOutputWithRenderers(ctx,
 						result,
 						*output.Output,
 						*output.Pretty,
@@ -125,7 +125,7 @@ Examples:
 				return fmt.Errorf("review details-for-version: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -216,7 +216,7 @@ Examples:
 				attrsPtr = &attrs
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("review details-create: %w", err)
 			}
@@ -229,7 +229,7 @@ Examples:
 				return fmt.Errorf("review details-create: failed to create: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -281,7 +281,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("review details-update: %w", err)
 			}
@@ -340,7 +340,7 @@ Examples:
 				return fmt.Errorf("review details-update: failed to update: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
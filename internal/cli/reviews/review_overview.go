@@ -98,12 +98,12 @@ Examples:
 				return shared.UsageError("review status does not accept positional arguments")
 			}
 
-			resolvedAppID, versionValue, versionIDValue, platformValue, err := resolveReviewOverviewFlags(*appID, *version, *versionID, *platform)
+			resolvedAppID, versionValue, versionIDValue, platformValue, err := resolveReviewOverviewFlags(ctx, *appID, *version, *versionID, *platform)
 			if err != nil {
 				return err
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("review status: %w", err)
 			}
@@ -122,7 +122,7 @@ Examples:
 			}
 			result := buildReviewStatusResult(snapshot)
 
-			return shared.PrintOutputWithRenderers(
+			return shared.PrintOutputWithRenderers(ctx,
 				result,
 				*output.Output,
 				*output.Pretty,
@@ -160,12 +160,12 @@ Examples:
 				return shared.UsageError("review doctor does not accept positional arguments")
 			}
 
-			resolvedAppID, versionValue, versionIDValue, platformValue, err := resolveReviewOverviewFlags(*appID, *version, *versionID, *platform)
+			resolvedAppID, versionValue, versionIDValue, platformValue, err := resolveReviewOverviewFlags(ctx, *appID, *version, *versionID, *platform)
 			if err != nil {
 				return err
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("review doctor: %w", err)
 			}
@@ -197,7 +197,7 @@ Examples:
 			}
 
 			result := buildReviewDoctorResult(snapshot, report)
-			return shared.PrintOutputWithRenderers(
+			return shared.PrintOutputWithRenderers(ctx,
 				result,
 				*output.Output,
 				*output.Pretty,
@@ -208,8 +208,8 @@ Examples:
 	}
 }
 
-func resolveReviewOverviewFlags(appID, version, versionID, platform string) (string, string, string, string, error) {
-	resolvedAppID := shared.ResolveAppID(appID)
+func resolveReviewOverviewFlags(ctx context.Context, appID, version, versionID, platform string) (string, string, string, string, error) {
+	resolvedAppID := shared.ResolveAppID(ctx, appID)
 	if strings.TrimSpace(resolvedAppID) == "" {
 		fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
 		return "", "", "", "", flag.ErrHelp
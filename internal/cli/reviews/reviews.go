@@ -63,7 +63,7 @@ Examples:
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			// If no flags are set and no args, show help
-			resolvedAppID := shared.ResolveAppID(*appID)
+			resolvedAppID := shared.ResolveAppID(ctx, *appID)
 			if resolvedAppID == "" && strings.TrimSpace(*next) == "" {
 				fmt.Fprintf(os.Stderr, "Error: --app is required (or set ASC_APP_ID)\n\n")
 				return flag.ErrHelp
@@ -103,7 +103,7 @@ Examples:
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
-			resolvedAppID := shared.ResolveAppID(*appID)
+			resolvedAppID := shared.ResolveAppID(ctx, *appID)
 			if resolvedAppID == "" && strings.TrimSpace(*next) == "" {
 				fmt.Fprintf(os.Stderr, "Error: --app is required (or set ASC_APP_ID)\n\n")
 				return flag.ErrHelp
@@ -128,7 +128,7 @@ func executeReviewsList(ctx context.Context, appID, output string, pretty bool,
 		return fmt.Errorf("reviews: %w", err)
 	}
 
-	client, err := shared.GetASCClient()
+	client, err := shared.GetASCClient(ctx)
 	if err != nil {
 		return fmt.Errorf("reviews: %w", err)
 	}
@@ -148,7 +148,7 @@ func executeReviewsList(ctx context.Context, appID, output string, pretty bool,
 
 	if paginate {
 		paginateOpts := append(opts, asc.WithLimit(200))
-		reviews, err := shared.PaginateWithSpinner(
+		if err := shared.PrintPaginatedOutputWithSpinner(
 			requestCtx,
 			func(ctx context.Context) (asc.PaginatedResponse, error) {
 				return client.GetReviews(ctx, appID, paginateOpts...)
@@ -156,12 +156,12 @@ func executeReviewsList(ctx context.Context, appID, output string, pretty bool,
 			func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 				return client.GetReviews(ctx, appID, asc.WithNextURL(nextURL))
 			},
-		)
-		if err != nil {
+			output,
+			pretty,
+		); err != nil {
 			return fmt.Errorf("reviews: %w", err)
 		}
-
-		return shared.PrintOutput(reviews, output, pretty)
+		return nil
 	}
 
 	reviews, err := client.GetReviews(requestCtx, appID, opts...)
@@ -169,5 +169,5 @@ func executeReviewsList(ctx context.Context, appID, output string, pretty bool,
 		return fmt.Errorf("reviews: failed to fetch: %w", err)
 	}
 
-	return shared.PrintOutput(reviews, output, pretty)
+	return shared.PrintOutput(ctx, reviews, output, pretty)
 }
@@ -23,7 +23,9 @@ func ReviewsRatingsCommand() *ffcli.Command {
 	country := fs.String("country", "us", "Country code (e.g., us, gb, de)")
 	all := fs.Bool("all", false, "Fetch ratings from all countries")
 	workers := fs.Int("workers", 10, "Number of parallel workers for --all")
-	output := shared.BindOutputFlags(fs)
+	// Ratings tables are printed as free-form text, so row-based formats
+	// such as csv are not offered here.
+	output := shared.BindOutputFlagsWith(fs, "output", shared.DefaultOutputFormat(), "Output format: json, table, markdown")
 
 	return &ffcli.Command{
 		Name:       "ratings",
@@ -94,7 +96,7 @@ func executeSingleRatings(ctx context.Context, client *itunes.Client, appID, cou
 	if err != nil {
 		return fmt.Errorf("reviews ratings: %w", err)
 	}
-	return shared.PrintOutputWithRenderers(
+	return shared.PrintOutputWithRenderers(ctx,
 		ratings,
 		output,
 		pretty,
@@ -108,7 +110,7 @@ func executeAllRatings(ctx context.Context, client *itunes.Client, appID string,
 	if err != nil {
 		return fmt.Errorf("reviews ratings: %w", err)
 	}
-	return shared.PrintOutputWithRenderers(
+	return shared.PrintOutputWithRenderers(ctx,
 		global,
 		output,
 		pretty,
@@ -118,7 +120,7 @@ func executeAllRatings(ctx context.Context, client *itunes.Client, appID string,
 }
 
 func normalizeRatingsOutput(output string, pretty bool) (string, error) {
-	return shared.ValidateOutputFormat(output, pretty)
+	return shared.ValidateOutputFormatAllowed(output, pretty, "json", "table", "markdown")
 }
 
 func printRatingsTable(r *itunes.AppRatings) error {
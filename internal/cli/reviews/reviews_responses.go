@@ -45,7 +45,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("reviews respond: %w", err)
 			}
@@ -58,7 +58,7 @@ Examples:
 				return fmt.Errorf("reviews respond: failed to create response: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -114,7 +114,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("reviews response get: %w", err)
 			}
@@ -127,7 +127,7 @@ Examples:
 				return fmt.Errorf("reviews response get: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -162,7 +162,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("reviews response delete: %w", err)
 			}
@@ -179,7 +179,7 @@ Examples:
 				Deleted: true,
 			}
 
-			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -210,7 +210,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("reviews response for-review: %w", err)
 			}
@@ -232,7 +232,7 @@ Examples:
 						Configured: false,
 						Message:    message,
 					}
-					return shared.PrintOutputWithRenderers(
+					return shared.PrintOutputWithRenderers(ctx,
 						result,
 						*output.Output,
 						*output.Pretty,
@@ -249,7 +249,7 @@ Examples:
 				return fmt.Errorf("reviews response for-review: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
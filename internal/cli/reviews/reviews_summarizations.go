@@ -49,7 +49,7 @@ Examples:
 				return fmt.Errorf("reviews summarizations: %w", err)
 			}
 
-			resolvedAppID := shared.ResolveAppID(*appID)
+			resolvedAppID := shared.ResolveAppID(ctx, *appID)
 			if resolvedAppID == "" && strings.TrimSpace(*next) == "" {
 				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
 				return flag.ErrHelp
@@ -65,7 +65,7 @@ Examples:
 				return fmt.Errorf("reviews summarizations: %w", err)
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("reviews summarizations: %w", err)
 			}
@@ -85,7 +85,7 @@ Examples:
 
 			if *paginate {
 				paginateOpts := append(opts, asc.WithCustomerReviewSummarizationsLimit(200))
-				summaries, err := shared.PaginateWithSpinner(
+				if err := shared.PrintPaginatedOutputWithSpinner(
 					requestCtx,
 					func(ctx context.Context) (asc.PaginatedResponse, error) {
 						return client.GetCustomerReviewSummarizations(ctx, resolvedAppID, paginateOpts...)
@@ -93,11 +93,12 @@ Examples:
 					func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 						return client.GetCustomerReviewSummarizations(ctx, resolvedAppID, asc.WithCustomerReviewSummarizationsNextURL(nextURL))
 					},
-				)
-				if err != nil {
+					*output.Output,
+					*output.Pretty,
+				); err != nil {
 					return fmt.Errorf("reviews summarizations: %w", err)
 				}
-				return shared.PrintOutput(summaries, *output.Output, *output.Pretty)
+				return nil
 			}
 
 			resp, err := client.GetCustomerReviewSummarizations(requestCtx, resolvedAppID, opts...)
@@ -105,7 +106,7 @@ Examples:
 				return fmt.Errorf("reviews summarizations: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
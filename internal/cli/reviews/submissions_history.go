@@ -81,13 +81,13 @@ Examples:
 				return shared.UsageError(err.Error())
 			}
 
-			resolvedAppID := shared.ResolveAppID(*appID)
+			resolvedAppID := shared.ResolveAppID(ctx, *appID)
 			if resolvedAppID == "" {
 				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("review history: %w", err)
 			}
@@ -117,7 +117,7 @@ Examples:
 
 			tableFunc := func() error { return printHistoryTable(entries) }
 			markdownFunc := func() error { return printHistoryMarkdown(entries) }
-			return shared.PrintOutputWithRenderers(entries, *output.Output, *output.Pretty, tableFunc, markdownFunc)
+			return shared.PrintOutputWithRenderers(ctx, entries, *output.Output, *output.Pretty, tableFunc, markdownFunc)
 		},
 	}
 }
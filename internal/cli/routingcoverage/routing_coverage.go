@@ -64,7 +64,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("routing-coverage get: %w", err)
 			}
@@ -77,7 +77,7 @@ Examples:
 				return fmt.Errorf("routing-coverage get: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -106,7 +106,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("routing-coverage info: %w", err)
 			}
@@ -119,7 +119,7 @@ Examples:
 				return fmt.Errorf("routing-coverage info: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -169,7 +169,7 @@ Examples:
 				return fmt.Errorf("routing-coverage create: file size must be greater than 0")
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("routing-coverage create: %w", err)
 			}
@@ -210,7 +210,7 @@ Examples:
 				return fmt.Errorf("routing-coverage create: failed to commit upload: %w", err)
 			}
 
-			return shared.PrintOutput(commitResp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, commitResp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -244,7 +244,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("routing-coverage delete: %w", err)
 			}
@@ -261,7 +261,7 @@ Examples:
 				Deleted: true,
 			}
 
-			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 		},
 	}
 }
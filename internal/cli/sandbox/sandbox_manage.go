@@ -43,7 +43,7 @@ Examples:
 				}
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("sandbox get: %w", err)
 			}
@@ -61,7 +61,7 @@ Examples:
 				return fmt.Errorf("sandbox get: %w", err)
 			}
 
-			return shared.PrintOutput(response, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, response, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -115,7 +115,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return err
 			}
@@ -153,7 +153,7 @@ Examples:
 				return fmt.Errorf("sandbox update: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -193,7 +193,7 @@ Examples:
 				}
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return err
 			}
@@ -223,7 +223,7 @@ Examples:
 				Cleared:   true,
 			}
 
-			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -53,6 +53,56 @@ func EndpointCount() (int, error) {
 	return len(endpoints), nil
 }
 
+// MatchPath returns the endpoints whose path template matches a concrete API
+// path such as "/v1/apps/123/builds". Templated segments like {id} match any
+// value; when several templates match, only the most specific ones (the most
+// literal segments) are returned.
+func MatchPath(path string) ([]Endpoint, error) {
+	endpoints, err := loadIndex()
+	if err != nil {
+		return nil, err
+	}
+
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	best := -1
+	var matches []Endpoint
+	for _, e := range endpoints {
+		literals, ok := matchPathTemplate(e.Path, segments)
+		if !ok || literals < best {
+			continue
+		}
+		if literals > best {
+			best = literals
+			matches = matches[:0]
+		}
+		matches = append(matches, e)
+	}
+	return matches, nil
+}
+
+// matchPathTemplate reports whether segments fit the template and how many
+// template segments matched literally.
+func matchPathTemplate(template string, segments []string) (int, bool) {
+	parts := strings.Split(strings.Trim(template, "/"), "/")
+	if len(parts) != len(segments) {
+		return 0, false
+	}
+	literals := 0
+	for i, part := range parts {
+		if strings.HasPrefix(part, "{") && strings.HasSuffix(part, "}") {
+			if segments[i] == "" {
+				return 0, false
+			}
+			continue
+		}
+		if part != segments[i] {
+			return 0, false
+		}
+		literals++
+	}
+	return literals, true
+}
+
 func matchEndpoint(e Endpoint, query string) bool {
 	q := strings.ToLower(query)
 	if strings.Contains(strings.ToLower(e.Path), q) {
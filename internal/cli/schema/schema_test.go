@@ -190,3 +190,39 @@ func TestNormalizeMethodFilter(t *testing.T) {
 		})
 	}
 }
+
+func TestMatchPath(t *testing.T) {
+	tests := []struct {
+		path     string
+		wantPath string
+		wantAny  bool
+	}{
+		{path: "/v1/apps/123/appStoreVersions", wantPath: "/v1/apps/{id}/appStoreVersions", wantAny: true},
+		{path: "/v1/apps", wantPath: "/v1/apps", wantAny: true},
+		{path: "/v1/apps/123/notARelationship", wantAny: false},
+		{path: "/v1/apps//builds", wantAny: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			matches, err := MatchPath(tt.path)
+			if err != nil {
+				t.Fatalf("MatchPath() error: %v", err)
+			}
+			if !tt.wantAny {
+				if len(matches) != 0 {
+					t.Fatalf("expected no matches, got %+v", matches)
+				}
+				return
+			}
+			if len(matches) == 0 {
+				t.Fatal("expected at least one match")
+			}
+			for _, m := range matches {
+				if m.Path != tt.wantPath {
+					t.Fatalf("expected template %q, got %q", tt.wantPath, m.Path)
+				}
+			}
+		})
+	}
+}
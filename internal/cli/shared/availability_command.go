@@ -51,7 +51,7 @@ func NewAvailabilitySetCommand(config AvailabilitySetCommandConfig) *ffcli.Comma
 		FlagSet:    fs,
 		UsageFunc:  DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
-			resolvedAppID := resolveAppID(*appID)
+			resolvedAppID := resolveAppID(ctx, *appID)
 			if resolvedAppID == "" {
 				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
 				return flag.ErrHelp
@@ -84,7 +84,7 @@ func NewAvailabilitySetCommand(config AvailabilitySetCommandConfig) *ffcli.Comma
 
 			availableValue := available.Value()
 
-			client, err := getASCClient()
+			client, err := getASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("%s: %w", config.ErrorPrefix, err)
 			}
@@ -108,20 +108,10 @@ func NewAvailabilitySetCommand(config AvailabilitySetCommandConfig) *ffcli.Comma
 				return fmt.Errorf("%s: app availability ID missing from response", config.ErrorPrefix)
 			}
 
-			firstPage, err := client.GetTerritoryAvailabilities(requestCtx, availabilityID, asc.WithTerritoryAvailabilitiesLimit(200))
+			territoryMap, err := FetchTerritoryAvailabilityIDs(requestCtx, client, availabilityID)
 			if err != nil {
 				return fmt.Errorf("%s: %w", config.ErrorPrefix, err)
 			}
-			paginated, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
-				return client.GetTerritoryAvailabilities(ctx, availabilityID, asc.WithTerritoryAvailabilitiesNextURL(nextURL))
-			})
-			if err != nil {
-				return fmt.Errorf("%s: %w", config.ErrorPrefix, err)
-			}
-			territoryResp, ok := paginated.(*asc.TerritoryAvailabilitiesResponse)
-			if !ok {
-				return fmt.Errorf("%s: unexpected territory availabilities response", config.ErrorPrefix)
-			}
 
 			if config.IncludeAvailableInNewTerritories {
 				availableInNewTerritoriesValue := availableInNewTerritories.Value()
@@ -134,11 +124,6 @@ func NewAvailabilitySetCommand(config AvailabilitySetCommandConfig) *ffcli.Comma
 				}
 			}
 
-			territoryMap, err := MapTerritoryAvailabilityIDs(territoryResp)
-			if err != nil {
-				return fmt.Errorf("%s: %w", config.ErrorPrefix, err)
-			}
-
 			var territoryAvailabilityIDs []string
 			if *allTerritories {
 				territoryAvailabilityIDs = make([]string, 0, len(territoryMap))
@@ -170,7 +155,7 @@ func NewAvailabilitySetCommand(config AvailabilitySetCommandConfig) *ffcli.Comma
 				}
 			}
 
-			return printOutput(resp, *output.Output, *output.Pretty)
+			return printOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -186,6 +171,36 @@ type territoryAvailabilityIDPayload struct {
 	Territory string `json:"t"`
 }
 
+// FetchTerritoryAvailabilityIDs maps territory IDs to territory-availability
+// IDs across every page of an app availability, one page at a time.
+func FetchTerritoryAvailabilityIDs(ctx context.Context, client *asc.Client, availabilityID string) (map[string]string, error) {
+	firstPage, err := client.GetTerritoryAvailabilities(ctx, availabilityID, asc.WithTerritoryAvailabilitiesLimit(200))
+	if err != nil {
+		return nil, err
+	}
+	ids := make(map[string]string)
+	err = asc.PaginateEach(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetTerritoryAvailabilities(ctx, availabilityID, asc.WithTerritoryAvailabilitiesNextURL(nextURL))
+	}, func(page asc.PaginatedResponse) error {
+		territoryResp, ok := page.(*asc.TerritoryAvailabilitiesResponse)
+		if !ok {
+			return fmt.Errorf("unexpected territory availabilities response")
+		}
+		pageIDs, err := MapTerritoryAvailabilityIDs(territoryResp)
+		if err != nil {
+			return err
+		}
+		for territoryID, id := range pageIDs {
+			ids[territoryID] = id
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
 // MapTerritoryAvailabilityIDs maps territory IDs to territory-availability IDs.
 func MapTerritoryAvailabilityIDs(resp *asc.TerritoryAvailabilitiesResponse) (map[string]string, error) {
 	if resp == nil {
@@ -48,8 +48,8 @@ func ResolveLatestBuild(ctx context.Context, client *asc.Client, opts LatestBuil
 
 // NormalizeLatestBuildSelectionOptions validates and normalizes the common
 // version/platform filters shared by latest-build selection workflows.
-func NormalizeLatestBuildSelectionOptions(appID, version, platform, processingState string, excludeExpired bool) (LatestBuildSelectionOptions, error) {
-	if ResolveAppID(strings.TrimSpace(appID)) == "" {
+func NormalizeLatestBuildSelectionOptions(ctx context.Context, appID, version, platform, processingState string, excludeExpired bool) (LatestBuildSelectionOptions, error) {
+	if ResolveAppID(ctx, strings.TrimSpace(appID)) == "" {
 		return LatestBuildSelectionOptions{}, UsageError("--app is required (or set ASC_APP_ID)")
 	}
 
@@ -156,7 +156,7 @@ func resolveLatestBuildSelection(ctx context.Context, client *asc.Client, opts L
 		return nil, fmt.Errorf("build client is required")
 	}
 
-	resolvedAppID := ResolveAppID(opts.AppID)
+	resolvedAppID := ResolveAppID(ctx, opts.AppID)
 	if resolvedAppID == "" {
 		return nil, UsageError("--app is required (or set ASC_APP_ID)")
 	}
@@ -65,7 +65,7 @@ func NewCategoriesSetCommand(config CategoriesSetCommandConfig) *ffcli.Command {
 				return fmt.Errorf("%s: --primary is required", config.ErrorPrefix)
 			}
 
-			client, err := getASCClient()
+			client, err := getASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("%s: %w", config.ErrorPrefix, err)
 			}
@@ -83,7 +83,7 @@ func NewCategoriesSetCommand(config CategoriesSetCommandConfig) *ffcli.Command {
 				return fmt.Errorf("%s: %w", config.ErrorPrefix, err)
 			}
 
-			return printOutput(resp, *output.Output, *output.Pretty)
+			return printOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
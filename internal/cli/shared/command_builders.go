@@ -62,7 +62,7 @@ func BuildIDGetCommand(config IDGetCommandConfig) *ffcli.Command {
 				return UsageErrorf("--%s is required", idFlagName)
 			}
 
-			client, err := GetASCClient()
+			client, err := GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("%s: %w", config.ErrorPrefix, err)
 			}
@@ -75,7 +75,7 @@ func BuildIDGetCommand(config IDGetCommandConfig) *ffcli.Command {
 				return fmt.Errorf("%s: %w", config.ErrorPrefix, err)
 			}
 
-			return PrintOutput(resp, *output.Output, *output.Pretty)
+			return PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -158,7 +158,7 @@ func BuildPaginatedListCommand(config PaginatedListCommandConfig) *ffcli.Command
 			return UsageErrorf("--%s is required", parentFlagName)
 		}
 
-		client, err := GetASCClient()
+		client, err := GetASCClient(ctx)
 		if err != nil {
 			return fmt.Errorf("%s: %w", config.ErrorPrefix, err)
 		}
@@ -172,7 +172,7 @@ func BuildPaginatedListCommand(config PaginatedListCommandConfig) *ffcli.Command
 				firstPageLimit = limitMax
 			}
 
-			resp, err := PaginateWithSpinner(
+			if err := PrintPaginatedOutputWithSpinner(
 				requestCtx,
 				func(ctx context.Context) (asc.PaginatedResponse, error) {
 					return config.FetchPage(ctx, client, resolvedParentID, firstPageLimit, *next)
@@ -180,12 +180,12 @@ func BuildPaginatedListCommand(config PaginatedListCommandConfig) *ffcli.Command
 				func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return config.FetchPage(ctx, client, resolvedParentID, 0, nextURL)
 				},
-			)
-			if err != nil {
+				*output.Output,
+				*output.Pretty,
+			); err != nil {
 				return fmt.Errorf("%s: %w", config.ErrorPrefix, err)
 			}
-
-			return PrintOutput(resp, *output.Output, *output.Pretty)
+			return nil
 		}
 
 		resp, err := config.FetchPage(requestCtx, client, resolvedParentID, *limit, *next)
@@ -193,7 +193,7 @@ func BuildPaginatedListCommand(config PaginatedListCommandConfig) *ffcli.Command
 			return fmt.Errorf("%s: %w", config.ErrorPrefix, err)
 		}
 
-		return PrintOutput(resp, *output.Output, *output.Pretty)
+		return PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 	}
 
 	return cmd
@@ -321,7 +321,7 @@ func BuildConfirmDeleteCommand(config ConfirmDeleteCommandConfig) *ffcli.Command
 				return UsageError("--confirm is required")
 			}
 
-			client, err := GetASCClient()
+			client, err := GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("%s: %w", config.ErrorPrefix, err)
 			}
@@ -334,7 +334,7 @@ func BuildConfirmDeleteCommand(config ConfirmDeleteCommandConfig) *ffcli.Command
 			}
 
 			result := config.Result(idValue)
-			return PrintOutput(result, *output.Output, *output.Pretty)
+			return PrintOutput(ctx, result, *output.Output, *output.Pretty)
 		},
 	}
 }
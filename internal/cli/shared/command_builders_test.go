@@ -277,3 +277,49 @@ func TestBuildPaginatedListCommand_PaginateUsesRequestedLimitForFirstPage(t *tes
 		t.Fatalf("expected JSON output to contain item-1, got %q", stdout)
 	}
 }
+
+func TestBuildPaginatedListCommand_PaginateStreamsNDJSONPages(t *testing.T) {
+	resetPrivateKeyTemp(t)
+
+	keyPath := filepath.Join(t.TempDir(), "AuthKey_TEST.p8")
+	writeECDSAPEM(t, keyPath)
+	t.Setenv("ASC_KEY_ID", "ENVKEY")
+	t.Setenv("ASC_ISSUER_ID", "ENVISS")
+	t.Setenv("ASC_PRIVATE_KEY_PATH", keyPath)
+
+	fetchErr := errors.New("page 2 failed")
+	cmd := BuildPaginatedListCommand(PaginatedListCommandConfig{
+		FlagSetName: "test-list",
+		Name:        "list",
+		ShortUsage:  "test list",
+		ShortHelp:   "test",
+		ParentFlag:  "app-id",
+		ErrorPrefix: "test list",
+		FetchPage: func(ctx context.Context, _ *asc.Client, parentID string, limit int, next string) (asc.PaginatedResponse, error) {
+			if next == "" {
+				return &testPaginatedResponse{
+					Data:  []map[string]string{{"id": "item-1"}},
+					Links: asc.Links{Next: "https://api.appstoreconnect.apple.com/v1/items?cursor=2"},
+				}, nil
+			}
+			return nil, fetchErr
+		},
+		ContextTimeout: func(ctx context.Context) (context.Context, context.CancelFunc) {
+			return context.WithCancel(ctx)
+		},
+	})
+
+	if err := cmd.FlagSet.Parse([]string{"--app-id", "app-1", "--paginate", "--output", "ndjson"}); err != nil {
+		t.Fatalf("parse flags: %v", err)
+	}
+
+	stdout, _ := captureOutput(t, func() {
+		if err := cmd.Exec(context.Background(), nil); !errors.Is(err, fetchErr) {
+			t.Fatalf("expected page 2 error, got %v", err)
+		}
+	})
+	// The first page is written before the second page is requested.
+	if stdout != "{\"id\":\"item-1\"}\n" {
+		t.Fatalf("expected first page to stream before failure, got %q", stdout)
+	}
+}
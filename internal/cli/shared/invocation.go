@@ -0,0 +1,129 @@
+package shared
+
+import (
+	"context"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+// Invocation holds the state of one command run in-process by another
+// command, such as a workflow asc step. Commands find it through their
+// context, so concurrent invocations never touch os.Stdout, the process
+// environment, or each other's --query/--fields projection.
+type Invocation struct {
+	// Stdout receives everything the command prints through PrintOutput and
+	// related helpers.
+	Stdout io.Writer
+	// Env overlays the process environment for settings commands read
+	// through Getenv, such as ASC_APP_ID.
+	Env map[string]string
+	// Clients, if set, hands out the authenticated clients of the invocation.
+	Clients *ClientCache
+
+	query  queryFlag
+	fields fieldsFlag
+
+	mu       sync.Mutex
+	result   any
+	captured bool
+}
+
+type invocationKey struct{}
+
+// WithInvocation returns a context that runs commands as inv.
+func WithInvocation(ctx context.Context, inv *Invocation) context.Context {
+	return context.WithValue(ctx, invocationKey{}, inv)
+}
+
+func invocationFrom(ctx context.Context) *Invocation {
+	if ctx == nil {
+		return nil
+	}
+	inv, _ := ctx.Value(invocationKey{}).(*Invocation)
+	return inv
+}
+
+// BindProjectionFlags registers --query and --fields on every command in the
+// tree that binds shared output flags, scoped to this invocation.
+func (inv *Invocation) BindProjectionFlags(cmd *ffcli.Command) {
+	bindOutputProjectionFlags(cmd, &inv.query, &inv.fields)
+}
+
+// Result returns the last value the command printed through PrintOutput or
+// PrintOutputWithRenderers, after any --query/--fields projection.
+func (inv *Invocation) Result() (any, bool) {
+	inv.mu.Lock()
+	defer inv.mu.Unlock()
+	return inv.result, inv.captured
+}
+
+func (inv *Invocation) record(data any) {
+	inv.mu.Lock()
+	defer inv.mu.Unlock()
+	inv.result = data
+	inv.captured = true
+}
+
+// recordOutput keeps a printed value as the result of the invocation in ctx.
+func recordOutput(ctx context.Context, data any) {
+	if inv := invocationFrom(ctx); inv != nil {
+		inv.record(data)
+	}
+}
+
+// Getenv returns the named variable from the invocation env in ctx, falling
+// back to the process environment.
+func Getenv(ctx context.Context, name string) string {
+	if inv := invocationFrom(ctx); inv != nil {
+		if value, ok := inv.Env[name]; ok {
+			return value
+		}
+	}
+	return os.Getenv(name)
+}
+
+// Stdout returns where command output goes for ctx: the invocation's writer,
+// or os.Stdout.
+func Stdout(ctx context.Context) io.Writer {
+	return outputWriter(ctx)
+}
+
+func outputWriter(ctx context.Context) io.Writer {
+	if inv := invocationFrom(ctx); inv != nil && inv.Stdout != nil {
+		return inv.Stdout
+	}
+	return os.Stdout
+}
+
+// ClientCache shares authenticated clients between invocations, one per
+// timeout. Credentials are resolved and the signing key is loaded once, from
+// the process settings in effect when the first client is built.
+type ClientCache struct {
+	mu      sync.Mutex
+	clients map[time.Duration]*asc.Client
+}
+
+// NewClientCache returns an empty ClientCache.
+func NewClientCache() *ClientCache {
+	return &ClientCache{clients: map[time.Duration]*asc.Client{}}
+}
+
+func (c *ClientCache) client(timeout time.Duration, build func() (*asc.Client, error)) (*asc.Client, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if client, ok := c.clients[timeout]; ok {
+		return client, nil
+	}
+	client, err := build()
+	if err != nil {
+		return nil, err
+	}
+	c.clients[timeout] = client
+	return client, nil
+}
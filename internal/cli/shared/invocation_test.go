@@ -0,0 +1,93 @@
+package shared
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"path/filepath"
+	"testing"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+)
+
+func TestInvocation_SharesClientsAcrossInvocations(t *testing.T) {
+	resetPrivateKeyTemp(t)
+
+	tempDir := t.TempDir()
+	keyPath := filepath.Join(tempDir, "AuthKey.p8")
+	writeECDSAPEM(t, keyPath)
+
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(tempDir, "missing.json"))
+	t.Setenv("ASC_BYPASS_KEYCHAIN", "1")
+	t.Setenv("ASC_PROFILE", "")
+	t.Setenv("ASC_KEY_ID", "KEY")
+	t.Setenv("ASC_ISSUER_ID", "ISS")
+	t.Setenv("ASC_PRIVATE_KEY_PATH", keyPath)
+
+	clients := NewClientCache()
+	first := WithInvocation(context.Background(), &Invocation{Clients: clients})
+	second := WithInvocation(context.Background(), &Invocation{Clients: clients})
+
+	a, err := getASCClient(first)
+	if err != nil {
+		t.Fatalf("getASCClient() error: %v", err)
+	}
+	b, err := getASCClient(second)
+	if err != nil {
+		t.Fatalf("getASCClient() error: %v", err)
+	}
+	if a != b {
+		t.Fatal("expected invocations to share one client")
+	}
+
+	standalone, err := getASCClient(context.Background())
+	if err != nil {
+		t.Fatalf("getASCClient() error: %v", err)
+	}
+	if standalone == a {
+		t.Fatal("expected a new client outside an invocation")
+	}
+}
+
+func TestInvocation_ScopesOutputEnvAndProjection(t *testing.T) {
+	t.Setenv("ASC_APP_ID", "process-app")
+	if err := SetOutputProjection("", ""); err != nil {
+		t.Fatalf("SetOutputProjection() error: %v", err)
+	}
+
+	var out bytes.Buffer
+	inv := &Invocation{Stdout: &out, Env: map[string]string{"ASC_APP_ID": "step-app"}}
+	fs := flag.NewFlagSet("view", flag.ContinueOnError)
+	BindOutputFlags(fs)
+	cmd := &ffcli.Command{Name: "view", FlagSet: fs}
+	inv.BindProjectionFlags(cmd)
+	if err := fs.Parse([]string{"--fields", "id"}); err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	ctx := WithInvocation(context.Background(), inv)
+
+	if got := ResolveAppID(ctx, ""); got != "step-app" {
+		t.Fatalf("ResolveAppID() = %q, want step-app", got)
+	}
+	if got := ResolveAppID(context.Background(), ""); got != "process-app" {
+		t.Fatalf("ResolveAppID() outside the invocation = %q, want process-app", got)
+	}
+
+	stdout, _ := captureOutput(t, func() {
+		if err := printOutput(ctx, map[string]string{"id": "1", "name": "App"}, "json", false); err != nil {
+			t.Fatalf("printOutput() error: %v", err)
+		}
+	})
+	if stdout != "" {
+		t.Fatalf("expected nothing on os.Stdout, got %q", stdout)
+	}
+	if got := out.String(); got != "{\"id\":\"1\"}\n" {
+		t.Fatalf("expected projected output on the invocation writer, got %q", got)
+	}
+	if result, ok := inv.Result(); !ok || result == nil {
+		t.Fatalf("expected the printed value to be recorded, got %v, %v", result, ok)
+	}
+	if outputProjectionActive(context.Background()) {
+		t.Fatal("expected the invocation --fields to stay out of the root projection")
+	}
+}
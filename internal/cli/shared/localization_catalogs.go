@@ -0,0 +1,461 @@
+package shared
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/validation"
+)
+
+// Localization file formats supported by download, upload, and diff.
+const (
+	LocalizationFormatStrings   = "strings"
+	LocalizationFormatXCStrings = "xcstrings"
+	LocalizationFormatXLIFF     = "xliff"
+	LocalizationFormatXLIFF2    = "xliff2"
+)
+
+const (
+	xliff12Namespace         = "urn:oasis:names:tc:xliff:document:1.2"
+	xliff20Namespace         = "urn:oasis:names:tc:xliff:document:2.0"
+	xliffSizeRestrictionNS   = "urn:oasis:names:tc:xliff:sizerestriction:2.0"
+	xliffOriginal            = "app-store-metadata"
+	xcStringsCatalogVersion  = "1.0"
+	localizationStateDefault = "translated"
+)
+
+// localizationFieldLimits maps localization keys to their App Store character limits.
+var localizationFieldLimits = map[string]int{
+	"description":     validation.LimitDescription,
+	"keywords":        validation.LimitKeywords,
+	"whatsNew":        validation.LimitWhatsNew,
+	"promotionalText": validation.LimitPromotionalText,
+	"name":            validation.LimitName,
+	"subtitle":        validation.LimitSubtitle,
+}
+
+// Translation states accepted on read. Values in any other state (for example
+// needs_review or needs-translation) are skipped so unreviewed vendor output is
+// never uploaded. A missing state counts as translated.
+var (
+	xcStringsAcceptedStates = []string{"", "translated"}
+	xliff12AcceptedStates   = []string{"", "translated", "signed-off", "final"}
+	xliff20AcceptedStates   = []string{"", "translated", "reviewed", "final"}
+)
+
+// LocalizationWriteOptions configures how downloaded localizations are written.
+type LocalizationWriteOptions struct {
+	// Format is one of the LocalizationFormat constants. Empty infers the
+	// format from the output path extension, defaulting to .strings.
+	Format string
+	// SourceLocale is the source language for .xcstrings and XLIFF output.
+	// Empty uses en-US when present, otherwise the first locale.
+	SourceLocale string
+}
+
+// ResolveLocalizationFormat validates format against the extension of path.
+// An empty format is inferred from the extension, defaulting to strings.
+func ResolveLocalizationFormat(format, path string) (string, error) {
+	normalized := strings.ToLower(strings.TrimSpace(format))
+	inferred := localizationFormatForPath(path)
+	switch normalized {
+	case "":
+		if inferred == "" {
+			return LocalizationFormatStrings, nil
+		}
+		return inferred, nil
+	case LocalizationFormatStrings, LocalizationFormatXCStrings, LocalizationFormatXLIFF, LocalizationFormatXLIFF2:
+	default:
+		return "", fmt.Errorf("--format must be one of: %s, %s, %s, %s", LocalizationFormatStrings, LocalizationFormatXCStrings, LocalizationFormatXLIFF, LocalizationFormatXLIFF2)
+	}
+	if inferred != "" && inferred != normalized && !(inferred == LocalizationFormatXLIFF && normalized == LocalizationFormatXLIFF2) {
+		return "", fmt.Errorf("--format %s does not match output path %q", normalized, path)
+	}
+	return normalized, nil
+}
+
+// localizationFormatForPath returns the format implied by the path extension,
+// or "" when the extension is not a localization file. XLIFF paths report
+// LocalizationFormatXLIFF; the version is read from the document.
+func localizationFormatForPath(path string) string {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".strings":
+		return LocalizationFormatStrings
+	case ".xcstrings":
+		return LocalizationFormatXCStrings
+	case ".xliff", ".xlf":
+		return LocalizationFormatXLIFF
+	default:
+		return ""
+	}
+}
+
+func resolveSourceLocale(sourceLocale string, locales []string) (string, error) {
+	sourceLocale = strings.TrimSpace(sourceLocale)
+	if sourceLocale != "" {
+		if !slices.Contains(locales, sourceLocale) {
+			return "", fmt.Errorf("source locale %q is not among the downloaded localizations", sourceLocale)
+		}
+		return sourceLocale, nil
+	}
+	if slices.Contains(locales, "en-US") {
+		return "en-US", nil
+	}
+	return locales[0], nil
+}
+
+func localizationLimitComment(key string) string {
+	limit, ok := localizationFieldLimits[key]
+	if !ok {
+		return ""
+	}
+	return fmt.Sprintf("Maximum %d characters.", limit)
+}
+
+// xcStringsCatalog is the JSON layout of an Xcode String Catalog.
+type xcStringsCatalog struct {
+	SourceLanguage string                    `json:"sourceLanguage"`
+	Strings        map[string]xcStringsEntry `json:"strings"`
+	Version        string                    `json:"version"`
+}
+
+type xcStringsEntry struct {
+	Comment         string                           `json:"comment,omitempty"`
+	ExtractionState string                           `json:"extractionState,omitempty"`
+	Localizations   map[string]xcStringsLocalization `json:"localizations,omitempty"`
+}
+
+type xcStringsLocalization struct {
+	StringUnit *xcStringsStringUnit `json:"stringUnit,omitempty"`
+}
+
+type xcStringsStringUnit struct {
+	State string `json:"state"`
+	Value string `json:"value"`
+}
+
+func encodeXCStrings(valuesByLocale map[string]map[string]string, order []string, sourceLocale string) ([]byte, error) {
+	catalog := xcStringsCatalog{
+		SourceLanguage: sourceLocale,
+		Strings:        make(map[string]xcStringsEntry),
+		Version:        xcStringsCatalogVersion,
+	}
+	for _, key := range order {
+		entry := xcStringsEntry{
+			Comment:         localizationLimitComment(key),
+			ExtractionState: "manual",
+			Localizations:   make(map[string]xcStringsLocalization),
+		}
+		for locale, values := range valuesByLocale {
+			value, ok := values[key]
+			if !ok {
+				continue
+			}
+			entry.Localizations[locale] = xcStringsLocalization{
+				StringUnit: &xcStringsStringUnit{State: localizationStateDefault, Value: value},
+			}
+		}
+		if len(entry.Localizations) == 0 {
+			continue
+		}
+		catalog.Strings[key] = entry
+	}
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(catalog); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func decodeXCStrings(data []byte) (map[string]map[string]string, []string, error) {
+	var catalog xcStringsCatalog
+	if err := json.Unmarshal(data, &catalog); err != nil {
+		return nil, nil, fmt.Errorf("xcstrings parse error: %w", err)
+	}
+
+	values := make(map[string]map[string]string)
+	var skipped []string
+	for key, entry := range catalog.Strings {
+		for locale, localization := range entry.Localizations {
+			unit := localization.StringUnit
+			if unit == nil {
+				continue
+			}
+			if !slices.Contains(xcStringsAcceptedStates, unit.State) {
+				skipped = append(skipped, fmt.Sprintf("%s:%s (%s)", locale, key, unit.State))
+				continue
+			}
+			if values[locale] == nil {
+				values[locale] = make(map[string]string)
+			}
+			values[locale][key] = unit.Value
+		}
+	}
+	return values, skipped, nil
+}
+
+// xliffProbe reads only the root version so the matching schema can be decoded.
+type xliffProbe struct {
+	Version string `xml:"version,attr"`
+}
+
+type xliff12Document struct {
+	XMLName xml.Name      `xml:"xliff"`
+	Xmlns   string        `xml:"xmlns,attr,omitempty"`
+	Version string        `xml:"version,attr"`
+	Files   []xliff12File `xml:"file"`
+}
+
+type xliff12File struct {
+	Original       string        `xml:"original,attr"`
+	SourceLanguage string        `xml:"source-language,attr"`
+	TargetLanguage string        `xml:"target-language,attr,omitempty"`
+	Datatype       string        `xml:"datatype,attr"`
+	Units          []xliff12Unit `xml:"body>trans-unit"`
+}
+
+type xliff12Unit struct {
+	ID       string         `xml:"id,attr"`
+	MaxWidth int            `xml:"maxwidth,attr,omitempty"`
+	SizeUnit string         `xml:"size-unit,attr,omitempty"`
+	Source   string         `xml:"source"`
+	Target   *xliff12Target `xml:"target"`
+}
+
+type xliff12Target struct {
+	State string `xml:"state,attr,omitempty"`
+	Value string `xml:",chardata"`
+}
+
+type xliff20Document struct {
+	XMLName xml.Name      `xml:"xliff"`
+	Xmlns   string        `xml:"xmlns,attr,omitempty"`
+	XmlnsSL string        `xml:"xmlns:slr,attr,omitempty"`
+	Version string        `xml:"version,attr"`
+	SrcLang string        `xml:"srcLang,attr"`
+	TrgLang string        `xml:"trgLang,attr,omitempty"`
+	Files   []xliff20File `xml:"file"`
+}
+
+type xliff20File struct {
+	ID       string           `xml:"id,attr"`
+	Profiles *xliff20Profiles `xml:"slr:profiles"`
+	Units    []xliff20Unit    `xml:"unit"`
+}
+
+// xliff20Profiles declares how slr:sizeRestriction values are measured.
+type xliff20Profiles struct {
+	GeneralProfile string `xml:"generalProfile,attr"`
+}
+
+type xliff20Unit struct {
+	ID              string           `xml:"id,attr"`
+	SizeRestriction int              `xml:"slr:sizeRestriction,attr,omitempty"`
+	Segments        []xliff20Segment `xml:"segment"`
+}
+
+type xliff20Segment struct {
+	State  string  `xml:"state,attr,omitempty"`
+	Source string  `xml:"source"`
+	Target *string `xml:"target"`
+}
+
+func encodeXLIFF(format string, values map[string]string, source map[string]string, order []string, sourceLocale, targetLocale string) ([]byte, error) {
+	var document any
+	if format == LocalizationFormatXLIFF2 {
+		file := xliff20File{
+			ID:       xliffOriginal,
+			Profiles: &xliff20Profiles{GeneralProfile: "xliff:codepoints"},
+		}
+		for _, key := range order {
+			value, ok := values[key]
+			if !ok {
+				continue
+			}
+			target := value
+			file.Units = append(file.Units, xliff20Unit{
+				ID:              key,
+				SizeRestriction: localizationFieldLimits[key],
+				Segments: []xliff20Segment{{
+					State:  localizationStateDefault,
+					Source: source[key],
+					Target: &target,
+				}},
+			})
+		}
+		document = xliff20Document{
+			Xmlns:   xliff20Namespace,
+			XmlnsSL: xliffSizeRestrictionNS,
+			Version: "2.0",
+			SrcLang: sourceLocale,
+			TrgLang: targetLocale,
+			Files:   []xliff20File{file},
+		}
+	} else {
+		file := xliff12File{
+			Original:       xliffOriginal,
+			SourceLanguage: sourceLocale,
+			TargetLanguage: targetLocale,
+			Datatype:       "plaintext",
+		}
+		for _, key := range order {
+			value, ok := values[key]
+			if !ok {
+				continue
+			}
+			unit := xliff12Unit{
+				ID:     key,
+				Source: source[key],
+				Target: &xliff12Target{State: localizationStateDefault, Value: value},
+			}
+			if limit, ok := localizationFieldLimits[key]; ok {
+				unit.MaxWidth = limit
+				unit.SizeUnit = "char"
+			}
+			file.Units = append(file.Units, unit)
+		}
+		document = xliff12Document{
+			Xmlns:   xliff12Namespace,
+			Version: "1.2",
+			Files:   []xliff12File{file},
+		}
+	}
+
+	data, err := xml.MarshalIndent(document, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(append([]byte(xml.Header), data...), '\n'), nil
+}
+
+// decodeXLIFF parses an XLIFF 1.2 or 2.0 document. fallbackLocale is used when
+// the document does not declare a target language.
+func decodeXLIFF(data []byte, fallbackLocale string) (map[string]map[string]string, []string, error) {
+	var probe xliffProbe
+	if err := xml.Unmarshal(data, &probe); err != nil {
+		return nil, nil, fmt.Errorf("xliff parse error: %w", err)
+	}
+
+	values := make(map[string]map[string]string)
+	var skipped []string
+	add := func(locale, key, value, state string, accepted []string) error {
+		if locale == "" {
+			locale = fallbackLocale
+		}
+		if locale == "" {
+			return fmt.Errorf("xliff unit %q has no target language (set target-language/trgLang or name the file <locale>.xliff)", key)
+		}
+		if !slices.Contains(accepted, state) {
+			skipped = append(skipped, fmt.Sprintf("%s:%s (%s)", locale, key, state))
+			return nil
+		}
+		if values[locale] == nil {
+			values[locale] = make(map[string]string)
+		}
+		values[locale][key] = value
+		return nil
+	}
+
+	switch {
+	case strings.HasPrefix(probe.Version, "2."):
+		var document xliff20Document
+		if err := xml.Unmarshal(data, &document); err != nil {
+			return nil, nil, fmt.Errorf("xliff parse error: %w", err)
+		}
+		for _, file := range document.Files {
+			for _, unit := range file.Units {
+				var b strings.Builder
+				state := ""
+				translated := false
+				for _, segment := range unit.Segments {
+					if segment.Target == nil {
+						continue
+					}
+					translated = true
+					b.WriteString(*segment.Target)
+					if !slices.Contains(xliff20AcceptedStates, segment.State) {
+						state = segment.State
+					}
+				}
+				if !translated {
+					continue
+				}
+				if err := add(strings.TrimSpace(document.TrgLang), unit.ID, b.String(), state, xliff20AcceptedStates); err != nil {
+					return nil, nil, err
+				}
+			}
+		}
+	case probe.Version == "1.2" || probe.Version == "":
+		var document xliff12Document
+		if err := xml.Unmarshal(data, &document); err != nil {
+			return nil, nil, fmt.Errorf("xliff parse error: %w", err)
+		}
+		for _, file := range document.Files {
+			for _, unit := range file.Units {
+				if unit.Target == nil {
+					continue
+				}
+				if err := add(strings.TrimSpace(file.TargetLanguage), unit.ID, unit.Target.Value, unit.Target.State, xliff12AcceptedStates); err != nil {
+					return nil, nil, err
+				}
+			}
+		}
+	default:
+		return nil, nil, fmt.Errorf("unsupported xliff version %q (expected 1.2 or 2.0)", probe.Version)
+	}
+	return values, skipped, nil
+}
+
+// readLocalizationCatalog reads a .xcstrings or XLIFF file into values by locale.
+func readLocalizationCatalog(path string) (map[string]map[string]string, []string, error) {
+	data, err := readLocalizationFile(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	if localizationFormatForPath(path) == LocalizationFormatXCStrings {
+		return decodeXCStrings(data)
+	}
+	base := filepath.Base(path)
+	return decodeXLIFF(data, strings.TrimSuffix(base, filepath.Ext(base)))
+}
+
+func readLocalizationFile(path string) ([]byte, error) {
+	info, err := os.Lstat(path)
+	if err != nil {
+		return nil, err
+	}
+	if info.Mode()&os.ModeSymlink != 0 {
+		return nil, fmt.Errorf("refusing to read symlink %q", path)
+	}
+	if !info.Mode().IsRegular() {
+		return nil, fmt.Errorf("expected regular file: %q", path)
+	}
+
+	file, err := OpenExistingNoFollow(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return io.ReadAll(file)
+}
+
+func warnSkippedLocalizationValues(skipped []string) {
+	if len(skipped) == 0 {
+		return
+	}
+	sort.Strings(skipped)
+	fmt.Fprintf(os.Stderr, "Warning: skipped %d localization value(s) not marked translated: %s\n", len(skipped), strings.Join(skipped, ", "))
+}
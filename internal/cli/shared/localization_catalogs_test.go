@@ -0,0 +1,216 @@
+package shared
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func catalogTestVersionItems() []asc.Resource[asc.AppStoreVersionLocalizationAttributes] {
+	return []asc.Resource[asc.AppStoreVersionLocalizationAttributes]{
+		{ID: "loc-en", Attributes: asc.AppStoreVersionLocalizationAttributes{
+			Locale:          "en-US",
+			Description:     "Line one\nLine <two> & more",
+			PromotionalText: "Try it",
+			SupportURL:      "https://example.com/support",
+		}},
+		{ID: "loc-fr", Attributes: asc.AppStoreVersionLocalizationAttributes{
+			Locale:          "fr-FR",
+			Description:     "Ligne un",
+			PromotionalText: "Essayez",
+		}},
+	}
+}
+
+func TestResolveLocalizationFormat(t *testing.T) {
+	tests := []struct {
+		format string
+		path   string
+		want   string
+		err    bool
+	}{
+		{path: "localizations", want: LocalizationFormatStrings},
+		{path: "en-US.strings", want: LocalizationFormatStrings},
+		{path: "Metadata.xcstrings", want: LocalizationFormatXCStrings},
+		{path: "fr-FR.xlf", want: LocalizationFormatXLIFF},
+		{format: "XLIFF2", path: "fr-FR.xliff", want: LocalizationFormatXLIFF2},
+		{format: "xcstrings", path: "out", want: LocalizationFormatXCStrings},
+		{format: "xcstrings", path: "en-US.strings", err: true},
+		{format: "po", path: "out", err: true},
+	}
+	for _, test := range tests {
+		got, err := ResolveLocalizationFormat(test.format, test.path)
+		if test.err {
+			if err == nil {
+				t.Fatalf("ResolveLocalizationFormat(%q, %q) expected error", test.format, test.path)
+			}
+			continue
+		}
+		if err != nil || got != test.want {
+			t.Fatalf("ResolveLocalizationFormat(%q, %q) = %q, %v; want %q", test.format, test.path, got, err, test.want)
+		}
+	}
+}
+
+func TestWriteVersionLocalizationFiles_XCStringsRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+
+	files, err := WriteVersionLocalizationFiles(dir, catalogTestVersionItems(), LocalizationWriteOptions{Format: LocalizationFormatXCStrings})
+	if err != nil {
+		t.Fatalf("WriteVersionLocalizationFiles() error: %v", err)
+	}
+	path := filepath.Join(dir, "version.xcstrings")
+	if len(files) != 2 || files[0].Path != path || files[1].Path != path {
+		t.Fatalf("unexpected files: %+v", files)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read catalog: %v", err)
+	}
+	content := string(data)
+	for _, want := range []string{`"sourceLanguage": "en-US"`, `"comment": "Maximum 170 characters."`, `"state": "translated"`, `<two> & more`} {
+		if !strings.Contains(content, want) {
+			t.Fatalf("expected %s in catalog:\n%s", want, content)
+		}
+	}
+
+	values, err := ReadLocalizationStrings(dir, nil)
+	if err != nil {
+		t.Fatalf("ReadLocalizationStrings() error: %v", err)
+	}
+	want := map[string]map[string]string{
+		"en-US": {"description": "Line one\nLine <two> & more", "promotionalText": "Try it", "supportUrl": "https://example.com/support"},
+		"fr-FR": {"description": "Ligne un", "promotionalText": "Essayez"},
+	}
+	if !reflect.DeepEqual(values, want) {
+		t.Fatalf("expected %v, got %v", want, values)
+	}
+}
+
+func TestReadLocalizationStrings_XCStringsSkipsUnreviewedValues(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "Metadata.xcstrings")
+	catalog := `{
+  "sourceLanguage": "en-US",
+  "strings": {
+    "description": {
+      "localizations": {
+        "en-US": {"stringUnit": {"state": "translated", "value": "Hello"}},
+        "fr-FR": {"stringUnit": {"state": "needs_review", "value": "Bonjour"}},
+        "de-DE": {"stringUnit": {"state": "translated", "value": "Hallo"}}
+      }
+    },
+    "keywords": {
+      "localizations": {
+        "fr-FR": {"stringUnit": {"state": "translated", "value": "un,deux"}}
+      }
+    }
+  },
+  "version": "1.0"
+}`
+	if err := os.WriteFile(path, []byte(catalog), 0o644); err != nil {
+		t.Fatalf("write catalog: %v", err)
+	}
+
+	values, err := ReadLocalizationStrings(path, []string{"en-US", "fr-FR"})
+	if err != nil {
+		t.Fatalf("ReadLocalizationStrings() error: %v", err)
+	}
+	want := map[string]map[string]string{
+		"en-US": {"description": "Hello"},
+		"fr-FR": {"keywords": "un,deux"},
+	}
+	if !reflect.DeepEqual(values, want) {
+		t.Fatalf("expected %v, got %v", want, values)
+	}
+}
+
+func TestWriteVersionLocalizationFiles_XLIFFRoundTrip(t *testing.T) {
+	for _, format := range []string{LocalizationFormatXLIFF, LocalizationFormatXLIFF2} {
+		t.Run(format, func(t *testing.T) {
+			dir := t.TempDir()
+			files, err := WriteVersionLocalizationFiles(dir, catalogTestVersionItems(), LocalizationWriteOptions{Format: format})
+			if err != nil {
+				t.Fatalf("WriteVersionLocalizationFiles() error: %v", err)
+			}
+			if len(files) != 2 || files[1].Path != filepath.Join(dir, "fr-FR.xliff") {
+				t.Fatalf("unexpected files: %+v", files)
+			}
+
+			data, err := os.ReadFile(files[1].Path)
+			if err != nil {
+				t.Fatalf("read xliff: %v", err)
+			}
+			content := string(data)
+			wants := []string{`source-language="en-US"`, `target-language="fr-FR"`, `maxwidth="170" size-unit="char"`, `<source>Try it</source>`, `<target state="translated">Essayez</target>`}
+			if format == LocalizationFormatXLIFF2 {
+				wants = []string{`srcLang="en-US"`, `trgLang="fr-FR"`, `slr:sizeRestriction="170"`, `<segment state="translated">`, `<source>Try it</source>`, `<target>Essayez</target>`}
+			}
+			for _, want := range wants {
+				if !strings.Contains(content, want) {
+					t.Fatalf("expected %s in xliff:\n%s", want, content)
+				}
+			}
+
+			values, err := ReadLocalizationStrings(dir, nil)
+			if err != nil {
+				t.Fatalf("ReadLocalizationStrings() error: %v", err)
+			}
+			if values["en-US"]["description"] != "Line one\nLine <two> & more" || values["fr-FR"]["promotionalText"] != "Essayez" || len(values["fr-FR"]) != 2 {
+				t.Fatalf("unexpected values: %v", values)
+			}
+		})
+	}
+}
+
+func TestDecodeXLIFFSkipsUnreviewedTargets(t *testing.T) {
+	xliff12 := `<?xml version="1.0" encoding="UTF-8"?>
+<xliff xmlns="urn:oasis:names:tc:xliff:document:1.2" version="1.2">
+  <file original="app-store-metadata" source-language="en-US" datatype="plaintext">
+    <body>
+      <trans-unit id="description"><source>Hello</source><target state="needs-review-translation">Hallo</target></trans-unit>
+      <trans-unit id="keywords"><source>one</source><target>eins</target></trans-unit>
+      <trans-unit id="whatsNew"><source>Fixes</source></trans-unit>
+    </body>
+  </file>
+</xliff>`
+	values, skipped, err := decodeXLIFF([]byte(xliff12), "de-DE")
+	if err != nil {
+		t.Fatalf("decodeXLIFF() error: %v", err)
+	}
+	if !reflect.DeepEqual(values, map[string]map[string]string{"de-DE": {"keywords": "eins"}}) {
+		t.Fatalf("unexpected values: %v", values)
+	}
+	if !reflect.DeepEqual(skipped, []string{"de-DE:description (needs-review-translation)"}) {
+		t.Fatalf("unexpected skipped: %v", skipped)
+	}
+
+	xliff20 := `<xliff xmlns="urn:oasis:names:tc:xliff:document:2.0" version="2.0" srcLang="en-US" trgLang="ja">
+  <file id="f1">
+    <unit id="name"><segment state="final"><source>App</source><target>アプリ</target></segment></unit>
+    <unit id="subtitle"><segment state="initial"><source>Sub</source><target>サブ</target></segment></unit>
+  </file>
+</xliff>`
+	values, skipped, err = decodeXLIFF([]byte(xliff20), "")
+	if err != nil {
+		t.Fatalf("decodeXLIFF() error: %v", err)
+	}
+	if !reflect.DeepEqual(values, map[string]map[string]string{"ja": {"name": "アプリ"}}) || len(skipped) != 1 {
+		t.Fatalf("unexpected values %v skipped %v", values, skipped)
+	}
+
+	if _, _, err := decodeXLIFF([]byte(`<xliff version="3.0"></xliff>`), "en"); err == nil {
+		t.Fatal("expected unsupported version error")
+	}
+}
+
+func TestWriteLocalizationFilesRejectsUnknownSourceLocale(t *testing.T) {
+	_, err := WriteVersionLocalizationFiles(t.TempDir(), catalogTestVersionItems(), LocalizationWriteOptions{Format: LocalizationFormatXLIFF, SourceLocale: "de-DE"})
+	if err == nil || !strings.Contains(err.Error(), `source locale "de-DE"`) {
+		t.Fatalf("expected source locale error, got %v", err)
+	}
+}
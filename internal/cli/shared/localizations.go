@@ -4,10 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"io"
 	"os"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -128,6 +128,12 @@ func NormalizeLocalizationType(value string) (string, error) {
 }
 
 func WriteVersionLocalizationStrings(outputPath string, items []asc.Resource[asc.AppStoreVersionLocalizationAttributes]) ([]asc.LocalizationFileResult, error) {
+	return WriteVersionLocalizationFiles(outputPath, items, LocalizationWriteOptions{})
+}
+
+// WriteVersionLocalizationFiles writes version localizations as .strings,
+// .xcstrings, or XLIFF files.
+func WriteVersionLocalizationFiles(outputPath string, items []asc.Resource[asc.AppStoreVersionLocalizationAttributes], opts LocalizationWriteOptions) ([]asc.LocalizationFileResult, error) {
 	byLocale := make(map[string]map[string]string, len(items))
 	for _, item := range items {
 		locale := strings.TrimSpace(item.Attributes.Locale)
@@ -136,10 +142,16 @@ func WriteVersionLocalizationStrings(outputPath string, items []asc.Resource[asc
 		}
 		byLocale[locale] = mapVersionLocalizationStrings(item.Attributes)
 	}
-	return writeLocalizationStrings(outputPath, byLocale, versionLocalizationKeys)
+	return writeLocalizationFiles(outputPath, LocalizationTypeVersion, byLocale, versionLocalizationKeys, opts)
 }
 
 func WriteAppInfoLocalizationStrings(outputPath string, items []asc.Resource[asc.AppInfoLocalizationAttributes]) ([]asc.LocalizationFileResult, error) {
+	return WriteAppInfoLocalizationFiles(outputPath, items, LocalizationWriteOptions{})
+}
+
+// WriteAppInfoLocalizationFiles writes app-info localizations as .strings,
+// .xcstrings, or XLIFF files.
+func WriteAppInfoLocalizationFiles(outputPath string, items []asc.Resource[asc.AppInfoLocalizationAttributes], opts LocalizationWriteOptions) ([]asc.LocalizationFileResult, error) {
 	byLocale := make(map[string]map[string]string, len(items))
 	for _, item := range items {
 		locale := strings.TrimSpace(item.Attributes.Locale)
@@ -148,13 +160,22 @@ func WriteAppInfoLocalizationStrings(outputPath string, items []asc.Resource[asc
 		}
 		byLocale[locale] = mapAppInfoLocalizationStrings(item.Attributes)
 	}
-	return writeLocalizationStrings(outputPath, byLocale, appInfoLocalizationKeys)
+	return writeLocalizationFiles(outputPath, LocalizationTypeAppInfo, byLocale, appInfoLocalizationKeys, opts)
 }
 
-func writeLocalizationStrings(outputPath string, valuesByLocale map[string]map[string]string, order []string) ([]asc.LocalizationFileResult, error) {
+// writeLocalizationFiles writes one file per locale, or a single catalog named
+// after catalogName when the format is xcstrings and outputPath is a directory.
+func writeLocalizationFiles(outputPath, catalogName string, valuesByLocale map[string]map[string]string, order []string, opts LocalizationWriteOptions) ([]asc.LocalizationFileResult, error) {
 	if len(valuesByLocale) == 0 {
 		return nil, fmt.Errorf("no localizations returned")
 	}
+	if strings.TrimSpace(outputPath) == "" {
+		outputPath = "localizations"
+	}
+	format, err := ResolveLocalizationFormat(opts.Format, outputPath)
+	if err != nil {
+		return nil, err
+	}
 
 	locales := make([]string, 0, len(valuesByLocale))
 	for locale := range valuesByLocale {
@@ -162,7 +183,34 @@ func writeLocalizationStrings(outputPath string, valuesByLocale map[string]map[s
 	}
 	sort.Strings(locales)
 
-	paths, err := resolveLocalizationOutputPaths(outputPath, locales)
+	sourceLocale := ""
+	if format != LocalizationFormatStrings {
+		sourceLocale, err = resolveSourceLocale(opts.SourceLocale, locales)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if format == LocalizationFormatXCStrings {
+		path := outputPath
+		if localizationFormatForPath(path) == "" {
+			path = filepath.Join(outputPath, catalogName+".xcstrings")
+		}
+		data, err := encodeXCStrings(valuesByLocale, order, sourceLocale)
+		if err != nil {
+			return nil, err
+		}
+		if err := writeNewLocalizationFile(path, data); err != nil {
+			return nil, err
+		}
+		results := make([]asc.LocalizationFileResult, 0, len(locales))
+		for _, locale := range locales {
+			results = append(results, asc.LocalizationFileResult{Locale: locale, Path: path})
+		}
+		return results, nil
+	}
+
+	paths, err := resolveLocalizationOutputPaths(outputPath, locales, format)
 	if err != nil {
 		return nil, err
 	}
@@ -173,7 +221,16 @@ func writeLocalizationStrings(outputPath string, valuesByLocale map[string]map[s
 		if !ok {
 			continue
 		}
-		if err := writeStringsFile(path, valuesByLocale[locale], order); err != nil {
+		if format == LocalizationFormatStrings {
+			err = writeStringsFile(path, valuesByLocale[locale], order)
+		} else {
+			var data []byte
+			data, err = encodeXLIFF(format, valuesByLocale[locale], valuesByLocale[sourceLocale], order, sourceLocale, locale)
+			if err == nil {
+				err = writeNewLocalizationFile(path, data)
+			}
+		}
+		if err != nil {
 			return nil, err
 		}
 		results = append(results, asc.LocalizationFileResult{
@@ -199,13 +256,13 @@ func isValidLocale(locale string) bool {
 	return localeValidationRegex.MatchString(locale)
 }
 
-func resolveLocalizationOutputPaths(outputPath string, locales []string) (map[string]string, error) {
+func resolveLocalizationOutputPaths(outputPath string, locales []string, format string) (map[string]string, error) {
 	if strings.TrimSpace(outputPath) == "" {
 		outputPath = "localizations"
 	}
 
 	result := make(map[string]string, len(locales))
-	if strings.HasSuffix(outputPath, ".strings") {
+	if localizationFormatForPath(outputPath) != "" {
 		if len(locales) != 1 {
 			return nil, fmt.Errorf("output path %q requires exactly one locale", outputPath)
 		}
@@ -225,7 +282,11 @@ func resolveLocalizationOutputPaths(outputPath string, locales []string) (map[st
 		if !isValidLocale(locale) {
 			return nil, fmt.Errorf("invalid locale code %q: must match pattern like 'en', 'en-US', or 'zh-Hans'", locale)
 		}
-		result[locale] = filepath.Join(outputPath, locale+".strings")
+		ext := ".strings"
+		if format == LocalizationFormatXLIFF || format == LocalizationFormatXLIFF2 {
+			ext = ".xliff"
+		}
+		result[locale] = filepath.Join(outputPath, locale+ext)
 	}
 	return result, nil
 }
@@ -263,6 +324,9 @@ func setIfNotEmpty(values map[string]string, key, value string) {
 	values[key] = value
 }
 
+// ReadLocalizationStrings reads localization values by locale from a directory
+// or a single .strings, .xcstrings, or XLIFF file. Catalog values that are not
+// marked translated are skipped with a warning.
 func ReadLocalizationStrings(inputPath string, locales []string) (map[string]map[string]string, error) {
 	info, err := os.Stat(inputPath)
 	if err != nil {
@@ -275,6 +339,18 @@ func ReadLocalizationStrings(inputPath string, locales []string) (map[string]map
 	}
 
 	if !info.IsDir() {
+		if format := localizationFormatForPath(inputPath); format == LocalizationFormatXCStrings || format == LocalizationFormatXLIFF {
+			values := make(map[string]map[string]string)
+			skipped, err := mergeLocalizationCatalog(values, inputPath, filter)
+			if err != nil {
+				return nil, err
+			}
+			warnSkippedLocalizationValues(skipped)
+			if len(values) == 0 {
+				return nil, fmt.Errorf("no translated localizations found in %q", inputPath)
+			}
+			return values, nil
+		}
 		if len(locales) > 1 {
 			return nil, fmt.Errorf("single file input only supports one locale")
 		}
@@ -301,35 +377,68 @@ func ReadLocalizationStrings(inputPath string, locales []string) (map[string]map
 	}
 
 	values := make(map[string]map[string]string)
+	var skipped []string
 	for _, entry := range entries {
 		if entry.IsDir() {
 			continue
 		}
-		if filepath.Ext(entry.Name()) != ".strings" {
-			continue
-		}
-		locale := strings.TrimSuffix(entry.Name(), ".strings")
-		if locale == "" {
-			continue
+		path := filepath.Join(inputPath, entry.Name())
+		switch localizationFormatForPath(entry.Name()) {
+		case LocalizationFormatStrings:
+			locale := strings.TrimSuffix(entry.Name(), filepath.Ext(entry.Name()))
+			if locale == "" {
+				continue
+			}
+			if len(filter) > 0 && !filter[locale] {
+				continue
+			}
+			parsed, err := readStringsFile(path)
+			if err != nil {
+				return nil, err
+			}
+			if _, exists := values[locale]; exists {
+				return nil, fmt.Errorf("duplicate locale %q in %s", locale, inputPath)
+			}
+			values[locale] = parsed
+		case LocalizationFormatXCStrings, LocalizationFormatXLIFF:
+			catalogSkipped, err := mergeLocalizationCatalog(values, path, filter)
+			if err != nil {
+				return nil, err
+			}
+			skipped = append(skipped, catalogSkipped...)
 		}
+	}
+	warnSkippedLocalizationValues(skipped)
+
+	if len(values) == 0 {
+		return nil, fmt.Errorf("no .strings, .xcstrings, or .xliff files found in %q", inputPath)
+	}
+	return values, nil
+}
+
+// mergeLocalizationCatalog adds the locales of a .xcstrings or XLIFF file to
+// values, rejecting locales already read from another file.
+func mergeLocalizationCatalog(values map[string]map[string]string, path string, filter map[string]bool) ([]string, error) {
+	parsed, skipped, err := readLocalizationCatalog(path)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	for locale, entries := range parsed {
 		if len(filter) > 0 && !filter[locale] {
 			continue
 		}
-		path := filepath.Join(inputPath, entry.Name())
-		parsed, err := readStringsFile(path)
-		if err != nil {
-			return nil, err
-		}
 		if _, exists := values[locale]; exists {
-			return nil, fmt.Errorf("duplicate locale %q in %s", locale, inputPath)
+			return nil, fmt.Errorf("duplicate locale %q in %s", locale, path)
 		}
-		values[locale] = parsed
+		values[locale] = entries
 	}
-
-	if len(values) == 0 {
-		return nil, fmt.Errorf("no .strings files found in %q", inputPath)
+	if len(filter) > 0 {
+		skipped = slices.DeleteFunc(skipped, func(item string) bool {
+			locale, _, _ := strings.Cut(item, ":")
+			return !filter[locale]
+		})
 	}
-	return values, nil
+	return skipped, nil
 }
 
 func UploadVersionLocalizations(ctx context.Context, client versionLocalizationClient, versionID string, valuesByLocale map[string]map[string]string, dryRun bool) ([]asc.LocalizationUploadLocaleResult, error) {
@@ -580,24 +689,7 @@ type stringsParser struct {
 }
 
 func readStringsFile(path string) (map[string]string, error) {
-	info, err := os.Lstat(path)
-	if err != nil {
-		return nil, err
-	}
-	if info.Mode()&os.ModeSymlink != 0 {
-		return nil, fmt.Errorf("refusing to read symlink %q", path)
-	}
-	if !info.Mode().IsRegular() {
-		return nil, fmt.Errorf("expected regular file: %q", path)
-	}
-
-	file, err := OpenExistingNoFollow(path)
-	if err != nil {
-		return nil, err
-	}
-	defer file.Close()
-
-	data, err := io.ReadAll(file)
+	data, err := readLocalizationFile(path)
 	if err != nil {
 		return nil, err
 	}
@@ -775,10 +867,6 @@ func (p *stringsParser) errorf(message string) error {
 }
 
 func writeStringsFile(path string, values map[string]string, order []string) error {
-	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
-		return err
-	}
-
 	var b strings.Builder
 	for _, key := range order {
 		value, ok := values[key]
@@ -788,6 +876,14 @@ func writeStringsFile(path string, values map[string]string, order []string) err
 		fmt.Fprintf(&b, "\"%s\" = \"%s\";\n", key, escapeStringsValue(value))
 	}
 
+	return writeNewLocalizationFile(path, []byte(b.String()))
+}
+
+func writeNewLocalizationFile(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
 	// Create file securely to prevent symlink attacks and TOCTOU vulnerabilities
 	// O_EXCL ensures atomic creation, O_NOFOLLOW prevents symlink traversal
 	file, err := OpenNewFileNoFollow(path, 0o644)
@@ -799,7 +895,7 @@ func writeStringsFile(path string, values map[string]string, order []string) err
 	}
 	defer file.Close()
 
-	if _, err := file.WriteString(b.String()); err != nil {
+	if _, err := file.Write(data); err != nil {
 		return err
 	}
 	return file.Sync()
@@ -0,0 +1,142 @@
+package shared
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+// outputFormats are the formats accepted by --output on commands that render
+// through PrintOutput. csv and tsv reuse the table rows; yaml and ndjson reuse
+// the JSON encoding.
+var outputFormats = []string{"json", "table", "markdown", "csv", "tsv", "yaml", "ndjson"}
+
+// PrintPaginatedOutput fetches every page after firstPage and prints the
+// result. NDJSON output streams records as each page arrives; every other
+// format prints the aggregated pages. --fields applies to each streamed
+// record; --query needs the whole document and is rejected with streaming.
+func PrintPaginatedOutput(ctx context.Context, firstPage asc.PaginatedResponse, fetchNext asc.PaginateFunc, format string, pretty bool) error {
+	normalized, err := validateOutputFormatAllowed(format, pretty, outputFormats...)
+	if err != nil {
+		return err
+	}
+	if normalized == "ndjson" {
+		if err := validateStreamedQuery(ctx); err != nil {
+			return err
+		}
+		return asc.PaginateEach(ctx, firstPage, fetchNext, func(page asc.PaginatedResponse) error {
+			return printOutput(ctx, page, normalized, pretty)
+		})
+	}
+
+	all, err := asc.PaginateAll(ctx, firstPage, fetchNext)
+	if err != nil {
+		return err
+	}
+	return printOutput(ctx, all, normalized, pretty)
+}
+
+// PrintPaginatedOutputWithSpinner is PrintPaginatedOutput with the first page
+// fetched by fetch. Aggregated formats show a spinner on stderr while pages
+// load; NDJSON streams without one so records reach stdout immediately.
+func PrintPaginatedOutputWithSpinner(ctx context.Context, fetch FetchFunc, fetchNext asc.PaginateFunc, format string, pretty bool) error {
+	normalized, err := validateOutputFormatAllowed(format, pretty, outputFormats...)
+	if err != nil {
+		return err
+	}
+	if normalized == "ndjson" {
+		if err := validateStreamedQuery(ctx); err != nil {
+			return err
+		}
+		firstPage, err := fetch(ctx)
+		if err != nil {
+			return err
+		}
+		return PrintPaginatedOutput(ctx, firstPage, fetchNext, normalized, pretty)
+	}
+
+	all, err := PaginateWithSpinner(ctx, fetch, fetchNext)
+	if err != nil {
+		return err
+	}
+	return printOutput(ctx, all, normalized, pretty)
+}
+
+// validateStreamedQuery rejects --query for streamed NDJSON output, where it
+// would run against each page instead of the merged result.
+func validateStreamedQuery(ctx context.Context) error {
+	if query, _ := outputProjection(ctx); query.expr == nil {
+		return nil
+	}
+	return UsageError("--query cannot be combined with --paginate --output ndjson; use --output json, or --fields to select fields per record")
+}
+
+// printDelimitedOutput renders data as csv or tsv from its registered table
+// rows. Types without table rows fall back to generic rows derived from their
+// JSON form.
+func printDelimitedOutput(w io.Writer, data any, format string) error {
+	if asc.HasRowRenderer(data) {
+		if format == "tsv" {
+			return asc.PrintTSVTo(w, data)
+		}
+		return asc.PrintCSVTo(w, data)
+	}
+	value, err := normalizeJSONValue(data)
+	if err != nil {
+		return fmt.Errorf("decode output for %s: %w", format, err)
+	}
+	headers, rows := projectionRows(value, nil)
+	return renderDelimitedRows(w, format, headers, rows)
+}
+
+// renderDelimitedRows writes a single table as csv or tsv.
+func renderDelimitedRows(w io.Writer, format string, headers []string, rows [][]string) error {
+	if format == "tsv" {
+		return asc.RenderTSVTo(w, headers, rows)
+	}
+	return asc.RenderCSVTo(w, headers, rows)
+}
+
+// printNDJSONOutput writes one compact JSON line per record. Records are the
+// resources in a JSON:API document's data member or the elements of an array;
+// any other value is written as a single line.
+func printNDJSONOutput(w io.Writer, data any) error {
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	encoded = bytes.TrimSpace(encoded)
+
+	if bytes.HasPrefix(encoded, []byte("{")) {
+		var document struct {
+			Data json.RawMessage `json:"data"`
+		}
+		if err := json.Unmarshal(encoded, &document); err == nil {
+			if data := bytes.TrimSpace(document.Data); len(data) > 0 && !bytes.Equal(data, []byte("null")) {
+				encoded = data
+			}
+		}
+	}
+
+	records := []json.RawMessage{encoded}
+	if bytes.HasPrefix(encoded, []byte("[")) {
+		records = nil
+		if err := json.Unmarshal(encoded, &records); err != nil {
+			return err
+		}
+	}
+
+	var buf bytes.Buffer
+	for _, record := range records {
+		if err := json.Compact(&buf, record); err != nil {
+			return err
+		}
+		buf.WriteByte('\n')
+	}
+	_, err = w.Write(buf.Bytes())
+	return err
+}
@@ -0,0 +1,179 @@
+package shared
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func TestPrintOutput_NDJSONWritesOneResourcePerLine(t *testing.T) {
+	stdout, _ := captureOutput(t, func() {
+		if err := PrintOutput(context.Background(), projectionTestApps(), "ndjson", false); err != nil {
+			t.Fatalf("PrintOutput() error: %v", err)
+		}
+	})
+	lines := strings.Split(strings.TrimSpace(stdout), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 lines, got %q", stdout)
+	}
+	if !strings.HasPrefix(lines[0], `{"type":"apps","id":"1"`) || !strings.HasPrefix(lines[1], `{"type":"apps","id":"2"`) {
+		t.Fatalf("unexpected ndjson lines: %q", lines)
+	}
+}
+
+func TestPrintOutput_NDJSONWithoutDataWritesSingleLine(t *testing.T) {
+	stdout, _ := captureOutput(t, func() {
+		if err := PrintOutput(context.Background(), map[string]any{"status": "ok"}, "ndjson", false); err != nil {
+			t.Fatalf("PrintOutput() error: %v", err)
+		}
+	})
+	if stdout != "{\"status\":\"ok\"}\n" {
+		t.Fatalf("stdout = %q", stdout)
+	}
+}
+
+func TestPrintOutput_CSVFallsBackToGenericRowsForUnregisteredTypes(t *testing.T) {
+	type summary struct {
+		Name  string `json:"name"`
+		Count int    `json:"count"`
+	}
+	stdout, _ := captureOutput(t, func() {
+		if err := PrintOutput(context.Background(), []summary{{Name: "a", Count: 1}}, "csv", false); err != nil {
+			t.Fatalf("PrintOutput() error: %v", err)
+		}
+	})
+	if stdout != "count,name\n1,a\n" {
+		t.Fatalf("stdout = %q", stdout)
+	}
+}
+
+func TestPrintOutputWithRenderers_TSVUsesDataRows(t *testing.T) {
+	type summary struct {
+		Key   string `json:"key"`
+		Value int    `json:"value"`
+	}
+	stdout, _ := captureOutput(t, func() {
+		err := PrintOutputWithRenderers(context.Background(), []summary{{Key: "a", Value: 1}}, "tsv", false,
+			func() error { t.Fatal("table renderer should not run"); return nil },
+			func() error { t.Fatal("markdown renderer should not run"); return nil },
+		)
+		if err != nil {
+			t.Fatalf("PrintOutputWithRenderers() error: %v", err)
+		}
+	})
+	if stdout != "key\tvalue\na\t1\n" {
+		t.Fatalf("stdout = %q", stdout)
+	}
+}
+
+func TestPrintOutput_PrettyOnlyWithJSON(t *testing.T) {
+	for _, format := range []string{"csv", "tsv", "yaml", "ndjson"} {
+		if err := PrintOutput(context.Background(), projectionTestApps(), format, true); err == nil {
+			t.Fatalf("expected --pretty error for %s", format)
+		}
+	}
+}
+
+func TestPrintPaginatedOutput_NDJSONStreamsPages(t *testing.T) {
+	firstPage := &asc.AppsResponse{
+		Data:  projectionTestApps().Data[:1],
+		Links: asc.Links{Next: "https://api.appstoreconnect.apple.com/v1/apps?cursor=2"},
+	}
+	fetchErr := errors.New("page failed")
+
+	stdout, _ := captureOutput(t, func() {
+		err := PrintPaginatedOutput(context.Background(), firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+			return nil, fetchErr
+		}, "ndjson", false)
+		if !errors.Is(err, fetchErr) {
+			t.Fatalf("expected fetch error, got %v", err)
+		}
+	})
+	// The first page is written before the second page is requested.
+	if !strings.HasPrefix(stdout, `{"type":"apps","id":"1"`) {
+		t.Fatalf("expected first page to stream before failure, got %q", stdout)
+	}
+}
+
+func TestPrintPaginatedOutput_AggregatesOtherFormats(t *testing.T) {
+	apps := projectionTestApps().Data
+	firstPage := &asc.AppsResponse{
+		Data:  apps[:1],
+		Links: asc.Links{Next: "https://api.appstoreconnect.apple.com/v1/apps?cursor=2"},
+	}
+
+	stdout, _ := captureOutput(t, func() {
+		err := PrintPaginatedOutput(context.Background(), firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+			return &asc.AppsResponse{Data: apps[1:]}, nil
+		}, "json", false)
+		if err != nil {
+			t.Fatalf("PrintPaginatedOutput() error: %v", err)
+		}
+	})
+	if strings.Count(stdout, "\n") != 1 || !strings.Contains(stdout, `"id":"1"`) || !strings.Contains(stdout, `"id":"2"`) {
+		t.Fatalf("expected one aggregated JSON document, got %q", stdout)
+	}
+}
+
+func TestPrintPaginatedOutput_NDJSONAppliesFieldsPerRecord(t *testing.T) {
+	setOutputProjectionForTest(t, "", "id,name")
+	apps := projectionTestApps().Data
+	firstPage := &asc.AppsResponse{
+		Data:  apps[:1],
+		Links: asc.Links{Next: "https://api.appstoreconnect.apple.com/v1/apps?cursor=2"},
+	}
+
+	stdout, _ := captureOutput(t, func() {
+		err := PrintPaginatedOutput(context.Background(), firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+			return &asc.AppsResponse{Data: apps[1:]}, nil
+		}, "ndjson", false)
+		if err != nil {
+			t.Fatalf("PrintPaginatedOutput() error: %v", err)
+		}
+	})
+	if stdout != "{\"id\":\"1\",\"name\":\"Alpha\"}\n{\"id\":\"2\",\"name\":\"Beta\"}\n" {
+		t.Fatalf("expected one projected record per line across pages, got %q", stdout)
+	}
+}
+
+func TestPrintPaginatedOutput_NDJSONRejectsQuery(t *testing.T) {
+	setOutputProjectionForTest(t, "length(data)", "")
+	apps := projectionTestApps().Data
+	firstPage := &asc.AppsResponse{
+		Data:  apps[:1],
+		Links: asc.Links{Next: "https://api.appstoreconnect.apple.com/v1/apps?cursor=2"},
+	}
+
+	stdout, stderr := captureOutput(t, func() {
+		err := PrintPaginatedOutput(context.Background(), firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+			t.Fatal("next page should not be fetched")
+			return nil, nil
+		}, "ndjson", false)
+		if !errors.Is(err, flag.ErrHelp) {
+			t.Fatalf("expected usage error, got %v", err)
+		}
+	})
+	if stdout != "" {
+		t.Fatalf("expected no output, got %q", stdout)
+	}
+	if !strings.Contains(stderr, "--query cannot be combined with --paginate --output ndjson") {
+		t.Fatalf("expected --query usage error, got %q", stderr)
+	}
+
+	// The same query over the merged pages still works with aggregated output.
+	stdout, _ = captureOutput(t, func() {
+		err := PrintPaginatedOutput(context.Background(), firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+			return &asc.AppsResponse{Data: apps[1:]}, nil
+		}, "json", false)
+		if err != nil {
+			t.Fatalf("PrintPaginatedOutput() error: %v", err)
+		}
+	})
+	if strings.TrimSpace(stdout) != "2" {
+		t.Fatalf("expected query over merged pages, got %q", stdout)
+	}
+}
@@ -0,0 +1,404 @@
+package shared
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/jmespath/go-jmespath"
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+// queryFlag compiles --query at parse time so syntax errors surface before
+// any API request is made.
+type queryFlag struct {
+	source string
+	expr   *jmespath.JMESPath
+}
+
+func (f *queryFlag) String() string {
+	if f == nil {
+		return ""
+	}
+	return f.source
+}
+
+func (f *queryFlag) Set(value string) error {
+	trimmed := strings.TrimSpace(value)
+	if trimmed == "" {
+		*f = queryFlag{}
+		return nil
+	}
+	expr, err := jmespath.Compile(trimmed)
+	if err != nil {
+		return fmt.Errorf("invalid JMESPath expression: %w", err)
+	}
+	*f = queryFlag{source: trimmed, expr: expr}
+	return nil
+}
+
+// fieldsFlag holds the --fields projection list.
+type fieldsFlag []string
+
+func (f *fieldsFlag) String() string {
+	if f == nil {
+		return ""
+	}
+	return strings.Join(*f, ",")
+}
+
+func (f *fieldsFlag) Set(value string) error {
+	fields := splitCSV(value)
+	if len(fields) == 0 && strings.TrimSpace(value) != "" {
+		return fmt.Errorf("--fields must list at least one field")
+	}
+	*f = fields
+	return nil
+}
+
+var (
+	outputQuery  queryFlag
+	outputFields fieldsFlag
+)
+
+// BindOutputProjectionFlags registers --query and --fields on every command in
+// the tree that binds shared output flags, so they can follow the subcommand
+// as well as precede it. Commands that define their own --fields (sparse
+// fieldset selectors) keep it; the root --fields still projects their output.
+func BindOutputProjectionFlags(cmd *ffcli.Command) {
+	bindOutputProjectionFlags(cmd, &outputQuery, &outputFields)
+}
+
+// outputQueryForwarder is implemented by --query values that give --query an
+// extra meaning and forward JMESPath expressions to the output projection.
+type outputQueryForwarder interface {
+	ForwardOutputQuery(target flag.Value)
+}
+
+func bindOutputProjectionFlags(cmd *ffcli.Command, query *queryFlag, fields *fieldsFlag) {
+	if cmd == nil {
+		return
+	}
+	for _, sub := range cmd.Subcommands {
+		bindOutputProjectionFlags(sub, query, fields)
+	}
+	if !bindsOutputFlags(cmd.FlagSet) {
+		return
+	}
+	if existing := cmd.FlagSet.Lookup("query"); existing == nil {
+		cmd.FlagSet.Var(query, "query", "Filter and reshape output with a JMESPath expression before rendering (e.g. 'data[].attributes.name')")
+	} else if forwarder, ok := existing.Value.(outputQueryForwarder); ok {
+		forwarder.ForwardOutputQuery(query)
+	}
+	if cmd.FlagSet.Lookup("fields") == nil {
+		cmd.FlagSet.Var(fields, "fields", "Comma-separated fields to keep in output (e.g. id,name,attributes.bundleId)")
+	}
+}
+
+func bindsOutputFlags(fs *flag.FlagSet) bool {
+	if fs == nil {
+		return false
+	}
+	found := false
+	fs.VisitAll(func(f *flag.Flag) {
+		if _, ok := f.Value.(*validatedOutputValue); ok {
+			found = true
+		}
+	})
+	return found
+}
+
+// outputProjection returns the --query/--fields projection for ctx: the
+// invocation's own, or the root flags.
+func outputProjection(ctx context.Context) (*queryFlag, fieldsFlag) {
+	if inv := invocationFrom(ctx); inv != nil {
+		return &inv.query, inv.fields
+	}
+	return &outputQuery, outputFields
+}
+
+// outputProjectionActive reports whether --query or --fields was set.
+func outputProjectionActive(ctx context.Context) bool {
+	query, fields := outputProjection(ctx)
+	return query.expr != nil || len(fields) > 0
+}
+
+// SetOutputProjection sets the --query/--fields projection (tests only).
+func SetOutputProjection(expr string, fields string) error {
+	outputQuery = queryFlag{}
+	outputFields = nil
+	if err := outputQuery.Set(expr); err != nil {
+		return err
+	}
+	return outputFields.Set(fields)
+}
+
+// applyOutputProjection decodes data to its JSON form, evaluates --query, and
+// then keeps only the --fields columns.
+func applyOutputProjection(ctx context.Context, data any) (any, error) {
+	value, err := normalizeJSONValue(data)
+	if err != nil {
+		return nil, fmt.Errorf("decode output for --query/--fields: %w", err)
+	}
+	query, fields := outputProjection(ctx)
+	if query.expr != nil {
+		value, err = query.expr.Search(value)
+		if err != nil {
+			return nil, fmt.Errorf("--query: %w", err)
+		}
+	}
+	if len(fields) > 0 {
+		value = projectFields(value, fields)
+	}
+	return value, nil
+}
+
+// normalizeJSONValue converts data into its decoded-JSON form (maps, slices,
+// strings, float64, bool, and nil) by round-tripping it through encoding/json.
+func normalizeJSONValue(data any) (any, error) {
+	switch data.(type) {
+	case nil, map[string]any, []any, string, float64, bool:
+		return data, nil
+	}
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	var decoded any
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		return nil, err
+	}
+	return decoded, nil
+}
+
+func printProjectedOutput(ctx context.Context, data any, format string, pretty bool) error {
+	value, err := applyOutputProjection(ctx, data)
+	if err != nil {
+		return err
+	}
+	recordOutput(ctx, value)
+	w := outputWriter(ctx)
+	_, fields := outputProjection(ctx)
+	switch format {
+	case "json":
+		return printJSONOutput(w, value, pretty)
+	case "table":
+		headers, rows := projectionRows(value, fields)
+		asc.RenderTableTo(w, headers, rows)
+		return nil
+	case "markdown":
+		headers, rows := projectionRows(value, fields)
+		asc.RenderMarkdownTo(w, headers, rows)
+		return nil
+	case "csv", "tsv":
+		headers, rows := projectionRows(value, fields)
+		return renderDelimitedRows(w, format, headers, rows)
+	case "yaml":
+		return asc.PrintYAMLTo(w, value)
+	case "ndjson":
+		return printNDJSONOutput(w, value)
+	default:
+		return fmt.Errorf("unsupported format: %s", format)
+	}
+}
+
+// fieldRecord is a projected object that preserves --fields order in JSON.
+type fieldRecord struct {
+	fields []string
+	values map[string]any
+}
+
+func (r fieldRecord) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, field := range r.fields {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, err := json.Marshal(field)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(r.values[field])
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// projectFields keeps only fields on each record. Records are the resources
+// in a JSON:API document's data member, the elements of an array, or a single
+// object.
+func projectFields(value any, fields []string) any {
+	if document, ok := value.(map[string]any); ok {
+		if data, ok := document["data"]; ok {
+			value = data
+		}
+	}
+
+	switch v := value.(type) {
+	case []any:
+		records := make([]fieldRecord, 0, len(v))
+		for _, item := range v {
+			records = append(records, projectRecord(item, fields))
+		}
+		return records
+	case map[string]any:
+		return projectRecord(v, fields)
+	default:
+		return value
+	}
+}
+
+func projectRecord(item any, fields []string) fieldRecord {
+	record := fieldRecord{fields: fields, values: make(map[string]any, len(fields))}
+	for _, field := range fields {
+		record.values[field] = lookupField(item, field)
+	}
+	return record
+}
+
+// lookupField resolves a dotted path. Paths that are not found on the record
+// itself fall back to its attributes, so "name" matches "attributes.name".
+func lookupField(item any, path string) any {
+	if value, ok := lookupPath(item, path); ok {
+		return value
+	}
+	if object, ok := item.(map[string]any); ok {
+		if value, ok := lookupPath(object["attributes"], path); ok {
+			return value
+		}
+	}
+	return nil
+}
+
+func lookupPath(item any, path string) (any, bool) {
+	current := item
+	for _, part := range strings.Split(path, ".") {
+		object, ok := current.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		current, ok = object[part]
+		if !ok {
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+// projectionRows renders a projected value as table rows. Lists of objects
+// become one row per object; a single object becomes Field/Value rows. fields
+// are the headers of an empty --fields projection.
+func projectionRows(value any, fields []string) ([]string, [][]string) {
+	switch v := value.(type) {
+	case []fieldRecord:
+		if len(v) == 0 {
+			return append([]string(nil), fields...), nil
+		}
+		rows := make([][]string, 0, len(v))
+		for _, record := range v {
+			rows = append(rows, recordCells(record))
+		}
+		return append([]string(nil), v[0].fields...), rows
+	case fieldRecord:
+		return append([]string(nil), v.fields...), [][]string{recordCells(v)}
+	case []any:
+		return listRows(v)
+	case map[string]any:
+		if data, ok := v["data"]; ok {
+			return projectionRows(data, fields)
+		}
+		rows := make([][]string, 0, len(v))
+		for _, key := range sortedMapKeys(v) {
+			rows = append(rows, []string{SanitizeTerminal(key), formatProjectedCell(v[key])})
+		}
+		return []string{"Field", "Value"}, rows
+	default:
+		return []string{"Value"}, [][]string{{formatProjectedCell(v)}}
+	}
+}
+
+func recordCells(record fieldRecord) []string {
+	cells := make([]string, 0, len(record.fields))
+	for _, field := range record.fields {
+		cells = append(cells, formatProjectedCell(record.values[field]))
+	}
+	return cells
+}
+
+func listRows(items []any) ([]string, [][]string) {
+	var headers []string
+	seen := make(map[string]struct{})
+	allObjects := len(items) > 0
+	for _, item := range items {
+		object, ok := item.(map[string]any)
+		if !ok {
+			allObjects = false
+			break
+		}
+		for _, key := range sortedMapKeys(object) {
+			if _, ok := seen[key]; !ok {
+				seen[key] = struct{}{}
+				headers = append(headers, key)
+			}
+		}
+	}
+
+	rows := make([][]string, 0, len(items))
+	if !allObjects {
+		for _, item := range items {
+			rows = append(rows, []string{formatProjectedCell(item)})
+		}
+		return []string{"Value"}, rows
+	}
+	for _, item := range items {
+		object := item.(map[string]any)
+		cells := make([]string, 0, len(headers))
+		for _, key := range headers {
+			cells = append(cells, formatProjectedCell(object[key]))
+		}
+		rows = append(rows, cells)
+	}
+	return headers, rows
+}
+
+func formatProjectedCell(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return SanitizeTerminal(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return SanitizeTerminal(string(data))
+	}
+}
+
+func sortedMapKeys(object map[string]any) []string {
+	keys := make([]string, 0, len(object))
+	for key := range object {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
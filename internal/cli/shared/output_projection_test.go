@@ -0,0 +1,169 @@
+package shared
+
+import (
+	"context"
+	"flag"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func projectionTestApps() *asc.AppsResponse {
+	return &asc.AppsResponse{
+		Data: []asc.Resource[asc.AppAttributes]{
+			{Type: asc.ResourceTypeApps, ID: "1", Attributes: asc.AppAttributes{Name: "Alpha", BundleID: "com.example.alpha", SKU: "A"}},
+			{Type: asc.ResourceTypeApps, ID: "2", Attributes: asc.AppAttributes{Name: "Beta", BundleID: "org.example.beta", SKU: "B"}},
+		},
+	}
+}
+
+func setOutputProjectionForTest(t *testing.T, expr, fields string) {
+	t.Helper()
+	if err := SetOutputProjection(expr, fields); err != nil {
+		t.Fatalf("SetOutputProjection() error: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = SetOutputProjection("", "")
+	})
+}
+
+func TestPrintOutput_QueryJSON(t *testing.T) {
+	setOutputProjectionForTest(t, "data[?starts_with(attributes.bundleId, 'com.')].id", "")
+
+	stdout, _ := captureOutput(t, func() {
+		if err := PrintOutput(context.Background(), projectionTestApps(), "json", false); err != nil {
+			t.Fatalf("PrintOutput() error: %v", err)
+		}
+	})
+	if got := strings.TrimSpace(stdout); got != `["1"]` {
+		t.Fatalf("stdout = %q, want %q", got, `["1"]`)
+	}
+}
+
+func TestPrintOutput_FieldsKeepOrderAndFallBackToAttributes(t *testing.T) {
+	setOutputProjectionForTest(t, "", "name,id,attributes.sku,missing")
+
+	stdout, _ := captureOutput(t, func() {
+		if err := PrintOutput(context.Background(), projectionTestApps(), "json", false); err != nil {
+			t.Fatalf("PrintOutput() error: %v", err)
+		}
+	})
+	want := `[{"name":"Alpha","id":"1","attributes.sku":"A","missing":null},{"name":"Beta","id":"2","attributes.sku":"B","missing":null}]`
+	if got := strings.TrimSpace(stdout); got != want {
+		t.Fatalf("stdout = %s, want %s", got, want)
+	}
+}
+
+func TestPrintOutput_QueryAndFieldsTable(t *testing.T) {
+	setOutputProjectionForTest(t, "data[?id == '2']", "id,bundleId")
+
+	stdout, _ := captureOutput(t, func() {
+		if err := PrintOutput(context.Background(), projectionTestApps(), "table", false); err != nil {
+			t.Fatalf("PrintOutput() error: %v", err)
+		}
+	})
+	if !strings.Contains(stdout, "bundleId") || !strings.Contains(stdout, "org.example.beta") {
+		t.Fatalf("expected projected table, got %q", stdout)
+	}
+	if strings.Contains(stdout, "Alpha") || strings.Contains(stdout, "com.example.alpha") {
+		t.Fatalf("expected filtered rows only, got %q", stdout)
+	}
+}
+
+func TestPrintOutputWithRenderers_ProjectionBypassesRenderers(t *testing.T) {
+	setOutputProjectionForTest(t, "length(data)", "")
+
+	stdout, _ := captureOutput(t, func() {
+		err := PrintOutputWithRenderers(context.Background(), projectionTestApps(), "markdown", false,
+			func() error { t.Fatal("table renderer should not run"); return nil },
+			func() error { t.Fatal("markdown renderer should not run"); return nil },
+		)
+		if err != nil {
+			t.Fatalf("PrintOutputWithRenderers() error: %v", err)
+		}
+	})
+	if !strings.Contains(stdout, "Value") || !strings.Contains(stdout, "2") {
+		t.Fatalf("expected scalar markdown output, got %q", stdout)
+	}
+}
+
+func TestSetOutputProjection_RejectsInvalidQuery(t *testing.T) {
+	t.Cleanup(func() {
+		_ = SetOutputProjection("", "")
+	})
+	if err := SetOutputProjection("data[", ""); err == nil {
+		t.Fatal("expected syntax error for invalid --query")
+	}
+}
+
+func TestBindOutputProjectionFlags(t *testing.T) {
+	t.Cleanup(func() {
+		_ = SetOutputProjection("", "")
+	})
+
+	listFS := flag.NewFlagSet("list", flag.ContinueOnError)
+	listFS.SetOutput(io.Discard)
+	BindOutputFlags(listFS)
+	sparseFS := flag.NewFlagSet("sparse", flag.ContinueOnError)
+	sparseFS.String("fields", "", "Fields to include")
+	BindOutputFlags(sparseFS)
+	groupFS := flag.NewFlagSet("group", flag.ContinueOnError)
+
+	group := &ffcli.Command{
+		Name:    "group",
+		FlagSet: groupFS,
+		Subcommands: []*ffcli.Command{
+			{Name: "list", FlagSet: listFS},
+			{Name: "sparse", FlagSet: sparseFS},
+		},
+	}
+	BindOutputProjectionFlags(group)
+
+	if groupFS.Lookup("query") != nil || groupFS.Lookup("fields") != nil {
+		t.Fatal("expected commands without output flags to be left alone")
+	}
+	if sparseFS.Lookup("query") == nil {
+		t.Fatal("expected --query on a command with its own --fields")
+	}
+	if _, ok := sparseFS.Lookup("fields").Value.(*fieldsFlag); ok {
+		t.Fatal("expected the command's own --fields to be kept")
+	}
+
+	if err := listFS.Parse([]string{"--query", "data[].id", "--fields", "id,name"}); err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+	if got := outputQuery.String(); got != "data[].id" {
+		t.Fatalf("outputQuery = %q, want %q", got, "data[].id")
+	}
+	if got := outputFields.String(); got != "id,name" {
+		t.Fatalf("outputFields = %q, want %q", got, "id,name")
+	}
+	if err := listFS.Parse([]string{"--query", "data[?"}); err == nil {
+		t.Fatal("expected parse error for invalid --query")
+	}
+}
+
+func TestProjectionRows(t *testing.T) {
+	headers, rows := projectionRows([]any{
+		map[string]any{"id": "1", "name": "Alpha"},
+		map[string]any{"id": "2", "count": float64(3)},
+	}, nil)
+	if got := strings.Join(headers, ","); got != "id,name,count" {
+		t.Fatalf("headers = %q", got)
+	}
+	if got := strings.Join(rows[1], ","); got != "2,,3" {
+		t.Fatalf("rows[1] = %q", got)
+	}
+
+	headers, rows = projectionRows(map[string]any{"total": float64(3), "ok": true}, nil)
+	if got := strings.Join(headers, ","); got != "Field,Value" {
+		t.Fatalf("headers = %q", got)
+	}
+	if len(rows) != 2 || rows[0][0] != "ok" || rows[0][1] != "true" {
+		t.Fatalf("rows = %v", rows)
+	}
+}
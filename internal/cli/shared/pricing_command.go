@@ -50,7 +50,7 @@ func NewPricingSetCommand(config PricingSetCommandConfig) *ffcli.Command {
 		FlagSet:    fs,
 		UsageFunc:  DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
-			resolvedAppID := resolveAppID(*appID)
+			resolvedAppID := resolveAppID(ctx, *appID)
 			if resolvedAppID == "" {
 				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
 				return flag.ErrHelp
@@ -98,7 +98,7 @@ func NewPricingSetCommand(config PricingSetCommandConfig) *ffcli.Command {
 				return fmt.Errorf("%s: %w", config.ErrorPrefix, err)
 			}
 
-			client, err := getASCClient()
+			client, err := getASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("%s: %w", config.ErrorPrefix, err)
 			}
@@ -147,7 +147,7 @@ func NewPricingSetCommand(config PricingSetCommandConfig) *ffcli.Command {
 				return fmt.Errorf("%s: %w", config.ErrorPrefix, err)
 			}
 
-			return printOutput(resp, *output.Output, *output.Pretty)
+			return printOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -1,6 +1,7 @@
 package shared
 
 import (
+	"context"
 	"fmt"
 	"sort"
 	"strings"
@@ -47,8 +48,8 @@ func SortResolvedPrices(rows []ResolvedPriceRow) {
 }
 
 // PrintResolvedPrices renders resolved price rows without registering global raw renderers.
-func PrintResolvedPrices(result *ResolvedPricesResult, format string, pretty bool) error {
-	return PrintOutputWithRenderers(
+func PrintResolvedPrices(ctx context.Context, result *ResolvedPricesResult, format string, pretty bool) error {
+	return PrintOutputWithRenderers(ctx,
 		result,
 		format,
 		pretty,
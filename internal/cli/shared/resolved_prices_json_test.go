@@ -1,6 +1,7 @@
 package shared
 
 import (
+	"context"
 	"encoding/json"
 	"testing"
 )
@@ -14,7 +15,7 @@ func TestPrintResolvedPrices_JSON(t *testing.T) {
 	}
 
 	stdout, _ := captureOutput(t, func() {
-		if err := PrintResolvedPrices(result, "json", false); err != nil {
+		if err := PrintResolvedPrices(context.Background(), result, "json", false); err != nil {
 			t.Fatalf("PrintResolvedPrices(json) error = %v", err)
 		}
 	})
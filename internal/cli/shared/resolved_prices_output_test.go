@@ -1,6 +1,7 @@
 package shared
 
 import (
+	"context"
 	"strings"
 	"testing"
 )
@@ -23,7 +24,7 @@ func TestPrintResolvedPrices_TableAndMarkdown(t *testing.T) {
 	}
 
 	tableOut, _ := captureOutput(t, func() {
-		if err := PrintResolvedPrices(result, "table", false); err != nil {
+		if err := PrintResolvedPrices(context.Background(), result, "table", false); err != nil {
 			t.Fatalf("PrintResolvedPrices(table) error = %v", err)
 		}
 	})
@@ -35,7 +36,7 @@ func TestPrintResolvedPrices_TableAndMarkdown(t *testing.T) {
 	}
 
 	markdownOut, _ := captureOutput(t, func() {
-		if err := PrintResolvedPrices(result, "markdown", false); err != nil {
+		if err := PrintResolvedPrices(context.Background(), result, "markdown", false); err != nil {
 			t.Fatalf("PrintResolvedPrices(markdown) error = %v", err)
 		}
 	})
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"flag"
 	"fmt"
+	"io"
 	"net/url"
 	"os"
 	"slices"
@@ -70,6 +71,8 @@ var (
 	retryLog            OptionalBool
 	debug               OptionalBool
 	apiDebug            OptionalBool
+	logFile             string
+	noCache             bool
 
 	getCredentialsWithSourceFn = auth.GetCredentialsWithSource
 	listCredentialSummariesFn  = auth.ListCredentialSummaries
@@ -93,6 +96,12 @@ func BindRootFlags(fs *flag.FlagSet) {
 	fs.Var(&retryLog, "retry-log", "Enable retry logging to stderr (overrides ASC_RETRY_LOG/config when set)")
 	fs.Var(&debug, "debug", "Enable debug logging to stderr")
 	fs.Var(&apiDebug, "api-debug", "Enable HTTP debug logging to stderr (redacts sensitive values)")
+	fs.StringVar(&logFile, "log-file", "", "Append retry, debug, and upload logs to this file instead of stderr (overrides ASC_LOG_FILE/config)")
+	fs.BoolVar(&noCache, "no-cache", false, "Bypass the on-disk API response cache (overrides ASC_CACHE_TTL/config)")
+	outputQuery = queryFlag{}
+	outputFields = nil
+	fs.Var(&outputQuery, "query", "Filter and reshape output with a JMESPath expression before rendering (e.g. 'data[].attributes.name')")
+	fs.Var(&outputFields, "fields", "Comma-separated fields to keep in output (e.g. id,name,attributes.bundleId)")
 	BindCIFlags(fs)
 }
 
@@ -749,15 +758,25 @@ func allowsEnvFallbackForStoredError(err error) bool {
 	return errors.Is(err, config.ErrNotFound) || errors.Is(err, auth.ErrDefaultCredentialsNotFound)
 }
 
-func getASCClient() (*asc.Client, error) {
-	resolved, err := resolveCredentials()
-	if err != nil {
-		return nil, err
+func getASCClient(ctx context.Context) (*asc.Client, error) {
+	return getASCClientWithTimeout(ctx, 0)
+}
+
+// getASCClientWithTimeout builds a client, or reuses the one the invocation in
+// ctx shares between its commands.
+func getASCClientWithTimeout(ctx context.Context, timeout time.Duration) (*asc.Client, error) {
+	if inv := invocationFrom(ctx); inv != nil && inv.Clients != nil {
+		return inv.Clients.client(timeout, func() (*asc.Client, error) {
+			return newASCClient(timeout)
+		})
 	}
-	return newASCClientFromResolvedCredentials(resolved, 0)
+	return newASCClient(timeout)
 }
 
-func getASCClientWithTimeout(timeout time.Duration) (*asc.Client, error) {
+func newASCClient(timeout time.Duration) (*asc.Client, error) {
+	if asc.ReplayingCassette() {
+		return newASCReplayClient(timeout)
+	}
 	resolved, err := resolveCredentials()
 	if err != nil {
 		return nil, err
@@ -767,16 +786,26 @@ func getASCClientWithTimeout(timeout time.Duration) (*asc.Client, error) {
 
 func newASCClientFromResolvedCredentials(resolved resolvedCredentials, timeout time.Duration) (*asc.Client, error) {
 	ApplyRootLoggingOverrides()
+	ApplyRootCacheOverrides()
+	profile := asc.WithProfile(resolved.profile)
 	if strings.TrimSpace(resolved.keyPEM) != "" {
 		if timeout > 0 {
-			return asc.NewClientFromPEMWithTimeout(resolved.keyID, resolved.issuerID, resolved.keyPEM, timeout)
+			return asc.NewClientFromPEMWithTimeout(resolved.keyID, resolved.issuerID, resolved.keyPEM, timeout, profile)
 		}
-		return asc.NewClientFromPEM(resolved.keyID, resolved.issuerID, resolved.keyPEM)
+		return asc.NewClientFromPEM(resolved.keyID, resolved.issuerID, resolved.keyPEM, profile)
 	}
 	if timeout > 0 {
-		return asc.NewClientWithTimeout(resolved.keyID, resolved.issuerID, resolved.keyPath, timeout)
+		return asc.NewClientWithTimeout(resolved.keyID, resolved.issuerID, resolved.keyPath, timeout, profile)
 	}
-	return asc.NewClient(resolved.keyID, resolved.issuerID, resolved.keyPath)
+	return asc.NewClient(resolved.keyID, resolved.issuerID, resolved.keyPath, profile)
+}
+
+// newASCReplayClient skips credential resolution: ASC_REPLAY serves every
+// request from the cassette, so CI can replay without signing keys.
+func newASCReplayClient(timeout time.Duration) (*asc.Client, error) {
+	ApplyRootLoggingOverrides()
+	ApplyRootCacheOverrides()
+	return asc.NewReplayClient(timeout, asc.WithProfile(resolveProfileName()))
 }
 
 // ApplyRootLoggingOverrides applies root-level logging flag overrides
@@ -802,6 +831,35 @@ func ApplyRootLoggingOverrides() {
 	}
 }
 
+// ApplyRootLogOutput applies the root-level --log-file flag and
+// ASC_LOG_FORMAT to the shared ASC loggers. Call CloseRootLogOutput when the
+// command finishes.
+func ApplyRootLogOutput() error {
+	if value := strings.TrimSpace(logFile); value != "" {
+		asc.SetLogFileOverride(&value)
+	} else {
+		asc.SetLogFileOverride(nil)
+	}
+	return asc.ConfigureLogOutput()
+}
+
+// CloseRootLogOutput flushes and closes the log file opened by
+// ApplyRootLogOutput.
+func CloseRootLogOutput() {
+	_ = asc.CloseLogOutput()
+}
+
+// ApplyRootCacheOverrides applies the root-level --no-cache flag into the
+// shared ASC runtime.
+func ApplyRootCacheOverrides() {
+	if noCache {
+		value := true
+		asc.SetResponseCacheDisabledOverride(&value)
+		return
+	}
+	asc.SetResponseCacheDisabledOverride(nil)
+}
+
 func checkMixedCredentialSources(sources credentialSource) error {
 	keyIDSource := strings.TrimSpace(sources.keyID)
 	issuerSource := strings.TrimSpace(sources.issuerID)
@@ -987,51 +1045,103 @@ func resetInvalidStrictAuthWarnings() {
 	strictAuthWarned = map[string]struct{}{}
 }
 
-func printOutput(data any, format string, pretty bool) error {
+func printOutput(ctx context.Context, data any, format string, pretty bool) error {
 	format, err := validateOutputFormat(format, pretty)
 	if err != nil {
 		return err
 	}
+	if outputProjectionActive(ctx) {
+		return printProjectedOutput(ctx, data, format, pretty)
+	}
+	recordOutput(ctx, data)
+	w := outputWriter(ctx)
 	switch format {
 	case "json":
-		return printJSONOutput(data, pretty)
+		return printJSONOutput(w, data, pretty)
 	case "markdown":
-		return asc.PrintMarkdown(data)
+		return asc.PrintMarkdownTo(w, data)
 	case "table":
-		return asc.PrintTable(data)
+		return asc.PrintTableTo(w, data)
+	case "csv", "tsv":
+		return printDelimitedOutput(w, data, format)
+	case "yaml":
+		return asc.PrintYAMLTo(w, data)
+	case "ndjson":
+		return printNDJSONOutput(w, data)
 	default:
 		return fmt.Errorf("unsupported format: %s", format)
 	}
 }
 
-func printOutputWithRenderers(data any, format string, pretty bool, tableRenderer, markdownRenderer func() error) error {
+func printOutputWithRenderers(ctx context.Context, data any, format string, pretty bool, tableRenderer, markdownRenderer func() error) error {
 	format, err := validateOutputFormat(format, pretty)
 	if err != nil {
 		return err
 	}
+	if outputProjectionActive(ctx) {
+		return printProjectedOutput(ctx, data, format, pretty)
+	}
+	recordOutput(ctx, data)
+	w := outputWriter(ctx)
 	switch format {
 	case "json":
-		return printJSONOutput(data, pretty)
+		return printJSONOutput(w, data, pretty)
 	case "table":
 		if tableRenderer == nil {
 			return fmt.Errorf("table renderer is required")
 		}
+		if invocationFrom(ctx) != nil {
+			return printRowsOutput(w, data, format)
+		}
 		return tableRenderer()
 	case "markdown":
 		if markdownRenderer == nil {
 			return fmt.Errorf("markdown renderer is required")
 		}
+		if invocationFrom(ctx) != nil {
+			return printRowsOutput(w, data, format)
+		}
 		return markdownRenderer()
+	case "csv", "tsv":
+		return printDelimitedOutput(w, data, format)
+	case "yaml":
+		return asc.PrintYAMLTo(w, data)
+	case "ndjson":
+		return printNDJSONOutput(w, data)
 	default:
 		return fmt.Errorf("unsupported format: %s", format)
 	}
 }
 
-func printJSONOutput(data any, pretty bool) error {
+// printRowsOutput renders table or markdown output for an invocation, whose
+// output must not go through command renderers that write to os.Stdout. It
+// uses the registered table rows of data, or generic rows derived from its
+// JSON form.
+func printRowsOutput(w io.Writer, data any, format string) error {
+	if asc.HasRowRenderer(data) {
+		if format == "markdown" {
+			return asc.PrintMarkdownTo(w, data)
+		}
+		return asc.PrintTableTo(w, data)
+	}
+	value, err := normalizeJSONValue(data)
+	if err != nil {
+		return fmt.Errorf("decode output for %s: %w", format, err)
+	}
+	headers, rows := projectionRows(value, nil)
+	if format == "markdown" {
+		asc.RenderMarkdownTo(w, headers, rows)
+	} else {
+		asc.RenderTableTo(w, headers, rows)
+	}
+	return nil
+}
+
+func printJSONOutput(w io.Writer, data any, pretty bool) error {
 	if pretty {
-		return asc.PrintPrettyJSON(data)
+		return asc.PrintPrettyJSONTo(w, data)
 	}
-	return asc.PrintJSON(data)
+	return asc.PrintJSONTo(w, data)
 }
 
 // NormalizeOutputFormat lowercases format and canonicalizes aliases.
@@ -1045,7 +1155,7 @@ func NormalizeOutputFormat(format string) string {
 }
 
 func validateOutputFormat(format string, pretty bool) (string, error) {
-	return validateOutputFormatAllowed(format, pretty, "json", "table", "markdown")
+	return validateOutputFormatAllowed(format, pretty, outputFormats...)
 }
 
 func validateOutputFormatAllowed(format string, pretty bool, allowed ...string) (string, error) {
@@ -1071,8 +1181,8 @@ func validateOutputFormatAllowed(format string, pretty bool, allowed ...string)
 	return normalized, nil
 }
 
-func printStreamPage(data any) error {
-	return asc.PrintJSON(data)
+func printStreamPage(ctx context.Context, data any) error {
+	return asc.PrintJSONTo(outputWriter(ctx), data)
 }
 
 func normalizeDate(value, flagName string) (string, error) {
@@ -1197,7 +1307,7 @@ func bindPrettyJSONFlagWithValue(fs *flag.FlagSet, value *bool) *bool {
 
 // BindOutputFlags registers --output and --pretty flags on the provided flagset.
 func BindOutputFlags(fs *flag.FlagSet) OutputFlags {
-	return BindOutputFlagsWith(fs, "output", DefaultOutputFormat(), "Output format: json, table, markdown")
+	return BindOutputFlagsWithAllowed(fs, "output", DefaultOutputFormat(), "Output format: json, table, markdown, csv, tsv, yaml, ndjson", outputFormats...)
 }
 
 // BindMetadataOutputFlags registers --output-format and --pretty flags on the provided flagset.
@@ -1273,10 +1383,15 @@ func wrapCommandOutputValidation(cmd *ffcli.Command, parents []*ffcli.Command) {
 	}
 }
 
-func resolveAppID(appID string) string {
+func resolveAppID(ctx context.Context, appID string) string {
 	if appID != "" {
 		return appID
 	}
+	if inv := invocationFrom(ctx); inv != nil {
+		if env, ok := inv.Env["ASC_APP_ID"]; ok {
+			return strings.TrimSpace(env)
+		}
+	}
 	if env, ok := os.LookupEnv("ASC_APP_ID"); ok {
 		return strings.TrimSpace(env)
 	}
@@ -1468,7 +1583,15 @@ func validateNextURL(next string) error {
 	if err != nil {
 		return fmt.Errorf("--next must be a valid URL: %w", err)
 	}
-	if parsed.Scheme != "https" || parsed.Host != "api.appstoreconnect.apple.com" {
+	baseURL, err := asc.ResolveBaseURL(resolveProfileName())
+	if err != nil {
+		return err
+	}
+	base, err := url.Parse(baseURL)
+	if err != nil {
+		return err
+	}
+	if parsed.Scheme != base.Scheme || parsed.Host != base.Host {
 		return fmt.Errorf("--next must be an App Store Connect URL")
 	}
 	return nil
@@ -1486,25 +1609,25 @@ func validateSort(value string, allowed ...string) error {
 }
 
 // Exported wrappers for shared helpers.
-func GetASCClient() (*asc.Client, error) {
+func GetASCClient(ctx context.Context) (*asc.Client, error) {
 	// Auth resolution can block on macOS keychain prompts. Show a subtle spinner on stderr
 	// (interactive runs only) so the CLI doesn’t look “stuck”.
 	const authSpinnerDelay = 200 * time.Millisecond
 	var client *asc.Client
 	err := WithSpinnerDelayed("", authSpinnerDelay, func() error {
 		var innerErr error
-		client, innerErr = getASCClient()
+		client, innerErr = getASCClient(ctx)
 		return innerErr
 	})
 	return client, err
 }
 
-func GetASCClientWithTimeout(timeout time.Duration) (*asc.Client, error) {
+func GetASCClientWithTimeout(ctx context.Context, timeout time.Duration) (*asc.Client, error) {
 	const authSpinnerDelay = 200 * time.Millisecond
 	var client *asc.Client
 	err := WithSpinnerDelayed("", authSpinnerDelay, func() error {
 		var innerErr error
-		client, innerErr = getASCClientWithTimeout(timeout)
+		client, innerErr = getASCClientWithTimeout(ctx, timeout)
 		return innerErr
 	})
 	return client, err
@@ -1542,12 +1665,12 @@ func ResolveProfileName() string {
 	return resolveProfileName()
 }
 
-func PrintOutput(data any, format string, pretty bool) error {
-	return printOutput(data, format, pretty)
+func PrintOutput(ctx context.Context, data any, format string, pretty bool) error {
+	return printOutput(ctx, data, format, pretty)
 }
 
-func PrintOutputWithRenderers(data any, format string, pretty bool, tableRenderer, markdownRenderer func() error) error {
-	return printOutputWithRenderers(data, format, pretty, tableRenderer, markdownRenderer)
+func PrintOutputWithRenderers(ctx context.Context, data any, format string, pretty bool, tableRenderer, markdownRenderer func() error) error {
+	return printOutputWithRenderers(ctx, data, format, pretty, tableRenderer, markdownRenderer)
 }
 
 func ValidateOutputFormat(format string, pretty bool) (string, error) {
@@ -1566,8 +1689,8 @@ func IsAppAvailabilityMissing(err error) bool {
 	return isAppAvailabilityMissing(err)
 }
 
-func ResolveAppID(appID string) string {
-	return resolveAppID(appID)
+func ResolveAppID(ctx context.Context, appID string) string {
+	return resolveAppID(ctx, appID)
 }
 
 func ContextWithTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
@@ -1613,6 +1736,6 @@ func ValidateSort(value string, allowed ...string) error {
 // PrintStreamPage writes a single page of data as a JSON line to stdout.
 // Used with --stream --paginate to emit results page-by-page as NDJSON
 // instead of buffering all pages in memory.
-func PrintStreamPage(data any) error {
-	return printStreamPage(data)
+func PrintStreamPage(ctx context.Context, data any) error {
+	return printStreamPage(ctx, data)
 }
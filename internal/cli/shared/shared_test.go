@@ -420,7 +420,7 @@ func TestValidateOutputFormat(t *testing.T) {
 		{name: "json allows pretty", input: "json", pretty: true, wantFormat: "json"},
 		{name: "md alias", input: "md", pretty: false, wantFormat: "markdown"},
 		{name: "table pretty rejected", input: "table", pretty: true, wantErr: "--pretty is only valid with JSON output"},
-		{name: "unsupported rejected", input: "yaml", pretty: false, wantErr: "unsupported format: yaml"},
+		{name: "unsupported rejected", input: "xml", pretty: false, wantErr: "unsupported format: xml"},
 	}
 
 	for _, tc := range tests {
@@ -494,7 +494,7 @@ func TestValidateOutputFormatAllowed_EmptyAllowedFallsBackToDefaultSet(t *testin
 
 func TestPrintOutputWithRenderers_JSONPath(t *testing.T) {
 	stdout, _ := captureOutput(t, func() {
-		if err := PrintOutputWithRenderers(
+		if err := PrintOutputWithRenderers(context.Background(),
 			map[string]string{"status": "ok"},
 			"json",
 			false,
@@ -511,7 +511,7 @@ func TestPrintOutputWithRenderers_JSONPath(t *testing.T) {
 
 func TestPrintOutputWithRenderers_JSONPrettyPath(t *testing.T) {
 	stdout, _ := captureOutput(t, func() {
-		if err := PrintOutputWithRenderers(
+		if err := PrintOutputWithRenderers(context.Background(),
 			map[string]string{"status": "ok"},
 			"json",
 			true,
@@ -528,7 +528,7 @@ func TestPrintOutputWithRenderers_JSONPrettyPath(t *testing.T) {
 
 func TestPrintOutputWithRenderers_EmptyFormatDefaultsJSON(t *testing.T) {
 	stdout, _ := captureOutput(t, func() {
-		if err := PrintOutputWithRenderers(
+		if err := PrintOutputWithRenderers(context.Background(),
 			map[string]string{"status": "ok"},
 			"",
 			false,
@@ -547,7 +547,7 @@ func TestPrintOutputWithRenderers_TableAndMarkdownPaths(t *testing.T) {
 	tableCalls := 0
 	markdownCalls := 0
 
-	if err := PrintOutputWithRenderers(
+	if err := PrintOutputWithRenderers(context.Background(),
 		struct{}{},
 		"table",
 		false,
@@ -560,7 +560,7 @@ func TestPrintOutputWithRenderers_TableAndMarkdownPaths(t *testing.T) {
 		t.Fatalf("expected table=1 markdown=0, got table=%d markdown=%d", tableCalls, markdownCalls)
 	}
 
-	if err := PrintOutputWithRenderers(
+	if err := PrintOutputWithRenderers(context.Background(),
 		struct{}{},
 		"md",
 		false,
@@ -575,21 +575,21 @@ func TestPrintOutputWithRenderers_TableAndMarkdownPaths(t *testing.T) {
 }
 
 func TestPrintOutputWithRenderers_RejectsPrettyForNonJSON(t *testing.T) {
-	err := PrintOutputWithRenderers(struct{}{}, "table", true, func() error { return nil }, func() error { return nil })
+	err := PrintOutputWithRenderers(context.Background(), struct{}{}, "table", true, func() error { return nil }, func() error { return nil })
 	if err == nil || !strings.Contains(err.Error(), "--pretty is only valid with JSON output") {
 		t.Fatalf("expected pretty validation error, got %v", err)
 	}
 }
 
 func TestPrintOutputWithRenderers_RequiresTableRenderer(t *testing.T) {
-	err := PrintOutputWithRenderers(struct{}{}, "table", false, nil, func() error { return nil })
+	err := PrintOutputWithRenderers(context.Background(), struct{}{}, "table", false, nil, func() error { return nil })
 	if err == nil || !strings.Contains(err.Error(), "table renderer is required") {
 		t.Fatalf("expected table renderer required error, got %v", err)
 	}
 }
 
 func TestPrintOutputWithRenderers_RequiresMarkdownRenderer(t *testing.T) {
-	err := PrintOutputWithRenderers(struct{}{}, "markdown", false, func() error { return nil }, nil)
+	err := PrintOutputWithRenderers(context.Background(), struct{}{}, "markdown", false, func() error { return nil }, nil)
 	if err == nil || !strings.Contains(err.Error(), "markdown renderer is required") {
 		t.Fatalf("expected markdown renderer required error, got %v", err)
 	}
@@ -1055,7 +1055,7 @@ func TestGetASCClient_ProfileMissingSkipsEnvFallback(t *testing.T) {
 		selectedProfile = previousProfile
 	})
 
-	_, err := getASCClient()
+	_, err := getASCClient(context.Background())
 	if err == nil {
 		t.Fatal("expected error, got nil")
 	}
@@ -1251,7 +1251,7 @@ func TestGetASCClient_UsesStoredPEMWhenPathMissing(t *testing.T) {
 	}
 	t.Cleanup(func() { getCredentialsWithSourceFn = previous })
 
-	if _, err := getASCClient(); err != nil {
+	if _, err := getASCClient(context.Background()); err != nil {
 		t.Fatalf("getASCClient() error: %v", err)
 	}
 }
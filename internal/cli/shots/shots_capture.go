@@ -85,7 +85,7 @@ macOS: app must be running. Captures the frontmost visible window by bundle ID.
 				return fmt.Errorf("screenshots capture: %w", err)
 			}
 
-			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 		},
 	}
 }
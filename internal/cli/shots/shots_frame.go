@@ -163,7 +163,7 @@ framed screenshots whenever the YAML config or referenced raw assets change.`,
 				return fmt.Errorf("screenshots frame: %w", err)
 			}
 
-			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -30,7 +30,7 @@ func ShotsFramesListDevicesCommand() *ffcli.Command {
 				Default: string(screenshots.DefaultFrameDevice()),
 				Devices: screenshots.FrameDeviceOptions(),
 			}
-			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 		},
 	}
 }
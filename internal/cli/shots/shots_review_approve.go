@@ -61,7 +61,7 @@ Selectors:
 			if err != nil {
 				return fmt.Errorf("screenshots review-approve: %w", err)
 			}
-			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -53,7 +53,7 @@ func ShotsReviewGenerateCommand() *ffcli.Command {
 			if err != nil {
 				return fmt.Errorf("screenshots review-generate: %w", err)
 			}
-			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -40,7 +40,7 @@ func ShotsReviewOpenCommand() *ffcli.Command {
 			if err != nil {
 				return fmt.Errorf("screenshots review-open: %w", err)
 			}
-			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 		},
 	}
 }
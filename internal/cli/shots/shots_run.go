@@ -64,7 +64,7 @@ Supported actions: launch, tap, type, wait, wait_for (polling), screenshot.`,
 				return fmt.Errorf("screenshots run: %w", err)
 			}
 
-			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -71,7 +71,7 @@ Examples:
 				outputDir = "./signing"
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("signing fetch: %w", err)
 			}
@@ -79,7 +79,7 @@ Examples:
 			requestCtx, cancel := shared.ContextWithTimeout(ctx)
 			defer cancel()
 
-			resolvedAppID := shared.ResolveAppID(*appID)
+			resolvedAppID := shared.ResolveAppID(ctx, *appID)
 			if resolvedAppID != "" {
 				if err := validateBundleIDMatchesApp(requestCtx, client, resolvedAppID, bundle); err != nil {
 					return fmt.Errorf("signing fetch: %w", err)
@@ -148,7 +148,7 @@ Examples:
 				result.CertificateFiles = append(result.CertificateFiles, certPath)
 			}
 
-			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 		},
 	}
 }
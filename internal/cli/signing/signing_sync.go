@@ -117,7 +117,7 @@ func syncPushCommand() *ffcli.Command {
 				return err
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("signing sync push: %w", err)
 			}
@@ -215,7 +215,7 @@ func syncPushCommand() *ffcli.Command {
 				ProfileType: profType,
 				Files:       files,
 			}
-			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -285,7 +285,7 @@ func syncPullCommand() *ffcli.Command {
 					RepoURL:   sanitizeRepoURLForOutput(repo),
 					Files:     []string{},
 				}
-				return shared.PrintOutput(result, *output.Output, *output.Pretty)
+				return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 			}
 
 			if err := os.MkdirAll(outDir, 0o755); err != nil {
@@ -314,7 +314,7 @@ func syncPullCommand() *ffcli.Command {
 				RepoURL:   sanitizeRepoURLForOutput(repo),
 				Files:     files,
 			}
-			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -163,7 +163,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			resolvedAppID := shared.ResolveAppID(*appID)
+			resolvedAppID := shared.ResolveAppID(ctx, *appID)
 			if resolvedAppID == "" {
 				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
 				return flag.ErrHelp
@@ -183,7 +183,7 @@ Examples:
 				return shared.UsageError("--max-polls requires --watch")
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("status: %w", err)
 			}
@@ -207,7 +207,7 @@ Examples:
 				return fmt.Errorf("status: %w", err)
 			}
 
-			return shared.PrintOutputWithRenderers(
+			return shared.PrintOutputWithRenderers(ctx,
 				resp,
 				*output.Output,
 				*output.Pretty,
@@ -220,6 +220,7 @@ Examples:
 
 func watchDashboard(ctx context.Context, client *asc.Client, appID string, includes includeSet, output string, pretty bool, pollInterval time.Duration, maxPolls int) error {
 	seen := ""
+	ctx = asc.WithoutResponseCache(ctx)
 
 	for poll := 1; maxPolls == 0 || poll <= maxPolls; poll++ {
 		requestCtx, cancel := shared.ContextWithTimeout(ctx)
@@ -237,7 +238,7 @@ func watchDashboard(ctx context.Context, client *asc.Client, appID string, inclu
 			return fmt.Errorf("status: encode watch snapshot: %w", err)
 		}
 		if poll == 1 || current != seen {
-			if err := printWatchSnapshot(resp, output, pretty, poll > 1); err != nil {
+			if err := printWatchSnapshot(ctx, resp, output, pretty, poll > 1); err != nil {
 				return err
 			}
 			seen = current
@@ -295,7 +296,7 @@ func normalizeStringSlice(values []string) []string {
 	return append([]string(nil), values...)
 }
 
-func printWatchSnapshot(resp *dashboardResponse, output string, pretty bool, separator bool) error {
+func printWatchSnapshot(ctx context.Context, resp *dashboardResponse, output string, pretty bool, separator bool) error {
 	format := strings.ToLower(strings.TrimSpace(output))
 	if format == "" {
 		format = shared.DefaultOutputFormat()
@@ -329,7 +330,7 @@ func printWatchSnapshot(resp *dashboardResponse, output string, pretty bool, sep
 		renderMarkdown(resp)
 		return nil
 	default:
-		return shared.PrintOutputWithRenderers(
+		return shared.PrintOutputWithRenderers(ctx,
 			resp,
 			output,
 			pretty,
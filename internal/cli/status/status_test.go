@@ -2,6 +2,7 @@ package status
 
 import (
 	"bytes"
+	"context"
 	"os"
 	"strings"
 	"testing"
@@ -88,7 +89,7 @@ func TestPrintWatchSnapshot_EmptyFormatUsesSharedDefaultOutput(t *testing.T) {
 	}
 
 	stdout, stderr := captureOutput(t, func() {
-		if err := printWatchSnapshot(resp, "", false, false); err != nil {
+		if err := printWatchSnapshot(context.Background(), resp, "", false, false); err != nil {
 			t.Fatalf("printWatchSnapshot() error = %v", err)
 		}
 	})
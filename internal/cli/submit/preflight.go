@@ -86,7 +86,7 @@ Examples:
 				return shared.UsageErrorf("unexpected argument(s): %s", strings.Join(args, " "))
 			}
 
-			resolvedAppID := shared.ResolveAppID(*appID)
+			resolvedAppID := shared.ResolveAppID(ctx, *appID)
 			if resolvedAppID == "" {
 				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
 				return flag.ErrHelp
@@ -120,7 +120,7 @@ Examples:
 				return nil
 			}
 
-			if err := shared.PrintOutput(result, normalizedOutput, *output.Pretty); err != nil {
+			if err := shared.PrintOutput(ctx, result, normalizedOutput, *output.Pretty); err != nil {
 				return err
 			}
 			if result.FailCount > 0 {
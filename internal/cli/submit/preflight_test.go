@@ -27,7 +27,7 @@ func TestSubmitPreflightCommand_MissingApp(t *testing.T) {
 	}
 	// ResolveAppID may still find an ID from local config — skip Exec test
 	// if that's the case, and only assert the command shape.
-	resolved := shared.ResolveAppID("")
+	resolved := shared.ResolveAppID(context.Background(), "")
 	if resolved != "" {
 		t.Skip("local config provides an app ID; skipping flag validation test")
 	}
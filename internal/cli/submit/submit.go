@@ -106,7 +106,7 @@ nondeprecated command above instead of extending this alias.`,
 				return shared.UsageError("--version and --version-id are mutually exclusive")
 			}
 
-			resolvedAppID := shared.ResolveAppID(*appID)
+			resolvedAppID := shared.ResolveAppID(ctx, *appID)
 			if resolvedAppID == "" {
 				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
 				return flag.ErrHelp
@@ -117,7 +117,7 @@ nondeprecated command above instead of extending this alias.`,
 				return shared.UsageError(err.Error())
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("submit create: %w", err)
 			}
@@ -247,7 +247,7 @@ nondeprecated command above instead of extending this alias.`,
 				CreatedDate:  createdDatePtr,
 			}
 
-			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -474,7 +474,7 @@ Examples:
 				return shared.UsageError("--id and --version-id are mutually exclusive")
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("submit status: %w", err)
 			}
@@ -526,7 +526,7 @@ Examples:
 						}
 					} else if reviewSubmission != nil {
 						applyReviewSubmissionStatus(result, reviewSubmission)
-						return shared.PrintOutput(result, *output.Output, *output.Pretty)
+						return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 					}
 				}
 
@@ -537,7 +537,7 @@ Examples:
 					return fmt.Errorf("submit status: %w", legacyErr)
 				}
 
-				return shared.PrintOutput(result, *output.Output, *output.Pretty)
+				return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 			}
 
 			if resolvedVersionID != "" {
@@ -548,7 +548,7 @@ Examples:
 				applyVersionStatus(result, versionResp)
 			}
 
-			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -842,7 +842,7 @@ Examples:
 				return shared.UsageError("--id and --version-id are mutually exclusive")
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("submit cancel: %w", err)
 			}
@@ -890,7 +890,7 @@ Examples:
 					if explicitAppID != "" {
 						resolvedAppID = explicitAppID
 					} else {
-						resolvedAppID = shared.ResolveAppID(*appID)
+						resolvedAppID = shared.ResolveAppID(ctx, *appID)
 					}
 				}
 
@@ -905,7 +905,7 @@ Examples:
 								ID:        resolvedSubmissionID,
 								Cancelled: true,
 							}
-							return shared.PrintOutput(result, *output.Output, *output.Pretty)
+							return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 						}
 						if !isPotentiallyCancellableReviewSubmissionState(submission.Attributes.SubmissionState) {
 							submission = nil
@@ -921,7 +921,7 @@ Examples:
 										ID:        resolvedSubmissionID,
 										Cancelled: true,
 									}
-									return shared.PrintOutput(result, *output.Output, *output.Pretty)
+									return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 								}
 								return fmt.Errorf("submit cancel: submission %s is no longer cancellable: %w", submission.ID, cancelErr)
 							} else {
@@ -934,7 +934,7 @@ Examples:
 								ID:        resolvedSubmissionID,
 								Cancelled: true,
 							}
-							return shared.PrintOutput(result, *output.Output, *output.Pretty)
+							return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 						}
 					}
 				}
@@ -962,7 +962,7 @@ Examples:
 						ID:        resolvedSubmissionID,
 						Cancelled: true,
 					}
-					return shared.PrintOutput(result, *output.Output, *output.Pretty)
+					return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 				}
 				if !asc.IsNotFound(err) {
 					return fmt.Errorf("submit cancel: %w", err)
@@ -981,7 +981,7 @@ Examples:
 				Cancelled: true,
 			}
 
-			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 		},
 	}
 }
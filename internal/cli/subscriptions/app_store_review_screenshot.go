@@ -59,7 +59,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("subscriptions app-store-review-screenshot get: %w", err)
 			}
@@ -77,7 +77,7 @@ Examples:
 				return fmt.Errorf("subscriptions app-store-review-screenshot get: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
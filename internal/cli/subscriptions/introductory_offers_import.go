@@ -136,7 +136,7 @@ Examples:
 
 			if *dryRun {
 				summary.Created = len(resolvedRows)
-				return shared.PrintOutputWithRenderers(
+				return shared.PrintOutputWithRenderers(ctx,
 					summary,
 					*output.Output,
 					*output.Pretty,
@@ -145,7 +145,7 @@ Examples:
 				)
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("subscriptions introductory-offers import: %w", err)
 			}
@@ -184,7 +184,7 @@ Examples:
 				summary.Created++
 			}
 
-			if err := shared.PrintOutputWithRenderers(
+			if err := shared.PrintOutputWithRenderers(ctx,
 				summary,
 				*output.Output,
 				*output.Pretty,
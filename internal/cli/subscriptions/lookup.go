@@ -15,7 +15,7 @@ func addSubscriptionLookupAppFlag(fs *flag.FlagSet) *string {
 }
 
 func resolveSubscriptionLookupID(ctx context.Context, client *asc.Client, appValue, selector string) (string, error) {
-	resolvedAppID := shared.ResolveAppID(appValue)
+	resolvedAppID := shared.ResolveAppID(ctx, appValue)
 	if err := shared.RequireAppForStableSelector(resolvedAppID, selector, "--subscription-id"); err != nil {
 		return "", err
 	}
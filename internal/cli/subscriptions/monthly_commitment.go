@@ -129,7 +129,7 @@ Examples:
 				return shared.UsageError("no eligible monthly-commitment territories remain after excluding USA and Singapore")
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("subscriptions pricing monthly-commitment enable: %w", err)
 			}
@@ -112,7 +112,7 @@ Examples:
 				return shared.UsageError("--subscription-id is required")
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("subscriptions price-points list: %w", err)
 			}
@@ -163,7 +163,7 @@ Examples:
 						if !ok {
 							return fmt.Errorf("unexpected pagination response type %T", page)
 						}
-						return shared.PrintStreamPage(typed)
+						return shared.PrintStreamPage(ctx, typed)
 					},
 				); err != nil {
 					return fmt.Errorf("subscriptions price-points list: %w", err)
@@ -180,23 +180,31 @@ Examples:
 					return fmt.Errorf("subscriptions price-points list: failed to fetch: %w", err)
 				}
 
-				resp, err := asc.PaginateAll(ctx, firstPage, func(_ context.Context, nextURL string) (asc.PaginatedResponse, error) {
-					pageCtx, pageCancel := shared.ContextWithTimeout(ctx)
-					defer pageCancel()
-					return client.GetSubscriptionPricePoints(pageCtx, id, asc.WithSubscriptionPricePointsNextURL(nextURL))
-				})
-				if err != nil {
-					return fmt.Errorf("subscriptions price-points list: %w", err)
-				}
-
 				if priceFilter.HasFilter() {
-					if typed, ok := resp.(*asc.SubscriptionPricePointsResponse); ok {
-						filterSubscriptionPricePoints(typed, priceFilter)
-						return shared.PrintOutput(typed, *output.Output, *output.Pretty)
-					}
+					filterSubscriptionPricePoints(firstPage, priceFilter)
 				}
 
-				return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+				if err := shared.PrintPaginatedOutput(
+					ctx,
+					firstPage,
+					func(_ context.Context, nextURL string) (asc.PaginatedResponse, error) {
+						pageCtx, pageCancel := shared.ContextWithTimeout(ctx)
+						defer pageCancel()
+						page, err := client.GetSubscriptionPricePoints(pageCtx, id, asc.WithSubscriptionPricePointsNextURL(nextURL))
+						if err != nil {
+							return nil, err
+						}
+						if priceFilter.HasFilter() {
+							filterSubscriptionPricePoints(page, priceFilter)
+						}
+						return page, nil
+					},
+					*output.Output,
+					*output.Pretty,
+				); err != nil {
+					return fmt.Errorf("subscriptions price-points list: %w", err)
+				}
+				return nil
 			}
 
 			resp, err := client.GetSubscriptionPricePoints(requestCtx, id, opts...)
@@ -208,7 +216,7 @@ Examples:
 				filterSubscriptionPricePoints(resp, priceFilter)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -248,7 +256,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("subscriptions price-points get: %w", err)
 			}
@@ -261,7 +269,7 @@ Examples:
 				return fmt.Errorf("subscriptions price-points get: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
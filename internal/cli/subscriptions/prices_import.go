@@ -147,7 +147,7 @@ Examples:
 				return fmt.Errorf("subscriptions prices import: %w", err)
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("subscriptions prices import: %w", err)
 			}
@@ -221,7 +221,7 @@ Examples:
 				summary.Created++
 			}
 
-			if err := shared.PrintOutputWithRenderers(
+			if err := shared.PrintOutputWithRenderers(ctx,
 				summary,
 				*output.Output,
 				*output.Pretty,
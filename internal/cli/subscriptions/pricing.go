@@ -90,7 +90,7 @@ func buildSubscriptionsPricingSummaryCommand(
 		Exec: func(ctx context.Context, args []string) error {
 			requestedSubID := strings.TrimSpace(*subscriptionID)
 			requestedAppID := strings.TrimSpace(*appID)
-			resolvedAppID := shared.ResolveAppID(requestedAppID)
+			resolvedAppID := shared.ResolveAppID(ctx, requestedAppID)
 			if requestedSubID == "" && resolvedAppID == "" {
 				fmt.Fprintln(os.Stderr, "Error: --app or --subscription-id is required")
 				return flag.ErrHelp
@@ -105,7 +105,7 @@ func buildSubscriptionsPricingSummaryCommand(
 				return shared.UsageError(err.Error())
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("subscriptions pricing: %w", err)
 			}
@@ -179,7 +179,7 @@ func buildSubscriptionsPricingSummaryCommand(
 			}
 
 			if len(subs) == 0 {
-				return printSubscriptionPricingResult(&subscriptionPricingResult{Subscriptions: []subscriptionPriceSummary{}}, *output.Output, *output.Pretty)
+				return printSubscriptionPricingResult(ctx, &subscriptionPricingResult{Subscriptions: []subscriptionPriceSummary{}}, *output.Output, *output.Pretty)
 			}
 
 			summaries, err := resolveSubscriptionPriceSummaries(ctx, client, subs, territoryFilter)
@@ -187,7 +187,7 @@ func buildSubscriptionsPricingSummaryCommand(
 				return fmt.Errorf("subscriptions pricing: %w", err)
 			}
 
-			return printSubscriptionPricingResult(&subscriptionPricingResult{Subscriptions: summaries}, *output.Output, *output.Pretty)
+			return printSubscriptionPricingResult(ctx, &subscriptionPricingResult{Subscriptions: summaries}, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -491,8 +491,8 @@ func territoryToCurrency(territory string) string {
 	return territory
 }
 
-func printSubscriptionPricingResult(result *subscriptionPricingResult, format string, pretty bool) error {
-	return shared.PrintOutputWithRenderers(
+func printSubscriptionPricingResult(ctx context.Context, result *subscriptionPricingResult, format string, pretty bool) error {
+	return shared.PrintOutputWithRenderers(ctx,
 		result,
 		format,
 		pretty,
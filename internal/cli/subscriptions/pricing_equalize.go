@@ -91,7 +91,7 @@ Examples:
 				return shared.UsageError("--workers must be between 1 and 32")
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("equalize: %w", err)
 			}
@@ -136,7 +136,7 @@ Examples:
 			allTerritories = append(allTerritories, equalizations...)
 
 			if *dryRun {
-				return printEqualizeResult(&equalizeResult{
+				return printEqualizeResult(ctx, &equalizeResult{
 					SubscriptionID: subID,
 					BaseTerritory:  territory,
 					BasePrice:      price,
@@ -191,7 +191,7 @@ Examples:
 							Failures:       renderEqualizeFailures(failures),
 						}
 						fmt.Fprintf(os.Stderr, "Done: %d succeeded, %d failed\n", result.Succeeded, result.Failed)
-						if err := printEqualizeResult(result, *output.Output, *output.Pretty); err != nil {
+						if err := printEqualizeResult(ctx, result, *output.Output, *output.Pretty); err != nil {
 							return err
 						}
 						return shared.NewReportedError(fmt.Errorf("equalize: failed to set initial price in %s", baseTarget.Territory))
@@ -221,7 +221,7 @@ Examples:
 
 			fmt.Fprintf(os.Stderr, "Done: %d succeeded, %d failed\n", result.Succeeded, result.Failed)
 
-			if err := printEqualizeResult(result, *output.Output, *output.Pretty); err != nil {
+			if err := printEqualizeResult(ctx, result, *output.Output, *output.Pretty); err != nil {
 				return err
 			}
 			if result.Failed > 0 {
@@ -747,8 +747,8 @@ func territoryFromPricePointRelationships(raw json.RawMessage) string {
 	return strings.ToUpper(strings.TrimSpace(relationships.Territory.Data.ID))
 }
 
-func printEqualizeResult(result *equalizeResult, format string, pretty bool) error {
-	return shared.PrintOutputWithRenderers(
+func printEqualizeResult(ctx context.Context, result *equalizeResult, format string, pretty bool) error {
+	return shared.PrintOutputWithRenderers(ctx,
 		result,
 		format,
 		pretty,
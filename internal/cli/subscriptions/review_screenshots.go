@@ -67,7 +67,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("subscriptions review-screenshots get: %w", err)
 			}
@@ -80,7 +80,7 @@ Examples:
 				return fmt.Errorf("subscriptions review-screenshots get: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -123,7 +123,7 @@ Examples:
 			}
 			defer file.Close()
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("subscriptions review-screenshots create: %w", err)
 			}
@@ -172,7 +172,7 @@ Examples:
 				return fmt.Errorf("subscriptions review-screenshots create: %w", verifyErr)
 			}
 
-			return shared.PrintOutput(finalResp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, finalResp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -210,7 +210,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("subscriptions review-screenshots update: %w", err)
 			}
@@ -232,7 +232,7 @@ Examples:
 				return fmt.Errorf("subscriptions review-screenshots update: failed to update: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -266,7 +266,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("subscriptions review-screenshots delete: %w", err)
 			}
@@ -279,7 +279,7 @@ Examples:
 			}
 
 			result := &asc.AssetDeleteResult{ID: id, Deleted: true}
-			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 		},
 	}
 }
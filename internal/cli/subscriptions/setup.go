@@ -196,7 +196,7 @@ Examples:
 			}
 
 			opts := subscriptionsSetupOptions{
-				AppID:                     shared.ResolveAppID(*appID),
+				AppID:                     shared.ResolveAppID(ctx, *appID),
 				GroupID:                   strings.TrimSpace(*groupID),
 				GroupReferenceName:        groupReferenceNameValue,
 				ReferenceName:             referenceNameValue,
@@ -295,7 +295,7 @@ Examples:
 			}
 
 			result, runErr := executeSubscriptionsSetup(ctx, opts)
-			if printErr := printSubscriptionsSetupResult(&result, *output.Output, *output.Pretty); printErr != nil {
+			if printErr := printSubscriptionsSetupResult(ctx, &result, *output.Output, *output.Pretty); printErr != nil {
 				return printErr
 			}
 			if runErr != nil {
@@ -322,7 +322,7 @@ func executeSubscriptionsSetup(ctx context.Context, opts subscriptionsSetupOptio
 		Steps:              make([]subscriptionsSetupStepResult, 0, 7),
 	}
 
-	client, err := shared.GetASCClient()
+	client, err := shared.GetASCClient(ctx)
 	if err != nil {
 		result.Status = "error"
 		result.Error = err.Error()
@@ -770,9 +770,9 @@ func resolveExpectedSubscriptionSetupVerificationPrice(ctx context.Context, clie
 	return "", fmt.Errorf("price point %q not found in %s during verification", opts.PricePointID, opts.PriceTerritory)
 }
 
-func printSubscriptionsSetupResult(result *subscriptionsSetupResult, format string, pretty bool) error {
+func printSubscriptionsSetupResult(ctx context.Context, result *subscriptionsSetupResult, format string, pretty bool) error {
 	headers, rows := subscriptionsSetupResultRows(result)
-	return shared.PrintOutputWithRenderers(
+	return shared.PrintOutputWithRenderers(ctx,
 		result,
 		format,
 		pretty,
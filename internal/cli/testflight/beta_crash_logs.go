@@ -63,7 +63,7 @@ func deprecatedBetaCrashLogsGetAliasCommand() *ffcli.Command {
 }
 
 func runCrashLogByCrashLogID(ctx context.Context, crashLogID string, output shared.OutputFlags) error {
-	client, err := shared.GetASCClient()
+	client, err := shared.GetASCClient(ctx)
 	if err != nil {
 		return fmt.Errorf("testflight crashes log: %w", err)
 	}
@@ -76,5 +76,5 @@ func runCrashLogByCrashLogID(ctx context.Context, crashLogID string, output shar
 		return fmt.Errorf("testflight crashes log: failed to fetch: %w", err)
 	}
 
-	return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+	return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 }
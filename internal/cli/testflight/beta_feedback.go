@@ -507,7 +507,7 @@ func resolveLegacyAliasID(legacyID, canonicalID, canonicalFlag string) (string,
 }
 
 func runFeedbackSubmissionView(ctx context.Context, submissionID string, output shared.OutputFlags) error {
-	client, err := shared.GetASCClient()
+	client, err := shared.GetASCClient(ctx)
 	if err != nil {
 		return fmt.Errorf("testflight feedback view: %w", err)
 	}
@@ -520,11 +520,11 @@ func runFeedbackSubmissionView(ctx context.Context, submissionID string, output
 		return fmt.Errorf("testflight feedback view: failed to fetch: %w", err)
 	}
 
-	return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+	return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 }
 
 func runFeedbackSubmissionDelete(ctx context.Context, submissionID string, output shared.OutputFlags) error {
-	client, err := shared.GetASCClient()
+	client, err := shared.GetASCClient(ctx)
 	if err != nil {
 		return fmt.Errorf("testflight feedback delete: %w", err)
 	}
@@ -541,11 +541,11 @@ func runFeedbackSubmissionDelete(ctx context.Context, submissionID string, outpu
 		Deleted: true,
 	}
 
-	return shared.PrintOutput(result, *output.Output, *output.Pretty)
+	return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 }
 
 func runCrashSubmissionView(ctx context.Context, submissionID string, output shared.OutputFlags) error {
-	client, err := shared.GetASCClient()
+	client, err := shared.GetASCClient(ctx)
 	if err != nil {
 		return fmt.Errorf("testflight crashes view: %w", err)
 	}
@@ -558,11 +558,11 @@ func runCrashSubmissionView(ctx context.Context, submissionID string, output sha
 		return fmt.Errorf("testflight crashes view: failed to fetch: %w", err)
 	}
 
-	return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+	return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 }
 
 func runCrashSubmissionDelete(ctx context.Context, submissionID string, output shared.OutputFlags) error {
-	client, err := shared.GetASCClient()
+	client, err := shared.GetASCClient(ctx)
 	if err != nil {
 		return fmt.Errorf("testflight crashes delete: %w", err)
 	}
@@ -579,11 +579,11 @@ func runCrashSubmissionDelete(ctx context.Context, submissionID string, output s
 		Deleted: true,
 	}
 
-	return shared.PrintOutput(result, *output.Output, *output.Pretty)
+	return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 }
 
 func runCrashLogBySubmissionID(ctx context.Context, submissionID string, output shared.OutputFlags) error {
-	client, err := shared.GetASCClient()
+	client, err := shared.GetASCClient(ctx)
 	if err != nil {
 		return fmt.Errorf("testflight crashes log: %w", err)
 	}
@@ -596,5 +596,5 @@ func runCrashLogBySubmissionID(ctx context.Context, submissionID string, output
 		return fmt.Errorf("testflight crashes log: failed to fetch: %w", err)
 	}
 
-	return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+	return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 }
@@ -91,7 +91,7 @@ Examples:
 				return fmt.Errorf("beta-groups list: %w", err)
 			}
 
-			resolvedAppID := shared.ResolveAppID(*appID)
+			resolvedAppID := shared.ResolveAppID(ctx, *appID)
 
 			if *internal && *external {
 				fmt.Fprintln(os.Stderr, "Error: --internal and --external are mutually exclusive")
@@ -110,7 +110,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("beta-groups list: %w", err)
 			}
@@ -139,7 +139,7 @@ Examples:
 
 				if *paginate {
 					paginateOpts := append(opts, asc.WithBetaGroupsLimit(200))
-					groups, err := shared.PaginateWithSpinner(
+					if err := shared.PrintPaginatedOutputWithSpinner(
 						requestCtx,
 						func(ctx context.Context) (asc.PaginatedResponse, error) {
 							return client.ListBetaGroups(ctx, paginateOpts...)
@@ -147,12 +147,12 @@ Examples:
 						func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 							return client.ListBetaGroups(ctx, asc.WithBetaGroupsNextURL(nextURL))
 						},
-					)
-					if err != nil {
+						*output.Output,
+						*output.Pretty,
+					); err != nil {
 						return fmt.Errorf("beta-groups list: %w", err)
 					}
-
-					return shared.PrintOutput(groups, *output.Output, *output.Pretty)
+					return nil
 				}
 
 				groups, err := client.ListBetaGroups(requestCtx, opts...)
@@ -160,70 +160,63 @@ Examples:
 					return fmt.Errorf("beta-groups list: failed to fetch: %w", err)
 				}
 
-				return shared.PrintOutput(groups, *output.Output, *output.Pretty)
+				return shared.PrintOutput(ctx, groups, *output.Output, *output.Pretty)
 			}
 
 			// The app-scoped endpoint /v1/apps/{id}/betaGroups does not accept
 			// filter[isInternalGroup], so we apply the filter client-side.
 			if internalFilter != nil {
-				var groups *asc.BetaGroupsResponse
-
 				if *paginate {
+					remaining := *limit
+					filterPage := func(resp asc.PaginatedResponse, err error) (asc.PaginatedResponse, error) {
+						if err != nil {
+							return nil, err
+						}
+						return filterBetaGroupsPage(resp, *internalFilter, *limit > 0, &remaining)
+					}
 					paginateOpts := append(opts, asc.WithBetaGroupsLimit(200))
-					resp, err := shared.PaginateWithSpinner(
+					if err := shared.PrintPaginatedOutputWithSpinner(
 						requestCtx,
 						func(ctx context.Context) (asc.PaginatedResponse, error) {
-							return client.GetBetaGroups(ctx, resolvedAppID, paginateOpts...)
+							return filterPage(client.GetBetaGroups(ctx, resolvedAppID, paginateOpts...))
 						},
 						func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
-							return client.GetBetaGroups(ctx, resolvedAppID, asc.WithBetaGroupsNextURL(nextURL))
+							return filterPage(client.GetBetaGroups(ctx, resolvedAppID, asc.WithBetaGroupsNextURL(nextURL)))
 						},
-					)
-					if err != nil {
+						*output.Output,
+						*output.Pretty,
+					); err != nil {
 						return fmt.Errorf("beta-groups list: %w", err)
 					}
-					var ok bool
-					groups, ok = resp.(*asc.BetaGroupsResponse)
-					if !ok {
-						return fmt.Errorf("beta-groups list: unexpected response type %T", resp)
-					}
-				} else {
-					// To apply the filter correctly, fetch all pages even without --paginate.
-					paginateOpts := append(opts, asc.WithBetaGroupsLimit(200))
-					firstPage, err := client.GetBetaGroups(requestCtx, resolvedAppID, paginateOpts...)
-					if err != nil {
-						return fmt.Errorf("beta-groups list: failed to fetch: %w", err)
-					}
-					resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
-						return client.GetBetaGroups(ctx, resolvedAppID, asc.WithBetaGroupsNextURL(nextURL))
-					})
-					if err != nil {
-						return fmt.Errorf("beta-groups list: %w", err)
-					}
-					var ok bool
-					groups, ok = resp.(*asc.BetaGroupsResponse)
-					if !ok {
-						return fmt.Errorf("beta-groups list: unexpected response type %T", resp)
-					}
+					return nil
 				}
 
-				filtered := *groups
-				filtered.Data = make([]asc.Resource[asc.BetaGroupAttributes], 0, len(groups.Data))
-				for _, g := range groups.Data {
-					if g.Attributes.IsInternalGroup == *internalFilter {
-						filtered.Data = append(filtered.Data, g)
-					}
+				// To apply the filter correctly, fetch all pages even without --paginate.
+				remaining := *limit
+				paginateOpts := append(opts, asc.WithBetaGroupsLimit(200))
+				firstPage, err := client.GetBetaGroups(requestCtx, resolvedAppID, paginateOpts...)
+				if err != nil {
+					return fmt.Errorf("beta-groups list: failed to fetch: %w", err)
 				}
-				if *limit > 0 && len(filtered.Data) > *limit {
-					filtered.Data = filtered.Data[:*limit]
+				filteredFirst, err := filterBetaGroupsPage(firstPage, *internalFilter, *limit > 0, &remaining)
+				if err != nil {
+					return fmt.Errorf("beta-groups list: %w", err)
 				}
-
-				return shared.PrintOutput(&filtered, *output.Output, *output.Pretty)
+				if err := shared.PrintPaginatedOutput(requestCtx, filteredFirst, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+					page, err := client.GetBetaGroups(ctx, resolvedAppID, asc.WithBetaGroupsNextURL(nextURL))
+					if err != nil {
+						return nil, err
+					}
+					return filterBetaGroupsPage(page, *internalFilter, *limit > 0, &remaining)
+				}, *output.Output, *output.Pretty); err != nil {
+					return fmt.Errorf("beta-groups list: %w", err)
+				}
+				return nil
 			}
 
 			if *paginate {
 				paginateOpts := append(opts, asc.WithBetaGroupsLimit(200))
-				groups, err := shared.PaginateWithSpinner(
+				if err := shared.PrintPaginatedOutputWithSpinner(
 					requestCtx,
 					func(ctx context.Context) (asc.PaginatedResponse, error) {
 						return client.GetBetaGroups(ctx, resolvedAppID, paginateOpts...)
@@ -231,12 +224,12 @@ Examples:
 					func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 						return client.GetBetaGroups(ctx, resolvedAppID, asc.WithBetaGroupsNextURL(nextURL))
 					},
-				)
-				if err != nil {
+					*output.Output,
+					*output.Pretty,
+				); err != nil {
 					return fmt.Errorf("beta-groups list: %w", err)
 				}
-
-				return shared.PrintOutput(groups, *output.Output, *output.Pretty)
+				return nil
 			}
 
 			groups, err := client.GetBetaGroups(requestCtx, resolvedAppID, opts...)
@@ -244,11 +237,38 @@ Examples:
 				return fmt.Errorf("beta-groups list: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(groups, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, groups, *output.Output, *output.Pretty)
 		},
 	}
 }
 
+// filterBetaGroupsPage keeps the groups of one page matching internal. When
+// limited, remaining counts down across pages and pagination stops once it
+// reaches zero.
+func filterBetaGroupsPage(resp asc.PaginatedResponse, internal, limited bool, remaining *int) (asc.PaginatedResponse, error) {
+	groups, ok := resp.(*asc.BetaGroupsResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected response type %T", resp)
+	}
+	filtered := *groups
+	filtered.Data = make([]asc.Resource[asc.BetaGroupAttributes], 0, len(groups.Data))
+	for _, g := range groups.Data {
+		if g.Attributes.IsInternalGroup == internal {
+			filtered.Data = append(filtered.Data, g)
+		}
+	}
+	if limited {
+		if len(filtered.Data) > *remaining {
+			filtered.Data = filtered.Data[:*remaining]
+		}
+		*remaining -= len(filtered.Data)
+		if *remaining == 0 {
+			filtered.Links.Next = ""
+		}
+	}
+	return &filtered, nil
+}
+
 // BetaGroupsCreateCommand returns the beta groups create subcommand.
 func BetaGroupsCreateCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("create", flag.ExitOnError)
@@ -270,7 +290,7 @@ Examples:
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
-			resolvedAppID := shared.ResolveAppID(*appID)
+			resolvedAppID := shared.ResolveAppID(ctx, *appID)
 			if resolvedAppID == "" {
 				fmt.Fprintf(os.Stderr, "Error: --app is required (or set ASC_APP_ID)\n\n")
 				return flag.ErrHelp
@@ -280,7 +300,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("beta-groups create: %w", err)
 			}
@@ -300,7 +320,7 @@ Examples:
 				return fmt.Errorf("beta-groups create: failed to create: %w", err)
 			}
 
-			return shared.PrintOutput(group, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, group, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -328,7 +348,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("beta-groups get: %w", err)
 			}
@@ -341,7 +361,7 @@ Examples:
 				return fmt.Errorf("beta-groups get: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(group, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, group, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -402,7 +422,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("beta-groups update: %w", err)
 			}
@@ -443,7 +463,7 @@ Examples:
 				return fmt.Errorf("beta-groups update: failed to update: %w", err)
 			}
 
-			return shared.PrintOutput(group, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, group, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -475,7 +495,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("beta-groups delete: %w", err)
 			}
@@ -527,7 +547,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("beta-groups add-testers: %w", err)
 			}
@@ -629,7 +649,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("beta-groups remove-testers: %w", err)
 			}
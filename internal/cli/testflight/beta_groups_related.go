@@ -65,7 +65,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("testflight beta-groups app get: %w", err)
 			}
@@ -78,7 +78,7 @@ Examples:
 				return fmt.Errorf("testflight beta-groups app get: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -137,7 +137,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("testflight beta-groups beta-recruitment-criteria get: %w", err)
 			}
@@ -150,7 +150,7 @@ Examples:
 				return fmt.Errorf("testflight beta-groups beta-recruitment-criteria get: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -209,7 +209,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("testflight beta-groups beta-recruitment-criterion-compatible-build-check get: %w", err)
 			}
@@ -222,7 +222,7 @@ Examples:
 				return fmt.Errorf("testflight beta-groups beta-recruitment-criterion-compatible-build-check get: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -75,7 +75,7 @@ Examples:
 				return fmt.Errorf("beta-license-agreements list: %w", err)
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("beta-license-agreements list: %w", err)
 			}
@@ -98,13 +98,12 @@ Examples:
 				if err != nil {
 					return fmt.Errorf("beta-license-agreements list: failed to fetch: %w", err)
 				}
-				agreements, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				if err := shared.PrintPaginatedOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetBetaLicenseAgreements(ctx, asc.WithBetaLicenseAgreementsNextURL(nextURL))
-				})
-				if err != nil {
+				}, *output.Output, *output.Pretty); err != nil {
 					return fmt.Errorf("beta-license-agreements list: %w", err)
 				}
-				return shared.PrintOutput(agreements, *output.Output, *output.Pretty)
+				return nil
 			}
 
 			agreements, err := client.GetBetaLicenseAgreements(requestCtx, opts...)
@@ -112,7 +111,7 @@ Examples:
 				return fmt.Errorf("beta-license-agreements list: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(agreements, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, agreements, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -143,7 +142,7 @@ Examples:
 			idValue := strings.TrimSpace(*id)
 			appValue := ""
 			if idValue == "" {
-				appValue = shared.ResolveAppID(*appID)
+				appValue = shared.ResolveAppID(ctx, *appID)
 			}
 			if idValue == "" && appValue == "" {
 				fmt.Fprintln(os.Stderr, "Error: --id or --app is required (or set ASC_APP_ID)")
@@ -158,7 +157,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("beta-license-agreements get: %w", err)
 			}
@@ -171,7 +170,7 @@ Examples:
 				if err != nil {
 					return fmt.Errorf("beta-license-agreements get: failed to fetch: %w", err)
 				}
-				return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+				return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 			}
 
 			opts := []asc.BetaLicenseAgreementOption{
@@ -184,7 +183,7 @@ Examples:
 				return fmt.Errorf("beta-license-agreements get: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -219,7 +218,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("beta-license-agreements update: %w", err)
 			}
@@ -232,7 +231,7 @@ Examples:
 				return fmt.Errorf("beta-license-agreements update: failed to update: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -106,13 +106,13 @@ Examples:
 				return shared.UsageError("--group cannot be combined with --build-id")
 			}
 
-			resolvedAppID := shared.ResolveAppID(*appID)
+			resolvedAppID := shared.ResolveAppID(ctx, *appID)
 			if resolvedAppID == "" && strings.TrimSpace(*next) == "" {
 				fmt.Fprintf(os.Stderr, "Error: --app is required (or set ASC_APP_ID)\n\n")
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("beta-testers list: %w", err)
 			}
@@ -143,7 +143,7 @@ Examples:
 
 			if *paginate {
 				paginateOpts := append(opts, asc.WithBetaTestersLimit(200))
-				testers, err := shared.PaginateWithSpinner(
+				if err := shared.PrintPaginatedOutputWithSpinner(
 					requestCtx,
 					func(ctx context.Context) (asc.PaginatedResponse, error) {
 						return client.GetBetaTesters(ctx, resolvedAppID, paginateOpts...)
@@ -151,12 +151,12 @@ Examples:
 					func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 						return client.GetBetaTesters(ctx, resolvedAppID, asc.WithBetaTestersNextURL(nextURL))
 					},
-				)
-				if err != nil {
+					*output.Output,
+					*output.Pretty,
+				); err != nil {
 					return fmt.Errorf("beta-testers list: %w", err)
 				}
-
-				return shared.PrintOutput(testers, *output.Output, *output.Pretty)
+				return nil
 			}
 
 			testers, err := client.GetBetaTesters(requestCtx, resolvedAppID, opts...)
@@ -164,7 +164,7 @@ Examples:
 				return fmt.Errorf("beta-testers list: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(testers, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, testers, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -193,7 +193,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("beta-testers get: %w", err)
 			}
@@ -206,7 +206,7 @@ Examples:
 				return fmt.Errorf("beta-testers get: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(tester, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, tester, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -233,7 +233,7 @@ Examples:
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
-			resolvedAppID := shared.ResolveAppID(*appID)
+			resolvedAppID := shared.ResolveAppID(ctx, *appID)
 			if resolvedAppID == "" {
 				fmt.Fprintf(os.Stderr, "Error: --app is required (or set ASC_APP_ID)\n\n")
 				return flag.ErrHelp
@@ -247,7 +247,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("beta-testers add: %w", err)
 			}
@@ -265,7 +265,7 @@ Examples:
 				return fmt.Errorf("beta-testers add: failed to create: %w", err)
 			}
 
-			return shared.PrintOutput(tester, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, tester, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -289,7 +289,7 @@ Examples:
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
-			resolvedAppID := shared.ResolveAppID(*appID)
+			resolvedAppID := shared.ResolveAppID(ctx, *appID)
 			if resolvedAppID == "" {
 				fmt.Fprintf(os.Stderr, "Error: --app is required (or set ASC_APP_ID)\n\n")
 				return flag.ErrHelp
@@ -299,7 +299,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("beta-testers remove: %w", err)
 			}
@@ -325,7 +325,7 @@ Examples:
 				Deleted: true,
 			}
 
-			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -362,7 +362,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("beta-testers add-groups: %w", err)
 			}
@@ -380,7 +380,7 @@ Examples:
 				Action:   "added",
 			}
 
-			if err := shared.PrintOutput(result, *output.Output, *output.Pretty); err != nil {
+			if err := shared.PrintOutput(ctx, result, *output.Output, *output.Pretty); err != nil {
 				return err
 			}
 
@@ -427,7 +427,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("beta-testers remove-groups: %w", err)
 			}
@@ -445,7 +445,7 @@ Examples:
 				Action:   "removed",
 			}
 
-			if err := shared.PrintOutput(result, *output.Output, *output.Pretty); err != nil {
+			if err := shared.PrintOutput(ctx, result, *output.Output, *output.Pretty); err != nil {
 				return err
 			}
 
@@ -490,7 +490,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("beta-testers add-builds: %w", err)
 			}
@@ -508,7 +508,7 @@ Examples:
 				Action:   "added",
 			}
 
-			if err := shared.PrintOutput(result, *output.Output, *output.Pretty); err != nil {
+			if err := shared.PrintOutput(ctx, result, *output.Output, *output.Pretty); err != nil {
 				return err
 			}
 
@@ -558,7 +558,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("beta-testers remove-builds: %w", err)
 			}
@@ -576,7 +576,7 @@ Examples:
 				Action:   "removed",
 			}
 
-			if err := shared.PrintOutput(result, *output.Output, *output.Pretty); err != nil {
+			if err := shared.PrintOutput(ctx, result, *output.Output, *output.Pretty); err != nil {
 				return err
 			}
 
@@ -623,7 +623,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("beta-testers remove-apps: %w", err)
 			}
@@ -641,7 +641,7 @@ Examples:
 				Action:   "removed",
 			}
 
-			if err := shared.PrintOutput(result, *output.Output, *output.Pretty); err != nil {
+			if err := shared.PrintOutput(ctx, result, *output.Output, *output.Pretty); err != nil {
 				return err
 			}
 
@@ -672,7 +672,7 @@ Examples:
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
-			resolvedAppID := shared.ResolveAppID(*appID)
+			resolvedAppID := shared.ResolveAppID(ctx, *appID)
 			if resolvedAppID == "" {
 				fmt.Fprintf(os.Stderr, "Error: --app is required (or set ASC_APP_ID)\n\n")
 				return flag.ErrHelp
@@ -682,7 +682,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("beta-testers invite: %w", err)
 			}
@@ -726,7 +726,7 @@ Examples:
 				Email:        emailValue,
 			}
 
-			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 		},
 	}
 }
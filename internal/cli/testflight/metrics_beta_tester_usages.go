@@ -142,14 +142,14 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			resolvedAppID := shared.ResolveAppID(*appID)
+			resolvedAppID := shared.ResolveAppID(ctx, *appID)
 			nextValue := strings.TrimSpace(*next)
 			if nextValue == "" && resolvedAppID == "" {
 				fmt.Fprintf(os.Stderr, "Error: --app is required (or set ASC_APP_ID)\n\n")
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("testflight metrics beta-tester-usages: %w", err)
 			}
@@ -177,7 +177,7 @@ Examples:
 					return fmt.Errorf("testflight metrics beta-tester-usages: %w", err)
 				}
 
-				return shared.PrintOutput(combined, *output.Output, *output.Pretty)
+				return shared.PrintOutput(ctx, combined, *output.Output, *output.Pretty)
 			}
 
 			resp, err := client.GetAppBetaTesterUsagesMetrics(requestCtx, resolvedAppID, opts...)
@@ -185,7 +185,7 @@ Examples:
 				return fmt.Errorf("testflight metrics beta-tester-usages: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -73,13 +73,13 @@ Examples:
 				return fmt.Errorf("testflight review get: %w", err)
 			}
 
-			resolvedAppID := shared.ResolveAppID(*appID)
+			resolvedAppID := shared.ResolveAppID(ctx, *appID)
 			if resolvedAppID == "" && strings.TrimSpace(*next) == "" {
 				fmt.Fprintf(os.Stderr, "Error: --app is required (or set ASC_APP_ID)\n\n")
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("testflight review get: %w", err)
 			}
@@ -97,7 +97,7 @@ Examples:
 				return fmt.Errorf("testflight review get: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(details, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, details, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -187,7 +187,7 @@ Examples:
 				attrs.Notes = &value
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("testflight review update: %w", err)
 			}
@@ -200,7 +200,7 @@ Examples:
 				return fmt.Errorf("testflight review update: failed to update: %w", err)
 			}
 
-			return shared.PrintOutput(detail, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, detail, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -236,7 +236,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("testflight review submit: %w", err)
 			}
@@ -249,7 +249,7 @@ Examples:
 				return fmt.Errorf("testflight review submit: failed to submit: %w", err)
 			}
 
-			return shared.PrintOutput(submission, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, submission, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -301,7 +301,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("testflight review app get: %w", err)
 			}
@@ -314,7 +314,7 @@ Examples:
 				return fmt.Errorf("testflight review app get: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -383,7 +383,7 @@ Examples:
 				return fmt.Errorf("testflight review submissions list: %w", err)
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("testflight review submissions list: %w", err)
 			}
@@ -400,7 +400,7 @@ Examples:
 
 			if *paginate {
 				paginateOpts := append(opts, asc.WithBetaAppReviewSubmissionsLimit(200))
-				resp, err := shared.PaginateWithSpinner(
+				if err := shared.PrintPaginatedOutputWithSpinner(
 					requestCtx,
 					func(ctx context.Context) (asc.PaginatedResponse, error) {
 						return client.GetBetaAppReviewSubmissions(ctx, paginateOpts...)
@@ -408,12 +408,12 @@ Examples:
 					func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 						return client.GetBetaAppReviewSubmissions(ctx, asc.WithBetaAppReviewSubmissionsNextURL(nextURL))
 					},
-				)
-				if err != nil {
+					*output.Output,
+					*output.Pretty,
+				); err != nil {
 					return fmt.Errorf("testflight review submissions list: %w", err)
 				}
-
-				return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+				return nil
 			}
 
 			resp, err := client.GetBetaAppReviewSubmissions(requestCtx, opts...)
@@ -421,7 +421,7 @@ Examples:
 				return fmt.Errorf("testflight review submissions list: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -450,7 +450,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("testflight review submissions get: %w", err)
 			}
@@ -463,7 +463,7 @@ Examples:
 				return fmt.Errorf("testflight review submissions get: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -492,7 +492,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("testflight review submissions build: %w", err)
 			}
@@ -505,7 +505,7 @@ Examples:
 				return fmt.Errorf("testflight review submissions build: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -572,7 +572,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("testflight beta-details get: %w", err)
 			}
@@ -591,7 +591,7 @@ Examples:
 				return fmt.Errorf("testflight beta-details get: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(details, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, details, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -643,7 +643,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("testflight beta-details build get: %w", err)
 			}
@@ -656,7 +656,7 @@ Examples:
 				return fmt.Errorf("testflight beta-details build get: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -717,7 +717,7 @@ Examples:
 				attrs.ExternalBuildState = &state
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("testflight beta-details update: %w", err)
 			}
@@ -730,7 +730,7 @@ Examples:
 				return fmt.Errorf("testflight beta-details update: failed to update: %w", err)
 			}
 
-			return shared.PrintOutput(detail, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, detail, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -790,7 +790,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("testflight recruitment delete: %w", err)
 			}
@@ -807,7 +807,7 @@ Examples:
 				Deleted: true,
 			}
 
-			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -844,7 +844,7 @@ Examples:
 				return fmt.Errorf("testflight recruitment options: %w", err)
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("testflight recruitment options: %w", err)
 			}
@@ -863,7 +863,7 @@ Examples:
 				return fmt.Errorf("testflight recruitment options: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(options, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, options, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -902,7 +902,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("testflight recruitment set: %w", err)
 			}
@@ -920,7 +920,7 @@ Examples:
 				if err != nil {
 					return fmt.Errorf("testflight recruitment set: failed to update: %w", err)
 				}
-				return shared.PrintOutput(criteria, *output.Output, *output.Pretty)
+				return shared.PrintOutput(ctx, criteria, *output.Output, *output.Pretty)
 			} else if !asc.IsNotFound(err) {
 				return fmt.Errorf("testflight recruitment set: failed to fetch existing criteria: %w", err)
 			}
@@ -930,7 +930,7 @@ Examples:
 				return fmt.Errorf("testflight recruitment set: failed to set: %w", createErr)
 			}
 
-			return shared.PrintOutput(criteria, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, criteria, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -1066,7 +1066,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("testflight metrics public-link: %w", err)
 			}
@@ -1079,7 +1079,7 @@ Examples:
 				return fmt.Errorf("testflight metrics public-link: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(metrics, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, metrics, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -1108,7 +1108,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("testflight metrics testers: %w", err)
 			}
@@ -1121,7 +1121,7 @@ Examples:
 				return fmt.Errorf("testflight metrics testers: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(metrics, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, metrics, *output.Output, *output.Pretty)
 		},
 	}
 }
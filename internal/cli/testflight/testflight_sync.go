@@ -144,7 +144,7 @@ Examples:
 			if err := applyLegacyBuildIDAlias(buildFilter, legacyBuildFilter); err != nil {
 				return err
 			}
-			resolvedAppID := shared.ResolveAppID(*appID)
+			resolvedAppID := shared.ResolveAppID(ctx, *appID)
 			if resolvedAppID == "" {
 				fmt.Fprintf(os.Stderr, "Error: --app is required (or set ASC_APP_ID)\n\n")
 				return flag.ErrHelp
@@ -172,7 +172,7 @@ Examples:
 				return fmt.Errorf("testflight sync pull: %w", err)
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("testflight sync pull: %w", err)
 			}
@@ -210,7 +210,7 @@ Examples:
 				Testers: len(config.Testers),
 			}
 
-			return shared.PrintOutput(summary, "json", *pretty)
+			return shared.PrintOutput(ctx, summary, "json", *pretty)
 		},
 	}
 }
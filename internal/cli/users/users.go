@@ -85,7 +85,7 @@ Examples:
 				return fmt.Errorf("users list: %w", err)
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("users list: %w", err)
 			}
@@ -107,14 +107,12 @@ Examples:
 					return fmt.Errorf("users list: failed to fetch: %w", err)
 				}
 
-				users, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				if err := shared.PrintPaginatedOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetUsers(ctx, asc.WithUsersNextURL(nextURL))
-				})
-				if err != nil {
+				}, *output.Output, *output.Pretty); err != nil {
 					return fmt.Errorf("users list: %w", err)
 				}
-
-				return shared.PrintOutput(users, *output.Output, *output.Pretty)
+				return nil
 			}
 
 			users, err := client.GetUsers(requestCtx, opts...)
@@ -122,7 +120,7 @@ Examples:
 				return fmt.Errorf("users list: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(users, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, users, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -158,7 +156,7 @@ Examples:
 				return fmt.Errorf("users get: %w", err)
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("users get: %w", err)
 			}
@@ -176,7 +174,7 @@ Examples:
 				return fmt.Errorf("users get: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(user, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, user, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -216,7 +214,7 @@ Examples:
 
 			visibleAppIDs := shared.SplitCSV(*visibleApps)
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("users update: %w", err)
 			}
@@ -243,7 +241,7 @@ Examples:
 				}
 			}
 
-			return shared.PrintOutput(user, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, user, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -278,7 +276,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("users delete: %w", err)
 			}
@@ -295,7 +293,7 @@ Examples:
 				Deleted: true,
 			}
 
-			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -360,7 +358,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("users invite: %w", err)
 			}
@@ -387,7 +385,7 @@ Examples:
 				return fmt.Errorf("users invite: failed to create: %w", err)
 			}
 
-			return shared.PrintOutput(invitation, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, invitation, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -450,7 +448,7 @@ Examples:
 				return fmt.Errorf("users invites list: %w", err)
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("users invites list: %w", err)
 			}
@@ -470,14 +468,12 @@ Examples:
 					return fmt.Errorf("users invites list: failed to fetch: %w", err)
 				}
 
-				invites, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				if err := shared.PrintPaginatedOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetUserInvitations(ctx, asc.WithUserInvitationsNextURL(nextURL))
-				})
-				if err != nil {
+				}, *output.Output, *output.Pretty); err != nil {
 					return fmt.Errorf("users invites list: %w", err)
 				}
-
-				return shared.PrintOutput(invites, *output.Output, *output.Pretty)
+				return nil
 			}
 
 			invites, err := client.GetUserInvitations(requestCtx, opts...)
@@ -485,7 +481,7 @@ Examples:
 				return fmt.Errorf("users invites list: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(invites, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, invites, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -514,7 +510,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("users invites get: %w", err)
 			}
@@ -527,7 +523,7 @@ Examples:
 				return fmt.Errorf("users invites get: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(invite, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, invite, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -562,7 +558,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("users invites revoke: %w", err)
 			}
@@ -579,7 +575,7 @@ Examples:
 				Revoked: true,
 			}
 
-			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 		},
 	}
 }
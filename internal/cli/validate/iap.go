@@ -44,7 +44,7 @@ Examples:
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
-			resolvedAppID := shared.ResolveAppID(*appID)
+			resolvedAppID := shared.ResolveAppID(ctx, *appID)
 			if resolvedAppID == "" {
 				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
 				return flag.ErrHelp
@@ -61,7 +61,7 @@ Examples:
 }
 
 func runValidateIAP(ctx context.Context, opts validateIAPOptions) error {
-	client, err := clientFactory()
+	client, err := clientFactory(ctx)
 	if err != nil {
 		return fmt.Errorf("validate iap: %w", err)
 	}
@@ -105,7 +105,7 @@ func runValidateIAP(ctx context.Context, opts validateIAPOptions) error {
 		IAPs:  iaps,
 	}, opts.Strict)
 
-	if err := shared.PrintOutput(&report, opts.Output, opts.Pretty); err != nil {
+	if err := shared.PrintOutput(ctx, &report, opts.Output, opts.Pretty); err != nil {
 		return err
 	}
 
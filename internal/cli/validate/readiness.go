@@ -25,7 +25,7 @@ type ReadinessOptions struct {
 // BuildReadinessReport fetches live App Store Connect data and returns a
 // structured readiness report without printing output.
 func BuildReadinessReport(ctx context.Context, opts ReadinessOptions) (validation.Report, error) {
-	client, err := clientFactory()
+	client, err := clientFactory(ctx)
 	if err != nil {
 		return validation.Report{}, err
 	}
@@ -47,7 +47,7 @@ Examples:
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
-			resolvedAppID := shared.ResolveAppID(*appID)
+			resolvedAppID := shared.ResolveAppID(ctx, *appID)
 			if resolvedAppID == "" {
 				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
 				return flag.ErrHelp
@@ -64,7 +64,7 @@ Examples:
 }
 
 func runValidateSubscriptions(ctx context.Context, opts validateSubscriptionsOptions) error {
-	client, err := clientFactory()
+	client, err := clientFactory(ctx)
 	if err != nil {
 		return fmt.Errorf("validate subscriptions: %w", err)
 	}
@@ -128,7 +128,7 @@ func runValidateSubscriptions(ctx context.Context, opts validateSubscriptionsOpt
 		BuildCheckSkipReason:      buildCheckSkipReason,
 	}, opts.Strict)
 
-	if err := shared.PrintOutput(&report, opts.Output, opts.Pretty); err != nil {
+	if err := shared.PrintOutput(ctx, &report, opts.Output, opts.Pretty); err != nil {
 		return err
 	}
 
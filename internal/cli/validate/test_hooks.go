@@ -15,7 +15,7 @@ func SetClientFactory(fn func() (*asc.Client, error)) func() {
 	if fn == nil {
 		clientFactory = shared.GetASCClient
 	} else {
-		clientFactory = fn
+		clientFactory = func(context.Context) (*asc.Client, error) { return fn() }
 	}
 	return func() {
 		clientFactory = previous
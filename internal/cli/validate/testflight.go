@@ -55,7 +55,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			resolvedAppID := shared.ResolveAppID(*appID)
+			resolvedAppID := shared.ResolveAppID(ctx, *appID)
 			if resolvedAppID == "" {
 				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
 				return flag.ErrHelp
@@ -73,7 +73,7 @@ Examples:
 }
 
 func runValidateTestFlight(ctx context.Context, opts validateTestFlightOptions) error {
-	client, err := clientFactory()
+	client, err := clientFactory(ctx)
 	if err != nil {
 		return fmt.Errorf("validate testflight: %w", err)
 	}
@@ -159,7 +159,7 @@ func runValidateTestFlight(ctx context.Context, opts validateTestFlightOptions)
 		BetaBuildLocalizations: betaBuildLocalizations,
 	}, opts.Strict)
 
-	if err := shared.PrintOutput(&report, opts.Output, opts.Pretty); err != nil {
+	if err := shared.PrintOutput(ctx, &report, opts.Output, opts.Pretty); err != nil {
 		return err
 	}
 
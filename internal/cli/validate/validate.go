@@ -104,7 +104,7 @@ Subscriptions:
 				return shared.UsageError("--version and --version-id are mutually exclusive")
 			}
 
-			resolvedAppID := shared.ResolveAppID(*appID)
+			resolvedAppID := shared.ResolveAppID(ctx, *appID)
 			if resolvedAppID == "" {
 				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
 				return flag.ErrHelp
@@ -210,7 +210,7 @@ func runValidate(ctx context.Context, opts validateOptions) error {
 		return fmt.Errorf("validate: %w", err)
 	}
 
-	if err := shared.PrintOutput(&report, opts.Output, opts.Pretty); err != nil {
+	if err := shared.PrintOutput(ctx, &report, opts.Output, opts.Pretty); err != nil {
 		return err
 	}
 
@@ -131,7 +131,7 @@ func WebAnalyticsOverviewCommand() *ffcli.Command {
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
-			resolvedAppID, startDate, endDate, err := resolveAnalyticsQueryFlags(*appID, *start, *end)
+			resolvedAppID, startDate, endDate, err := resolveAnalyticsQueryFlags(ctx, *appID, *start, *end)
 			if err != nil {
 				return err
 			}
@@ -151,7 +151,7 @@ func WebAnalyticsOverviewCommand() *ffcli.Command {
 				return withWebAuthHint(err, "analytics overview")
 			}
 
-			return shared.PrintOutputWithRenderers(
+			return shared.PrintOutputWithRenderers(ctx,
 				result,
 				*output.Output,
 				*output.Pretty,
@@ -185,7 +185,7 @@ func WebAnalyticsSubscriptionsCommand() *ffcli.Command {
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
-			resolvedAppID, startDate, endDate, err := resolveAnalyticsQueryFlags(*appID, *start, *end)
+			resolvedAppID, startDate, endDate, err := resolveAnalyticsQueryFlags(ctx, *appID, *start, *end)
 			if err != nil {
 				return err
 			}
@@ -205,7 +205,7 @@ func WebAnalyticsSubscriptionsCommand() *ffcli.Command {
 				return withWebAuthHint(err, "analytics subscriptions")
 			}
 
-			return shared.PrintOutputWithRenderers(
+			return shared.PrintOutputWithRenderers(ctx,
 				result,
 				*output.Output,
 				*output.Pretty,
@@ -239,7 +239,7 @@ func WebAnalyticsMetricsCommand() *ffcli.Command {
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
-			resolvedAppID, startDate, endDate, err := resolveAnalyticsQueryFlags(*appID, *start, *end)
+			resolvedAppID, startDate, endDate, err := resolveAnalyticsQueryFlags(ctx, *appID, *start, *end)
 			if err != nil {
 				return err
 			}
@@ -280,7 +280,7 @@ func WebAnalyticsMetricsCommand() *ffcli.Command {
 				Frequency: resolvedFrequency,
 				Result:    result,
 			}
-			return shared.PrintOutputWithRenderers(
+			return shared.PrintOutputWithRenderers(ctx,
 				payload,
 				*output.Output,
 				*output.Pretty,
@@ -313,7 +313,7 @@ func WebAnalyticsRetentionCommand() *ffcli.Command {
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
-			resolvedAppID, startDate, endDate, err := resolveAnalyticsQueryFlags(*appID, *start, *end)
+			resolvedAppID, startDate, endDate, err := resolveAnalyticsQueryFlags(ctx, *appID, *start, *end)
 			if err != nil {
 				return err
 			}
@@ -349,7 +349,7 @@ func WebAnalyticsRetentionCommand() *ffcli.Command {
 				Frequency: resolvedFrequency,
 				Result:    result,
 			}
-			return shared.PrintOutputWithRenderers(
+			return shared.PrintOutputWithRenderers(ctx,
 				payload,
 				*output.Output,
 				*output.Pretty,
@@ -385,7 +385,7 @@ func WebAnalyticsCohortsCommand() *ffcli.Command {
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
-			resolvedAppID, startDate, endDate, err := resolveAnalyticsQueryFlags(*appID, *start, *end)
+			resolvedAppID, startDate, endDate, err := resolveAnalyticsQueryFlags(ctx, *appID, *start, *end)
 			if err != nil {
 				return err
 			}
@@ -433,7 +433,7 @@ func WebAnalyticsCohortsCommand() *ffcli.Command {
 				Periods:   periodList,
 				Result:    result,
 			}
-			return shared.PrintOutputWithRenderers(
+			return shared.PrintOutputWithRenderers(ctx,
 				payload,
 				*output.Output,
 				*output.Pretty,
@@ -444,8 +444,8 @@ func WebAnalyticsCohortsCommand() *ffcli.Command {
 	}
 }
 
-func resolveAnalyticsQueryFlags(appID, startDate, endDate string) (string, string, string, error) {
-	resolvedAppID := strings.TrimSpace(shared.ResolveAppID(appID))
+func resolveAnalyticsQueryFlags(ctx context.Context, appID, startDate, endDate string) (string, string, string, error) {
+	resolvedAppID := strings.TrimSpace(shared.ResolveAppID(ctx, appID))
 	if resolvedAppID == "" {
 		return "", "", "", shared.UsageError("--app is required")
 	}
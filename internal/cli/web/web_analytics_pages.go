@@ -72,7 +72,7 @@ func WebAnalyticsSourcesCommand() *ffcli.Command {
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
-			resolvedAppID, startDate, endDate, err := resolveAnalyticsQueryFlags(*appID, *start, *end)
+			resolvedAppID, startDate, endDate, err := resolveAnalyticsQueryFlags(ctx, *appID, *start, *end)
 			if err != nil {
 				return err
 			}
@@ -91,7 +91,7 @@ func WebAnalyticsSourcesCommand() *ffcli.Command {
 				return withWebAuthHint(err, "analytics sources")
 			}
 
-			return shared.PrintOutputWithRenderers(
+			return shared.PrintOutputWithRenderers(ctx,
 				result,
 				*output.Output,
 				*output.Pretty,
@@ -155,7 +155,7 @@ func WebAnalyticsInAppEventsCommand() *ffcli.Command {
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
-			resolvedAppID, startDate, endDate, err := resolveAnalyticsQueryFlags(*appID, *start, *end)
+			resolvedAppID, startDate, endDate, err := resolveAnalyticsQueryFlags(ctx, *appID, *start, *end)
 			if err != nil {
 				return err
 			}
@@ -174,7 +174,7 @@ func WebAnalyticsInAppEventsCommand() *ffcli.Command {
 				return withWebAuthHint(err, "analytics in-app-events")
 			}
 
-			return shared.PrintOutputWithRenderers(
+			return shared.PrintOutputWithRenderers(ctx,
 				result,
 				*output.Output,
 				*output.Pretty,
@@ -234,7 +234,7 @@ func WebAnalyticsCampaignsCommand() *ffcli.Command {
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
-			resolvedAppID, startDate, endDate, err := resolveAnalyticsQueryFlags(*appID, *start, *end)
+			resolvedAppID, startDate, endDate, err := resolveAnalyticsQueryFlags(ctx, *appID, *start, *end)
 			if err != nil {
 				return err
 			}
@@ -253,7 +253,7 @@ func WebAnalyticsCampaignsCommand() *ffcli.Command {
 				return withWebAuthHint(err, "analytics campaigns")
 			}
 
-			return shared.PrintOutputWithRenderers(
+			return shared.PrintOutputWithRenderers(ctx,
 				result,
 				*output.Output,
 				*output.Pretty,
@@ -285,7 +285,7 @@ func WebAnalyticsSalesCommand() *ffcli.Command {
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
-			resolvedAppID, startDate, endDate, err := resolveAnalyticsQueryFlags(*appID, *start, *end)
+			resolvedAppID, startDate, endDate, err := resolveAnalyticsQueryFlags(ctx, *appID, *start, *end)
 			if err != nil {
 				return err
 			}
@@ -304,7 +304,7 @@ func WebAnalyticsSalesCommand() *ffcli.Command {
 				return withWebAuthHint(err, "analytics sales")
 			}
 
-			return shared.PrintOutputWithRenderers(
+			return shared.PrintOutputWithRenderers(ctx,
 				result,
 				*output.Output,
 				*output.Pretty,
@@ -362,7 +362,7 @@ func WebAnalyticsBenchmarksCommand() *ffcli.Command {
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
-			resolvedAppID, err := resolveAnalyticsAppIDFlag(*appID)
+			resolvedAppID, err := resolveAnalyticsAppIDFlag(ctx, *appID)
 			if err != nil {
 				return err
 			}
@@ -381,7 +381,7 @@ func WebAnalyticsBenchmarksCommand() *ffcli.Command {
 				return withWebAuthHint(err, "analytics benchmarks")
 			}
 
-			return shared.PrintOutputWithRenderers(
+			return shared.PrintOutputWithRenderers(ctx,
 				result,
 				*output.Output,
 				*output.Pretty,
@@ -416,7 +416,7 @@ relevant analytics features or dimensions we observed while mapping the sidebar.
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
-			resolvedAppID, err := resolveAnalyticsAppIDFlag(*appID)
+			resolvedAppID, err := resolveAnalyticsAppIDFlag(ctx, *appID)
 			if err != nil {
 				return err
 			}
@@ -443,7 +443,7 @@ relevant analytics features or dimensions we observed while mapping the sidebar.
 				return withWebAuthHint(err, "analytics "+name)
 			}
 
-			return shared.PrintOutputWithRenderers(
+			return shared.PrintOutputWithRenderers(ctx,
 				payload,
 				*output.Output,
 				*output.Pretty,
@@ -454,8 +454,8 @@ relevant analytics features or dimensions we observed while mapping the sidebar.
 	}
 }
 
-func resolveAnalyticsAppIDFlag(appID string) (string, error) {
-	resolvedAppID := strings.TrimSpace(shared.ResolveAppID(appID))
+func resolveAnalyticsAppIDFlag(ctx context.Context, appID string) (string, error) {
+	resolvedAppID := strings.TrimSpace(shared.ResolveAppID(ctx, appID))
 	if resolvedAppID == "" {
 		return "", shared.UsageError("--app is required")
 	}
@@ -86,7 +86,7 @@ Examples:
 				return shared.UsageError("web apps availability create does not accept positional arguments")
 			}
 
-			resolvedAppID := strings.TrimSpace(shared.ResolveAppID(*appID))
+			resolvedAppID := strings.TrimSpace(shared.ResolveAppID(ctx, *appID))
 			if resolvedAppID == "" {
 				return shared.UsageError("--app is required (or set ASC_APP_ID)")
 			}
@@ -156,7 +156,7 @@ Examples:
 				AvailableInNewTerritories: created.AvailableInNewTerritories,
 				AvailableTerritories:      created.AvailableTerritories,
 			}
-			return shared.PrintOutputWithRenderers(
+			return shared.PrintOutputWithRenderers(ctx,
 				result,
 				*output.Output,
 				*output.Pretty,
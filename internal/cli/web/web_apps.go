@@ -92,7 +92,7 @@ func bundleIDPlatformForWebApp(platform string) (asc.Platform, error) {
 }
 
 func ensureBundleIDExists(ctx context.Context, bundleID, appName, platform string) (bool, error) {
-	client, err := shared.GetASCClient()
+	client, err := shared.GetASCClient(ctx)
 	if err != nil {
 		return false, errors.Join(errBundleIDPreflightAuthUnavailable, err)
 	}
@@ -134,7 +134,7 @@ func deleteBundleIDByIdentifier(ctx context.Context, bundleID string) error {
 		return nil
 	}
 
-	client, err := shared.GetASCClient()
+	client, err := shared.GetASCClient(ctx)
 	if err != nil {
 		return err
 	}
@@ -397,5 +397,5 @@ func RunAppsCreate(ctx context.Context, opts AppsCreateRunOptions) error {
 	}
 
 	fmt.Fprintf(os.Stderr, "Created app successfully (id=%s)\n", strings.TrimSpace(app.Data.ID))
-	return shared.PrintOutput(app, opts.Output, opts.Pretty)
+	return shared.PrintOutput(ctx, app, opts.Output, opts.Pretty)
 }
@@ -79,7 +79,7 @@ Examples:
 				return shared.UsageError("web apps medical-device set does not accept positional arguments")
 			}
 
-			resolvedAppID := strings.TrimSpace(shared.ResolveAppID(*appID))
+			resolvedAppID := strings.TrimSpace(shared.ResolveAppID(ctx, *appID))
 			if resolvedAppID == "" {
 				return shared.UsageError("--app is required (or set ASC_APP_ID)")
 			}
@@ -117,7 +117,7 @@ Examples:
 				return fmt.Errorf("web apps medical-device set failed: missing declaration result")
 			}
 
-			return shared.PrintOutputWithRenderers(
+			return shared.PrintOutputWithRenderers(ctx,
 				result,
 				*output.Output,
 				*output.Pretty,
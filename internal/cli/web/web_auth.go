@@ -724,7 +724,7 @@ Examples:
 				TeamID:        session.TeamID,
 				ProviderID:    session.ProviderID,
 			}
-			return shared.PrintOutput(status, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, status, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -765,15 +765,15 @@ If --apple-id is not provided, this checks the last cached session.
 			}
 			if err != nil {
 				if errors.Is(err, webcore.ErrCachedSessionExpired) {
-					return shared.PrintOutput(webAuthStatus{Authenticated: false}, *output.Output, *output.Pretty)
+					return shared.PrintOutput(ctx, webAuthStatus{Authenticated: false}, *output.Output, *output.Pretty)
 				}
 				return fmt.Errorf("web auth status failed: %w", err)
 			}
 
 			if !ok || session == nil {
-				return shared.PrintOutput(webAuthStatus{Authenticated: false}, *output.Output, *output.Pretty)
+				return shared.PrintOutput(ctx, webAuthStatus{Authenticated: false}, *output.Output, *output.Pretty)
 			}
-			return shared.PrintOutput(webAuthStatus{
+			return shared.PrintOutput(ctx, webAuthStatus{
 				Authenticated: true,
 				Source:        "cache",
 				AppleID:       session.UserEmail,
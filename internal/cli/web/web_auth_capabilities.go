@@ -214,7 +214,7 @@ Examples:
 				ReferenceLastVerified: ref.LastVerified,
 			}
 
-			return shared.PrintOutputWithRenderers(
+			return shared.PrintOutputWithRenderers(ctx,
 				result,
 				*output.Output,
 				*output.Pretty,
@@ -1208,7 +1208,7 @@ Examples:
 			if err != nil {
 				return withWebAuthHint(err, "web privacy catalog")
 			}
-			return shared.PrintOutputWithRenderers(
+			return shared.PrintOutputWithRenderers(ctx,
 				payload,
 				*output.Output,
 				*output.Pretty,
@@ -1246,7 +1246,7 @@ Examples:
 			if len(args) > 0 {
 				return shared.UsageError("web privacy pull does not accept positional arguments")
 			}
-			resolvedAppID := strings.TrimSpace(shared.ResolveAppID(*appID))
+			resolvedAppID := strings.TrimSpace(shared.ResolveAppID(ctx, *appID))
 			if resolvedAppID == "" {
 				return shared.UsageError("--app is required (or set ASC_APP_ID)")
 			}
@@ -1294,7 +1294,7 @@ Examples:
 				},
 				Out: outPath,
 			}
-			return shared.PrintOutputWithRenderers(
+			return shared.PrintOutputWithRenderers(ctx,
 				payload,
 				*output.Output,
 				*output.Pretty,
@@ -1331,7 +1331,7 @@ Examples:
 			if len(args) > 0 {
 				return shared.UsageError("web privacy plan does not accept positional arguments")
 			}
-			resolvedAppID := strings.TrimSpace(shared.ResolveAppID(*appID))
+			resolvedAppID := strings.TrimSpace(shared.ResolveAppID(ctx, *appID))
 			if resolvedAppID == "" {
 				return shared.UsageError("--app is required (or set ASC_APP_ID)")
 			}
@@ -1371,7 +1371,7 @@ Examples:
 				return withWebAuthHint(err, "web privacy plan")
 			}
 
-			return shared.PrintOutputWithRenderers(
+			return shared.PrintOutputWithRenderers(ctx,
 				plan,
 				*output.Output,
 				*output.Pretty,
@@ -1411,7 +1411,7 @@ Examples:
 			if len(args) > 0 {
 				return shared.UsageError("web privacy apply does not accept positional arguments")
 			}
-			resolvedAppID := strings.TrimSpace(shared.ResolveAppID(*appID))
+			resolvedAppID := strings.TrimSpace(shared.ResolveAppID(ctx, *appID))
 			if resolvedAppID == "" {
 				return shared.UsageError("--app is required (or set ASC_APP_ID)")
 			}
@@ -1479,7 +1479,7 @@ Examples:
 				Actions:        actions,
 				APICalls:       plan.APICalls,
 			}
-			return shared.PrintOutputWithRenderers(
+			return shared.PrintOutputWithRenderers(ctx,
 				payload,
 				*output.Output,
 				*output.Pretty,
@@ -1515,7 +1515,7 @@ Examples:
 			if len(args) > 0 {
 				return shared.UsageError("web privacy publish does not accept positional arguments")
 			}
-			resolvedAppID := strings.TrimSpace(shared.ResolveAppID(*appID))
+			resolvedAppID := strings.TrimSpace(shared.ResolveAppID(ctx, *appID))
 			if resolvedAppID == "" {
 				return shared.UsageError("--app is required (or set ASC_APP_ID)")
 			}
@@ -1557,7 +1557,7 @@ Examples:
 				WasPublished: stateBefore.Published,
 				Changed:      !stateBefore.Published && stateAfter.Published,
 			}
-			return shared.PrintOutputWithRenderers(
+			return shared.PrintOutputWithRenderers(ctx,
 				payload,
 				*output.Output,
 				*output.Pretty,
@@ -757,7 +757,7 @@ func WebReviewListCommand() *ffcli.Command {
 				return withWebAuthHint(err, "web review list")
 			}
 			filtered := filterSubmissionsByState(submissions, states)
-			return shared.PrintOutputWithRenderers(
+			return shared.PrintOutputWithRenderers(ctx,
 				filtered,
 				*output.Output,
 				*output.Pretty,
@@ -836,7 +836,7 @@ Selection:
 					AppID:     trimmedAppID,
 					Selection: selection,
 				}
-				return shared.PrintOutput(payload, *output.Output, *output.Pretty)
+				return shared.PrintOutput(ctx, payload, *output.Output, *output.Pretty)
 			}
 
 			var (
@@ -898,7 +898,7 @@ Selection:
 				payload.OutputDirectory = ""
 			}
 
-			if err := shared.PrintOutputWithRenderers(
+			if err := shared.PrintOutputWithRenderers(ctx,
 				payload,
 				*output.Output,
 				*output.Pretty,
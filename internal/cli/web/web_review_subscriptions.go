@@ -486,7 +486,7 @@ func WebReviewSubscriptionsListCommand() *ffcli.Command {
 				AttachedCount: countAttachedReviewSubscriptions(subscriptions),
 				Subscriptions: subscriptions,
 			}
-			return shared.PrintOutputWithRenderers(
+			return shared.PrintOutputWithRenderers(ctx,
 				payload,
 				*output.Output,
 				*output.Pretty,
@@ -575,7 +575,7 @@ func WebReviewSubscriptionsAttachCommand() *ffcli.Command {
 				payload.Subscription = *refreshed
 			}
 
-			return shared.PrintOutputWithRenderers(
+			return shared.PrintOutputWithRenderers(ctx,
 				payload,
 				*output.Output,
 				*output.Pretty,
@@ -687,7 +687,7 @@ func WebReviewSubscriptionsAttachGroupCommand() *ffcli.Command {
 				Skipped:            skipped,
 			}
 
-			return shared.PrintOutputWithRenderers(
+			return shared.PrintOutputWithRenderers(ctx,
 				payload,
 				*output.Output,
 				*output.Pretty,
@@ -771,7 +771,7 @@ func WebReviewSubscriptionsRemoveCommand() *ffcli.Command {
 				payload.Subscription = *refreshed
 			}
 
-			return shared.PrintOutputWithRenderers(
+			return shared.PrintOutputWithRenderers(ctx,
 				payload,
 				*output.Output,
 				*output.Pretty,
@@ -877,7 +877,7 @@ func WebReviewSubscriptionsRemoveGroupCommand() *ffcli.Command {
 				Skipped:            skipped,
 			}
 
-			return shared.PrintOutputWithRenderers(
+			return shared.PrintOutputWithRenderers(ctx,
 				payload,
 				*output.Output,
 				*output.Pretty,
@@ -145,7 +145,7 @@ Examples:
 				Territory: territoryValue,
 				Submitted: true,
 			}
-			return shared.PrintOutputWithRenderers(
+			return shared.PrintOutputWithRenderers(ctx,
 				result,
 				*output.Output,
 				*output.Pretty,
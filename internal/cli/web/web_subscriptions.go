@@ -116,7 +116,7 @@ Examples:
 			}
 
 			trimmedSubscriptionID := strings.TrimSpace(*subscriptionID)
-			trimmedAppID := strings.TrimSpace(shared.ResolveAppID(*appID))
+			trimmedAppID := strings.TrimSpace(shared.ResolveAppID(ctx, *appID))
 			trimmedPlanAvailabilityID := strings.TrimSpace(*planAvailabilityID)
 			switch {
 			case trimmedSubscriptionID == "":
@@ -210,7 +210,7 @@ Examples:
 				AvailableTerritories:      verified.AvailableTerritories,
 				RequiresAccountHolderRole: true,
 			}
-			return shared.PrintOutputWithRenderers(
+			return shared.PrintOutputWithRenderers(ctx,
 				result,
 				*output.Output,
 				*output.Pretty,
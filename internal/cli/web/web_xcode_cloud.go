@@ -130,7 +130,7 @@ Examples:
 			if err != nil {
 				return withWebAuthHint(err, "xcode-cloud usage summary")
 			}
-			return shared.PrintOutputWithRenderers(
+			return shared.PrintOutputWithRenderers(ctx,
 				result,
 				*output.Output,
 				*output.Pretty,
@@ -227,7 +227,7 @@ Examples:
 			if err != nil {
 				return withWebAuthHint(err, "xcode-cloud usage months")
 			}
-			return shared.PrintOutputWithRenderers(
+			return shared.PrintOutputWithRenderers(ctx,
 				result,
 				*output.Output,
 				*output.Pretty,
@@ -329,7 +329,7 @@ Examples:
 			if err != nil {
 				return withWebAuthHint(err, "xcode-cloud usage days")
 			}
-			return shared.PrintOutputWithRenderers(
+			return shared.PrintOutputWithRenderers(ctx,
 				result,
 				*output.Output,
 				*output.Pretty,
@@ -449,7 +449,7 @@ Examples:
 				if wf == nil {
 					return fmt.Errorf("workflow %q not found in product %q", wfID, pid)
 				}
-				return shared.PrintOutputWithRenderers(
+				return shared.PrintOutputWithRenderers(ctx,
 					wf,
 					*output.Output,
 					*output.Pretty,
@@ -475,7 +475,7 @@ Examples:
 					planTotal = summary.Plan.Total
 				}
 			}
-			return shared.PrintOutputWithRenderers(
+			return shared.PrintOutputWithRenderers(ctx,
 				out,
 				*output.Output,
 				*output.Pretty,
@@ -660,7 +660,7 @@ Examples:
 			if err != nil {
 				return withWebAuthHint(err, "xcode-cloud products")
 			}
-			return shared.PrintOutputWithRenderers(
+			return shared.PrintOutputWithRenderers(ctx,
 				result,
 				*output.Output,
 				*output.Pretty,
@@ -259,7 +259,7 @@ Examples:
 				})
 			}
 
-			if err := shared.PrintOutputWithRenderers(
+			if err := shared.PrintOutputWithRenderers(ctx,
 				alertResult,
 				*output.Output,
 				*output.Pretty,
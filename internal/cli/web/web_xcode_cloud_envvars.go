@@ -145,7 +145,7 @@ Examples:
 			if err != nil {
 				return withWebAuthHint(err, "xcode-cloud env-vars list")
 			}
-			return shared.PrintOutputWithRenderers(
+			return shared.PrintOutputWithRenderers(ctx,
 				result,
 				*output.Output,
 				*output.Pretty,
@@ -290,7 +290,7 @@ Examples:
 			if err != nil {
 				return withWebAuthHint(err, "xcode-cloud env-vars set")
 			}
-			return shared.PrintOutputWithRenderers(
+			return shared.PrintOutputWithRenderers(ctx,
 				result,
 				*output.Output,
 				*output.Pretty,
@@ -413,7 +413,7 @@ Examples:
 			if err != nil {
 				return withWebAuthHint(err, "xcode-cloud env-vars delete")
 			}
-			return shared.PrintOutputWithRenderers(
+			return shared.PrintOutputWithRenderers(ctx,
 				result,
 				*output.Output,
 				*output.Pretty,
@@ -128,7 +128,7 @@ Examples:
 			if err != nil {
 				return withWebAuthHint(err, "xcode-cloud env-vars shared list")
 			}
-			return shared.PrintOutputWithRenderers(
+			return shared.PrintOutputWithRenderers(ctx,
 				result,
 				*output.Output,
 				*output.Pretty,
@@ -272,7 +272,7 @@ Examples:
 			if err != nil {
 				return withWebAuthHint(err, "xcode-cloud env-vars shared set")
 			}
-			return shared.PrintOutputWithRenderers(
+			return shared.PrintOutputWithRenderers(ctx,
 				result,
 				*output.Output,
 				*output.Pretty,
@@ -374,7 +374,7 @@ Examples:
 			if err != nil {
 				return withWebAuthHint(err, "xcode-cloud env-vars shared delete")
 			}
-			return shared.PrintOutputWithRenderers(
+			return shared.PrintOutputWithRenderers(ctx,
 				result,
 				*output.Output,
 				*output.Pretty,
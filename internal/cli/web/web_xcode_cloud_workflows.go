@@ -170,7 +170,7 @@ Examples:
 				return withWebAuthHint(err, "xcode-cloud workflows describe")
 			}
 
-			return shared.PrintOutputWithRenderers(
+			return shared.PrintOutputWithRenderers(ctx,
 				result,
 				*output.Output,
 				*output.Pretty,
@@ -275,7 +275,7 @@ Examples:
 				return withWebAuthHint(err, "xcode-cloud workflows create")
 			}
 
-			return shared.PrintOutputWithRenderers(
+			return shared.PrintOutputWithRenderers(ctx,
 				result,
 				*output.Output,
 				*output.Pretty,
@@ -385,7 +385,7 @@ Examples:
 				return withWebAuthHint(err, "xcode-cloud workflows edit")
 			}
 
-			return shared.PrintOutputWithRenderers(
+			return shared.PrintOutputWithRenderers(ctx,
 				result,
 				*output.Output,
 				*output.Pretty,
@@ -436,7 +436,7 @@ Examples:
 				return err
 			}
 
-			return shared.PrintOutputWithRenderers(
+			return shared.PrintOutputWithRenderers(ctx,
 				result,
 				*output.Output,
 				*output.Pretty,
@@ -493,7 +493,7 @@ Examples:
 				return err
 			}
 
-			return shared.PrintOutputWithRenderers(
+			return shared.PrintOutputWithRenderers(ctx,
 				result,
 				*output.Output,
 				*output.Pretty,
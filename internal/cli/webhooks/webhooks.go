@@ -11,12 +11,14 @@ import (
 
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+	workflowcli "github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/workflow"
 )
 
 const webhooksMaxLimit = 200
 
-// WebhooksCommand returns the webhooks command group.
-func WebhooksCommand() *ffcli.Command {
+// WebhooksCommand returns the webhooks command group. runWorkflow runs the
+// workflows asc webhooks serve triggers.
+func WebhooksCommand(runWorkflow workflowcli.FileRunFunc) *ffcli.Command {
 	fs := flag.NewFlagSet("webhooks", flag.ExitOnError)
 
 	return &ffcli.Command{
@@ -32,6 +34,7 @@ Examples:
   asc webhooks update --webhook-id "WEBHOOK_ID" --url "https://new-url.com/webhook" --enabled false
   asc webhooks delete --webhook-id "WEBHOOK_ID" --confirm
   asc webhooks serve --port 8787 --dir ./webhook-events
+  asc webhooks serve --workflow-file .asc/workflow.json --route BUILD_UPLOAD_STATE_UPDATED=distribute-qa
   asc webhooks deliveries --webhook-id "WEBHOOK_ID"
   asc webhooks deliveries relationships --webhook-id "WEBHOOK_ID"
   asc webhooks deliveries redeliver --delivery-id "DELIVERY_ID"
@@ -44,7 +47,7 @@ Examples:
 			WebhooksCreateCommand(),
 			WebhooksUpdateCommand(),
 			WebhooksDeleteCommand(),
-			WebhooksServeCommand(),
+			WebhooksServeCommand(runWorkflow),
 			WebhookDeliveriesCommand(),
 			WebhookPingCommand(),
 		},
@@ -77,7 +80,7 @@ Examples:
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
-			resolvedAppID := shared.ResolveAppID(*appID)
+			resolvedAppID := shared.ResolveAppID(ctx, *appID)
 			if resolvedAppID == "" && strings.TrimSpace(*next) == "" {
 				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
 				return flag.ErrHelp
@@ -89,7 +92,7 @@ Examples:
 				return fmt.Errorf("webhooks list: %w", err)
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("webhooks list: %w", err)
 			}
@@ -112,13 +115,12 @@ Examples:
 				if err != nil {
 					return fmt.Errorf("webhooks list: failed to fetch: %w", err)
 				}
-				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				if err := shared.PrintPaginatedOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetAppWebhooks(ctx, resolvedAppID, asc.WithWebhooksNextURL(nextURL))
-				})
-				if err != nil {
+				}, *output.Output, *output.Pretty); err != nil {
 					return fmt.Errorf("webhooks list: %w", err)
 				}
-				return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+				return nil
 			}
 
 			webhooks, err := client.GetAppWebhooks(requestCtx, resolvedAppID, opts...)
@@ -126,7 +128,7 @@ Examples:
 				return fmt.Errorf("webhooks list: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(webhooks, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, webhooks, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -155,7 +157,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("webhooks get: %w", err)
 			}
@@ -168,7 +170,7 @@ Examples:
 				return fmt.Errorf("webhooks get: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(webhook, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, webhook, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -197,7 +199,7 @@ Examples:
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
-			resolvedAppID := shared.ResolveAppID(*appID)
+			resolvedAppID := shared.ResolveAppID(ctx, *appID)
 			if resolvedAppID == "" {
 				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
 				return flag.ErrHelp
@@ -228,7 +230,7 @@ Examples:
 				return fmt.Errorf("webhooks create: %w", err)
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("webhooks create: %w", err)
 			}
@@ -249,7 +251,7 @@ Examples:
 				return fmt.Errorf("webhooks create: failed to create: %w", err)
 			}
 
-			return shared.PrintOutput(webhook, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, webhook, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -322,7 +324,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("webhooks update: %w", err)
 			}
@@ -335,7 +337,7 @@ Examples:
 				return fmt.Errorf("webhooks update: failed to update: %w", err)
 			}
 
-			return shared.PrintOutput(webhook, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, webhook, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -369,7 +371,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("webhooks delete: %w", err)
 			}
@@ -382,7 +384,7 @@ Examples:
 			}
 
 			result := &asc.WebhookDeleteResult{ID: trimmedID, Deleted: true}
-			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -446,7 +448,7 @@ Examples:
 				return fmt.Errorf("webhooks deliveries: %w", err)
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("webhooks deliveries: %w", err)
 			}
@@ -485,13 +487,12 @@ Examples:
 				if err != nil {
 					return fmt.Errorf("webhooks deliveries: failed to fetch: %w", err)
 				}
-				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				if err := shared.PrintPaginatedOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetWebhookDeliveries(ctx, trimmedID, asc.WithWebhookDeliveriesNextURL(nextURL))
-				})
-				if err != nil {
+				}, *output.Output, *output.Pretty); err != nil {
 					return fmt.Errorf("webhooks deliveries: %w", err)
 				}
-				return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+				return nil
 			}
 
 			deliveries, err := client.GetWebhookDeliveries(requestCtx, trimmedID, opts...)
@@ -499,7 +500,7 @@ Examples:
 				return fmt.Errorf("webhooks deliveries: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(deliveries, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, deliveries, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -546,7 +547,7 @@ Examples:
 				trimmedID = derivedID
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("webhooks deliveries links: %w", err)
 			}
@@ -569,13 +570,12 @@ Examples:
 				if err != nil {
 					return fmt.Errorf("webhooks deliveries links: failed to fetch: %w", err)
 				}
-				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				if err := shared.PrintPaginatedOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetWebhookDeliveriesRelationships(ctx, trimmedID, asc.WithLinkagesNextURL(nextURL))
-				})
-				if err != nil {
+				}, *output.Output, *output.Pretty); err != nil {
 					return fmt.Errorf("webhooks deliveries links: %w", err)
 				}
-				return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+				return nil
 			}
 
 			resp, err := client.GetWebhookDeliveriesRelationships(requestCtx, trimmedID, opts...)
@@ -583,7 +583,7 @@ Examples:
 				return fmt.Errorf("webhooks deliveries links: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -612,7 +612,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("webhooks deliveries redeliver: %w", err)
 			}
@@ -625,7 +625,7 @@ Examples:
 				return fmt.Errorf("webhooks deliveries redeliver: failed to create: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -654,7 +654,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("webhooks ping: %w", err)
 			}
@@ -667,7 +667,7 @@ Examples:
 				return fmt.Errorf("webhooks ping: failed to create: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -21,8 +21,8 @@ import (
 
 	"github.com/peterbourgon/ff/v3/ffcli"
 
-	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+	workflowcli "github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/workflow"
 )
 
 const (
@@ -41,12 +41,15 @@ var webhookExecCommand = func(ctx context.Context, command string) *exec.Cmd {
 }
 
 type webhookServeStartup struct {
-	URL          string `json:"url"`
-	Host         string `json:"host"`
-	Port         int    `json:"port"`
-	Dir          string `json:"dir,omitempty"`
-	ExecEnabled  bool   `json:"execEnabled"`
-	MaxBodyBytes int64  `json:"maxBodyBytes"`
+	URL          string            `json:"url"`
+	Host         string            `json:"host"`
+	Port         int               `json:"port"`
+	Dir          string            `json:"dir,omitempty"`
+	ExecEnabled  bool              `json:"execEnabled"`
+	MaxBodyBytes int64             `json:"maxBodyBytes"`
+	WorkflowFile string            `json:"workflowFile,omitempty"`
+	QueueDir     string            `json:"queueDir,omitempty"`
+	Routes       map[string]string `json:"routes,omitempty"`
 }
 
 type webhookServeEvent struct {
@@ -66,10 +69,15 @@ type webhookServeRuntime struct {
 	queueMu      sync.RWMutex
 	workersWG    sync.WaitGroup
 	fileCounter  uint64
+	workflows    *webhookWorkflowQueue
+	// logOut receives receiver log lines. It is captured when the command
+	// starts.
+	logOut io.Writer
 }
 
-// WebhooksServeCommand returns the webhooks serve subcommand.
-func WebhooksServeCommand() *ffcli.Command {
+// WebhooksServeCommand returns the webhooks serve subcommand. runWorkflow
+// runs the workflows that --route maps events to.
+func WebhooksServeCommand(runWorkflow workflowcli.FileRunFunc) *ffcli.Command {
 	fs := flag.NewFlagSet("serve", flag.ExitOnError)
 
 	host := fs.String("host", webhooksServeDefaultHost, "Host to bind the local webhook receiver")
@@ -79,6 +87,10 @@ func WebhooksServeCommand() *ffcli.Command {
 	execCommand := fs.String("exec", "", "Optional command to execute per event (payload JSON is piped on stdin)")
 	output := fs.String("output", "text", "Output format: text (default), json")
 	maxBodyBytes := fs.Int64("max-body-bytes", webhooksServeDefaultMaxBodyBytes, "Maximum accepted request body size in bytes")
+	workflowFile := fs.String("workflow-file", "", "Optional workflow.json whose workflows --route triggers per event")
+	var routes shared.MultiStringFlag
+	fs.Var(&routes, "route", "Run a workflow for an event type: EVENT_TYPE=WORKFLOW or *=WORKFLOW (repeatable; requires --workflow-file)")
+	queueDir := fs.String("queue-dir", "", "Directory for the persistent workflow event queue (default: webhook-queue next to --workflow-file)")
 
 	return &ffcli.Command{
 		Name:       "serve",
@@ -90,11 +102,29 @@ Security note:
   The default host is loopback-only.
   Binding to non-loopback hosts requires --allow-remote.
   If you expose this server remotely, treat --exec like local automation with network trigger access.
+  The same applies to --workflow-file: every routed event runs a workflow.
+
+Workflow triggers:
+  --workflow-file with one or more --route EVENT_TYPE=WORKFLOW flags runs the
+  routed workflow for each matching event, one event at a time, in arrival order.
+  Event types match case-insensitively; --route "*=WORKFLOW" catches every other
+  event. Unrouted events are accepted and ignored by the workflow queue.
+
+  Routed events are written to a queue directory before the receiver responds,
+  and stay there until their workflow run finishes, so events received or
+  interrupted before a restart run when the receiver starts again. Events whose
+  workflow fails move to the queue's failed/ directory.
+
+  Each run gets the event as params: EVENT_TYPE, EVENT_ID, EVENT_RECEIVED_AT,
+  EVENT_PAYLOAD (raw JSON), and EVENT_<PATH> for every scalar payload field,
+  e.g. data.attributes.newState becomes EVENT_DATA_ATTRIBUTES_NEW_STATE.
+  Runs are recorded like asc workflow run; inspect them with asc workflow runs.
 
 Examples:
   asc webhooks serve --port 8787
   asc webhooks serve --port 8787 --dir ./webhook-events
-  asc webhooks serve --port 8787 --exec "./scripts/on-webhook.sh"`,
+  asc webhooks serve --port 8787 --exec "./scripts/on-webhook.sh"
+  asc webhooks serve --port 8787 --workflow-file .asc/workflow.json --route BUILD_UPLOAD_STATE_UPDATED=distribute-qa`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -129,11 +159,28 @@ Examples:
 				return flag.ErrHelp
 			}
 
+			if strings.TrimSpace(*workflowFile) == "" && (len(routes) > 0 || strings.TrimSpace(*queueDir) != "") {
+				return shared.UsageError("--route and --queue-dir require --workflow-file")
+			}
+
+			stdout, logOut := io.Writer(os.Stdout), io.Writer(os.Stderr)
+
 			eventsDir, err := prepareWebhookServeDirectory(*dir)
 			if err != nil {
 				return fmt.Errorf("webhooks serve: %w", err)
 			}
 
+			var workflows *webhookWorkflowQueue
+			if strings.TrimSpace(*workflowFile) != "" {
+				workflows, err = newWebhookWorkflowQueue(*workflowFile, *queueDir, routes, runWorkflow, logOut)
+				if err != nil {
+					if errors.Is(err, flag.ErrHelp) {
+						return err
+					}
+					return fmt.Errorf("webhooks serve: %w", err)
+				}
+			}
+
 			listener, err := net.Listen("tcp", net.JoinHostPort(bindHost, strconv.Itoa(*port)))
 			if err != nil {
 				return fmt.Errorf("webhooks serve: failed to listen on %s: %w", net.JoinHostPort(bindHost, strconv.Itoa(*port)), err)
@@ -153,6 +200,11 @@ Examples:
 				ExecEnabled:  strings.TrimSpace(*execCommand) != "",
 				MaxBodyBytes: *maxBodyBytes,
 			}
+			if workflows != nil {
+				startup.WorkflowFile = workflows.workflowFile
+				startup.QueueDir = workflows.dir
+				startup.Routes = workflows.routes
+			}
 
 			runtime := &webhookServeRuntime{
 				dir:          eventsDir,
@@ -161,8 +213,18 @@ Examples:
 				eventQueue:   make(chan webhookServeEvent, webhooksServeDefaultQueueSize),
 				workerCount:  webhooksServeDefaultWorkerCount,
 				execTimeout:  webhooksServeDefaultExecTimeout,
+				workflows:    workflows,
+				logOut:       logOut,
 			}
 			runtime.startWorkers(ctx)
+			if workflows != nil {
+				queueCtx, stopQueue := context.WithCancel(ctx)
+				workflows.start(queueCtx)
+				defer func() {
+					stopQueue()
+					workflows.wait()
+				}()
+			}
 			server := &http.Server{
 				Handler:           runtime.newHandler(),
 				ReadHeaderTimeout: 5 * time.Second,
@@ -182,11 +244,11 @@ Examples:
 			}()
 
 			if outputFormat == "json" {
-				if err := asc.PrintJSON(startup); err != nil {
+				if err := json.NewEncoder(stdout).Encode(startup); err != nil {
 					return fmt.Errorf("webhooks serve: %w", err)
 				}
 			} else {
-				fmt.Fprintf(os.Stdout, "Listening for webhook events on %s\n", startup.URL)
+				fmt.Fprintf(stdout, "Listening for webhook events on %s\n", startup.URL)
 			}
 
 			select {
@@ -242,8 +304,7 @@ func (r *webhookServeRuntime) newHandler() http.Handler {
 			EventID:    eventID,
 		}
 
-		fmt.Fprintf(
-			os.Stderr,
+		r.logf(
 			"webhooks serve: received event type=%s id=%s bytes=%d\n",
 			firstNonEmpty(strings.TrimSpace(event.EventType), "unknown"),
 			firstNonEmpty(strings.TrimSpace(event.EventID), "unknown"),
@@ -257,14 +318,36 @@ func (r *webhookServeRuntime) newHandler() http.Handler {
 			return
 		}
 
-		writeWebhookServeJSON(w, http.StatusAccepted, map[string]any{
+		response := map[string]any{
 			"accepted": true,
-		})
+		}
+		if r.workflows != nil {
+			if workflowName, ok := r.workflows.workflowFor(event.EventType); ok {
+				if _, err := r.workflows.enqueue(webhookQueuedEvent{
+					ReceivedAt: event.ReceivedAt,
+					EventType:  event.EventType,
+					EventID:    event.EventID,
+					Workflow:   workflowName,
+					Payload:    event.Payload,
+				}); err != nil {
+					r.logf("webhooks serve: failed to queue workflow for event id=%s: %v\n", firstNonEmpty(event.EventID, "unknown"), err)
+					writeWebhookServeJSON(w, http.StatusInternalServerError, map[string]any{
+						"error": "failed to queue workflow",
+					})
+					return
+				}
+				response["workflow"] = workflowName
+			}
+		}
+
+		writeWebhookServeJSON(w, http.StatusAccepted, response)
 	})
 }
 
 func (r *webhookServeRuntime) startWorkers(_ context.Context) {
-	if r.eventQueue == nil {
+	// Workers hold their own reference: stopWorkers clears r.eventQueue.
+	queue := r.eventQueue
+	if queue == nil {
 		return
 	}
 
@@ -276,7 +359,7 @@ func (r *webhookServeRuntime) startWorkers(_ context.Context) {
 	for i := 0; i < workerCount; i++ {
 		go func() {
 			defer r.workersWG.Done()
-			for event := range r.eventQueue {
+			for event := range queue {
 				r.processEvent(event)
 			}
 		}()
@@ -311,9 +394,9 @@ func (r *webhookServeRuntime) processEvent(event webhookServeEvent) {
 	if r.dir != "" {
 		path, err := r.writeEventFile(event)
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "webhooks serve: failed to persist event id=%s: %v\n", firstNonEmpty(event.EventID, "unknown"), err)
+			r.logf("webhooks serve: failed to persist event id=%s: %v\n", firstNonEmpty(event.EventID, "unknown"), err)
 		} else {
-			fmt.Fprintf(os.Stderr, "webhooks serve: wrote event payload to %s\n", path)
+			r.logf("webhooks serve: wrote event payload to %s\n", path)
 		}
 	}
 
@@ -326,11 +409,17 @@ func (r *webhookServeRuntime) processEvent(event webhookServeEvent) {
 		err := runWebhookExecCommand(execCtx, r.execCommand, event.Payload)
 		cancel()
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "webhooks serve: exec failed for event id=%s: %v\n", firstNonEmpty(event.EventID, "unknown"), err)
+			r.logf("webhooks serve: exec failed for event id=%s: %v\n", firstNonEmpty(event.EventID, "unknown"), err)
 		}
 	}
 }
 
+func (r *webhookServeRuntime) logf(format string, args ...any) {
+	if r.logOut != nil {
+		fmt.Fprintf(r.logOut, format, args...)
+	}
+}
+
 func (r *webhookServeRuntime) writeEventFile(event webhookServeEvent) (string, error) {
 	fileIndex := atomic.AddUint64(&r.fileCounter, 1)
 	fileName := fmt.Sprintf(
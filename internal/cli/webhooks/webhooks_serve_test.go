@@ -24,7 +24,7 @@ func TestWebhooksServeReceivesAndWritesEvent(t *testing.T) {
 
 	ctx, cancel := context.WithCancel(context.Background())
 	errCh := make(chan error, 1)
-	cmd := WebhooksServeCommand()
+	cmd := WebhooksServeCommand(nil)
 	cmd.FlagSet.SetOutput(io.Discard)
 	if err := cmd.Parse([]string{
 		"--host", "127.0.0.1",
@@ -65,7 +65,7 @@ func TestWebhooksServeExecReceivesPayload(t *testing.T) {
 
 	ctx, cancel := context.WithCancel(context.Background())
 	errCh := make(chan error, 1)
-	cmd := WebhooksServeCommand()
+	cmd := WebhooksServeCommand(nil)
 	cmd.FlagSet.SetOutput(io.Discard)
 	if err := cmd.Parse([]string{
 		"--host", "127.0.0.1",
@@ -89,7 +89,7 @@ func TestWebhooksServeExecReceivesPayload(t *testing.T) {
 }
 
 func TestWebhooksServeRejectsNonLoopbackWithoutAllowRemote(t *testing.T) {
-	cmd := WebhooksServeCommand()
+	cmd := WebhooksServeCommand(nil)
 	cmd.FlagSet.SetOutput(io.Discard)
 	if err := cmd.Parse([]string{
 		"--host", "0.0.0.0",
@@ -112,7 +112,7 @@ func TestWebhooksServeAllowsNonLoopbackWithAllowRemote(t *testing.T) {
 
 	ctx, cancel := context.WithCancel(context.Background())
 	errCh := make(chan error, 1)
-	cmd := WebhooksServeCommand()
+	cmd := WebhooksServeCommand(nil)
 	cmd.FlagSet.SetOutput(io.Discard)
 	if err := cmd.Parse([]string{
 		"--host", "0.0.0.0",
@@ -0,0 +1,379 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+	"unicode"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+	workflowcli "github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/workflow"
+	wf "github.com/rudrankriyam/App-Store-Connect-CLI/internal/workflow"
+)
+
+const (
+	webhooksServeQueueDirName    = "webhook-queue"
+	webhooksServeRouteAnyEvent   = "*"
+	webhooksServeQueuePendingDir = "pending"
+	webhooksServeQueueFailedDir  = "failed"
+)
+
+// webhookQueuedEvent is one routed event in the persistent workflow queue.
+type webhookQueuedEvent struct {
+	ReceivedAt time.Time       `json:"receivedAt"`
+	EventType  string          `json:"eventType,omitempty"`
+	EventID    string          `json:"eventId,omitempty"`
+	Workflow   string          `json:"workflow"`
+	Payload    json.RawMessage `json:"payload"`
+	RunID      string          `json:"runId,omitempty"`
+	Error      string          `json:"error,omitempty"`
+}
+
+// webhookWorkflowQueue persists routed events as one file each under
+// pending/ and runs their workflows one at a time, oldest first. Events stay
+// pending until their run finishes, so events received or interrupted before
+// a restart run when the receiver starts again. Failed events move to
+// failed/.
+type webhookWorkflowQueue struct {
+	dir          string
+	workflowFile string
+	routes       map[string]string
+	run          workflowcli.FileRunFunc
+	logOut       io.Writer
+	counter      uint64
+	wake         chan struct{}
+	done         chan struct{}
+}
+
+// newWebhookWorkflowQueue validates the workflow file and routes and prepares
+// the queue directory. An empty queueDir defaults to webhook-queue next to the
+// workflow file. Queue log lines and workflow output go to logOut.
+func newWebhookWorkflowQueue(workflowFile, queueDir string, routeValues []string, run workflowcli.FileRunFunc, logOut io.Writer) (*webhookWorkflowQueue, error) {
+	if run == nil {
+		return nil, fmt.Errorf("workflow triggers are not available")
+	}
+	absPath, err := filepath.Abs(strings.TrimSpace(workflowFile))
+	if err != nil {
+		return nil, fmt.Errorf("resolve workflow file: %w", err)
+	}
+	routes, err := parseWebhookWorkflowRoutes(routeValues)
+	if err != nil {
+		return nil, shared.UsageErrorf("%s", err)
+	}
+
+	def, err := wf.Load(absPath)
+	if err != nil {
+		return nil, err
+	}
+	for _, event := range slices.Sorted(maps.Keys(routes)) {
+		if _, ok := def.Workflows[routes[event]]; !ok {
+			return nil, shared.UsageErrorf("--route %s: workflow %q not found in %s", event, routes[event], absPath)
+		}
+	}
+
+	dir := strings.TrimSpace(queueDir)
+	if dir == "" {
+		dir = filepath.Join(filepath.Dir(absPath), webhooksServeQueueDirName)
+	}
+	dir = filepath.Clean(dir)
+	for _, sub := range []string{webhooksServeQueuePendingDir, webhooksServeQueueFailedDir} {
+		if err := os.MkdirAll(filepath.Join(dir, sub), 0o700); err != nil {
+			return nil, fmt.Errorf("create queue directory: %w", err)
+		}
+	}
+
+	return &webhookWorkflowQueue{
+		dir:          dir,
+		workflowFile: absPath,
+		routes:       routes,
+		run:          run,
+		logOut:       logOut,
+		wake:         make(chan struct{}, 1),
+	}, nil
+}
+
+// parseWebhookWorkflowRoutes parses EVENT_TYPE=WORKFLOW values. Event types
+// match case-insensitively; "*" routes every event without its own route.
+func parseWebhookWorkflowRoutes(values []string) (map[string]string, error) {
+	if len(values) == 0 {
+		return nil, fmt.Errorf("--route is required with --workflow-file")
+	}
+	routes := make(map[string]string, len(values))
+	for _, value := range values {
+		event, workflowName, ok := strings.Cut(value, "=")
+		event = strings.ToUpper(strings.TrimSpace(event))
+		workflowName = strings.TrimSpace(workflowName)
+		if !ok || event == "" || workflowName == "" {
+			return nil, fmt.Errorf("--route %q must be EVENT_TYPE=WORKFLOW", value)
+		}
+		if _, exists := routes[event]; exists {
+			return nil, fmt.Errorf("--route for %s is given more than once", event)
+		}
+		routes[event] = workflowName
+	}
+	return routes, nil
+}
+
+// workflowFor returns the workflow routed for an event type, if any.
+func (q *webhookWorkflowQueue) workflowFor(eventType string) (string, bool) {
+	if name, ok := q.routes[strings.ToUpper(strings.TrimSpace(eventType))]; ok {
+		return name, true
+	}
+	name, ok := q.routes[webhooksServeRouteAnyEvent]
+	return name, ok
+}
+
+// enqueue persists an event and wakes the queue worker. Once it returns
+// without error the event survives a restart.
+func (q *webhookWorkflowQueue) enqueue(event webhookQueuedEvent) (string, error) {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return "", err
+	}
+	fileName := fmt.Sprintf(
+		"%s-%06d-%s.json",
+		event.ReceivedAt.Format("20060102T150405.000000000Z"),
+		atomic.AddUint64(&q.counter, 1),
+		sanitizeWebhookServeFilenameSegment(event.EventType),
+	)
+	path := filepath.Join(q.dir, webhooksServeQueuePendingDir, fileName)
+	if err := writeWebhookQueueFile(path, data); err != nil {
+		return "", err
+	}
+
+	select {
+	case q.wake <- struct{}{}:
+	default:
+	}
+	return path, nil
+}
+
+// start runs queued events until ctx is done, beginning with events left
+// pending by a previous receiver.
+func (q *webhookWorkflowQueue) start(ctx context.Context) {
+	q.done = make(chan struct{})
+	go func() {
+		defer close(q.done)
+		for {
+			q.drain(ctx)
+			select {
+			case <-ctx.Done():
+				return
+			case <-q.wake:
+			}
+		}
+	}()
+}
+
+// wait blocks until the worker started by start has returned.
+func (q *webhookWorkflowQueue) wait() {
+	if q.done != nil {
+		<-q.done
+	}
+}
+
+// drain runs every pending event once. Events queued meanwhile wake the
+// worker again.
+func (q *webhookWorkflowQueue) drain(ctx context.Context) {
+	pending, err := q.pendingFiles()
+	if err != nil {
+		q.logf("webhooks serve: failed to read event queue: %v\n", err)
+		return
+	}
+	for _, path := range pending {
+		if ctx.Err() != nil {
+			return
+		}
+		q.process(ctx, path)
+	}
+}
+
+func (q *webhookWorkflowQueue) pendingFiles() ([]string, error) {
+	dir := filepath.Join(q.dir, webhooksServeQueuePendingDir)
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	files := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".json" {
+			continue
+		}
+		files = append(files, filepath.Join(dir, entry.Name()))
+	}
+	// File names start with the receive time, so name order is arrival order.
+	slices.Sort(files)
+	return files, nil
+}
+
+// process runs the workflow for one queued event. An event whose run is
+// interrupted by shutdown stays pending and runs again on the next start.
+func (q *webhookWorkflowQueue) process(ctx context.Context, path string) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		q.logf("webhooks serve: failed to read queued event %s: %v\n", path, err)
+		return
+	}
+	var event webhookQueuedEvent
+	if err := json.Unmarshal(data, &event); err != nil {
+		event.Error = fmt.Sprintf("invalid queued event: %v", err)
+		q.fail(path, data, event)
+		return
+	}
+
+	eventID := firstNonEmpty(event.EventID, "unknown")
+	params, err := webhookWorkflowParams(event)
+	if err != nil {
+		event.Error = err.Error()
+		q.fail(path, data, event)
+		return
+	}
+
+	q.logf("webhooks serve: running workflow %s for event type=%s id=%s\n", event.Workflow, firstNonEmpty(event.EventType, "unknown"), eventID)
+	result, runErr := q.run(ctx, q.workflowFile, event.Workflow, params, q.logOut)
+	if result != nil {
+		event.RunID = result.RunID
+	}
+	if runErr != nil && ctx.Err() != nil {
+		q.logf("webhooks serve: workflow %s for event id=%s interrupted; it stays queued\n", event.Workflow, eventID)
+		return
+	}
+	if runErr != nil {
+		event.Error = runErr.Error()
+		q.fail(path, data, event)
+		return
+	}
+
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		q.logf("webhooks serve: failed to dequeue event id=%s: %v\n", eventID, err)
+	}
+	q.logf("webhooks serve: workflow %s for event id=%s finished run_id=%s\n", event.Workflow, eventID, firstNonEmpty(event.RunID, "unknown"))
+}
+
+// fail moves a queued event to failed/, recording the run ID and error, so it
+// is not retried on restart.
+func (q *webhookWorkflowQueue) fail(path string, original []byte, event webhookQueuedEvent) {
+	q.logf("webhooks serve: workflow %s failed for event id=%s: %s\n", firstNonEmpty(event.Workflow, "unknown"), firstNonEmpty(event.EventID, "unknown"), event.Error)
+
+	data := original
+	if encoded, err := json.Marshal(event); err == nil && event.Payload != nil {
+		data = encoded
+	}
+	failedPath := filepath.Join(q.dir, webhooksServeQueueFailedDir, filepath.Base(path))
+	if err := writeWebhookQueueFile(failedPath, data); err != nil {
+		q.logf("webhooks serve: failed to record failed event %s: %v\n", path, err)
+		return
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		q.logf("webhooks serve: failed to dequeue event %s: %v\n", path, err)
+	}
+}
+
+func (q *webhookWorkflowQueue) logf(format string, args ...any) {
+	if q.logOut != nil {
+		fmt.Fprintf(q.logOut, format, args...)
+	}
+}
+
+// writeWebhookQueueFile writes through a temporary file and renames it, so a
+// crash never leaves a partial event in the queue.
+func writeWebhookQueueFile(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".event-*.tmp")
+	if err != nil {
+		return err
+	}
+	tmpPath := tmp.Name()
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmpPath)
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmpPath)
+		return err
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		_ = os.Remove(tmpPath)
+		return err
+	}
+	return nil
+}
+
+// webhookWorkflowParams turns an event into workflow params: EVENT_TYPE,
+// EVENT_ID, EVENT_RECEIVED_AT, EVENT_PAYLOAD (the raw JSON), and one
+// EVENT_<PATH> param per scalar payload field, e.g. data.attributes.newState
+// becomes EVENT_DATA_ATTRIBUTES_NEW_STATE. Event metadata wins over payload
+// fields of the same name.
+func webhookWorkflowParams(event webhookQueuedEvent) (map[string]string, error) {
+	var args []string
+	var payload any
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		return nil, fmt.Errorf("invalid event payload: %w", err)
+	}
+	args = appendWebhookPayloadParams(args, "EVENT", payload)
+	args = append(args,
+		"EVENT_TYPE="+event.EventType,
+		"EVENT_ID="+event.EventID,
+		"EVENT_RECEIVED_AT="+event.ReceivedAt.UTC().Format(time.RFC3339),
+		"EVENT_PAYLOAD="+string(event.Payload),
+	)
+	return wf.ParseParams(args)
+}
+
+func appendWebhookPayloadParams(args []string, prefix string, value any) []string {
+	switch v := value.(type) {
+	case map[string]any:
+		for _, key := range slices.Sorted(maps.Keys(v)) {
+			segment := webhookParamSegment(key)
+			if segment == "" {
+				continue
+			}
+			args = appendWebhookPayloadParams(args, prefix+"_"+segment, v[key])
+		}
+	case []any:
+		for i, item := range v {
+			args = appendWebhookPayloadParams(args, prefix+"_"+strconv.Itoa(i), item)
+		}
+	case string:
+		args = append(args, prefix+"="+v)
+	case float64:
+		args = append(args, prefix+"="+strconv.FormatFloat(v, 'f', -1, 64))
+	case bool:
+		args = append(args, prefix+"="+strconv.FormatBool(v))
+	}
+	return args
+}
+
+// webhookParamSegment converts a payload key such as "newState" or
+// "bundle-id" to NEW_STATE or BUNDLE_ID.
+func webhookParamSegment(key string) string {
+	var b strings.Builder
+	prevLower := false
+	for _, r := range key {
+		switch {
+		case unicode.IsUpper(r):
+			if prevLower {
+				b.WriteByte('_')
+			}
+			b.WriteRune(r)
+			prevLower = false
+		case unicode.IsLower(r) || unicode.IsDigit(r):
+			b.WriteRune(unicode.ToUpper(r))
+			prevLower = true
+		default:
+			b.WriteByte('_')
+			prevLower = false
+		}
+	}
+	return strings.Trim(b.String(), "_")
+}
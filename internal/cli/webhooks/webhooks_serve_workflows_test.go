@@ -0,0 +1,421 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+	workflowcli "github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/workflow"
+	wf "github.com/rudrankriyam/App-Store-Connect-CLI/internal/workflow"
+)
+
+type recordedWorkflowRun struct {
+	workflow string
+	params   map[string]string
+}
+
+type fakeWorkflowRunner struct {
+	mu   sync.Mutex
+	runs []recordedWorkflowRun
+	err  error
+	// block, when set, makes runs wait for ctx to be done.
+	block bool
+}
+
+func (f *fakeWorkflowRunner) run(ctx context.Context, _ string, workflowName string, params map[string]string, _ io.Writer) (*wf.RunResult, error) {
+	f.mu.Lock()
+	f.runs = append(f.runs, recordedWorkflowRun{workflow: workflowName, params: params})
+	runID := fmt.Sprintf("%s-run-%d", workflowName, len(f.runs))
+	f.mu.Unlock()
+
+	if f.block {
+		<-ctx.Done()
+		return &wf.RunResult{RunID: runID, Status: "error"}, ctx.Err()
+	}
+	if f.err != nil {
+		return &wf.RunResult{RunID: runID, Status: "error"}, f.err
+	}
+	return &wf.RunResult{RunID: runID, Status: "ok"}, nil
+}
+
+func (f *fakeWorkflowRunner) recorded() []recordedWorkflowRun {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]recordedWorkflowRun(nil), f.runs...)
+}
+
+func writeTestWorkflowFile(t *testing.T, dir string) string {
+	t.Helper()
+
+	path := filepath.Join(dir, "workflow.json")
+	content := `{"workflows": {"distribute": {"steps": ["echo distribute"]}, "notify": {"steps": ["echo notify"]}}}`
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("write workflow file: %v", err)
+	}
+	return path
+}
+
+func waitForWorkflowRuns(t *testing.T, runner *fakeWorkflowRunner, count int) []recordedWorkflowRun {
+	t.Helper()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		if runs := runner.recorded(); len(runs) >= count {
+			return runs
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	t.Fatalf("timed out waiting for %d workflow runs, got %d", count, len(runner.recorded()))
+	return nil
+}
+
+func queueFiles(t *testing.T, dir string) []string {
+	t.Helper()
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("read queue dir: %v", err)
+	}
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if filepath.Ext(entry.Name()) == ".json" {
+			names = append(names, entry.Name())
+		}
+	}
+	return names
+}
+
+func TestWebhooksServeRunsRoutedWorkflow(t *testing.T) {
+	dir := t.TempDir()
+	workflowFile := writeTestWorkflowFile(t, dir)
+	runner := &fakeWorkflowRunner{}
+	port := freeLocalPort(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errCh := make(chan error, 1)
+	cmd := WebhooksServeCommand(runner.run)
+	cmd.FlagSet.SetOutput(io.Discard)
+	if err := cmd.Parse([]string{
+		"--host", "127.0.0.1",
+		"--port", fmt.Sprintf("%d", port),
+		"--workflow-file", workflowFile,
+		"--route", "build_upload_state_updated=distribute",
+	}); err != nil {
+		t.Fatalf("parse error: %v", err)
+	}
+
+	go func() {
+		errCh <- cmd.Run(ctx)
+	}()
+	defer shutdownServeCommand(t, cancel, errCh)
+
+	baseURL := fmt.Sprintf("http://127.0.0.1:%d", port)
+	if status := postJSONWithRetry(t, baseURL, `{"id":"evt-ignored","eventType":"APP_STORE_VERSION_STATE_UPDATED"}`); status != 202 {
+		t.Fatalf("expected status 202, got %d", status)
+	}
+	if status := postJSONWithRetry(t, baseURL, `{
+		"id":"evt-build-1",
+		"eventType":"BUILD_UPLOAD_STATE_UPDATED",
+		"data":{"attributes":{"newState":"COMPLETE"},"relationships":{"instance":{"data":{"id":"build-42"}}}}
+	}`); status != 202 {
+		t.Fatalf("expected status 202, got %d", status)
+	}
+
+	runs := waitForWorkflowRuns(t, runner, 1)
+	if runs[0].workflow != "distribute" {
+		t.Fatalf("expected distribute workflow, got %q", runs[0].workflow)
+	}
+	params := runs[0].params
+	if params["EVENT_TYPE"] != "BUILD_UPLOAD_STATE_UPDATED" || params["EVENT_ID"] != "evt-build-1" {
+		t.Fatalf("unexpected event metadata params: %v", params)
+	}
+	if params["EVENT_DATA_ATTRIBUTES_NEW_STATE"] != "COMPLETE" || params["EVENT_DATA_RELATIONSHIPS_INSTANCE_DATA_ID"] != "build-42" {
+		t.Fatalf("unexpected payload params: %v", params)
+	}
+	if !strings.Contains(params["EVENT_PAYLOAD"], `"newState":"COMPLETE"`) {
+		t.Fatalf("expected raw payload param, got %q", params["EVENT_PAYLOAD"])
+	}
+
+	pendingDir := filepath.Join(dir, webhooksServeQueueDirName, webhooksServeQueuePendingDir)
+	deadline := time.Now().Add(5 * time.Second)
+	for len(queueFiles(t, pendingDir)) > 0 && time.Now().Before(deadline) {
+		time.Sleep(20 * time.Millisecond)
+	}
+	if pending := queueFiles(t, pendingDir); len(pending) != 0 {
+		t.Fatalf("expected finished event to leave the queue, got %v", pending)
+	}
+	if runs := runner.recorded(); len(runs) != 1 {
+		t.Fatalf("expected unrouted event to be ignored, got %d runs", len(runs))
+	}
+}
+
+func TestWebhookWorkflowQueueRunsPendingEventsOnStart(t *testing.T) {
+	dir := t.TempDir()
+	workflowFile := writeTestWorkflowFile(t, dir)
+	runner := &fakeWorkflowRunner{}
+
+	queue, err := newWebhookWorkflowQueue(workflowFile, "", []string{"*=notify", "BUILD_UPLOAD_STATE_UPDATED=distribute"}, runner.run, io.Discard)
+	if err != nil {
+		t.Fatalf("newWebhookWorkflowQueue: %v", err)
+	}
+	received := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	for i, eventType := range []string{"BUILD_UPLOAD_STATE_UPDATED", "OTHER_EVENT"} {
+		workflowName, ok := queue.workflowFor(eventType)
+		if !ok {
+			t.Fatalf("expected route for %s", eventType)
+		}
+		if _, err := queue.enqueue(webhookQueuedEvent{
+			ReceivedAt: received.Add(time.Duration(i) * time.Second),
+			EventType:  eventType,
+			EventID:    fmt.Sprintf("evt-%d", i),
+			Workflow:   workflowName,
+			Payload:    json.RawMessage(`{}`),
+		}); err != nil {
+			t.Fatalf("enqueue: %v", err)
+		}
+	}
+
+	// A new queue over the same directory picks up events left by the first.
+	restarted, err := newWebhookWorkflowQueue(workflowFile, "", []string{"*=notify"}, runner.run, io.Discard)
+	if err != nil {
+		t.Fatalf("newWebhookWorkflowQueue: %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	restarted.start(ctx)
+	runs := waitForWorkflowRuns(t, runner, 2)
+	cancel()
+	restarted.wait()
+
+	if runs[0].workflow != "distribute" || runs[0].params["EVENT_ID"] != "evt-0" || runs[1].workflow != "notify" {
+		t.Fatalf("expected queued events in arrival order, got %+v", runs)
+	}
+	if pending := queueFiles(t, filepath.Join(queue.dir, webhooksServeQueuePendingDir)); len(pending) != 0 {
+		t.Fatalf("expected empty queue, got %v", pending)
+	}
+}
+
+func TestWebhookWorkflowQueueMovesFailedEvents(t *testing.T) {
+	dir := t.TempDir()
+	workflowFile := writeTestWorkflowFile(t, dir)
+	runner := &fakeWorkflowRunner{err: errors.New("step failed")}
+
+	queue, err := newWebhookWorkflowQueue(workflowFile, filepath.Join(dir, "queue"), []string{"*=notify"}, runner.run, io.Discard)
+	if err != nil {
+		t.Fatalf("newWebhookWorkflowQueue: %v", err)
+	}
+	if _, err := queue.enqueue(webhookQueuedEvent{ReceivedAt: time.Now().UTC(), EventID: "evt-fail", Workflow: "notify", Payload: json.RawMessage(`{}`)}); err != nil {
+		t.Fatalf("enqueue: %v", err)
+	}
+	queue.drain(context.Background())
+
+	failed := queueFiles(t, filepath.Join(dir, "queue", webhooksServeQueueFailedDir))
+	if len(failed) != 1 {
+		t.Fatalf("expected one failed event, got %v", failed)
+	}
+	data, err := os.ReadFile(filepath.Join(dir, "queue", webhooksServeQueueFailedDir, failed[0]))
+	if err != nil {
+		t.Fatalf("read failed event: %v", err)
+	}
+	var event webhookQueuedEvent
+	if err := json.Unmarshal(data, &event); err != nil {
+		t.Fatalf("decode failed event: %v", err)
+	}
+	if event.Error != "step failed" || event.RunID != "notify-run-1" || event.EventID != "evt-fail" {
+		t.Fatalf("unexpected failed event: %+v", event)
+	}
+	if pending := queueFiles(t, filepath.Join(dir, "queue", webhooksServeQueuePendingDir)); len(pending) != 0 {
+		t.Fatalf("expected failed event to leave pending, got %v", pending)
+	}
+}
+
+func TestWebhookWorkflowQueueKeepsInterruptedEvents(t *testing.T) {
+	dir := t.TempDir()
+	workflowFile := writeTestWorkflowFile(t, dir)
+	runner := &fakeWorkflowRunner{block: true}
+
+	queue, err := newWebhookWorkflowQueue(workflowFile, "", []string{"*=notify"}, runner.run, io.Discard)
+	if err != nil {
+		t.Fatalf("newWebhookWorkflowQueue: %v", err)
+	}
+	if _, err := queue.enqueue(webhookQueuedEvent{ReceivedAt: time.Now().UTC(), EventID: "evt-slow", Workflow: "notify", Payload: json.RawMessage(`{}`)}); err != nil {
+		t.Fatalf("enqueue: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	queue.start(ctx)
+	waitForWorkflowRuns(t, runner, 1)
+	cancel()
+	queue.wait()
+
+	if pending := queueFiles(t, filepath.Join(queue.dir, webhooksServeQueuePendingDir)); len(pending) != 1 {
+		t.Fatalf("expected interrupted event to stay queued, got %v", pending)
+	}
+	if failed := queueFiles(t, filepath.Join(queue.dir, webhooksServeQueueFailedDir)); len(failed) != 0 {
+		t.Fatalf("expected no failed events, got %v", failed)
+	}
+}
+
+func TestParseWebhookWorkflowRoutesErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		routes  []string
+		wantErr string
+	}{
+		{"missing routes", nil, "--route is required with --workflow-file"},
+		{"malformed route", []string{"BUILD_UPLOAD_STATE_UPDATED"}, "must be EVENT_TYPE=WORKFLOW"},
+		{"empty workflow", []string{"BUILD_UPLOAD_STATE_UPDATED="}, "must be EVENT_TYPE=WORKFLOW"},
+		{"duplicate route", []string{"a=notify", "A=distribute"}, "--route for A is given more than once"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseWebhookWorkflowRoutes(tt.routes)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("expected %q error, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestNewWebhookWorkflowQueueErrors(t *testing.T) {
+	dir := t.TempDir()
+	workflowFile := writeTestWorkflowFile(t, dir)
+	runner := &fakeWorkflowRunner{}
+
+	if _, err := newWebhookWorkflowQueue(workflowFile, "", []string{"A=missing"}, runner.run, io.Discard); !errors.Is(err, flag.ErrHelp) {
+		t.Fatalf("expected usage error for unknown workflow, got %v", err)
+	}
+	if _, err := newWebhookWorkflowQueue(filepath.Join(dir, "missing.json"), "", []string{"*=notify"}, runner.run, io.Discard); err == nil {
+		t.Fatal("expected error for missing workflow file")
+	}
+	if _, err := newWebhookWorkflowQueue(workflowFile, "", []string{"*=notify"}, nil, io.Discard); err == nil {
+		t.Fatal("expected error without a workflow runner")
+	}
+}
+
+func TestWebhookWorkflowParams(t *testing.T) {
+	params, err := webhookWorkflowParams(webhookQueuedEvent{
+		ReceivedAt: time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC),
+		EventType:  "BUILD_UPLOAD_STATE_UPDATED",
+		EventID:    "header-id",
+		Payload:    json.RawMessage(`{"id":"payload-id","data":{"version":2,"ready":true,"tags":["a","b"],"bundle-id":"com.example","note":null}}`),
+	})
+	if err != nil {
+		t.Fatalf("webhookWorkflowParams: %v", err)
+	}
+
+	want := map[string]string{
+		"EVENT_ID":             "header-id",
+		"EVENT_TYPE":           "BUILD_UPLOAD_STATE_UPDATED",
+		"EVENT_RECEIVED_AT":    "2026-03-01T12:00:00Z",
+		"EVENT_DATA_VERSION":   "2",
+		"EVENT_DATA_READY":     "true",
+		"EVENT_DATA_TAGS_0":    "a",
+		"EVENT_DATA_TAGS_1":    "b",
+		"EVENT_DATA_BUNDLE_ID": "com.example",
+	}
+	for key, value := range want {
+		if params[key] != value {
+			t.Fatalf("expected %s=%q, got %q (params %v)", key, value, params[key], params)
+		}
+	}
+	if _, ok := params["EVENT_DATA_NOTE"]; ok {
+		t.Fatalf("expected null payload fields to be skipped, got %v", params)
+	}
+}
+
+func TestWebhooksServeKeepsReceiverLogsOutOfInProcessSteps(t *testing.T) {
+	dir := t.TempDir()
+	workflowFile := filepath.Join(dir, "workflow.json")
+	if err := os.WriteFile(workflowFile, []byte(`{"workflows": {"probe": {"steps": [{"name": "probe", "asc": ["probe"]}]}}}`), 0o600); err != nil {
+		t.Fatalf("write workflow file: %v", err)
+	}
+
+	started := make(chan struct{}, 2)
+	release := make(chan struct{})
+	subcommands := func() []*ffcli.Command {
+		return []*ffcli.Command{{
+			Name:    "probe",
+			FlagSet: flag.NewFlagSet("probe", flag.ContinueOnError),
+			Exec: func(ctx context.Context, _ []string) error {
+				fmt.Fprintln(shared.Stdout(ctx), "probe output")
+				started <- struct{}{}
+				select {
+				case <-release:
+				case <-ctx.Done():
+				}
+				return nil
+			},
+		}}
+	}
+
+	port := freeLocalPort(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	errCh := make(chan error, 1)
+	cmd := WebhooksServeCommand(workflowcli.FileRunner(subcommands))
+	cmd.FlagSet.SetOutput(io.Discard)
+	if err := cmd.Parse([]string{
+		"--host", "127.0.0.1",
+		"--port", fmt.Sprintf("%d", port),
+		"--workflow-file", workflowFile,
+		"--route", "*=probe",
+	}); err != nil {
+		t.Fatalf("parse error: %v", err)
+	}
+	go func() {
+		errCh <- cmd.Run(ctx)
+	}()
+	defer shutdownServeCommand(t, cancel, errCh)
+
+	baseURL := fmt.Sprintf("http://127.0.0.1:%d", port)
+	if status := postJSONWithRetry(t, baseURL, `{"id":"evt-1","eventType":"BUILD_UPLOAD_STATE_UPDATED"}`); status != 202 {
+		t.Fatalf("expected status 202, got %d", status)
+	}
+	select {
+	case <-started:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the asc step to start")
+	}
+	// The receiver logs this event while the first step is running.
+	if status := postJSONWithRetry(t, baseURL, `{"id":"evt-2","eventType":"BUILD_UPLOAD_STATE_UPDATED"}`); status != 202 {
+		t.Fatalf("expected status 202, got %d", status)
+	}
+	close(release)
+
+	pendingDir := filepath.Join(dir, webhooksServeQueueDirName, webhooksServeQueuePendingDir)
+	deadline := time.Now().Add(5 * time.Second)
+	for len(queueFiles(t, pendingDir)) > 0 && time.Now().Before(deadline) {
+		time.Sleep(20 * time.Millisecond)
+	}
+	if pending := queueFiles(t, pendingDir); len(pending) != 0 {
+		t.Fatalf("expected both events to finish, got %v", pending)
+	}
+
+	logs, err := filepath.Glob(filepath.Join(dir, "runs", "logs", "*", "*.log"))
+	if err != nil || len(logs) != 2 {
+		t.Fatalf("expected 2 step logs, got %v (%v)", logs, err)
+	}
+	for _, path := range logs {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatalf("read step log: %v", err)
+		}
+		if !strings.Contains(string(data), "probe output") {
+			t.Fatalf("expected step output in %s, got %q", path, data)
+		}
+		if strings.Contains(string(data), "webhooks serve:") {
+			t.Fatalf("expected receiver logs to stay out of %s, got %q", path, data)
+		}
+	}
+}
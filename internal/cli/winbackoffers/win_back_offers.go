@@ -172,13 +172,13 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("win-back-offers list: %w", err)
 			}
 
 			if strings.TrimSpace(*next) == "" {
-				resolvedAppID := shared.ResolveAppID(strings.TrimSpace(*appID))
+				resolvedAppID := shared.ResolveAppID(ctx, strings.TrimSpace(*appID))
 				if err := shared.RequireAppForStableSelector(resolvedAppID, id, "--subscription-id"); err != nil {
 					return err
 				}
@@ -209,14 +209,12 @@ Examples:
 					return fmt.Errorf("win-back-offers list: failed to fetch: %w", err)
 				}
 
-				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				if err := shared.PrintPaginatedOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetSubscriptionWinBackOffers(ctx, id, asc.WithWinBackOffersNextURL(nextURL))
-				})
-				if err != nil {
+				}, *output.Output, *output.Pretty); err != nil {
 					return fmt.Errorf("win-back-offers list: %w", err)
 				}
-
-				return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+				return nil
 			}
 
 			resp, err := client.GetSubscriptionWinBackOffers(requestCtx, id, opts...)
@@ -224,7 +222,7 @@ Examples:
 				return fmt.Errorf("win-back-offers list: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -424,12 +422,12 @@ Examples:
 				endDateValue = &normalizedEndDate
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("win-back-offers create: %w", err)
 			}
 
-			resolvedAppID := shared.ResolveAppID(strings.TrimSpace(*appID))
+			resolvedAppID := shared.ResolveAppID(ctx, strings.TrimSpace(*appID))
 			if err := shared.RequireAppForStableSelector(resolvedAppID, subscription, "--subscription-id"); err != nil {
 				return err
 			}
@@ -477,7 +475,7 @@ Examples:
 				return fmt.Errorf("win-back-offers create: failed to create: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -600,7 +598,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("win-back-offers update: %w", err)
 			}
@@ -613,7 +611,7 @@ Examples:
 				return fmt.Errorf("win-back-offers update: failed to update: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -647,7 +645,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("win-back-offers delete: %w", err)
 			}
@@ -664,7 +662,7 @@ Examples:
 				Deleted: true,
 			}
 
-			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -738,7 +736,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("win-back-offers prices: %w", err)
 			}
@@ -763,14 +761,12 @@ Examples:
 					return fmt.Errorf("win-back-offers prices: failed to fetch: %w", err)
 				}
 
-				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				if err := shared.PrintPaginatedOutput(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 					return client.GetWinBackOfferPrices(ctx, trimmedID, asc.WithWinBackOfferPricesNextURL(nextURL))
-				})
-				if err != nil {
+				}, *output.Output, *output.Pretty); err != nil {
 					return fmt.Errorf("win-back-offers prices: %w", err)
 				}
-
-				return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+				return nil
 			}
 
 			resp, err := client.GetWinBackOfferPrices(requestCtx, trimmedID, opts...)
@@ -778,7 +774,7 @@ Examples:
 				return fmt.Errorf("win-back-offers prices: failed to fetch: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -844,13 +840,13 @@ Examples:
 				return shared.UsageError("--subscription-id is required")
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("win-back-offers links: %w", err)
 			}
 
 			if strings.TrimSpace(*next) == "" {
-				resolvedAppID := shared.ResolveAppID(strings.TrimSpace(*appID))
+				resolvedAppID := shared.ResolveAppID(ctx, strings.TrimSpace(*appID))
 				if err := shared.RequireAppForStableSelector(resolvedAppID, id, "--subscription-id"); err != nil {
 					return err
 				}
@@ -874,7 +870,7 @@ Examples:
 			}
 
 			if *paginate {
-				resp, err := shared.PaginateWithSpinner(
+				if err := shared.PrintPaginatedOutputWithSpinner(
 					requestCtx,
 					func(ctx context.Context) (asc.PaginatedResponse, error) {
 						return fetchPage(ctx, winBackOffersMaxLimit, *next)
@@ -882,18 +878,19 @@ Examples:
 					func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 						return fetchPage(ctx, 0, nextURL)
 					},
-				)
-				if err != nil {
+					*output.Output,
+					*output.Pretty,
+				); err != nil {
 					return fmt.Errorf("win-back-offers links: %w", err)
 				}
-				return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+				return nil
 			}
 
 			resp, err := fetchPage(requestCtx, *limit, *next)
 			if err != nil {
 				return fmt.Errorf("win-back-offers links: %w", err)
 			}
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
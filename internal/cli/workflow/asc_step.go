@@ -0,0 +1,104 @@
+package workflow
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+	wf "github.com/rudrankriyam/App-Store-Connect-CLI/internal/workflow"
+)
+
+// newInProcessRunner returns a workflow ASCRunner that dispatches asc steps
+// through a fresh command tree built by subcommands, without starting a new
+// process. Each step runs as a shared.Invocation that prints to the step's
+// stdout, reads the step env, and has its own --query/--fields, so parallel
+// steps don't share state. All steps of the run share one authenticated
+// client.
+func newInProcessRunner(subcommands func() []*ffcli.Command) wf.ASCRunner {
+	clients := shared.NewClientCache()
+	return func(ctx context.Context, args []string, env map[string]string, stdout, stderr io.Writer) ([]byte, error) {
+		if subcommands == nil {
+			return nil, fmt.Errorf("asc steps are not available")
+		}
+
+		root := &ffcli.Command{
+			Name:        "asc",
+			FlagSet:     flag.NewFlagSet("asc", flag.ContinueOnError),
+			Subcommands: subcommands(),
+		}
+		if len(args) == 0 || findSubcommand(root, args[0]) == nil {
+			return nil, fmt.Errorf("unknown command %q", firstArg(args))
+		}
+		if args[0] == "workflow" {
+			return nil, fmt.Errorf("asc steps cannot run workflow commands; use a workflow step instead")
+		}
+
+		var printed bytes.Buffer
+		inv := &shared.Invocation{
+			Stdout:  io.MultiWriter(stdout, &printed),
+			Env:     env,
+			Clients: clients,
+		}
+		for _, sub := range root.Subcommands {
+			shared.WrapCommandOutputValidation(sub)
+			inv.BindProjectionFlags(sub)
+		}
+		continueOnFlagErrors(root, stderr)
+
+		if err := root.ParseAndRun(shared.WithInvocation(ctx, inv), args); err != nil {
+			if errors.Is(err, flag.ErrHelp) {
+				return nil, fmt.Errorf("invalid usage of asc %s", args[0])
+			}
+			return nil, err
+		}
+
+		result, ok := inv.Result()
+		if !ok {
+			// Commands that print JSON without PrintOutput still expose it.
+			if trimmed := bytes.TrimSpace(printed.Bytes()); json.Valid(trimmed) {
+				return trimmed, nil
+			}
+			return nil, nil
+		}
+		data, err := json.Marshal(result)
+		if err != nil {
+			return nil, fmt.Errorf("encode command result: %w", err)
+		}
+		return data, nil
+	}
+}
+
+func findSubcommand(cmd *ffcli.Command, name string) *ffcli.Command {
+	for _, sub := range cmd.Subcommands {
+		if sub.Name == name {
+			return sub
+		}
+	}
+	return nil
+}
+
+func firstArg(args []string) string {
+	if len(args) == 0 {
+		return ""
+	}
+	return args[0]
+}
+
+// continueOnFlagErrors makes flag parse errors return instead of exiting the
+// process, and sends usage text to stderr.
+func continueOnFlagErrors(cmd *ffcli.Command, stderr io.Writer) {
+	if cmd.FlagSet != nil {
+		cmd.FlagSet.Init(cmd.FlagSet.Name(), flag.ContinueOnError)
+		cmd.FlagSet.SetOutput(stderr)
+	}
+	for _, sub := range cmd.Subcommands {
+		continueOnFlagErrors(sub, stderr)
+	}
+}
@@ -0,0 +1,71 @@
+package workflow
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+	wf "github.com/rudrankriyam/App-Store-Connect-CLI/internal/workflow"
+)
+
+func workflowGraphCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("workflow graph", flag.ExitOnError)
+	filePath := fs.String("file", wf.DefaultPath, "Path to workflow.json")
+	format := fs.String("format", wf.GraphFormatMermaid, "Graph format: mermaid or dot")
+
+	return &ffcli.Command{
+		Name:       "graph",
+		ShortUsage: "asc workflow graph [flags] [name]",
+		ShortHelp:  "Render workflow step order and calls as Mermaid or DOT.",
+		LongHelp: `Render the workflows in workflow.json as a Mermaid flowchart or a Graphviz
+DOT digraph. Each workflow is drawn as a cluster of its steps in run order,
+parallel groups fan out to their branches following needs, and workflow steps
+link to the workflow they call. Step labels show if conditions and matrix sizes.
+
+Pass a workflow name to draw only that workflow and the workflows it calls.
+Output is diagram text, not JSON.
+
+Examples:
+  asc workflow graph
+  asc workflow graph release
+  asc workflow graph --format dot release | dot -Tsvg -o release.svg`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(_ context.Context, args []string) error {
+			if len(args) > 1 {
+				return shared.UsageErrorf("unexpected argument(s): %s", strings.Join(args[1:], " "))
+			}
+			switch *format {
+			case wf.GraphFormatMermaid, wf.GraphFormatDOT:
+			default:
+				return shared.UsageErrorf("--format must be %s or %s", wf.GraphFormatMermaid, wf.GraphFormatDOT)
+			}
+			root := ""
+			if len(args) == 1 {
+				root = strings.TrimSpace(args[0])
+			}
+
+			absPath, err := filepath.Abs(strings.TrimSpace(*filePath))
+			if err != nil {
+				return fmt.Errorf("workflow graph: resolve path: %w", err)
+			}
+			def, err := wf.LoadUnvalidated(absPath)
+			if err != nil {
+				return fmt.Errorf("workflow graph: %w", err)
+			}
+
+			out, err := wf.RenderGraph(def, root, *format)
+			if err != nil {
+				return fmt.Errorf("workflow graph: %w", err)
+			}
+			_, err = fmt.Fprint(os.Stdout, out)
+			return err
+		},
+	}
+}
@@ -0,0 +1,55 @@
+package workflow
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/auth"
+	wf "github.com/rudrankriyam/App-Store-Connect-CLI/internal/workflow"
+)
+
+// FileRunFunc runs a named workflow from a workflow file with params, streaming
+// step output to output.
+type FileRunFunc func(ctx context.Context, filePath, workflowName string, params map[string]string, output io.Writer) (*wf.RunResult, error)
+
+// FileRunner returns a FileRunFunc that runs workflows the way asc workflow
+// run does: the file is loaded and validated on every call, run state is
+// persisted in the runs directory next to it, and asc steps run in-process
+// through subcommands. Commands that trigger workflows, such as asc webhooks
+// serve, use it.
+func FileRunner(subcommands func() []*ffcli.Command) FileRunFunc {
+	return func(ctx context.Context, filePath, workflowName string, params map[string]string, output io.Writer) (*wf.RunResult, error) {
+		return runFile(ctx, subcommands, filePath, workflowName, params, output)
+	}
+}
+
+func runFile(ctx context.Context, subcommands func() []*ffcli.Command, filePath, workflowName string, params map[string]string, output io.Writer) (*wf.RunResult, error) {
+	absPath, err := filepath.Abs(strings.TrimSpace(filePath))
+	if err != nil {
+		return nil, fmt.Errorf("resolve path: %w", err)
+	}
+	def, err := wf.Load(absPath)
+	if err != nil {
+		return nil, err
+	}
+	stateDir, err := workflowStateDir(absPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return wf.Run(ctx, def, wf.RunOptions{
+		WorkflowName: workflowName,
+		Params:       params,
+		WorkflowFile: absPath,
+		StateDir:     stateDir,
+		ASC:          newInProcessRunner(subcommands),
+		Keychain:     auth.GetSecret,
+		Stdout:       output,
+		Stderr:       output,
+	})
+}
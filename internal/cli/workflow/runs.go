@@ -0,0 +1,327 @@
+package workflow
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+	wf "github.com/rudrankriyam/App-Store-Connect-CLI/internal/workflow"
+)
+
+func workflowRunsCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("workflow runs", flag.ExitOnError)
+
+	return &ffcli.Command{
+		Name:       "runs",
+		ShortUsage: "asc workflow runs <subcommand> [flags]",
+		ShortHelp:  "Inspect persisted workflow runs.",
+		LongHelp: `Inspect the run history that asc workflow run persists in the runs directory
+next to the workflow file: status and timing of each step, and the stdout and
+stderr captured for every executed step.
+
+Examples:
+  asc workflow runs list
+  asc workflow runs list --workflow release --status error
+  asc workflow runs show release-20260312T120000Z-deadbeef
+  asc workflow runs logs release-20260312T120000Z-deadbeef --step upload
+  asc workflow runs prune --older-than 30d --confirm`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			workflowRunsListCommand(),
+			workflowRunsShowCommand(),
+			workflowRunsLogsCommand(),
+			workflowRunsPruneCommand(),
+		},
+		Exec: func(_ context.Context, _ []string) error {
+			return flag.ErrHelp
+		},
+	}
+}
+
+func workflowRunsListCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("workflow runs list", flag.ExitOnError)
+	filePath := fs.String("file", wf.DefaultPath, "Path to workflow.json")
+	workflowName := fs.String("workflow", "", "Only list runs of this workflow")
+	status := fs.String("status", "", "Only list runs with this status: ok, error, running")
+	limit := fs.Int("limit", 0, "Maximum number of runs to list (0 for all)")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "list",
+		ShortUsage: "asc workflow runs list [flags]",
+		ShortHelp:  "List persisted workflow runs, newest first.",
+		LongHelp: `List persisted workflow runs, newest first.
+
+Examples:
+  asc workflow runs list
+  asc workflow runs list --workflow release --limit 5
+  asc workflow runs list --status error`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(_ context.Context, args []string) error {
+			if len(args) > 0 {
+				return shared.UsageErrorf("unexpected argument(s): %s", strings.Join(args, " "))
+			}
+			if *limit < 0 {
+				return shared.UsageError("--limit must be greater than or equal to 0")
+			}
+
+			stateDir, err := workflowStateDir(*filePath)
+			if err != nil {
+				return fmt.Errorf("workflow runs list: %w", err)
+			}
+			runs, err := wf.ListRuns(stateDir)
+			if err != nil {
+				return fmt.Errorf("workflow runs list: %w", err)
+			}
+
+			filtered := make([]wf.RunRecord, 0, len(runs))
+			for _, run := range runs {
+				if name := strings.TrimSpace(*workflowName); name != "" && run.Workflow != name {
+					continue
+				}
+				if s := strings.TrimSpace(*status); s != "" && !strings.EqualFold(run.Status, s) {
+					continue
+				}
+				filtered = append(filtered, run)
+				if *limit > 0 && len(filtered) == *limit {
+					break
+				}
+			}
+			return printJSON(os.Stdout, filtered, *pretty)
+		},
+	}
+}
+
+func workflowRunsShowCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("workflow runs show", flag.ExitOnError)
+	filePath := fs.String("file", wf.DefaultPath, "Path to workflow.json")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "show",
+		ShortUsage: "asc workflow runs show [flags] <run-id>",
+		ShortHelp:  "Show a workflow run with per-step status and timing.",
+		LongHelp: `Show a persisted workflow run, including the status, duration, error, and
+log file of each step.
+
+Examples:
+  asc workflow runs show release-20260312T120000Z-deadbeef
+  asc workflow runs show release-20260312T120000Z-deadbeef --pretty`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(_ context.Context, args []string) error {
+			runID, err := singleRunIDArg(args)
+			if err != nil {
+				return err
+			}
+
+			stateDir, err := workflowStateDir(*filePath)
+			if err != nil {
+				return fmt.Errorf("workflow runs show: %w", err)
+			}
+			run, err := wf.LoadRun(stateDir, runID)
+			if err != nil {
+				return fmt.Errorf("workflow runs show: %w", err)
+			}
+			return printJSON(os.Stdout, run, *pretty)
+		},
+	}
+}
+
+func workflowRunsLogsCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("workflow runs logs", flag.ExitOnError)
+	filePath := fs.String("file", wf.DefaultPath, "Path to workflow.json")
+	stepName := fs.String("step", "", "Only print logs of steps with this name")
+
+	return &ffcli.Command{
+		Name:       "logs",
+		ShortUsage: "asc workflow runs logs [flags] <run-id>",
+		ShortHelp:  "Print the captured output of a workflow run's steps.",
+		LongHelp: `Print the stdout and stderr captured for each executed step of a workflow run,
+in execution order. Each step's log starts with a header line. Logs of steps
+that were retried or rerun with --resume contain every attempt.
+
+Examples:
+  asc workflow runs logs release-20260312T120000Z-deadbeef
+  asc workflow runs logs release-20260312T120000Z-deadbeef --step upload`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(_ context.Context, args []string) error {
+			runID, err := singleRunIDArg(args)
+			if err != nil {
+				return err
+			}
+
+			stateDir, err := workflowStateDir(*filePath)
+			if err != nil {
+				return fmt.Errorf("workflow runs logs: %w", err)
+			}
+			run, err := wf.LoadRun(stateDir, runID)
+			if err != nil {
+				return fmt.Errorf("workflow runs logs: %w", err)
+			}
+
+			name := strings.TrimSpace(*stepName)
+			printed := 0
+			for _, step := range run.Steps {
+				if step.Log == "" || (name != "" && step.Name != name) {
+					continue
+				}
+				data, err := os.ReadFile(step.Log)
+				if err != nil {
+					return fmt.Errorf("workflow runs logs: %w", err)
+				}
+				fmt.Fprintf(os.Stdout, "==> %s (%s) <==\n", stepLogHeader(step), step.Status)
+				_, _ = os.Stdout.Write(data)
+				if len(data) > 0 && data[len(data)-1] != '\n' {
+					fmt.Fprintln(os.Stdout)
+				}
+				printed++
+			}
+			if printed == 0 && name != "" {
+				return fmt.Errorf("workflow runs logs: no logs for step %q in run %q", name, runID)
+			}
+			return nil
+		},
+	}
+}
+
+func workflowRunsPruneCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("workflow runs prune", flag.ExitOnError)
+	filePath := fs.String("file", wf.DefaultPath, "Path to workflow.json")
+	olderThan := fs.String("older-than", "", "Delete runs last updated before a duration ago (e.g., 30d, 2w, 72h) or date (YYYY-MM-DD)")
+	dryRun := fs.Bool("dry-run", false, "List the runs that would be deleted without deleting them")
+	confirm := fs.Bool("confirm", false, "Confirm deletion")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "prune",
+		ShortUsage: "asc workflow runs prune --older-than AGE [flags]",
+		ShortHelp:  "Delete old workflow runs and their logs.",
+		LongHelp: `Delete persisted workflow runs, and their step logs, that were last updated
+before the --older-than cutoff. Pruned runs can no longer be resumed.
+
+Examples:
+  asc workflow runs prune --older-than 30d --dry-run
+  asc workflow runs prune --older-than 30d --confirm
+  asc workflow runs prune --older-than 2026-01-01 --confirm`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(_ context.Context, args []string) error {
+			if len(args) > 0 {
+				return shared.UsageErrorf("unexpected argument(s): %s", strings.Join(args, " "))
+			}
+			if strings.TrimSpace(*olderThan) == "" {
+				return shared.UsageError("--older-than is required")
+			}
+			if !*dryRun && !*confirm {
+				return shared.UsageError("--confirm is required to delete runs")
+			}
+			cutoff, err := parseRunsCutoff(*olderThan, time.Now().UTC())
+			if err != nil {
+				return shared.UsageErrorf("%s", err)
+			}
+
+			stateDir, err := workflowStateDir(*filePath)
+			if err != nil {
+				return fmt.Errorf("workflow runs prune: %w", err)
+			}
+			pruned, err := wf.PruneRuns(stateDir, cutoff, *dryRun)
+			if err != nil {
+				return fmt.Errorf("workflow runs prune: %w", err)
+			}
+
+			type pruneResult struct {
+				DryRun bool           `json:"dry_run,omitempty"`
+				Cutoff string         `json:"cutoff"`
+				Pruned []wf.RunRecord `json:"pruned"`
+			}
+			return printJSON(os.Stdout, pruneResult{
+				DryRun: *dryRun,
+				Cutoff: cutoff.Format(time.RFC3339),
+				Pruned: pruned,
+			}, *pretty)
+		},
+	}
+}
+
+// workflowStateDir returns the runs directory that asc workflow run uses for
+// the given workflow file.
+func workflowStateDir(filePath string) (string, error) {
+	absPath, err := filepath.Abs(strings.TrimSpace(filePath))
+	if err != nil {
+		return "", fmt.Errorf("resolve path: %w", err)
+	}
+	return filepath.Join(filepath.Dir(absPath), "runs"), nil
+}
+
+func singleRunIDArg(args []string) (string, error) {
+	switch {
+	case len(args) == 0 || strings.TrimSpace(args[0]) == "":
+		return "", shared.UsageError("run ID is required")
+	case len(args) > 1:
+		return "", shared.UsageErrorf("unexpected argument(s): %s", strings.Join(args[1:], " "))
+	default:
+		return strings.TrimSpace(args[0]), nil
+	}
+}
+
+func stepLogHeader(step wf.StepResult) string {
+	label := fmt.Sprintf("step %d", step.Index)
+	if step.Branch > 0 {
+		label += fmt.Sprintf(" branch %d", step.Branch)
+	}
+	if step.ParentWorkflow != "" {
+		label = step.ParentWorkflow + " " + label
+	}
+	if step.Name != "" {
+		label += " " + step.Name
+	}
+	if len(step.Matrix) > 0 {
+		pairs := make([]string, 0, len(step.Matrix))
+		for _, key := range slices.Sorted(maps.Keys(step.Matrix)) {
+			pairs = append(pairs, key+"="+step.Matrix[key])
+		}
+		label += " [" + strings.Join(pairs, ",") + "]"
+	}
+	return label
+}
+
+// parseRunsCutoff accepts a date (YYYY-MM-DD), an age in days or weeks
+// (30d, 2w), or a Go duration (72h).
+func parseRunsCutoff(value string, now time.Time) (time.Time, error) {
+	trimmed := strings.ToLower(strings.TrimSpace(value))
+	if parsed, err := time.Parse("2006-01-02", trimmed); err == nil {
+		return parsed, nil
+	}
+
+	invalid := fmt.Errorf("--older-than must be a duration like 30d, 2w, or 72h, or a date like 2026-01-02")
+	if n := len(trimmed); n > 1 && (trimmed[n-1] == 'd' || trimmed[n-1] == 'w') {
+		count, err := strconv.Atoi(trimmed[:n-1])
+		if err != nil || count <= 0 {
+			return time.Time{}, invalid
+		}
+		days := count
+		if trimmed[n-1] == 'w' {
+			days *= 7
+		}
+		return now.AddDate(0, 0, -days), nil
+	}
+	d, err := time.ParseDuration(trimmed)
+	if err != nil || d <= 0 {
+		return time.Time{}, invalid
+	}
+	return now.Add(-d), nil
+}
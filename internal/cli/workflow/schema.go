@@ -0,0 +1,42 @@
+package workflow
+
+import (
+	"context"
+	"flag"
+	"os"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+	wf "github.com/rudrankriyam/App-Store-Connect-CLI/internal/workflow"
+)
+
+func workflowSchemaCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("workflow schema", flag.ExitOnError)
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "schema",
+		ShortUsage: "asc workflow schema [flags]",
+		ShortHelp:  "Print the JSON Schema for workflow.json.",
+		LongHelp: `Print the JSON Schema (draft-07) for .asc/workflow.json, generated from the
+workflow types this asc version loads. Point your editor at it for completion,
+hover docs, and typo checks; asc workflow validate still checks references,
+cycles, and expressions.
+
+Reference it from the file itself with "$schema": "./workflow.schema.json", or
+map it in VS Code settings under "json.schemas".
+
+Examples:
+  asc workflow schema --pretty > .asc/workflow.schema.json`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(_ context.Context, args []string) error {
+			if len(args) > 0 {
+				return shared.UsageErrorf("unexpected argument(s): %s", strings.Join(args, " "))
+			}
+			return printJSON(os.Stdout, wf.JSONSchema(), *pretty)
+		},
+	}
+}
@@ -0,0 +1,139 @@
+package workflow
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/auth"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// maxSecretBytes bounds a secret read from stdin.
+const maxSecretBytes = 1 << 20
+
+var validKeychainSecretName = regexp.MustCompile(`^[A-Za-z0-9_.-]+$`)
+
+func workflowSecretsCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("workflow secrets", flag.ExitOnError)
+
+	return &ffcli.Command{
+		Name:       "secrets",
+		ShortUsage: "asc workflow secrets <subcommand> [flags]",
+		ShortHelp:  "Manage workflow secrets stored in the system keychain.",
+		LongHelp: `Manage secrets that workflow files read with {"keychain": "NAME"}.
+Values are read from stdin so they never appear in shell history or process lists.
+
+Examples:
+  printf '%s' "$SLACK_TOKEN" | asc workflow secrets set SLACK_TOKEN
+  asc workflow secrets set MATCH_PASSWORD < ./match-password.txt
+  asc workflow secrets delete SLACK_TOKEN --confirm`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			workflowSecretsSetCommand(),
+			workflowSecretsDeleteCommand(),
+		},
+		Exec: func(_ context.Context, _ []string) error {
+			return flag.ErrHelp
+		},
+	}
+}
+
+func workflowSecretsSetCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("workflow secrets set", flag.ExitOnError)
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "set",
+		ShortUsage: "asc workflow secrets set [flags] <name> < value",
+		ShortHelp:  "Store a workflow secret read from stdin.",
+		LongHelp: `Store a secret in the system keychain, replacing any previous value.
+The value is read from stdin; one trailing newline is dropped.
+
+Examples:
+  printf '%s' "$SLACK_TOKEN" | asc workflow secrets set SLACK_TOKEN
+  asc workflow secrets set MATCH_PASSWORD < ./match-password.txt`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(_ context.Context, args []string) error {
+			name, err := secretNameArg(args)
+			if err != nil {
+				return err
+			}
+			data, err := io.ReadAll(io.LimitReader(os.Stdin, maxSecretBytes+1))
+			if err != nil {
+				return fmt.Errorf("workflow secrets set: read stdin: %w", err)
+			}
+			if len(data) > maxSecretBytes {
+				return shared.UsageErrorf("secret value must be at most %d bytes", maxSecretBytes)
+			}
+			value := strings.TrimSuffix(strings.TrimSuffix(string(data), "\n"), "\r")
+			if value == "" {
+				return shared.UsageError("secret value is required on stdin")
+			}
+			if err := auth.StoreSecret(name, value); err != nil {
+				return fmt.Errorf("workflow secrets set: %w", err)
+			}
+			return printJSON(os.Stdout, struct {
+				Name   string `json:"name"`
+				Stored bool   `json:"stored"`
+			}{Name: name, Stored: true}, *pretty)
+		},
+	}
+}
+
+func workflowSecretsDeleteCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("workflow secrets delete", flag.ExitOnError)
+	confirm := fs.Bool("confirm", false, "Confirm deletion")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "delete",
+		ShortUsage: "asc workflow secrets delete [flags] <name>",
+		ShortHelp:  "Delete a workflow secret from the system keychain.",
+		LongHelp: `Delete a secret from the system keychain. Workflows that read it will fail
+until it is set again.
+
+Examples:
+  asc workflow secrets delete SLACK_TOKEN --confirm`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(_ context.Context, args []string) error {
+			name, err := secretNameArg(args)
+			if err != nil {
+				return err
+			}
+			if !*confirm {
+				return shared.UsageError("--confirm is required to delete a secret")
+			}
+			if err := auth.RemoveSecret(name); err != nil {
+				return fmt.Errorf("workflow secrets delete: %w", err)
+			}
+			return printJSON(os.Stdout, struct {
+				Name    string `json:"name"`
+				Deleted bool   `json:"deleted"`
+			}{Name: name, Deleted: true}, *pretty)
+		},
+	}
+}
+
+func secretNameArg(args []string) (string, error) {
+	switch {
+	case len(args) == 0 || strings.TrimSpace(args[0]) == "":
+		return "", shared.UsageError("secret name is required")
+	case len(args) > 1:
+		return "", shared.UsageErrorf("unexpected argument(s): %s", strings.Join(args[1:], " "))
+	}
+	name := strings.TrimSpace(args[0])
+	if !validKeychainSecretName.MatchString(name) {
+		return "", shared.UsageErrorf("secret name %q may only contain letters, digits, dots, hyphens, and underscores", name)
+	}
+	return name, nil
+}
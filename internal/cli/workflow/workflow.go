@@ -13,12 +13,14 @@ import (
 
 	"github.com/peterbourgon/ff/v3/ffcli"
 
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/auth"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
 	wf "github.com/rudrankriyam/App-Store-Connect-CLI/internal/workflow"
 )
 
 // WorkflowCommand returns the top-level workflow command group.
-func WorkflowCommand() *ffcli.Command {
+// subcommands builds the root command tree used to run "asc" steps in-process.
+func WorkflowCommand(subcommands func() []*ffcli.Command) *ffcli.Command {
 	fs := flag.NewFlagSet("workflow", flag.ExitOnError)
 
 	return &ffcli.Command{
@@ -38,17 +40,26 @@ Security note:
   Only run workflow files you trust (especially when using --file).
   Treat .asc/workflow.json like code: review it before running.
   Be careful with --file: it can point to any path, not just .asc/workflow.json.
-  Steps inherit your process environment; be careful with secrets.
-  Declared step outputs are persisted in the run-state file; do not map secrets into outputs.
+  Steps inherit your process environment; declare credentials under "secrets" so they are masked.
+  Declared step outputs are persisted in the run-state file; outputs containing a declared secret are masked.
   In CI, avoid running workflows on untrusted PRs with secrets/tokens.
   asc workflow validate checks structure, not safety of commands.
 
 Tips:
   Use asc workflow validate before running a new workflow file.
+  Save asc workflow schema output next to the file and add "$schema" for editor completion.
+  Visualize step order and calls with asc workflow graph (Mermaid) or --format dot.
   Preview the plan with asc workflow run --dry-run <name>.
   Run-step outputs can be referenced later as ${steps.resolve_build.BUILD_ID}.
   Output-producing step names only need to stay unique across workflows that can execute together in the same run graph.
   For asc commands that declare outputs, usually pass --output json.
+  Steps written as "asc": ["builds", "info", "--app", "$APP_ID", "--latest"] run in-process and
+  extract outputs from the command result without --output json.
+  Add "matrix": {"app": ["123", "456"]} to run a step once per value, using ${{ matrix.app }}.
+  Add "imports": {"lib": "../release-workflows/v2"} to share workflows across repos; call them as "lib.release".
+  Add "secrets": {"SLACK_TOKEN": {"keychain": "slack-token"}} for tokens; store them with asc workflow secrets set.
+  Conditions like "if": "steps.status.outputs.state == 'VALID' && params.TRACK != 'internal'" are type-checked by validate;
+  "if": "always()" or "failure()" keeps a cleanup step running after an earlier step fails.
   A proven local Xcode -> TestFlight shape is: asc builds next-build-number --app $APP_ID -> asc xcode archive -> asc xcode export -> asc publish testflight --group ... --wait.
 
 Example workflow file (.asc/workflow.json):
@@ -129,13 +140,21 @@ Examples:
   asc workflow run beta SUBMIT_BETA:true
   asc workflow run release VERSION:2.1.0
   asc workflow run --dry-run beta
-  asc workflow run release --resume beta-20260312T120000Z-deadbeef`,
+  asc workflow run release --resume beta-20260312T120000Z-deadbeef
+  asc workflow graph release
+  asc workflow schema --pretty > .asc/workflow.schema.json
+  asc workflow runs list
+  printf '%s' "$SLACK_TOKEN" | asc workflow secrets set slack-token`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
-			workflowRunCommand(),
+			workflowRunCommand(subcommands),
 			workflowValidateCommand(),
 			workflowListCommand(),
+			workflowGraphCommand(),
+			workflowSchemaCommand(),
+			workflowRunsCommand(),
+			workflowSecretsCommand(),
 		},
 		Exec: func(_ context.Context, _ []string) error {
 			return flag.ErrHelp
@@ -143,7 +162,7 @@ Examples:
 	}
 }
 
-func workflowRunCommand() *ffcli.Command {
+func workflowRunCommand(subcommands func() []*ffcli.Command) *ffcli.Command {
 	fs := flag.NewFlagSet("workflow run", flag.ExitOnError)
 	filePath := fs.String("file", wf.DefaultPath, "Path to workflow.json")
 	dryRun := fs.Bool("dry-run", false, "Preview steps without executing")
@@ -161,15 +180,18 @@ Use --resume with the emitted run ID to continue a partially completed run witho
 rerunning already-persisted successful steps.
 Resume automatically reuses the original workflow file, saved params, and persisted outputs.
 Do not pass extra KEY:VALUE params with --resume.
+Each step's status, timing, and stdout/stderr are kept with the run; inspect them
+with asc workflow runs.
 If a step declares "outputs", the command must emit JSON on stdout; for asc commands,
-usually pass --output json.
+usually pass --output json. "asc" steps run in-process and take outputs from the
+command result instead.
 stdout stays machine-parseable JSON even on failure; step and hook output streams to stderr.
 
 Security note:
   Workflows intentionally execute arbitrary shell commands.
   Only run workflow files you trust (especially when using --file).
   In CI, avoid running workflows on untrusted PRs with secrets/tokens.
-  Declared step outputs are persisted in the run-state file; do not map secrets into outputs.
+  Declared step outputs are persisted in the run-state file; outputs containing a declared secret are masked.
 
 Tip: See "asc workflow --help" for a complete workflow.json example and file format tips.
 
@@ -212,7 +234,10 @@ Examples:
 				return shared.UsageError("resume runs do not accept additional KEY:VALUE parameters")
 			}
 
-			stateDir := filepath.Join(filepath.Dir(absPath), "runs")
+			stateDir, err := workflowStateDir(absPath)
+			if err != nil {
+				return fmt.Errorf("workflow run: %w", err)
+			}
 
 			result, err := wf.Run(ctx, def, wf.RunOptions{
 				WorkflowName: workflowName,
@@ -221,6 +246,8 @@ Examples:
 				WorkflowFile: absPath,
 				StateDir:     stateDir,
 				ResumeRunID:  strings.TrimSpace(*resume),
+				ASC:          newInProcessRunner(subcommands),
+				Keychain:     auth.GetSecret,
 				// Keep stdout machine-parseable JSON; stream step output to stderr.
 				Stdout: os.Stderr,
 				Stderr: os.Stderr,
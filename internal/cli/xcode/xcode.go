@@ -145,7 +145,7 @@ Examples:
 				return fmt.Errorf("xcode archive: %w", err)
 			}
 
-			return shared.PrintOutputWithRenderers(
+			return shared.PrintOutputWithRenderers(ctx,
 				result,
 				*output.Output,
 				*output.Pretty,
@@ -250,7 +250,7 @@ Examples:
 				if strings.TrimSpace(result.Version) == "" || strings.TrimSpace(result.BuildNumber) == "" {
 					return fmt.Errorf("xcode export: exported archive is missing version/build metadata required for --wait")
 				}
-				client, err := getASCClientFn()
+				client, err := getASCClientFn(ctx)
 				if err != nil {
 					return fmt.Errorf("xcode export: %w", err)
 				}
@@ -292,7 +292,7 @@ Examples:
 				commandResult.ProcessingState = strings.ToUpper(strings.TrimSpace(buildResp.Data.Attributes.ProcessingState))
 			}
 
-			return shared.PrintOutputWithRenderers(
+			return shared.PrintOutputWithRenderers(ctx,
 				commandResult,
 				*output.Output,
 				*output.Pretty,
@@ -362,7 +362,7 @@ Examples:
 				return fmt.Errorf("xcode validate: %w", err)
 			}
 
-			return shared.PrintOutputWithRenderers(
+			return shared.PrintOutputWithRenderers(ctx,
 				result,
 				*output.Output,
 				*output.Pretty,
@@ -262,7 +262,7 @@ func TestXcodeExportWaitPollsForUploadedBuild(t *testing.T) {
 		}, nil
 	}
 	inferArchivePlatformFn = func(string) (string, error) { return "IOS", nil }
-	getASCClientFn = func() (*asc.Client, error) { return &asc.Client{}, nil }
+	getASCClientFn = func(context.Context) (*asc.Client, error) { return &asc.Client{}, nil }
 	resolveAppIDWithExactLookupFn = func(_ context.Context, _ *asc.Client, app string) (string, error) {
 		if app != "com.example.demo" {
 			t.Fatalf("expected bundle ID app lookup, got %q", app)
@@ -396,7 +396,7 @@ func TestXcodeExportWaitRejectsNilProcessedBuildResponse(t *testing.T) {
 		}, nil
 	}
 	inferArchivePlatformFn = func(string) (string, error) { return "IOS", nil }
-	getASCClientFn = func() (*asc.Client, error) { return &asc.Client{}, nil }
+	getASCClientFn = func(context.Context) (*asc.Client, error) { return &asc.Client{}, nil }
 	resolveAppIDWithExactLookupFn = func(context.Context, *asc.Client, string) (string, error) {
 		return "123456789", nil
 	}
@@ -452,7 +452,7 @@ func TestXcodeExportWaitRejectsMissingBuildUploadID(t *testing.T) {
 		}, nil
 	}
 	inferArchivePlatformFn = func(string) (string, error) { return "IOS", nil }
-	getASCClientFn = func() (*asc.Client, error) { return &asc.Client{}, nil }
+	getASCClientFn = func(context.Context) (*asc.Client, error) { return &asc.Client{}, nil }
 	resolveAppIDWithExactLookupFn = func(context.Context, *asc.Client, string) (string, error) {
 		return "123456789", nil
 	}
@@ -90,7 +90,7 @@ func xcodeVersionViewCommand() *ffcli.Command {
 				return fmt.Errorf("xcode version view: %w", err)
 			}
 
-			return shared.PrintOutputWithRenderers(
+			return shared.PrintOutputWithRenderers(ctx,
 				result,
 				*output.Output,
 				*output.Pretty,
@@ -143,7 +143,7 @@ func xcodeVersionEditCommand() *ffcli.Command {
 				return fmt.Errorf("xcode version edit: %w", err)
 			}
 
-			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -203,7 +203,7 @@ Examples:
 				return fmt.Errorf("xcode version bump: %w", err)
 			}
 
-			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 		},
 	}
 }
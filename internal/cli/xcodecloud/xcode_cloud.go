@@ -17,7 +17,7 @@ import (
 const xcodeCloudAppFlagUsage = "App Store Connect app ID, bundle ID, or exact app name (or ASC_APP_ID env)"
 
 func resolveXcodeCloudAppID(ctx context.Context, client *asc.Client, appID string) (string, error) {
-	resolvedAppID := shared.ResolveAppID(appID)
+	resolvedAppID := shared.ResolveAppID(ctx, appID)
 	if resolvedAppID == "" {
 		return "", nil
 	}
@@ -150,13 +150,13 @@ Examples:
 				return shared.UsageError("--poll-interval must be greater than 0")
 			}
 
-			resolvedAppID := shared.ResolveAppID(*appID)
+			resolvedAppID := shared.ResolveAppID(ctx, *appID)
 			if hasWorkflowName && !hasSourceRunID && resolvedAppID == "" {
 				fmt.Fprintln(os.Stderr, "Error: --app is required when using --workflow (or set ASC_APP_ID)")
 				return flag.ErrHelp
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("xcode-cloud run: %w", err)
 			}
@@ -306,7 +306,7 @@ Examples:
 			}
 
 			if !*wait {
-				return shared.PrintOutput(result, *output.Output, *output.Pretty)
+				return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 			}
 
 			// Wait for completion
@@ -350,7 +350,7 @@ Examples:
 				return shared.UsageError("--poll-interval must be greater than 0")
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("xcode-cloud status: %w", err)
 			}
@@ -369,7 +369,7 @@ Examples:
 			}
 
 			result := buildStatusResult(resp)
-			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, result, *output.Output, *output.Pretty)
 		},
 	}
 }
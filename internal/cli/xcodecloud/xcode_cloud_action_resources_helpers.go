@@ -297,7 +297,7 @@ func runXcodeCloudActionResourceList(
 		return shared.UsageError("--next is not supported with --run-id; use --action-id with a links.next URL")
 	}
 
-	client, err := shared.GetASCClient()
+	client, err := shared.GetASCClient(ctx)
 	if err != nil {
 		return fmt.Errorf("%s: %w", errorPrefix, err)
 	}
@@ -310,7 +310,7 @@ func runXcodeCloudActionResourceList(
 		if err != nil {
 			return fmt.Errorf("%s: %w", errorPrefix, err)
 		}
-		return shared.PrintOutput(resp, output, pretty)
+		return shared.PrintOutput(ctx, resp, output, pretty)
 	}
 
 	if nextURL == "" && resolvedActionID == "" {
@@ -324,7 +324,7 @@ func runXcodeCloudActionResourceList(
 	}
 
 	if paginate {
-		resp, err := shared.PaginateWithSpinner(
+		if err := shared.PrintPaginatedOutputWithSpinner(
 			requestCtx,
 			func(ctx context.Context) (asc.PaginatedResponse, error) {
 				return fetchPage(ctx, client, resolvedActionID, 200, nextURL)
@@ -332,11 +332,12 @@ func runXcodeCloudActionResourceList(
 			func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 				return fetchPage(ctx, client, resolvedActionID, 0, nextURL)
 			},
-		)
-		if err != nil {
+			output,
+			pretty,
+		); err != nil {
 			return fmt.Errorf("%s: %w", errorPrefix, err)
 		}
-		return shared.PrintOutput(resp, output, pretty)
+		return nil
 	}
 
 	resp, err := fetchPage(requestCtx, client, resolvedActionID, limit, nextURL)
@@ -344,7 +345,7 @@ func runXcodeCloudActionResourceList(
 		return fmt.Errorf("%s: %w", errorPrefix, err)
 	}
 
-	return shared.PrintOutput(resp, output, pretty)
+	return shared.PrintOutput(ctx, resp, output, pretty)
 }
 
 func resolveSingleBuildActionIDForRun(ctx context.Context, client *asc.Client, runID string) (string, error) {
@@ -273,7 +273,7 @@ func xcodeCloudProductsList(ctx context.Context, appID string, limit int, next s
 		return fmt.Errorf("xcode-cloud products: %w", err)
 	}
 
-	resolvedAppID := shared.ResolveAppID(appID)
+	resolvedAppID := shared.ResolveAppID(ctx, appID)
 	opts := []asc.CiProductsOption{
 		asc.WithCiProductsLimit(limit),
 		asc.WithCiProductsNextURL(next),
@@ -282,7 +282,7 @@ func xcodeCloudProductsList(ctx context.Context, appID string, limit int, next s
 		opts = append(opts, asc.WithCiProductsAppID(resolvedAppID))
 	}
 
-	client, err := shared.GetASCClient()
+	client, err := shared.GetASCClient(ctx)
 	if err != nil {
 		return fmt.Errorf("xcode-cloud products: %w", err)
 	}
@@ -304,30 +304,33 @@ func xcodeCloudProductsList(ctx context.Context, appID string, limit int, next s
 
 	if paginate {
 		paginateOpts := append(opts, asc.WithCiProductsLimit(200))
-		paginatedResp, err := shared.PaginateWithSpinner(
+		hydratePage := func(page asc.PaginatedResponse, err error) (asc.PaginatedResponse, error) {
+			if err != nil || !shouldHydrateCiProductBundleIDs(output) {
+				return page, err
+			}
+			resp, ok := page.(*asc.CiProductsResponse)
+			if !ok {
+				return nil, fmt.Errorf("unexpected response type %T", page)
+			}
+			if err := hydrateCiProductBundleIDs(requestCtx, client, resp); err != nil {
+				return nil, err
+			}
+			return resp, nil
+		}
+		if err := shared.PrintPaginatedOutputWithSpinner(
 			requestCtx,
 			func(ctx context.Context) (asc.PaginatedResponse, error) {
-				return client.GetCiProducts(ctx, paginateOpts...)
+				return hydratePage(client.GetCiProducts(ctx, paginateOpts...))
 			},
 			func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
-				return client.GetCiProducts(ctx, asc.WithCiProductsNextURL(nextURL))
+				return hydratePage(client.GetCiProducts(ctx, asc.WithCiProductsNextURL(nextURL)))
 			},
-		)
-		if err != nil {
+			output,
+			pretty,
+		); err != nil {
 			return fmt.Errorf("xcode-cloud products: %w", err)
 		}
-
-		resp, ok := paginatedResp.(*asc.CiProductsResponse)
-		if !ok {
-			return fmt.Errorf("xcode-cloud products: unexpected response type %T", paginatedResp)
-		}
-		if shouldHydrateCiProductBundleIDs(output) {
-			if err := hydrateCiProductBundleIDs(requestCtx, client, resp); err != nil {
-				return fmt.Errorf("xcode-cloud products: %w", err)
-			}
-		}
-
-		return shared.PrintOutput(resp, output, pretty)
+		return nil
 	}
 
 	resp, err := client.GetCiProducts(requestCtx, opts...)
@@ -340,7 +343,7 @@ func xcodeCloudProductsList(ctx context.Context, appID string, limit int, next s
 		}
 	}
 
-	return shared.PrintOutput(resp, output, pretty)
+	return shared.PrintOutput(ctx, resp, output, pretty)
 }
 
 func shouldHydrateCiProductBundleIDs(output string) bool {
@@ -24,7 +24,7 @@ func waitForBuildCompletion(ctx context.Context, client *asc.Client, buildRunID
 
 		if asc.IsBuildRunComplete(resp.Data.Attributes.ExecutionProgress) {
 			result := buildStatusResult(resp)
-			if err := shared.PrintOutput(result, outputFormat, pretty); err != nil {
+			if err := shared.PrintOutput(ctx, result, outputFormat, pretty); err != nil {
 				return struct{}{}, false, err
 			}
 
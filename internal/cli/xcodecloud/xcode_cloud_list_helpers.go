@@ -31,7 +31,7 @@ func runXcodeCloudPaginatedList(
 		return shared.UsageError(fmt.Sprintf("%s: %s", errorPrefix, err.Error()))
 	}
 
-	client, err := shared.GetASCClient()
+	client, err := shared.GetASCClient(ctx)
 	if err != nil {
 		return fmt.Errorf("%s: %w", errorPrefix, err)
 	}
@@ -40,7 +40,7 @@ func runXcodeCloudPaginatedList(
 	defer cancel()
 
 	if paginate {
-		resp, err := shared.PaginateWithSpinner(
+		if err := shared.PrintPaginatedOutputWithSpinner(
 			requestCtx,
 			func(ctx context.Context) (asc.PaginatedResponse, error) {
 				return fetchPage(ctx, client, 200, nextURL)
@@ -48,11 +48,12 @@ func runXcodeCloudPaginatedList(
 			func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 				return fetchNextPage(ctx, client, nextURL)
 			},
-		)
-		if err != nil {
+			output,
+			pretty,
+		); err != nil {
 			return fmt.Errorf("%s: %w", errorPrefix, err)
 		}
-		return shared.PrintOutput(resp, output, pretty)
+		return nil
 	}
 
 	resp, err := fetchPage(requestCtx, client, limit, nextURL)
@@ -60,7 +61,7 @@ func runXcodeCloudPaginatedList(
 		return fmt.Errorf("%s: %w", errorPrefix, err)
 	}
 
-	return shared.PrintOutput(resp, output, pretty)
+	return shared.PrintOutput(ctx, resp, output, pretty)
 }
 
 func runXcodeCloudPaginatedParentList(
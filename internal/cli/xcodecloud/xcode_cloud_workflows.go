@@ -160,7 +160,7 @@ Examples:
 				return fmt.Errorf("xcode-cloud workflows create: %w", err)
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("xcode-cloud workflows create: %w", err)
 			}
@@ -173,7 +173,7 @@ Examples:
 				return fmt.Errorf("xcode-cloud workflows create: failed to create: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -212,7 +212,7 @@ Examples:
 				return fmt.Errorf("xcode-cloud workflows update: %w", err)
 			}
 
-			client, err := shared.GetASCClient()
+			client, err := shared.GetASCClient(ctx)
 			if err != nil {
 				return fmt.Errorf("xcode-cloud workflows update: %w", err)
 			}
@@ -225,7 +225,7 @@ Examples:
 				return fmt.Errorf("xcode-cloud workflows update: failed to update: %w", err)
 			}
 
-			return shared.PrintOutput(resp, *output.Output, *output.Pretty)
+			return shared.PrintOutput(ctx, resp, *output.Output, *output.Pretty)
 		},
 	}
 }
@@ -267,13 +267,13 @@ func xcodeCloudWorkflowsList(ctx context.Context, appID string, limit int, next
 		return fmt.Errorf("xcode-cloud workflows: %w", err)
 	}
 
-	resolvedAppID := shared.ResolveAppID(appID)
+	resolvedAppID := shared.ResolveAppID(ctx, appID)
 	if resolvedAppID == "" && nextURL == "" {
 		fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
 		return flag.ErrHelp
 	}
 
-	client, err := shared.GetASCClient()
+	client, err := shared.GetASCClient(ctx)
 	if err != nil {
 		return fmt.Errorf("xcode-cloud workflows: %w", err)
 	}
@@ -302,7 +302,7 @@ func xcodeCloudWorkflowsList(ctx context.Context, appID string, limit int, next
 
 	if paginate {
 		paginateOpts := append(opts, asc.WithCiWorkflowsLimit(200))
-		resp, err := shared.PaginateWithSpinner(
+		if err := shared.PrintPaginatedOutputWithSpinner(
 			requestCtx,
 			func(ctx context.Context) (asc.PaginatedResponse, error) {
 				return client.GetCiWorkflows(ctx, productID, paginateOpts...)
@@ -310,12 +310,12 @@ func xcodeCloudWorkflowsList(ctx context.Context, appID string, limit int, next
 			func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
 				return client.GetCiWorkflows(ctx, productID, asc.WithCiWorkflowsNextURL(nextURL))
 			},
-		)
-		if err != nil {
+			output,
+			pretty,
+		); err != nil {
 			return fmt.Errorf("xcode-cloud workflows: %w", err)
 		}
-
-		return shared.PrintOutput(resp, output, pretty)
+		return nil
 	}
 
 	resp, err := client.GetCiWorkflows(requestCtx, productID, opts...)
@@ -323,5 +323,5 @@ func xcodeCloudWorkflowsList(ctx context.Context, appID string, limit int, next
 		return fmt.Errorf("xcode-cloud workflows: %w", err)
 	}
 
-	return shared.PrintOutput(resp, output, pretty)
+	return shared.PrintOutput(ctx, resp, output, pretty)
 }
@@ -1,9 +1,13 @@
 package config
 
 import (
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net"
+	"net/url"
 	"os"
 	"path/filepath"
 	"strconv"
@@ -149,6 +153,137 @@ type Config struct {
 	MaxDelay             string        `json:"max_delay"`
 	RetryLog             string        `json:"retry_log"`
 	Debug                string        `json:"debug"`
+	LogFormat            string        `json:"log_format,omitempty"`
+	LogFile              string        `json:"log_file,omitempty"`
+	CacheTTL             DurationValue `json:"cache_ttl"`
+
+	RateLimitWarnThreshold string `json:"rate_limit_warn_threshold"`
+	RateLimitShared        string `json:"rate_limit_shared"`
+
+	// Network holds the default API endpoint and transport settings;
+	// Profiles overrides them per credential name.
+	Network
+	Profiles map[string]Network `json:"profiles,omitempty"`
+}
+
+// Network holds API endpoint and transport settings for outbound requests.
+type Network struct {
+	BaseURL    string `json:"base_url,omitempty"`
+	ProxyURL   string `json:"proxy_url,omitempty"`
+	CABundle   string `json:"ca_bundle,omitempty"`
+	ClientCert string `json:"client_cert,omitempty"`
+	ClientKey  string `json:"client_key,omitempty"`
+}
+
+// Merge returns n with every non-empty field of override applied on top.
+func (n Network) Merge(override Network) Network {
+	if value := strings.TrimSpace(override.BaseURL); value != "" {
+		n.BaseURL = value
+	}
+	if value := strings.TrimSpace(override.ProxyURL); value != "" {
+		n.ProxyURL = value
+	}
+	if value := strings.TrimSpace(override.CABundle); value != "" {
+		n.CABundle = value
+	}
+	if value := strings.TrimSpace(override.ClientCert); value != "" {
+		n.ClientCert = value
+	}
+	if value := strings.TrimSpace(override.ClientKey); value != "" {
+		n.ClientKey = value
+	}
+	return n
+}
+
+// NetworkForProfile returns the network settings for a profile: the
+// top-level settings overlaid with profiles[name].
+func (c *Config) NetworkForProfile(name string) Network {
+	if c == nil {
+		return Network{}
+	}
+	network := Network{}.Merge(c.Network)
+	if profile, ok := c.Profiles[strings.TrimSpace(name)]; ok {
+		network = network.Merge(profile)
+	}
+	return network
+}
+
+// Validate checks that network settings are well-formed. It does not read
+// the referenced certificate files.
+func (n Network) Validate() error {
+	if raw := strings.TrimSpace(n.BaseURL); raw != "" {
+		parsed, err := url.Parse(raw)
+		if err != nil || parsed.Host == "" || (parsed.Scheme != "https" && parsed.Scheme != "http") {
+			return fmt.Errorf("base_url must be an absolute http(s) URL")
+		}
+		if parsed.Scheme == "http" && !IsLoopbackHost(parsed.Hostname()) {
+			return fmt.Errorf("base_url must use https unless the host is loopback")
+		}
+		if parsed.RawQuery != "" || parsed.Fragment != "" || parsed.User != nil {
+			return fmt.Errorf("base_url must not include credentials, a query, or a fragment")
+		}
+	}
+	if raw := strings.TrimSpace(n.ProxyURL); raw != "" {
+		parsed, err := url.Parse(raw)
+		if err != nil || parsed.Host == "" {
+			return fmt.Errorf("proxy_url must be an absolute URL")
+		}
+		switch parsed.Scheme {
+		case "http", "https", "socks5":
+		default:
+			return fmt.Errorf("proxy_url scheme must be http, https, or socks5")
+		}
+	}
+	if (strings.TrimSpace(n.ClientCert) == "") != (strings.TrimSpace(n.ClientKey) == "") {
+		return fmt.Errorf("client_cert and client_key must be set together")
+	}
+	return nil
+}
+
+// TLSConfig returns a copy of base (which may be nil) with the CA bundle and
+// client certificate applied. It returns base unchanged when neither is set.
+func (n Network) TLSConfig(base *tls.Config) (*tls.Config, error) {
+	caBundle := strings.TrimSpace(n.CABundle)
+	clientCert := strings.TrimSpace(n.ClientCert)
+	if caBundle == "" && clientCert == "" {
+		return base, nil
+	}
+
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if base != nil {
+		tlsConfig = base.Clone()
+	}
+	if caBundle != "" {
+		data, err := os.ReadFile(caBundle)
+		if err != nil {
+			return nil, fmt.Errorf("ca_bundle: %w", err)
+		}
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(data) {
+			return nil, fmt.Errorf("ca_bundle: no PEM certificates found in %s", caBundle)
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if clientCert != "" {
+		cert, err := tls.LoadX509KeyPair(clientCert, strings.TrimSpace(n.ClientKey))
+		if err != nil {
+			return nil, fmt.Errorf("client_cert: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return tlsConfig, nil
+}
+
+// IsLoopbackHost reports whether host is localhost or a loopback IP.
+func IsLoopbackHost(host string) bool {
+	if strings.EqualFold(host, "localhost") {
+		return true
+	}
+	ip := net.ParseIP(strings.Trim(host, "[]"))
+	return ip != nil && ip.IsLoopback()
 }
 
 // ErrNotFound is returned when the config file doesn't exist
@@ -342,9 +477,18 @@ func (c *Config) Validate() error {
 	if err := validateDurationValue("upload_timeout_seconds", c.UploadTimeoutSeconds); err != nil {
 		return wrapInvalidConfig(err)
 	}
+	if err := validateDurationValue("cache_ttl", c.CacheTTL); err != nil {
+		return wrapInvalidConfig(err)
+	}
 	if err := validateMaxRetries(c.MaxRetries); err != nil {
 		return wrapInvalidConfig(err)
 	}
+	if err := validateRateLimitWarnThreshold(c.RateLimitWarnThreshold); err != nil {
+		return wrapInvalidConfig(err)
+	}
+	if err := validateLogFormat(c.LogFormat); err != nil {
+		return wrapInvalidConfig(err)
+	}
 
 	baseDelay, baseSet, err := parseOptionalDuration("base_delay", c.BaseDelay)
 	if err != nil {
@@ -357,6 +501,14 @@ func (c *Config) Validate() error {
 	if baseSet && maxSet && maxDelay < baseDelay {
 		return wrapInvalidConfig(fmt.Errorf("max_delay must be >= base_delay"))
 	}
+	if err := c.Network.Validate(); err != nil {
+		return wrapInvalidConfig(err)
+	}
+	for name, profile := range c.Profiles {
+		if err := profile.Validate(); err != nil {
+			return wrapInvalidConfig(fmt.Errorf("profiles.%s: %w", name, err))
+		}
+	}
 	return nil
 }
 
@@ -394,6 +546,33 @@ func validateMaxRetries(raw string) error {
 	return nil
 }
 
+func validateRateLimitWarnThreshold(raw string) error {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return nil
+	}
+	if percent, ok := strings.CutSuffix(raw, "%"); ok {
+		parsed, err := strconv.ParseFloat(strings.TrimSpace(percent), 64)
+		if err != nil || parsed < 0 || parsed > 100 {
+			return fmt.Errorf("rate_limit_warn_threshold percentage must be between 0%% and 100%%")
+		}
+		return nil
+	}
+	if parsed, err := strconv.Atoi(raw); err != nil || parsed < 0 {
+		return fmt.Errorf("rate_limit_warn_threshold must be a non-negative integer or percentage")
+	}
+	return nil
+}
+
+func validateLogFormat(raw string) error {
+	switch strings.ToLower(strings.TrimSpace(raw)) {
+	case "", "text", "json":
+		return nil
+	default:
+		return fmt.Errorf("log_format must be text or json")
+	}
+}
+
 func parseOptionalDuration(field, raw string) (time.Duration, bool, error) {
 	raw = strings.TrimSpace(raw)
 	if raw == "" {
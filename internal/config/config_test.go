@@ -4,6 +4,7 @@ import (
 	"errors"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -454,3 +455,88 @@ func TestLoadAtRejectsMaxDelayBelowBaseDelay(t *testing.T) {
 		t.Fatalf("expected ErrInvalidConfig, got %v", err)
 	}
 }
+
+func TestLoadAtRejectsInvalidProfileNetwork(t *testing.T) {
+	tempDir := t.TempDir()
+	path := filepath.Join(tempDir, "config.json")
+	cfg := &Config{
+		Profiles: map[string]Network{
+			"corp": {ClientCert: "/etc/asc/client.pem"},
+		},
+	}
+	if err := SaveAt(path, cfg); err != nil {
+		t.Fatalf("SaveAt() error: %v", err)
+	}
+
+	_, err := LoadAt(path)
+	if err == nil {
+		t.Fatal("expected error for client_cert without client_key, got nil")
+	}
+	if !errors.Is(err, ErrInvalidConfig) || !strings.Contains(err.Error(), "profiles.corp") {
+		t.Fatalf("expected ErrInvalidConfig for profiles.corp, got %v", err)
+	}
+}
+
+func TestLoadAtRejectsInvalidLogFormat(t *testing.T) {
+	tempDir := t.TempDir()
+	path := filepath.Join(tempDir, "config.json")
+	if err := SaveAt(path, &Config{LogFormat: "xml"}); err != nil {
+		t.Fatalf("SaveAt() error: %v", err)
+	}
+
+	_, err := LoadAt(path)
+	if !errors.Is(err, ErrInvalidConfig) || !strings.Contains(err.Error(), "log_format") {
+		t.Fatalf("expected ErrInvalidConfig for log_format, got %v", err)
+	}
+}
+
+func TestNetworkForProfileOverlaysTopLevelSettings(t *testing.T) {
+	cfg := &Config{
+		Network: Network{
+			BaseURL:  "https://gateway.example.com",
+			ProxyURL: "http://proxy.example.com:3128",
+			CABundle: "/etc/asc/ca.pem",
+		},
+		Profiles: map[string]Network{
+			"corp": {ProxyURL: "http://corp-proxy.example.com:3128"},
+		},
+	}
+
+	got := cfg.NetworkForProfile("corp")
+	want := Network{
+		BaseURL:  "https://gateway.example.com",
+		ProxyURL: "http://corp-proxy.example.com:3128",
+		CABundle: "/etc/asc/ca.pem",
+	}
+	if got != want {
+		t.Fatalf("NetworkForProfile(corp) = %+v, want %+v", got, want)
+	}
+	if got := cfg.NetworkForProfile("other"); got != cfg.Network {
+		t.Fatalf("NetworkForProfile(other) = %+v, want top-level settings", got)
+	}
+}
+
+func TestNetworkValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		network Network
+		wantErr bool
+	}{
+		{name: "empty", network: Network{}},
+		{name: "https base", network: Network{BaseURL: "https://gateway.example.com/asc"}},
+		{name: "loopback http base", network: Network{BaseURL: "http://127.0.0.1:8788"}},
+		{name: "remote http base", network: Network{BaseURL: "http://gateway.example.com"}, wantErr: true},
+		{name: "socks proxy", network: Network{ProxyURL: "socks5://127.0.0.1:1080"}},
+		{name: "bad proxy scheme", network: Network{ProxyURL: "ftp://proxy.example.com"}, wantErr: true},
+		{name: "cert and key", network: Network{ClientCert: "cert.pem", ClientKey: "key.pem"}},
+		{name: "key without cert", network: Network{ClientKey: "key.pem"}, wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.network.Validate()
+			if test.wantErr != (err != nil) {
+				t.Fatalf("Validate() error = %v, wantErr %v", err, test.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,277 @@
+package telemetry
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	exportTimeout = 10 * time.Second
+
+	statusCodeOK    = 1
+	statusCodeError = 2
+
+	// aggregationTemporalityCumulative marks counter values as totals since
+	// the start of the run.
+	aggregationTemporalityCumulative = 2
+)
+
+// The types below are the subset of the OTLP/JSON encoding asc emits.
+
+type otlpKeyValue struct {
+	Key   string       `json:"key"`
+	Value otlpAnyValue `json:"value"`
+}
+
+type otlpAnyValue struct {
+	StringValue *string `json:"stringValue,omitempty"`
+	IntValue    *string `json:"intValue,omitempty"`
+	BoolValue   *bool   `json:"boolValue,omitempty"`
+}
+
+type otlpResource struct {
+	Attributes []otlpKeyValue `json:"attributes"`
+}
+
+type otlpScope struct {
+	Name string `json:"name"`
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code"`
+	Message string `json:"message,omitempty"`
+}
+
+type otlpSpan struct {
+	TraceID           string         `json:"traceId"`
+	SpanID            string         `json:"spanId"`
+	ParentSpanID      string         `json:"parentSpanId,omitempty"`
+	Name              string         `json:"name"`
+	Kind              int            `json:"kind"`
+	StartTimeUnixNano string         `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string         `json:"endTimeUnixNano"`
+	Attributes        []otlpKeyValue `json:"attributes,omitempty"`
+	Status            otlpStatus     `json:"status"`
+}
+
+type otlpScopeSpans struct {
+	Scope otlpScope  `json:"scope"`
+	Spans []otlpSpan `json:"spans"`
+}
+
+type otlpResourceSpans struct {
+	Resource   otlpResource     `json:"resource"`
+	ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpTraces struct {
+	ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+}
+
+type otlpDataPoint struct {
+	Attributes        []otlpKeyValue `json:"attributes,omitempty"`
+	StartTimeUnixNano string         `json:"startTimeUnixNano"`
+	TimeUnixNano      string         `json:"timeUnixNano"`
+	AsInt             string         `json:"asInt"`
+}
+
+type otlpSum struct {
+	DataPoints             []otlpDataPoint `json:"dataPoints"`
+	AggregationTemporality int             `json:"aggregationTemporality"`
+	IsMonotonic            bool            `json:"isMonotonic"`
+}
+
+type otlpMetric struct {
+	Name string  `json:"name"`
+	Unit string  `json:"unit,omitempty"`
+	Sum  otlpSum `json:"sum"`
+}
+
+type otlpScopeMetrics struct {
+	Scope   otlpScope    `json:"scope"`
+	Metrics []otlpMetric `json:"metrics"`
+}
+
+type otlpResourceMetrics struct {
+	Resource     otlpResource       `json:"resource"`
+	ScopeMetrics []otlpScopeMetrics `json:"scopeMetrics"`
+}
+
+type otlpMetrics struct {
+	ResourceMetrics []otlpResourceMetrics `json:"resourceMetrics"`
+}
+
+func encodeTraces(serviceVersion string, spans []spanData) otlpTraces {
+	encoded := make([]otlpSpan, 0, len(spans))
+	for _, span := range spans {
+		status := otlpStatus{Code: statusCodeOK}
+		if span.failed {
+			status = otlpStatus{Code: statusCodeError, Message: span.errorText}
+		}
+		encoded = append(encoded, otlpSpan{
+			TraceID:           span.traceID,
+			SpanID:            span.spanID,
+			ParentSpanID:      span.parentID,
+			Name:              span.name,
+			Kind:              int(span.kind),
+			StartTimeUnixNano: unixNano(span.start),
+			EndTimeUnixNano:   unixNano(span.end),
+			Attributes:        encodeAttrs(span.attrs),
+			Status:            status,
+		})
+	}
+
+	return otlpTraces{ResourceSpans: []otlpResourceSpans{{
+		Resource:   resource(serviceVersion),
+		ScopeSpans: []otlpScopeSpans{{Scope: otlpScope{Name: serviceName}, Spans: encoded}},
+	}}}
+}
+
+func encodeMetrics(serviceVersion string, start, now time.Time, counters []counter) otlpMetrics {
+	byName := map[string]*otlpMetric{}
+	names := []string{}
+	for _, c := range counters {
+		metric, ok := byName[c.name]
+		if !ok {
+			metric = &otlpMetric{Name: c.name, Unit: "1", Sum: otlpSum{
+				AggregationTemporality: aggregationTemporalityCumulative,
+				IsMonotonic:            true,
+			}}
+			byName[c.name] = metric
+			names = append(names, c.name)
+		}
+		metric.Sum.DataPoints = append(metric.Sum.DataPoints, otlpDataPoint{
+			Attributes:        encodeAttrs(c.attrs),
+			StartTimeUnixNano: unixNano(start),
+			TimeUnixNano:      unixNano(now),
+			AsInt:             strconv.FormatInt(c.value, 10),
+		})
+	}
+	sort.Strings(names)
+	encoded := make([]otlpMetric, 0, len(names))
+	for _, name := range names {
+		encoded = append(encoded, *byName[name])
+	}
+
+	return otlpMetrics{ResourceMetrics: []otlpResourceMetrics{{
+		Resource:     resource(serviceVersion),
+		ScopeMetrics: []otlpScopeMetrics{{Scope: otlpScope{Name: serviceName}, Metrics: encoded}},
+	}}}
+}
+
+func resource(serviceVersion string) otlpResource {
+	attrs := []Attr{String("service.name", serviceName)}
+	if version := strings.TrimSpace(serviceVersion); version != "" {
+		attrs = append(attrs, String("service.version", version))
+	}
+	return otlpResource{Attributes: encodeAttrs(attrs)}
+}
+
+func encodeAttrs(attrs []Attr) []otlpKeyValue {
+	if len(attrs) == 0 {
+		return nil
+	}
+	encoded := make([]otlpKeyValue, 0, len(attrs))
+	for _, attr := range attrs {
+		var value otlpAnyValue
+		switch v := attr.Value.(type) {
+		case string:
+			value.StringValue = &v
+		case int64:
+			s := strconv.FormatInt(v, 10)
+			value.IntValue = &s
+		case bool:
+			value.BoolValue = &v
+		default:
+			s := fmt.Sprint(v)
+			value.StringValue = &s
+		}
+		encoded = append(encoded, otlpKeyValue{Key: attr.Key, Value: value})
+	}
+	return encoded
+}
+
+func unixNano(t time.Time) string {
+	return strconv.FormatInt(t.UnixNano(), 10)
+}
+
+// exportFile appends the traces and metrics that are non-nil as JSON lines,
+// the format read by the OpenTelemetry Collector's otlpjsonfile receiver.
+func exportFile(path string, traces *otlpTraces, metrics *otlpMetrics) error {
+	if dir := filepath.Dir(path); dir != "" {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return err
+		}
+	}
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	if traces != nil {
+		if err := encoder.Encode(traces); err != nil {
+			return err
+		}
+	}
+	if metrics != nil {
+		if err := encoder.Encode(metrics); err != nil {
+			return err
+		}
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	if _, err := file.Write(buf.Bytes()); err != nil {
+		_ = file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+func exportHTTP(ctx context.Context, endpoint string, traces *otlpTraces, metrics *otlpMetrics) error {
+	ctx, cancel := context.WithTimeout(ctx, exportTimeout)
+	defer cancel()
+
+	base := strings.TrimRight(endpoint, "/")
+	if traces != nil {
+		if err := postJSON(ctx, base+"/v1/traces", traces); err != nil {
+			return err
+		}
+	}
+	if metrics != nil {
+		return postJSON(ctx, base+"/v1/metrics", metrics)
+	}
+	return nil
+}
+
+func postJSON(ctx context.Context, url string, payload any) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("POST %s returned status %d", url, resp.StatusCode)
+	}
+	return nil
+}
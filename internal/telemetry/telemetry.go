@@ -0,0 +1,360 @@
+// Package telemetry records optional trace spans and counters for asc runs
+// and exports them as OTLP/JSON, either to a file or to an OTLP/HTTP
+// collector. When neither ASC_OTEL_FILE nor ASC_OTEL_ENDPOINT is set, every
+// call is a no-op.
+package telemetry
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	fileEnvVar     = "ASC_OTEL_FILE"
+	endpointEnvVar = "ASC_OTEL_ENDPOINT"
+
+	serviceName = "asc"
+)
+
+// Finished spans are exported in batches so long-running commands such as
+// asc webhooks serve do not hold them until exit. A batch goes out every
+// spanExportInterval, or sooner once spanBatchSize spans are waiting. Spans
+// that arrive while maxBufferedSpans are still waiting are dropped and
+// counted in CounterDroppedSpans.
+var (
+	spanExportInterval = 5 * time.Second
+	spanBatchSize      = 512
+	maxBufferedSpans   = 8192
+)
+
+// Counter names recorded by asc.
+const (
+	CounterHTTPRequests    = "asc.http.requests"
+	CounterHTTPRetries     = "asc.http.retries"
+	CounterHTTPRateLimited = "asc.http.rate_limited"
+	CounterDroppedSpans    = "asc.telemetry.dropped_spans"
+)
+
+// SpanKind mirrors the OTLP span kinds asc emits.
+type SpanKind int
+
+const (
+	SpanKindInternal SpanKind = 1
+	SpanKindClient   SpanKind = 3
+)
+
+// Attr is a span or counter attribute.
+type Attr struct {
+	Key   string
+	Value any
+}
+
+// String returns a string attribute.
+func String(key, value string) Attr { return Attr{Key: key, Value: value} }
+
+// Int returns an integer attribute.
+func Int(key string, value int64) Attr { return Attr{Key: key, Value: value} }
+
+// Bool returns a boolean attribute.
+func Bool(key string, value bool) Attr { return Attr{Key: key, Value: value} }
+
+// Options configures where telemetry is exported.
+type Options struct {
+	ServiceVersion string
+	// File receives OTLP/JSON traces lines as span batches are exported and
+	// one metrics line when the run shuts down.
+	File string
+	// Endpoint is an OTLP/HTTP collector base URL, such as
+	// http://localhost:4318; /v1/traces and /v1/metrics are appended.
+	Endpoint string
+}
+
+// OptionsFromEnv reads ASC_OTEL_FILE and ASC_OTEL_ENDPOINT.
+func OptionsFromEnv(serviceVersion string) Options {
+	return Options{
+		ServiceVersion: serviceVersion,
+		File:           strings.TrimSpace(os.Getenv(fileEnvVar)),
+		Endpoint:       strings.TrimSpace(os.Getenv(endpointEnvVar)),
+	}
+}
+
+// Enabled reports whether any exporter is configured.
+func (o Options) Enabled() bool {
+	return o.File != "" || o.Endpoint != ""
+}
+
+type recorder struct {
+	options Options
+	started time.Time
+
+	mu        sync.Mutex
+	spans     []spanData
+	dropped   int64
+	counters  map[string]*counter
+	exportErr error // first background export failure, reported by Shutdown
+
+	exportMu sync.Mutex // serializes exports so file lines never interleave
+	wake     chan struct{}
+	stop     chan struct{}
+	stopped  chan struct{}
+}
+
+type counter struct {
+	name  string
+	attrs []Attr
+	value int64
+}
+
+var active atomic.Pointer[recorder]
+
+// Init starts recording when options name an exporter. Calling Init again
+// discards anything recorded since the previous Init.
+func Init(options Options) {
+	if !options.Enabled() {
+		if previous := active.Swap(nil); previous != nil {
+			previous.stopExporting()
+		}
+		return
+	}
+	rec := &recorder{
+		options:  options,
+		started:  time.Now(),
+		counters: make(map[string]*counter),
+		wake:     make(chan struct{}, 1),
+		stop:     make(chan struct{}),
+		stopped:  make(chan struct{}),
+	}
+	if previous := active.Swap(rec); previous != nil {
+		previous.stopExporting()
+	}
+	go rec.exportLoop()
+}
+
+// Enabled reports whether spans and counters are being recorded.
+func Enabled() bool {
+	return active.Load() != nil
+}
+
+// Shutdown exports everything not yet exported, including counters, and
+// stops recording.
+func Shutdown(ctx context.Context) error {
+	rec := active.Swap(nil)
+	if rec == nil {
+		return nil
+	}
+	rec.stopExporting()
+
+	rec.mu.Lock()
+	spans := rec.spans
+	rec.spans = nil
+	counters := make([]counter, 0, len(rec.counters)+1)
+	for _, c := range rec.counters {
+		counters = append(counters, *c)
+	}
+	if rec.dropped > 0 {
+		counters = append(counters, counter{name: CounterDroppedSpans, value: rec.dropped})
+	}
+	exportErr := rec.exportErr
+	rec.mu.Unlock()
+
+	var traces *otlpTraces
+	if len(spans) > 0 {
+		encoded := encodeTraces(rec.options.ServiceVersion, spans)
+		traces = &encoded
+	}
+	metrics := encodeMetrics(rec.options.ServiceVersion, rec.started, time.Now(), counters)
+	return errors.Join(exportErr, rec.export(ctx, traces, &metrics))
+}
+
+// exportLoop exports span batches until stopExporting is called.
+func (r *recorder) exportLoop() {
+	defer close(r.stopped)
+	ticker := time.NewTicker(spanExportInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.stop:
+			return
+		case <-ticker.C:
+		case <-r.wake:
+		}
+		r.exportBatch()
+	}
+}
+
+func (r *recorder) stopExporting() {
+	close(r.stop)
+	<-r.stopped
+}
+
+// exportBatch exports the spans finished since the last export.
+func (r *recorder) exportBatch() {
+	r.mu.Lock()
+	spans := r.spans
+	r.spans = nil
+	r.mu.Unlock()
+	if len(spans) == 0 {
+		return
+	}
+
+	traces := encodeTraces(r.options.ServiceVersion, spans)
+	if err := r.export(context.Background(), &traces, nil); err != nil {
+		r.mu.Lock()
+		if r.exportErr == nil {
+			r.exportErr = err
+		}
+		r.mu.Unlock()
+	}
+}
+
+// export sends traces and metrics, when non-nil, to every configured exporter.
+func (r *recorder) export(ctx context.Context, traces *otlpTraces, metrics *otlpMetrics) error {
+	r.exportMu.Lock()
+	defer r.exportMu.Unlock()
+
+	var errs []error
+	if r.options.File != "" {
+		if err := exportFile(r.options.File, traces, metrics); err != nil {
+			errs = append(errs, fmt.Errorf("telemetry: write %s: %w", r.options.File, err))
+		}
+	}
+	if r.options.Endpoint != "" {
+		if err := exportHTTP(ctx, r.options.Endpoint, traces, metrics); err != nil {
+			errs = append(errs, fmt.Errorf("telemetry: export to %s: %w", r.options.Endpoint, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Add increments a counter.
+func Add(name string, delta int64, attrs ...Attr) {
+	rec := active.Load()
+	if rec == nil {
+		return
+	}
+	key := counterKey(name, attrs)
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	c, ok := rec.counters[key]
+	if !ok {
+		c = &counter{name: name, attrs: attrs}
+		rec.counters[key] = c
+	}
+	c.value += delta
+}
+
+func counterKey(name string, attrs []Attr) string {
+	var b strings.Builder
+	b.WriteString(name)
+	for _, attr := range attrs {
+		fmt.Fprintf(&b, "|%s=%v", attr.Key, attr.Value)
+	}
+	return b.String()
+}
+
+type spanContextKey struct{}
+
+type spanData struct {
+	traceID   string
+	spanID    string
+	parentID  string
+	name      string
+	kind      SpanKind
+	start     time.Time
+	end       time.Time
+	attrs     []Attr
+	errorText string
+	failed    bool
+}
+
+// Span is an in-progress span. A nil *Span is valid and ignores all calls,
+// so callers never need to check whether telemetry is enabled.
+type Span struct {
+	rec   *recorder
+	mu    sync.Mutex
+	data  spanData
+	ended bool
+}
+
+// Start begins a span that is a child of the span in ctx, if any.
+func Start(ctx context.Context, name string, kind SpanKind, attrs ...Attr) (context.Context, *Span) {
+	rec := active.Load()
+	if rec == nil {
+		return ctx, nil
+	}
+
+	data := spanData{
+		spanID: newID(8),
+		name:   name,
+		kind:   kind,
+		start:  time.Now(),
+		attrs:  append([]Attr(nil), attrs...),
+	}
+	if parent, ok := ctx.Value(spanContextKey{}).(*Span); ok && parent != nil && parent.rec == rec {
+		data.traceID = parent.data.traceID
+		data.parentID = parent.data.spanID
+	} else {
+		data.traceID = newID(16)
+	}
+
+	span := &Span{rec: rec, data: data}
+	return context.WithValue(ctx, spanContextKey{}, span), span
+}
+
+// SetAttributes adds attributes to the span.
+func (s *Span) SetAttributes(attrs ...Attr) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.data.attrs = append(s.data.attrs, attrs...)
+}
+
+// End finishes the span, marking it failed when err is non-nil.
+func (s *Span) End(err error) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	if s.ended {
+		s.mu.Unlock()
+		return
+	}
+	s.ended = true
+	s.data.end = time.Now()
+	if err != nil {
+		s.data.failed = true
+		s.data.errorText = err.Error()
+	}
+	data := s.data
+	s.mu.Unlock()
+
+	s.rec.mu.Lock()
+	defer s.rec.mu.Unlock()
+	if len(s.rec.spans) >= maxBufferedSpans {
+		s.rec.dropped++
+		return
+	}
+	s.rec.spans = append(s.rec.spans, data)
+	if len(s.rec.spans) >= spanBatchSize {
+		select {
+		case s.rec.wake <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func newID(size int) string {
+	buf := make([]byte, size)
+	_, _ = rand.Read(buf)
+	return hex.EncodeToString(buf)
+}
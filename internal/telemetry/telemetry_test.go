@@ -0,0 +1,255 @@
+package telemetry
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func setSpanBuffering(t *testing.T, interval time.Duration, batchSize, maxBuffered int) {
+	t.Helper()
+	previousInterval, previousBatch, previousMax := spanExportInterval, spanBatchSize, maxBufferedSpans
+	spanExportInterval, spanBatchSize, maxBufferedSpans = interval, batchSize, maxBuffered
+	t.Cleanup(func() {
+		spanExportInterval, spanBatchSize, maxBufferedSpans = previousInterval, previousBatch, previousMax
+	})
+}
+
+func readExport(t *testing.T, path string) (otlpTraces, otlpMetrics) {
+	t.Helper()
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("Open() error: %v", err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 4*1024*1024)
+	var lines [][]byte
+	for scanner.Scan() {
+		lines = append(lines, append([]byte(nil), scanner.Bytes()...))
+	}
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 lines, got %d", len(lines))
+	}
+	var traces otlpTraces
+	if err := json.Unmarshal(lines[0], &traces); err != nil {
+		t.Fatalf("unmarshal traces: %v", err)
+	}
+	var metrics otlpMetrics
+	if err := json.Unmarshal(lines[1], &metrics); err != nil {
+		t.Fatalf("unmarshal metrics: %v", err)
+	}
+	return traces, metrics
+}
+
+func TestDisabledIsNoop(t *testing.T) {
+	Init(Options{})
+	if Enabled() {
+		t.Fatal("expected telemetry to be disabled")
+	}
+	ctx, span := Start(context.Background(), "noop", SpanKindInternal)
+	if span != nil {
+		t.Fatal("expected nil span when disabled")
+	}
+	span.SetAttributes(String("k", "v"))
+	span.End(errors.New("ignored"))
+	Add(CounterHTTPRetries, 1)
+	if ctx == nil {
+		t.Fatal("expected context to be returned")
+	}
+	if err := Shutdown(context.Background()); err != nil {
+		t.Fatalf("Shutdown() error: %v", err)
+	}
+}
+
+func TestFileExportLinksChildSpansAndCounters(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "otel", "asc.jsonl")
+	Init(Options{ServiceVersion: "1.2.3", File: path})
+	t.Cleanup(func() { Init(Options{}) })
+
+	ctx, root := Start(context.Background(), "asc apps list", SpanKindInternal)
+	_, child := Start(ctx, "HTTP GET", SpanKindClient, String("http.request.method", "GET"))
+	child.SetAttributes(Int("http.response.status_code", 429))
+	child.End(errors.New("rate limited"))
+	root.End(nil)
+
+	var wg sync.WaitGroup
+	for range 5 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			Add(CounterHTTPRetries, 1)
+		}()
+	}
+	wg.Wait()
+	Add(CounterHTTPRequests, 2, String("http.request.method", "GET"))
+	Add(CounterHTTPRequests, 1, String("http.request.method", "POST"))
+
+	if err := Shutdown(context.Background()); err != nil {
+		t.Fatalf("Shutdown() error: %v", err)
+	}
+	if Enabled() {
+		t.Fatal("expected Shutdown to stop recording")
+	}
+
+	traces, metrics := readExport(t, path)
+	spans := traces.ResourceSpans[0].ScopeSpans[0].Spans
+	if len(spans) != 2 {
+		t.Fatalf("expected 2 spans, got %d", len(spans))
+	}
+	byName := map[string]otlpSpan{}
+	for _, span := range spans {
+		byName[span.Name] = span
+	}
+	parent, httpSpan := byName["asc apps list"], byName["HTTP GET"]
+	if parent.ParentSpanID != "" || parent.Status.Code != statusCodeOK {
+		t.Fatalf("unexpected root span %+v", parent)
+	}
+	if httpSpan.TraceID != parent.TraceID || httpSpan.ParentSpanID != parent.SpanID {
+		t.Fatalf("expected HTTP span to be a child of the command span: %+v", httpSpan)
+	}
+	if httpSpan.Status.Code != statusCodeError || httpSpan.Status.Message != "rate limited" || httpSpan.Kind != int(SpanKindClient) {
+		t.Fatalf("unexpected HTTP span %+v", httpSpan)
+	}
+	if len(parent.TraceID) != 32 || len(parent.SpanID) != 16 {
+		t.Fatalf("unexpected ID lengths: trace %q span %q", parent.TraceID, parent.SpanID)
+	}
+
+	resourceAttrs := traces.ResourceSpans[0].Resource.Attributes
+	if len(resourceAttrs) != 2 || *resourceAttrs[1].Value.StringValue != "1.2.3" {
+		t.Fatalf("unexpected resource attributes %+v", resourceAttrs)
+	}
+
+	got := map[string]int{}
+	for _, metric := range metrics.ResourceMetrics[0].ScopeMetrics[0].Metrics {
+		for _, point := range metric.Sum.DataPoints {
+			got[metric.Name+"="+point.AsInt]++
+		}
+	}
+	for _, want := range []string{"asc.http.retries=5", "asc.http.requests=2", "asc.http.requests=1"} {
+		if got[want] != 1 {
+			t.Fatalf("expected data point %s, got %v", want, got)
+		}
+	}
+}
+
+func TestHTTPExportPostsTracesAndMetrics(t *testing.T) {
+	var mu sync.Mutex
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Content-Type") != "application/json" {
+			t.Errorf("unexpected content type %q", r.Header.Get("Content-Type"))
+		}
+		mu.Lock()
+		paths = append(paths, r.URL.Path)
+		mu.Unlock()
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	Init(Options{Endpoint: server.URL + "/"})
+	t.Cleanup(func() { Init(Options{}) })
+	_, span := Start(context.Background(), "asc apps list", SpanKindInternal)
+	span.End(nil)
+
+	if err := Shutdown(context.Background()); err != nil {
+		t.Fatalf("Shutdown() error: %v", err)
+	}
+	if len(paths) != 2 || paths[0] != "/v1/traces" || paths[1] != "/v1/metrics" {
+		t.Fatalf("unexpected export paths %v", paths)
+	}
+}
+
+func TestShutdownReportsExportFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	Init(Options{Endpoint: server.URL})
+	t.Cleanup(func() { Init(Options{}) })
+	if err := Shutdown(context.Background()); err == nil {
+		t.Fatal("expected export error")
+	}
+}
+
+func TestFullBatchIsExportedBeforeShutdown(t *testing.T) {
+	setSpanBuffering(t, time.Hour, 2, 100)
+	path := filepath.Join(t.TempDir(), "asc.jsonl")
+	Init(Options{File: path})
+	t.Cleanup(func() { Init(Options{}) })
+
+	for _, name := range []string{"delivery 1", "delivery 2"} {
+		_, span := Start(context.Background(), name, SpanKindInternal)
+		span.End(nil)
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		data, _ := os.ReadFile(path)
+		if strings.Contains(string(data), "delivery 2") {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("expected the full batch to be exported while running, got %q", data)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	_, late := Start(context.Background(), "delivery 3", SpanKindInternal)
+	late.End(nil)
+	if err := Shutdown(context.Background()); err != nil {
+		t.Fatalf("Shutdown() error: %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile() error: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected batch, final traces, and metrics lines, got %d:\n%s", len(lines), data)
+	}
+	if strings.Count(string(data), `"delivery `) != 3 || !strings.Contains(lines[1], "delivery 3") {
+		t.Fatalf("expected each span exported once, got:\n%s", data)
+	}
+}
+
+func TestSpansBeyondBufferAreDroppedAndCounted(t *testing.T) {
+	setSpanBuffering(t, time.Hour, 100, 1)
+	path := filepath.Join(t.TempDir(), "asc.jsonl")
+	Init(Options{File: path})
+	t.Cleanup(func() { Init(Options{}) })
+
+	for range 3 {
+		_, span := Start(context.Background(), "event", SpanKindInternal)
+		span.End(nil)
+	}
+	if err := Shutdown(context.Background()); err != nil {
+		t.Fatalf("Shutdown() error: %v", err)
+	}
+
+	traces, metrics := readExport(t, path)
+	if spans := traces.ResourceSpans[0].ScopeSpans[0].Spans; len(spans) != 1 {
+		t.Fatalf("expected 1 buffered span, got %d", len(spans))
+	}
+	var dropped string
+	for _, metric := range metrics.ResourceMetrics[0].ScopeMetrics[0].Metrics {
+		if metric.Name == CounterDroppedSpans {
+			dropped = metric.Sum.DataPoints[0].AsInt
+		}
+	}
+	if dropped != "2" {
+		t.Fatalf("expected 2 dropped spans, got %q", dropped)
+	}
+}
@@ -0,0 +1,61 @@
+package translate
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+)
+
+var execCommand = func(ctx context.Context, command string) *exec.Cmd {
+	return exec.CommandContext(ctx, "sh", "-c", command)
+}
+
+// CommandTranslator translates through a local command. The request is piped
+// as JSON on stdin and the translation is read from stdout. The request is
+// also exposed as ASC_TRANSLATE_* environment variables for simple scripts.
+type CommandTranslator struct {
+	Command string
+}
+
+// Translate runs the command once for req.
+func (t *CommandTranslator) Translate(ctx context.Context, req Request) (string, error) {
+	command := strings.TrimSpace(t.Command)
+	if command == "" {
+		return "", fmt.Errorf("translate command is required")
+	}
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+
+	cmd := execCommand(ctx, command)
+	cmd.Stdin = bytes.NewReader(payload)
+	cmd.Env = append(os.Environ(),
+		"ASC_TRANSLATE_SOURCE_LOCALE="+req.SourceLocale,
+		"ASC_TRANSLATE_TARGET_LOCALE="+req.TargetLocale,
+		"ASC_TRANSLATE_FIELD="+req.Field,
+		"ASC_TRANSLATE_MAX_LENGTH="+strconv.Itoa(req.MaxLength),
+	)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		msg := strings.TrimSpace(stderr.String())
+		if msg == "" {
+			return "", fmt.Errorf("translate command failed: %w", err)
+		}
+		return "", fmt.Errorf("translate command failed: %w (output: %s)", err, msg)
+	}
+
+	translated := CleanOutput(stdout.String())
+	if translated == "" {
+		return "", fmt.Errorf("translate command returned no output")
+	}
+	return translated, nil
+}
@@ -0,0 +1,122 @@
+package translate
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const (
+	// DefaultOpenAIBaseURL is used when no base URL is configured.
+	DefaultOpenAIBaseURL = "https://api.openai.com/v1"
+
+	openAIMaxResponseBodyBytes = 1 << 20
+	openAIMaxErrorBodyBytes    = 4096
+)
+
+// OpenAITranslator translates through an OpenAI-compatible chat completions
+// endpoint, including local servers such as Ollama, llama.cpp, or vLLM.
+type OpenAITranslator struct {
+	BaseURL    string
+	APIKey     string
+	Model      string
+	HTTPClient *http.Client
+}
+
+type openAIChatMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type openAIChatRequest struct {
+	Model       string              `json:"model"`
+	Messages    []openAIChatMessage `json:"messages"`
+	Temperature float64             `json:"temperature"`
+}
+
+type openAIChatResponse struct {
+	Choices []struct {
+		Message openAIChatMessage `json:"message"`
+	} `json:"choices"`
+}
+
+// Translate sends one chat completion request for req.
+func (t *OpenAITranslator) Translate(ctx context.Context, req Request) (string, error) {
+	model := strings.TrimSpace(t.Model)
+	if model == "" {
+		return "", fmt.Errorf("model is required")
+	}
+	endpoint, err := openAIChatCompletionsURL(t.BaseURL)
+	if err != nil {
+		return "", err
+	}
+
+	body, err := json.Marshal(openAIChatRequest{
+		Model: model,
+		Messages: []openAIChatMessage{
+			{Role: "system", Content: Instructions(req)},
+			{Role: "user", Content: req.Text},
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	if apiKey := strings.TrimSpace(t.APIKey); apiKey != "" {
+		httpReq.Header.Set("Authorization", "Bearer "+apiKey)
+	}
+
+	client := t.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(httpReq)
+	if err != nil {
+		return "", fmt.Errorf("translation request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		data, _ := io.ReadAll(io.LimitReader(resp.Body, openAIMaxErrorBodyBytes))
+		msg := strings.TrimSpace(string(data))
+		if msg == "" {
+			return "", fmt.Errorf("translation request failed with status %d", resp.StatusCode)
+		}
+		return "", fmt.Errorf("translation request failed with status %d: %s", resp.StatusCode, msg)
+	}
+
+	var decoded openAIChatResponse
+	if err := json.NewDecoder(io.LimitReader(resp.Body, openAIMaxResponseBodyBytes)).Decode(&decoded); err != nil {
+		return "", fmt.Errorf("invalid translation response: %w", err)
+	}
+	if len(decoded.Choices) == 0 {
+		return "", fmt.Errorf("translation response has no choices")
+	}
+	translated := CleanOutput(decoded.Choices[0].Message.Content)
+	if translated == "" {
+		return "", fmt.Errorf("translation response is empty")
+	}
+	return translated, nil
+}
+
+func openAIChatCompletionsURL(baseURL string) (string, error) {
+	base := strings.TrimSpace(baseURL)
+	if base == "" {
+		base = DefaultOpenAIBaseURL
+	}
+	parsed, err := url.Parse(base)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return "", fmt.Errorf("invalid base URL %q: expected http(s)://host[/path]", base)
+	}
+	return strings.TrimRight(base, "/") + "/chat/completions", nil
+}
@@ -0,0 +1,48 @@
+package translate
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+const (
+	ProviderCommand = "command"
+	ProviderOpenAI  = "openai"
+)
+
+// Request describes one metadata field to translate.
+type Request struct {
+	SourceLocale string `json:"sourceLocale"`
+	TargetLocale string `json:"targetLocale"`
+	Field        string `json:"field"`
+	Text         string `json:"text"`
+	// MaxLength is the App Store Connect character limit for Field, or 0
+	// when the field has none.
+	MaxLength int `json:"maxLength,omitempty"`
+}
+
+// Translator translates one metadata field and returns the translated text.
+type Translator interface {
+	Translate(ctx context.Context, req Request) (string, error)
+}
+
+// Instructions returns the guidance given to model-backed providers for req.
+func Instructions(req Request) string {
+	lines := []string{
+		fmt.Sprintf("Translate App Store %s text from %s to %s.", req.Field, req.SourceLocale, req.TargetLocale),
+		"Keep the meaning, tone, line breaks, URLs, and product names. Reply with the translation only.",
+	}
+	if req.Field == "keywords" {
+		lines = append(lines, "The text is a comma-separated keyword list. Return localized search keywords separated by commas without spaces.")
+	}
+	if req.MaxLength > 0 {
+		lines = append(lines, fmt.Sprintf("The result must be at most %d characters.", req.MaxLength))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// CleanOutput trims surrounding whitespace from provider output.
+func CleanOutput(output string) string {
+	return strings.TrimSpace(strings.ReplaceAll(output, "\r\n", "\n"))
+}
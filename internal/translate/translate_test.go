@@ -0,0 +1,91 @@
+package translate
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestCommandTranslatorPipesRequestJSON(t *testing.T) {
+	translator := &CommandTranslator{Command: `cat >/dev/null; printf '%s:%s\n' "$ASC_TRANSLATE_TARGET_LOCALE" "$ASC_TRANSLATE_MAX_LENGTH"`}
+	got, err := translator.Translate(context.Background(), Request{SourceLocale: "en-US", TargetLocale: "de-DE", Field: "whatsNew", Text: "Fixes", MaxLength: 4000})
+	if err != nil {
+		t.Fatalf("Translate() error: %v", err)
+	}
+	if got != "de-DE:4000" {
+		t.Fatalf("expected env-based output, got %q", got)
+	}
+
+	echo := &CommandTranslator{Command: "cat"}
+	got, err = echo.Translate(context.Background(), Request{TargetLocale: "ja", Field: "description", Text: "Hello"})
+	if err != nil {
+		t.Fatalf("Translate() error: %v", err)
+	}
+	var req Request
+	if err := json.Unmarshal([]byte(got), &req); err != nil || req.TargetLocale != "ja" || req.Text != "Hello" {
+		t.Fatalf("expected request JSON on stdin, got %q (%v)", got, err)
+	}
+}
+
+func TestCommandTranslatorReportsFailures(t *testing.T) {
+	_, err := (&CommandTranslator{Command: "echo boom >&2; exit 3"}).Translate(context.Background(), Request{})
+	if err == nil || !strings.Contains(err.Error(), "boom") {
+		t.Fatalf("expected stderr in error, got %v", err)
+	}
+	_, err = (&CommandTranslator{Command: "true"}).Translate(context.Background(), Request{})
+	if err == nil || !strings.Contains(err.Error(), "no output") {
+		t.Fatalf("expected empty output error, got %v", err)
+	}
+}
+
+func TestOpenAITranslatorSendsChatCompletion(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/chat/completions" {
+			t.Fatalf("unexpected path %s", r.URL.Path)
+		}
+		if got := r.Header.Get("Authorization"); got != "Bearer secret" {
+			t.Fatalf("unexpected authorization %q", got)
+		}
+		var body openAIChatRequest
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("decode body: %v", err)
+		}
+		if body.Model != "local-model" || len(body.Messages) != 2 || body.Messages[1].Content != "Try it" {
+			t.Fatalf("unexpected body: %+v", body)
+		}
+		if !strings.Contains(body.Messages[0].Content, "at most 170 characters") {
+			t.Fatalf("expected limit in instructions, got %q", body.Messages[0].Content)
+		}
+		_, _ = w.Write([]byte(`{"choices":[{"message":{"role":"assistant","content":"  Probier es aus\n"}}]}`))
+	}))
+	defer server.Close()
+
+	translator := &OpenAITranslator{BaseURL: server.URL + "/v1/", APIKey: "secret", Model: "local-model", HTTPClient: server.Client()}
+	got, err := translator.Translate(context.Background(), Request{SourceLocale: "en-US", TargetLocale: "de-DE", Field: "promotionalText", Text: "Try it", MaxLength: 170})
+	if err != nil {
+		t.Fatalf("Translate() error: %v", err)
+	}
+	if got != "Probier es aus" {
+		t.Fatalf("expected trimmed translation, got %q", got)
+	}
+}
+
+func TestOpenAITranslatorReportsHTTPErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"error":"model not found"}`, http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	translator := &OpenAITranslator{BaseURL: server.URL, Model: "missing", HTTPClient: server.Client()}
+	_, err := translator.Translate(context.Background(), Request{Text: "Hello"})
+	if err == nil || !strings.Contains(err.Error(), "status 404") || !strings.Contains(err.Error(), "model not found") {
+		t.Fatalf("expected status error, got %v", err)
+	}
+
+	if _, err := (&OpenAITranslator{BaseURL: "ftp://example.com", Model: "m"}).Translate(context.Background(), Request{}); err == nil {
+		t.Fatal("expected invalid base URL error")
+	}
+}
@@ -0,0 +1,142 @@
+package workflow
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+)
+
+// ASCRunner executes an asc command in-process. args exclude the leading
+// "asc"; env is the step env, which overlays the process environment.
+// Human-readable output goes to stdout and stderr; result is the JSON encoding
+// of the command's structured result, or nil if it printed none.
+type ASCRunner func(ctx context.Context, args []string, env map[string]string, stdout, stderr io.Writer) (result []byte, err error)
+
+func (r *runner) executeASCStep(ctx context.Context, pos stepPosition, step Step, env map[string]string, sr StepResult, stepStart time.Time) error {
+	if r.opts.DryRun {
+		fmt.Fprintf(r.opts.Stderr, "[dry-run] %s: %s\n", pos.dryRunLabel(), sr.Command)
+		sr.Status = "dry-run"
+		sr.DurationMS = time.Since(stepStart).Milliseconds()
+		r.recordStep(sr)
+		return nil
+	}
+
+	fail := func(err error) error {
+		sr.Status = "error"
+		if ctx.Err() != nil {
+			sr.Status = "cancelled"
+		}
+		sr.Error = err.Error()
+		sr.DurationMS = time.Since(stepStart).Milliseconds()
+		r.recordStep(sr)
+		r.setFailedStep(failedStepName(step.Name, pos.key))
+		return fmt.Errorf("workflow: %s: %w", pos.label, err)
+	}
+
+	if r.opts.ASC == nil {
+		return fail(fmt.Errorf("asc steps are not supported by this runner"))
+	}
+	args, err := expandASCArgs(step.ASC, env, r.currentOutputs())
+	if err != nil {
+		return fail(err)
+	}
+
+	var result []byte
+	attempts, timedOut, err := r.runWithPolicy(ctx, pos, step, func(ctx context.Context) error {
+		var runErr error
+		result, runErr = r.opts.ASC(ctx, args, env, r.opts.Stdout, r.opts.Stderr)
+		return runErr
+	})
+	sr.Attempts = attempts
+	sr.TimedOut = timedOut
+	if err != nil {
+		if step.ContinueOnError && ctx.Err() == nil {
+			sr.Status = "error"
+			sr.Error = err.Error()
+			sr.ContinuedOnError = true
+			sr.DurationMS = time.Since(stepStart).Milliseconds()
+			r.recordStep(sr)
+			fmt.Fprintf(r.opts.Stderr, "workflow: %s failed; continuing (continue_on_error): %v\n", pos.label, err)
+			return nil
+		}
+		return fail(err)
+	}
+
+	if len(step.Outputs) > 0 {
+		if len(result) == 0 {
+			return fail(fmt.Errorf("extract outputs: command produced no structured result"))
+		}
+		extracted, err := extractDeclaredOutputs(step.Outputs, result)
+		if err != nil {
+			return fail(err)
+		}
+		sr.Outputs = extracted
+		if strings.TrimSpace(step.Name) != "" {
+			r.setOutputs(step.Name, extracted)
+		}
+	}
+
+	sr.Status = "ok"
+	sr.DurationMS = time.Since(stepStart).Milliseconds()
+	r.recordStep(sr)
+
+	if err := r.persistStep(pos.key, sr); err != nil {
+		r.setFailedStep(failedStepName(step.Name, pos.key))
+		return err
+	}
+	return nil
+}
+
+// expandASCArgs expands $VAR, ${VAR}, and ${steps.NAME.OUTPUT} in each
+// argument. Variables resolve from the step env, then the process env. Values
+// are substituted verbatim since no shell is involved.
+func expandASCArgs(args []string, env map[string]string, outputs map[string]map[string]string) ([]string, error) {
+	expanded := make([]string, len(args))
+	var expandErr error
+	for i, arg := range args {
+		expanded[i] = os.Expand(arg, func(name string) string {
+			if ref, ok := strings.CutPrefix(name, "steps."); ok {
+				// Namespaced matrix step names may contain dots; output
+				// names cannot.
+				dot := strings.LastIndex(ref, ".")
+				if dot < 0 {
+					dot = len(ref)
+				}
+				stepName, outputName := ref[:dot], strings.TrimPrefix(ref[dot:], ".")
+				value, ok := outputs[stepName][outputName]
+				if !ok && expandErr == nil {
+					expandErr = fmt.Errorf("unknown step output %q", name)
+				}
+				return value
+			}
+			if value, ok := env[name]; ok {
+				return value
+			}
+			return os.Getenv(name)
+		})
+	}
+	if expandErr != nil {
+		return nil, expandErr
+	}
+	return expanded, nil
+}
+
+// stepCommand returns the command a step runs, for results and dry runs.
+func stepCommand(step Step) string {
+	if len(step.ASC) == 0 {
+		return step.Run
+	}
+	parts := make([]string, 0, len(step.ASC)+1)
+	parts = append(parts, "asc")
+	for _, arg := range step.ASC {
+		if arg != "" && !strings.ContainsAny(arg, " \t\n'\"\\`|&;<>()*?[]#~") {
+			parts = append(parts, arg)
+			continue
+		}
+		parts = append(parts, shellQuote(arg))
+	}
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,695 @@
+package workflow
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// condition is a parsed, type-checked step "if" expression.
+//
+// The language is deliberately small: string ('...' or "..."), number, and
+// true/false literals; references; comparisons (== != < <= > >=); ! && ||;
+// parentheses; and the functions contains, startsWith, endsWith, always,
+// success, and failure. References resolve to strings:
+//
+//	NAME                      env var NAME (workflow env, params, then OS env)
+//	env.NAME                  the same, spelled out
+//	params.NAME               runtime param NAME
+//	matrix.KEY                current matrix value
+//	steps.NAME.outputs.KEY    output KEY of step NAME ("" if it has not run)
+//
+// A reference used as a boolean follows the env truthiness rules, so a bare
+// "DEPLOY" keeps its original meaning. Compared with a number it is parsed as
+// one.
+type condition struct {
+	root condNode
+}
+
+// condType is the static type of an expression. condDynamic is a reference,
+// whose value is only known at run time.
+type condType int
+
+const (
+	condDynamic condType = iota
+	condString
+	condNumber
+	condBool
+)
+
+func (t condType) String() string {
+	switch t {
+	case condString:
+		return "a string"
+	case condNumber:
+		return "a number"
+	case condBool:
+		return "a boolean"
+	default:
+		return "a reference"
+	}
+}
+
+type condNode interface {
+	typeOf() (condType, error)
+	eval(scope *conditionScope) (condValue, error)
+}
+
+type condValue struct {
+	typ condType
+	str string
+	num float64
+	b   bool
+}
+
+// conditionScope is what references and status functions resolve against.
+type conditionScope struct {
+	env     map[string]string
+	params  map[string]string
+	matrix  map[string]string
+	outputs map[string]map[string]string
+	failed  bool
+}
+
+// conditionRef is a reference found in a condition, e.g. {"steps", "build", "state"}.
+type conditionRef struct {
+	scope  string
+	name   string
+	output string
+}
+
+func (r conditionRef) String() string {
+	switch r.scope {
+	case "":
+		return r.name
+	case "steps":
+		return "steps." + r.name + ".outputs." + r.output
+	default:
+		return r.scope + "." + r.name
+	}
+}
+
+// statusFunctions may be called in conditions. A step whose condition calls
+// one opts out of the implicit success() check and is still considered after
+// an earlier step fails.
+var statusFunctions = map[string]bool{"always": true, "success": true, "failure": true}
+
+var stringFunctions = map[string]func(s, substr string) bool{
+	"contains":   strings.Contains,
+	"startsWith": strings.HasPrefix,
+	"endsWith":   strings.HasSuffix,
+}
+
+// parseCondition parses and type-checks an "if" expression.
+func parseCondition(src string) (*condition, error) {
+	p := &condParser{src: src}
+	if err := p.tokenize(); err != nil {
+		return nil, err
+	}
+	if len(p.tokens) == 0 {
+		return nil, fmt.Errorf("empty expression")
+	}
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.kind != tokEOF {
+		return nil, fmt.Errorf("unexpected %s", tok)
+	}
+	typ, err := root.typeOf()
+	if err != nil {
+		return nil, err
+	}
+	if typ != condBool && typ != condDynamic {
+		return nil, fmt.Errorf("expression must be a boolean, not %s", typ)
+	}
+	return &condition{root: root}, nil
+}
+
+// eval reports whether the condition holds in scope.
+func (c *condition) eval(scope *conditionScope) (bool, error) {
+	v, err := c.root.eval(scope)
+	if err != nil {
+		return false, err
+	}
+	return v.truth(), nil
+}
+
+// refs returns the references in the condition, in source order.
+func (c *condition) refs() []conditionRef {
+	var refs []conditionRef
+	walkCondition(c.root, func(n condNode) {
+		if ref, ok := n.(*condRefNode); ok {
+			refs = append(refs, ref.ref)
+		}
+	})
+	return refs
+}
+
+// usesStatus reports whether the condition calls always(), success(), or
+// failure().
+func (c *condition) usesStatus() bool {
+	found := false
+	walkCondition(c.root, func(n condNode) {
+		if call, ok := n.(*condCallNode); ok && statusFunctions[call.name] {
+			found = true
+		}
+	})
+	return found
+}
+
+func walkCondition(n condNode, fn func(condNode)) {
+	fn(n)
+	switch n := n.(type) {
+	case *condNotNode:
+		walkCondition(n.x, fn)
+	case *condBinaryNode:
+		walkCondition(n.left, fn)
+		walkCondition(n.right, fn)
+	case *condCallNode:
+		for _, arg := range n.args {
+			walkCondition(arg, fn)
+		}
+	}
+}
+
+// stepRunsAfterFailure reports whether a step is still considered once an
+// earlier step has failed: its condition must call a status function.
+func stepRunsAfterFailure(step Step) bool {
+	src := strings.TrimSpace(step.If)
+	if src == "" {
+		return false
+	}
+	cond, err := parseCondition(src)
+	return err == nil && cond.usesStatus()
+}
+
+func (v condValue) truth() bool {
+	if v.typ == condBool {
+		return v.b
+	}
+	return isTruthy(v.str)
+}
+
+func (v condValue) text() string {
+	if v.typ == condNumber {
+		return strconv.FormatFloat(v.num, 'f', -1, 64)
+	}
+	return v.str
+}
+
+func (v condValue) number() (float64, bool) {
+	if v.typ == condNumber {
+		return v.num, true
+	}
+	n, err := strconv.ParseFloat(strings.TrimSpace(v.str), 64)
+	return n, err == nil
+}
+
+func condValuesEqual(a, b condValue) bool {
+	switch {
+	case a.typ == condBool || b.typ == condBool:
+		return a.truth() == b.truth()
+	case a.typ == condNumber || b.typ == condNumber:
+		x, okA := a.number()
+		y, okB := b.number()
+		return okA && okB && x == y
+	default:
+		return a.str == b.str
+	}
+}
+
+type condLiteralNode struct {
+	value condValue
+}
+
+func (n *condLiteralNode) typeOf() (condType, error) { return n.value.typ, nil }
+
+func (n *condLiteralNode) eval(*conditionScope) (condValue, error) { return n.value, nil }
+
+type condRefNode struct {
+	ref conditionRef
+}
+
+func (n *condRefNode) typeOf() (condType, error) { return condDynamic, nil }
+
+func (n *condRefNode) eval(scope *conditionScope) (condValue, error) {
+	var value string
+	switch n.ref.scope {
+	case "", "env":
+		var ok bool
+		if value, ok = scope.env[n.ref.name]; !ok {
+			value = os.Getenv(n.ref.name)
+		}
+	case "params":
+		value = scope.params[n.ref.name]
+	case "matrix":
+		var ok bool
+		if value, ok = scope.matrix[n.ref.name]; !ok {
+			if scope.matrix == nil {
+				return condValue{}, fmt.Errorf("matrix value %q referenced outside a matrix step", n.ref.String())
+			}
+			return condValue{}, fmt.Errorf("unknown matrix value %q", n.ref.String())
+		}
+	case "steps":
+		value = scope.outputs[n.ref.name][n.ref.output]
+	}
+	return condValue{typ: condDynamic, str: value}, nil
+}
+
+type condNotNode struct {
+	x condNode
+}
+
+func (n *condNotNode) typeOf() (condType, error) {
+	typ, err := n.x.typeOf()
+	if err != nil {
+		return 0, err
+	}
+	if typ != condBool && typ != condDynamic {
+		return 0, fmt.Errorf("operand of ! must be a boolean, not %s", typ)
+	}
+	return condBool, nil
+}
+
+func (n *condNotNode) eval(scope *conditionScope) (condValue, error) {
+	v, err := n.x.eval(scope)
+	if err != nil {
+		return condValue{}, err
+	}
+	return condValue{typ: condBool, b: !v.truth()}, nil
+}
+
+type condBinaryNode struct {
+	op          string
+	left, right condNode
+}
+
+func (n *condBinaryNode) typeOf() (condType, error) {
+	left, err := n.left.typeOf()
+	if err != nil {
+		return 0, err
+	}
+	right, err := n.right.typeOf()
+	if err != nil {
+		return 0, err
+	}
+	switch n.op {
+	case "&&", "||":
+		for _, typ := range []condType{left, right} {
+			if typ != condBool && typ != condDynamic {
+				return 0, fmt.Errorf("operands of %s must be booleans, not %s", n.op, typ)
+			}
+		}
+	case "==", "!=":
+		if left != condDynamic && right != condDynamic && left != right {
+			return 0, fmt.Errorf("cannot compare %s with %s", left, right)
+		}
+	default:
+		for _, typ := range []condType{left, right} {
+			if typ != condNumber && typ != condDynamic {
+				return 0, fmt.Errorf("operands of %s must be numbers, not %s", n.op, typ)
+			}
+		}
+	}
+	return condBool, nil
+}
+
+func (n *condBinaryNode) eval(scope *conditionScope) (condValue, error) {
+	left, err := n.left.eval(scope)
+	if err != nil {
+		return condValue{}, err
+	}
+	// && and || short-circuit, so "failure() || steps.x.outputs.y > 1" does
+	// not need a numeric output once the run has failed.
+	switch {
+	case n.op == "&&" && !left.truth():
+		return condValue{typ: condBool}, nil
+	case n.op == "||" && left.truth():
+		return condValue{typ: condBool, b: true}, nil
+	}
+	right, err := n.right.eval(scope)
+	if err != nil {
+		return condValue{}, err
+	}
+
+	var result bool
+	switch n.op {
+	case "&&", "||":
+		result = right.truth()
+	case "==":
+		result = condValuesEqual(left, right)
+	case "!=":
+		result = !condValuesEqual(left, right)
+	default:
+		x, ok := left.number()
+		if !ok {
+			return condValue{}, fmt.Errorf("%q is not a number", left.text())
+		}
+		y, ok := right.number()
+		if !ok {
+			return condValue{}, fmt.Errorf("%q is not a number", right.text())
+		}
+		switch n.op {
+		case "<":
+			result = x < y
+		case "<=":
+			result = x <= y
+		case ">":
+			result = x > y
+		case ">=":
+			result = x >= y
+		}
+	}
+	return condValue{typ: condBool, b: result}, nil
+}
+
+type condCallNode struct {
+	name string
+	args []condNode
+}
+
+func (n *condCallNode) typeOf() (condType, error) {
+	switch {
+	case statusFunctions[n.name]:
+		if len(n.args) != 0 {
+			return 0, fmt.Errorf("%s() takes no arguments", n.name)
+		}
+	case stringFunctions[n.name] != nil:
+		if len(n.args) != 2 {
+			return 0, fmt.Errorf("%s() takes 2 arguments, got %d", n.name, len(n.args))
+		}
+		for _, arg := range n.args {
+			typ, err := arg.typeOf()
+			if err != nil {
+				return 0, err
+			}
+			if typ == condBool {
+				return 0, fmt.Errorf("arguments of %s() must be strings, not %s", n.name, typ)
+			}
+		}
+	default:
+		return 0, fmt.Errorf("unknown function %s()", n.name)
+	}
+	return condBool, nil
+}
+
+func (n *condCallNode) eval(scope *conditionScope) (condValue, error) {
+	switch n.name {
+	case "always":
+		return condValue{typ: condBool, b: true}, nil
+	case "success":
+		return condValue{typ: condBool, b: !scope.failed}, nil
+	case "failure":
+		return condValue{typ: condBool, b: scope.failed}, nil
+	}
+	args := make([]string, len(n.args))
+	for i, arg := range n.args {
+		v, err := arg.eval(scope)
+		if err != nil {
+			return condValue{}, err
+		}
+		args[i] = v.text()
+	}
+	return condValue{typ: condBool, b: stringFunctions[n.name](args[0], args[1])}, nil
+}
+
+type condTokenKind int
+
+const (
+	tokEOF condTokenKind = iota
+	tokIdent
+	tokString
+	tokNumber
+	tokOp
+)
+
+type condToken struct {
+	kind condTokenKind
+	text string
+	pos  int
+}
+
+func (t condToken) String() string {
+	if t.kind == tokEOF {
+		return "end of expression"
+	}
+	return fmt.Sprintf("%q at offset %d", t.text, t.pos)
+}
+
+type condParser struct {
+	src    string
+	tokens []condToken
+	next   int
+}
+
+var condOperators = []string{"==", "!=", "<=", ">=", "&&", "||", "<", ">", "!", "(", ")", ",", "."}
+
+func (p *condParser) tokenize() error {
+	src := p.src
+	for i := 0; i < len(src); {
+		c := src[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case isCondIdentStart(c):
+			start := i
+			for i < len(src) && (isCondIdentStart(src[i]) || isCondDigit(src[i]) || src[i] == '-') {
+				i++
+			}
+			p.tokens = append(p.tokens, condToken{kind: tokIdent, text: src[start:i], pos: start})
+		case isCondDigit(c) || (c == '-' && i+1 < len(src) && isCondDigit(src[i+1])):
+			start := i
+			i++
+			for i < len(src) && (isCondDigit(src[i]) || src[i] == '.') {
+				i++
+			}
+			p.tokens = append(p.tokens, condToken{kind: tokNumber, text: src[start:i], pos: start})
+		case c == '\'' || c == '"':
+			// A doubled quote inside a string stands for one quote: 'it''s'.
+			start := i
+			var b strings.Builder
+			i++
+			for {
+				if i >= len(src) {
+					return fmt.Errorf("unterminated string at offset %d", start)
+				}
+				if src[i] == c {
+					if i+1 < len(src) && src[i+1] == c {
+						b.WriteByte(c)
+						i += 2
+						continue
+					}
+					i++
+					break
+				}
+				b.WriteByte(src[i])
+				i++
+			}
+			p.tokens = append(p.tokens, condToken{kind: tokString, text: b.String(), pos: start})
+		default:
+			matched := false
+			for _, op := range condOperators {
+				if strings.HasPrefix(src[i:], op) {
+					p.tokens = append(p.tokens, condToken{kind: tokOp, text: op, pos: i})
+					i += len(op)
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				return fmt.Errorf("unexpected character %q at offset %d", c, i)
+			}
+		}
+	}
+	return nil
+}
+
+func isCondIdentStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isCondDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func (p *condParser) peek() condToken {
+	if p.next >= len(p.tokens) {
+		return condToken{kind: tokEOF, pos: len(p.src)}
+	}
+	return p.tokens[p.next]
+}
+
+func (p *condParser) take() condToken {
+	tok := p.peek()
+	if tok.kind != tokEOF {
+		p.next++
+	}
+	return tok
+}
+
+func (p *condParser) acceptOp(ops ...string) (string, bool) {
+	tok := p.peek()
+	if tok.kind != tokOp {
+		return "", false
+	}
+	for _, op := range ops {
+		if tok.text == op {
+			p.next++
+			return op, true
+		}
+	}
+	return "", false
+}
+
+func (p *condParser) expectOp(op string) error {
+	if _, ok := p.acceptOp(op); !ok {
+		return fmt.Errorf("expected %q, got %s", op, p.peek())
+	}
+	return nil
+}
+
+func (p *condParser) parseOr() (condNode, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		if _, ok := p.acceptOp("||"); !ok {
+			return left, nil
+		}
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &condBinaryNode{op: "||", left: left, right: right}
+	}
+}
+
+func (p *condParser) parseAnd() (condNode, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		if _, ok := p.acceptOp("&&"); !ok {
+			return left, nil
+		}
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = &condBinaryNode{op: "&&", left: left, right: right}
+	}
+}
+
+func (p *condParser) parseUnary() (condNode, error) {
+	if _, ok := p.acceptOp("!"); ok {
+		x, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &condNotNode{x: x}, nil
+	}
+	return p.parseComparison()
+}
+
+func (p *condParser) parseComparison() (condNode, error) {
+	left, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+	op, ok := p.acceptOp("==", "!=", "<=", ">=", "<", ">")
+	if !ok {
+		return left, nil
+	}
+	right, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+	return &condBinaryNode{op: op, left: left, right: right}, nil
+}
+
+func (p *condParser) parsePrimary() (condNode, error) {
+	tok := p.take()
+	switch tok.kind {
+	case tokString:
+		return &condLiteralNode{value: condValue{typ: condString, str: tok.text}}, nil
+	case tokNumber:
+		n, err := strconv.ParseFloat(tok.text, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %s", tok)
+		}
+		return &condLiteralNode{value: condValue{typ: condNumber, num: n}}, nil
+	case tokIdent:
+		switch tok.text {
+		case "true", "false":
+			return &condLiteralNode{value: condValue{typ: condBool, b: tok.text == "true"}}, nil
+		}
+		if _, ok := p.acceptOp("("); ok {
+			return p.parseCall(tok.text)
+		}
+		return p.parseRef(tok)
+	case tokOp:
+		if tok.text == "(" {
+			x, err := p.parseOr()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expectOp(")"); err != nil {
+				return nil, err
+			}
+			return x, nil
+		}
+	}
+	return nil, fmt.Errorf("unexpected %s", tok)
+}
+
+func (p *condParser) parseCall(name string) (condNode, error) {
+	call := &condCallNode{name: name}
+	if _, ok := p.acceptOp(")"); ok {
+		return call, nil
+	}
+	for {
+		arg, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		call.args = append(call.args, arg)
+		if _, ok := p.acceptOp(","); !ok {
+			break
+		}
+	}
+	if err := p.expectOp(")"); err != nil {
+		return nil, err
+	}
+	return call, nil
+}
+
+func (p *condParser) parseRef(first condToken) (condNode, error) {
+	parts := []string{first.text}
+	for {
+		if _, ok := p.acceptOp("."); !ok {
+			break
+		}
+		tok := p.take()
+		if tok.kind != tokIdent {
+			return nil, fmt.Errorf("expected a name after \".\", got %s", tok)
+		}
+		parts = append(parts, tok.text)
+	}
+
+	var ref conditionRef
+	switch {
+	case len(parts) == 1:
+		ref = conditionRef{name: parts[0]}
+	case len(parts) == 2 && (parts[0] == "env" || parts[0] == "params" || parts[0] == "matrix"):
+		ref = conditionRef{scope: parts[0], name: parts[1]}
+	case len(parts) == 4 && parts[0] == "steps" && parts[2] == "outputs":
+		ref = conditionRef{scope: "steps", name: parts[1], output: parts[3]}
+	default:
+		return nil, fmt.Errorf("unknown reference %q (use NAME, env.NAME, params.NAME, matrix.KEY, or steps.NAME.outputs.KEY)", strings.Join(parts, "."))
+	}
+	return &condRefNode{ref: ref}, nil
+}
@@ -0,0 +1,313 @@
+package workflow
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestParseCondition_Evaluates(t *testing.T) {
+	scope := &conditionScope{
+		env:    map[string]string{"DEPLOY": "yes", "BRANCH": "release/2.0", "EMPTY": ""},
+		params: map[string]string{"TRACK": "production", "COUNT": "3"},
+		matrix: map[string]string{"platform": "IOS"},
+		outputs: map[string]map[string]string{
+			"build": {"state": "VALID", "count": "12"},
+		},
+	}
+	tests := []struct {
+		expr string
+		want bool
+	}{
+		{"DEPLOY", true},
+		{"EMPTY", false},
+		{"env.DEPLOY", true},
+		{"!DEPLOY", false},
+		{"steps.build.outputs.state == 'VALID'", true},
+		{`steps.build.outputs.state != "VALID"`, false},
+		{"steps.missing.outputs.state == ''", true},
+		{"steps.build.outputs.count > 9", true},
+		{"steps.build.outputs.count <= 11.5", false},
+		{"params.COUNT == 3", true},
+		{"params.COUNT == 3.0", true},
+		{"params.TRACK == 3", false},
+		{"params.MISSING == ''", true},
+		{"matrix.platform == 'IOS' && params.TRACK == 'production'", true},
+		{"params.TRACK == 'beta' || contains(BRANCH, 'release/')", true},
+		{"startsWith(BRANCH, 'release/') && endsWith(BRANCH, '.0')", true},
+		{"!(DEPLOY && EMPTY)", true},
+		{"DEPLOY == true", true},
+		{"EMPTY == false", true},
+		{"'it''s' == \"it's\"", true},
+		{"always()", true},
+		{"success()", true},
+		{"failure()", false},
+		// || short-circuits, so the non-numeric comparison is never evaluated.
+		{"DEPLOY || BRANCH > 1", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			cond, err := parseCondition(tt.expr)
+			if err != nil {
+				t.Fatalf("parseCondition: %v", err)
+			}
+			got, err := cond.eval(scope)
+			if err != nil {
+				t.Fatalf("eval: %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestParseCondition_Errors(t *testing.T) {
+	tests := []struct {
+		expr    string
+		wantErr string
+	}{
+		{"steps.build.outputs.state ==", "unexpected end of expression"},
+		{"(DEPLOY", `expected ")"`},
+		{"DEPLOY DEPLOY", `unexpected "DEPLOY" at offset 7`},
+		{"'open", "unterminated string"},
+		{"$DEPLOY", `unexpected character '$'`},
+		{"steps.build.state", `unknown reference "steps.build.state"`},
+		{"secrets.TOKEN", `unknown reference "secrets.TOKEN"`},
+		{"'VALID'", "expression must be a boolean, not a string"},
+		{"1 == 'one'", "cannot compare a number with a string"},
+		{"steps.build.outputs.count > 'ten'", "operands of > must be numbers, not a string"},
+		{"DEPLOY && 'yes'", "operands of && must be booleans, not a string"},
+		{"!3", "operand of ! must be a boolean, not a number"},
+		{"contains(BRANCH)", "contains() takes 2 arguments, got 1"},
+		{"contains(BRANCH, true)", "arguments of contains() must be strings"},
+		{"always(DEPLOY)", "always() takes no arguments"},
+		{"matches(BRANCH, 'x')", "unknown function matches()"},
+		{"1 < 2 < 3", `unexpected "<"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			_, err := parseCondition(tt.expr)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestCondition_EvalRejectsNonNumericComparison(t *testing.T) {
+	cond, err := parseCondition("steps.build.outputs.state > 1")
+	if err != nil {
+		t.Fatalf("parseCondition: %v", err)
+	}
+	_, err = cond.eval(&conditionScope{outputs: map[string]map[string]string{"build": {"state": "VALID"}}})
+	if err == nil || !strings.Contains(err.Error(), `"VALID" is not a number`) {
+		t.Fatalf("expected numeric error, got %v", err)
+	}
+}
+
+func TestRun_ConditionUsesStepOutputsAndParams(t *testing.T) {
+	def := &Definition{
+		Workflows: map[string]Workflow{
+			"release": {Steps: []Step{
+				{Name: "status", Run: `echo '{"state":"VALID"}'`, Outputs: map[string]string{"STATE": "$.state"}},
+				{Run: "echo submit", If: "steps.status.outputs.STATE == 'VALID' && params.TRACK == 'production'"},
+				{Run: "echo beta", If: "params.TRACK == 'beta'"},
+			}},
+		},
+	}
+	opts := runOpts("release")
+	opts.Params = map[string]string{"TRACK": "production"}
+
+	result, err := Run(context.Background(), def, opts)
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if result.Steps[1].Status != "ok" || result.Steps[2].Status != "skipped" {
+		t.Fatalf("expected submit to run and beta to be skipped, got %+v", result.Steps)
+	}
+}
+
+func TestRun_AlwaysAndFailureStepsRunAfterFailure(t *testing.T) {
+	def := &Definition{
+		Workflows: map[string]Workflow{
+			"release": {Steps: []Step{
+				{Name: "upload", Run: "echo upload; exit 3"},
+				{Name: "publish", Run: "echo publish"},
+				{Name: "on-success", Run: "echo on-success", If: "success()"},
+				{Name: "notify", Run: "echo notify", If: "failure()"},
+				{Name: "cleanup", Workflow: "cleanup", If: "always()"},
+			}},
+			"cleanup": {Private: true, Steps: []Step{{Run: "echo cleanup"}}},
+		},
+	}
+	opts := runOpts("release")
+
+	result, err := Run(context.Background(), def, opts)
+	if err == nil || !strings.Contains(err.Error(), "release step 1") {
+		t.Fatalf("expected the original failure, got %v", err)
+	}
+	if result.Status != "error" || result.FailedStep != "upload" {
+		t.Fatalf("expected failed_step=upload, got status=%q failed_step=%q", result.Status, result.FailedStep)
+	}
+
+	stdout := opts.Stdout.(*bytes.Buffer).String()
+	if strings.Contains(stdout, "publish") || strings.Contains(stdout, "on-success") {
+		t.Fatalf("expected steps without a status function to be skipped, got %q", stdout)
+	}
+	for _, want := range []string{"notify", "cleanup"} {
+		if !strings.Contains(stdout, want) {
+			t.Fatalf("expected %q to run after the failure, got %q", want, stdout)
+		}
+	}
+
+	statuses := map[string]string{}
+	for _, step := range result.Steps {
+		statuses[step.Name] = step.Status
+	}
+	if _, ok := statuses["publish"]; ok {
+		t.Fatalf("expected publish not to be recorded, got %v", statuses)
+	}
+	if statuses["on-success"] != "skipped" || statuses["notify"] != "ok" {
+		t.Fatalf("unexpected step statuses %v", statuses)
+	}
+}
+
+func TestRun_FailureStepSkippedOnSuccess(t *testing.T) {
+	def := &Definition{
+		Workflows: map[string]Workflow{
+			"release": {Steps: []Step{
+				{Run: "echo ok"},
+				{Run: "echo notify", If: "failure()"},
+			}},
+		},
+	}
+	result, err := Run(context.Background(), def, runOpts("release"))
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if result.Steps[1].Status != "skipped" {
+		t.Fatalf("expected failure() step to be skipped, got %q", result.Steps[1].Status)
+	}
+}
+
+func TestRun_ResumeRerunsStepsThatRanAfterFailure(t *testing.T) {
+	dir := t.TempDir()
+	allowPath := filepath.Join(dir, "allow")
+	def := &Definition{
+		Workflows: map[string]Workflow{
+			"release": {Steps: []Step{
+				{Name: "upload", Run: "[ -f " + allowPath + " ]"},
+				{Name: "cleanup", Run: "echo cleanup", If: "always()"},
+			}},
+		},
+	}
+	opts := runOpts("release")
+	opts.WorkflowFile = filepath.Join(dir, "workflow.json")
+	opts.StateDir = filepath.Join(dir, "runs")
+
+	first, err := Run(context.Background(), def, opts)
+	if err == nil {
+		t.Fatal("expected first run to fail")
+	}
+	writeFileAt(t, allowPath, "ok")
+
+	stdout := &bytes.Buffer{}
+	opts.Stdout = stdout
+	opts.ResumeRunID = first.RunID
+	resumed, err := Run(context.Background(), def, opts)
+	if err != nil {
+		t.Fatalf("resume Run: %v", err)
+	}
+	if resumed.Steps[1].Status != "ok" || !strings.Contains(stdout.String(), "cleanup") {
+		t.Fatalf("expected cleanup to run again on resume, got %+v (stdout %q)", resumed.Steps, stdout.String())
+	}
+}
+
+func TestRun_MatrixConditionPerCombination(t *testing.T) {
+	def := &Definition{
+		Workflows: map[string]Workflow{
+			"release": {Steps: []Step{{
+				Run:    "echo platform=${{ matrix.platform }}",
+				If:     "matrix.platform != 'MAC_OS'",
+				Matrix: map[string][]string{"platform": {"IOS", "MAC_OS"}},
+			}}},
+		},
+	}
+	opts := runOpts("release")
+
+	result, err := Run(context.Background(), def, opts)
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if len(result.Steps) != 2 || result.Steps[0].Status != "ok" || result.Steps[1].Status != "skipped" {
+		t.Fatalf("expected IOS to run and MAC_OS to be skipped, got %+v", result.Steps)
+	}
+}
+
+func TestRun_InvalidConditionFailsStep(t *testing.T) {
+	def := &Definition{
+		Workflows: map[string]Workflow{
+			"release": {Steps: []Step{{Name: "publish", Run: "echo publish", If: "TRACK =="}}},
+		},
+	}
+	result, err := Run(context.Background(), def, runOpts("release"))
+	if err == nil || !strings.Contains(err.Error(), "release step 1: if:") {
+		t.Fatalf("expected condition error, got %v", err)
+	}
+	if result.FailedStep != "publish" {
+		t.Fatalf("expected failed_step=publish, got %q", result.FailedStep)
+	}
+}
+
+func TestValidate_Conditions(t *testing.T) {
+	tests := []struct {
+		name string
+		step Step
+		code ValidationCode
+	}{
+		{"syntax", Step{Run: "echo", If: "TRACK =="}, ErrInvalidIf},
+		{"type", Step{Run: "echo", If: "params.COUNT > 'ten'"}, ErrInvalidIf},
+		{"unknown output", Step{Run: "echo", If: "steps.build.outputs.MISSING == 'x'"}, ErrUnknownStepOutput},
+		{"unknown step", Step{Run: "echo", If: "steps.nope.outputs.STATE == 'x'"}, ErrUnknownStepOutput},
+		{"unknown matrix key", Step{Run: "echo", If: "matrix.app == 'a'", Matrix: map[string][]string{"platform": {"IOS"}}}, ErrUnknownMatrixKey},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			def := &Definition{
+				Workflows: map[string]Workflow{
+					"beta": {Steps: []Step{
+						{Name: "build", Run: "echo", Outputs: map[string]string{"STATE": "$.state"}},
+						tt.step,
+					}},
+				},
+			}
+			errs := Validate(def)
+			assertValidationCode(t, errs, tt.code)
+			if errs[0].Step != 2 {
+				t.Fatalf("expected error on step 2, got %+v", errs[0])
+			}
+		})
+	}
+}
+
+func TestValidate_ConditionsValid(t *testing.T) {
+	def := &Definition{
+		Workflows: map[string]Workflow{
+			"beta": {Steps: []Step{
+				{Name: "build", Run: "echo", Outputs: map[string]string{"STATE": "$.state"}},
+				{Run: "echo", If: "SUBMIT"},
+				{Run: "echo", If: "steps.build.outputs.STATE == 'VALID' && (params.COUNT > 2 || !env.SKIP)"},
+				{Run: "echo", If: "matrix.platform == 'IOS'", Matrix: map[string][]string{"platform": {"IOS", "MAC_OS"}}},
+				{Workflow: "notify", If: "failure()"},
+			}},
+			// Matrix values may come from a calling step.
+			"notify": {Private: true, Steps: []Step{{Run: "echo", If: "matrix.platform == 'IOS'"}}},
+		},
+	}
+	if errs := Validate(def); len(errs) != 0 {
+		t.Fatalf("expected no validation errors, got %v", errs)
+	}
+}
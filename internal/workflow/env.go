@@ -8,6 +8,7 @@ import (
 	"os/exec"
 	"strings"
 	"sync"
+	"time"
 )
 
 var (
@@ -19,6 +20,8 @@ var (
 	cachedShellFlags []string
 )
 
+const shellWaitDelay = time.Second
+
 // mergeEnv merges environment maps in order. Later values override earlier.
 func mergeEnv(maps ...map[string]string) map[string]string {
 	result := make(map[string]string)
@@ -119,6 +122,9 @@ func runShellCommand(ctx context.Context, command string, env map[string]string,
 	cmd.Env = buildEnvSlice(env)
 	cmd.Stdout = stdout
 	cmd.Stderr = stderr
+	// Once the context ends, don't wait on background children that still
+	// hold stdout/stderr open.
+	cmd.WaitDelay = shellWaitDelay
 	return cmd.Run()
 }
 
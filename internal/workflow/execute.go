@@ -7,8 +7,10 @@ import (
 	"io"
 	"maps"
 	"os"
+	"path/filepath"
 	"slices"
 	"strings"
+	"sync"
 	"time"
 )
 
@@ -25,11 +27,20 @@ type RunOptions struct {
 	WorkflowFile string
 	StateDir     string
 	ResumeRunID  string
+	// ASC runs "asc" steps in-process. Runs that contain asc steps fail
+	// without it.
+	ASC ASCRunner
+	// Keychain reads secrets declared with a keychain source. Runs that
+	// declare one fail without it.
+	Keychain SecretLookup
 }
 
-// StepResult records one executed step.
+// StepResult records one executed step. Steps inside a parallel group share
+// the group's Index and carry their 1-based Branch number. Steps run for a
+// matrix combination carry its values in Matrix.
 type StepResult struct {
 	Index          int               `json:"index"`
+	Branch         int               `json:"branch,omitempty"`
 	Name           string            `json:"name,omitempty"`
 	Command        string            `json:"command,omitempty"`
 	Workflow       string            `json:"workflow,omitempty"`
@@ -38,6 +49,15 @@ type StepResult struct {
 	DurationMS     int64             `json:"duration_ms"`
 	Error          string            `json:"error,omitempty"`
 	Outputs        map[string]string `json:"outputs,omitempty"`
+	Matrix         map[string]string `json:"matrix,omitempty"`
+	// Log is the file holding the step's stdout and stderr, for runs with
+	// a state directory.
+	Log string `json:"log,omitempty"`
+
+	// Attempts counts executions of a run step, including retries.
+	Attempts         int  `json:"attempts,omitempty"`
+	TimedOut         bool `json:"timed_out,omitempty"`
+	ContinuedOnError bool `json:"continued_on_error,omitempty"`
 }
 
 // HookResult records execution of a hook command (before_all/after_all/error).
@@ -85,6 +105,14 @@ type runner struct {
 	statePath      string
 	definitionHash string
 	outputs        map[string]map[string]string
+	mu             *sync.Mutex
+	// matrix is the combination being executed, if any.
+	matrix *matrixInstance
+	// afterFailure is set while running steps whose if condition let them
+	// run after an earlier step failed.
+	afterFailure bool
+	// masker hides secret values in results and run state.
+	masker *secretMasker
 }
 
 func (r *RunResult) ensureHooks() *HooksResult {
@@ -129,14 +157,33 @@ func Run(ctx context.Context, def *Definition, opts RunOptions) (*RunResult, err
 		result.DurationMS = time.Since(start).Milliseconds()
 	}()
 
+	// Dry runs print commands without expanding env, so secrets stay unread.
+	var secrets map[string]string
+	if !opts.DryRun {
+		var err error
+		if secrets, err = resolveSecrets(def.Secrets, opts.WorkflowFile, opts.Keychain); err != nil {
+			result.Status = "error"
+			result.Error = err.Error()
+			return result, err
+		}
+	}
+	masker := newSecretMasker(secrets)
+	if masker != nil {
+		stdout, stderr := masker.writer(opts.Stdout), masker.writer(opts.Stderr)
+		defer stdout.Flush()
+		defer stderr.Flush()
+		opts.Stdout, opts.Stderr = stdout, stderr
+	}
+
 	r, err := newRunner(def, opts, result)
 	if err != nil {
 		result.Status = "error"
 		result.Error = err.Error()
 		return result, err
 	}
+	r.masker = masker
 
-	env := mergeEnv(def.Env, wf.Env, r.opts.Params)
+	env := mergeEnv(def.Env, wf.Env, secrets, r.opts.Params)
 
 	if resumed := r.resumedHook("before_all", def.BeforeAll); resumed != nil {
 		result.ensureHooks().BeforeAll = resumed
@@ -192,6 +239,7 @@ func newRunner(def *Definition, opts RunOptions, result *RunResult) (*runner, er
 		opts:    opts,
 		result:  result,
 		outputs: map[string]map[string]string{},
+		mu:      &sync.Mutex{},
 	}
 
 	if opts.DryRun {
@@ -226,6 +274,10 @@ func newRunner(def *Definition, opts RunOptions, result *RunResult) (*runner, er
 		if err := r.validateResumeState(state); err != nil {
 			return nil, err
 		}
+		// History keeps the latest attempt; completed steps show as resumed.
+		state.Status = "running"
+		state.Error = ""
+		state.Results = nil
 		r.state = state
 		r.statePath = runFile
 		r.result.RunID = state.RunID
@@ -276,7 +328,11 @@ func (r *runner) outputsFromState(state *persistedRunState) map[string]map[strin
 		if strings.TrimSpace(step.Name) == "" || len(step.Outputs) == 0 {
 			continue
 		}
-		outputs[step.Name] = cloneStringMap(step.Outputs)
+		name := step.Name
+		if len(step.Matrix) > 0 {
+			name = matrixOutputName(name, matrixID(step.Matrix))
+		}
+		outputs[name] = cloneStringMap(step.Outputs)
 	}
 	return outputs
 }
@@ -327,190 +383,424 @@ func (r *runner) persistHook(which string, hook *HookResult) error {
 	return saveRunState(r.statePath, *r.state)
 }
 
+// executeSteps runs steps in order. After a step fails, only steps whose if
+// condition calls always(), success(), or failure() are still considered, so
+// cleanup steps can run; the first failure is returned.
 func (r *runner) executeSteps(ctx context.Context, workflowName string, steps []Step, env map[string]string, callPath string, depth int) error {
+	var firstErr error
 	for i, step := range steps {
 		idx := i + 1
-		stepKey := appendStepKey(callPath, workflowName, idx)
-		stepStart := time.Now()
-
-		sr := StepResult{
-			Index:    idx,
-			Name:     step.Name,
-			Command:  step.Run,
-			Workflow: strings.TrimSpace(step.Workflow),
+		pos := stepPosition{
+			workflow: workflowName,
+			index:    idx,
+			key:      appendStepKey(callPath, workflowName, idx),
+			label:    fmt.Sprintf("%s step %d", workflowName, idx),
 		}
-		if workflowName != r.opts.WorkflowName {
-			sr.ParentWorkflow = workflowName
-		}
-
-		if ifVar := strings.TrimSpace(step.If); ifVar != "" {
-			val, ok := env[ifVar]
-			if !ok {
-				val = os.Getenv(ifVar)
-			}
-			if !isTruthy(val) {
-				sr.Status = "skipped"
-				sr.DurationMS = time.Since(stepStart).Milliseconds()
-				r.result.Steps = append(r.result.Steps, sr)
+		stepRunner := r
+		if firstErr != nil {
+			if ctx.Err() != nil || !stepRunsAfterFailure(step) {
 				continue
 			}
+			cleanup := *r
+			cleanup.afterFailure = true
+			stepRunner = &cleanup
 		}
+		if err := stepRunner.executeStep(ctx, pos, step, env, depth); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
 
-		if ref := sr.Workflow; ref != "" {
-			if depth+1 > MaxCallDepth {
-				err := fmt.Errorf("workflow: %s step %d: max call depth %d exceeded", workflowName, idx, MaxCallDepth)
-				sr.Status = "error"
-				sr.Error = fmt.Sprintf("max call depth %d exceeded", MaxCallDepth)
-				sr.DurationMS = time.Since(stepStart).Milliseconds()
-				r.result.Steps = append(r.result.Steps, sr)
-				r.result.FailedStep = failedStepName(step.Name, stepKey)
-				return err
-			}
+// stepPosition locates a step within the run: its workflow, 1-based index,
+// persisted state key, and the label used in error messages. Parallel
+// branches also carry their 1-based branch number, and matrix combinations
+// their id.
+type stepPosition struct {
+	workflow string
+	index    int
+	branch   int
+	matrix   string
+	key      string
+	label    string
+}
 
-			subWf, ok := r.def.Workflows[ref]
-			if !ok {
-				err := fmt.Errorf("workflow: %s step %d: unknown workflow %q", workflowName, idx, ref)
-				sr.Status = "error"
-				sr.Error = fmt.Sprintf("unknown workflow %q", ref)
-				sr.DurationMS = time.Since(stepStart).Milliseconds()
-				r.result.Steps = append(r.result.Steps, sr)
-				r.result.FailedStep = failedStepName(step.Name, stepKey)
-				return err
-			}
+func (p stepPosition) dryRunLabel() string {
+	label := fmt.Sprintf("step %d", p.index)
+	if p.branch > 0 {
+		label += fmt.Sprintf(" branch %d", p.branch)
+	}
+	if p.matrix != "" {
+		label += " [" + p.matrix + "]"
+	}
+	return label
+}
 
-			resolvedWith := cloneStringMap(step.With)
-			var err error
-			if !r.opts.DryRun {
-				resolvedWith, err = interpolateMapValues(step.With, r.outputs)
-				if err != nil {
-					wrapped := fmt.Errorf("workflow: %s step %d: %w", workflowName, idx, err)
-					sr.Status = "error"
-					sr.Error = err.Error()
-					sr.DurationMS = time.Since(stepStart).Milliseconds()
-					r.result.Steps = append(r.result.Steps, sr)
-					r.result.FailedStep = failedStepName(step.Name, stepKey)
-					return wrapped
-				}
-			}
+func (r *runner) executeStep(ctx context.Context, pos stepPosition, step Step, env map[string]string, depth int) error {
+	stepStart := time.Now()
 
-			subEnv := mergeEnv(subWf.Env, env, resolvedWith)
-			if r.opts.DryRun {
-				fmt.Fprintf(r.opts.Stderr, "[dry-run] step %d: workflow %s\n", idx, ref)
-			}
+	sr := StepResult{
+		Index:    pos.index,
+		Branch:   pos.branch,
+		Name:     step.Name,
+		Command:  stepCommand(step),
+		Workflow: strings.TrimSpace(step.Workflow),
+		Matrix:   cloneStringMap(r.matrixValues()),
+	}
+	if pos.workflow != r.opts.WorkflowName {
+		sr.ParentWorkflow = pos.workflow
+	}
 
-			if err := r.executeSteps(ctx, ref, subWf.Steps, subEnv, stepKey, depth+1); err != nil {
-				return err
-			}
-			continue
-		}
+	// A matrix step's condition is checked per combination, so it can refer
+	// to matrix values.
+	if len(step.Matrix) > 0 {
+		return r.executeMatrix(ctx, pos, step, env, depth)
+	}
 
-		if r.state != nil {
-			if persisted, ok := r.state.Steps[stepKey]; ok && persisted.Status == "ok" {
-				sr.Status = "resumed"
-				sr.Outputs = cloneStringMap(persisted.Outputs)
-				sr.DurationMS = 0
-				r.result.Steps = append(r.result.Steps, sr)
-				if strings.TrimSpace(persisted.Name) != "" && len(persisted.Outputs) > 0 {
-					r.outputs[persisted.Name] = cloneStringMap(persisted.Outputs)
-					r.result.Outputs = cloneNestedStringMap(r.outputs)
-				}
-				continue
-			}
+	if src := strings.TrimSpace(step.If); src != "" {
+		ok, err := r.evalCondition(src, env)
+		if err != nil {
+			sr.Status = "error"
+			sr.Error = fmt.Sprintf("if: %v", err)
+			sr.DurationMS = time.Since(stepStart).Milliseconds()
+			r.recordStep(sr)
+			r.setFailedStep(failedStepName(step.Name, pos.key))
+			return fmt.Errorf("workflow: %s: if: %w", pos.label, err)
 		}
+		if !ok {
+			sr.Status = "skipped"
+			sr.DurationMS = time.Since(stepStart).Milliseconds()
+			r.recordStep(sr)
+			return nil
+		}
+	}
+
+	resolved, err := applyMatrix(step, r.matrixValues())
+	if err != nil {
+		sr.Status = "error"
+		sr.Error = err.Error()
+		sr.DurationMS = time.Since(stepStart).Milliseconds()
+		r.recordStep(sr)
+		r.setFailedStep(failedStepName(step.Name, pos.key))
+		return fmt.Errorf("workflow: %s: %w", pos.label, err)
+	}
+	step = resolved
+	sr.Command = stepCommand(step)
 
+	if len(step.Parallel) > 0 {
 		if r.opts.DryRun {
-			fmt.Fprintf(r.opts.Stderr, "[dry-run] step %d: %s\n", idx, step.Run)
-			sr.Status = "dry-run"
-			sr.DurationMS = time.Since(stepStart).Milliseconds()
-			r.result.Steps = append(r.result.Steps, sr)
-			continue
+			fmt.Fprintf(r.opts.Stderr, "[dry-run] %s: parallel (%d branches)\n", pos.dryRunLabel(), len(step.Parallel))
 		}
+		return r.executeParallel(ctx, pos, step, env, depth)
+	}
 
-		command, err := interpolateCommand(step.Run, r.outputs)
-		if err != nil {
-			wrapped := fmt.Errorf("workflow: %s step %d: %w", workflowName, idx, err)
+	if ref := sr.Workflow; ref != "" {
+		if depth+1 > MaxCallDepth {
+			err := fmt.Errorf("workflow: %s: max call depth %d exceeded", pos.label, MaxCallDepth)
 			sr.Status = "error"
-			sr.Error = err.Error()
+			sr.Error = fmt.Sprintf("max call depth %d exceeded", MaxCallDepth)
 			sr.DurationMS = time.Since(stepStart).Milliseconds()
-			r.result.Steps = append(r.result.Steps, sr)
-			r.result.FailedStep = failedStepName(step.Name, stepKey)
-			return wrapped
-		}
-
-		stdout := r.opts.Stdout
-		var captured bytes.Buffer
-		if len(step.Outputs) > 0 {
-			stdout = io.MultiWriter(r.opts.Stdout, &captured)
+			r.recordStep(sr)
+			r.setFailedStep(failedStepName(step.Name, pos.key))
+			return err
 		}
 
-		if err := runShellCommand(ctx, command, env, stdout, r.opts.Stderr); err != nil {
-			wrapped := fmt.Errorf("workflow: %s step %d: %w", workflowName, idx, err)
+		subWf, ok := r.def.Workflows[ref]
+		if !ok {
+			err := fmt.Errorf("workflow: %s: unknown workflow %q", pos.label, ref)
 			sr.Status = "error"
-			sr.Error = err.Error()
+			sr.Error = fmt.Sprintf("unknown workflow %q", ref)
 			sr.DurationMS = time.Since(stepStart).Milliseconds()
-			r.result.Steps = append(r.result.Steps, sr)
-			r.result.FailedStep = failedStepName(step.Name, stepKey)
-			return wrapped
+			r.recordStep(sr)
+			r.setFailedStep(failedStepName(step.Name, pos.key))
+			return err
 		}
 
-		if len(step.Outputs) > 0 {
-			extracted, err := extractDeclaredOutputs(step.Outputs, captured.Bytes())
+		resolvedWith := cloneStringMap(step.With)
+		if !r.opts.DryRun {
+			resolvedWith, err = interpolateMapValues(step.With, r.currentOutputs())
 			if err != nil {
-				wrapped := fmt.Errorf("workflow: %s step %d: %w", workflowName, idx, err)
+				wrapped := fmt.Errorf("workflow: %s: %w", pos.label, err)
 				sr.Status = "error"
 				sr.Error = err.Error()
 				sr.DurationMS = time.Since(stepStart).Milliseconds()
-				r.result.Steps = append(r.result.Steps, sr)
-				r.result.FailedStep = failedStepName(step.Name, stepKey)
+				r.recordStep(sr)
+				r.setFailedStep(failedStepName(step.Name, pos.key))
 				return wrapped
 			}
-			sr.Outputs = extracted
-			if strings.TrimSpace(step.Name) != "" {
-				r.outputs[step.Name] = cloneStringMap(extracted)
-				r.result.Outputs = cloneNestedStringMap(r.outputs)
-			}
 		}
 
-		sr.Status = "ok"
+		subEnv := mergeEnv(subWf.Env, env, resolvedWith)
+		if r.opts.DryRun {
+			fmt.Fprintf(r.opts.Stderr, "[dry-run] %s: workflow %s\n", pos.dryRunLabel(), ref)
+		}
+
+		return r.executeSteps(ctx, ref, subWf.Steps, subEnv, pos.key, depth+1)
+	}
+
+	if persisted, ok := r.persistedStep(pos.key); ok && persisted.Status == "ok" {
+		sr.Status = "resumed"
+		sr.Outputs = cloneStringMap(persisted.Outputs)
+		sr.DurationMS = 0
+		r.recordStep(sr)
+		if strings.TrimSpace(persisted.Name) != "" && len(persisted.Outputs) > 0 {
+			r.setOutputs(persisted.Name, persisted.Outputs)
+		}
+		return nil
+	}
+
+	if logFile := r.openStepLog(pos.key); logFile != nil {
+		defer logFile.Close()
+		sr.Log = logFile.Name()
+		stepRunner := *r
+		var log io.Writer = &lockedWriter{w: logFile}
+		if r.masker != nil {
+			masked := r.masker.writer(logFile)
+			defer masked.Flush()
+			log = masked
+		}
+		stepRunner.opts.Stdout = io.MultiWriter(r.opts.Stdout, log)
+		stepRunner.opts.Stderr = io.MultiWriter(r.opts.Stderr, log)
+		r = &stepRunner
+	}
+
+	if len(step.ASC) > 0 {
+		return r.executeASCStep(ctx, pos, step, env, sr, stepStart)
+	}
+
+	if r.opts.DryRun {
+		fmt.Fprintf(r.opts.Stderr, "[dry-run] %s: %s\n", pos.dryRunLabel(), step.Run)
+		sr.Status = "dry-run"
 		sr.DurationMS = time.Since(stepStart).Milliseconds()
-		r.result.Steps = append(r.result.Steps, sr)
+		r.recordStep(sr)
+		return nil
+	}
 
-		if err := r.persistStep(stepKey, sr); err != nil {
-			r.result.FailedStep = failedStepName(step.Name, stepKey)
-			return err
+	command, err := interpolateCommand(step.Run, r.currentOutputs())
+	if err != nil {
+		wrapped := fmt.Errorf("workflow: %s: %w", pos.label, err)
+		sr.Status = "error"
+		sr.Error = err.Error()
+		sr.DurationMS = time.Since(stepStart).Milliseconds()
+		r.recordStep(sr)
+		r.setFailedStep(failedStepName(step.Name, pos.key))
+		return wrapped
+	}
+
+	stdout := r.opts.Stdout
+	var captured bytes.Buffer
+	if len(step.Outputs) > 0 {
+		stdout = io.MultiWriter(r.opts.Stdout, &captured)
+	}
+
+	attempts, timedOut, err := r.runWithPolicy(ctx, pos, step, func(ctx context.Context) error {
+		captured.Reset()
+		return runShellCommand(ctx, command, env, stdout, r.opts.Stderr)
+	})
+	sr.Attempts = attempts
+	sr.TimedOut = timedOut
+	if err != nil {
+		wrapped := fmt.Errorf("workflow: %s: %w", pos.label, err)
+		sr.Status = "error"
+		if ctx.Err() != nil {
+			sr.Status = "cancelled"
 		}
+		sr.Error = err.Error()
+		sr.DurationMS = time.Since(stepStart).Milliseconds()
+		if step.ContinueOnError && ctx.Err() == nil {
+			sr.ContinuedOnError = true
+			r.recordStep(sr)
+			fmt.Fprintf(r.opts.Stderr, "workflow: %s failed; continuing (continue_on_error): %v\n", pos.label, err)
+			return nil
+		}
+		r.recordStep(sr)
+		r.setFailedStep(failedStepName(step.Name, pos.key))
+		return wrapped
+	}
+
+	if len(step.Outputs) > 0 {
+		extracted, err := extractDeclaredOutputs(step.Outputs, captured.Bytes())
+		if err != nil {
+			wrapped := fmt.Errorf("workflow: %s: %w", pos.label, err)
+			sr.Status = "error"
+			sr.Error = err.Error()
+			sr.DurationMS = time.Since(stepStart).Milliseconds()
+			r.recordStep(sr)
+			r.setFailedStep(failedStepName(step.Name, pos.key))
+			return wrapped
+		}
+		sr.Outputs = extracted
+		if strings.TrimSpace(step.Name) != "" {
+			r.setOutputs(step.Name, extracted)
+		}
+	}
+
+	sr.Status = "ok"
+	sr.DurationMS = time.Since(stepStart).Milliseconds()
+	r.recordStep(sr)
+
+	if err := r.persistStep(pos.key, sr); err != nil {
+		r.setFailedStep(failedStepName(step.Name, pos.key))
+		return err
 	}
 	return nil
 }
 
+// recordStep, setFailedStep, setOutputs, currentOutputs, persistedStep, and
+// persistStep guard state shared between parallel branches.
+func (r *runner) recordStep(sr StepResult) {
+	sr.Error = r.masker.mask(sr.Error)
+	sr.Outputs = r.masker.maskMap(sr.Outputs)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.result.Steps = append(r.result.Steps, sr)
+	if r.state != nil {
+		r.state.Results = append(r.state.Results, sr)
+		// History is best effort; resume state is saved by persistStep.
+		_ = saveRunState(r.statePath, *r.state)
+	}
+}
+
+// openStepLog opens the append-only log for a step, or returns nil when the
+// run keeps no state. Failing to open it does not fail the step.
+func (r *runner) openStepLog(stepKey string) *os.File {
+	if r.state == nil {
+		return nil
+	}
+	dir := stepLogDir(filepath.Dir(r.statePath), r.state.RunID)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		fmt.Fprintf(r.opts.Stderr, "workflow: step log unavailable: %v\n", err)
+		return nil
+	}
+	f, err := os.OpenFile(filepath.Join(dir, stepLogFileName(stepKey)), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		fmt.Fprintf(r.opts.Stderr, "workflow: step log unavailable: %v\n", err)
+		return nil
+	}
+	return f
+}
+
+// lockedWriter serializes writes from a command's stdout and stderr copiers.
+type lockedWriter struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func (l *lockedWriter) Write(p []byte) (int, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.w.Write(p)
+}
+
+// evalCondition evaluates a step's if condition against the step env, run
+// params, current matrix combination, and outputs published so far.
+func (r *runner) evalCondition(src string, env map[string]string) (bool, error) {
+	cond, err := parseCondition(src)
+	if err != nil {
+		return false, err
+	}
+	return cond.eval(&conditionScope{
+		env:     env,
+		params:  r.opts.Params,
+		matrix:  r.matrixValues(),
+		outputs: r.currentOutputs(),
+		failed:  r.failed(),
+	})
+}
+
+// failed reports whether a step has failed the run so far. Steps that
+// continued on error do not count.
+func (r *runner) failed() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.result.FailedStep != ""
+}
+
+// setFailedStep keeps the first failure when several branches fail.
+func (r *runner) setFailedStep(name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.result.FailedStep == "" {
+		r.result.FailedStep = name
+	}
+}
+
+// setOutputs publishes a step's outputs. Inside a matrix combination they are
+// visible by name only to that combination and are published as NAME[id].
+func (r *runner) setOutputs(name string, outputs map[string]string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.matrix != nil {
+		r.matrix.outputs[name] = cloneStringMap(outputs)
+		name = matrixOutputName(name, r.matrix.id)
+	}
+	r.outputs[name] = cloneStringMap(outputs)
+	r.result.Outputs = r.publishedOutputs()
+}
+
+// publishedOutputs returns the outputs to report, with secrets masked. The
+// unmasked values stay in r.outputs for later steps to reference.
+func (r *runner) publishedOutputs() map[string]map[string]string {
+	return r.masker.maskNestedMap(cloneNestedStringMap(r.outputs))
+}
+
+func (r *runner) currentOutputs() map[string]map[string]string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	outputs := cloneNestedStringMap(r.outputs)
+	if r.matrix != nil && len(r.matrix.outputs) > 0 {
+		if outputs == nil {
+			outputs = map[string]map[string]string{}
+		}
+		maps.Copy(outputs, cloneNestedStringMap(r.matrix.outputs))
+	}
+	return outputs
+}
+
+func (r *runner) persistedStep(stepKey string) (persistedStepState, bool) {
+	if r.state == nil {
+		return persistedStepState{}, false
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	persisted, ok := r.state.Steps[stepKey]
+	return persisted, ok
+}
+
+// persistStep records a finished step for --resume. Steps that ran after a
+// failure are not recorded, so they run again when the run is resumed.
 func (r *runner) persistStep(stepKey string, sr StepResult) error {
-	if r.state == nil || sr.Status != "ok" {
+	if r.state == nil || sr.Status != "ok" || r.afterFailure {
 		return nil
 	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
 	r.state.Steps[stepKey] = persistedStepState{
 		Name:           sr.Name,
 		Workflow:       sr.Workflow,
 		ParentWorkflow: sr.ParentWorkflow,
 		Status:         "ok",
-		Outputs:        cloneStringMap(sr.Outputs),
+		Outputs:        r.masker.maskMap(cloneStringMap(sr.Outputs)),
+		Matrix:         cloneStringMap(sr.Matrix),
 	}
 	return saveRunState(r.statePath, *r.state)
 }
 
 func (r *runner) finishSuccess() error {
-	r.result.Outputs = cloneNestedStringMap(r.outputs)
+	r.result.Outputs = r.publishedOutputs()
 	if r.state == nil {
 		return nil
 	}
 	r.state.Status = "ok"
 	r.state.FailedStep = ""
+	r.state.Error = ""
 	return saveRunState(r.statePath, *r.state)
 }
 
 func (r *runner) markFailure(err error, failedStep string) {
 	r.result.Status = "error"
-	r.result.Error = err.Error()
-	r.result.Outputs = cloneNestedStringMap(r.outputs)
+	r.result.Error = r.masker.mask(err.Error())
+	r.result.Outputs = r.publishedOutputs()
 	if strings.TrimSpace(failedStep) != "" {
 		r.result.FailedStep = failedStep
 	}
@@ -520,6 +810,7 @@ func (r *runner) markFailure(err error, failedStep string) {
 	}
 	r.state.Status = "error"
 	r.state.FailedStep = r.result.FailedStep
+	r.state.Error = r.result.Error
 	_ = saveRunState(r.statePath, *r.state)
 
 	if r.hasRecoverableState() {
@@ -4,8 +4,10 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"testing"
 )
@@ -1567,3 +1569,754 @@ func TestRun_DurationMS_IncludesAfterAll(t *testing.T) {
 		t.Fatalf("expected DurationMS >= 100 (must include after_all time), got %d", result.DurationMS)
 	}
 }
+
+func TestRun_ParallelBranchesRunConcurrently(t *testing.T) {
+	dir := t.TempDir()
+	// Each branch waits for the other's marker file, so the run only succeeds
+	// when both branches are in flight at the same time.
+	rendezvous := func(mine, theirs string) string {
+		return fmt.Sprintf(
+			`touch %q; for i in $(seq 100); do if [ -f %q ]; then echo %s; exit 0; fi; sleep 0.05; done; exit 1`,
+			filepath.Join(dir, mine), filepath.Join(dir, theirs), mine,
+		)
+	}
+	def := &Definition{
+		Workflows: map[string]Workflow{
+			"release": {Steps: []Step{
+				{Parallel: []Step{
+					{Name: "screenshots", Run: rendezvous("a", "b")},
+					{Name: "metadata", Run: rendezvous("b", "a")},
+				}},
+				{Run: "echo done"},
+			}},
+		},
+	}
+	opts := runOpts("release")
+
+	result, err := Run(context.Background(), def, opts)
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if len(result.Steps) != 3 {
+		t.Fatalf("expected 3 step results, got %+v", result.Steps)
+	}
+	for _, sr := range result.Steps[:2] {
+		if sr.Index != 1 || sr.Branch == 0 || sr.Status != "ok" {
+			t.Fatalf("unexpected branch result: %+v", sr)
+		}
+	}
+	if result.Steps[2].Index != 2 || result.Steps[2].Branch != 0 {
+		t.Fatalf("expected follow-up step after the group, got %+v", result.Steps[2])
+	}
+	stdout := opts.Stdout.(*bytes.Buffer).String()
+	if !strings.Contains(stdout, "a\n") || !strings.Contains(stdout, "b\n") || !strings.HasSuffix(stdout, "done\n") {
+		t.Fatalf("unexpected stdout: %q", stdout)
+	}
+}
+
+func TestRun_ParallelNeedsOrderBranchesAndShareOutputs(t *testing.T) {
+	def := &Definition{
+		Workflows: map[string]Workflow{
+			"release": {Steps: []Step{{Parallel: []Step{
+				{Name: "distribute", Run: "echo distributed ${steps.upload.BUILD_ID}", Needs: []string{"upload"}},
+				{Name: "upload", Run: `sleep 0.1; printf '{"buildId":"build-7"}'`, Outputs: map[string]string{"BUILD_ID": "$.buildId"}},
+			}}}},
+		},
+	}
+	opts := runOpts("release")
+
+	result, err := Run(context.Background(), def, opts)
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if got := []string{result.Steps[0].Name, result.Steps[1].Name}; got[0] != "upload" || got[1] != "distribute" {
+		t.Fatalf("expected upload to finish before distribute, got %v", got)
+	}
+	if result.Steps[0].Branch != 2 || result.Steps[1].Branch != 1 {
+		t.Fatalf("expected branch numbers to follow declaration order, got %+v", result.Steps)
+	}
+	if result.Outputs["upload"]["BUILD_ID"] != "build-7" {
+		t.Fatalf("expected upload outputs, got %#v", result.Outputs)
+	}
+	if stdout := opts.Stdout.(*bytes.Buffer).String(); !strings.Contains(stdout, "distributed build-7") {
+		t.Fatalf("expected distribute to see upload output, got %q", stdout)
+	}
+}
+
+func TestRun_ParallelMaxParallelBoundsConcurrency(t *testing.T) {
+	lock := filepath.Join(t.TempDir(), "lock")
+	branch := fmt.Sprintf(`mkdir %q && sleep 0.05 && rmdir %q`, lock, lock)
+	def := &Definition{
+		Workflows: map[string]Workflow{
+			"release": {Steps: []Step{{
+				MaxParallel: 1,
+				Parallel:    []Step{{Run: branch}, {Run: branch}, {Run: branch}},
+			}}},
+		},
+	}
+
+	result, err := Run(context.Background(), def, runOpts("release"))
+	if err != nil {
+		t.Fatalf("Run: %v (steps %+v)", err, result.Steps)
+	}
+	if len(result.Steps) != 3 {
+		t.Fatalf("expected 3 branch results, got %+v", result.Steps)
+	}
+}
+
+func TestRun_ParallelFailFastCancelsRemainingBranches(t *testing.T) {
+	def := &Definition{
+		Workflows: map[string]Workflow{
+			"release": {Steps: []Step{
+				{
+					MaxParallel: 2,
+					Parallel: []Step{
+						{Name: "upload", Run: "echo upload failed >&2; exit 3"},
+						{Name: "metadata", Run: "sleep 10"},
+						{Name: "distribute", Run: "echo distribute"},
+					},
+				},
+				{Run: "echo never"},
+			}},
+		},
+	}
+	opts := runOpts("release")
+
+	result, err := Run(context.Background(), def, opts)
+	if err == nil {
+		t.Fatal("expected group failure")
+	}
+	if !strings.Contains(err.Error(), "release step 1 branch 1") {
+		t.Fatalf("expected error to locate the failing branch, got %v", err)
+	}
+	if result.FailedStep != "upload" {
+		t.Fatalf("expected failed_step=upload, got %q", result.FailedStep)
+	}
+	statuses := map[string]string{}
+	for _, sr := range result.Steps {
+		statuses[sr.Name] = sr.Status
+	}
+	want := map[string]string{"upload": "error", "metadata": "cancelled", "distribute": "cancelled"}
+	for name, status := range want {
+		if statuses[name] != status {
+			t.Fatalf("expected %s status %q, got %q (all: %v)", name, status, statuses[name], statuses)
+		}
+	}
+	if len(result.Steps) != 3 {
+		t.Fatalf("expected the step after the group not to run, got %+v", result.Steps)
+	}
+	if stderr := opts.Stderr.(*bytes.Buffer).String(); !strings.Contains(stderr, "upload failed") {
+		t.Fatalf("expected branch stderr to be flushed, got %q", stderr)
+	}
+}
+
+func TestRun_ParallelWaitAllRunsIndependentBranches(t *testing.T) {
+	failFast := false
+	def := &Definition{
+		Workflows: map[string]Workflow{
+			"release": {Steps: []Step{{
+				FailFast: &failFast,
+				Parallel: []Step{
+					{Name: "upload", Run: "exit 3"},
+					{Name: "metadata", Run: "sleep 0.1; echo metadata"},
+					{Name: "distribute", Run: "echo distribute", Needs: []string{"upload"}},
+					{Name: "notify", Run: "echo notify", Needs: []string{"distribute"}},
+				},
+			}}},
+		},
+	}
+	opts := runOpts("release")
+
+	result, err := Run(context.Background(), def, opts)
+	if err == nil {
+		t.Fatal("expected group failure")
+	}
+	statuses := map[string]string{}
+	for _, sr := range result.Steps {
+		statuses[sr.Name] = sr.Status
+	}
+	want := map[string]string{"upload": "error", "metadata": "ok", "distribute": "skipped", "notify": "skipped"}
+	for name, status := range want {
+		if statuses[name] != status {
+			t.Fatalf("expected %s status %q, got %q (all: %v)", name, status, statuses[name], statuses)
+		}
+	}
+	stdout := opts.Stdout.(*bytes.Buffer).String()
+	if !strings.Contains(stdout, "metadata") || strings.Contains(stdout, "distribute") {
+		t.Fatalf("unexpected stdout: %q", stdout)
+	}
+}
+
+func TestRun_ParallelResumeRerunsOnlyFailedBranches(t *testing.T) {
+	dir := t.TempDir()
+	counterPath := filepath.Join(dir, "upload-count.txt")
+	allowPath := filepath.Join(dir, "allow-metadata")
+	failFast := false
+
+	def := &Definition{
+		Workflows: map[string]Workflow{
+			"release": {Steps: []Step{{
+				FailFast: &failFast,
+				Parallel: []Step{
+					{
+						Name:    "upload",
+						Run:     fmt.Sprintf(`printf 'hit\n' >> %q && printf '{"buildId":"build-42"}'`, counterPath),
+						Outputs: map[string]string{"BUILD_ID": "$.buildId"},
+					},
+					{Name: "metadata", Run: fmt.Sprintf(`[ -f %q ]`, allowPath)},
+				},
+			}}},
+		},
+	}
+
+	runFile := filepath.Join(dir, "workflow.json")
+	stateDir := filepath.Join(dir, "runs")
+
+	firstOpts := runOpts("release")
+	firstOpts.WorkflowFile = runFile
+	firstOpts.StateDir = stateDir
+
+	firstResult, err := Run(context.Background(), def, firstOpts)
+	if err == nil {
+		t.Fatal("expected first run to fail")
+	}
+	if !firstResult.Recoverable || firstResult.FailedStep != "metadata" {
+		t.Fatalf("expected recoverable failure at metadata, got %+v", firstResult)
+	}
+
+	if writeErr := os.WriteFile(allowPath, []byte("ok"), 0o600); writeErr != nil {
+		t.Fatalf("write allow file: %v", writeErr)
+	}
+
+	resumeOpts := runOpts("release")
+	resumeOpts.WorkflowFile = runFile
+	resumeOpts.StateDir = stateDir
+	resumeOpts.ResumeRunID = firstResult.RunID
+
+	resumeResult, resumeErr := Run(context.Background(), def, resumeOpts)
+	if resumeErr != nil {
+		t.Fatalf("resume Run: %v", resumeErr)
+	}
+	statuses := map[string]string{}
+	for _, sr := range resumeResult.Steps {
+		statuses[sr.Name] = sr.Status
+	}
+	if statuses["upload"] != "resumed" || statuses["metadata"] != "ok" {
+		t.Fatalf("unexpected resumed statuses: %v", statuses)
+	}
+	if resumeResult.Outputs["upload"]["BUILD_ID"] != "build-42" {
+		t.Fatalf("expected resumed branch outputs, got %#v", resumeResult.Outputs)
+	}
+
+	countBytes, readErr := os.ReadFile(counterPath)
+	if readErr != nil {
+		t.Fatalf("read upload counter: %v", readErr)
+	}
+	if got := strings.Count(string(countBytes), "hit\n"); got != 1 {
+		t.Fatalf("expected upload branch to run once, got %d", got)
+	}
+}
+
+func TestRun_ParallelDryRunIsSequential(t *testing.T) {
+	def := &Definition{
+		Workflows: map[string]Workflow{
+			"release": {Steps: []Step{{Parallel: []Step{
+				{Name: "b", Run: "echo b", Needs: []string{"a"}},
+				{Name: "a", Run: "echo a"},
+			}}}},
+		},
+	}
+	opts := runOpts("release")
+	opts.DryRun = true
+
+	if _, err := Run(context.Background(), def, opts); err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	want := "[dry-run] step 1: parallel (2 branches)\n" +
+		"[dry-run] step 1 branch 2: echo a\n" +
+		"[dry-run] step 1 branch 1: echo b\n"
+	if got := opts.Stderr.(*bytes.Buffer).String(); got != want {
+		t.Fatalf("unexpected dry-run output:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestRun_RetryRerunsFailedStep(t *testing.T) {
+	counterPath := filepath.Join(t.TempDir(), "attempts.txt")
+	def := &Definition{
+		Workflows: map[string]Workflow{
+			"release": {Steps: []Step{{
+				Name:    "wait",
+				Run:     fmt.Sprintf(`printf 'x' >> %q; [ "$(cat %q)" = "xxx" ] && printf '{"state":"VALID"}'`, counterPath, counterPath),
+				Retry:   &StepRetry{Attempts: 3, Backoff: "10ms"},
+				Outputs: map[string]string{"STATE": "$.state"},
+			}}},
+		},
+	}
+	opts := runOpts("release")
+
+	result, err := Run(context.Background(), def, opts)
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	sr := result.Steps[0]
+	if sr.Status != "ok" || sr.Attempts != 3 {
+		t.Fatalf("expected ok after 3 attempts, got %+v", sr)
+	}
+	if sr.Outputs["STATE"] != "VALID" {
+		t.Fatalf("expected outputs from the successful attempt, got %#v", sr.Outputs)
+	}
+	if stderr := opts.Stderr.(*bytes.Buffer).String(); !strings.Contains(stderr, "attempt 2/3 failed") {
+		t.Fatalf("expected retry notice on stderr, got %q", stderr)
+	}
+}
+
+func TestRun_RetryExhaustedFailsStep(t *testing.T) {
+	def := &Definition{
+		Workflows: map[string]Workflow{
+			"release": {Steps: []Step{{Name: "flaky", Run: "exit 1", Retry: &StepRetry{Attempts: 2}}}},
+		},
+	}
+
+	result, err := Run(context.Background(), def, runOpts("release"))
+	if err == nil {
+		t.Fatal("expected failure after retries")
+	}
+	if sr := result.Steps[0]; sr.Status != "error" || sr.Attempts != 2 {
+		t.Fatalf("expected error after 2 attempts, got %+v", sr)
+	}
+}
+
+func TestRun_TimeoutFailsStep(t *testing.T) {
+	def := &Definition{
+		Workflows: map[string]Workflow{
+			"release": {Steps: []Step{{Name: "wait", Run: "sleep 5", Timeout: "100ms"}}},
+		},
+	}
+
+	result, err := Run(context.Background(), def, runOpts("release"))
+	if err == nil {
+		t.Fatal("expected timeout failure")
+	}
+	if !strings.Contains(err.Error(), "timed out after 100ms") {
+		t.Fatalf("expected timeout error, got %v", err)
+	}
+	sr := result.Steps[0]
+	if sr.Status != "error" || !sr.TimedOut || sr.Attempts != 1 {
+		t.Fatalf("expected timed-out step, got %+v", sr)
+	}
+	if sr.DurationMS >= 5000 {
+		t.Fatalf("expected step to stop at the timeout, took %dms", sr.DurationMS)
+	}
+}
+
+func TestRun_TimeoutAppliesPerAttempt(t *testing.T) {
+	counterPath := filepath.Join(t.TempDir(), "attempts.txt")
+	def := &Definition{
+		Workflows: map[string]Workflow{
+			"release": {Steps: []Step{{
+				Run:     fmt.Sprintf(`printf 'x' >> %q; if [ "$(cat %q)" = "x" ]; then exec sleep 5; fi`, counterPath, counterPath),
+				Timeout: "200ms",
+				Retry:   &StepRetry{Attempts: 2},
+			}}},
+		},
+	}
+
+	result, err := Run(context.Background(), def, runOpts("release"))
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if sr := result.Steps[0]; sr.Status != "ok" || sr.Attempts != 2 || sr.TimedOut {
+		t.Fatalf("expected second attempt to succeed, got %+v", sr)
+	}
+}
+
+func TestRun_ContinueOnErrorKeepsRunning(t *testing.T) {
+	def := &Definition{
+		Workflows: map[string]Workflow{
+			"release": {Steps: []Step{
+				{Name: "notify", Run: "exit 2", ContinueOnError: true},
+				{Run: "echo after"},
+			}},
+		},
+	}
+	opts := runOpts("release")
+
+	result, err := Run(context.Background(), def, opts)
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if result.Status != "ok" || result.FailedStep != "" {
+		t.Fatalf("expected run to succeed, got %+v", result)
+	}
+	if sr := result.Steps[0]; sr.Status != "error" || !sr.ContinuedOnError || sr.Error == "" {
+		t.Fatalf("expected failed step to be recorded, got %+v", sr)
+	}
+	if stdout := opts.Stdout.(*bytes.Buffer).String(); !strings.Contains(stdout, "after") {
+		t.Fatalf("expected next step to run, got %q", stdout)
+	}
+}
+
+func TestRun_ASCStepRunsAndExtractsOutputs(t *testing.T) {
+	var calls [][]string
+	def := &Definition{
+		Env: map[string]string{"APP_ID": "123"},
+		Workflows: map[string]Workflow{
+			"release": {Steps: []Step{
+				{
+					Name:    "latest",
+					ASC:     []string{"builds", "info", "--app", "$APP_ID", "--latest"},
+					Outputs: map[string]string{"BUILD_ID": "$.data.id"},
+				},
+				{ASC: []string{"builds", "add-groups", "--build-id", "${steps.latest.BUILD_ID}", "--group", "it's beta"}},
+			}},
+		},
+	}
+	opts := runOpts("release")
+	opts.ASC = func(_ context.Context, args []string, env map[string]string, stdout, _ io.Writer) ([]byte, error) {
+		calls = append(calls, args)
+		fmt.Fprintln(stdout, "table output")
+		if args[0] == "builds" && args[1] == "info" {
+			return []byte(`{"data":{"id":"build-9"}}`), nil
+		}
+		return nil, nil
+	}
+
+	result, err := Run(context.Background(), def, opts)
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if len(calls) != 2 {
+		t.Fatalf("expected 2 asc calls, got %v", calls)
+	}
+	if got := strings.Join(calls[0], " "); got != "builds info --app 123 --latest" {
+		t.Fatalf("unexpected first args: %q", got)
+	}
+	if got := calls[1][3]; got != "build-9" {
+		t.Fatalf("expected step output to be substituted, got %q", got)
+	}
+	if got := calls[1][5]; got != "it's beta" {
+		t.Fatalf("expected argument to be passed verbatim, got %q", got)
+	}
+	if result.Outputs["latest"]["BUILD_ID"] != "build-9" {
+		t.Fatalf("expected outputs from structured result, got %#v", result.Outputs)
+	}
+	if got := result.Steps[1].Command; got != `asc builds add-groups --build-id ${steps.latest.BUILD_ID} --group 'it'"'"'s beta'` {
+		t.Fatalf("unexpected recorded command: %q", got)
+	}
+	if result.Steps[0].Attempts != 1 {
+		t.Fatalf("expected attempts to be recorded, got %+v", result.Steps[0])
+	}
+}
+
+func TestRun_ASCStepOutputsRequireStructuredResult(t *testing.T) {
+	def := &Definition{
+		Workflows: map[string]Workflow{
+			"release": {Steps: []Step{{
+				Name:    "latest",
+				ASC:     []string{"builds", "info"},
+				Outputs: map[string]string{"BUILD_ID": "$.data.id"},
+			}}},
+		},
+	}
+	opts := runOpts("release")
+	opts.ASC = func(context.Context, []string, map[string]string, io.Writer, io.Writer) ([]byte, error) {
+		return nil, nil
+	}
+
+	_, err := Run(context.Background(), def, opts)
+	if err == nil || !strings.Contains(err.Error(), "no structured result") {
+		t.Fatalf("expected missing result error, got %v", err)
+	}
+}
+
+func TestRun_ASCStepRetriesFailures(t *testing.T) {
+	calls := 0
+	def := &Definition{
+		Workflows: map[string]Workflow{
+			"release": {Steps: []Step{{ASC: []string{"builds", "wait"}, Retry: &StepRetry{Attempts: 2}}}},
+		},
+	}
+	opts := runOpts("release")
+	opts.ASC = func(context.Context, []string, map[string]string, io.Writer, io.Writer) ([]byte, error) {
+		calls++
+		if calls == 1 {
+			return nil, fmt.Errorf("connection reset")
+		}
+		return nil, nil
+	}
+
+	result, err := Run(context.Background(), def, opts)
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if result.Steps[0].Attempts != 2 {
+		t.Fatalf("expected 2 attempts, got %+v", result.Steps[0])
+	}
+}
+
+func TestRun_ASCStepWithoutRunnerFails(t *testing.T) {
+	def := &Definition{
+		Workflows: map[string]Workflow{
+			"release": {Steps: []Step{{ASC: []string{"apps", "list"}}}},
+		},
+	}
+
+	result, err := Run(context.Background(), def, runOpts("release"))
+	if err == nil {
+		t.Fatal("expected error without an asc runner")
+	}
+	if result.Steps[0].Status != "error" {
+		t.Fatalf("expected step error, got %+v", result.Steps[0])
+	}
+}
+
+func TestRun_ASCStepDryRun(t *testing.T) {
+	def := &Definition{
+		Workflows: map[string]Workflow{
+			"release": {Steps: []Step{{ASC: []string{"apps", "view", "--id", "$APP_ID"}}}},
+		},
+	}
+	opts := runOpts("release")
+	opts.DryRun = true
+	opts.ASC = func(context.Context, []string, map[string]string, io.Writer, io.Writer) ([]byte, error) {
+		t.Fatal("dry run must not execute asc steps")
+		return nil, nil
+	}
+
+	if _, err := Run(context.Background(), def, opts); err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if got := opts.Stderr.(*bytes.Buffer).String(); got != "[dry-run] step 1: asc apps view --id $APP_ID\n" {
+		t.Fatalf("unexpected dry-run output: %q", got)
+	}
+}
+
+func TestRun_MatrixExpandsCombinationsWithNamespacedOutputs(t *testing.T) {
+	def := &Definition{
+		Workflows: map[string]Workflow{
+			"release": {Steps: []Step{
+				{
+					Workflow: "ship",
+					Matrix: map[string][]string{
+						"platform": {"IOS", "MAC_OS"},
+						"app":      {"a", "b"},
+					},
+					With: map[string]string{"APP": "${{ matrix.app }}"},
+				},
+				{Run: `echo after=${steps.upload[app=b,platform=MAC_OS].ID}`},
+			}},
+			"ship": {Private: true, Steps: []Step{
+				{
+					Name:    "upload",
+					Run:     `printf '{"id":"%s-%s"}\n' "$APP" ${{ matrix.platform }}`,
+					Outputs: map[string]string{"ID": "$.id"},
+				},
+				{Run: `echo got=${steps.upload.ID}`},
+			}},
+		},
+	}
+	opts := runOpts("release")
+
+	result, err := Run(context.Background(), def, opts)
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+
+	stdout := opts.Stdout.(*bytes.Buffer).String()
+	var got []string
+	for _, line := range strings.Split(strings.TrimSpace(stdout), "\n") {
+		if strings.HasPrefix(line, "got=") || strings.HasPrefix(line, "after=") {
+			got = append(got, line)
+		}
+	}
+	want := []string{"got=a-IOS", "got=a-MAC_OS", "got=b-IOS", "got=b-MAC_OS", "after=b-MAC_OS"}
+	if !slices.Equal(got, want) {
+		t.Fatalf("unexpected step output order:\n%v\nwant:\n%v", got, want)
+	}
+
+	if len(result.Outputs) != 4 {
+		t.Fatalf("expected 4 namespaced outputs, got %#v", result.Outputs)
+	}
+	if _, ok := result.Outputs["upload"]; ok {
+		t.Fatalf("expected matrix outputs to be namespaced, got %#v", result.Outputs)
+	}
+	if got := result.Outputs["upload[app=a,platform=MAC_OS]"]["ID"]; got != "a-MAC_OS" {
+		t.Fatalf("unexpected namespaced output: %q", got)
+	}
+
+	var matrixSteps int
+	for _, sr := range result.Steps {
+		if sr.Name == "upload" {
+			matrixSteps++
+			if sr.Matrix["app"] == "" || sr.Matrix["platform"] == "" {
+				t.Fatalf("expected matrix values on step result, got %+v", sr)
+			}
+		}
+	}
+	if matrixSteps != 4 {
+		t.Fatalf("expected 4 upload step results, got %d", matrixSteps)
+	}
+}
+
+func TestRun_MatrixDelimitersInValuesDoNotCollide(t *testing.T) {
+	// Validation rejects these values; Run must still keep the two
+	// combinations apart when given an unvalidated definition.
+	def := &Definition{
+		Workflows: map[string]Workflow{
+			"release": {Steps: []Step{
+				{
+					Name:    "upload",
+					Run:     `echo '{"id":"joined"}'`,
+					Outputs: map[string]string{"ID": "$.id"},
+					Matrix:  map[string][]string{"a": {"x,b=y"}},
+				},
+				{
+					Name:    "upload",
+					Run:     `echo '{"id":"split"}'`,
+					Outputs: map[string]string{"ID": "$.id"},
+					Matrix:  map[string][]string{"a": {"x"}, "b": {"y"}},
+				},
+			}},
+		},
+	}
+
+	result, err := Run(context.Background(), def, runOpts("release"))
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if got := result.Outputs["upload[a=x%2Cb%3Dy]"]["ID"]; got != "joined" {
+		t.Fatalf("expected escaped combination output, got %#v", result.Outputs)
+	}
+	if got := result.Outputs["upload[a=x,b=y]"]["ID"]; got != "split" {
+		t.Fatalf("expected unescaped combination output, got %#v", result.Outputs)
+	}
+	if got := matrixID(map[string]string{"app": "My App", "platform": "IOS"}); got != "app=My App,platform=IOS" {
+		t.Fatalf("expected valid values to read as written, got %q", got)
+	}
+}
+
+func TestRun_MatrixRunStepQuotesValues(t *testing.T) {
+	def := &Definition{
+		Workflows: map[string]Workflow{
+			"release": {Steps: []Step{{
+				Run:    `printf '%s|' ${{ matrix.name }} "${{ matrix.name }}"`,
+				Matrix: map[string][]string{"name": {"My App", `it's "x"`}},
+			}}},
+		},
+	}
+	opts := runOpts("release")
+
+	result, err := Run(context.Background(), def, opts)
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if got := opts.Stdout.(*bytes.Buffer).String(); got != `My App|My App|it's "x"|it's "x"|` {
+		t.Fatalf("unexpected stdout: %q", got)
+	}
+	if got := result.Steps[0].Command; got != `printf '%s|' 'My App' "My App"` {
+		t.Fatalf("expected recorded command to show the combination, got %q", got)
+	}
+}
+
+func TestRun_MatrixReferenceOutsideMatrixFails(t *testing.T) {
+	def := &Definition{
+		Workflows: map[string]Workflow{
+			"release": {Steps: []Step{{Run: "echo ${{ matrix.app }}"}}},
+		},
+	}
+
+	result, err := Run(context.Background(), def, runOpts("release"))
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if !strings.Contains(err.Error(), "outside a matrix step") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Steps[0].Status != "error" {
+		t.Fatalf("expected failed step result, got %+v", result.Steps)
+	}
+}
+
+func TestRun_MatrixResumeRerunsOnlyUnfinishedCombinations(t *testing.T) {
+	dir := t.TempDir()
+	counterPath := filepath.Join(dir, "count.txt")
+	allowPath := filepath.Join(dir, "allow-b")
+
+	def := &Definition{
+		Workflows: map[string]Workflow{
+			"release": {Steps: []Step{{
+				Name: "upload",
+				Run: fmt.Sprintf(`printf '%%s\n' ${{ matrix.app }} >> %q && { [ ${{ matrix.app }} = a ] || [ -f %q ]; } && printf '{"id":"id-%%s"}' ${{ matrix.app }}`,
+					counterPath, allowPath),
+				Outputs: map[string]string{"ID": "$.id"},
+				Matrix:  map[string][]string{"app": {"a", "b"}},
+			}}},
+		},
+	}
+
+	firstOpts := runOpts("release")
+	firstOpts.WorkflowFile = filepath.Join(dir, "workflow.json")
+	firstOpts.StateDir = filepath.Join(dir, "runs")
+
+	firstResult, err := Run(context.Background(), def, firstOpts)
+	if err == nil {
+		t.Fatal("expected first run to fail")
+	}
+	if !firstResult.Recoverable {
+		t.Fatalf("expected recoverable failure, got %+v", firstResult)
+	}
+	if !strings.Contains(err.Error(), "[app=b]") {
+		t.Fatalf("expected error to name the combination, got %v", err)
+	}
+
+	if writeErr := os.WriteFile(allowPath, []byte("ok"), 0o600); writeErr != nil {
+		t.Fatalf("write allow file: %v", writeErr)
+	}
+
+	resumeOpts := firstOpts
+	resumeOpts.Stdout = &bytes.Buffer{}
+	resumeOpts.Stderr = &bytes.Buffer{}
+	resumeOpts.ResumeRunID = firstResult.RunID
+
+	resumeResult, err := Run(context.Background(), def, resumeOpts)
+	if err != nil {
+		t.Fatalf("resume Run: %v", err)
+	}
+	if len(resumeResult.Steps) != 2 || resumeResult.Steps[0].Status != "resumed" || resumeResult.Steps[1].Status != "ok" {
+		t.Fatalf("unexpected resumed steps: %+v", resumeResult.Steps)
+	}
+	if resumeResult.Outputs["upload[app=a]"]["ID"] != "id-a" || resumeResult.Outputs["upload[app=b]"]["ID"] != "id-b" {
+		t.Fatalf("unexpected outputs after resume: %#v", resumeResult.Outputs)
+	}
+
+	data, err := os.ReadFile(counterPath)
+	if err != nil {
+		t.Fatalf("read counter: %v", err)
+	}
+	if got := string(data); got != "a\nb\nb\n" {
+		t.Fatalf("expected only combination b to rerun, got %q", got)
+	}
+}
+
+func TestRun_MatrixDryRun(t *testing.T) {
+	def := &Definition{
+		Workflows: map[string]Workflow{
+			"release": {Steps: []Step{{
+				ASC:    []string{"builds", "list", "--platform", "${{ matrix.platform }}"},
+				Matrix: map[string][]string{"platform": {"IOS", "TV_OS"}},
+			}}},
+		},
+	}
+	opts := runOpts("release")
+	opts.DryRun = true
+
+	if _, err := Run(context.Background(), def, opts); err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	want := "[dry-run] step 1: matrix (2 combinations)\n" +
+		"[dry-run] step 1 [platform=IOS]: asc builds list --platform IOS\n" +
+		"[dry-run] step 1 [platform=TV_OS]: asc builds list --platform TV_OS\n"
+	if got := opts.Stderr.(*bytes.Buffer).String(); got != want {
+		t.Fatalf("unexpected dry-run output:\n%s\nwant:\n%s", got, want)
+	}
+}
@@ -0,0 +1,319 @@
+package workflow
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// Graph output formats accepted by RenderGraph.
+const (
+	GraphFormatMermaid = "mermaid"
+	GraphFormatDOT     = "dot"
+)
+
+// maxGraphLabel bounds the command text shown for a step.
+const maxGraphLabel = 48
+
+// RenderGraph renders the workflows in def as a Mermaid flowchart or a
+// Graphviz DOT digraph. Each workflow is a cluster of its steps in run order;
+// parallel groups fan out to their branches (following needs) and back in,
+// and workflow steps link to the cluster they call with a dashed edge.
+//
+// When root is set, only root and the workflows it calls, directly or
+// through other workflows, are rendered.
+func RenderGraph(def *Definition, root, format string) (string, error) {
+	if def == nil {
+		return "", fmt.Errorf("workflow: definition is nil")
+	}
+	names, err := graphWorkflowNames(def, root)
+	if err != nil {
+		return "", err
+	}
+	graph := buildGraph(def, names)
+
+	switch format {
+	case "", GraphFormatMermaid:
+		return graph.mermaid(), nil
+	case GraphFormatDOT:
+		return graph.dot(), nil
+	default:
+		return "", fmt.Errorf("workflow: unknown graph format %q (want %s or %s)", format, GraphFormatMermaid, GraphFormatDOT)
+	}
+}
+
+// graphWorkflowNames returns the workflows to render: every workflow sorted
+// by name, or root followed by the workflows it reaches in call order.
+func graphWorkflowNames(def *Definition, root string) ([]string, error) {
+	root = strings.TrimSpace(root)
+	if root == "" {
+		return slices.Sorted(maps.Keys(def.Workflows)), nil
+	}
+	if _, ok := def.Workflows[root]; !ok {
+		return nil, fmt.Errorf("workflow: unknown workflow %q", root)
+	}
+	names := []string{root}
+	seen := map[string]bool{root: true}
+	for i := 0; i < len(names); i++ {
+		for _, step := range def.Workflows[names[i]].Steps {
+			for _, ref := range stepWorkflowRefs(step) {
+				if _, ok := def.Workflows[ref]; ok && !seen[ref] {
+					seen[ref] = true
+					names = append(names, ref)
+				}
+			}
+		}
+	}
+	return names, nil
+}
+
+type graphNodeKind int
+
+const (
+	graphNodeRun graphNodeKind = iota
+	graphNodeASC
+	graphNodeCall
+	graphNodeParallel
+)
+
+type graphNode struct {
+	id    string
+	label string
+	kind  graphNodeKind
+}
+
+type graphEdge struct {
+	from, to string
+}
+
+type graphCluster struct {
+	id    string
+	label string
+	nodes []graphNode
+	edges []graphEdge
+}
+
+type workflowGraph struct {
+	clusters []*graphCluster
+	// calls link workflow steps to the cluster ID they call.
+	calls []graphEdge
+}
+
+func buildGraph(def *Definition, names []string) *workflowGraph {
+	clusterIDs := make(map[string]string, len(names))
+	for i, name := range names {
+		clusterIDs[name] = fmt.Sprintf("w%d", i+1)
+	}
+
+	graph := &workflowGraph{}
+	for _, name := range names {
+		wf := def.Workflows[name]
+		cluster := &graphCluster{id: clusterIDs[name], label: name}
+		if wf.Private {
+			cluster.label += " (private)"
+		}
+
+		addNode := func(id string, step Step) {
+			cluster.nodes = append(cluster.nodes, graphNode{id: id, label: graphStepLabel(step), kind: graphStepKind(step)})
+			if target, ok := clusterIDs[strings.TrimSpace(step.Workflow)]; ok && len(step.Parallel) == 0 {
+				graph.calls = append(graph.calls, graphEdge{from: id, to: target})
+			}
+		}
+
+		var exits []string
+		for i, step := range wf.Steps {
+			id := fmt.Sprintf("%ss%d", cluster.id, i+1)
+			addNode(id, step)
+			for _, from := range exits {
+				cluster.edges = append(cluster.edges, graphEdge{from: from, to: id})
+			}
+			exits = []string{id}
+			if len(step.Parallel) == 0 {
+				continue
+			}
+
+			// Branches without needs start from the group; branches no
+			// sibling needs lead on to the next step.
+			branchIDs := make(map[string]string, len(step.Parallel))
+			for j, branch := range step.Parallel {
+				if branch.Name != "" {
+					branchIDs[branch.Name] = fmt.Sprintf("%sb%d", id, j+1)
+				}
+			}
+			needed := map[string]bool{}
+			for _, branch := range step.Parallel {
+				for _, need := range branch.Needs {
+					needed[need] = true
+				}
+			}
+			exits = nil
+			for j, branch := range step.Parallel {
+				branchID := fmt.Sprintf("%sb%d", id, j+1)
+				addNode(branchID, branch)
+				linked := false
+				for _, need := range branch.Needs {
+					if from, ok := branchIDs[need]; ok {
+						cluster.edges = append(cluster.edges, graphEdge{from: from, to: branchID})
+						linked = true
+					}
+				}
+				if !linked {
+					cluster.edges = append(cluster.edges, graphEdge{from: id, to: branchID})
+				}
+				if branch.Name == "" || !needed[branch.Name] {
+					exits = append(exits, branchID)
+				}
+			}
+		}
+		graph.clusters = append(graph.clusters, cluster)
+	}
+	return graph
+}
+
+func graphStepKind(step Step) graphNodeKind {
+	switch {
+	case len(step.Parallel) > 0:
+		return graphNodeParallel
+	case strings.TrimSpace(step.Workflow) != "":
+		return graphNodeCall
+	case len(step.ASC) > 0:
+		return graphNodeASC
+	default:
+		return graphNodeRun
+	}
+}
+
+// graphStepLabel returns the lines shown for a step: its name or action,
+// followed by its condition and matrix size when set.
+func graphStepLabel(step Step) string {
+	var action string
+	switch graphStepKind(step) {
+	case graphNodeParallel:
+		action = fmt.Sprintf("parallel (%d branches)", len(step.Parallel))
+		if step.MaxParallel > 0 {
+			action = fmt.Sprintf("parallel (%d branches, max %d)", len(step.Parallel), step.MaxParallel)
+		}
+	case graphNodeCall:
+		action = "workflow " + strings.TrimSpace(step.Workflow)
+	case graphNodeASC:
+		action = truncateGraphLabel("asc " + strings.Join(step.ASC, " "))
+	default:
+		action = truncateGraphLabel(step.Run)
+	}
+
+	lines := []string{action}
+	if name := strings.TrimSpace(step.Name); name != "" {
+		lines[0] = name
+		if graphStepKind(step) == graphNodeCall || graphStepKind(step) == graphNodeParallel {
+			lines = append(lines, action)
+		}
+	}
+	if cond := strings.TrimSpace(step.If); cond != "" {
+		lines = append(lines, "if: "+truncateGraphLabel(cond))
+	}
+	if len(step.Matrix) > 0 {
+		lines = append(lines, fmt.Sprintf("matrix: %d combinations", matrixCombinationCount(step.Matrix)))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// truncateGraphLabel keeps the first line of s, shortened to maxGraphLabel runes.
+func truncateGraphLabel(s string) string {
+	s = strings.TrimSpace(s)
+	line, _, multiline := strings.Cut(s, "\n")
+	line = strings.TrimSpace(line)
+	runes := []rune(line)
+	if len(runes) > maxGraphLabel {
+		return string(runes[:maxGraphLabel-1]) + "…"
+	}
+	if multiline {
+		return line + " …"
+	}
+	return line
+}
+
+func (g *workflowGraph) mermaid() string {
+	var b strings.Builder
+	b.WriteString("flowchart TD\n")
+	for _, cluster := range g.clusters {
+		fmt.Fprintf(&b, "  subgraph %s[%s]\n", cluster.id, mermaidLabel(cluster.label))
+		b.WriteString("    direction TB\n")
+		for _, node := range cluster.nodes {
+			label := mermaidLabel(node.label)
+			switch node.kind {
+			case graphNodeASC:
+				fmt.Fprintf(&b, "    %s(%s)\n", node.id, label)
+			case graphNodeCall:
+				fmt.Fprintf(&b, "    %s[[%s]]\n", node.id, label)
+			case graphNodeParallel:
+				fmt.Fprintf(&b, "    %s{{%s}}\n", node.id, label)
+			default:
+				fmt.Fprintf(&b, "    %s[%s]\n", node.id, label)
+			}
+		}
+		for _, edge := range cluster.edges {
+			fmt.Fprintf(&b, "    %s --> %s\n", edge.from, edge.to)
+		}
+		b.WriteString("  end\n")
+	}
+	for _, edge := range g.calls {
+		fmt.Fprintf(&b, "  %s -. calls .-> %s\n", edge.from, edge.to)
+	}
+	return b.String()
+}
+
+// mermaidLabel quotes a label, using Mermaid's entity codes for characters
+// that would end the string.
+func mermaidLabel(s string) string {
+	s = strings.NewReplacer(`"`, "#quot;", "\n", "<br/>").Replace(s)
+	return `"` + s + `"`
+}
+
+func (g *workflowGraph) dot() string {
+	var b strings.Builder
+	b.WriteString("digraph workflows {\n")
+	b.WriteString("  compound=true;\n")
+	b.WriteString("  node [shape=box, fontname=\"Helvetica\"];\n")
+
+	// Call edges point at a cluster's first node and clip to the cluster.
+	entries := make(map[string]string, len(g.clusters))
+	for _, cluster := range g.clusters {
+		fmt.Fprintf(&b, "  subgraph cluster_%s {\n", cluster.id)
+		fmt.Fprintf(&b, "    label=%s;\n", dotLabel(cluster.label))
+		if len(cluster.nodes) == 0 {
+			// Graphviz drops empty clusters; keep a placeholder to link to.
+			entries[cluster.id] = cluster.id + "_empty"
+			fmt.Fprintf(&b, "    %s [label=\"(no steps)\", style=dashed];\n", entries[cluster.id])
+		} else {
+			entries[cluster.id] = cluster.nodes[0].id
+		}
+		for _, node := range cluster.nodes {
+			attrs := "label=" + dotLabel(node.label)
+			switch node.kind {
+			case graphNodeASC:
+				attrs += ", style=rounded"
+			case graphNodeCall:
+				attrs += ", peripheries=2"
+			case graphNodeParallel:
+				attrs += ", shape=hexagon"
+			}
+			fmt.Fprintf(&b, "    %s [%s];\n", node.id, attrs)
+		}
+		for _, edge := range cluster.edges {
+			fmt.Fprintf(&b, "    %s -> %s;\n", edge.from, edge.to)
+		}
+		b.WriteString("  }\n")
+	}
+	for _, edge := range g.calls {
+		fmt.Fprintf(&b, "  %s -> %s [lhead=cluster_%s, style=dashed, label=\"calls\"];\n", edge.from, entries[edge.to], edge.to)
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// dotLabel quotes s as a DOT string with centered line breaks.
+func dotLabel(s string) string {
+	s = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(s)
+	return `"` + s + `"`
+}
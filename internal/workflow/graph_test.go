@@ -0,0 +1,102 @@
+package workflow
+
+import (
+	"strings"
+	"testing"
+)
+
+func graphTestDefinition() *Definition {
+	return &Definition{
+		Workflows: map[string]Workflow{
+			"release": {Steps: []Step{
+				{Name: "build", Run: "xcodebuild archive \\\n  -scheme App"},
+				{Name: "checks", Parallel: []Step{
+					{Name: "lint", Run: "swiftlint"},
+					{Name: "test", Run: "swift test"},
+					{Name: "report", Run: "echo done", Needs: []string{"lint", "test"}},
+				}},
+				{Name: "upload", Workflow: "upload", If: `params.channel == "beta"`, Matrix: map[string][]string{"platform": {"IOS", "MAC_OS"}}},
+			}},
+			"upload": {Private: true, Steps: []Step{
+				{ASC: []string{"builds", "upload", "--ipa", "App.ipa"}},
+			}},
+			"unrelated": {Steps: []Step{{Run: "echo"}}},
+		},
+	}
+}
+
+func TestRenderGraph_Mermaid(t *testing.T) {
+	out, err := RenderGraph(graphTestDefinition(), "release", GraphFormatMermaid)
+	if err != nil {
+		t.Fatalf("RenderGraph: %v", err)
+	}
+	for _, want := range []string{
+		"flowchart TD\n",
+		`subgraph w1["release"]`,
+		`subgraph w2["upload (private)"]`,
+		`w1s1["build"]`,
+		`w1s2{{"checks<br/>parallel (3 branches)"}}`,
+		`w1s3[["upload<br/>workflow upload<br/>if: params.channel == #quot;beta#quot;<br/>matrix: 2 combinations"]]`,
+		`w2s1("asc builds upload --ipa App.ipa")`,
+		// Fan out to independent branches, follow needs, fan in from the last.
+		"w1s1 --> w1s2\n",
+		"w1s2 --> w1s2b1\n",
+		"w1s2 --> w1s2b2\n",
+		"w1s2b1 --> w1s2b3\n",
+		"w1s2b2 --> w1s2b3\n",
+		"w1s2b3 --> w1s3\n",
+		"w1s3 -. calls .-> w2\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected %q in:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "unrelated") {
+		t.Fatalf("expected only workflows reachable from the root, got:\n%s", out)
+	}
+	if strings.Contains(out, "w1s2b1 --> w1s3") {
+		t.Fatalf("expected needed branches not to lead to the next step, got:\n%s", out)
+	}
+}
+
+func TestRenderGraph_DOT(t *testing.T) {
+	out, err := RenderGraph(graphTestDefinition(), "", GraphFormatDOT)
+	if err != nil {
+		t.Fatalf("RenderGraph: %v", err)
+	}
+	for _, want := range []string{
+		"digraph workflows {\n",
+		"compound=true;",
+		// Without a root every workflow is drawn, sorted by name.
+		"subgraph cluster_w1 {\n    label=\"release\";",
+		"subgraph cluster_w2 {\n    label=\"unrelated\";",
+		"subgraph cluster_w3 {\n    label=\"upload (private)\";",
+		`w1s1 [label="build"];`,
+		`w1s2 [label="checks\nparallel (3 branches)", shape=hexagon];`,
+		`w3s1 [label="asc builds upload --ipa App.ipa", style=rounded];`,
+		`w1s3 -> w3s1 [lhead=cluster_w3, style=dashed, label="calls"];`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected %q in:\n%s", want, out)
+		}
+	}
+}
+
+func TestRenderGraph_Errors(t *testing.T) {
+	if _, err := RenderGraph(graphTestDefinition(), "missing", GraphFormatMermaid); err == nil || !strings.Contains(err.Error(), `unknown workflow "missing"`) {
+		t.Fatalf("expected unknown workflow error, got %v", err)
+	}
+	if _, err := RenderGraph(graphTestDefinition(), "", "svg"); err == nil || !strings.Contains(err.Error(), `unknown graph format "svg"`) {
+		t.Fatalf("expected unknown format error, got %v", err)
+	}
+}
+
+func TestGraphStepLabel_TruncatesCommands(t *testing.T) {
+	if got := graphStepLabel(Step{Run: "xcodebuild archive \\\n  -scheme App"}); got != `xcodebuild archive \ …` {
+		t.Fatalf("expected the first line of a multi-line command, got %q", got)
+	}
+	long := strings.Repeat("a", maxGraphLabel+10)
+	if got := graphStepLabel(Step{Run: long}); len([]rune(got)) != maxGraphLabel {
+		t.Fatalf("expected a label of %d runes, got %q", maxGraphLabel, got)
+	}
+}
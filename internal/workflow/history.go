@@ -0,0 +1,132 @@
+package workflow
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+)
+
+// RunRecord describes a persisted workflow run, as read from StateDir.
+type RunRecord struct {
+	RunID        string            `json:"run_id"`
+	Workflow     string            `json:"workflow"`
+	WorkflowFile string            `json:"workflow_file,omitempty"`
+	Status       string            `json:"status"`
+	Error        string            `json:"error,omitempty"`
+	FailedStep   string            `json:"failed_step,omitempty"`
+	Params       map[string]string `json:"params,omitempty"`
+	CreatedAt    string            `json:"created_at,omitempty"`
+	UpdatedAt    string            `json:"updated_at,omitempty"`
+	DurationMS   int64             `json:"duration_ms"`
+	StepCount    int               `json:"step_count"`
+	RunFile      string            `json:"run_file"`
+	Steps        []StepResult      `json:"steps,omitempty"`
+}
+
+// ListRuns returns the runs persisted in stateDir, newest first, without
+// their step results. A missing directory has no runs.
+func ListRuns(stateDir string) ([]RunRecord, error) {
+	entries, err := os.ReadDir(stateDir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("read workflow runs: %w", err)
+	}
+
+	runs := make([]RunRecord, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".json" {
+			continue
+		}
+		path := filepath.Join(stateDir, entry.Name())
+		state, err := loadRunState(path)
+		if err != nil || state == nil || state.RunID == "" {
+			// Skip files that are not run state rather than failing the listing.
+			continue
+		}
+		record := newRunRecord(state, path)
+		record.Steps = nil
+		runs = append(runs, record)
+	}
+
+	slices.SortFunc(runs, func(a, b RunRecord) int {
+		return cmp.Or(
+			cmp.Compare(b.CreatedAt, a.CreatedAt),
+			cmp.Compare(b.RunID, a.RunID),
+		)
+	})
+	return runs, nil
+}
+
+// LoadRun returns one persisted run with its step results.
+func LoadRun(stateDir, runID string) (*RunRecord, error) {
+	path := runStateFilePath(stateDir, runID)
+	state, err := loadRunState(path)
+	if err != nil {
+		return nil, err
+	}
+	if state == nil {
+		return nil, fmt.Errorf("workflow run %q not found", runID)
+	}
+	record := newRunRecord(state, path)
+	return &record, nil
+}
+
+// PruneRuns deletes runs last updated before cutoff, along with their step
+// logs, and returns them. With dryRun it only reports what would be deleted.
+func PruneRuns(stateDir string, cutoff time.Time, dryRun bool) ([]RunRecord, error) {
+	runs, err := ListRuns(stateDir)
+	if err != nil {
+		return nil, err
+	}
+
+	pruned := make([]RunRecord, 0)
+	for _, run := range runs {
+		updated, err := time.Parse(time.RFC3339, cmp.Or(run.UpdatedAt, run.CreatedAt))
+		if err != nil || !updated.Before(cutoff) {
+			continue
+		}
+		if !dryRun {
+			if err := os.Remove(run.RunFile); err != nil && !errors.Is(err, os.ErrNotExist) {
+				return pruned, fmt.Errorf("delete workflow run %q: %w", run.RunID, err)
+			}
+			if err := os.RemoveAll(stepLogDir(stateDir, run.RunID)); err != nil {
+				return pruned, fmt.Errorf("delete workflow run %q logs: %w", run.RunID, err)
+			}
+		}
+		pruned = append(pruned, run)
+	}
+	return pruned, nil
+}
+
+func newRunRecord(state *persistedRunState, path string) RunRecord {
+	record := RunRecord{
+		RunID:        state.RunID,
+		Workflow:     state.Workflow,
+		WorkflowFile: state.WorkflowFile,
+		Status:       state.Status,
+		Error:        state.Error,
+		FailedStep:   state.FailedStep,
+		Params:       cloneStringMap(state.Params),
+		CreatedAt:    state.CreatedAt,
+		UpdatedAt:    state.UpdatedAt,
+		StepCount:    len(state.Results),
+		RunFile:      path,
+		Steps:        slices.Clone(state.Results),
+	}
+	created, createdErr := time.Parse(time.RFC3339, state.CreatedAt)
+	updated, updatedErr := time.Parse(time.RFC3339, state.UpdatedAt)
+	if createdErr == nil && updatedErr == nil && !updated.Before(created) {
+		record.DurationMS = updated.Sub(created).Milliseconds()
+	}
+	if strings.TrimSpace(record.Status) == "" {
+		record.Status = "unknown"
+	}
+	return record
+}
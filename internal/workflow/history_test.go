@@ -0,0 +1,205 @@
+package workflow
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRun_PersistsStepResultsAndLogs(t *testing.T) {
+	dir := t.TempDir()
+	def := &Definition{
+		Workflows: map[string]Workflow{
+			"release": {Steps: []Step{
+				{Name: "build", Run: "echo building; echo warning >&2"},
+				{Name: "skip", Run: "echo never", If: "UNSET_FLAG_FOR_TEST"},
+				{Name: "upload", Run: "echo uploading; exit 3"},
+			}},
+		},
+	}
+	opts := runOpts("release")
+	opts.WorkflowFile = filepath.Join(dir, "workflow.json")
+	opts.StateDir = filepath.Join(dir, "runs")
+
+	result, err := Run(context.Background(), def, opts)
+	if err == nil {
+		t.Fatal("expected run to fail")
+	}
+
+	record, err := LoadRun(opts.StateDir, result.RunID)
+	if err != nil {
+		t.Fatalf("LoadRun: %v", err)
+	}
+	if record.Status != "error" || record.FailedStep != "upload" || !strings.Contains(record.Error, "exit status 3") {
+		t.Fatalf("unexpected run record: %+v", record)
+	}
+	if len(record.Steps) != 3 || record.StepCount != 3 {
+		t.Fatalf("expected 3 persisted step results, got %+v", record.Steps)
+	}
+	if record.Steps[1].Status != "skipped" || record.Steps[1].Log != "" {
+		t.Fatalf("expected skipped step without a log, got %+v", record.Steps[1])
+	}
+
+	build, err := os.ReadFile(record.Steps[0].Log)
+	if err != nil {
+		t.Fatalf("read build log: %v", err)
+	}
+	if got := string(build); !strings.Contains(got, "building\n") || !strings.Contains(got, "warning\n") {
+		t.Fatalf("expected stdout and stderr in build log, got %q", got)
+	}
+	upload, err := os.ReadFile(record.Steps[2].Log)
+	if err != nil {
+		t.Fatalf("read upload log: %v", err)
+	}
+	if string(upload) != "uploading\n" {
+		t.Fatalf("unexpected upload log: %q", upload)
+	}
+	if record.Steps[0].Log != result.Steps[0].Log {
+		t.Fatalf("expected run result to report the log path, got %q", result.Steps[0].Log)
+	}
+}
+
+func TestRun_ResumeAppendsToStepLogAndResetsHistory(t *testing.T) {
+	dir := t.TempDir()
+	allowPath := filepath.Join(dir, "allow")
+	def := &Definition{
+		Workflows: map[string]Workflow{
+			"release": {Steps: []Step{
+				{Name: "build", Run: "echo built"},
+				{Name: "upload", Run: "echo attempt; [ -f " + allowPath + " ]"},
+			}},
+		},
+	}
+	opts := runOpts("release")
+	opts.WorkflowFile = filepath.Join(dir, "workflow.json")
+	opts.StateDir = filepath.Join(dir, "runs")
+
+	first, err := Run(context.Background(), def, opts)
+	if err == nil {
+		t.Fatal("expected first run to fail")
+	}
+	if err := os.WriteFile(allowPath, nil, 0o600); err != nil {
+		t.Fatalf("write allow file: %v", err)
+	}
+	opts.ResumeRunID = first.RunID
+	if _, err := Run(context.Background(), def, opts); err != nil {
+		t.Fatalf("resume Run: %v", err)
+	}
+
+	record, err := LoadRun(opts.StateDir, first.RunID)
+	if err != nil {
+		t.Fatalf("LoadRun: %v", err)
+	}
+	if record.Status != "ok" || record.Error != "" || record.FailedStep != "" {
+		t.Fatalf("expected resumed run to finish ok, got %+v", record)
+	}
+	if len(record.Steps) != 2 || record.Steps[0].Status != "resumed" || record.Steps[1].Status != "ok" {
+		t.Fatalf("expected history of the latest attempt, got %+v", record.Steps)
+	}
+	data, err := os.ReadFile(record.Steps[1].Log)
+	if err != nil {
+		t.Fatalf("read upload log: %v", err)
+	}
+	if string(data) != "attempt\nattempt\n" {
+		t.Fatalf("expected both attempts in the step log, got %q", data)
+	}
+}
+
+func TestListRunsAndPruneRuns(t *testing.T) {
+	dir := t.TempDir()
+	stateDir := filepath.Join(dir, "runs")
+	def := &Definition{
+		Workflows: map[string]Workflow{
+			"beta":    {Steps: []Step{{Run: "echo beta"}}},
+			"release": {Steps: []Step{{Run: "echo release"}}},
+		},
+	}
+
+	var runIDs []string
+	for _, name := range []string{"beta", "release"} {
+		opts := runOpts(name)
+		opts.WorkflowFile = filepath.Join(dir, "workflow.json")
+		opts.StateDir = stateDir
+		result, err := Run(context.Background(), def, opts)
+		if err != nil {
+			t.Fatalf("Run %s: %v", name, err)
+		}
+		runIDs = append(runIDs, result.RunID)
+	}
+	if err := os.WriteFile(filepath.Join(stateDir, "notes.json"), []byte("not a run"), 0o600); err != nil {
+		t.Fatalf("write stray file: %v", err)
+	}
+
+	// Age the beta run so only it is pruned.
+	oldRun := runStateFilePath(stateDir, runIDs[0])
+	state, err := loadRunState(oldRun)
+	if err != nil {
+		t.Fatalf("load run state: %v", err)
+	}
+	state.CreatedAt = "2020-01-01T00:00:00Z"
+	state.UpdatedAt = "2020-01-01T00:05:00Z"
+	data, err := json.Marshal(state)
+	if err != nil {
+		t.Fatalf("marshal run state: %v", err)
+	}
+	if err := os.WriteFile(oldRun, data, 0o600); err != nil {
+		t.Fatalf("rewrite run state: %v", err)
+	}
+
+	runs, err := ListRuns(stateDir)
+	if err != nil {
+		t.Fatalf("ListRuns: %v", err)
+	}
+	if len(runs) != 2 || runs[0].RunID != runIDs[1] || runs[1].RunID != runIDs[0] {
+		t.Fatalf("expected runs newest first, got %+v", runs)
+	}
+	if runs[1].Steps != nil || runs[1].StepCount != 1 || runs[1].DurationMS != 5*60*1000 {
+		t.Fatalf("unexpected listed run: %+v", runs[1])
+	}
+
+	cutoff := time.Now().Add(-24 * time.Hour)
+	preview, err := PruneRuns(stateDir, cutoff, true)
+	if err != nil {
+		t.Fatalf("PruneRuns dry run: %v", err)
+	}
+	if len(preview) != 1 || preview[0].RunID != runIDs[0] {
+		t.Fatalf("expected old run in dry-run preview, got %+v", preview)
+	}
+	if _, err := os.Stat(oldRun); err != nil {
+		t.Fatalf("expected dry run to keep run file: %v", err)
+	}
+
+	if _, err := PruneRuns(stateDir, cutoff, false); err != nil {
+		t.Fatalf("PruneRuns: %v", err)
+	}
+	if _, err := os.Stat(oldRun); !os.IsNotExist(err) {
+		t.Fatalf("expected run file to be deleted, got %v", err)
+	}
+	if _, err := os.Stat(stepLogDir(stateDir, runIDs[0])); !os.IsNotExist(err) {
+		t.Fatalf("expected run logs to be deleted, got %v", err)
+	}
+	remaining, err := ListRuns(stateDir)
+	if err != nil {
+		t.Fatalf("ListRuns: %v", err)
+	}
+	if len(remaining) != 1 || remaining[0].RunID != runIDs[1] {
+		t.Fatalf("expected recent run to remain, got %+v", remaining)
+	}
+}
+
+func TestListRuns_MissingDirectory(t *testing.T) {
+	runs, err := ListRuns(filepath.Join(t.TempDir(), "runs"))
+	if err != nil || len(runs) != 0 {
+		t.Fatalf("expected no runs, got %v, %v", runs, err)
+	}
+}
+
+func TestLoadRun_NotFound(t *testing.T) {
+	if _, err := LoadRun(t.TempDir(), "missing"); err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+}
@@ -0,0 +1,159 @@
+package workflow
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// ErrWorkflowImport indicates an imports entry could not be resolved.
+var ErrWorkflowImport = errors.New("import workflows")
+
+// importDirFile is the file loaded when an import points at a directory.
+const importDirFile = "workflow.json"
+
+// resolveImports loads the files def imports and merges their workflows into
+// def.Workflows as ALIAS.NAME. Import paths are relative to the importing
+// file; a directory stands for the workflow.json inside it, so versioned
+// libraries can be imported as "../release-workflows/v2".
+//
+// Imported files may import further files; their workflows end up as
+// ALIAS.INNER.NAME. Workflow steps inside an imported file keep referring to
+// their siblings by plain name and are rewritten to the namespaced name.
+// The imported file's env applies to its workflows, under each workflow's own
+// env. stack holds the files being loaded and is used to reject import
+// cycles; digests collects the SHA-256 of every imported file.
+func resolveImports(def *Definition, path string, stack []string, digests map[string]string) error {
+	if len(def.Imports) == 0 {
+		return nil
+	}
+	if def.Workflows == nil {
+		def.Workflows = map[string]Workflow{}
+	}
+
+	for _, alias := range slices.Sorted(maps.Keys(def.Imports)) {
+		if !validWorkflowName.MatchString(alias) {
+			return fmt.Errorf("%w: alias %q must start with a letter and contain only letters, digits, hyphens, underscores", ErrWorkflowImport, alias)
+		}
+		target, err := importTarget(path, def.Imports[alias])
+		if err != nil {
+			return fmt.Errorf("%w: %q: %w", ErrWorkflowImport, alias, err)
+		}
+		if slices.Contains(stack, target) {
+			return fmt.Errorf("%w: import cycle: %s", ErrWorkflowImport, importCycle(stack, target))
+		}
+
+		imported, data, err := parseDefinitionFile(target)
+		if err != nil {
+			return fmt.Errorf("%w: %q: %w", ErrWorkflowImport, alias, err)
+		}
+		if imported.BeforeAll != "" || imported.AfterAll != "" || imported.Error != "" {
+			return fmt.Errorf("%w: %q: imported files cannot define before_all, after_all, or error hooks", ErrWorkflowImport, alias)
+		}
+		if len(imported.Secrets) > 0 {
+			return fmt.Errorf("%w: %q: imported files cannot declare secrets; declare them in the importing file", ErrWorkflowImport, alias)
+		}
+		sum := sha256.Sum256(data)
+		digests[importDigestKey(stack[0], target)] = hex.EncodeToString(sum[:])
+
+		if err := resolveImports(imported, target, append(slices.Clone(stack), target), digests); err != nil {
+			return err
+		}
+
+		for _, name := range slices.Sorted(maps.Keys(imported.Workflows)) {
+			workflow := imported.Workflows[name]
+			if len(imported.Env) > 0 {
+				env := maps.Clone(imported.Env)
+				maps.Copy(env, workflow.Env)
+				workflow.Env = env
+			}
+			workflow.Steps = namespaceStepRefs(workflow.Steps, alias)
+
+			qualified := alias + "." + name
+			if _, exists := def.Workflows[qualified]; exists {
+				return fmt.Errorf("%w: imported workflow %q conflicts with an existing workflow", ErrWorkflowImport, qualified)
+			}
+			def.Workflows[qualified] = workflow
+		}
+	}
+	return nil
+}
+
+// importTarget resolves an import path against the importing file.
+func importTarget(fromFile, value string) (string, error) {
+	trimmed := strings.TrimSpace(value)
+	if trimmed == "" {
+		return "", fmt.Errorf("path is required")
+	}
+	target := trimmed
+	if !filepath.IsAbs(target) {
+		target = filepath.Join(filepath.Dir(fromFile), target)
+	}
+	target, err := filepath.Abs(target)
+	if err != nil {
+		return "", err
+	}
+	info, err := os.Stat(target)
+	if err != nil {
+		return "", err
+	}
+	if info.IsDir() {
+		target = filepath.Join(target, importDirFile)
+	}
+	return target, nil
+}
+
+// namespaceStepRefs returns steps with workflow references prefixed by alias.
+func namespaceStepRefs(steps []Step, alias string) []Step {
+	if steps == nil {
+		return nil
+	}
+	out := make([]Step, len(steps))
+	for i, step := range steps {
+		if ref := strings.TrimSpace(step.Workflow); ref != "" {
+			step.Workflow = alias + "." + ref
+		}
+		step.Parallel = namespaceStepRefs(step.Parallel, alias)
+		out[i] = step
+	}
+	return out
+}
+
+// importCycle describes the chain of files from the first visit of target
+// back to target, relative to the root file's directory where possible.
+func importCycle(stack []string, target string) string {
+	start := slices.Index(stack, target)
+	chain := append(slices.Clone(stack[start:]), target)
+	base := filepath.Dir(stack[0])
+	for i, file := range chain {
+		if rel, err := filepath.Rel(base, file); err == nil {
+			chain[i] = rel
+		}
+	}
+	return strings.Join(chain, " -> ")
+}
+
+// importDigestKey names an imported file relative to the root workflow file,
+// so a checkout in another directory keeps the same fingerprint.
+func importDigestKey(rootFile, target string) string {
+	if rel, err := filepath.Rel(filepath.Dir(rootFile), target); err == nil {
+		return filepath.ToSlash(rel)
+	}
+	return target
+}
+
+// importedWorkflowAlias returns the import alias of a namespaced workflow
+// name, or "" for a name defined in the root file.
+func importedWorkflowAlias(name string) string {
+	alias, _, ok := strings.Cut(name, ".")
+	if !ok {
+		return ""
+	}
+	return alias
+}
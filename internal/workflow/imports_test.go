@@ -0,0 +1,264 @@
+package workflow
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func writeFileAt(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("write %s: %v", path, err)
+	}
+}
+
+func TestLoad_ImportsNamespaceWorkflows(t *testing.T) {
+	dir := t.TempDir()
+	writeFileAt(t, filepath.Join(dir, "shared", "v2", "workflow.json"), `{
+		"imports": {"util": "../util.json"},
+		"env": {"TRACK": "beta", "CHANNEL": "lib"},
+		"workflows": {
+			"release": {
+				"env": {"CHANNEL": "release"},
+				"steps": [
+					{"workflow": "prepare"},
+					{"parallel": [{"name": "notify", "workflow": "util.notify"}]}
+				]
+			},
+			"prepare": {"private": true, "steps": ["echo prepare"]}
+		}
+	}`)
+	writeFileAt(t, filepath.Join(dir, "shared", "util.json"), `{
+		"workflows": {"notify": {"steps": ["echo notify"]}}
+	}`)
+	path := writeWorkflowFile(t, dir, `{
+		// Directory imports load the workflow.json inside.
+		"imports": {"lib": "shared/v2"},
+		"workflows": {
+			"ship": {"steps": [{"workflow": "lib.release"}]}
+		}
+	}`)
+
+	def, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+
+	for _, name := range []string{"ship", "lib.release", "lib.prepare", "lib.util.notify"} {
+		if _, ok := def.Workflows[name]; !ok {
+			t.Fatalf("expected workflow %q, got %v", name, slices.Sorted(maps.Keys(def.Workflows)))
+		}
+	}
+	release := def.Workflows["lib.release"]
+	if release.Steps[0].Workflow != "lib.prepare" || release.Steps[1].Parallel[0].Workflow != "lib.util.notify" {
+		t.Fatalf("expected sibling references to be namespaced, got %+v", release.Steps)
+	}
+	if release.Env["TRACK"] != "beta" || release.Env["CHANNEL"] != "release" {
+		t.Fatalf("expected imported file env under workflow env, got %v", release.Env)
+	}
+	if !def.Workflows["lib.prepare"].Private {
+		t.Fatal("expected imported workflow to stay private")
+	}
+	if len(def.importDigests) != 2 || def.importDigests["shared/v2/workflow.json"] == "" || def.importDigests["shared/util.json"] == "" {
+		t.Fatalf("expected digests for both imported files, got %v", def.importDigests)
+	}
+}
+
+func TestLoad_ImportErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		files   map[string]string
+		wantErr string
+	}{
+		{
+			name: "cycle",
+			files: map[string]string{
+				"workflow.json": `{"imports": {"a": "a.json"}, "workflows": {"main": {"steps": ["echo"]}}}`,
+				"a.json":        `{"imports": {"b": "b.json"}, "workflows": {"x": {"steps": ["echo"]}}}`,
+				"b.json":        `{"imports": {"a": "a.json"}, "workflows": {"y": {"steps": ["echo"]}}}`,
+			},
+			wantErr: "import cycle: a.json -> b.json -> a.json",
+		},
+		{
+			name: "self import",
+			files: map[string]string{
+				"workflow.json": `{"imports": {"self": "."}, "workflows": {"main": {"steps": ["echo"]}}}`,
+			},
+			wantErr: "import cycle: workflow.json -> workflow.json",
+		},
+		{
+			name: "missing file",
+			files: map[string]string{
+				"workflow.json": `{"imports": {"lib": "missing.json"}, "workflows": {"main": {"steps": ["echo"]}}}`,
+			},
+			wantErr: `"lib"`,
+		},
+		{
+			name: "invalid alias",
+			files: map[string]string{
+				"workflow.json": `{"imports": {"my.lib": "lib.json"}, "workflows": {"main": {"steps": ["echo"]}}}`,
+				"lib.json":      `{"workflows": {"x": {"steps": ["echo"]}}}`,
+			},
+			wantErr: `alias "my.lib" must start with a letter`,
+		},
+		{
+			name: "hooks in imported file",
+			files: map[string]string{
+				"workflow.json": `{"imports": {"lib": "lib.json"}, "workflows": {"main": {"steps": ["echo"]}}}`,
+				"lib.json":      `{"before_all": "echo hi", "workflows": {"x": {"steps": ["echo"]}}}`,
+			},
+			wantErr: "imported files cannot define before_all, after_all, or error hooks",
+		},
+		{
+			name: "secrets in imported file",
+			files: map[string]string{
+				"workflow.json": `{"imports": {"lib": "lib.json"}, "workflows": {"main": {"steps": ["echo"]}}}`,
+				"lib.json":      `{"secrets": {"TOKEN": {"env": "TOKEN"}}, "workflows": {"x": {"steps": ["echo"]}}}`,
+			},
+			wantErr: "imported files cannot declare secrets",
+		},
+		{
+			name: "unknown field in imported file",
+			files: map[string]string{
+				"workflow.json": `{"imports": {"lib": "lib.json"}, "workflows": {"main": {"steps": ["echo"]}}}`,
+				"lib.json":      `{"unknown": true, "workflows": {"x": {"steps": ["echo"]}}}`,
+			},
+			wantErr: "parse workflow JSON",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			for name, content := range tt.files {
+				writeFileAt(t, filepath.Join(dir, name), content)
+			}
+			_, err := Load(filepath.Join(dir, "workflow.json"))
+			if !errors.Is(err, ErrWorkflowImport) || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("expected import error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestLoad_ImportedWorkflowReferencesAreValidated(t *testing.T) {
+	dir := t.TempDir()
+	writeFileAt(t, filepath.Join(dir, "lib.json"), `{
+		"workflows": {"release": {"steps": [{"workflow": "missing"}]}}
+	}`)
+	path := writeWorkflowFile(t, dir, `{
+		"imports": {"lib": "lib.json"},
+		"workflows": {"main": {"steps": [{"workflow": "lib.release"}]}}
+	}`)
+
+	def, err := LoadUnvalidated(path)
+	if err != nil {
+		t.Fatalf("LoadUnvalidated: %v", err)
+	}
+	errs := Validate(def)
+	assertValidationCode(t, errs, ErrWorkflowNotFound)
+	if !strings.Contains(errs[0].Message, `"lib.missing"`) {
+		t.Fatalf("expected namespaced reference in message, got %q", errs[0].Message)
+	}
+}
+
+func TestValidate_DottedWorkflowNameRequiresImport(t *testing.T) {
+	def := &Definition{
+		Workflows: map[string]Workflow{
+			"lib.release": {Steps: []Step{{Run: "echo hi"}}},
+		},
+	}
+	assertValidationCode(t, Validate(def), ErrInvalidWorkflowName)
+
+	def.Imports = map[string]string{"lib": "lib.json"}
+	if errs := Validate(def); len(errs) != 0 {
+		t.Fatalf("expected imported workflow name to be valid, got %v", errs)
+	}
+
+	def.Workflows = map[string]Workflow{"lib..release": {Steps: []Step{{Run: "echo hi"}}}}
+	assertValidationCode(t, Validate(def), ErrInvalidWorkflowName)
+}
+
+func TestDefinitionFingerprint_CoversImportedFiles(t *testing.T) {
+	dir := t.TempDir()
+	libPath := filepath.Join(dir, "lib.json")
+	writeFileAt(t, libPath, `{"workflows": {"release": {"steps": ["echo one"]}}}`)
+	path := writeWorkflowFile(t, dir, `{
+		"imports": {"lib": "lib.json"},
+		"workflows": {"main": {"steps": [{"workflow": "lib.release"}]}}
+	}`)
+
+	fingerprint := func() string {
+		t.Helper()
+		def, err := Load(path)
+		if err != nil {
+			t.Fatalf("Load: %v", err)
+		}
+		hash, err := definitionFingerprint(def)
+		if err != nil {
+			t.Fatalf("definitionFingerprint: %v", err)
+		}
+		return hash
+	}
+
+	original := fingerprint()
+	if again := fingerprint(); again != original {
+		t.Fatal("expected a stable fingerprint")
+	}
+	// A comment-only change leaves the merged workflows untouched but still
+	// changes the imported file.
+	writeFileAt(t, libPath, `{
+		// tweaked
+		"workflows": {"release": {"steps": ["echo one"]}}
+	}`)
+	if fingerprint() == original {
+		t.Fatal("expected fingerprint to change with the imported file")
+	}
+}
+
+func TestRun_ResumeRejectsChangedImport(t *testing.T) {
+	dir := t.TempDir()
+	libPath := filepath.Join(dir, "lib.json")
+	allowPath := filepath.Join(dir, "allow")
+	writeFileAt(t, libPath, `{"workflows": {"release": {"steps": ["echo imported", "[ -f `+allowPath+` ]"]}}}`)
+	path := writeWorkflowFile(t, dir, `{
+		"imports": {"lib": "lib.json"},
+		"workflows": {"main": {"steps": [{"workflow": "lib.release"}]}}
+	}`)
+
+	def, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	stdout := &bytes.Buffer{}
+	opts := runOpts("main")
+	opts.Stdout = stdout
+	opts.WorkflowFile = path
+	opts.StateDir = filepath.Join(dir, "runs")
+	first, err := Run(context.Background(), def, opts)
+	if err == nil {
+		t.Fatal("expected first run to fail")
+	}
+	if !strings.Contains(stdout.String(), "imported") {
+		t.Fatalf("expected imported workflow to run, got %q", stdout.String())
+	}
+
+	writeFileAt(t, libPath, `{"workflows": {"release": {"steps": ["echo changed", "[ -f `+allowPath+` ]"]}}}`)
+	changed, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	opts.ResumeRunID = first.RunID
+	if _, err := Run(context.Background(), changed, opts); err == nil || !strings.Contains(err.Error(), "does not match the current workflow definition") {
+		t.Fatalf("expected resume to reject the changed import, got %v", err)
+	}
+}
@@ -10,7 +10,12 @@ import (
 	"strings"
 )
 
-var stepOutputPattern = regexp.MustCompile(`\$\{steps\.([a-zA-Z0-9_-]+)\.([a-zA-Z0-9_]+)\}`)
+var (
+	// stepOutputPattern also matches namespaced matrix outputs such as
+	// ${steps.upload[app=a,platform=IOS].BUILD_ID}.
+	stepOutputPattern = regexp.MustCompile(`\$\{steps\.([a-zA-Z0-9_-]+(?:\[[^\]{}]*\])?)\.([a-zA-Z0-9_]+)\}`)
+	matrixRefPattern  = regexp.MustCompile(`\$\{\{\s*matrix\.([a-zA-Z0-9_-]+)\s*\}\}`)
+)
 
 func interpolateCommand(input string, outputs map[string]map[string]string) (string, error) {
 	return interpolateStepOutputs(input, outputs, true)
@@ -64,6 +69,80 @@ func interpolateStepOutputs(input string, outputs map[string]map[string]string,
 	return b.String(), nil
 }
 
+// applyMatrix resolves ${{ matrix.KEY }} references in a step's run command,
+// asc arguments, and with values. Run commands get shell-escaped values; the
+// others receive them verbatim.
+func applyMatrix(step Step, values map[string]string) (Step, error) {
+	var err error
+	if step.Run, err = interpolateMatrix(step.Run, values, true); err != nil {
+		return step, err
+	}
+	if len(step.ASC) > 0 {
+		args := make([]string, len(step.ASC))
+		for i, arg := range step.ASC {
+			if args[i], err = interpolateMatrix(arg, values, false); err != nil {
+				return step, err
+			}
+		}
+		step.ASC = args
+	}
+	if len(step.With) > 0 {
+		with := make(map[string]string, len(step.With))
+		for _, key := range slices.Sorted(maps.Keys(step.With)) {
+			if with[key], err = interpolateMatrix(step.With[key], values, false); err != nil {
+				return step, err
+			}
+		}
+		step.With = with
+	}
+	return step, nil
+}
+
+func interpolateMatrix(input string, values map[string]string, shellEscape bool) (string, error) {
+	matches := matrixRefPattern.FindAllStringSubmatchIndex(input, -1)
+	if len(matches) == 0 {
+		return input, nil
+	}
+
+	var b strings.Builder
+	last := 0
+	for _, match := range matches {
+		key := input[match[2]:match[3]]
+		value, ok := values[key]
+		if !ok {
+			if values == nil {
+				return "", fmt.Errorf("matrix value %q referenced outside a matrix step", "matrix."+key)
+			}
+			return "", fmt.Errorf("unknown matrix value %q", "matrix."+key)
+		}
+
+		b.WriteString(input[last:match[0]])
+		if shellEscape {
+			b.WriteString(escapeShellValue(value, shellQuoteContextAt(input, match[0])))
+		} else {
+			b.WriteString(value)
+		}
+		last = match[1]
+	}
+	b.WriteString(input[last:])
+	return b.String(), nil
+}
+
+// matrixRefs returns the matrix keys a step references, sorted.
+func matrixRefs(step Step) []string {
+	texts := append([]string{step.Run}, step.ASC...)
+	for _, key := range slices.Sorted(maps.Keys(step.With)) {
+		texts = append(texts, step.With[key])
+	}
+	seen := map[string]struct{}{}
+	for _, text := range texts {
+		for _, match := range matrixRefPattern.FindAllStringSubmatch(text, -1) {
+			seen[match[1]] = struct{}{}
+		}
+	}
+	return slices.Sorted(maps.Keys(seen))
+}
+
 type shellQuoteContext int
 
 const (
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 
 	"github.com/tidwall/jsonc"
 )
@@ -37,12 +38,38 @@ func Load(path string) (*Definition, error) {
 	return def, nil
 }
 
-// LoadUnvalidated reads and parses a workflow definition file without validation.
+// LoadUnvalidated reads and parses a workflow definition file without
+// validation. Workflows from the files it imports are merged in under their
+// alias; see resolveImports.
 func LoadUnvalidated(path string) (*Definition, error) {
-	data, err := os.ReadFile(path)
+	def, _, err := parseDefinitionFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if len(def.Imports) == 0 {
+		return def, nil
+	}
+
+	absPath, err := filepath.Abs(path)
 	if err != nil {
 		return nil, fmt.Errorf("%w: %w", ErrWorkflowRead, err)
 	}
+	digests := map[string]string{}
+	if err := resolveImports(def, absPath, []string{absPath}, digests); err != nil {
+		return nil, err
+	}
+	def.importDigests = digests
+	return def, nil
+}
+
+// parseDefinitionFile reads and decodes one workflow file, returning its raw
+// contents as well.
+func parseDefinitionFile(path string) (*Definition, []byte, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %w", ErrWorkflowRead, err)
+	}
+	data := raw
 
 	// Allow JSONC-style comments (// and /* */) in workflow files.
 	data = jsonc.ToJSON(data)
@@ -51,12 +78,12 @@ func LoadUnvalidated(path string) (*Definition, error) {
 	dec := json.NewDecoder(bytes.NewReader(data))
 	dec.DisallowUnknownFields()
 	if err := dec.Decode(&def); err != nil {
-		return nil, fmt.Errorf("%w: %w", ErrWorkflowParseJSON, err)
+		return nil, nil, fmt.Errorf("%w: %w", ErrWorkflowParseJSON, err)
 	}
 	// Ensure there is exactly one JSON value in the file.
 	if err := dec.Decode(&struct{}{}); err != io.EOF {
-		return nil, fmt.Errorf("%w: trailing data", ErrWorkflowParseJSON)
+		return nil, nil, fmt.Errorf("%w: trailing data", ErrWorkflowParseJSON)
 	}
 
-	return &def, nil
+	return &def, raw, nil
 }
@@ -0,0 +1,135 @@
+package workflow
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// MaxMatrixCombinations is the maximum number of combinations one matrix step
+// may expand into.
+const MaxMatrixCombinations = 256
+
+// matrixInstance is the matrix combination a runner is executing. Outputs set
+// while it runs are visible under their plain step names to later steps of the
+// same combination and are published to the run as NAME[id].
+type matrixInstance struct {
+	id      string
+	values  map[string]string
+	outputs map[string]map[string]string
+}
+
+// executeMatrix runs a step once per matrix combination, stopping at the first
+// failure. Each combination gets its own state key, so --resume only reruns
+// combinations that did not finish.
+func (r *runner) executeMatrix(ctx context.Context, pos stepPosition, step Step, env map[string]string, depth int) error {
+	combinations := matrixCombinations(step.Matrix)
+	if r.opts.DryRun {
+		fmt.Fprintf(r.opts.Stderr, "[dry-run] %s: matrix (%d combinations)\n", pos.dryRunLabel(), len(combinations))
+	}
+
+	inner := step
+	inner.Matrix = nil
+	for _, values := range combinations {
+		instance := *r
+		instance.matrix = r.newMatrixInstance(values)
+
+		instancePos := pos
+		instancePos.matrix = instance.matrix.id
+		instancePos.key = pos.key + "{" + instance.matrix.id + "}"
+		instancePos.label = fmt.Sprintf("%s [%s]", pos.label, instance.matrix.id)
+		if err := instance.executeStep(ctx, instancePos, inner, env, depth); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// newMatrixInstance starts a combination. Inside an enclosing combination the
+// values and visible outputs of the outer one carry over.
+func (r *runner) newMatrixInstance(values map[string]string) *matrixInstance {
+	instance := &matrixInstance{
+		values:  map[string]string{},
+		outputs: map[string]map[string]string{},
+	}
+	if r.matrix != nil {
+		maps.Copy(instance.values, r.matrix.values)
+		r.mu.Lock()
+		maps.Copy(instance.outputs, cloneNestedStringMap(r.matrix.outputs))
+		r.mu.Unlock()
+	}
+	maps.Copy(instance.values, values)
+	instance.id = matrixID(instance.values)
+	return instance
+}
+
+func (r *runner) matrixValues() map[string]string {
+	if r.matrix == nil {
+		return nil
+	}
+	return r.matrix.values
+}
+
+// matrixCombinations expands a matrix into every combination of its values.
+// Keys vary in sorted order with the last key changing fastest, and values
+// keep their declared order.
+func matrixCombinations(matrix map[string][]string) []map[string]string {
+	keys := slices.Sorted(maps.Keys(matrix))
+	combinations := []map[string]string{{}}
+	for _, key := range keys {
+		next := make([]map[string]string, 0, len(combinations)*len(matrix[key]))
+		for _, combination := range combinations {
+			for _, value := range matrix[key] {
+				expanded := maps.Clone(combination)
+				expanded[key] = value
+				next = append(next, expanded)
+			}
+		}
+		combinations = next
+	}
+	return combinations
+}
+
+// matrixCombinationCount returns how many combinations a matrix expands into,
+// capped just above MaxMatrixCombinations.
+func matrixCombinationCount(matrix map[string][]string) int {
+	count := 1
+	for _, values := range matrix {
+		count *= len(values)
+		if count > MaxMatrixCombinations {
+			return MaxMatrixCombinations + 1
+		}
+	}
+	return count
+}
+
+// matrixIDEscaper percent-encodes the characters that delimit matrix IDs and
+// namespaced output names. Validation rejects them in matrix values, so IDs of
+// valid matrices read as written; escaping keeps definitions that skipped
+// validation from mapping two combinations to one ID.
+var matrixIDEscaper = strings.NewReplacer(
+	"%", "%25",
+	",", "%2C",
+	"=", "%3D",
+	"[", "%5B",
+	"]", "%5D",
+	"{", "%7B",
+	"}", "%7D",
+)
+
+// matrixID identifies a combination as "key=value,..." with sorted keys.
+func matrixID(values map[string]string) string {
+	parts := make([]string, 0, len(values))
+	for _, key := range slices.Sorted(maps.Keys(values)) {
+		parts = append(parts, matrixIDEscaper.Replace(key)+"="+matrixIDEscaper.Replace(values[key]))
+	}
+	return strings.Join(parts, ",")
+}
+
+// matrixOutputName is the run-level name of outputs a step produced for a
+// matrix combination, e.g. upload[app=a,platform=IOS].
+func matrixOutputName(name, id string) string {
+	return name + "[" + id + "]"
+}
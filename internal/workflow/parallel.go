@@ -0,0 +1,192 @@
+package workflow
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+type branchState int
+
+const (
+	branchPending branchState = iota
+	branchRunning
+	branchSucceeded
+	branchFailed
+)
+
+type branchDone struct {
+	branch int
+	err    error
+}
+
+// executeParallel runs the branches of a parallel group. A branch starts once
+// every branch it needs has succeeded, with at most MaxParallel running at a
+// time. Each branch is persisted under its own state key, so --resume only
+// reruns branches that did not succeed.
+func (r *runner) executeParallel(ctx context.Context, pos stepPosition, step Step, env map[string]string, depth int) error {
+	branches := step.Parallel
+	limit := step.MaxParallel
+	if limit <= 0 || limit > len(branches) {
+		limit = len(branches)
+	}
+	if r.opts.DryRun {
+		// Dry runs only print commands; keep them in a stable order.
+		limit = 1
+	}
+	failFast := step.FailFast == nil || *step.FailFast
+
+	groupCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	byName := make(map[string]int, len(branches))
+	for i, branch := range branches {
+		if name := strings.TrimSpace(branch.Name); name != "" {
+			byName[name] = i
+		}
+	}
+
+	states := make([]branchState, len(branches))
+	done := make(chan branchDone)
+	var errs []error
+	running := 0
+	stopped := false
+
+	for {
+		if !stopped && ctx.Err() == nil {
+			r.skipBlockedBranches(pos, branches, byName, states)
+			for i := range branches {
+				if running >= limit {
+					break
+				}
+				if states[i] != branchPending || !branchReady(branches[i], byName, states) {
+					continue
+				}
+				states[i] = branchRunning
+				running++
+				go func(i int) {
+					done <- branchDone{branch: i, err: r.runBranch(groupCtx, pos, i, branches[i], env, depth)}
+				}(i)
+			}
+		}
+		if running == 0 {
+			break
+		}
+
+		finished := <-done
+		running--
+		if finished.err == nil {
+			states[finished.branch] = branchSucceeded
+			continue
+		}
+		states[finished.branch] = branchFailed
+		if stopped && ctx.Err() == nil {
+			// Cancelled by fail-fast; the failure that stopped the group is
+			// already recorded.
+			continue
+		}
+		errs = append(errs, finished.err)
+		if failFast {
+			stopped = true
+			cancel()
+		}
+	}
+
+	for i, branch := range branches {
+		if states[i] != branchPending {
+			continue
+		}
+		status := "skipped"
+		if stopped || ctx.Err() != nil {
+			status = "cancelled"
+		}
+		r.recordStep(r.branchResult(pos, i, branch, status))
+	}
+
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errs[0]
+	default:
+		return errors.Join(errs...)
+	}
+}
+
+// runBranch executes one branch with its stdout and stderr buffered, then
+// writes the buffers in one piece so concurrent branches do not interleave.
+func (r *runner) runBranch(ctx context.Context, group stepPosition, i int, step Step, env map[string]string, depth int) error {
+	var stdout, stderr bytes.Buffer
+	branchRunner := *r
+	branchRunner.opts.Stdout = &stdout
+	branchRunner.opts.Stderr = &stderr
+
+	pos := stepPosition{
+		workflow: group.workflow,
+		index:    group.index,
+		branch:   i + 1,
+		key:      appendStepKey(group.key, "parallel", i+1),
+		label:    fmt.Sprintf("%s branch %d", group.label, i+1),
+	}
+	err := branchRunner.executeStep(ctx, pos, step, env, depth)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	_, _ = io.Copy(r.opts.Stdout, &stdout)
+	_, _ = io.Copy(r.opts.Stderr, &stderr)
+	return err
+}
+
+// skipBlockedBranches marks pending branches whose needs failed (directly or
+// transitively) as skipped.
+func (r *runner) skipBlockedBranches(group stepPosition, branches []Step, byName map[string]int, states []branchState) {
+	for changed := true; changed; {
+		changed = false
+		for i, branch := range branches {
+			if states[i] != branchPending {
+				continue
+			}
+			for _, need := range branch.Needs {
+				j, ok := byName[strings.TrimSpace(need)]
+				if !ok || states[j] != branchFailed {
+					continue
+				}
+				states[i] = branchFailed
+				sr := r.branchResult(group, i, branch, "skipped")
+				sr.Error = fmt.Sprintf("needs %q, which did not succeed", strings.TrimSpace(need))
+				r.recordStep(sr)
+				changed = true
+				break
+			}
+		}
+	}
+}
+
+func branchReady(branch Step, byName map[string]int, states []branchState) bool {
+	for _, need := range branch.Needs {
+		j, ok := byName[strings.TrimSpace(need)]
+		if !ok || states[j] != branchSucceeded {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *runner) branchResult(group stepPosition, i int, branch Step, status string) StepResult {
+	sr := StepResult{
+		Index:    group.index,
+		Branch:   i + 1,
+		Name:     branch.Name,
+		Command:  stepCommand(branch),
+		Workflow: strings.TrimSpace(branch.Workflow),
+		Status:   status,
+		Matrix:   cloneStringMap(r.matrixValues()),
+	}
+	if group.workflow != r.opts.WorkflowName {
+		sr.ParentWorkflow = group.workflow
+	}
+	return sr
+}
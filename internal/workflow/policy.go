@@ -0,0 +1,73 @@
+package workflow
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// runWithPolicy runs one attempt of a step at a time, applying the step's
+// timeout to each attempt and retrying failures as configured. It returns the
+// number of attempts made and whether the last attempt timed out.
+func (r *runner) runWithPolicy(ctx context.Context, pos stepPosition, step Step, attempt func(context.Context) error) (int, bool, error) {
+	// Validate has already rejected malformed durations.
+	timeout, _ := parseStepDuration(step.Timeout)
+	attempts := 1
+	var backoff time.Duration
+	if step.Retry != nil {
+		attempts = max(step.Retry.Attempts, 1)
+		backoff, _ = parseStepDuration(step.Retry.Backoff)
+	}
+
+	for n := 1; ; n++ {
+		timedOut, err := runAttemptWithTimeout(ctx, timeout, attempt)
+		if err == nil {
+			return n, false, nil
+		}
+		if n >= attempts || ctx.Err() != nil {
+			return n, timedOut, err
+		}
+
+		fmt.Fprintf(r.opts.Stderr, "workflow: %s attempt %d/%d failed: %v; retrying in %s\n", pos.label, n, attempts, err, backoff)
+		if backoff > 0 {
+			timer := time.NewTimer(backoff)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return n, false, ctx.Err()
+			case <-timer.C:
+			}
+		}
+	}
+}
+
+func runAttemptWithTimeout(ctx context.Context, timeout time.Duration, attempt func(context.Context) error) (bool, error) {
+	if timeout <= 0 {
+		return false, attempt(ctx)
+	}
+	attemptCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	err := attempt(attemptCtx)
+	if err != nil && ctx.Err() == nil && errors.Is(attemptCtx.Err(), context.DeadlineExceeded) {
+		return true, fmt.Errorf("timed out after %s", timeout)
+	}
+	return false, err
+}
+
+// parseStepDuration parses a step timeout or backoff. Empty means unset.
+func parseStepDuration(value string) (time.Duration, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, err
+	}
+	if d < 0 {
+		return 0, fmt.Errorf("must not be negative")
+	}
+	return d, nil
+}
@@ -0,0 +1,266 @@
+package workflow
+
+import (
+	"reflect"
+	"strings"
+)
+
+// SchemaDialect is the JSON Schema draft JSONSchema targets. Draft-07 is the
+// newest draft editors such as VS Code fully support.
+const SchemaDialect = "http://json-schema.org/draft-07/schema#"
+
+// durationPattern matches the Go durations accepted by timeout and backoff.
+const durationPattern = `^([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$`
+
+// JSONSchema returns a JSON Schema for .asc/workflow.json files. It is
+// generated from the json tags of Definition and the types it contains, with
+// descriptions and constraints from schemaAnnotations, so the schema tracks
+// the loader. Rules that span fields or workflows (unknown references,
+// cycles, needs ordering) are left to Validate.
+func JSONSchema() map[string]any {
+	g := &schemaGenerator{defs: map[string]any{}}
+	root := g.structSchema(reflect.TypeOf(Definition{}))
+
+	schema := map[string]any{
+		"$schema":     SchemaDialect,
+		"title":       "asc workflow file",
+		"description": "Workflow definitions for .asc/workflow.json, run with asc workflow run.",
+	}
+	for key, value := range root {
+		schema[key] = value
+	}
+	schema["definitions"] = g.defs
+	return schema
+}
+
+type schemaGenerator struct {
+	defs map[string]any
+}
+
+// typeSchema returns the schema for t. Named structs are emitted once under
+// definitions and referenced, which also terminates the recursion through
+// Step.Parallel.
+func (g *schemaGenerator) typeSchema(t reflect.Type) map[string]any {
+	switch t.Kind() {
+	case reflect.Pointer:
+		return g.typeSchema(t.Elem())
+	case reflect.String:
+		return map[string]any{"type": "string"}
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]any{"type": "integer"}
+	case reflect.Slice, reflect.Array:
+		return map[string]any{"type": "array", "items": g.typeSchema(t.Elem())}
+	case reflect.Map:
+		return map[string]any{"type": "object", "additionalProperties": g.typeSchema(t.Elem())}
+	case reflect.Struct:
+		name := t.Name()
+		if _, ok := g.defs[name]; !ok {
+			g.defs[name] = nil // reserve the name before recursing
+			g.defs[name] = g.definitionSchema(t)
+		}
+		return map[string]any{"$ref": "#/definitions/" + name}
+	default:
+		return map[string]any{}
+	}
+}
+
+// definitionSchema wraps structSchema for types whose JSON form is more than
+// their fields.
+func (g *schemaGenerator) definitionSchema(t reflect.Type) map[string]any {
+	object := g.structSchema(t)
+	switch t {
+	case reflect.TypeOf(Step{}):
+		// Mirrors Step.UnmarshalJSON: a bare string is a run step.
+		object["anyOf"] = []any{
+			map[string]any{"required": []string{"run"}},
+			map[string]any{"required": []string{"asc"}},
+			map[string]any{"required": []string{"workflow"}},
+			map[string]any{"required": []string{"parallel"}},
+		}
+		return map[string]any{
+			"description": "A step: a shell command string, or an object with exactly one of run, asc, workflow, or parallel.",
+			"anyOf": []any{
+				map[string]any{"type": "string", "minLength": 1, "description": "Shorthand for {\"run\": \"...\"}."},
+				object,
+			},
+		}
+	case reflect.TypeOf(Secret{}):
+		object["description"] = "Where a secret's value comes from. Set exactly one source."
+		object["oneOf"] = []any{
+			map[string]any{"required": []string{"env"}},
+			map[string]any{"required": []string{"keychain"}},
+			map[string]any{"required": []string{"file"}},
+		}
+	}
+	return object
+}
+
+// structSchema describes the exported, json-tagged fields of t. Fields
+// without omitempty are required, matching what Validate rejects when absent.
+func (g *schemaGenerator) structSchema(t reflect.Type) map[string]any {
+	properties := map[string]any{}
+	var required []string
+	for i := range t.NumField() {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name, opts, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+		property := g.typeSchema(field.Type)
+		if annotation, ok := schemaAnnotations[t.Name()+"."+name]; ok {
+			if ref, isRef := property["$ref"]; isRef {
+				// Draft-07 ignores keywords beside $ref.
+				property = map[string]any{"allOf": []any{map[string]any{"$ref": ref}}}
+			}
+			for key, value := range annotation {
+				property[key] = value
+			}
+		}
+		properties[name] = property
+		if !strings.Contains(opts, "omitempty") {
+			required = append(required, name)
+		}
+	}
+
+	schema := map[string]any{
+		"type":                 "object",
+		"properties":           properties,
+		"additionalProperties": false,
+	}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+	return schema
+}
+
+// schemaAnnotations holds the description and extra constraints for each
+// field, keyed by Go type name and JSON field name. Keys set here replace the
+// generated ones.
+var schemaAnnotations = map[string]map[string]any{
+	"Definition.$schema": {
+		"description": "JSON Schema for editor support, such as the output of asc workflow schema. Ignored by asc.",
+	},
+	"Definition.imports": {
+		"description":   "Other workflow files (or directories holding a workflow.json) to import, by alias. Imported workflows are called as ALIAS.NAME.",
+		"propertyNames": map[string]any{"pattern": validWorkflowName.String()},
+	},
+	"Definition.env": {
+		"description": "Environment variables for every workflow. Workflow env, secrets, and runtime params override them.",
+	},
+	"Definition.secrets": {
+		"description":   "Secrets injected into every step's environment and masked as *** in output, logs, and run state.",
+		"propertyNames": map[string]any{"pattern": validSecretName.String()},
+	},
+	"Definition.before_all": {
+		"description": "Shell command run once before the workflow's steps.",
+	},
+	"Definition.after_all": {
+		"description": "Shell command run once after all steps succeed.",
+	},
+	"Definition.error": {
+		"description": "Shell command run when the run fails.",
+	},
+	"Definition.workflows": {
+		"description":   "Named workflows. Run one with asc workflow run NAME.",
+		"propertyNames": map[string]any{"pattern": validWorkflowName.String()},
+	},
+
+	"Workflow.description": {
+		"description": "Shown by asc workflow list.",
+	},
+	"Workflow.private": {
+		"description": "Hide the workflow from asc workflow list and prevent running it directly. It can still be called by other workflows.",
+	},
+	"Workflow.env": {
+		"description": "Environment variables for this workflow's steps. Overrides top-level env.",
+	},
+	"Workflow.steps": {
+		"description": "Steps run in order.",
+	},
+
+	"Step.run": {
+		"description": "Shell command to run. Uses bash -o pipefail when available, otherwise sh.",
+	},
+	"Step.asc": {
+		"description": "asc command arguments, without the leading \"asc\", run without a shell.",
+		"minItems":    1,
+	},
+	"Step.workflow": {
+		"description": "Name of another workflow to call as a sub-workflow.",
+	},
+	"Step.name": {
+		"description": "Label for output, history, and step output references (${steps.NAME.KEY}).",
+	},
+	"Step.if": {
+		"description": "Condition that decides whether the step runs: an env var name, or an expression such as params.channel == 'beta' && !failure().",
+	},
+	"Step.with": {
+		"description": "Environment overrides passed to a sub-workflow call.",
+	},
+	"Step.outputs": {
+		"description":          "Outputs to publish from the step's JSON result, as NAME: $.json.path.",
+		"propertyNames":        map[string]any{"pattern": validOutputName.String()},
+		"additionalProperties": map[string]any{"type": "string", "pattern": validOutputExpr.String()},
+	},
+	"Step.parallel": {
+		"description": "Branches to run concurrently. The step is a parallel group.",
+		"minItems":    1,
+	},
+	"Step.max_parallel": {
+		"description": "Maximum branches of a parallel group running at once. 0 runs all at once.",
+		"minimum":     0,
+	},
+	"Step.fail_fast": {
+		"description": "Cancel the remaining branches of a parallel group after the first failure. Defaults to true.",
+	},
+	"Step.needs": {
+		"description": "Names of sibling branches in the same parallel group that must finish first.",
+		"uniqueItems": true,
+	},
+	"Step.matrix": {
+		"description":   "Run the step once per combination of values. Reference the current value as ${{ matrix.KEY }}.",
+		"minProperties": 1,
+		"propertyNames": map[string]any{"pattern": validWorkflowName.String()},
+		"additionalProperties": map[string]any{
+			"type":        "array",
+			"minItems":    1,
+			"uniqueItems": true,
+			"items":       map[string]any{"type": "string", "pattern": `^[^\[\]{},=]+$`},
+		},
+	},
+	"Step.retry": {
+		"description": "Rerun a failed run or asc step.",
+	},
+	"Step.timeout": {
+		"description": "Go duration, such as \"10m\", applied to each attempt of a run or asc step.",
+		"pattern":     durationPattern,
+	},
+	"Step.continue_on_error": {
+		"description": "Record a failed run or asc step without failing the run.",
+	},
+
+	"StepRetry.attempts": {
+		"description": "Total attempts, including the first run.",
+		"minimum":     1,
+	},
+	"StepRetry.backoff": {
+		"description": "Go duration to wait between attempts.",
+		"pattern":     durationPattern,
+	},
+
+	"Secret.env": {
+		"description": "Read the value from this environment variable.",
+	},
+	"Secret.keychain": {
+		"description": "Read the value from the system keychain, as stored by asc workflow secrets set.",
+	},
+	"Secret.file": {
+		"description": "Read the value from this file, relative to the workflow file. A trailing newline is dropped.",
+	},
+}
@@ -0,0 +1,81 @@
+package workflow
+
+import (
+	"encoding/json"
+	"regexp"
+	"slices"
+	"testing"
+)
+
+func TestJSONSchema_DescribesEveryField(t *testing.T) {
+	schema := JSONSchema()
+	if _, err := json.Marshal(schema); err != nil {
+		t.Fatalf("marshal schema: %v", err)
+	}
+
+	// New fields must be documented in schemaAnnotations, so editors never
+	// show an undescribed key.
+	check := func(owner string, object map[string]any) {
+		properties, _ := object["properties"].(map[string]any)
+		if len(properties) == 0 {
+			t.Fatalf("%s: expected properties", owner)
+		}
+		for name, raw := range properties {
+			property := raw.(map[string]any)
+			if description, _ := property["description"].(string); description == "" {
+				t.Errorf("%s.%s has no description", owner, name)
+			}
+		}
+	}
+	check("Definition", schema)
+	defs := schema["definitions"].(map[string]any)
+	for _, name := range []string{"Workflow", "StepRetry", "Secret"} {
+		check(name, defs[name].(map[string]any))
+	}
+	step := defs["Step"].(map[string]any)["anyOf"].([]any)
+	check("Step", step[1].(map[string]any))
+	if got := step[0].(map[string]any)["type"]; got != "string" {
+		t.Fatalf("expected the string step shorthand first, got %v", got)
+	}
+
+	if required := schema["required"]; !slices.Equal(required.([]string), []string{"workflows"}) {
+		t.Fatalf("expected workflows to be required, got %v", required)
+	}
+}
+
+func TestJSONSchema_PatternsCompile(t *testing.T) {
+	var walk func(path string, value any)
+	walk = func(path string, value any) {
+		switch v := value.(type) {
+		case map[string]any:
+			for key, child := range v {
+				if pattern, ok := child.(string); ok && key == "pattern" {
+					if _, err := regexp.Compile(pattern); err != nil {
+						t.Errorf("%s: pattern %q: %v", path, pattern, err)
+					}
+				}
+				walk(path+"/"+key, child)
+			}
+		case []any:
+			for _, child := range v {
+				walk(path, child)
+			}
+		}
+	}
+	walk("#", JSONSchema())
+}
+
+func TestLoad_AcceptsSchemaKey(t *testing.T) {
+	dir := t.TempDir()
+	path := writeWorkflowFile(t, dir, `{
+		"$schema": "./workflow.schema.json",
+		"workflows": {"main": {"steps": ["echo hi"]}}
+	}`)
+	def, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if def.Schema != "./workflow.schema.json" {
+		t.Fatalf("expected $schema to be kept, got %q", def.Schema)
+	}
+}
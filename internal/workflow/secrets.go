@@ -0,0 +1,237 @@
+package workflow
+
+import (
+	"bytes"
+	"cmp"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// Secret declares where a secret's value comes from. Exactly one of Env
+// (an environment variable), Keychain (an item stored with asc workflow
+// secrets set), or File (a path relative to the workflow file) is set.
+type Secret struct {
+	Env      string `json:"env,omitempty"`
+	Keychain string `json:"keychain,omitempty"`
+	File     string `json:"file,omitempty"`
+}
+
+// SecretLookup reads a named secret from the system keychain.
+type SecretLookup func(name string) (string, error)
+
+// secretMask replaces masked values in output, results, and run state.
+const secretMask = "***"
+
+// minMaskedSecretLineLength is the shortest line of a multi-line secret that
+// is masked on its own; shorter lines such as "{" or "}" would otherwise
+// mask unrelated output.
+const minMaskedSecretLineLength = 8
+
+// maxPendingMaskedBytes bounds how much of an unterminated line a
+// maskingWriter holds back before writing it out.
+const maxPendingMaskedBytes = 64 << 10
+
+var (
+	validSecretName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	envRefPattern   = regexp.MustCompile(`\$(?:\{([A-Za-z_][A-Za-z0-9_]*)\}|([A-Za-z_][A-Za-z0-9_]*))`)
+)
+
+// resolveSecrets reads every declared secret. Relative file paths are
+// resolved against the directory of workflowFile, or the working directory
+// when there is none.
+func resolveSecrets(secrets map[string]Secret, workflowFile string, keychain SecretLookup) (map[string]string, error) {
+	if len(secrets) == 0 {
+		return nil, nil
+	}
+	values := make(map[string]string, len(secrets))
+	for _, name := range slices.Sorted(maps.Keys(secrets)) {
+		value, err := resolveSecret(secrets[name], workflowFile, keychain)
+		if err != nil {
+			return nil, fmt.Errorf("workflow: secret %q: %w", name, err)
+		}
+		values[name] = value
+	}
+	return values, nil
+}
+
+func resolveSecret(secret Secret, workflowFile string, keychain SecretLookup) (string, error) {
+	switch {
+	case secret.Env != "":
+		value := os.Getenv(secret.Env)
+		if value == "" {
+			return "", fmt.Errorf("environment variable %s is not set", secret.Env)
+		}
+		return value, nil
+	case secret.Keychain != "":
+		if keychain == nil {
+			return "", fmt.Errorf("keychain secrets are not supported by this runner")
+		}
+		return keychain(secret.Keychain)
+	case secret.File != "":
+		path := secret.File
+		if !filepath.IsAbs(path) && workflowFile != "" {
+			path = filepath.Join(filepath.Dir(workflowFile), path)
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return "", err
+		}
+		value := strings.TrimRight(string(data), "\r\n")
+		if value == "" {
+			return "", fmt.Errorf("file %s is empty", secret.File)
+		}
+		return value, nil
+	default:
+		return "", fmt.Errorf("no source (set env, keychain, or file)")
+	}
+}
+
+// secretSourceCount counts the sources a secret declares; valid secrets
+// declare exactly one.
+func secretSourceCount(secret Secret) int {
+	count := 0
+	for _, source := range []string{secret.Env, secret.Keychain, secret.File} {
+		if strings.TrimSpace(source) != "" {
+			count++
+		}
+	}
+	return count
+}
+
+// envRefs returns the names of $NAME and ${NAME} references in s.
+func envRefs(s string) []string {
+	var names []string
+	for _, match := range envRefPattern.FindAllStringSubmatch(s, -1) {
+		names = append(names, cmp.Or(match[1], match[2]))
+	}
+	return names
+}
+
+// secretMasker replaces secret values with "***". Each line of a multi-line
+// secret long enough to be distinctive is masked on its own as well, since
+// line-oriented tools print them separately. A nil secretMasker masks nothing.
+type secretMasker struct {
+	replacer *strings.Replacer
+}
+
+func newSecretMasker(values map[string]string) *secretMasker {
+	seen := map[string]bool{}
+	for _, value := range values {
+		if value == "" {
+			continue
+		}
+		seen[value] = true
+		for line := range strings.Lines(value) {
+			if line = strings.TrimSpace(line); maskableSecretLine(line) {
+				seen[line] = true
+			}
+		}
+	}
+	if len(seen) == 0 {
+		return nil
+	}
+	// Longer values go first so a secret containing another is masked whole.
+	candidates := slices.SortedFunc(maps.Keys(seen), func(a, b string) int {
+		return cmp.Or(cmp.Compare(len(b), len(a)), strings.Compare(a, b))
+	})
+	pairs := make([]string, 0, 2*len(candidates))
+	for _, candidate := range candidates {
+		pairs = append(pairs, candidate, secretMask)
+	}
+	return &secretMasker{replacer: strings.NewReplacer(pairs...)}
+}
+
+// maskableSecretLine reports whether one line of a multi-line secret is
+// worth masking by itself. PEM armor lines are public boilerplate.
+func maskableSecretLine(line string) bool {
+	if len(line) < minMaskedSecretLineLength || strings.HasPrefix(line, "-----") {
+		return false
+	}
+	return strings.ContainsFunc(line, func(r rune) bool {
+		return unicode.IsLetter(r) || unicode.IsDigit(r)
+	})
+}
+
+func (m *secretMasker) mask(s string) string {
+	if m == nil || s == "" {
+		return s
+	}
+	return m.replacer.Replace(s)
+}
+
+func (m *secretMasker) maskMap(values map[string]string) map[string]string {
+	if m == nil || values == nil {
+		return values
+	}
+	masked := make(map[string]string, len(values))
+	for k, v := range values {
+		masked[k] = m.mask(v)
+	}
+	return masked
+}
+
+func (m *secretMasker) maskNestedMap(values map[string]map[string]string) map[string]map[string]string {
+	if m == nil || values == nil {
+		return values
+	}
+	masked := make(map[string]map[string]string, len(values))
+	for k, v := range values {
+		masked[k] = m.maskMap(v)
+	}
+	return masked
+}
+
+// maskingWriter masks secrets in everything written through it. Output is
+// passed on a line at a time so a secret split across writes is still
+// caught; Flush writes out a trailing partial line.
+type maskingWriter struct {
+	mu      sync.Mutex
+	w       io.Writer
+	masker  *secretMasker
+	pending []byte
+}
+
+func (m *secretMasker) writer(w io.Writer) *maskingWriter {
+	return &maskingWriter{w: w, masker: m}
+}
+
+func (mw *maskingWriter) Write(p []byte) (int, error) {
+	mw.mu.Lock()
+	defer mw.mu.Unlock()
+	mw.pending = append(mw.pending, p...)
+	end := bytes.LastIndexAny(mw.pending, "\r\n") + 1
+	if end == 0 && len(mw.pending) > maxPendingMaskedBytes {
+		end = len(mw.pending)
+	}
+	if end > 0 {
+		if err := mw.emit(end); err != nil {
+			return 0, err
+		}
+	}
+	return len(p), nil
+}
+
+// Flush writes any buffered partial line.
+func (mw *maskingWriter) Flush() error {
+	mw.mu.Lock()
+	defer mw.mu.Unlock()
+	return mw.emit(len(mw.pending))
+}
+
+func (mw *maskingWriter) emit(n int) error {
+	if n == 0 {
+		return nil
+	}
+	out := mw.masker.mask(string(mw.pending[:n]))
+	mw.pending = append(mw.pending[:0], mw.pending[n:]...)
+	_, err := io.WriteString(mw.w, out)
+	return err
+}
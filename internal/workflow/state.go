@@ -7,8 +7,10 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"maps"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"time"
 )
@@ -29,6 +31,7 @@ type persistedStepState struct {
 	ParentWorkflow string            `json:"parent_workflow,omitempty"`
 	Status         string            `json:"status,omitempty"`
 	Outputs        map[string]string `json:"outputs,omitempty"`
+	Matrix         map[string]string `json:"matrix,omitempty"`
 }
 
 type persistedRunState struct {
@@ -39,10 +42,15 @@ type persistedRunState struct {
 	Params         map[string]string             `json:"params,omitempty"`
 	Status         string                        `json:"status,omitempty"`
 	FailedStep     string                        `json:"failed_step,omitempty"`
+	Error          string                        `json:"error,omitempty"`
 	Hooks          *persistedRunHooks            `json:"hooks,omitempty"`
 	Steps          map[string]persistedStepState `json:"steps,omitempty"`
 	CreatedAt      string                        `json:"created_at,omitempty"`
 	UpdatedAt      string                        `json:"updated_at,omitempty"`
+
+	// Results records every step of the latest attempt of the run, for
+	// history. Steps holds only completed steps and drives --resume.
+	Results []StepResult `json:"results,omitempty"`
 }
 
 func newPersistedRunState(workflowName, workflowFile, definitionHash string, params map[string]string) (persistedRunState, error) {
@@ -105,13 +113,32 @@ func runStateFilePath(stateDir, runID string) string {
 	return filepath.Join(stateDir, sanitizeStateToken(runID)+".json")
 }
 
+// stepLogDir is where a run's per-step logs are written.
+func stepLogDir(stateDir, runID string) string {
+	return filepath.Join(stateDir, "logs", sanitizeStateToken(runID))
+}
+
+// stepLogFileName names a step's log file. The hash keeps names unique when
+// sanitizing maps different step keys to the same token.
+func stepLogFileName(stepKey string) string {
+	sum := sha256.Sum256([]byte(stepKey))
+	return sanitizeStateToken(stepKey) + "-" + hex.EncodeToString(sum[:4]) + ".log"
+}
+
+// definitionFingerprint hashes the definition, including workflows merged in
+// from imports, and the contents of every imported file, so resuming after
+// an imported file changes is detected.
 func definitionFingerprint(def *Definition) (string, error) {
 	data, err := json.Marshal(def)
 	if err != nil {
 		return "", fmt.Errorf("marshal workflow definition: %w", err)
 	}
-	sum := sha256.Sum256(data)
-	return hex.EncodeToString(sum[:]), nil
+	h := sha256.New()
+	h.Write(data)
+	for _, file := range slices.Sorted(maps.Keys(def.importDigests)) {
+		fmt.Fprintf(h, "\x00%s\x00%s", file, def.importDigests[file])
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
 }
 
 func generateRunID(workflowName string) (string, error) {
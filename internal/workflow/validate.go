@@ -26,6 +26,25 @@ const (
 	ErrDuplicateOutputProducerName ValidationCode = "duplicate_output_producer_name"
 	ErrInvalidOutputName           ValidationCode = "invalid_output_name"
 	ErrInvalidOutputExpr           ValidationCode = "invalid_output_expr"
+	ErrParallelConflict            ValidationCode = "parallel_conflict"
+	ErrEmptyParallel               ValidationCode = "empty_parallel"
+	ErrNestedParallel              ValidationCode = "nested_parallel"
+	ErrParallelOptions             ValidationCode = "parallel_options_without_group"
+	ErrInvalidMaxParallel          ValidationCode = "invalid_max_parallel"
+	ErrNeedsOutsideParallel        ValidationCode = "needs_outside_parallel"
+	ErrUnknownNeed                 ValidationCode = "unknown_need"
+	ErrCyclicNeeds                 ValidationCode = "cyclic_needs"
+	ErrDuplicateBranchName         ValidationCode = "duplicate_branch_name"
+	ErrStepPolicyOnNonRun          ValidationCode = "step_policy_on_non_run"
+	ErrInvalidRetry                ValidationCode = "invalid_retry"
+	ErrInvalidTimeout              ValidationCode = "invalid_timeout"
+	ErrStepInvalidASC              ValidationCode = "step_invalid_asc"
+	ErrInvalidMatrix               ValidationCode = "invalid_matrix"
+	ErrUnknownMatrixKey            ValidationCode = "unknown_matrix_key"
+	ErrInvalidIf                   ValidationCode = "invalid_if"
+	ErrUnknownStepOutput           ValidationCode = "unknown_step_output"
+	ErrInvalidSecret               ValidationCode = "invalid_secret"
+	ErrSecretInWith                ValidationCode = "secret_in_with"
 )
 
 // ValidationError describes a structured workflow validation failure.
@@ -33,6 +52,7 @@ type ValidationError struct {
 	Code     ValidationCode `json:"code"`
 	Workflow string         `json:"workflow,omitempty"`
 	Step     int            `json:"step,omitempty"`
+	Branch   int            `json:"branch,omitempty"`
 	Message  string         `json:"message"`
 }
 
@@ -43,8 +63,10 @@ func (e *ValidationError) Error() string {
 // validWorkflowName matches alphanumeric, hyphens, and underscores, starting with a letter.
 var (
 	validWorkflowName = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_-]*$`)
-	validOutputName   = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_]*$`)
-	validOutputExpr   = regexp.MustCompile(`^\$\.[a-zA-Z0-9_]+(?:\.[a-zA-Z0-9_]+)*$`)
+	// validImportedWorkflowName matches ALIAS.NAME, with one segment per import level.
+	validImportedWorkflowName = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_-]*(?:\.[a-zA-Z][a-zA-Z0-9_-]*)+$`)
+	validOutputName           = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_]*$`)
+	validOutputExpr           = regexp.MustCompile(`^\$\.[a-zA-Z0-9_]+(?:\.[a-zA-Z0-9_]+)*$`)
 )
 
 // Validate checks a Definition for structural errors.
@@ -64,6 +86,16 @@ func Validate(def *Definition) []*ValidationError {
 	names := slices.Sorted(maps.Keys(def.Workflows))
 
 	for _, name := range names {
+		if alias := importedWorkflowAlias(name); alias != "" {
+			if _, ok := def.Imports[alias]; !ok || !validImportedWorkflowName.MatchString(name) {
+				errs = append(errs, &ValidationError{
+					Code:     ErrInvalidWorkflowName,
+					Workflow: name,
+					Message:  fmt.Sprintf("workflow name %q may only contain dots when it names a workflow imported as ALIAS.NAME", name),
+				})
+			}
+			continue
+		}
 		if !validWorkflowName.MatchString(name) {
 			errs = append(errs, &ValidationError{
 				Code:     ErrInvalidWorkflowName,
@@ -73,6 +105,8 @@ func Validate(def *Definition) []*ValidationError {
 		}
 	}
 
+	errs = append(errs, validateSecrets(def)...)
+
 	outputProducerConflicts := collectOutputProducerConflicts(def)
 
 	for _, name := range names {
@@ -88,118 +122,442 @@ func Validate(def *Definition) []*ValidationError {
 
 		for i, step := range wf.Steps {
 			idx := i + 1
-			hasRun := strings.TrimSpace(step.Run) != ""
-			hasWorkflow := strings.TrimSpace(step.Workflow) != ""
-			hasRawRun := step.Run != ""
-
-			if !hasRun && !hasWorkflow {
-				if hasRawRun {
-					errs = append(errs, &ValidationError{
-						Code:     ErrStepEmptyRun,
-						Workflow: name,
-						Step:     idx,
-						Message:  fmt.Sprintf("workflow %q step %d has empty run command", name, idx),
-					})
-				} else {
-					errs = append(errs, &ValidationError{
-						Code:     ErrStepNoAction,
-						Workflow: name,
-						Step:     idx,
-						Message:  fmt.Sprintf("workflow %q step %d must have run or workflow", name, idx),
-					})
-				}
-			}
+			pos := stepRef{step: idx}
+			errs = append(errs, validateStep(def, name, pos, fmt.Sprintf("step %d", idx), step, nil, outputProducerConflicts)...)
 
-			if hasRun && hasWorkflow {
+			if len(step.Needs) > 0 {
 				errs = append(errs, &ValidationError{
-					Code:     ErrStepConflict,
+					Code:     ErrNeedsOutsideParallel,
 					Workflow: name,
 					Step:     idx,
-					Message:  fmt.Sprintf("workflow %q step %d has both run and workflow (only one allowed)", name, idx),
+					Message:  fmt.Sprintf("workflow %q step %d has 'needs' outside a parallel group", name, idx),
 				})
 			}
-
-			if hasRun && len(step.With) > 0 {
+			if step.Parallel != nil {
+				errs = append(errs, validateParallelGroup(def, name, idx, step, outputProducerConflicts)...)
+			} else if step.MaxParallel != 0 || step.FailFast != nil {
 				errs = append(errs, &ValidationError{
-					Code:     ErrStepWithOnRun,
+					Code:     ErrParallelOptions,
 					Workflow: name,
 					Step:     idx,
-					Message:  fmt.Sprintf("workflow %q step %d has 'with' on a run step (only allowed on workflow steps)", name, idx),
+					Message:  fmt.Sprintf("workflow %q step %d sets max_parallel or fail_fast without parallel", name, idx),
 				})
 			}
+		}
+	}
 
-			if len(step.Outputs) > 0 {
-				if hasWorkflow {
-					errs = append(errs, &ValidationError{
-						Code:     ErrStepOutputsOnWorkflow,
-						Workflow: name,
-						Step:     idx,
-						Message:  fmt.Sprintf("workflow %q step %d has 'outputs' on a workflow step (only allowed on run steps)", name, idx),
-					})
-				}
+	if cycleErr := detectCycles(def); cycleErr != nil {
+		errs = append(errs, cycleErr)
+	}
 
-				trimmedName := strings.TrimSpace(step.Name)
-				if trimmedName == "" || !validWorkflowName.MatchString(trimmedName) {
-					errs = append(errs, &ValidationError{
-						Code:     ErrStepOutputsRequireName,
-						Workflow: name,
-						Step:     idx,
-						Message:  fmt.Sprintf("workflow %q step %d must use a reference-safe 'name' when declaring outputs", name, idx),
-					})
-				} else {
-					if prevWorkflow, exists := outputProducerConflicts[name][idx]; exists {
-						errs = append(errs, &ValidationError{
-							Code:     ErrDuplicateOutputProducerName,
-							Workflow: name,
-							Step:     idx,
-							Message:  fmt.Sprintf("workflow %q step %d reuses output-producing step name %q already declared in workflow %q", name, idx, trimmedName, prevWorkflow),
-						})
-					}
-				}
+	return errs
+}
 
-				for _, outputName := range slices.Sorted(maps.Keys(step.Outputs)) {
-					if !validOutputName.MatchString(outputName) {
-						errs = append(errs, &ValidationError{
-							Code:     ErrInvalidOutputName,
-							Workflow: name,
-							Step:     idx,
-							Message:  fmt.Sprintf("workflow %q step %d has invalid output name %q", name, idx, outputName),
-						})
-					}
-					if !validOutputExpr.MatchString(strings.TrimSpace(step.Outputs[outputName])) {
-						errs = append(errs, &ValidationError{
-							Code:     ErrInvalidOutputExpr,
-							Workflow: name,
-							Step:     idx,
-							Message:  fmt.Sprintf("workflow %q step %d output %q must use a JSON path like $.field", name, idx, outputName),
-						})
-					}
-				}
+// stepRef identifies a step, or a branch of a parallel step, within a workflow.
+type stepRef struct {
+	step   int
+	branch int
+}
+
+// validateStep checks a run or workflow step. loc is "step N" or
+// "step N branch M" and is used in messages. scope is the matrix of the
+// enclosing parallel group, if any.
+func validateStep(def *Definition, name string, pos stepRef, loc string, step Step, scope map[string][]string, outputProducerConflicts map[string]map[stepRef]string) []*ValidationError {
+	var errs []*ValidationError
+	idx := pos.step
+	newErr := func(code ValidationCode, format string, args ...any) *ValidationError {
+		return &ValidationError{
+			Code:     code,
+			Workflow: name,
+			Step:     idx,
+			Branch:   pos.branch,
+			Message:  fmt.Sprintf("workflow %q %s ", name, loc) + fmt.Sprintf(format, args...),
+		}
+	}
+
+	hasRun := strings.TrimSpace(step.Run) != ""
+	hasWorkflow := strings.TrimSpace(step.Workflow) != ""
+	hasRawRun := step.Run != ""
+	hasASC := step.ASC != nil
+
+	if step.Retry != nil || step.Timeout != "" || step.ContinueOnError {
+		if step.Parallel != nil || (hasWorkflow && !hasRun && !hasASC) {
+			errs = append(errs, newErr(ErrStepPolicyOnNonRun, "has 'retry', 'timeout', or 'continue_on_error' (only allowed on run and asc steps)"))
+		}
+		if step.Retry != nil {
+			if step.Retry.Attempts < 1 {
+				errs = append(errs, newErr(ErrInvalidRetry, "retry attempts must be at least 1"))
+			}
+			if _, err := parseStepDuration(step.Retry.Backoff); err != nil {
+				errs = append(errs, newErr(ErrInvalidRetry, "retry backoff %q must be a non-negative duration like 10s", step.Retry.Backoff))
+			}
+		}
+		if d, err := parseStepDuration(step.Timeout); err != nil || (step.Timeout != "" && d == 0) {
+			errs = append(errs, newErr(ErrInvalidTimeout, "timeout %q must be a positive duration like 10m", step.Timeout))
+		}
+	}
+
+	if step.Matrix != nil {
+		errs = append(errs, validateMatrix(step.Matrix, newErr)...)
+	}
+	if step.Matrix != nil || scope != nil {
+		// Steps outside a matrix may still be called from one, so their
+		// references are only checked at run time.
+		for _, key := range matrixRefs(step) {
+			_, own := step.Matrix[key]
+			_, inherited := scope[key]
+			if !own && !inherited {
+				errs = append(errs, newErr(ErrUnknownMatrixKey, "references matrix.%s, which is not a matrix key", key))
 			}
+		}
+	}
+
+	if src := strings.TrimSpace(step.If); src != "" {
+		errs = append(errs, validateCondition(def, src, step.Matrix, scope, newErr)...)
+	}
+
+	if step.Parallel != nil {
+		if hasRun || hasASC || hasWorkflow || len(step.With) > 0 || len(step.Outputs) > 0 {
+			errs = append(errs, newErr(ErrParallelConflict, "has 'parallel' combined with run, asc, workflow, with, or outputs"))
+		}
+		return errs
+	}
 
-			if hasWorkflow {
-				ref := strings.TrimSpace(step.Workflow)
-				if _, ok := def.Workflows[ref]; !ok {
-					errs = append(errs, &ValidationError{
-						Code:     ErrWorkflowNotFound,
-						Workflow: name,
-						Step:     idx,
-						Message:  fmt.Sprintf("workflow %q step %d references unknown workflow %q", name, idx, ref),
-					})
+	if !hasRun && !hasWorkflow && !hasASC {
+		if hasRawRun {
+			errs = append(errs, newErr(ErrStepEmptyRun, "has empty run command"))
+		} else {
+			errs = append(errs, newErr(ErrStepNoAction, "must have run, asc, or workflow"))
+		}
+	}
+
+	if hasRun && hasWorkflow {
+		errs = append(errs, newErr(ErrStepConflict, "has both run and workflow (only one allowed)"))
+	}
+	if hasASC && (hasRun || hasWorkflow) {
+		errs = append(errs, newErr(ErrStepConflict, "has asc together with run or workflow (only one allowed)"))
+	}
+
+	if hasASC {
+		switch {
+		case len(step.ASC) == 0 || strings.TrimSpace(step.ASC[0]) == "":
+			errs = append(errs, newErr(ErrStepInvalidASC, "has empty asc command"))
+		case strings.TrimSpace(step.ASC[0]) == "asc":
+			errs = append(errs, newErr(ErrStepInvalidASC, "asc arguments must not start with \"asc\" (use [\"builds\", \"list\", ...])"))
+		}
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(step.With)) {
+		for _, ref := range envRefs(step.With[key]) {
+			if _, ok := def.Secrets[ref]; ok {
+				errs = append(errs, newErr(ErrSecretInWith, "references secret %s in 'with' key %q (secrets already reach every step as env vars; with values are not treated as secret)", ref, key))
+			}
+		}
+	}
+
+	if hasRun && len(step.With) > 0 {
+		errs = append(errs, newErr(ErrStepWithOnRun, "has 'with' on a run step (only allowed on workflow steps)"))
+	}
+	if hasASC && len(step.With) > 0 {
+		errs = append(errs, newErr(ErrStepWithOnRun, "has 'with' on an asc step (only allowed on workflow steps)"))
+	}
+
+	if len(step.Outputs) > 0 {
+		if hasWorkflow {
+			errs = append(errs, newErr(ErrStepOutputsOnWorkflow, "has 'outputs' on a workflow step (only allowed on run steps)"))
+		}
+
+		trimmedName := strings.TrimSpace(step.Name)
+		if trimmedName == "" || !validWorkflowName.MatchString(trimmedName) {
+			errs = append(errs, newErr(ErrStepOutputsRequireName, "must use a reference-safe 'name' when declaring outputs"))
+		} else if prevWorkflow, exists := outputProducerConflicts[name][pos]; exists {
+			errs = append(errs, newErr(ErrDuplicateOutputProducerName, "reuses output-producing step name %q already declared in workflow %q", trimmedName, prevWorkflow))
+		}
+
+		for _, outputName := range slices.Sorted(maps.Keys(step.Outputs)) {
+			if !validOutputName.MatchString(outputName) {
+				errs = append(errs, newErr(ErrInvalidOutputName, "has invalid output name %q", outputName))
+			}
+			if !validOutputExpr.MatchString(strings.TrimSpace(step.Outputs[outputName])) {
+				errs = append(errs, newErr(ErrInvalidOutputExpr, "output %q must use a JSON path like $.field", outputName))
+			}
+		}
+	}
+
+	if hasWorkflow {
+		ref := strings.TrimSpace(step.Workflow)
+		if _, ok := def.Workflows[ref]; !ok {
+			errs = append(errs, newErr(ErrWorkflowNotFound, "references unknown workflow %q", ref))
+		}
+	}
+
+	return errs
+}
+
+// validateSecrets checks secret names and sources. A secret may not share a
+// name with an env entry, which would silently shadow it.
+func validateSecrets(def *Definition) []*ValidationError {
+	var errs []*ValidationError
+	for _, name := range slices.Sorted(maps.Keys(def.Secrets)) {
+		newErr := func(format string, args ...any) {
+			errs = append(errs, &ValidationError{
+				Code:    ErrInvalidSecret,
+				Message: fmt.Sprintf("secret %q ", name) + fmt.Sprintf(format, args...),
+			})
+		}
+		if !validSecretName.MatchString(name) {
+			newErr("must be a valid environment variable name")
+		}
+		if secretSourceCount(def.Secrets[name]) != 1 {
+			newErr("must set exactly one of env, keychain, or file")
+		}
+		if _, ok := def.Env[name]; ok {
+			newErr("conflicts with top-level env %q", name)
+		}
+		for _, workflowName := range slices.Sorted(maps.Keys(def.Workflows)) {
+			if _, ok := def.Workflows[workflowName].Env[name]; ok {
+				newErr("conflicts with env %q of workflow %q", name, workflowName)
+			}
+		}
+	}
+	return errs
+}
+
+// validateCondition parses and type-checks an if condition, then checks the
+// step outputs and matrix keys it refers to.
+func validateCondition(def *Definition, src string, matrix, scope map[string][]string, newErr func(ValidationCode, string, ...any) *ValidationError) []*ValidationError {
+	cond, err := parseCondition(src)
+	if err != nil {
+		return []*ValidationError{newErr(ErrInvalidIf, "has invalid if %q: %v", src, err)}
+	}
+	var errs []*ValidationError
+	for _, ref := range cond.refs() {
+		switch ref.scope {
+		case "steps":
+			if !declaresStepOutput(def, ref.name, ref.output) {
+				errs = append(errs, newErr(ErrUnknownStepOutput, "if references %s, which no step named %q declares", ref, ref.name))
+			}
+		case "matrix":
+			if matrix == nil && scope == nil {
+				continue
+			}
+			_, own := matrix[ref.name]
+			_, inherited := scope[ref.name]
+			if !own && !inherited {
+				errs = append(errs, newErr(ErrUnknownMatrixKey, "references matrix.%s, which is not a matrix key", ref.name))
+			}
+		}
+	}
+	return errs
+}
+
+// declaresStepOutput reports whether any step or branch named name declares
+// output key.
+func declaresStepOutput(def *Definition, name, key string) bool {
+	var declares func(steps []Step) bool
+	declares = func(steps []Step) bool {
+		for _, step := range steps {
+			if strings.TrimSpace(step.Name) == name {
+				if _, ok := step.Outputs[key]; ok {
+					return true
 				}
 			}
+			if declares(step.Parallel) {
+				return true
+			}
+		}
+		return false
+	}
+	for _, wf := range def.Workflows {
+		if declares(wf.Steps) {
+			return true
 		}
 	}
+	return false
+}
 
-	if cycleErr := detectCycles(def); cycleErr != nil {
-		errs = append(errs, cycleErr)
+// validateMatrix checks matrix keys and values. Values must not contain the
+// characters that delimit namespaced output names like NAME[key=value,...].
+func validateMatrix(matrix map[string][]string, newErr func(ValidationCode, string, ...any) *ValidationError) []*ValidationError {
+	var errs []*ValidationError
+	if len(matrix) == 0 {
+		return append(errs, newErr(ErrInvalidMatrix, "has an empty matrix"))
+	}
+	for _, key := range slices.Sorted(maps.Keys(matrix)) {
+		if !validWorkflowName.MatchString(key) {
+			errs = append(errs, newErr(ErrInvalidMatrix, "has invalid matrix key %q", key))
+		}
+		values := matrix[key]
+		if len(values) == 0 {
+			errs = append(errs, newErr(ErrInvalidMatrix, "matrix key %q has no values", key))
+		}
+		seen := map[string]bool{}
+		for _, value := range values {
+			switch {
+			case strings.TrimSpace(value) == "":
+				errs = append(errs, newErr(ErrInvalidMatrix, "matrix key %q has an empty value", key))
+			case strings.ContainsAny(value, "[]{},="):
+				errs = append(errs, newErr(ErrInvalidMatrix, "matrix value %q must not contain any of []{},=", value))
+			case seen[value]:
+				errs = append(errs, newErr(ErrInvalidMatrix, "matrix key %q repeats value %q", key, value))
+			}
+			seen[value] = true
+		}
+	}
+	if matrixCombinationCount(matrix) > MaxMatrixCombinations {
+		errs = append(errs, newErr(ErrInvalidMatrix, "matrix expands to more than %d combinations", MaxMatrixCombinations))
+	}
+	return errs
+}
+
+// validateParallelGroup checks the branches of a parallel step and the needs
+// graph between them.
+func validateParallelGroup(def *Definition, name string, idx int, step Step, outputProducerConflicts map[string]map[stepRef]string) []*ValidationError {
+	var errs []*ValidationError
+	if len(step.Parallel) == 0 {
+		errs = append(errs, &ValidationError{
+			Code:     ErrEmptyParallel,
+			Workflow: name,
+			Step:     idx,
+			Message:  fmt.Sprintf("workflow %q step %d has an empty parallel group", name, idx),
+		})
+		return errs
+	}
+	if step.MaxParallel < 0 {
+		errs = append(errs, &ValidationError{
+			Code:     ErrInvalidMaxParallel,
+			Workflow: name,
+			Step:     idx,
+			Message:  fmt.Sprintf("workflow %q step %d has negative max_parallel %d", name, idx, step.MaxParallel),
+		})
+	}
+
+	byName := map[string]int{}
+	for i, branch := range step.Parallel {
+		branchIdx := i + 1
+		loc := fmt.Sprintf("step %d branch %d", idx, branchIdx)
+		errs = append(errs, validateStep(def, name, stepRef{step: idx, branch: branchIdx}, loc, branch, step.Matrix, outputProducerConflicts)...)
+
+		if branch.Parallel != nil || branch.MaxParallel != 0 || branch.FailFast != nil {
+			errs = append(errs, &ValidationError{
+				Code:     ErrNestedParallel,
+				Workflow: name,
+				Step:     idx,
+				Branch:   branchIdx,
+				Message:  fmt.Sprintf("workflow %q %s cannot nest a parallel group (call a workflow instead)", name, loc),
+			})
+		}
+
+		branchName := strings.TrimSpace(branch.Name)
+		if branchName == "" {
+			continue
+		}
+		if first, exists := byName[branchName]; exists {
+			errs = append(errs, &ValidationError{
+				Code:     ErrDuplicateBranchName,
+				Workflow: name,
+				Step:     idx,
+				Branch:   branchIdx,
+				Message:  fmt.Sprintf("workflow %q %s reuses branch name %q from branch %d", name, loc, branchName, first+1),
+			})
+			continue
+		}
+		byName[branchName] = i
+	}
+
+	for i, branch := range step.Parallel {
+		for _, need := range branch.Needs {
+			need = strings.TrimSpace(need)
+			if j, ok := byName[need]; ok && j != i {
+				continue
+			}
+			errs = append(errs, &ValidationError{
+				Code:     ErrUnknownNeed,
+				Workflow: name,
+				Step:     idx,
+				Branch:   i + 1,
+				Message:  fmt.Sprintf("workflow %q step %d branch %d needs %q, which is not another branch in the group", name, idx, i+1, need),
+			})
+		}
+	}
+
+	if cycle := detectNeedsCycle(step.Parallel, byName); len(cycle) > 0 {
+		errs = append(errs, &ValidationError{
+			Code:     ErrCyclicNeeds,
+			Workflow: name,
+			Step:     idx,
+			Message:  fmt.Sprintf("workflow %q step %d has cyclic needs: %s", name, idx, strings.Join(cycle, " -> ")),
+		})
 	}
 
 	return errs
 }
 
-func collectOutputProducerConflicts(def *Definition) map[string]map[int]string {
-	conflicts := map[string]map[int]string{}
+// detectNeedsCycle returns the branch names forming a needs cycle, if any.
+// Uses the same white/gray/black coloring as detectCycles.
+func detectNeedsCycle(branches []Step, byName map[string]int) []string {
+	const (
+		white = 0
+		gray  = 1
+		black = 2
+	)
+
+	colors := make([]int, len(branches))
+	var path []int
+
+	var dfs func(i int) []string
+	dfs = func(i int) []string {
+		colors[i] = gray
+		path = append(path, i)
+		for _, need := range branches[i].Needs {
+			j, ok := byName[strings.TrimSpace(need)]
+			if !ok || j == i {
+				continue
+			}
+			switch colors[j] {
+			case gray:
+				start := slices.Index(path, j)
+				var cycle []string
+				for _, p := range path[start:] {
+					cycle = append(cycle, strings.TrimSpace(branches[p].Name))
+				}
+				return append(cycle, strings.TrimSpace(branches[j].Name))
+			case white:
+				if cycle := dfs(j); cycle != nil {
+					return cycle
+				}
+			}
+		}
+		path = path[:len(path)-1]
+		colors[i] = black
+		return nil
+	}
+
+	for i := range branches {
+		if colors[i] == white {
+			if cycle := dfs(i); cycle != nil {
+				return cycle
+			}
+		}
+	}
+	return nil
+}
+
+// stepWorkflowRefs returns the workflows a step calls, including calls made by
+// its parallel branches.
+func stepWorkflowRefs(step Step) []string {
+	var refs []string
+	if ref := strings.TrimSpace(step.Workflow); ref != "" {
+		refs = append(refs, ref)
+	}
+	for _, branch := range step.Parallel {
+		refs = append(refs, stepWorkflowRefs(branch)...)
+	}
+	return refs
+}
+
+func collectOutputProducerConflicts(def *Definition) map[string]map[stepRef]string {
+	conflicts := map[string]map[stepRef]string{}
 	reachabilityCache := map[string]map[string]struct{}{}
 
 	for _, root := range slices.Sorted(maps.Keys(def.Workflows)) {
@@ -209,28 +567,34 @@ func collectOutputProducerConflicts(def *Definition) map[string]map[int]string {
 		reachableNames := slices.Sorted(maps.Keys(reachable))
 		for _, workflowName := range reachableNames {
 			wf := def.Workflows[workflowName]
-			for i, step := range wf.Steps {
+			record := func(pos stepRef, step Step) {
 				if len(step.Outputs) == 0 {
-					continue
+					return
 				}
 
 				trimmedName := strings.TrimSpace(step.Name)
 				if trimmedName == "" || !validWorkflowName.MatchString(trimmedName) {
-					continue
+					return
 				}
 
 				if prevWorkflow, exists := seen[trimmedName]; exists {
 					if conflicts[workflowName] == nil {
-						conflicts[workflowName] = map[int]string{}
+						conflicts[workflowName] = map[stepRef]string{}
 					}
-					if _, recorded := conflicts[workflowName][i+1]; !recorded {
-						conflicts[workflowName][i+1] = prevWorkflow
+					if _, recorded := conflicts[workflowName][pos]; !recorded {
+						conflicts[workflowName][pos] = prevWorkflow
 					}
-					continue
+					return
 				}
 
 				seen[trimmedName] = workflowName
 			}
+			for i, step := range wf.Steps {
+				record(stepRef{step: i + 1}, step)
+				for j, branch := range step.Parallel {
+					record(stepRef{step: i + 1, branch: j + 1}, branch)
+				}
+			}
 		}
 	}
 
@@ -250,12 +614,10 @@ func workflowsReachableFrom(def *Definition, root string, cache map[string]map[s
 
 	if wf, ok := def.Workflows[root]; ok {
 		for _, step := range wf.Steps {
-			ref := strings.TrimSpace(step.Workflow)
-			if ref == "" {
-				continue
-			}
-			for name := range workflowsReachableFrom(def, ref, cache, visiting) {
-				reachable[name] = struct{}{}
+			for _, ref := range stepWorkflowRefs(step) {
+				for name := range workflowsReachableFrom(def, ref, cache, visiting) {
+					reachable[name] = struct{}{}
+				}
 			}
 		}
 	}
@@ -291,11 +653,11 @@ func detectCycles(def *Definition) *ValidationError {
 			return nil
 		}
 
+		var refs []string
 		for _, step := range wf.Steps {
-			ref := strings.TrimSpace(step.Workflow)
-			if ref == "" {
-				continue
-			}
+			refs = append(refs, stepWorkflowRefs(step)...)
+		}
+		for _, ref := range refs {
 			switch colors[ref] {
 			case gray:
 				cycleStart := -1
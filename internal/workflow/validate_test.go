@@ -3,6 +3,7 @@ package workflow
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
@@ -556,6 +557,177 @@ func TestValidate_NoCycleWithSharedDependency(t *testing.T) {
 	}
 }
 
+func TestValidate_ParallelGroupValid(t *testing.T) {
+	def := &Definition{
+		Workflows: map[string]Workflow{
+			"release": {Steps: []Step{{
+				MaxParallel: 2,
+				Parallel: []Step{
+					{Name: "upload", Run: "echo upload", Outputs: map[string]string{"BUILD_ID": "$.id"}},
+					{Name: "metadata", Run: "echo metadata"},
+					{Name: "distribute", Workflow: "distribute", Needs: []string{"upload"}},
+				},
+			}}},
+			"distribute": {Steps: []Step{{Run: "echo distribute"}}},
+		},
+	}
+	if errs := Validate(def); len(errs) != 0 {
+		t.Fatalf("expected no validation errors, got %v", errs)
+	}
+}
+
+func TestValidate_ParallelConflict(t *testing.T) {
+	def := &Definition{
+		Workflows: map[string]Workflow{
+			"beta": {Steps: []Step{{Run: "echo hi", Parallel: []Step{{Run: "echo a"}}}}},
+		},
+	}
+	errs := Validate(def)
+	assertValidationCode(t, errs, ErrParallelConflict)
+}
+
+func TestValidate_EmptyParallel(t *testing.T) {
+	def := &Definition{
+		Workflows: map[string]Workflow{
+			"beta": {Steps: []Step{{Parallel: []Step{}}}},
+		},
+	}
+	errs := Validate(def)
+	assertValidationCode(t, errs, ErrEmptyParallel)
+}
+
+func TestValidate_NestedParallel(t *testing.T) {
+	def := &Definition{
+		Workflows: map[string]Workflow{
+			"beta": {Steps: []Step{{Parallel: []Step{{Parallel: []Step{{Run: "echo a"}}}}}}},
+		},
+	}
+	errs := Validate(def)
+	assertValidationCode(t, errs, ErrNestedParallel)
+}
+
+func TestValidate_ParallelOptionsWithoutGroup(t *testing.T) {
+	def := &Definition{
+		Workflows: map[string]Workflow{
+			"beta": {Steps: []Step{{Run: "echo hi", MaxParallel: 2}}},
+		},
+	}
+	errs := Validate(def)
+	assertValidationCode(t, errs, ErrParallelOptions)
+}
+
+func TestValidate_NegativeMaxParallel(t *testing.T) {
+	def := &Definition{
+		Workflows: map[string]Workflow{
+			"beta": {Steps: []Step{{MaxParallel: -1, Parallel: []Step{{Run: "echo a"}}}}},
+		},
+	}
+	errs := Validate(def)
+	assertValidationCode(t, errs, ErrInvalidMaxParallel)
+}
+
+func TestValidate_NeedsOutsideParallel(t *testing.T) {
+	def := &Definition{
+		Workflows: map[string]Workflow{
+			"beta": {Steps: []Step{{Name: "a", Run: "echo a"}, {Run: "echo b", Needs: []string{"a"}}}},
+		},
+	}
+	errs := Validate(def)
+	assertValidationCode(t, errs, ErrNeedsOutsideParallel)
+}
+
+func TestValidate_UnknownNeed(t *testing.T) {
+	def := &Definition{
+		Workflows: map[string]Workflow{
+			"beta": {Steps: []Step{{Parallel: []Step{
+				{Name: "a", Run: "echo a", Needs: []string{"missing"}},
+			}}}},
+		},
+	}
+	errs := Validate(def)
+	assertValidationCode(t, errs, ErrUnknownNeed)
+	if errs[0].Step != 1 || errs[0].Branch != 1 {
+		t.Fatalf("expected error at step 1 branch 1, got %+v", errs[0])
+	}
+	if !strings.Contains(errs[0].Message, `step 1 branch 1 needs "missing"`) {
+		t.Fatalf("unexpected message: %s", errs[0].Message)
+	}
+}
+
+func TestValidate_CyclicNeeds(t *testing.T) {
+	def := &Definition{
+		Workflows: map[string]Workflow{
+			"beta": {Steps: []Step{{Parallel: []Step{
+				{Name: "a", Run: "echo a", Needs: []string{"c"}},
+				{Name: "b", Run: "echo b", Needs: []string{"a"}},
+				{Name: "c", Run: "echo c", Needs: []string{"b"}},
+			}}}},
+		},
+	}
+	errs := Validate(def)
+	assertValidationCode(t, errs, ErrCyclicNeeds)
+	for _, e := range errs {
+		if e.Code == ErrCyclicNeeds && !strings.Contains(e.Message, "a -> c -> b -> a") {
+			t.Fatalf("unexpected cycle message: %s", e.Message)
+		}
+	}
+}
+
+func TestValidate_DuplicateBranchName(t *testing.T) {
+	def := &Definition{
+		Workflows: map[string]Workflow{
+			"beta": {Steps: []Step{{Parallel: []Step{
+				{Name: "a", Run: "echo a"},
+				{Name: "a", Run: "echo b"},
+			}}}},
+		},
+	}
+	errs := Validate(def)
+	assertValidationCode(t, errs, ErrDuplicateBranchName)
+}
+
+func TestValidate_ParallelBranchChecksStepRules(t *testing.T) {
+	def := &Definition{
+		Workflows: map[string]Workflow{
+			"beta": {Steps: []Step{{Parallel: []Step{
+				{Run: "echo a"},
+				{Workflow: "missing"},
+			}}}},
+		},
+	}
+	errs := Validate(def)
+	assertValidationCode(t, errs, ErrWorkflowNotFound)
+	if errs[0].Message != `workflow "beta" step 1 branch 2 references unknown workflow "missing"` {
+		t.Fatalf("unexpected message: %s", errs[0].Message)
+	}
+}
+
+func TestValidate_CycleThroughParallelBranch(t *testing.T) {
+	def := &Definition{
+		Workflows: map[string]Workflow{
+			"a": {Steps: []Step{{Parallel: []Step{{Run: "echo a"}, {Workflow: "b"}}}}},
+			"b": {Steps: []Step{{Workflow: "a"}}},
+		},
+	}
+	errs := Validate(def)
+	assertValidationCode(t, errs, ErrCyclicReference)
+}
+
+func TestValidate_DuplicateOutputProducerInParallelBranch(t *testing.T) {
+	def := &Definition{
+		Workflows: map[string]Workflow{
+			"beta": {Steps: []Step{
+				{Name: "build", Run: "echo {}", Outputs: map[string]string{"ID": "$.id"}},
+				{Parallel: []Step{
+					{Name: "build", Run: "echo {}", Outputs: map[string]string{"ID": "$.id"}},
+				}},
+			}},
+		},
+	}
+	errs := Validate(def)
+	assertValidationCode(t, errs, ErrDuplicateOutputProducerName)
+}
+
 func TestValidate_CollectsMultipleErrors(t *testing.T) {
 	def := &Definition{
 		Workflows: map[string]Workflow{
@@ -713,3 +885,172 @@ func TestLoad_ReturnsAllValidationErrors(t *testing.T) {
 		t.Fatalf("expected errors.As to find ValidationError, got %T: %v", err, err)
 	}
 }
+
+func TestValidate_StepPolicies(t *testing.T) {
+	tests := []struct {
+		name string
+		step Step
+		code ValidationCode
+	}{
+		{"retry on workflow step", Step{Workflow: "other", Retry: &StepRetry{Attempts: 2}}, ErrStepPolicyOnNonRun},
+		{"timeout on parallel group", Step{Timeout: "1m", Parallel: []Step{{Run: "echo a"}}}, ErrStepPolicyOnNonRun},
+		{"zero attempts", Step{Run: "echo", Retry: &StepRetry{Attempts: 0}}, ErrInvalidRetry},
+		{"bad backoff", Step{Run: "echo", Retry: &StepRetry{Attempts: 2, Backoff: "soon"}}, ErrInvalidRetry},
+		{"negative backoff", Step{Run: "echo", Retry: &StepRetry{Attempts: 2, Backoff: "-1s"}}, ErrInvalidRetry},
+		{"bad timeout", Step{Run: "echo", Timeout: "forever"}, ErrInvalidTimeout},
+		{"zero timeout", Step{Run: "echo", Timeout: "0s"}, ErrInvalidTimeout},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			def := &Definition{
+				Workflows: map[string]Workflow{
+					"beta":  {Steps: []Step{tt.step}},
+					"other": {Steps: []Step{{Run: "echo"}}},
+				},
+			}
+			assertValidationCode(t, Validate(def), tt.code)
+		})
+	}
+}
+
+func TestValidate_StepPoliciesValid(t *testing.T) {
+	def := &Definition{
+		Workflows: map[string]Workflow{
+			"beta": {Steps: []Step{
+				{Run: "asc builds wait", Retry: &StepRetry{Attempts: 3, Backoff: "30s"}, Timeout: "20m"},
+				{Run: "echo notify", ContinueOnError: true},
+				{Parallel: []Step{{Run: "echo a", Timeout: "1m", ContinueOnError: true}}},
+			}},
+		},
+	}
+	if errs := Validate(def); len(errs) != 0 {
+		t.Fatalf("expected no validation errors, got %v", errs)
+	}
+}
+
+func TestLoad_StepPolicies(t *testing.T) {
+	dir := t.TempDir()
+	path := writeWorkflowFile(t, dir, `{
+		"workflows": {
+			"beta": {
+				"steps": [
+					{"run": "asc builds wait", "retry": {"attempts": 3, "backoff": "5s"}, "timeout": "10m", "continue_on_error": true}
+				]
+			}
+		}
+	}`)
+
+	def, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	step := def.Workflows["beta"].Steps[0]
+	if step.Retry == nil || step.Retry.Attempts != 3 || step.Retry.Backoff != "5s" {
+		t.Fatalf("unexpected retry: %+v", step.Retry)
+	}
+	if step.Timeout != "10m" || !step.ContinueOnError {
+		t.Fatalf("unexpected step policies: %+v", step)
+	}
+}
+
+func TestValidate_ASCSteps(t *testing.T) {
+	tests := []struct {
+		name string
+		step Step
+		code ValidationCode
+	}{
+		{"empty", Step{ASC: []string{}}, ErrStepInvalidASC},
+		{"leading asc", Step{ASC: []string{"asc", "apps", "list"}}, ErrStepInvalidASC},
+		{"with run", Step{ASC: []string{"apps", "list"}, Run: "echo"}, ErrStepConflict},
+		{"with workflow", Step{ASC: []string{"apps", "list"}, Workflow: "other"}, ErrStepConflict},
+		{"with on asc", Step{ASC: []string{"apps", "list"}, With: map[string]string{"A": "b"}}, ErrStepWithOnRun},
+		{"in parallel group", Step{ASC: []string{"apps", "list"}, Parallel: []Step{{Run: "echo"}}}, ErrParallelConflict},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			def := &Definition{
+				Workflows: map[string]Workflow{
+					"beta":  {Steps: []Step{tt.step}},
+					"other": {Steps: []Step{{Run: "echo"}}},
+				},
+			}
+			assertValidationCode(t, Validate(def), tt.code)
+		})
+	}
+}
+
+func TestValidate_ASCStepValid(t *testing.T) {
+	def := &Definition{
+		Workflows: map[string]Workflow{
+			"beta": {Steps: []Step{{
+				Name:    "latest",
+				ASC:     []string{"builds", "info", "--app", "$APP_ID", "--latest"},
+				Outputs: map[string]string{"BUILD_ID": "$.data.id"},
+				Retry:   &StepRetry{Attempts: 2},
+			}}},
+		},
+	}
+	if errs := Validate(def); len(errs) != 0 {
+		t.Fatalf("expected no validation errors, got %v", errs)
+	}
+}
+
+func TestValidate_MatrixErrors(t *testing.T) {
+	tooMany := make([]string, 17)
+	for i := range tooMany {
+		tooMany[i] = fmt.Sprintf("v%d", i)
+	}
+	tests := []struct {
+		name string
+		step Step
+		code ValidationCode
+	}{
+		{"empty", Step{Run: "echo", Matrix: map[string][]string{}}, ErrInvalidMatrix},
+		{"invalid key", Step{Run: "echo", Matrix: map[string][]string{"1app": {"a"}}}, ErrInvalidMatrix},
+		{"no values", Step{Run: "echo", Matrix: map[string][]string{"app": {}}}, ErrInvalidMatrix},
+		{"empty value", Step{Run: "echo", Matrix: map[string][]string{"app": {" "}}}, ErrInvalidMatrix},
+		{"delimiter in value", Step{Run: "echo", Matrix: map[string][]string{"app": {"a,b"}}}, ErrInvalidMatrix},
+		{"duplicate value", Step{Run: "echo", Matrix: map[string][]string{"app": {"a", "a"}}}, ErrInvalidMatrix},
+		{"too many combinations", Step{Run: "echo", Matrix: map[string][]string{"a": tooMany, "b": tooMany}}, ErrInvalidMatrix},
+		{"unknown key", Step{Run: "echo ${{ matrix.platform }}", Matrix: map[string][]string{"app": {"a"}}}, ErrUnknownMatrixKey},
+		{"unknown key in with", Step{Workflow: "other", With: map[string]string{"P": "${{matrix.platform}}"}, Matrix: map[string][]string{"app": {"a"}}}, ErrUnknownMatrixKey},
+		{"unknown key in branch", Step{Matrix: map[string][]string{"app": {"a"}}, Parallel: []Step{{Run: "echo ${{ matrix.platform }}"}}}, ErrUnknownMatrixKey},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			def := &Definition{
+				Workflows: map[string]Workflow{
+					"beta":  {Steps: []Step{tt.step}},
+					"other": {Steps: []Step{{Run: "echo"}}},
+				},
+			}
+			assertValidationCode(t, Validate(def), tt.code)
+		})
+	}
+}
+
+func TestValidate_MatrixValid(t *testing.T) {
+	def := &Definition{
+		Workflows: map[string]Workflow{
+			"beta": {Steps: []Step{
+				{
+					Workflow: "ship",
+					With:     map[string]string{"APP_ID": "${{ matrix.app }}"},
+					Matrix:   map[string][]string{"app": {"123", "456"}, "platform": {"IOS", "MAC_OS"}},
+				},
+				{
+					Matrix: map[string][]string{"platform": {"IOS"}},
+					Parallel: []Step{
+						{ASC: []string{"builds", "list", "--platform", "${{ matrix.platform }}"}},
+						{Run: "echo ${{ matrix.platform }} ${{ matrix.locale }}", Matrix: map[string][]string{"locale": {"en-US"}}},
+					},
+				},
+			}},
+			// Sub-workflows resolve matrix values from the calling step.
+			"ship": {Private: true, Steps: []Step{{Run: "echo ${{ matrix.platform }}"}}},
+		},
+	}
+	if errs := Validate(def); len(errs) != 0 {
+		t.Fatalf("expected no validation errors, got %v", errs)
+	}
+}
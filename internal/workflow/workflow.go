@@ -11,12 +11,28 @@ import (
 )
 
 // Definition is the top-level .asc/workflow.json schema.
+//
+// Imports maps an alias to another workflow file, or to a directory holding
+// a workflow.json, relative to this file. Load merges the imported workflows
+// into Workflows as ALIAS.NAME.
+//
+// Secrets are injected into every step's env like Env, but their values are
+// masked as "***" in step output, logs, RunResult, and persisted run state.
+//
+// Schema is the optional "$schema" key editors use to find JSONSchema's
+// output; the runner ignores it.
 type Definition struct {
+	Schema    string              `json:"$schema,omitempty"`
+	Imports   map[string]string   `json:"imports,omitempty"`
 	Env       map[string]string   `json:"env,omitempty"`
+	Secrets   map[string]Secret   `json:"secrets,omitempty"`
 	BeforeAll string              `json:"before_all,omitempty"`
 	AfterAll  string              `json:"after_all,omitempty"`
 	Error     string              `json:"error,omitempty"`
 	Workflows map[string]Workflow `json:"workflows"`
+
+	// importDigests maps each imported file to the SHA-256 of its contents.
+	importDigests map[string]string
 }
 
 // Workflow is a named automation sequence.
@@ -29,13 +45,50 @@ type Workflow struct {
 
 // Step is one executable action in a workflow.
 // Bare JSON strings unmarshal to Step{Run: "..."} as shorthand.
+//
+// A step with Parallel is a group: its branches run concurrently, up to
+// MaxParallel at a time (0 means all at once). A branch may list sibling
+// branch names in Needs to wait for them. FailFast (default true) cancels the
+// remaining branches after the first failure; false lets independent branches
+// finish before the group reports its errors.
+//
+// ASC runs an asc command (arguments without the leading "asc") through
+// RunOptions.ASC without a shell; its outputs are read from the command's
+// structured result instead of stdout.
+//
+// Matrix runs the step once per combination of its values, in order, with
+// keys sorted by name. ${{ matrix.KEY }} in run, asc, and with resolves to the
+// current combination, including inside sub-workflows the step calls. Outputs
+// produced by a combination are also published as NAME[key=value,...].
+//
+// Run and asc steps may also set Retry, Timeout (a Go duration such as "10m",
+// applied to each attempt), and ContinueOnError, which records a failed command
+// without failing the run.
 type Step struct {
-	Run      string            `json:"run,omitempty"`
-	Workflow string            `json:"workflow,omitempty"`
-	Name     string            `json:"name,omitempty"`
-	If       string            `json:"if,omitempty"`
-	With     map[string]string `json:"with,omitempty"`
-	Outputs  map[string]string `json:"outputs,omitempty"`
+	Run         string              `json:"run,omitempty"`
+	ASC         []string            `json:"asc,omitempty"`
+	Workflow    string              `json:"workflow,omitempty"`
+	Name        string              `json:"name,omitempty"`
+	If          string              `json:"if,omitempty"`
+	With        map[string]string   `json:"with,omitempty"`
+	Outputs     map[string]string   `json:"outputs,omitempty"`
+	Parallel    []Step              `json:"parallel,omitempty"`
+	MaxParallel int                 `json:"max_parallel,omitempty"`
+	FailFast    *bool               `json:"fail_fast,omitempty"`
+	Needs       []string            `json:"needs,omitempty"`
+	Matrix      map[string][]string `json:"matrix,omitempty"`
+
+	Retry           *StepRetry `json:"retry,omitempty"`
+	Timeout         string     `json:"timeout,omitempty"`
+	ContinueOnError bool       `json:"continue_on_error,omitempty"`
+}
+
+// StepRetry reruns a failed run or asc step. Attempts counts the first run, so
+// attempts: 3 allows two retries. Backoff is a Go duration waited between
+// attempts.
+type StepRetry struct {
+	Attempts int    `json:"attempts"`
+	Backoff  string `json:"backoff,omitempty"`
 }
 
 // UnmarshalJSON handles the flexible step format: